@@ -0,0 +1,317 @@
+package main
+
+import (
+	"log/slog"
+
+	"array-assessment/internal/config"
+	"array-assessment/internal/handlers"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"gorm.io/gorm"
+)
+
+// application holds the fully wired dependency graph used by the HTTP server
+// and the background workers
+type application struct {
+	config *config.Config
+	db     *gorm.DB
+	logger *slog.Logger
+
+	// Dependencies required by middleware
+	tokenService         services.TokenServiceInterface
+	blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface
+	northWindService     services.NorthWindServiceInterface
+	exchangeRateService  services.ExchangeRateServiceInterface
+	mfaService           services.MFAServiceInterface
+
+	// Background workers
+	processingService       services.TransactionProcessingServiceInterface
+	categoryService         services.CategoryServiceInterface
+	recategorizationService services.RecategorizationServiceInterface
+	transferScheduleService services.TransferScheduleServiceInterface
+	interestService         services.InterestServiceInterface
+	holdService             services.HoldServiceInterface
+	externalTransferService services.ExternalTransferServiceInterface
+	webhookService          services.WebhookServiceInterface
+	approvalService         services.ApprovalServiceInterface
+	fraudReviewService      services.FraudReviewServiceInterface
+
+	// HTTP handlers
+	authHandler                *handlers.AuthHandler
+	mfaHandler                 *handlers.MFAHandler
+	sessionHandler             *handlers.SessionHandler
+	roleHandler                *handlers.RoleHandler
+	approvalHandler            *handlers.ApprovalHandler
+	accountHandler             *handlers.AccountHandler
+	accountSummaryHandler      *handlers.AccountSummaryHandler
+	transactionHandler         *handlers.TransactionHandler
+	transactionCategoryHandler *handlers.TransactionCategoryHandler
+	customerHandler            *handlers.CustomerHandler
+	adminHandler               *handlers.AdminHandler
+	categoryHandler            *handlers.CategoryHandler
+	recategorizationHandler    *handlers.RecategorizationHandler
+	transferScheduleHandler    *handlers.TransferScheduleHandler
+	interestHandler            *handlers.InterestHandler
+	holdHandler                *handlers.HoldHandler
+	externalTransferHandler    *handlers.ExternalTransferHandler
+	limitHandler               *handlers.LimitHandler
+	fraudReviewHandler         *handlers.FraudReviewHandler
+	reversalHandler            *handlers.ReversalHandler
+	importHandler              *handlers.TransactionImportHandler
+	webhookHandler             *handlers.WebhookHandler
+	queueHandler               *handlers.QueueHandler
+	devHandler                 *handlers.DevHandler
+	docsHandler                *handlers.DocsHandler
+	jwksHandler                *handlers.JWKSHandler
+	healthHandler              *handlers.HealthCheckHandler
+}
+
+// newApplication constructs repositories, services and handlers
+func newApplication(cfg *config.Config, db *gorm.DB, logger *slog.Logger) *application {
+	// Repositories
+	userRepo := repositories.NewUserRepository(db)
+	refreshTokenRepo := repositories.NewRefreshTokenRepository(db)
+	blacklistedTokenRepo := repositories.NewBlacklistedTokenRepository(db)
+	auditLogRepo := repositories.NewAuditLogRepository(db)
+	accountRepo := repositories.NewAccountRepository(db)
+	transactionRepo := repositories.NewTransactionRepository(db)
+	transferRepo := repositories.NewTransferRepository(db)
+	transferScheduleRepo := repositories.NewTransferScheduleRepository(db)
+	queueRepo := repositories.NewProcessingQueueRepository(db)
+	categoryRepo := repositories.NewTransactionCategoryRepository(db)
+	merchantMappingRepo := repositories.NewMerchantMappingRepository(db)
+	recategorizationJobRepo := repositories.NewRecategorizationJobRepository(db)
+	interestRepo := repositories.NewInterestRepository(db)
+	holdRepo := repositories.NewHoldRepository(db)
+	exchangeRateRepo := repositories.NewExchangeRateRepository(db)
+	externalTransferRepo := repositories.NewExternalTransferRepository(db)
+	limitRepo := repositories.NewLimitRepository(db)
+	fraudReviewRepo := repositories.NewFraudReviewRepository(db)
+	statementArchiveRepo := repositories.NewStatementArchiveRepository(db)
+	webhookRepo := repositories.NewWebhookRepository(db)
+	mfaRepo := repositories.NewMFARepository(db)
+	sessionRepo := repositories.NewSessionRepository(db)
+	roleRepo := repositories.NewRoleRepository(db)
+	pendingActionRepo := repositories.NewPendingActionRepository(db)
+
+	// Cross-cutting services
+	auditService := services.NewAuditService(auditLogRepo)
+	auditLogger := services.NewAuditLogger(logger)
+	customerLogger := services.NewCustomerLogger(logger)
+	metrics := services.NewPrometheusMetrics()
+	circuitBreaker := services.NewCircuitBreaker(services.DefaultCircuitBreakerConfig())
+	webhookService := services.NewWebhookService(
+		webhookRepo,
+		cfg.Webhook.Timeout,
+		cfg.Webhook.MaxAttempts,
+		cfg.Webhook.BatchSize,
+		cfg.Webhook.AllowInsecureURLs,
+		logger,
+	)
+
+	// Domain services
+	tokenService := services.NewTokenService(&cfg.JWT)
+	passwordService := services.NewPasswordService(userRepo, auditService)
+	mfaService := services.NewMFAService(mfaRepo, userRepo, auditLogRepo, cfg.MFA.Issuer, cfg.MFA.SecretKey, logger)
+	sessionService := services.NewSessionService(sessionRepo, blacklistedTokenRepo, auditLogRepo, logger)
+	roleService := services.NewRoleService(roleRepo, userRepo, auditLogRepo, sessionService, logger)
+	exchangeRateService := services.NewExchangeRateService(exchangeRateRepo, logger)
+	limitService := services.NewLimitService(limitRepo, accountRepo, logger)
+	metricsService := services.NewAccountMetricsService(accountRepo, transactionRepo, userRepo, interestRepo, exchangeRateService)
+	fraudScreeningService := services.NewFraudScreeningService(
+		fraudScreeningRules(&cfg.Fraud, metricsService, transferRepo, auditLogRepo),
+		holdRepo,
+		fraudReviewRepo,
+		auditLogRepo,
+		cfg.Fraud.ReviewWindow,
+		logger,
+	)
+	accountService := services.NewAccountService(accountRepo, transactionRepo, transferRepo, userRepo, auditLogRepo, exchangeRateService, limitService, fraudScreeningService, webhookService, logger)
+	fraudReviewService := services.NewFraudReviewService(fraudReviewRepo, holdRepo, transferRepo, externalTransferRepo, accountService, webhookService, cfg.Fraud.ExpiryBatchSize, logger)
+	transferScheduleService := services.NewTransferScheduleService(
+		transferScheduleRepo,
+		transferRepo,
+		accountService,
+		cfg.Transfer.ScheduleBatchSize,
+		logger,
+	)
+	authService := services.NewAuthService(
+		userRepo,
+		refreshTokenRepo,
+		auditLogRepo,
+		blacklistedTokenRepo,
+		roleRepo,
+		passwordService,
+		tokenService,
+		accountService,
+		mfaService,
+		sessionService,
+		logger,
+	)
+	interestService := services.NewInterestService(
+		interestRepo,
+		accountRepo,
+		transactionRepo,
+		cfg.Interest.AccountBatchSize,
+		logger,
+	)
+	holdService := services.NewHoldService(
+		holdRepo,
+		accountRepo,
+		transactionRepo,
+		queueRepo,
+		cfg.Hold.ExpiryBatchSize,
+		logger,
+	)
+	reversalService := services.NewReversalService(transactionRepo, transferRepo, accountRepo, queueRepo, logger)
+	summaryService := services.NewAccountSummaryService(accountRepo, userRepo, exchangeRateService)
+	statementService := services.NewStatementService(accountRepo, transactionRepo, userRepo, metricsService, statementArchiveRepo)
+	searchService := services.NewCustomerSearchService(userRepo)
+	profileService := services.NewCustomerProfileService(userRepo, accountRepo, auditService, webhookService)
+	associationService := services.NewAccountAssociationService(userRepo, accountRepo, auditService, logger)
+	categoryService := services.NewCategoryService(categoryRepo, merchantMappingRepo, logger)
+	transactionImportService := services.NewTransactionImportService(
+		transactionRepo,
+		accountRepo,
+		categoryRepo,
+		categoryService,
+		logger,
+	)
+	approvalService := services.NewApprovalService(
+		pendingActionRepo,
+		auditLogRepo,
+		[]services.ActionExecutor{
+			services.NewDeleteCustomerExecutor(profileService),
+			services.NewTransferOwnershipExecutor(associationService),
+			services.NewResetPasswordExecutor(passwordService),
+			services.NewManualTransactionExecutor(accountService),
+			services.NewTransactionImportExecutor(transactionImportService),
+		},
+		&cfg.Approval,
+		logger,
+	)
+	northWindService := services.NewNorthWindService(&cfg.NorthWind, logger)
+	externalTransferService := services.NewExternalTransferService(
+		externalTransferRepo,
+		accountRepo,
+		userRepo,
+		northWindService,
+		limitService,
+		fraudScreeningService,
+		cfg.Transfer.ExternalSettlementDelay,
+		cfg.Transfer.ExternalBatchSize,
+		webhookService,
+		logger,
+	)
+	processingService := services.NewTransactionProcessingService(
+		transactionRepo,
+		queueRepo,
+		holdRepo,
+		auditLogger,
+		metrics,
+		circuitBreaker,
+		webhookService,
+		cfg.Queue.MaxWorkers,
+		cfg.Queue.WorkerID,
+		cfg.Queue.LeaseDuration,
+	)
+	deadLetterService := services.NewDeadLetterService(queueRepo, logger)
+	categoryManagementService := services.NewCategoryManagementService(categoryRepo, merchantMappingRepo, categoryService, logger)
+	recategorizationService := services.NewRecategorizationService(
+		recategorizationJobRepo,
+		transactionRepo,
+		categoryService,
+		cfg.Category.RecategorizationBatchSize,
+		logger,
+	)
+	transactionCategoryService := services.NewTransactionCategoryService(
+		transactionRepo,
+		accountRepo,
+		auditLogRepo,
+		categoryService,
+		categoryManagementService,
+		logger,
+	)
+	transactionExportService := services.NewTransactionExportService(transactionRepo)
+
+	return &application{
+		config: cfg,
+		db:     db,
+		logger: logger,
+
+		tokenService:         tokenService,
+		blacklistedTokenRepo: blacklistedTokenRepo,
+		northWindService:     northWindService,
+		exchangeRateService:  exchangeRateService,
+		mfaService:           mfaService,
+
+		processingService:       processingService,
+		categoryService:         categoryService,
+		recategorizationService: recategorizationService,
+		transferScheduleService: transferScheduleService,
+		interestService:         interestService,
+		holdService:             holdService,
+		externalTransferService: externalTransferService,
+		webhookService:          webhookService,
+		approvalService:         approvalService,
+		fraudReviewService:      fraudReviewService,
+
+		authHandler:                handlers.NewAuthHandler(authService),
+		mfaHandler:                 handlers.NewMFAHandler(mfaService),
+		sessionHandler:             handlers.NewSessionHandler(sessionService),
+		roleHandler:                handlers.NewRoleHandler(roleService),
+		approvalHandler:            handlers.NewApprovalHandler(approvalService),
+		accountHandler:             handlers.NewAccountHandler(accountService, approvalService, auditLogger, metrics),
+		accountSummaryHandler:      handlers.NewAccountSummaryHandler(summaryService, metricsService, statementService),
+		transactionHandler:         handlers.NewTransactionHandler(transactionRepo, accountRepo, transactionExportService),
+		transactionCategoryHandler: handlers.NewTransactionCategoryHandler(transactionCategoryService),
+		customerHandler: handlers.NewCustomerHandler(
+			searchService,
+			profileService,
+			associationService,
+			passwordService,
+			auditService,
+			approvalService,
+			customerLogger,
+			metrics,
+		),
+		adminHandler:            handlers.NewAdminHandler(userRepo, auditLogRepo),
+		categoryHandler:         handlers.NewCategoryHandler(categoryManagementService, auditLogRepo),
+		recategorizationHandler: handlers.NewRecategorizationHandler(recategorizationService, auditLogRepo),
+		transferScheduleHandler: handlers.NewTransferScheduleHandler(transferScheduleService),
+		interestHandler:         handlers.NewInterestHandler(interestService, auditLogRepo),
+		holdHandler:             handlers.NewHoldHandler(holdService, auditLogRepo),
+		externalTransferHandler: handlers.NewExternalTransferHandler(externalTransferService, auditLogRepo),
+		limitHandler:            handlers.NewLimitHandler(limitService, auditLogRepo),
+		fraudReviewHandler:      handlers.NewFraudReviewHandler(fraudReviewService, auditLogRepo),
+		reversalHandler:         handlers.NewReversalHandler(reversalService, auditLogRepo),
+		importHandler:           handlers.NewTransactionImportHandler(transactionImportService, approvalService, auditLogRepo),
+		webhookHandler:          handlers.NewWebhookHandler(webhookService, auditLogRepo),
+		queueHandler:            handlers.NewQueueHandler(processingService, deadLetterService, auditLogRepo),
+		devHandler:              handlers.NewDevHandler(transactionRepo, accountRepo),
+		docsHandler:             handlers.NewDocsHandler(),
+		jwksHandler:             handlers.NewJWKSHandler(tokenService),
+		healthHandler:           handlers.NewHealthCheckHandler(db),
+	}
+}
+
+// fraudScreeningRules builds the screening pipeline from configuration. Disabling
+// screening leaves the pipeline empty so nothing is held for review.
+func fraudScreeningRules(
+	cfg *config.FraudConfig,
+	metricsService services.AccountMetricsServiceInterface,
+	transferRepo repositories.TransferRepositoryInterface,
+	auditLogRepo repositories.AuditLogRepositoryInterface,
+) []services.ScreeningRule {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return []services.ScreeningRule{
+		services.NewLargeAmountRule(metricsService, cfg.AmountMultiplier, cfg.MinHistoryTransactions),
+		services.NewNewDestinationRule(transferRepo),
+		services.NewNewLoginIPRule(auditLogRepo, cfg.LoginHistory),
+		services.NewRapidTransfersRule(transferRepo, cfg.RapidTransferWindow, cfg.RapidTransferCount),
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"array-assessment/internal/config"
+	"array-assessment/internal/database"
+	"array-assessment/internal/handlers"
+	"array-assessment/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"gorm.io/gorm"
+)
+
+// @title Array Banking API
+// @version 1.0
+// @description Production-quality banking REST API for developer assessment and interviewing. Provides core banking functionality including identity management, account operations, customer management, and transaction processing.
+// @BasePath /api/v1
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Type "Bearer" followed by a space and JWT token.
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("server exited with error: %v", err)
+	}
+}
+
+// run loads configuration, wires dependencies, starts the HTTP server and the
+// queue worker, and blocks until a shutdown signal is received
+func run() error {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	slog.SetDefault(logger)
+
+	cfg := config.Load()
+
+	db, err := database.Initialize(cfg)
+	if err != nil {
+		return err
+	}
+
+	app := newApplication(cfg, db, logger)
+
+	if cfg.FX.RatesFile != "" {
+		if _, err := app.exchangeRateService.LoadRatesFile(cfg.FX.RatesFile); err != nil {
+			return err
+		}
+	}
+	e := newEcho(app)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	workerCtx, cancelWorkers := context.WithCancel(context.Background())
+	defer cancelWorkers()
+
+	var workers sync.WaitGroup
+	startWorker := func(work func()) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			work()
+		}()
+	}
+
+	startWorker(func() { app.processingService.StartProcessing(workerCtx) })
+	startWorker(func() { app.processingService.StartLeaseReaper(workerCtx, cfg.Queue.LeaseReaperInterval) })
+	startWorker(func() { app.categoryService.StartAutoReload(workerCtx, cfg.Category.RulesReloadInterval) })
+	startWorker(func() { app.recategorizationService.StartWorker(workerCtx, cfg.Category.RecategorizationPollInterval) })
+	startWorker(func() { app.transferScheduleService.StartWorker(workerCtx, cfg.Transfer.SchedulePollInterval) })
+	startWorker(func() { app.interestService.StartWorker(workerCtx, cfg.Interest.AccrualPollInterval) })
+	startWorker(func() { app.holdService.StartWorker(workerCtx, cfg.Hold.ExpiryPollInterval) })
+	startWorker(func() { app.externalTransferService.StartWorker(workerCtx, cfg.Transfer.ExternalPollInterval) })
+	startWorker(func() { app.webhookService.StartWorker(workerCtx, cfg.Webhook.PollInterval) })
+	startWorker(func() { app.approvalService.StartWorker(workerCtx, cfg.Approval.PollInterval) })
+	startWorker(func() { app.fraudReviewService.StartWorker(workerCtx, cfg.Fraud.ExpiryPollInterval) })
+
+	server := &http.Server{
+		Addr:         net.JoinHostPort(cfg.Server.Host, cfg.Server.Port),
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+		IdleTimeout:  cfg.Server.IdleTimeout,
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		logger.Info("starting HTTP server",
+			slog.String("addr", server.Addr),
+			slog.String("environment", cfg.Server.Environment),
+		)
+		if err := e.StartServer(server); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+		close(serverErr)
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Info("shutdown signal received, draining in-flight requests")
+	case err := <-serverErr:
+		if err != nil {
+			cancelWorkers()
+			workers.Wait()
+			return err
+		}
+	}
+
+	return shutdown(e, db, cancelWorkers, &workers, cfg.Server.ShutdownTimeout, logger)
+}
+
+// newEcho creates the Echo instance with the global middleware chain and routes
+func newEcho(app *application) *echo.Echo {
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	e.Validator = handlers.NewValidator()
+	e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
+
+	e.Use(middleware.RequestID())
+	e.Use(middleware.PanicRecovery())
+	e.Use(middleware.SecurityHeaders())
+	e.Use(echomiddleware.CORSWithConfig(echomiddleware.CORSConfig{
+		AllowOrigins: app.config.Server.CORSAllowOrigins,
+		AllowHeaders: []string{
+			echo.HeaderOrigin,
+			echo.HeaderContentType,
+			echo.HeaderAccept,
+			echo.HeaderAuthorization,
+			"Idempotency-Key",
+			middleware.TraceIDHeader,
+		},
+		ExposeHeaders: []string{middleware.TraceIDHeader},
+	}))
+	e.Use(middleware.RateLimiterWithConfig(app.config.Security.RateLimitPerSecond, app.config.Security.RateLimitPerSecond*2))
+
+	app.registerRoutes(e)
+
+	return e
+}
+
+// shutdown stops accepting new requests, waits for in-flight requests to finish,
+// then stops the queue workers and closes the database connection pool
+func shutdown(e *echo.Echo, db *gorm.DB, cancelWorkers context.CancelFunc, workers *sync.WaitGroup, timeout time.Duration, logger *slog.Logger) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var shutdownErr error
+	if err := e.Shutdown(ctx); err != nil {
+		logger.Error("HTTP server shutdown failed", slog.String("error", err.Error()))
+		shutdownErr = err
+	}
+
+	logger.Info("stopping queue workers")
+	cancelWorkers()
+
+	workersDone := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workersDone)
+	}()
+
+	select {
+	case <-workersDone:
+		logger.Info("queue workers stopped")
+	case <-ctx.Done():
+		logger.Warn("timed out waiting for queue workers to stop")
+	}
+
+	if sqlDB, err := db.DB(); err == nil {
+		if err := sqlDB.Close(); err != nil {
+			logger.Error("failed to close database connection", slog.String("error", err.Error()))
+		}
+	}
+
+	logger.Info("server stopped")
+	return shutdownErr
+}
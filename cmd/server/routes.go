@@ -0,0 +1,202 @@
+package main
+
+import (
+	"array-assessment/internal/middleware"
+	"array-assessment/internal/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// registerRoutes registers the route table documented in docs/swagger.yaml
+func (app *application) registerRoutes(e *echo.Echo) {
+	requireAuth := middleware.RequireAuth(app.tokenService, app.blacklistedTokenRepo)
+	requirePermission := middleware.RequirePermission
+	requireStepUp := middleware.RequireRecentMFA(app.mfaService, app.config.MFA.StepUpWindow)
+
+	// Documentation and observability
+	e.GET("/docs", app.docsHandler.ServeScalarUI)
+	e.GET("/docs/swagger.json", app.docsHandler.ServeOAS3JSON)
+	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+
+	// Public token verification keys for other services
+	e.GET("/.well-known/jwks.json", app.jwksHandler.GetJWKS)
+
+	api := e.Group("/api/v1")
+
+	// System
+	api.GET("/health", app.healthHandler.HealthCheck)
+
+	// Authentication
+	auth := api.Group("/auth")
+	auth.POST("/register", app.authHandler.Register)
+	auth.POST("/login", app.authHandler.Login)
+	auth.POST("/login/mfa", app.authHandler.CompleteMFALogin)
+	auth.POST("/login/mfa/enroll", app.authHandler.BeginMFALoginEnrollment)
+	auth.POST("/refresh", app.authHandler.RefreshToken)
+	auth.POST("/logout", app.authHandler.Logout, requireAuth)
+
+	// Multi-factor authentication
+	auth.GET("/mfa", app.mfaHandler.GetStatus, requireAuth)
+	auth.POST("/mfa/enroll", app.mfaHandler.BeginEnrollment, requireAuth)
+	auth.POST("/mfa/enroll/confirm", app.mfaHandler.ConfirmEnrollment, requireAuth)
+	auth.POST("/mfa/verify", app.authHandler.StepUp, requireAuth)
+	auth.POST("/mfa/disable", app.mfaHandler.Disable, requireAuth)
+	auth.POST("/mfa/recovery-codes", app.mfaHandler.RegenerateRecoveryCodes, requireAuth)
+
+	// Accounts
+	accounts := api.Group("/accounts", requireAuth)
+	accounts.POST("", app.accountHandler.CreateAccount, middleware.RequireAuthAccount(app.northWindService))
+	accounts.GET("", app.accountHandler.GetUserAccounts)
+	accounts.GET("/summary", app.accountSummaryHandler.GetAccountSummary)
+	accounts.GET("/metrics", app.accountSummaryHandler.GetAccountMetrics)
+	accounts.GET("/metrics/aggregate", app.accountSummaryHandler.GetAggregateMetrics)
+	accounts.GET("/:accountId", app.accountHandler.GetAccount)
+	accounts.PATCH("/:accountId/status", app.accountHandler.UpdateAccountStatus)
+	accounts.DELETE("/:accountId", app.accountHandler.CloseAccount)
+	accounts.POST("/:accountId/transactions", app.accountHandler.PerformTransaction)
+	accounts.GET("/:accountId/transactions", app.transactionHandler.ListTransactions)
+	accounts.GET("/:accountId/transactions/export", app.transactionHandler.ExportTransactions)
+	accounts.GET("/:accountId/transactions/:id", app.transactionHandler.GetTransaction)
+	accounts.POST("/:accountId/transfer", app.accountHandler.Transfer, requireStepUp)
+	accounts.POST("/:accountId/external-transfers", app.externalTransferHandler.InitiateTransfer, requireStepUp)
+	accounts.GET("/:accountId/statements", app.accountSummaryHandler.GetStatement)
+	accounts.GET("/:accountId/statements/:year/:period", app.accountSummaryHandler.DownloadStatement)
+	accounts.GET("/:accountId/limits", app.limitHandler.GetAccountLimits)
+	accounts.POST("/:accountId/transfer-ownership", app.customerHandler.TransferAccountOwnership, requirePermission(models.PermissionCustomersWrite))
+
+	// Transactions
+	transactions := api.Group("/transactions", requireAuth)
+	transactions.PATCH("/:id/category", app.transactionCategoryHandler.OverrideCategory)
+
+	// Customers: self-service
+	customers := api.Group("/customers", requireAuth)
+	customers.GET("/me", app.customerHandler.GetMyProfile)
+	customers.PUT("/me/email", app.customerHandler.UpdateMyEmail, requireStepUp)
+	customers.GET("/me/accounts", app.customerHandler.GetMyAccounts)
+	customers.GET("/me/transfers", app.accountHandler.GetTransferHistory)
+	customers.GET("/me/external-transfers", app.externalTransferHandler.ListTransfers)
+	customers.GET("/me/external-transfers/:id", app.externalTransferHandler.GetTransfer)
+	customers.GET("/me/transfer-schedules", app.transferScheduleHandler.ListSchedules)
+	customers.POST("/me/transfer-schedules", app.transferScheduleHandler.CreateSchedule, requireStepUp)
+	customers.GET("/me/transfer-schedules/:id", app.transferScheduleHandler.GetSchedule)
+	customers.POST("/me/transfer-schedules/:id/pause", app.transferScheduleHandler.PauseSchedule)
+	customers.POST("/me/transfer-schedules/:id/resume", app.transferScheduleHandler.ResumeSchedule, requireStepUp)
+	customers.POST("/me/transfer-schedules/:id/cancel", app.transferScheduleHandler.CancelSchedule)
+	customers.GET("/me/activity", app.customerHandler.GetMyActivity)
+	customers.PUT("/me/password", app.customerHandler.UpdateMyPassword, requireStepUp)
+	customers.GET("/me/sessions", app.sessionHandler.ListMySessions)
+	customers.DELETE("/me/sessions", app.sessionHandler.RevokeAllMySessions)
+	customers.DELETE("/me/sessions/:sessionId", app.sessionHandler.RevokeMySession)
+
+	// Customers: staff management
+	readCustomers := requirePermission(models.PermissionCustomersRead)
+	writeCustomers := requirePermission(models.PermissionCustomersWrite)
+	customers.GET("/search", app.customerHandler.SearchCustomers, readCustomers)
+	customers.POST("", app.customerHandler.CreateCustomer, writeCustomers)
+	customers.GET("/:id", app.customerHandler.GetCustomerProfile, readCustomers)
+	customers.PUT("/:id", app.customerHandler.UpdateCustomerProfile, writeCustomers)
+	customers.DELETE("/:id", app.customerHandler.DeleteCustomer, writeCustomers)
+	customers.GET("/:id/accounts", app.customerHandler.GetCustomerAccounts, readCustomers)
+	customers.POST("/:id/accounts", app.customerHandler.CreateAccountForCustomer, writeCustomers)
+	customers.GET("/:id/activity", app.customerHandler.GetCustomerActivity, requirePermission(models.PermissionAuditRead))
+	customers.PUT("/:id/password/reset", app.customerHandler.ResetCustomerPassword, requirePermission(models.PermissionCustomersResetPassword), requireStepUp)
+	customers.GET("/:id/sessions", app.sessionHandler.ListCustomerSessions, readCustomers)
+	customers.DELETE("/:id/sessions", app.sessionHandler.RevokeAllCustomerSessions, requirePermission(models.PermissionSessionsRevoke))
+	customers.DELETE("/:id/sessions/:sessionId", app.sessionHandler.RevokeCustomerSession, requirePermission(models.PermissionSessionsRevoke))
+
+	// Webhooks
+	webhooks := api.Group("/webhooks", requireAuth)
+	webhooks.POST("", app.webhookHandler.CreateSubscription)
+	webhooks.GET("", app.webhookHandler.ListSubscriptions)
+	webhooks.GET("/:id", app.webhookHandler.GetSubscription)
+	webhooks.PATCH("/:id", app.webhookHandler.UpdateSubscription)
+	webhooks.DELETE("/:id", app.webhookHandler.DeleteSubscription)
+	webhooks.GET("/:id/deliveries", app.webhookHandler.ListDeliveries)
+	webhooks.POST("/:id/deliveries/:deliveryId/redeliver", app.webhookHandler.Redeliver)
+
+	// Admin: each route requires the permission for its area
+	admin := api.Group("/admin", requireAuth)
+	readUsers := requirePermission(models.PermissionCustomersRead)
+	writeUsers := requirePermission(models.PermissionCustomersWrite)
+	admin.GET("/users", app.adminHandler.ListUsers, readUsers)
+	admin.GET("/users/:userId", app.adminHandler.GetUserByID, readUsers)
+	admin.DELETE("/users/:userId", app.adminHandler.DeleteUser, writeUsers)
+	admin.POST("/users/:userId/unlock", app.adminHandler.UnlockUser, writeUsers)
+	admin.PUT("/users/:userId/role", app.roleHandler.AssignRole, requirePermission(models.PermissionRolesManage))
+	admin.PUT("/users/:userId/mfa", app.mfaHandler.SetRequirement, requirePermission(models.PermissionMFAManage))
+	admin.DELETE("/users/:userId/mfa", app.mfaHandler.Reset, requirePermission(models.PermissionMFAManage), requireStepUp)
+	admin.GET("/users/:userId/accounts", app.accountHandler.GetUserAccountsAdmin, readUsers)
+	admin.GET("/roles", app.roleHandler.ListRoles, requirePermission(models.PermissionRolesManage))
+
+	manageLimits := requirePermission(models.PermissionLimitsManage)
+	admin.GET("/users/:userId/limits", app.limitHandler.GetUserLimits, manageLimits)
+	admin.PUT("/users/:userId/limits", app.limitHandler.SetUserLimits, manageLimits)
+	admin.DELETE("/users/:userId/limits", app.limitHandler.DeleteUserLimits, manageLimits)
+	admin.POST("/accounts/:accountId/limit-overrides", app.limitHandler.GrantOverride, manageLimits)
+	admin.POST("/limit-overrides/:id/revoke", app.limitHandler.RevokeOverride, manageLimits)
+	admin.GET("/limits", app.limitHandler.ListAccountTypeLimits, manageLimits)
+	admin.PUT("/limits/:accountType", app.limitHandler.SetAccountTypeLimits, manageLimits)
+
+	admin.GET("/accounts", app.accountHandler.GetAllAccounts, readUsers)
+	admin.GET("/accounts/:accountId", app.accountHandler.GetAccountByIDAdmin, readUsers)
+	admin.POST("/accounts/:accountId/transactions", app.accountHandler.PostTellerTransaction, requirePermission(models.PermissionTransactionsPost))
+
+	manageHolds := requirePermission(models.PermissionHoldsManage)
+	admin.GET("/accounts/:accountId/holds", app.holdHandler.ListHolds, manageHolds)
+	admin.POST("/accounts/:accountId/holds", app.holdHandler.PlaceHold, manageHolds)
+	admin.POST("/holds/:id/capture", app.holdHandler.CaptureHold, manageHolds)
+	admin.POST("/holds/:id/release", app.holdHandler.ReleaseHold, manageHolds)
+
+	reviewFraud := requirePermission(models.PermissionFraudReview)
+	admin.GET("/fraud-reviews", app.fraudReviewHandler.ListReviews, reviewFraud)
+	admin.GET("/fraud-reviews/:id", app.fraudReviewHandler.GetReview, reviewFraud)
+	admin.POST("/fraud-reviews/:id/approve", app.fraudReviewHandler.ApproveReview, reviewFraud)
+	admin.POST("/fraud-reviews/:id/reject", app.fraudReviewHandler.RejectReview, reviewFraud)
+
+	manageTransactions := requirePermission(models.PermissionTransactionsManage)
+	admin.POST("/transactions/import", app.importHandler.ImportTransactions, manageTransactions)
+	admin.POST("/transactions/:id/reverse", app.reversalHandler.ReverseTransaction, manageTransactions)
+	admin.POST("/external-transfers/:id/return", app.externalTransferHandler.ReturnTransfer, manageTransactions)
+
+	manageQueue := requirePermission(models.PermissionQueueManage)
+	admin.GET("/queue/metrics", app.queueHandler.GetMetrics, manageQueue)
+	admin.GET("/queue/failed", app.queueHandler.ListFailed, manageQueue)
+	admin.GET("/queue/failed/:id", app.queueHandler.GetItem, manageQueue)
+	admin.POST("/queue/failed/replay", app.queueHandler.ReplayFailed, manageQueue)
+	admin.POST("/queue/failed/purge", app.queueHandler.PurgeFailed, manageQueue)
+
+	manageCategories := requirePermission(models.PermissionCategoriesManage)
+	admin.GET("/categories", app.categoryHandler.ListCategories, manageCategories)
+	admin.POST("/categories", app.categoryHandler.CreateCategory, manageCategories)
+	admin.PUT("/categories/reorder", app.categoryHandler.ReorderCategories, manageCategories)
+	admin.GET("/categories/:code", app.categoryHandler.GetCategory, manageCategories)
+	admin.PATCH("/categories/:code", app.categoryHandler.UpdateCategory, manageCategories)
+	admin.DELETE("/categories/:code", app.categoryHandler.DeactivateCategory, manageCategories)
+	admin.GET("/merchant-mappings", app.categoryHandler.ListMerchantMappings, manageCategories)
+	admin.POST("/merchant-mappings", app.categoryHandler.CreateMerchantMapping, manageCategories)
+	admin.POST("/merchant-mappings/test", app.categoryHandler.TestCategorization, manageCategories)
+	admin.GET("/merchant-mappings/:id", app.categoryHandler.GetMerchantMapping, manageCategories)
+	admin.PATCH("/merchant-mappings/:id", app.categoryHandler.UpdateMerchantMapping, manageCategories)
+	admin.DELETE("/merchant-mappings/:id", app.categoryHandler.DeactivateMerchantMapping, manageCategories)
+	admin.GET("/recategorization-jobs", app.recategorizationHandler.ListJobs, manageCategories)
+	admin.POST("/recategorization-jobs", app.recategorizationHandler.StartJob, manageCategories)
+	admin.GET("/recategorization-jobs/:id", app.recategorizationHandler.GetJob, manageCategories)
+	admin.POST("/recategorization-jobs/:id/cancel", app.recategorizationHandler.CancelJob, manageCategories)
+	admin.POST("/recategorization-jobs/:id/resume", app.recategorizationHandler.ResumeJob, manageCategories)
+
+	admin.POST("/interest/backfill", app.interestHandler.Backfill, requirePermission(models.PermissionInterestManage))
+
+	reviewApprovals := requirePermission(models.PermissionApprovalsReview)
+	admin.GET("/approvals", app.approvalHandler.ListApprovals, reviewApprovals)
+	admin.GET("/approvals/:id", app.approvalHandler.GetApproval, reviewApprovals)
+	admin.POST("/approvals/:id/approve", app.approvalHandler.Approve, reviewApprovals, requireStepUp)
+	admin.POST("/approvals/:id/reject", app.approvalHandler.Reject, reviewApprovals)
+
+	// Development-only endpoints are never exposed in production
+	if !app.config.IsProduction() {
+		dev := api.Group("/dev", requireAuth)
+		dev.POST("/accounts/:accountId/generate-test-data", app.devHandler.GenerateTestData)
+		dev.DELETE("/accounts/:accountId/test-data", app.devHandler.ClearTestData)
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"array-assessment/internal/config"
+	"array-assessment/internal/database"
+	"array-assessment/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(ServerSuite))
+}
+
+type ServerSuite struct {
+	suite.Suite
+	app *application
+}
+
+// SetupSuite wires the application once; Prometheus collectors can only be
+// registered a single time per process
+func (s *ServerSuite) SetupSuite() {
+	privateKey, publicKey, err := config.GenerateRSAKeyPair()
+	s.Require().NoError(err)
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			Environment:      "testing",
+			CORSAllowOrigins: []string{"http://localhost:3000"},
+		},
+		JWT: config.JWTConfig{
+			PrivateKey:           privateKey,
+			PublicKey:            publicKey,
+			Issuer:               "test-issuer",
+			AccessTokenDuration:  time.Hour,
+			RefreshTokenDuration: 24 * time.Hour,
+		},
+		Security: config.SecurityConfig{
+			BCryptCost:         4,
+			RateLimitPerSecond: 100,
+		},
+		Queue: config.QueueConfig{MaxWorkers: 1},
+	}
+
+	db := database.SetupTestDB(s.T())
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s.app = newApplication(cfg, db.DB, logger)
+}
+
+func (s *ServerSuite) routeSet(e *echo.Echo) map[string]bool {
+	routes := make(map[string]bool)
+	for _, r := range e.Routes() {
+		routes[r.Method+" "+r.Path] = true
+	}
+	return routes
+}
+
+func (s *ServerSuite) TestRegisterRoutes_MatchesSwaggerSpec() {
+	data, err := os.ReadFile("../../docs/swagger.json")
+	s.Require().NoError(err)
+
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	s.Require().NoError(json.Unmarshal(data, &spec))
+	s.Require().NotEmpty(spec.Paths)
+
+	routes := s.routeSet(newEcho(s.app))
+	pathParam := regexp.MustCompile(`\{([^}]+)\}`)
+
+	for path, methods := range spec.Paths {
+		echoPath := pathParam.ReplaceAllString(path, ":$1")
+		if echoPath != "/docs" {
+			echoPath = "/api/v1" + echoPath
+		}
+		for method := range methods {
+			key := strings.ToUpper(method) + " " + echoPath
+			s.True(routes[key], "route %s is documented but not registered", key)
+		}
+	}
+}
+
+func (s *ServerSuite) TestRegisterRoutes_DevRoutesHiddenInProduction() {
+	original := s.app.config.Server.Environment
+	defer func() { s.app.config.Server.Environment = original }()
+
+	devRoute := http.MethodPost + " /api/v1/dev/accounts/:accountId/generate-test-data"
+
+	s.True(s.routeSet(newEcho(s.app))[devRoute])
+
+	s.app.config.Server.Environment = "production"
+	s.False(s.routeSet(newEcho(s.app))[devRoute])
+}
+
+func (s *ServerSuite) TestHealthCheck() {
+	e := newEcho(s.app)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.NotEmpty(rec.Header().Get(middleware.TraceIDHeader))
+}
+
+func (s *ServerSuite) TestJWKS_IsPublic() {
+	e := newEcho(s.app)
+
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"kid"`)
+}
+
+func (s *ServerSuite) TestProtectedRoute_RequiresAuth() {
+	e := newEcho(s.app)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusUnauthorized, rec.Code)
+}
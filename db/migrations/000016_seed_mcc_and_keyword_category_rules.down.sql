@@ -0,0 +1,8 @@
+DELETE FROM merchant_mappings WHERE match_type IN ('MCC', 'KEYWORD');
+
+ALTER TABLE merchant_mappings
+DROP CONSTRAINT IF EXISTS chk_merchant_mappings_match_type;
+
+ALTER TABLE merchant_mappings
+ADD CONSTRAINT chk_merchant_mappings_match_type
+CHECK (match_type IN ('EXACT', 'PARTIAL', 'FUZZY', 'REGEX'));
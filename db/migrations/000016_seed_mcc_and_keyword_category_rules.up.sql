@@ -0,0 +1,328 @@
+ALTER TABLE merchant_mappings
+DROP CONSTRAINT IF EXISTS chk_merchant_mappings_match_type;
+
+ALTER TABLE merchant_mappings
+ADD CONSTRAINT chk_merchant_mappings_match_type
+CHECK (match_type IN ('EXACT', 'PARTIAL', 'FUZZY', 'REGEX', 'MCC', 'KEYWORD'));
+
+-- Seed MCC rules for Groceries
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('5411', 'MCC 5411', 'GROCERIES', '5411', 'MCC', 0.95),
+('5422', 'MCC 5422', 'GROCERIES', '5422', 'MCC', 0.95),
+('5441', 'MCC 5441', 'GROCERIES', '5441', 'MCC', 0.95),
+('5451', 'MCC 5451', 'GROCERIES', '5451', 'MCC', 0.95),
+('5462', 'MCC 5462', 'GROCERIES', '5462', 'MCC', 0.95),
+('5499', 'MCC 5499', 'GROCERIES', '5499', 'MCC', 0.95),
+('5541', 'MCC 5541', 'GROCERIES', '5541', 'MCC', 0.95);
+
+-- Seed MCC rules for Dining & Restaurants
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('5811', 'MCC 5811', 'DINING', '5811', 'MCC', 0.95),
+('5812', 'MCC 5812', 'DINING', '5812', 'MCC', 0.95),
+('5813', 'MCC 5813', 'DINING', '5813', 'MCC', 0.95),
+('5814', 'MCC 5814', 'DINING', '5814', 'MCC', 0.95);
+
+-- Seed MCC rules for Transportation
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('4111', 'MCC 4111', 'TRANSPORTATION', '4111', 'MCC', 0.95),
+('4112', 'MCC 4112', 'TRANSPORTATION', '4112', 'MCC', 0.95),
+('4119', 'MCC 4119', 'TRANSPORTATION', '4119', 'MCC', 0.95),
+('4121', 'MCC 4121', 'TRANSPORTATION', '4121', 'MCC', 0.95),
+('4131', 'MCC 4131', 'TRANSPORTATION', '4131', 'MCC', 0.95),
+('4214', 'MCC 4214', 'TRANSPORTATION', '4214', 'MCC', 0.95),
+('4215', 'MCC 4215', 'TRANSPORTATION', '4215', 'MCC', 0.95),
+('4225', 'MCC 4225', 'TRANSPORTATION', '4225', 'MCC', 0.95),
+('4411', 'MCC 4411', 'TRANSPORTATION', '4411', 'MCC', 0.95),
+('4468', 'MCC 4468', 'TRANSPORTATION', '4468', 'MCC', 0.95),
+('5542', 'MCC 5542', 'TRANSPORTATION', '5542', 'MCC', 0.95),
+('5552', 'MCC 5552', 'TRANSPORTATION', '5552', 'MCC', 0.95),
+('5571', 'MCC 5571', 'TRANSPORTATION', '5571', 'MCC', 0.95),
+('5592', 'MCC 5592', 'TRANSPORTATION', '5592', 'MCC', 0.95),
+('5598', 'MCC 5598', 'TRANSPORTATION', '5598', 'MCC', 0.95),
+('7511', 'MCC 7511', 'TRANSPORTATION', '7511', 'MCC', 0.95),
+('7512', 'MCC 7512', 'TRANSPORTATION', '7512', 'MCC', 0.95),
+('7513', 'MCC 7513', 'TRANSPORTATION', '7513', 'MCC', 0.95),
+('7519', 'MCC 7519', 'TRANSPORTATION', '7519', 'MCC', 0.95),
+('7523', 'MCC 7523', 'TRANSPORTATION', '7523', 'MCC', 0.95),
+('7531', 'MCC 7531', 'TRANSPORTATION', '7531', 'MCC', 0.95),
+('7534', 'MCC 7534', 'TRANSPORTATION', '7534', 'MCC', 0.95),
+('7535', 'MCC 7535', 'TRANSPORTATION', '7535', 'MCC', 0.95),
+('7538', 'MCC 7538', 'TRANSPORTATION', '7538', 'MCC', 0.95),
+('7542', 'MCC 7542', 'TRANSPORTATION', '7542', 'MCC', 0.95);
+
+-- Seed MCC rules for Entertainment
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('5735', 'MCC 5735', 'ENTERTAINMENT', '5735', 'MCC', 0.95),
+('5815', 'MCC 5815', 'ENTERTAINMENT', '5815', 'MCC', 0.95),
+('5816', 'MCC 5816', 'ENTERTAINMENT', '5816', 'MCC', 0.95),
+('5817', 'MCC 5817', 'ENTERTAINMENT', '5817', 'MCC', 0.95),
+('5818', 'MCC 5818', 'ENTERTAINMENT', '5818', 'MCC', 0.95),
+('7832', 'MCC 7832', 'ENTERTAINMENT', '7832', 'MCC', 0.95),
+('7841', 'MCC 7841', 'ENTERTAINMENT', '7841', 'MCC', 0.95),
+('7911', 'MCC 7911', 'ENTERTAINMENT', '7911', 'MCC', 0.95),
+('7922', 'MCC 7922', 'ENTERTAINMENT', '7922', 'MCC', 0.95),
+('7929', 'MCC 7929', 'ENTERTAINMENT', '7929', 'MCC', 0.95),
+('7932', 'MCC 7932', 'ENTERTAINMENT', '7932', 'MCC', 0.95),
+('7933', 'MCC 7933', 'ENTERTAINMENT', '7933', 'MCC', 0.95),
+('7941', 'MCC 7941', 'ENTERTAINMENT', '7941', 'MCC', 0.95),
+('7991', 'MCC 7991', 'ENTERTAINMENT', '7991', 'MCC', 0.95),
+('7992', 'MCC 7992', 'ENTERTAINMENT', '7992', 'MCC', 0.95),
+('7993', 'MCC 7993', 'ENTERTAINMENT', '7993', 'MCC', 0.95),
+('7994', 'MCC 7994', 'ENTERTAINMENT', '7994', 'MCC', 0.95),
+('7995', 'MCC 7995', 'ENTERTAINMENT', '7995', 'MCC', 0.95),
+('7996', 'MCC 7996', 'ENTERTAINMENT', '7996', 'MCC', 0.95),
+('7997', 'MCC 7997', 'ENTERTAINMENT', '7997', 'MCC', 0.95),
+('7998', 'MCC 7998', 'ENTERTAINMENT', '7998', 'MCC', 0.95),
+('7999', 'MCC 7999', 'ENTERTAINMENT', '7999', 'MCC', 0.95);
+
+-- Seed MCC rules for Shopping
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('5200', 'MCC 5200', 'SHOPPING', '5200', 'MCC', 0.95),
+('5211', 'MCC 5211', 'SHOPPING', '5211', 'MCC', 0.95),
+('5231', 'MCC 5231', 'SHOPPING', '5231', 'MCC', 0.95),
+('5251', 'MCC 5251', 'SHOPPING', '5251', 'MCC', 0.95),
+('5261', 'MCC 5261', 'SHOPPING', '5261', 'MCC', 0.95),
+('5271', 'MCC 5271', 'SHOPPING', '5271', 'MCC', 0.95),
+('5311', 'MCC 5311', 'SHOPPING', '5311', 'MCC', 0.95),
+('5331', 'MCC 5331', 'SHOPPING', '5331', 'MCC', 0.95),
+('5399', 'MCC 5399', 'SHOPPING', '5399', 'MCC', 0.95),
+('5611', 'MCC 5611', 'SHOPPING', '5611', 'MCC', 0.95),
+('5621', 'MCC 5621', 'SHOPPING', '5621', 'MCC', 0.95),
+('5631', 'MCC 5631', 'SHOPPING', '5631', 'MCC', 0.95),
+('5641', 'MCC 5641', 'SHOPPING', '5641', 'MCC', 0.95),
+('5651', 'MCC 5651', 'SHOPPING', '5651', 'MCC', 0.95),
+('5661', 'MCC 5661', 'SHOPPING', '5661', 'MCC', 0.95),
+('5681', 'MCC 5681', 'SHOPPING', '5681', 'MCC', 0.95),
+('5691', 'MCC 5691', 'SHOPPING', '5691', 'MCC', 0.95),
+('5697', 'MCC 5697', 'SHOPPING', '5697', 'MCC', 0.95),
+('5698', 'MCC 5698', 'SHOPPING', '5698', 'MCC', 0.95),
+('5699', 'MCC 5699', 'SHOPPING', '5699', 'MCC', 0.95),
+('5712', 'MCC 5712', 'SHOPPING', '5712', 'MCC', 0.95),
+('5713', 'MCC 5713', 'SHOPPING', '5713', 'MCC', 0.95),
+('5714', 'MCC 5714', 'SHOPPING', '5714', 'MCC', 0.95),
+('5718', 'MCC 5718', 'SHOPPING', '5718', 'MCC', 0.95),
+('5719', 'MCC 5719', 'SHOPPING', '5719', 'MCC', 0.95),
+('5722', 'MCC 5722', 'SHOPPING', '5722', 'MCC', 0.95),
+('5732', 'MCC 5732', 'SHOPPING', '5732', 'MCC', 0.95),
+('5733', 'MCC 5733', 'SHOPPING', '5733', 'MCC', 0.95),
+('5734', 'MCC 5734', 'SHOPPING', '5734', 'MCC', 0.95),
+('5945', 'MCC 5945', 'SHOPPING', '5945', 'MCC', 0.95),
+('5946', 'MCC 5946', 'SHOPPING', '5946', 'MCC', 0.95),
+('5947', 'MCC 5947', 'SHOPPING', '5947', 'MCC', 0.95),
+('5948', 'MCC 5948', 'SHOPPING', '5948', 'MCC', 0.95),
+('5949', 'MCC 5949', 'SHOPPING', '5949', 'MCC', 0.95),
+('5950', 'MCC 5950', 'SHOPPING', '5950', 'MCC', 0.95),
+('5960', 'MCC 5960', 'SHOPPING', '5960', 'MCC', 0.95),
+('5961', 'MCC 5961', 'SHOPPING', '5961', 'MCC', 0.95),
+('5962', 'MCC 5962', 'SHOPPING', '5962', 'MCC', 0.95),
+('5963', 'MCC 5963', 'SHOPPING', '5963', 'MCC', 0.95),
+('5964', 'MCC 5964', 'SHOPPING', '5964', 'MCC', 0.95),
+('5965', 'MCC 5965', 'SHOPPING', '5965', 'MCC', 0.95),
+('5966', 'MCC 5966', 'SHOPPING', '5966', 'MCC', 0.95),
+('5967', 'MCC 5967', 'SHOPPING', '5967', 'MCC', 0.95),
+('5968', 'MCC 5968', 'SHOPPING', '5968', 'MCC', 0.95),
+('5969', 'MCC 5969', 'SHOPPING', '5969', 'MCC', 0.95),
+('5970', 'MCC 5970', 'SHOPPING', '5970', 'MCC', 0.95),
+('5971', 'MCC 5971', 'SHOPPING', '5971', 'MCC', 0.95),
+('5972', 'MCC 5972', 'SHOPPING', '5972', 'MCC', 0.95),
+('5973', 'MCC 5973', 'SHOPPING', '5973', 'MCC', 0.95),
+('5975', 'MCC 5975', 'SHOPPING', '5975', 'MCC', 0.95),
+('5976', 'MCC 5976', 'SHOPPING', '5976', 'MCC', 0.95),
+('5977', 'MCC 5977', 'SHOPPING', '5977', 'MCC', 0.95),
+('5978', 'MCC 5978', 'SHOPPING', '5978', 'MCC', 0.95),
+('5983', 'MCC 5983', 'SHOPPING', '5983', 'MCC', 0.95),
+('5992', 'MCC 5992', 'SHOPPING', '5992', 'MCC', 0.95),
+('5993', 'MCC 5993', 'SHOPPING', '5993', 'MCC', 0.95),
+('5994', 'MCC 5994', 'SHOPPING', '5994', 'MCC', 0.95),
+('5995', 'MCC 5995', 'SHOPPING', '5995', 'MCC', 0.95),
+('5999', 'MCC 5999', 'SHOPPING', '5999', 'MCC', 0.95);
+
+-- Seed MCC rules for Bills & Utilities
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('4812', 'MCC 4812', 'BILLS_UTILITIES', '4812', 'MCC', 0.95),
+('4813', 'MCC 4813', 'BILLS_UTILITIES', '4813', 'MCC', 0.95),
+('4814', 'MCC 4814', 'BILLS_UTILITIES', '4814', 'MCC', 0.95),
+('4815', 'MCC 4815', 'BILLS_UTILITIES', '4815', 'MCC', 0.95),
+('4816', 'MCC 4816', 'BILLS_UTILITIES', '4816', 'MCC', 0.95),
+('4821', 'MCC 4821', 'BILLS_UTILITIES', '4821', 'MCC', 0.95),
+('4829', 'MCC 4829', 'BILLS_UTILITIES', '4829', 'MCC', 0.95),
+('4899', 'MCC 4899', 'BILLS_UTILITIES', '4899', 'MCC', 0.95),
+('4900', 'MCC 4900', 'BILLS_UTILITIES', '4900', 'MCC', 0.95);
+
+-- Seed MCC rules for Healthcare
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('5912', 'MCC 5912', 'HEALTHCARE', '5912', 'MCC', 0.95),
+('8011', 'MCC 8011', 'HEALTHCARE', '8011', 'MCC', 0.95),
+('8021', 'MCC 8021', 'HEALTHCARE', '8021', 'MCC', 0.95),
+('8031', 'MCC 8031', 'HEALTHCARE', '8031', 'MCC', 0.95),
+('8041', 'MCC 8041', 'HEALTHCARE', '8041', 'MCC', 0.95),
+('8042', 'MCC 8042', 'HEALTHCARE', '8042', 'MCC', 0.95),
+('8043', 'MCC 8043', 'HEALTHCARE', '8043', 'MCC', 0.95),
+('8044', 'MCC 8044', 'HEALTHCARE', '8044', 'MCC', 0.95),
+('8049', 'MCC 8049', 'HEALTHCARE', '8049', 'MCC', 0.95),
+('8050', 'MCC 8050', 'HEALTHCARE', '8050', 'MCC', 0.95),
+('8062', 'MCC 8062', 'HEALTHCARE', '8062', 'MCC', 0.95),
+('8071', 'MCC 8071', 'HEALTHCARE', '8071', 'MCC', 0.95);
+
+-- Seed MCC rules for Education
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('8211', 'MCC 8211', 'EDUCATION', '8211', 'MCC', 0.95),
+('8220', 'MCC 8220', 'EDUCATION', '8220', 'MCC', 0.95),
+('8241', 'MCC 8241', 'EDUCATION', '8241', 'MCC', 0.95),
+('8244', 'MCC 8244', 'EDUCATION', '8244', 'MCC', 0.95),
+('8249', 'MCC 8249', 'EDUCATION', '8249', 'MCC', 0.95),
+('8299', 'MCC 8299', 'EDUCATION', '8299', 'MCC', 0.95);
+
+-- Seed MCC rules for Travel
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('3000', 'MCC 3000', 'TRAVEL', '3000', 'MCC', 0.95),
+('3001', 'MCC 3001', 'TRAVEL', '3001', 'MCC', 0.95),
+('3002', 'MCC 3002', 'TRAVEL', '3002', 'MCC', 0.95),
+('3003', 'MCC 3003', 'TRAVEL', '3003', 'MCC', 0.95),
+('3004', 'MCC 3004', 'TRAVEL', '3004', 'MCC', 0.95),
+('3005', 'MCC 3005', 'TRAVEL', '3005', 'MCC', 0.95),
+('3006', 'MCC 3006', 'TRAVEL', '3006', 'MCC', 0.95),
+('3007', 'MCC 3007', 'TRAVEL', '3007', 'MCC', 0.95),
+('3008', 'MCC 3008', 'TRAVEL', '3008', 'MCC', 0.95),
+('3009', 'MCC 3009', 'TRAVEL', '3009', 'MCC', 0.95),
+('3010', 'MCC 3010', 'TRAVEL', '3010', 'MCC', 0.95),
+('3011', 'MCC 3011', 'TRAVEL', '3011', 'MCC', 0.95),
+('3012', 'MCC 3012', 'TRAVEL', '3012', 'MCC', 0.95),
+('3013', 'MCC 3013', 'TRAVEL', '3013', 'MCC', 0.95),
+('3014', 'MCC 3014', 'TRAVEL', '3014', 'MCC', 0.95),
+('3015', 'MCC 3015', 'TRAVEL', '3015', 'MCC', 0.95),
+('3016', 'MCC 3016', 'TRAVEL', '3016', 'MCC', 0.95),
+('3017', 'MCC 3017', 'TRAVEL', '3017', 'MCC', 0.95),
+('3018', 'MCC 3018', 'TRAVEL', '3018', 'MCC', 0.95),
+('3019', 'MCC 3019', 'TRAVEL', '3019', 'MCC', 0.95),
+('3020', 'MCC 3020', 'TRAVEL', '3020', 'MCC', 0.95),
+('3021', 'MCC 3021', 'TRAVEL', '3021', 'MCC', 0.95),
+('3022', 'MCC 3022', 'TRAVEL', '3022', 'MCC', 0.95),
+('3023', 'MCC 3023', 'TRAVEL', '3023', 'MCC', 0.95),
+('3024', 'MCC 3024', 'TRAVEL', '3024', 'MCC', 0.95),
+('3025', 'MCC 3025', 'TRAVEL', '3025', 'MCC', 0.95),
+('3026', 'MCC 3026', 'TRAVEL', '3026', 'MCC', 0.95),
+('3027', 'MCC 3027', 'TRAVEL', '3027', 'MCC', 0.95),
+('3028', 'MCC 3028', 'TRAVEL', '3028', 'MCC', 0.95),
+('3029', 'MCC 3029', 'TRAVEL', '3029', 'MCC', 0.95),
+('3030', 'MCC 3030', 'TRAVEL', '3030', 'MCC', 0.95),
+('3031', 'MCC 3031', 'TRAVEL', '3031', 'MCC', 0.95),
+('3032', 'MCC 3032', 'TRAVEL', '3032', 'MCC', 0.95),
+('3033', 'MCC 3033', 'TRAVEL', '3033', 'MCC', 0.95),
+('3034', 'MCC 3034', 'TRAVEL', '3034', 'MCC', 0.95),
+('3035', 'MCC 3035', 'TRAVEL', '3035', 'MCC', 0.95),
+('3036', 'MCC 3036', 'TRAVEL', '3036', 'MCC', 0.95),
+('3037', 'MCC 3037', 'TRAVEL', '3037', 'MCC', 0.95),
+('3038', 'MCC 3038', 'TRAVEL', '3038', 'MCC', 0.95),
+('3039', 'MCC 3039', 'TRAVEL', '3039', 'MCC', 0.95),
+('3040', 'MCC 3040', 'TRAVEL', '3040', 'MCC', 0.95),
+('3041', 'MCC 3041', 'TRAVEL', '3041', 'MCC', 0.95),
+('3042', 'MCC 3042', 'TRAVEL', '3042', 'MCC', 0.95),
+('3043', 'MCC 3043', 'TRAVEL', '3043', 'MCC', 0.95),
+('3044', 'MCC 3044', 'TRAVEL', '3044', 'MCC', 0.95),
+('3045', 'MCC 3045', 'TRAVEL', '3045', 'MCC', 0.95),
+('3046', 'MCC 3046', 'TRAVEL', '3046', 'MCC', 0.95),
+('3047', 'MCC 3047', 'TRAVEL', '3047', 'MCC', 0.95),
+('3048', 'MCC 3048', 'TRAVEL', '3048', 'MCC', 0.95),
+('3049', 'MCC 3049', 'TRAVEL', '3049', 'MCC', 0.95),
+('3050', 'MCC 3050', 'TRAVEL', '3050', 'MCC', 0.95),
+('3051', 'MCC 3051', 'TRAVEL', '3051', 'MCC', 0.95),
+('3052', 'MCC 3052', 'TRAVEL', '3052', 'MCC', 0.95),
+('3053', 'MCC 3053', 'TRAVEL', '3053', 'MCC', 0.95),
+('3054', 'MCC 3054', 'TRAVEL', '3054', 'MCC', 0.95),
+('3055', 'MCC 3055', 'TRAVEL', '3055', 'MCC', 0.95),
+('3056', 'MCC 3056', 'TRAVEL', '3056', 'MCC', 0.95),
+('3057', 'MCC 3057', 'TRAVEL', '3057', 'MCC', 0.95),
+('3058', 'MCC 3058', 'TRAVEL', '3058', 'MCC', 0.95),
+('3059', 'MCC 3059', 'TRAVEL', '3059', 'MCC', 0.95),
+('3060', 'MCC 3060', 'TRAVEL', '3060', 'MCC', 0.95),
+('3061', 'MCC 3061', 'TRAVEL', '3061', 'MCC', 0.95),
+('3062', 'MCC 3062', 'TRAVEL', '3062', 'MCC', 0.95),
+('3063', 'MCC 3063', 'TRAVEL', '3063', 'MCC', 0.95),
+('3064', 'MCC 3064', 'TRAVEL', '3064', 'MCC', 0.95),
+('3065', 'MCC 3065', 'TRAVEL', '3065', 'MCC', 0.95),
+('3066', 'MCC 3066', 'TRAVEL', '3066', 'MCC', 0.95),
+('3067', 'MCC 3067', 'TRAVEL', '3067', 'MCC', 0.95),
+('3068', 'MCC 3068', 'TRAVEL', '3068', 'MCC', 0.95),
+('3069', 'MCC 3069', 'TRAVEL', '3069', 'MCC', 0.95),
+('3070', 'MCC 3070', 'TRAVEL', '3070', 'MCC', 0.95),
+('3071', 'MCC 3071', 'TRAVEL', '3071', 'MCC', 0.95),
+('3072', 'MCC 3072', 'TRAVEL', '3072', 'MCC', 0.95),
+('3073', 'MCC 3073', 'TRAVEL', '3073', 'MCC', 0.95),
+('3074', 'MCC 3074', 'TRAVEL', '3074', 'MCC', 0.95),
+('3075', 'MCC 3075', 'TRAVEL', '3075', 'MCC', 0.95),
+('3076', 'MCC 3076', 'TRAVEL', '3076', 'MCC', 0.95),
+('3077', 'MCC 3077', 'TRAVEL', '3077', 'MCC', 0.95),
+('3078', 'MCC 3078', 'TRAVEL', '3078', 'MCC', 0.95),
+('3079', 'MCC 3079', 'TRAVEL', '3079', 'MCC', 0.95),
+('3080', 'MCC 3080', 'TRAVEL', '3080', 'MCC', 0.95),
+('3081', 'MCC 3081', 'TRAVEL', '3081', 'MCC', 0.95),
+('3082', 'MCC 3082', 'TRAVEL', '3082', 'MCC', 0.95),
+('3083', 'MCC 3083', 'TRAVEL', '3083', 'MCC', 0.95),
+('3084', 'MCC 3084', 'TRAVEL', '3084', 'MCC', 0.95),
+('3085', 'MCC 3085', 'TRAVEL', '3085', 'MCC', 0.95),
+('3086', 'MCC 3086', 'TRAVEL', '3086', 'MCC', 0.95),
+('3087', 'MCC 3087', 'TRAVEL', '3087', 'MCC', 0.95),
+('3088', 'MCC 3088', 'TRAVEL', '3088', 'MCC', 0.95),
+('3089', 'MCC 3089', 'TRAVEL', '3089', 'MCC', 0.95),
+('3090', 'MCC 3090', 'TRAVEL', '3090', 'MCC', 0.95),
+('3091', 'MCC 3091', 'TRAVEL', '3091', 'MCC', 0.95),
+('3092', 'MCC 3092', 'TRAVEL', '3092', 'MCC', 0.95),
+('3093', 'MCC 3093', 'TRAVEL', '3093', 'MCC', 0.95),
+('3094', 'MCC 3094', 'TRAVEL', '3094', 'MCC', 0.95),
+('3095', 'MCC 3095', 'TRAVEL', '3095', 'MCC', 0.95),
+('3096', 'MCC 3096', 'TRAVEL', '3096', 'MCC', 0.95),
+('3097', 'MCC 3097', 'TRAVEL', '3097', 'MCC', 0.95),
+('3098', 'MCC 3098', 'TRAVEL', '3098', 'MCC', 0.95),
+('3099', 'MCC 3099', 'TRAVEL', '3099', 'MCC', 0.95),
+('3100', 'MCC 3100', 'TRAVEL', '3100', 'MCC', 0.95),
+('3101', 'MCC 3101', 'TRAVEL', '3101', 'MCC', 0.95),
+('3102', 'MCC 3102', 'TRAVEL', '3102', 'MCC', 0.95),
+('4511', 'MCC 4511', 'TRAVEL', '4511', 'MCC', 0.95),
+('7011', 'MCC 7011', 'TRAVEL', '7011', 'MCC', 0.95),
+('7012', 'MCC 7012', 'TRAVEL', '7012', 'MCC', 0.95),
+('7032', 'MCC 7032', 'TRAVEL', '7032', 'MCC', 0.95),
+('7033', 'MCC 7033', 'TRAVEL', '7033', 'MCC', 0.95);
+
+-- Seed MCC rules for ATM & Cash
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, mcc_code, match_type, confidence_score) VALUES
+('6010', 'MCC 6010', 'ATM_CASH', '6010', 'MCC', 0.95),
+('6011', 'MCC 6011', 'ATM_CASH', '6011', 'MCC', 0.95),
+('6012', 'MCC 6012', 'ATM_CASH', '6012', 'MCC', 0.95),
+('6050', 'MCC 6050', 'ATM_CASH', '6050', 'MCC', 0.95),
+('6051', 'MCC 6051', 'ATM_CASH', '6051', 'MCC', 0.95);
+
+-- Seed description keyword rules for Income
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, match_type, confidence_score) VALUES
+('Direct Deposit', 'Direct Deposit', 'INCOME', 'KEYWORD', 0.95),
+('Salary', 'Salary', 'INCOME', 'KEYWORD', 0.95),
+('Payroll', 'Payroll', 'INCOME', 'KEYWORD', 0.95),
+('Paycheck', 'Paycheck', 'INCOME', 'KEYWORD', 0.95),
+('Wage', 'Wage', 'INCOME', 'KEYWORD', 0.95),
+('Income', 'Income', 'INCOME', 'KEYWORD', 0.95),
+('Employer', 'Employer', 'INCOME', 'KEYWORD', 0.95);
+
+-- Seed description keyword rules for ATM & Cash
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, match_type, confidence_score) VALUES
+('ATM Withdrawal', 'ATM Withdrawal', 'ATM_CASH', 'KEYWORD', 0.90),
+('Cash Withdrawal', 'Cash Withdrawal', 'ATM_CASH', 'KEYWORD', 0.90),
+('ATM', 'ATM', 'ATM_CASH', 'KEYWORD', 0.90),
+('Cash Out', 'Cash Out', 'ATM_CASH', 'KEYWORD', 0.90),
+('Cash Advance', 'Cash Advance', 'ATM_CASH', 'KEYWORD', 0.90);
+
+-- Seed description keyword rules for Fees & Charges
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, match_type, confidence_score) VALUES
+('Monthly Service Fee', 'Monthly Service Fee', 'FEES', 'KEYWORD', 0.90),
+('Overdraft Fee', 'Overdraft Fee', 'FEES', 'KEYWORD', 0.90),
+('Late Fee', 'Late Fee', 'FEES', 'KEYWORD', 0.90),
+('Service Charge', 'Service Charge', 'FEES', 'KEYWORD', 0.90),
+('Bank Fee', 'Bank Fee', 'FEES', 'KEYWORD', 0.90),
+('Transaction Fee', 'Transaction Fee', 'FEES', 'KEYWORD', 0.90),
+('International Fee', 'International Fee', 'FEES', 'KEYWORD', 0.90);
+
+-- Seed description keyword rules for Other
+INSERT INTO merchant_mappings (merchant_pattern, normalized_name, category_code, match_type, confidence_score) VALUES
+('Refund', 'Refund', 'OTHER', 'KEYWORD', 0.70),
+('Reimbursement', 'Reimbursement', 'OTHER', 'KEYWORD', 0.70),
+('Credit Adjustment', 'Credit Adjustment', 'OTHER', 'KEYWORD', 0.70),
+('Return', 'Return', 'OTHER', 'KEYWORD', 0.70);
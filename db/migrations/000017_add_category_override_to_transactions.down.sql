@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transactions_category_overridden_at;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS category_overridden_by;
+ALTER TABLE transactions DROP COLUMN IF EXISTS category_overridden_at;
@@ -0,0 +1,5 @@
+-- Track manual category overrides so automatic re-categorization leaves them alone
+ALTER TABLE transactions ADD COLUMN category_overridden_at TIMESTAMP NULL;
+ALTER TABLE transactions ADD COLUMN category_overridden_by UUID NULL REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_transactions_category_overridden_at ON transactions(category_overridden_at);
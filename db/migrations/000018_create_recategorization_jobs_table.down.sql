@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_transactions_created_at_id;
+DROP TABLE IF EXISTS recategorization_jobs;
@@ -0,0 +1,36 @@
+-- Backfill jobs that re-run the categorization rules over historical transactions
+CREATE TABLE recategorization_jobs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+    account_id UUID NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    start_date TIMESTAMP NULL,
+    end_date TIMESTAMP NULL,
+    batch_size INT NOT NULL,
+    requested_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    cursor_created_at TIMESTAMP NULL,
+    cursor_transaction_id UUID NULL,
+    processed_count BIGINT NOT NULL DEFAULT 0,
+    changed_count BIGINT NOT NULL DEFAULT 0,
+    unchanged_count BIGINT NOT NULL DEFAULT 0,
+    overridden_count BIGINT NOT NULL DEFAULT 0,
+    conflict_count BIGINT NOT NULL DEFAULT 0,
+    transitions JSONB NULL,
+    error_message TEXT NULL,
+    started_at TIMESTAMP NULL,
+    completed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_recategorization_jobs_status CHECK (status IN ('pending', 'running', 'completed', 'failed', 'cancelled')),
+    CONSTRAINT chk_recategorization_jobs_batch_size CHECK (batch_size > 0),
+    CONSTRAINT chk_recategorization_jobs_date_range CHECK (start_date IS NULL OR end_date IS NULL OR start_date < end_date)
+);
+
+CREATE INDEX idx_recategorization_jobs_status ON recategorization_jobs(status, created_at);
+
+-- Keyset pagination over transactions in (created_at, id) order
+CREATE INDEX idx_transactions_created_at_id ON transactions(created_at, id);
+
+COMMENT ON COLUMN recategorization_jobs.cursor_created_at IS 'Checkpoint: created_at of the last transaction processed';
+COMMENT ON COLUMN recategorization_jobs.cursor_transaction_id IS 'Checkpoint: id of the last transaction processed';
+COMMENT ON COLUMN recategorization_jobs.transitions IS 'Counts per category transition, keyed "FROM->TO"';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transfers_schedule_id;
+ALTER TABLE transfers DROP COLUMN IF EXISTS schedule_occurrence;
+ALTER TABLE transfers DROP COLUMN IF EXISTS schedule_id;
+DROP TABLE IF EXISTS transfer_schedules;
@@ -0,0 +1,43 @@
+-- Standing orders that repeat an account-to-account transfer
+CREATE TABLE transfer_schedules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    from_account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    to_account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    amount DECIMAL(15,2) NOT NULL,
+    description TEXT NOT NULL,
+    frequency VARCHAR(20) NOT NULL,
+    day_of_month INT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NULL,
+    max_occurrences INT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    next_occurrence INT NOT NULL DEFAULT 1,
+    occurrences_run INT NOT NULL DEFAULT 0,
+    next_run_at TIMESTAMP NULL,
+    last_run_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_transfer_schedules_amount CHECK (amount > 0),
+    CONSTRAINT chk_transfer_schedules_accounts CHECK (from_account_id <> to_account_id),
+    CONSTRAINT chk_transfer_schedules_frequency CHECK (frequency IN ('weekly', 'biweekly', 'monthly', 'day_of_month')),
+    CONSTRAINT chk_transfer_schedules_day_of_month CHECK (
+        (frequency = 'day_of_month' AND day_of_month BETWEEN 1 AND 31) OR
+        (frequency <> 'day_of_month' AND day_of_month IS NULL)
+    ),
+    CONSTRAINT chk_transfer_schedules_end_date CHECK (end_date IS NULL OR end_date >= start_date),
+    CONSTRAINT chk_transfer_schedules_max_occurrences CHECK (max_occurrences IS NULL OR max_occurrences > 0),
+    CONSTRAINT chk_transfer_schedules_status CHECK (status IN ('active', 'paused', 'cancelled', 'completed'))
+);
+
+CREATE INDEX idx_transfer_schedules_user_id ON transfer_schedules(user_id);
+CREATE INDEX idx_transfer_schedules_due ON transfer_schedules(status, next_run_at);
+
+-- Link each occurrence's transfer back to its schedule
+ALTER TABLE transfers ADD COLUMN schedule_id UUID NULL REFERENCES transfer_schedules(id) ON DELETE SET NULL;
+ALTER TABLE transfers ADD COLUMN schedule_occurrence INT NULL;
+
+CREATE INDEX idx_transfers_schedule_id ON transfers(schedule_id);
+
+COMMENT ON COLUMN transfer_schedules.next_occurrence IS 'Number of the next occurrence; part of its idempotency key';
+COMMENT ON COLUMN transfers.schedule_occurrence IS 'Occurrence number within the schedule that created this transfer';
@@ -0,0 +1,36 @@
+-- Monthly payments of accrued interest; one per account and month
+CREATE TABLE interest_postings (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    period_start DATE NOT NULL,
+    accrual_count INT NOT NULL DEFAULT 0,
+    accrued DECIMAL(20,10) NOT NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    carry DECIMAL(20,10) NOT NULL,
+    transaction_id UUID NULL REFERENCES transactions(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_interest_postings_amount CHECK (amount >= 0),
+    CONSTRAINT chk_interest_postings_carry CHECK (carry >= 0 AND carry < 0.01)
+);
+
+CREATE UNIQUE INDEX idx_interest_postings_account_period ON interest_postings(account_id, period_start);
+
+-- Interest earned on each day's closing balance
+CREATE TABLE interest_accruals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    accrual_date DATE NOT NULL,
+    balance DECIMAL(15,2) NOT NULL,
+    rate DECIMAL(5,4) NOT NULL,
+    amount DECIMAL(20,10) NOT NULL,
+    posting_id UUID NULL REFERENCES interest_postings(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_interest_accruals_amount CHECK (amount >= 0)
+);
+
+CREATE UNIQUE INDEX idx_interest_accruals_account_date ON interest_accruals(account_id, accrual_date);
+CREATE INDEX idx_interest_accruals_posting_id ON interest_accruals(posting_id);
+
+COMMENT ON COLUMN interest_accruals.amount IS 'balance * rate / 365, kept to 10 decimal places';
+COMMENT ON COLUMN interest_accruals.posting_id IS 'Monthly posting that paid this accrual; NULL until paid';
+COMMENT ON COLUMN interest_postings.carry IS 'Sub-cent remainder carried into the next posting';
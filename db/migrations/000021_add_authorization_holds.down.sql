@@ -0,0 +1,13 @@
+DELETE FROM transaction_processing_queue WHERE operation = 'expire';
+
+ALTER TABLE transaction_processing_queue
+DROP CONSTRAINT IF EXISTS transaction_processing_queue_operation_check;
+
+ALTER TABLE transaction_processing_queue
+ADD CONSTRAINT transaction_processing_queue_operation_check
+CHECK (operation IN ('process', 'reverse', 'validate'));
+
+DROP INDEX IF EXISTS idx_transactions_active_holds;
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS chk_accounts_held_balance;
+ALTER TABLE accounts DROP COLUMN IF EXISTS held_balance;
@@ -0,0 +1,19 @@
+-- Funds reserved by authorization holds; the available balance is balance - held_balance
+ALTER TABLE accounts ADD COLUMN held_balance DECIMAL(15,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE accounts
+ADD CONSTRAINT chk_accounts_held_balance
+CHECK (held_balance >= 0 AND held_balance <= balance);
+
+-- Holds are pending debits with pending_until set; this index serves the expiry sweep
+CREATE INDEX idx_transactions_active_holds
+    ON transactions (pending_until)
+    WHERE status = 'pending' AND pending_until IS NOT NULL;
+
+-- Expired holds are released through the processing queue
+ALTER TABLE transaction_processing_queue
+DROP CONSTRAINT IF EXISTS transaction_processing_queue_operation_check;
+
+ALTER TABLE transaction_processing_queue
+ADD CONSTRAINT transaction_processing_queue_operation_check
+CHECK (operation IN ('process', 'reverse', 'validate', 'expire'));
@@ -0,0 +1,14 @@
+ALTER TABLE transfers
+DROP CONSTRAINT IF EXISTS transfers_status_check;
+
+UPDATE transfers SET status = 'completed' WHERE status = 'reversed';
+
+ALTER TABLE transfers
+ADD CONSTRAINT transfers_status_check
+CHECK (status IN ('pending', 'completed', 'failed'));
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS reversed_at;
+
+DROP INDEX IF EXISTS idx_transactions_reversal_of;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS reversal_of;
@@ -0,0 +1,17 @@
+-- Offsetting transactions link to the transaction they reverse; the unique index
+-- guarantees a transaction is reversed at most once
+ALTER TABLE transactions ADD COLUMN reversal_of UUID NULL REFERENCES transactions(id);
+
+CREATE UNIQUE INDEX idx_transactions_reversal_of
+    ON transactions (reversal_of)
+    WHERE reversal_of IS NOT NULL;
+
+-- Transfers are reversed by offsetting both legs
+ALTER TABLE transfers ADD COLUMN reversed_at TIMESTAMP NULL;
+
+ALTER TABLE transfers
+DROP CONSTRAINT IF EXISTS transfers_status_check;
+
+ALTER TABLE transfers
+ADD CONSTRAINT transfers_status_check
+CHECK (status IN ('pending', 'completed', 'failed', 'reversed'));
@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS idx_processing_queue_failed_operation;
+
+UPDATE transaction_processing_queue SET status = 'failed' WHERE status = 'purged';
+
+ALTER TABLE transaction_processing_queue
+DROP CONSTRAINT IF EXISTS transaction_processing_queue_status_check;
+
+ALTER TABLE transaction_processing_queue
+ADD CONSTRAINT transaction_processing_queue_status_check
+CHECK (status IN ('pending', 'processing', 'completed', 'failed'));
+
+ALTER TABLE transaction_processing_queue DROP COLUMN IF EXISTS purged_by;
+ALTER TABLE transaction_processing_queue DROP COLUMN IF EXISTS purge_reason;
+ALTER TABLE transaction_processing_queue DROP COLUMN IF EXISTS purged_at;
+ALTER TABLE transaction_processing_queue DROP COLUMN IF EXISTS replay_count;
+ALTER TABLE transaction_processing_queue DROP COLUMN IF EXISTS retry_history;
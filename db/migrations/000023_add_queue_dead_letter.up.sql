@@ -0,0 +1,21 @@
+-- Each failed attempt is appended to retry_history so failed items keep the error
+-- that caused every retry, not only the last one
+ALTER TABLE transaction_processing_queue ADD COLUMN retry_history JSONB NULL;
+ALTER TABLE transaction_processing_queue ADD COLUMN replay_count INTEGER NOT NULL DEFAULT 0;
+
+-- Failed items are purged rather than deleted so the reason is kept
+ALTER TABLE transaction_processing_queue ADD COLUMN purged_at TIMESTAMP NULL;
+ALTER TABLE transaction_processing_queue ADD COLUMN purge_reason TEXT NULL;
+ALTER TABLE transaction_processing_queue ADD COLUMN purged_by UUID NULL REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE transaction_processing_queue
+DROP CONSTRAINT IF EXISTS transaction_processing_queue_status_check;
+
+ALTER TABLE transaction_processing_queue
+ADD CONSTRAINT transaction_processing_queue_status_check
+CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'purged'));
+
+-- Failed items are listed by operation and age
+CREATE INDEX idx_processing_queue_failed_operation
+    ON transaction_processing_queue (operation, processed_at DESC)
+    WHERE status = 'failed';
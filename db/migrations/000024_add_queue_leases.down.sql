@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_processing_queue_lease_expiry;
+
+ALTER TABLE transaction_processing_queue DROP COLUMN IF EXISTS lease_expires_at;
+ALTER TABLE transaction_processing_queue DROP COLUMN IF EXISTS worker_id;
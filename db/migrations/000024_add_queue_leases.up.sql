@@ -0,0 +1,8 @@
+-- Workers claim items by moving them to processing under a lease; the reaper
+-- returns items whose lease expired to pending
+ALTER TABLE transaction_processing_queue ADD COLUMN worker_id VARCHAR(100) NULL;
+ALTER TABLE transaction_processing_queue ADD COLUMN lease_expires_at TIMESTAMP NULL;
+
+CREATE INDEX idx_processing_queue_lease_expiry
+    ON transaction_processing_queue (lease_expires_at)
+    WHERE status = 'processing';
@@ -0,0 +1,9 @@
+ALTER TABLE transactions DROP COLUMN IF EXISTS exchange_rate;
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS rate_locked_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE transfers DROP COLUMN IF EXISTS converted_amount;
+ALTER TABLE transfers DROP COLUMN IF EXISTS to_currency;
+ALTER TABLE transfers DROP COLUMN IF EXISTS from_currency;
+
+DROP TABLE IF EXISTS exchange_rates;
@@ -0,0 +1,25 @@
+-- Current rate per currency pair: one unit of base_currency buys rate units of quote_currency
+CREATE TABLE exchange_rates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    base_currency VARCHAR(3) NOT NULL,
+    quote_currency VARCHAR(3) NOT NULL,
+    rate DECIMAL(20,10) NOT NULL,
+    source VARCHAR(50) NOT NULL,
+    effective_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_exchange_rates_rate CHECK (rate > 0),
+    CONSTRAINT chk_exchange_rates_pair CHECK (base_currency <> quote_currency)
+);
+
+CREATE UNIQUE INDEX idx_exchange_rates_pair ON exchange_rates(base_currency, quote_currency);
+
+-- Cross-currency transfers record the rate locked when they were made and the
+-- amount credited in the destination currency
+ALTER TABLE transfers ADD COLUMN from_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE transfers ADD COLUMN to_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE transfers ADD COLUMN converted_amount DECIMAL(15,2) NULL;
+ALTER TABLE transfers ADD COLUMN exchange_rate DECIMAL(20,10) NULL;
+ALTER TABLE transfers ADD COLUMN rate_locked_at TIMESTAMP NULL;
+
+ALTER TABLE transactions ADD COLUMN exchange_rate DECIMAL(20,10) NULL;
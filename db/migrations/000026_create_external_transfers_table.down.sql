@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_external_transfers_updated_at ON external_transfers;
+DROP TABLE IF EXISTS external_transfers;
@@ -0,0 +1,47 @@
+-- Transfers to and from accounts at other institutions, verified and settled through NorthWind
+CREATE TABLE external_transfers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    direction VARCHAR(10) NOT NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    description TEXT NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    reference_number VARCHAR(50) NOT NULL,
+    counterparty_name VARCHAR(100) NOT NULL,
+    counterparty_account_number VARCHAR(20) NOT NULL,
+    counterparty_routing_number VARCHAR(20) NOT NULL,
+    counterparty_institution VARCHAR(255) NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'initiated',
+    provider_transfer_id VARCHAR(100) NULL,
+    hold_transaction_id UUID NULL REFERENCES transactions(id),
+    settlement_transaction_id UUID NULL REFERENCES transactions(id),
+    return_code VARCHAR(3) NULL,
+    return_reason TEXT NULL,
+    failure_reason TEXT NULL,
+    submitted_at TIMESTAMP NULL,
+    settle_after TIMESTAMP NULL,
+    settled_at TIMESTAMP NULL,
+    returned_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_external_transfers_idempotency_key UNIQUE (idempotency_key),
+    CONSTRAINT uq_external_transfers_reference_number UNIQUE (reference_number),
+    CONSTRAINT chk_external_transfers_amount CHECK (amount > 0),
+    CONSTRAINT chk_external_transfers_direction CHECK (direction IN ('outbound', 'inbound')),
+    CONSTRAINT chk_external_transfers_status CHECK (status IN ('initiated', 'submitted', 'settled', 'returned', 'failed')),
+    CONSTRAINT chk_external_transfers_hold CHECK (direction = 'inbound' OR hold_transaction_id IS NOT NULL)
+);
+
+CREATE INDEX idx_external_transfers_user_id ON external_transfers(user_id);
+CREATE INDEX idx_external_transfers_account_id ON external_transfers(account_id);
+CREATE INDEX idx_external_transfers_provider_transfer_id ON external_transfers(provider_transfer_id);
+CREATE INDEX idx_external_transfers_due ON external_transfers(status, settle_after);
+
+CREATE TRIGGER update_external_transfers_updated_at BEFORE UPDATE ON external_transfers
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE external_transfers IS 'ACH-style transfers to and from accounts verified through NorthWind';
+COMMENT ON COLUMN external_transfers.hold_transaction_id IS 'Hold placed on initiation of an outbound transfer; captured when it settles';
+COMMENT ON COLUMN external_transfers.settle_after IS 'Earliest settlement time; returns received before then close the transfer';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS limit_overrides;
+DROP TRIGGER IF EXISTS update_transaction_limits_updated_at ON transaction_limits;
+DROP TABLE IF EXISTS transaction_limits;
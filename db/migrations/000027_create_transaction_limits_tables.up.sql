@@ -0,0 +1,46 @@
+-- Transaction limits for each account type, replaced field by field by a customer's own limits
+CREATE TABLE transaction_limits (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_type VARCHAR(50) NULL,
+    user_id UUID NULL REFERENCES users(id) ON DELETE CASCADE,
+    daily_withdrawal_limit DECIMAL(15,2) NULL,
+    monthly_withdrawal_limit DECIMAL(15,2) NULL,
+    max_single_transfer DECIMAL(15,2) NULL,
+    hourly_transaction_limit INTEGER NULL,
+    updated_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_transaction_limits_account_type UNIQUE (account_type),
+    CONSTRAINT uq_transaction_limits_user_id UNIQUE (user_id),
+    CONSTRAINT chk_transaction_limits_scope CHECK ((account_type IS NULL) <> (user_id IS NULL)),
+    CONSTRAINT chk_transaction_limits_daily CHECK (daily_withdrawal_limit IS NULL OR daily_withdrawal_limit > 0),
+    CONSTRAINT chk_transaction_limits_monthly CHECK (monthly_withdrawal_limit IS NULL OR monthly_withdrawal_limit > 0),
+    CONSTRAINT chk_transaction_limits_single_transfer CHECK (max_single_transfer IS NULL OR max_single_transfer > 0),
+    CONSTRAINT chk_transaction_limits_hourly CHECK (hourly_transaction_limit IS NULL OR hourly_transaction_limit > 0)
+);
+
+CREATE TRIGGER update_transaction_limits_updated_at BEFORE UPDATE ON transaction_limits
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE transaction_limits IS 'Withdrawal, transfer and velocity limits per account type or per customer; NULL limits are unset';
+COMMENT ON COLUMN transaction_limits.hourly_transaction_limit IS 'Maximum number of transactions posted to an account in any hour';
+
+-- Temporary admin overrides of one of an account's limits
+CREATE TABLE limit_overrides (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    limit_type VARCHAR(30) NOT NULL,
+    value DECIMAL(15,2) NOT NULL,
+    reason TEXT NOT NULL,
+    granted_by UUID NOT NULL REFERENCES users(id),
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL,
+    revoked_by UUID NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_limit_overrides_limit_type CHECK (limit_type IN ('daily_withdrawal', 'monthly_withdrawal', 'single_transfer', 'hourly_transactions')),
+    CONSTRAINT chk_limit_overrides_value CHECK (value > 0)
+);
+
+CREATE INDEX idx_limit_overrides_account_active ON limit_overrides(account_id, expires_at) WHERE revoked_at IS NULL;
+
+COMMENT ON TABLE limit_overrides IS 'Temporary limit overrides; the most recently granted active override for a limit type wins';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_transfers_from_to_status;
+DROP TRIGGER IF EXISTS update_fraud_reviews_updated_at ON fraud_reviews;
+DROP TABLE IF EXISTS fraud_reviews;
@@ -0,0 +1,39 @@
+-- Debits and outgoing transfers flagged by fraud screening, held for admin review
+CREATE TABLE fraud_reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    subject_type VARCHAR(20) NOT NULL,
+    hold_id UUID NOT NULL REFERENCES transactions(id),
+    transfer_id UUID NULL REFERENCES transfers(id),
+    amount DECIMAL(15,2) NOT NULL,
+    description TEXT NOT NULL,
+    rules JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending_review',
+    expires_at TIMESTAMP NOT NULL,
+    reviewed_by UUID NULL REFERENCES users(id),
+    reviewed_at TIMESTAMP NULL,
+    decision_reason TEXT NULL,
+    failure_reason TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_fraud_reviews_subject_type CHECK (subject_type IN ('transaction', 'transfer')),
+    CONSTRAINT chk_fraud_reviews_transfer CHECK ((subject_type = 'transfer') = (transfer_id IS NOT NULL)),
+    CONSTRAINT chk_fraud_reviews_status CHECK (status IN ('pending_review', 'approved', 'rejected', 'expired')),
+    CONSTRAINT chk_fraud_reviews_amount CHECK (amount > 0),
+    CONSTRAINT uq_fraud_reviews_hold_id UNIQUE (hold_id)
+);
+
+CREATE INDEX idx_fraud_reviews_status_created_at ON fraud_reviews(status, created_at);
+CREATE INDEX idx_fraud_reviews_account_id ON fraud_reviews(account_id);
+CREATE INDEX idx_fraud_reviews_transfer_id ON fraud_reviews(transfer_id) WHERE transfer_id IS NOT NULL;
+
+-- Screening looks up earlier transfers between the same accounts and recent transfers from an account
+CREATE INDEX idx_transfers_from_to_status ON transfers(from_account_id, to_account_id, status);
+
+CREATE TRIGGER update_fraud_reviews_updated_at BEFORE UPDATE ON fraud_reviews
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE fraud_reviews IS 'Items flagged by fraud screening; the amount stays reserved by the hold until an admin decides';
+COMMENT ON COLUMN fraud_reviews.rules IS 'Each screening rule that fired, mapped to the reason it gave';
+COMMENT ON COLUMN fraud_reviews.failure_reason IS 'Why an approved item could not be completed, for example insufficient funds';
@@ -0,0 +1,27 @@
+-- Rendered statement documents for closed periods. Each account, period and format
+-- is rendered once so re-downloads return the identical document.
+CREATE TABLE archived_statements (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    period_type VARCHAR(20) NOT NULL,
+    year INTEGER NOT NULL,
+    period INTEGER NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    content BYTEA NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    size_bytes INTEGER NOT NULL,
+    generated_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_archived_statements_period_type CHECK (period_type IN ('monthly', 'quarterly')),
+    CONSTRAINT chk_archived_statements_period CHECK (
+        (period_type = 'monthly' AND period BETWEEN 1 AND 12) OR
+        (period_type = 'quarterly' AND period BETWEEN 1 AND 4)
+    ),
+    CONSTRAINT chk_archived_statements_format CHECK (format IN ('pdf', 'csv'))
+);
+
+CREATE UNIQUE INDEX idx_archived_statements_period ON archived_statements(account_id, period_type, year, period, format);
+
+COMMENT ON TABLE archived_statements IS 'Statement documents rendered for closed periods, returned unchanged on re-download';
+COMMENT ON COLUMN archived_statements.content_hash IS 'Hex-encoded SHA-256 of content';
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_accounts_account_number_trgm;
+DROP INDEX IF EXISTS idx_users_email_trgm;
+DROP INDEX IF EXISTS idx_users_full_name_trgm;
+DROP INDEX IF EXISTS idx_users_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_first_name_trgm;
+
+DROP EXTENSION IF EXISTS "pg_trgm";
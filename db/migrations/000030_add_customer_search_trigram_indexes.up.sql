@@ -0,0 +1,11 @@
+-- Trigram indexes for fuzzy customer search. The indexed expressions match the ones
+-- the search filters on so the % operator and LIKE '%...%' can use them.
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
+CREATE INDEX idx_users_first_name_trgm ON users USING GIN (LOWER(first_name) gin_trgm_ops);
+CREATE INDEX idx_users_last_name_trgm ON users USING GIN (LOWER(last_name) gin_trgm_ops);
+CREATE INDEX idx_users_full_name_trgm ON users USING GIN (LOWER(first_name || ' ' || last_name) gin_trgm_ops);
+CREATE INDEX idx_users_email_trgm ON users USING GIN (LOWER(email) gin_trgm_ops);
+CREATE INDEX idx_accounts_account_number_trgm ON accounts USING GIN (account_number gin_trgm_ops);
+
+COMMENT ON INDEX idx_users_full_name_trgm IS 'Supports fuzzy customer search on first and last name together';
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS update_webhook_deliveries_updated_at ON webhook_deliveries;
+DROP TRIGGER IF EXISTS update_webhook_subscriptions_updated_at ON webhook_subscriptions;
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription_created_at;
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_all_customers;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_user_id;
+DROP TABLE IF EXISTS webhook_subscriptions;
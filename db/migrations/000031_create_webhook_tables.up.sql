@@ -0,0 +1,54 @@
+-- Outbound webhook subscriptions and the queue of deliveries made to them
+CREATE TABLE webhook_subscriptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    description TEXT NULL,
+    event_types JSONB NOT NULL,
+    secret VARCHAR(100) NOT NULL,
+    all_customers BOOLEAN NOT NULL DEFAULT FALSE,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_webhook_subscriptions_user_id ON webhook_subscriptions(user_id);
+CREATE INDEX idx_webhook_subscriptions_all_customers ON webhook_subscriptions(all_customers) WHERE active AND all_customers;
+
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subscription_id UUID NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL DEFAULT 8,
+    next_attempt_at TIMESTAMP NOT NULL,
+    last_attempt_at TIMESTAMP NULL,
+    response_status INTEGER NULL,
+    last_error TEXT NULL,
+    delivered_at TIMESTAMP NULL,
+    attempts JSONB NULL,
+    redelivery_of UUID NULL REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_webhook_deliveries_status CHECK (status IN ('pending', 'delivered', 'failed')),
+    CONSTRAINT chk_webhook_deliveries_attempts CHECK (attempt_count >= 0 AND max_attempts > 0)
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription_created_at ON webhook_deliveries(subscription_id, created_at);
+
+CREATE TRIGGER update_webhook_subscriptions_updated_at BEFORE UPDATE ON webhook_subscriptions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_webhook_deliveries_updated_at BEFORE UPDATE ON webhook_deliveries
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE webhook_subscriptions IS 'URLs that receive signed event notifications';
+COMMENT ON COLUMN webhook_subscriptions.all_customers IS 'Set on subscriptions created by admins, which receive events for every customer';
+COMMENT ON COLUMN webhook_subscriptions.secret IS 'Shared secret used to sign payloads with HMAC-SHA256';
+COMMENT ON TABLE webhook_deliveries IS 'Queued webhook deliveries, retried with exponential backoff';
+COMMENT ON COLUMN webhook_deliveries.attempts IS 'Every attempt made, with the response status or error it got';
+COMMENT ON COLUMN webhook_deliveries.redelivery_of IS 'The delivery this one was manually redelivered from';
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_mfa_enrollments_updated_at ON mfa_enrollments;
+DROP TABLE IF EXISTS mfa_enrollments;
+ALTER TABLE users DROP COLUMN IF EXISTS mfa_required;
@@ -0,0 +1,23 @@
+-- TOTP multi-factor authentication. Enrollment is opt-in unless an admin sets
+-- users.mfa_required, in which case the user has to enroll at their next login.
+ALTER TABLE users ADD COLUMN mfa_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE mfa_enrollments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    secret VARCHAR(64) NOT NULL,
+    confirmed_at TIMESTAMP NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    recovery_codes JSONB NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_mfa_enrollments_updated_at BEFORE UPDATE ON mfa_enrollments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON COLUMN users.mfa_required IS 'Set by admins to make the user enroll in MFA before they can log in';
+COMMENT ON TABLE mfa_enrollments IS 'TOTP (RFC 6238) authenticators, one per user';
+COMMENT ON COLUMN mfa_enrollments.confirmed_at IS 'When the user proved the authenticator works; MFA is enforced from then on';
+COMMENT ON COLUMN mfa_enrollments.last_used_step IS 'Time step of the last accepted code, so a code cannot be used twice';
+COMMENT ON COLUMN mfa_enrollments.recovery_codes IS 'SHA-256 hashes of the unused single-use recovery codes';
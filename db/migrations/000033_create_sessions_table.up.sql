@@ -0,0 +1,29 @@
+-- Sessions group the refresh tokens issued from one login (a token family).
+-- Refreshing rotates the token within the session; presenting a rotated token
+-- again means it was stolen, and the whole session is revoked.
+CREATE TABLE sessions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    device_name VARCHAR(100) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    last_used_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP NULL,
+    revoked_reason VARCHAR(50) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_sessions_user_id ON sessions(user_id);
+CREATE INDEX idx_sessions_active ON sessions(user_id, expires_at) WHERE revoked_at IS NULL;
+
+ALTER TABLE refresh_tokens ADD COLUMN session_id UUID NULL REFERENCES sessions(id) ON DELETE CASCADE;
+ALTER TABLE refresh_tokens ADD COLUMN rotated_at TIMESTAMP NULL;
+
+CREATE INDEX idx_refresh_tokens_session_id ON refresh_tokens(session_id);
+
+COMMENT ON TABLE sessions IS 'Signed-in devices; one per login, shared by the refresh tokens rotated from it';
+COMMENT ON COLUMN sessions.last_used_at IS 'When the session last logged in or refreshed its tokens';
+COMMENT ON COLUMN sessions.revoked_reason IS 'logout, user_revoked, signed_out_everywhere, admin_revoked or token_reuse';
+COMMENT ON COLUMN refresh_tokens.session_id IS 'Session the token belongs to; NULL for tokens issued before sessions existed';
+COMMENT ON COLUMN refresh_tokens.rotated_at IS 'When the token was exchanged for a new one; using it again revokes the session';
@@ -0,0 +1,59 @@
+-- Roles grant permissions, and admin routes check permissions rather than the
+-- role name. Access tokens carry the permissions of the user's role when issued.
+CREATE TABLE roles (
+    name VARCHAR(20) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE permissions (
+    name VARCHAR(50) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE role_permissions (
+    role_name VARCHAR(20) NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    permission_name VARCHAR(50) NOT NULL REFERENCES permissions(name) ON DELETE CASCADE,
+    PRIMARY KEY (role_name, permission_name)
+);
+
+INSERT INTO roles (name, description) VALUES
+    ('customer', 'Bank customer; manages their own accounts only'),
+    ('support', 'Support agent; read-only customer view and password resets'),
+    ('teller', 'Teller; posts deposits and withdrawals to customer accounts'),
+    ('compliance', 'Compliance officer; audit log and holds'),
+    ('admin', 'Super-admin; every permission');
+
+INSERT INTO permissions (name, description) VALUES
+    ('customers:read', 'View customers, users, accounts and sessions'),
+    ('customers:write', 'Create, update, delete and unlock customers and their accounts'),
+    ('customers:reset_password', 'Reset customer passwords'),
+    ('sessions:revoke', 'Sign customers out of their sessions'),
+    ('mfa:manage', 'Require or reset customer multi-factor authentication'),
+    ('transactions:post', 'Post deposits and withdrawals to any account'),
+    ('transactions:manage', 'Import and reverse transactions and return external transfers'),
+    ('audit:read', 'View customer activity from the audit log'),
+    ('holds:manage', 'View, place, capture and release holds'),
+    ('limits:manage', 'View and set transaction limits and overrides'),
+    ('fraud:review', 'Approve and reject fraud reviews'),
+    ('queue:manage', 'Inspect, replay and purge the processing queue'),
+    ('categories:manage', 'Manage categories, merchant mappings and recategorization jobs'),
+    ('interest:manage', 'Run interest backfills'),
+    ('roles:manage', 'View roles and assign them to users');
+
+INSERT INTO role_permissions (role_name, permission_name) VALUES
+    ('support', 'customers:read'),
+    ('support', 'customers:reset_password'),
+    ('teller', 'customers:read'),
+    ('teller', 'transactions:post'),
+    ('compliance', 'customers:read'),
+    ('compliance', 'audit:read'),
+    ('compliance', 'holds:manage');
+
+INSERT INTO role_permissions (role_name, permission_name)
+SELECT 'admin', name FROM permissions;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT fk_users_role FOREIGN KEY (role) REFERENCES roles(name);
+
+COMMENT ON TABLE role_permissions IS 'Permissions granted by each role; changes apply to access tokens issued afterwards';
+COMMENT ON COLUMN sessions.revoked_reason IS 'logout, user_revoked, signed_out_everywhere, admin_revoked, token_reuse or role_changed';
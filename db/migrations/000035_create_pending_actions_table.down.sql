@@ -0,0 +1,4 @@
+DELETE FROM role_permissions WHERE permission_name = 'approvals:review';
+DELETE FROM permissions WHERE name = 'approvals:review';
+DROP TRIGGER IF EXISTS update_pending_actions_updated_at ON pending_actions;
+DROP TABLE IF EXISTS pending_actions;
@@ -0,0 +1,38 @@
+-- High-risk staff actions held for maker-checker approval. The admin who requests
+-- an action cannot decide it; approval by a second admin carries it out.
+CREATE TABLE pending_actions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    action_type VARCHAR(50) NOT NULL,
+    resource_id VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending_approval',
+    requested_by UUID NOT NULL REFERENCES users(id),
+    expires_at TIMESTAMP NOT NULL,
+    reviewed_by UUID NULL REFERENCES users(id),
+    reviewed_at TIMESTAMP NULL,
+    decision_reason TEXT NULL,
+    failure_reason TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_pending_actions_action_type CHECK (action_type IN ('delete_customer', 'transfer_account_ownership', 'reset_customer_password', 'manual_transaction')),
+    CONSTRAINT chk_pending_actions_status CHECK (status IN ('pending_approval', 'approved', 'rejected', 'expired')),
+    CONSTRAINT chk_pending_actions_reviewer CHECK (reviewed_by IS NULL OR reviewed_by <> requested_by)
+);
+
+CREATE INDEX idx_pending_actions_status_created_at ON pending_actions(status, created_at);
+CREATE INDEX idx_pending_actions_resource_id ON pending_actions(resource_id);
+-- The expiry worker looks up pending actions past their expiry
+CREATE INDEX idx_pending_actions_expires_at ON pending_actions(expires_at) WHERE status = 'pending_approval';
+
+CREATE TRIGGER update_pending_actions_updated_at BEFORE UPDATE ON pending_actions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO permissions (name, description) VALUES
+    ('approvals:review', 'View, approve and reject actions held for a second admin''s approval');
+
+INSERT INTO role_permissions (role_name, permission_name) VALUES
+    ('admin', 'approvals:review');
+
+COMMENT ON TABLE pending_actions IS 'Staff actions held until a second admin approves or rejects them, or they expire';
+COMMENT ON COLUMN pending_actions.payload IS 'Arguments the action is carried out with once approved';
+COMMENT ON COLUMN pending_actions.failure_reason IS 'Why an approved action could not be carried out, for example a non-zero balance';
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_fraud_reviews_external_transfer_id;
+
+DELETE FROM fraud_reviews WHERE subject_type = 'external_transfer';
+ALTER TABLE fraud_reviews DROP CONSTRAINT IF EXISTS chk_fraud_reviews_external_transfer;
+ALTER TABLE fraud_reviews DROP CONSTRAINT chk_fraud_reviews_subject_type;
+ALTER TABLE fraud_reviews ADD CONSTRAINT chk_fraud_reviews_subject_type
+    CHECK (subject_type IN ('transaction', 'transfer'));
+ALTER TABLE fraud_reviews DROP COLUMN IF EXISTS external_transfer_id;
+
+UPDATE external_transfers SET status = 'failed', failure_reason = 'fraud review removed' WHERE status = 'pending_review';
+ALTER TABLE external_transfers DROP CONSTRAINT chk_external_transfers_status;
+ALTER TABLE external_transfers ADD CONSTRAINT chk_external_transfers_status
+    CHECK (status IN ('initiated', 'submitted', 'settled', 'returned', 'failed'));
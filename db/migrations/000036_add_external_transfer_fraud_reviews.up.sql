@@ -0,0 +1,16 @@
+-- Outbound external transfers flagged by fraud screening wait for review before submission
+ALTER TABLE external_transfers DROP CONSTRAINT chk_external_transfers_status;
+ALTER TABLE external_transfers ADD CONSTRAINT chk_external_transfers_status
+    CHECK (status IN ('pending_review', 'initiated', 'submitted', 'settled', 'returned', 'failed'));
+
+ALTER TABLE fraud_reviews ADD COLUMN external_transfer_id UUID NULL REFERENCES external_transfers(id);
+
+ALTER TABLE fraud_reviews DROP CONSTRAINT chk_fraud_reviews_subject_type;
+ALTER TABLE fraud_reviews ADD CONSTRAINT chk_fraud_reviews_subject_type
+    CHECK (subject_type IN ('transaction', 'transfer', 'external_transfer'));
+ALTER TABLE fraud_reviews ADD CONSTRAINT chk_fraud_reviews_external_transfer
+    CHECK ((subject_type = 'external_transfer') = (external_transfer_id IS NOT NULL));
+
+CREATE INDEX idx_fraud_reviews_external_transfer_id ON fraud_reviews(external_transfer_id) WHERE external_transfer_id IS NOT NULL;
+
+COMMENT ON COLUMN fraud_reviews.external_transfer_id IS 'Held external transfer; its own hold reserves the amount and is the review hold';
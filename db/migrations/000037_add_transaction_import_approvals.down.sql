@@ -0,0 +1,4 @@
+DELETE FROM pending_actions WHERE action_type = 'transaction_import';
+ALTER TABLE pending_actions DROP CONSTRAINT chk_pending_actions_action_type;
+ALTER TABLE pending_actions ADD CONSTRAINT chk_pending_actions_action_type
+    CHECK (action_type IN ('delete_customer', 'transfer_account_ownership', 'reset_customer_password', 'manual_transaction'));
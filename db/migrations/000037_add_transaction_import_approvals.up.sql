@@ -0,0 +1,5 @@
+-- Transaction imports with a row at or above the approval threshold are held for
+-- a second admin, with the file in the payload
+ALTER TABLE pending_actions DROP CONSTRAINT chk_pending_actions_action_type;
+ALTER TABLE pending_actions ADD CONSTRAINT chk_pending_actions_action_type
+    CHECK (action_type IN ('delete_customer', 'transfer_account_ownership', 'reset_customer_password', 'manual_transaction', 'transaction_import'));
@@ -0,0 +1,4 @@
+-- Encrypted secrets do not fit the old column, so those enrollments are reset
+DELETE FROM mfa_enrollments WHERE length(secret) > 64;
+ALTER TABLE mfa_enrollments ALTER COLUMN secret TYPE VARCHAR(64);
+COMMENT ON COLUMN mfa_enrollments.secret IS NULL;
@@ -0,0 +1,6 @@
+-- Authenticator secrets are stored encrypted with MFA_SECRET_KEY, which needs more
+-- room than the base32 secret. Secrets written before this are read as plaintext
+-- and encrypted the next time the user enrolls.
+ALTER TABLE mfa_enrollments ALTER COLUMN secret TYPE VARCHAR(255);
+
+COMMENT ON COLUMN mfa_enrollments.secret IS 'TOTP secret, AES-256-GCM encrypted with MFA_SECRET_KEY';
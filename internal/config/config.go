@@ -13,6 +13,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 type Config struct {
@@ -21,6 +23,16 @@ type Config struct {
 	JWT       JWTConfig
 	Security  SecurityConfig
 	NorthWind NorthWindConfig
+	Queue     QueueConfig
+	Category  CategoryConfig
+	Transfer  TransferConfig
+	Interest  InterestConfig
+	Hold      HoldConfig
+	FX        FXConfig
+	Fraud     FraudConfig
+	Webhook   WebhookConfig
+	MFA       MFAConfig
+	Approval  ApprovalConfig
 }
 
 type ServerConfig struct {
@@ -29,6 +41,8 @@ type ServerConfig struct {
 	Environment      string
 	ReadTimeout      time.Duration
 	WriteTimeout     time.Duration
+	IdleTimeout      time.Duration
+	ShutdownTimeout  time.Duration
 	CORSAllowOrigins []string
 }
 
@@ -47,9 +61,33 @@ type DatabaseConfig struct {
 type JWTConfig struct {
 	AccessTokenDuration  time.Duration
 	RefreshTokenDuration time.Duration
+	MFAChallengeDuration time.Duration
 	PrivateKey           *rsa.PrivateKey
 	PublicKey            *rsa.PublicKey
 	Issuer               string
+
+	// NextPrivateKey is published for verification straight away and takes over
+	// signing at NextKeyActivatesAt, so every replica and every service verifying
+	// our tokens knows it before the first token signed with it arrives.
+	NextPrivateKey     *rsa.PrivateKey
+	NextKeyActivatesAt time.Time
+	// PreviousPublicKey is the key PrivateKey replaced at KeyRotatedAt. Tokens it
+	// signed are accepted until the longest-lived of them has expired.
+	PreviousPublicKey *rsa.PublicKey
+	KeyRotatedAt      time.Time
+}
+
+// MaxTokenLifetime is the longest any issued token stays valid, after which a
+// replaced signing key is no longer needed for verification
+func (c *JWTConfig) MaxTokenLifetime() time.Duration {
+	lifetime := c.AccessTokenDuration
+	if c.RefreshTokenDuration > lifetime {
+		lifetime = c.RefreshTokenDuration
+	}
+	if c.MFAChallengeDuration > lifetime {
+		lifetime = c.MFAChallengeDuration
+	}
+	return lifetime
 }
 
 type SecurityConfig struct {
@@ -69,14 +107,108 @@ type NorthWindConfig struct {
 	Timeout time.Duration
 }
 
+type QueueConfig struct {
+	MaxWorkers          int
+	WorkerID            string
+	LeaseDuration       time.Duration
+	LeaseReaperInterval time.Duration
+}
+
+type CategoryConfig struct {
+	RulesReloadInterval          time.Duration
+	RecategorizationBatchSize    int
+	RecategorizationPollInterval time.Duration
+}
+
+type TransferConfig struct {
+	SchedulePollInterval    time.Duration
+	ScheduleBatchSize       int
+	ExternalPollInterval    time.Duration
+	ExternalBatchSize       int
+	ExternalSettlementDelay time.Duration
+}
+
+type InterestConfig struct {
+	AccrualPollInterval time.Duration
+	AccountBatchSize    int
+}
+
+type HoldConfig struct {
+	ExpiryPollInterval time.Duration
+	ExpiryBatchSize    int
+}
+
+type FXConfig struct {
+	RatesFile string
+}
+
+// FraudConfig configures the fraud screening rules. A debit or transfer is held
+// for review when it exceeds AmountMultiplier times the account's average
+// transaction amount over at least MinHistoryTransactions transactions, when it is
+// the first transfer to a destination, when the customer's latest login came from
+// an IP not seen in their previous LoginHistory logins, or when it is one of more
+// than RapidTransferCount transfers within RapidTransferWindow. Reviews not decided
+// within ReviewWindow are expired by a worker every ExpiryPollInterval, up to
+// ExpiryBatchSize at a time.
+type FraudConfig struct {
+	Enabled                bool
+	ReviewWindow           time.Duration
+	ExpiryPollInterval     time.Duration
+	ExpiryBatchSize        int
+	AmountMultiplier       int
+	MinHistoryTransactions int
+	LoginHistory           int
+	RapidTransferWindow    time.Duration
+	RapidTransferCount     int
+}
+
+// WebhookConfig configures outbound webhook delivery. Due deliveries are sent every
+// PollInterval, up to BatchSize at a time, and a delivery that is not acknowledged
+// within Timeout is retried until it has been attempted MaxAttempts times. Plain
+// http URLs are rejected unless AllowInsecureURLs is set.
+type WebhookConfig struct {
+	PollInterval      time.Duration
+	BatchSize         int
+	Timeout           time.Duration
+	MaxAttempts       int
+	AllowInsecureURLs bool
+}
+
+// MFAConfig configures TOTP multi-factor authentication. Issuer names the bank in
+// authenticator apps. Sensitive operations by users enrolled in MFA require a code
+// verified within StepUpWindow. SecretKey is the AES-256 key authenticator secrets
+// are encrypted with at rest.
+type MFAConfig struct {
+	Issuer       string
+	StepUpWindow time.Duration
+	SecretKey    []byte
+}
+
+// ApprovalConfig configures maker-checker approval of high-risk staff actions.
+// When Enabled, each action in RequiredActions is held until a second admin
+// approves it; manual transactions, and imports with a row or a total, are held
+// only from TransactionThreshold up.
+// Requests not decided within Window expire, and the expiry worker closes them
+// every PollInterval, up to BatchSize at a time.
+type ApprovalConfig struct {
+	Enabled              bool
+	RequiredActions      []string
+	TransactionThreshold decimal.Decimal
+	Window               time.Duration
+	PollInterval         time.Duration
+	BatchSize            int
+}
+
 func Load() *Config {
 	config := &Config{
 		Server: ServerConfig{
-			Port:         getEnv("SERVER_PORT", "8080"),
-			Host:         getEnv("SERVER_HOST", "localhost"),
-			Environment:  getEnv("APP_ENV", "development"),
-			ReadTimeout:  getDurationEnv("SERVER_READ_TIMEOUT", 15*time.Second),
-			WriteTimeout: getDurationEnv("SERVER_WRITE_TIMEOUT", 15*time.Second),
+			Port:            getEnv("SERVER_PORT", "8080"),
+			Host:            getEnv("SERVER_HOST", "localhost"),
+			Environment:     getEnv("APP_ENV", "development"),
+			ReadTimeout:     getDurationEnv("SERVER_READ_TIMEOUT", 15*time.Second),
+			WriteTimeout:    getDurationEnv("SERVER_WRITE_TIMEOUT", 15*time.Second),
+			IdleTimeout:     getDurationEnv("SERVER_IDLE_TIMEOUT", 120*time.Second),
+			ShutdownTimeout: getDurationEnv("SERVER_SHUTDOWN_TIMEOUT", 30*time.Second),
 		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
@@ -102,6 +234,7 @@ func Load() *Config {
 		JWT: JWTConfig{
 			AccessTokenDuration:  getDurationEnv("JWT_ACCESS_TOKEN_DURATION", 24*time.Hour),
 			RefreshTokenDuration: getDurationEnv("JWT_REFRESH_TOKEN_DURATION", 7*24*time.Hour),
+			MFAChallengeDuration: getDurationEnv("JWT_MFA_CHALLENGE_DURATION", 5*time.Minute),
 			Issuer:               getEnv("JWT_ISSUER", "banking-api"),
 		},
 		NorthWind: NorthWindConfig{
@@ -109,6 +242,67 @@ func Load() *Config {
 			ApiKey:  getEnv("NORTHWIND_API_KEY", ""),
 			Timeout: getDurationEnv("NORTHWIND_TIMEOUT", 30*time.Second),
 		},
+		Queue: QueueConfig{
+			MaxWorkers:          getIntEnv("QUEUE_MAX_WORKERS", 10),
+			WorkerID:            getEnv("QUEUE_WORKER_ID", defaultWorkerID()),
+			LeaseDuration:       getDurationEnv("QUEUE_LEASE_DURATION", 5*time.Minute),
+			LeaseReaperInterval: getDurationEnv("QUEUE_LEASE_REAPER_INTERVAL", 30*time.Second),
+		},
+		Category: CategoryConfig{
+			RulesReloadInterval:          getDurationEnv("CATEGORY_RULES_RELOAD_INTERVAL", time.Minute),
+			RecategorizationBatchSize:    getIntEnv("CATEGORY_RECATEGORIZATION_BATCH_SIZE", 500),
+			RecategorizationPollInterval: getDurationEnv("CATEGORY_RECATEGORIZATION_POLL_INTERVAL", 10*time.Second),
+		},
+		Transfer: TransferConfig{
+			SchedulePollInterval:    getDurationEnv("TRANSFER_SCHEDULE_POLL_INTERVAL", time.Minute),
+			ScheduleBatchSize:       getIntEnv("TRANSFER_SCHEDULE_BATCH_SIZE", 100),
+			ExternalPollInterval:    getDurationEnv("EXTERNAL_TRANSFER_POLL_INTERVAL", time.Minute),
+			ExternalBatchSize:       getIntEnv("EXTERNAL_TRANSFER_BATCH_SIZE", 100),
+			ExternalSettlementDelay: getDurationEnv("EXTERNAL_TRANSFER_SETTLEMENT_DELAY", 48*time.Hour),
+		},
+		Interest: InterestConfig{
+			AccrualPollInterval: getDurationEnv("INTEREST_ACCRUAL_POLL_INTERVAL", time.Hour),
+			AccountBatchSize:    getIntEnv("INTEREST_ACCOUNT_BATCH_SIZE", 100),
+		},
+		Hold: HoldConfig{
+			ExpiryPollInterval: getDurationEnv("HOLD_EXPIRY_POLL_INTERVAL", time.Minute),
+			ExpiryBatchSize:    getIntEnv("HOLD_EXPIRY_BATCH_SIZE", 100),
+		},
+		FX: FXConfig{
+			RatesFile: getEnv("FX_RATES_FILE", ""),
+		},
+		Fraud: FraudConfig{
+			Enabled:                getBoolEnv("FRAUD_SCREENING_ENABLED", true),
+			ReviewWindow:           getDurationEnv("FRAUD_REVIEW_WINDOW", 72*time.Hour),
+			ExpiryPollInterval:     getDurationEnv("FRAUD_REVIEW_EXPIRY_POLL_INTERVAL", time.Minute),
+			ExpiryBatchSize:        getIntEnv("FRAUD_REVIEW_EXPIRY_BATCH_SIZE", 100),
+			AmountMultiplier:       getIntEnv("FRAUD_AMOUNT_MULTIPLIER", 5),
+			MinHistoryTransactions: getIntEnv("FRAUD_MIN_HISTORY_TRANSACTIONS", 5),
+			LoginHistory:           getIntEnv("FRAUD_LOGIN_HISTORY", 20),
+			RapidTransferWindow:    getDurationEnv("FRAUD_RAPID_TRANSFER_WINDOW", 10*time.Minute),
+			RapidTransferCount:     getIntEnv("FRAUD_RAPID_TRANSFER_COUNT", 3),
+		},
+		Webhook: WebhookConfig{
+			PollInterval:      getDurationEnv("WEBHOOK_POLL_INTERVAL", 5*time.Second),
+			BatchSize:         getIntEnv("WEBHOOK_BATCH_SIZE", 100),
+			Timeout:           getDurationEnv("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxAttempts:       getIntEnv("WEBHOOK_MAX_ATTEMPTS", 8),
+			AllowInsecureURLs: getBoolEnv("WEBHOOK_ALLOW_INSECURE_URLS", false),
+		},
+		MFA: MFAConfig{
+			Issuer:       getEnv("MFA_ISSUER", "Array Bank"),
+			StepUpWindow: getDurationEnv("MFA_STEP_UP_WINDOW", 5*time.Minute),
+		},
+		Approval: ApprovalConfig{
+			Enabled: getBoolEnv("APPROVAL_ENABLED", true),
+			RequiredActions: getListEnv("APPROVAL_REQUIRED_ACTIONS", []string{
+				"delete_customer", "transfer_account_ownership", "reset_customer_password", "manual_transaction", "transaction_import",
+			}),
+			TransactionThreshold: getDecimalEnv("APPROVAL_TRANSACTION_THRESHOLD", decimal.NewFromInt(10000)),
+			Window:               getDurationEnv("APPROVAL_WINDOW", 24*time.Hour),
+			PollInterval:         getDurationEnv("APPROVAL_EXPIRY_POLL_INTERVAL", time.Minute),
+			BatchSize:            getIntEnv("APPROVAL_EXPIRY_BATCH_SIZE", 100),
+		},
 	}
 
 	config.Server.CORSAllowOrigins = config.loadCORSAllowOrigins()
@@ -118,6 +312,15 @@ func Load() *Config {
 	if loadJWTKeysErr != nil {
 		log.Fatal("Failed to load RSA keys:", loadJWTKeysErr)
 	}
+	if err := config.loadJWTRotationKeys(); err != nil {
+		log.Fatal("Failed to load RSA rotation keys:", err)
+	}
+
+	var loadMFAKeyErr error
+	config.MFA.SecretKey, loadMFAKeyErr = config.loadMFASecretKey()
+	if loadMFAKeyErr != nil {
+		log.Fatal("Failed to load MFA secret key:", loadMFAKeyErr)
+	}
 
 	return config
 }
@@ -139,6 +342,16 @@ func (c *Config) IsTesting() bool {
 	return c.Server.Environment == "testing"
 }
 
+// defaultWorkerID identifies this process when claiming queue items. The hostname
+// and PID keep it unique across replicas and restarts on the same host.
+func defaultWorkerID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "worker"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -173,6 +386,44 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+func getDecimalEnv(key string, defaultValue decimal.Decimal) decimal.Decimal {
+	if value := os.Getenv(key); value != "" {
+		if d, err := decimal.NewFromString(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
+
+// getListEnv parses a comma-separated list, ignoring blank entries
+func getListEnv(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getTimeEnv parses a required RFC 3339 timestamp from the environment
+func getTimeEnv(key string) (time.Time, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%s must be set", key)
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be an RFC 3339 timestamp: %w", key, err)
+	}
+	return t, nil
+}
+
 // loadJWTKeys loads RSA keys for JWT signing and verification
 // Priority order:
 // 1. If JWT_PRIVATE_KEY and JWT_PUBLIC_KEY env vars are set, use them (works in all environments)
@@ -195,6 +446,34 @@ func (c *Config) loadJWTKeys() (*rsa.PrivateKey, *rsa.PublicKey, error) {
 	return GenerateRSAKeyPair()
 }
 
+// loadMFASecretKey loads the key MFA secrets are encrypted with from MFA_SECRET_KEY,
+// a base64-encoded 32-byte key. Production requires it; elsewhere a random key is
+// generated, so enrollments do not survive a restart.
+func (c *Config) loadMFASecretKey() ([]byte, error) {
+	keyB64 := os.Getenv("MFA_SECRET_KEY")
+	if keyB64 != "" {
+		key, err := base64.StdEncoding.DecodeString(keyB64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode MFA_SECRET_KEY: %w", err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("MFA_SECRET_KEY must be 32 bytes, got %d", len(key))
+		}
+		return key, nil
+	}
+
+	if c.IsProduction() {
+		return nil, fmt.Errorf("MFA_SECRET_KEY environment variable must be set in production environments")
+	}
+
+	log.Println("Development environment: generating a new MFA secret key (consider setting MFA_SECRET_KEY to keep MFA enrollments across restarts)")
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate MFA secret key: %w", err)
+	}
+	return key, nil
+}
+
 // loadKeysFromEnvVars loads RSA keys from base64-encoded environment variables
 func (c *Config) loadKeysFromEnvVars(privateKeyB64, publicKeyB64 string) (*rsa.PrivateKey, *rsa.PublicKey, error) {
 
@@ -221,6 +500,47 @@ func (c *Config) loadKeysFromEnvVars(privateKeyB64, publicKeyB64 string) (*rsa.P
 	return privateKey, publicKey, nil
 }
 
+// loadJWTRotationKeys loads the optional keys for a signing key rotation:
+// JWT_NEXT_PRIVATE_KEY with JWT_NEXT_KEY_ACTIVATES_AT schedules the next key, and
+// JWT_PREVIOUS_PUBLIC_KEY with JWT_KEY_ROTATED_AT keeps the replaced key
+// verifying. Keys are base64-encoded PEM and times are RFC 3339.
+func (c *Config) loadJWTRotationKeys() error {
+	if nextKeyB64 := os.Getenv("JWT_NEXT_PRIVATE_KEY"); nextKeyB64 != "" {
+		nextKeyBytes, err := base64.StdEncoding.DecodeString(nextKeyB64)
+		if err != nil {
+			return fmt.Errorf("failed to decode JWT_NEXT_PRIVATE_KEY: %w", err)
+		}
+		c.JWT.NextPrivateKey, err = loadRSAPrivateKey(nextKeyBytes)
+		if err != nil {
+			return fmt.Errorf("failed to parse next private key: %w", err)
+		}
+
+		c.JWT.NextKeyActivatesAt, err = getTimeEnv("JWT_NEXT_KEY_ACTIVATES_AT")
+		if err != nil {
+			return err
+		}
+		log.Printf("Next JWT signing key scheduled to activate at %s", c.JWT.NextKeyActivatesAt.Format(time.RFC3339))
+	}
+
+	if previousKeyB64 := os.Getenv("JWT_PREVIOUS_PUBLIC_KEY"); previousKeyB64 != "" {
+		previousKeyBytes, err := base64.StdEncoding.DecodeString(previousKeyB64)
+		if err != nil {
+			return fmt.Errorf("failed to decode JWT_PREVIOUS_PUBLIC_KEY: %w", err)
+		}
+		c.JWT.PreviousPublicKey, err = loadRSAPublicKey(previousKeyBytes)
+		if err != nil {
+			return fmt.Errorf("failed to parse previous public key: %w", err)
+		}
+
+		c.JWT.KeyRotatedAt, err = getTimeEnv("JWT_KEY_ROTATED_AT")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // loadCORSAllowOrigins retrieves CORS allowed origins from environment or returns default
 func (c *Config) loadCORSAllowOrigins() []string {
 	corsOrigins := os.Getenv("CORS_ALLOW_ORIGINS")
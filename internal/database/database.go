@@ -60,6 +60,8 @@ func (db *DB) AutoMigrate() error {
 		&models.Transaction{},
 		&models.Transfer{},
 		&models.ProcessingQueueItem{},
+		&models.TransactionCategory{},
+		&models.MerchantMapping{},
 	)
 }
 
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"array-assessment/internal/models"
 
 	"github.com/shopspring/decimal"
@@ -14,6 +16,7 @@ type CreateAccountRequest struct {
 	AccountNumber     string `json:"account_number" validate:"required"`
 	RoutingNumber     string `json:"routing_number" validate:"required"`
 	AccountHolderName string `json:"account_holder_name" validate:"required,min=1,max=100"`
+	Currency          string `json:"currency,omitempty" validate:"omitempty,len=3"`
 }
 
 // UpdateAccountStatusRequest represents the request payload for updating account status
@@ -35,6 +38,60 @@ type TransferRequest struct {
 	Description string `json:"description" validate:"required,min=1,max=255"`
 }
 
+// CreateTransferScheduleRequest represents the request payload for a recurring transfer.
+// The schedule ends at the end date or after the given number of occurrences,
+// whichever comes first; without either it runs until cancelled.
+type CreateTransferScheduleRequest struct {
+	FromAccountID  string     `json:"fromAccountId" validate:"required,uuid"`
+	ToAccountID    string     `json:"toAccountId" validate:"required,uuid"`
+	Amount         string     `json:"amount" validate:"required"`
+	Description    string     `json:"description" validate:"required,min=1,max=255"`
+	Frequency      string     `json:"frequency" validate:"required,oneof=weekly biweekly monthly day_of_month"`
+	DayOfMonth     *int       `json:"dayOfMonth,omitempty" validate:"required_if=Frequency day_of_month,omitempty,min=1,max=31"`
+	StartDate      time.Time  `json:"startDate" validate:"required"`
+	EndDate        *time.Time `json:"endDate,omitempty"`
+	MaxOccurrences *int       `json:"maxOccurrences,omitempty" validate:"omitempty,min=1"`
+}
+
+// PlaceHoldRequest represents the request payload for placing an authorization hold.
+// Without an expiry the hold lasts models.DefaultHoldDuration.
+type PlaceHoldRequest struct {
+	Amount      string     `json:"amount" validate:"required"`
+	Description string     `json:"description" validate:"required,min=1,max=255"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+}
+
+// CaptureHoldRequest represents the request payload for capturing a hold.
+// Without an amount the full held amount is captured.
+type CaptureHoldRequest struct {
+	Amount string `json:"amount,omitempty"`
+}
+
+// CreateExternalTransferRequest represents the request payload for a transfer to or
+// from an account at another institution. Outbound transfers send funds from our
+// account to the counterparty; inbound transfers pull funds from the counterparty.
+type CreateExternalTransferRequest struct {
+	Direction    string                       `json:"direction" validate:"required,oneof=outbound inbound"`
+	Amount       string                       `json:"amount" validate:"required"`
+	Description  string                       `json:"description" validate:"required,min=1,max=255"`
+	Counterparty ExternalTransferCounterparty `json:"counterparty" validate:"required"`
+}
+
+// ExternalTransferCounterparty identifies the account at the other institution
+type ExternalTransferCounterparty struct {
+	AccountHolderName string `json:"accountHolderName" validate:"required,min=1,max=100"`
+	AccountNumber     string `json:"accountNumber" validate:"required,numeric,min=4,max=17"`
+	RoutingNumber     string `json:"routingNumber" validate:"required,numeric,len=9"`
+	InstitutionName   string `json:"institutionName,omitempty" validate:"omitempty,max=255"`
+}
+
+// ReturnExternalTransferRequest represents the request payload for recording an
+// ACH return. Without a reason the return code's description is used.
+type ReturnExternalTransferRequest struct {
+	ReturnCode string `json:"returnCode" validate:"required,len=3"`
+	Reason     string `json:"reason,omitempty" validate:"omitempty,max=255"`
+}
+
 // Account Response DTOs
 
 // CreateAccountResponse represents the response after creating an account
@@ -82,9 +139,14 @@ type MessageResponse struct {
 type TransferResponse struct {
 	Message             string  `json:"message"`
 	TransferID          string  `json:"transferId"`
+	Status              string  `json:"status"`
 	FromAccountID       string  `json:"fromAccountId"`
 	ToAccountID         string  `json:"toAccountId"`
 	Amount              string  `json:"amount"`
+	FromCurrency        string  `json:"fromCurrency"`
+	ToCurrency          string  `json:"toCurrency"`
+	ConvertedAmount     *string `json:"convertedAmount,omitempty"`
+	ExchangeRate        *string `json:"exchangeRate,omitempty"`
 	DebitTransactionID  *string `json:"debitTransactionId,omitempty"`
 	CreditTransactionID *string `json:"creditTransactionId,omitempty"`
 }
@@ -101,3 +163,28 @@ type PaginationMeta struct {
 	Limit int   `json:"limit"`
 	Total int64 `json:"total"`
 }
+
+// SetTransactionLimitsRequest represents the request payload for an account type's or
+// customer's transaction limits. Omitted amounts are unlimited for an account type;
+// for a customer they fall back to the account type's limits.
+type SetTransactionLimitsRequest struct {
+	DailyWithdrawalLimit   *string `json:"dailyWithdrawalLimit,omitempty"`
+	MonthlyWithdrawalLimit *string `json:"monthlyWithdrawalLimit,omitempty"`
+	MaxSingleTransfer      *string `json:"maxSingleTransfer,omitempty"`
+	HourlyTransactionLimit *int    `json:"hourlyTransactionLimit,omitempty" validate:"omitempty,min=1"`
+}
+
+// GrantLimitOverrideRequest represents the request payload for a temporary override
+// of one of an account's limits
+type GrantLimitOverrideRequest struct {
+	LimitType string    `json:"limitType" validate:"required,oneof=daily_withdrawal monthly_withdrawal single_transfer hourly_transactions"`
+	Value     string    `json:"value" validate:"required"`
+	Reason    string    `json:"reason" validate:"required,min=1,max=255"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+}
+
+// FraudReviewDecisionRequest represents an admin's approval or rejection of an item
+// held by fraud screening
+type FraudReviewDecisionRequest struct {
+	Reason string `json:"reason" validate:"required,min=1,max=500"`
+}
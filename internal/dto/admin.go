@@ -21,6 +21,15 @@ type ListUsersRequest struct {
 	Limit  int `query:"limit" validate:"min=1,max=100"`
 }
 
+// InterestBackfillRequest represents a request to accrue interest for missed days.
+// Dates are calendar days in UTC (YYYY-MM-DD) and the range is inclusive. Without an
+// account every savings and money market account is backfilled.
+type InterestBackfillRequest struct {
+	AccountID *uuid.UUID `json:"accountId,omitempty"`
+	StartDate string     `json:"startDate" validate:"required,datetime=2006-01-02"`
+	EndDate   string     `json:"endDate" validate:"required,datetime=2006-01-02"`
+}
+
 // Admin Response DTOs
 
 // UserResponse represents a user in admin API responses
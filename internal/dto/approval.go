@@ -0,0 +1,20 @@
+package dto
+
+import "array-assessment/internal/models"
+
+// Approval Request DTOs
+
+// ApprovalDecisionRequest represents a second admin's approval or rejection of an
+// action held for maker-checker approval
+type ApprovalDecisionRequest struct {
+	Reason string `json:"reason" validate:"required,min=1,max=500"`
+}
+
+// Approval Response DTOs
+
+// ApprovalDecisionResponse is a decided action. Result carries what an approved
+// action returned, such as a temporary password, and is shown only to the approver.
+type ApprovalDecisionResponse struct {
+	Action *models.PendingAction  `json:"action"`
+	Result map[string]interface{} `json:"result,omitempty"`
+}
@@ -12,10 +12,12 @@ type RegisterRequest struct {
 	LastName  string `json:"lastName" validate:"required,min=1,max=100"`
 }
 
-// LoginRequest contains login credentials
+// LoginRequest contains login credentials. DeviceName optionally names the
+// session the login starts, as shown in the session list.
 type LoginRequest struct {
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required"`
+	Email      string `json:"email" validate:"required,email"`
+	Password   string `json:"password" validate:"required"`
+	DeviceName string `json:"deviceName,omitempty" validate:"omitempty,max=100"`
 }
 
 // RefreshTokenRequest contains refresh token for renewal
@@ -28,11 +30,23 @@ type RefreshTokenRequest struct {
 // TokenResponse contains authentication tokens
 type TokenResponse struct {
 	AccessToken  string    `json:"accessToken"`
-	RefreshToken string    `json:"refreshToken"`
+	RefreshToken string    `json:"refreshToken,omitempty"`
 	TokenType    string    `json:"tokenType"`
 	ExpiresAt    time.Time `json:"expiresAt"`
 }
 
+// LoginResponse is returned by login. It carries tokens, or, for users enrolled in
+// or required to use MFA, a challenge token to exchange for tokens with a code.
+// RecoveryCodes is only set when MFA enrollment is completed during login.
+type LoginResponse struct {
+	*TokenResponse
+	MFARequired           bool       `json:"mfaRequired,omitempty"`
+	MFAEnrollmentRequired bool       `json:"mfaEnrollmentRequired,omitempty"`
+	ChallengeToken        string     `json:"challengeToken,omitempty"`
+	ChallengeExpiresAt    *time.Time `json:"challengeExpiresAt,omitempty"`
+	RecoveryCodes         []string   `json:"recoveryCodes,omitempty"`
+}
+
 // UserProfileResponse represents the authenticated user's profile
 type UserProfileResponse struct {
 	ID        string    `json:"id"`
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Category Request DTOs
+
+// CreateCategoryRequest represents a request to create a transaction category
+type CreateCategoryRequest struct {
+	Code               string  `json:"code" validate:"required,min=1,max=50"`
+	Name               string  `json:"name" validate:"required,min=1,max=100"`
+	Description        string  `json:"description" validate:"max=500"`
+	ParentCategoryCode *string `json:"parent_category_code,omitempty" validate:"omitempty,max=50"`
+	Icon               string  `json:"icon" validate:"max=50"`
+	DisplayOrder       int     `json:"display_order" validate:"min=0"`
+}
+
+// UpdateCategoryRequest represents a partial update of a transaction category.
+// An empty parent_category_code moves the category to the top level.
+type UpdateCategoryRequest struct {
+	Name               *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Description        *string `json:"description,omitempty" validate:"omitempty,max=500"`
+	ParentCategoryCode *string `json:"parent_category_code,omitempty" validate:"omitempty,max=50"`
+	Icon               *string `json:"icon,omitempty" validate:"omitempty,max=50"`
+	IsActive           *bool   `json:"is_active,omitempty"`
+	DisplayOrder       *int    `json:"display_order,omitempty" validate:"omitempty,min=0"`
+}
+
+// CategoryPositionRequest places a single category in the hierarchy
+type CategoryPositionRequest struct {
+	Code               string  `json:"code" validate:"required,max=50"`
+	ParentCategoryCode *string `json:"parent_category_code,omitempty" validate:"omitempty,max=50"`
+	DisplayOrder       int     `json:"display_order" validate:"min=0"`
+}
+
+// ReorderCategoriesRequest represents a request to move and reorder categories
+type ReorderCategoriesRequest struct {
+	Categories []CategoryPositionRequest `json:"categories" validate:"required,min=1,dive"`
+}
+
+// CreateMerchantMappingRequest represents a request to create a categorization rule
+type CreateMerchantMappingRequest struct {
+	MerchantPattern string   `json:"merchant_pattern" validate:"required,min=1,max=255"`
+	NormalizedName  string   `json:"normalized_name" validate:"max=255"`
+	CategoryCode    string   `json:"category_code" validate:"required,max=50"`
+	MCCCode         *string  `json:"mcc_code,omitempty" validate:"omitempty,max=10"`
+	MatchType       string   `json:"match_type" validate:"required,oneof=EXACT PARTIAL FUZZY REGEX MCC KEYWORD"`
+	ConfidenceScore *float64 `json:"confidence_score,omitempty" validate:"omitempty,min=0,max=1"`
+}
+
+// UpdateMerchantMappingRequest represents a partial update of a categorization rule
+type UpdateMerchantMappingRequest struct {
+	MerchantPattern *string  `json:"merchant_pattern,omitempty" validate:"omitempty,min=1,max=255"`
+	NormalizedName  *string  `json:"normalized_name,omitempty" validate:"omitempty,max=255"`
+	CategoryCode    *string  `json:"category_code,omitempty" validate:"omitempty,max=50"`
+	MCCCode         *string  `json:"mcc_code,omitempty" validate:"omitempty,max=10"`
+	MatchType       *string  `json:"match_type,omitempty" validate:"omitempty,oneof=EXACT PARTIAL FUZZY REGEX MCC KEYWORD"`
+	ConfidenceScore *float64 `json:"confidence_score,omitempty" validate:"omitempty,min=0,max=1"`
+	IsActive        *bool    `json:"is_active,omitempty"`
+}
+
+// TestCategorizationRequest represents a sample transaction to run through the categorization rules
+type TestCategorizationRequest struct {
+	Description  string `json:"description" validate:"max=255"`
+	MerchantName string `json:"merchant_name" validate:"max=255"`
+	MCCCode      string `json:"mcc_code" validate:"max=10"`
+}
+
+// StartRecategorizationRequest represents a request to re-run the categorization rules
+// over historical transactions. Without an account or date range every transaction is
+// in scope.
+type StartRecategorizationRequest struct {
+	AccountID *uuid.UUID `json:"account_id,omitempty"`
+	StartDate *time.Time `json:"start_date,omitempty"`
+	EndDate   *time.Time `json:"end_date,omitempty"`
+	DryRun    bool       `json:"dry_run"`
+	BatchSize int        `json:"batch_size,omitempty" validate:"omitempty,min=1,max=5000"`
+}
@@ -11,6 +11,7 @@ import (
 // SearchCustomersRequest represents the request to search for customers
 type SearchCustomersRequest struct {
 	Query  string `query:"q" validate:"required,min=1"`
+	Type   string `query:"type"`
 	Limit  int    `query:"limit" validate:"omitempty,min=1,max=1000"`
 	Offset int    `query:"offset" validate:"omitempty,min=0"`
 }
@@ -34,6 +35,7 @@ type CustomerSearchResult struct {
 	AccountCount int        `json:"accountCount"`
 	LastLoginAt  *time.Time `json:"lastLoginAt"`
 	CreatedAt    time.Time  `json:"createAt"`
+	Score        float64    `json:"score"`
 }
 
 // GetCustomerProfileResponse represents the detailed customer profile
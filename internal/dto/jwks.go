@@ -0,0 +1,21 @@
+package dto
+
+// JWKS Response DTOs
+
+// JWK is an RSA public key in JSON Web Key format (RFC 7517). N and E are the
+// base64url-encoded modulus and exponent, and Kid matches the kid header of the
+// tokens the key signed.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKSResponse is the JSON Web Key Set of keys currently accepted for verifying
+// tokens, including a scheduled key that has not started signing yet
+type JWKSResponse struct {
+	Keys []JWK `json:"keys"`
+}
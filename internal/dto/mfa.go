@@ -0,0 +1,53 @@
+package dto
+
+import "time"
+
+// MFA Request DTOs
+
+// MFALoginRequest exchanges a login challenge for tokens. Code is a code from the
+// authenticator app or one of the user's recovery codes.
+type MFALoginRequest struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+	Code           string `json:"code" validate:"required,min=6,max=20"`
+	DeviceName     string `json:"deviceName,omitempty" validate:"omitempty,max=100"`
+}
+
+// MFAChallengeRequest starts MFA enrollment during login for users required to use MFA
+type MFAChallengeRequest struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+}
+
+// MFACodeRequest carries a code from the authenticator app or a recovery code
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required,min=6,max=20"`
+}
+
+// SetMFARequirementRequest makes MFA mandatory, or optional again, for a user
+type SetMFARequirementRequest struct {
+	Required *bool `json:"required" validate:"required"`
+}
+
+// MFA Response DTOs
+
+// MFAEnrollmentResponse contains a new authenticator secret. ProvisioningURI is an
+// otpauth:// URI to render as a QR code for authenticator apps to scan.
+type MFAEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioningUri"`
+	Digits          int    `json:"digits"`
+	Period          int    `json:"period"`
+}
+
+// MFARecoveryCodesResponse contains single-use recovery codes, shown only once
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// MFAStatusResponse describes a user's MFA enrollment
+type MFAStatusResponse struct {
+	Enabled                bool       `json:"enabled"`
+	Required               bool       `json:"required"`
+	EnrollmentPending      bool       `json:"enrollmentPending"`
+	ConfirmedAt            *time.Time `json:"confirmedAt,omitempty"`
+	RecoveryCodesRemaining int        `json:"recoveryCodesRemaining"`
+}
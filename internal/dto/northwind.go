@@ -97,7 +97,7 @@ type NorthWindTransferResponseData struct {
 }
 
 type NorthWindInitiateTransferRequest struct {
-	Amount             float64                  `json:"amount"`
+	Amount             string                   `json:"amount"` // decimal string, never a float
 	Currency           string                   `json:"currency"`
 	Direction          string                   `json:"direction"`
 	DestinationAccount NorthWindTransferAccount `json:"destination_account"`
@@ -132,6 +132,8 @@ type NorthWindTransferStatusResponse struct {
 	Fee                    decimal.Decimal                  `json:"fee"`           // use decimal for money
 	ExchangeRate           decimal.Decimal                  `json:"exchange_rate"` // use decimal for precision
 	RetryCount             int                              `json:"retry_count"`
+	ReturnCode             string                           `json:"return_code,omitempty"`
+	ReturnReason           string                           `json:"return_reason,omitempty"`
 	SourceAccount          NorthWindTransferAccount         `json:"source_account"`
 	DestinationAccount     NorthWindTransferAccount         `json:"destination_account"`
 	StatusHistory          []NorthWindTransferStatusHistory `json:"status_history"`
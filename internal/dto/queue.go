@@ -2,10 +2,29 @@ package dto
 
 // QueueMetrics represents metrics for the processing queue
 type QueueMetrics struct {
-	PendingCount    int64   `json:"pendingCount"`
-	ProcessingCount int64   `json:"processingCount"`
-	CompletedCount  int64   `json:"completedCount"`
-	FailedCount     int64   `json:"failedCount"`
-	AvgProcessingMs float64 `json:"avgProcessingMs"`
-	OldestPending   *string `json:"oldestPending,omitempty"`
+	PendingCount      int64            `json:"pendingCount"`
+	ProcessingCount   int64            `json:"processingCount"`
+	CompletedCount    int64            `json:"completedCount"`
+	FailedCount       int64            `json:"failedCount"`
+	FailedByOperation map[string]int64 `json:"failedByOperation"`
+	AvgProcessingMs   float64          `json:"avgProcessingMs"`
+	OldestPending     *string          `json:"oldestPending,omitempty"`
+}
+
+// ReplayQueueItemsRequest represents a request to replay failed queue items
+type ReplayQueueItemsRequest struct {
+	IDs []string `json:"ids" validate:"required,min=1,max=100,dive,uuid"`
+}
+
+// PurgeQueueItemsRequest represents a request to purge failed queue items
+type PurgeQueueItemsRequest struct {
+	IDs    []string `json:"ids" validate:"required,min=1,max=100,dive,uuid"`
+	Reason string   `json:"reason" validate:"required,min=1,max=500"`
+}
+
+// QueueItemsActionResponse reports which queue items a replay or purge affected.
+// Items that do not exist or are not failed are skipped.
+type QueueItemsActionResponse struct {
+	ItemIDs    []string `json:"itemIds"`
+	SkippedIDs []string `json:"skippedIds"`
 }
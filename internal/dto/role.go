@@ -0,0 +1,22 @@
+package dto
+
+// Role Request DTOs
+
+// AssignRoleRequest changes the role a user is assigned
+type AssignRoleRequest struct {
+	Role string `json:"role" validate:"required,max=20"`
+}
+
+// Role Response DTOs
+
+// RoleResponse describes a role and the permissions it grants
+type RoleResponse struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// RoleListResponse lists every role
+type RoleListResponse struct {
+	Roles []RoleResponse `json:"roles"`
+}
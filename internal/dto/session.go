@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session Response DTOs
+
+// SessionResponse describes a signed-in device. IPAddress and UserAgent are from
+// the session's most recent use. Current marks the session making the request.
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	DeviceName string    `json:"deviceName"`
+	IPAddress  string    `json:"ipAddress"`
+	UserAgent  string    `json:"userAgent"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Current    bool      `json:"current"`
+}
+
+// SessionListResponse lists a user's active sessions, most recently used first
+type SessionListResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// RevokeSessionsResponse reports how many sessions were signed out
+type RevokeSessionsResponse struct {
+	RevokedCount int `json:"revokedCount"`
+}
@@ -3,7 +3,10 @@ package dto
 import (
 	"time"
 
+	"array-assessment/internal/models"
+
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionFilters contains filtering options for transaction queries
@@ -51,3 +54,92 @@ type ListTransactionsResponse struct {
 	Transactions []TransactionWithBalance `json:"transactions"`
 	Pagination   PaginationInfo           `json:"pagination"`
 }
+
+// OverrideTransactionCategoryRequest represents a manual correction of a transaction's category
+type OverrideTransactionCategoryRequest struct {
+	Category      string `json:"category" validate:"required,max=50"`
+	Reason        string `json:"reason" validate:"required,min=1,max=500"`
+	Version       *int   `json:"version,omitempty" validate:"omitempty,min=1"`
+	CreateRule    bool   `json:"createRule"`
+	RuleMatchType string `json:"ruleMatchType,omitempty" validate:"omitempty,oneof=EXACT PARTIAL"`
+}
+
+// OverrideTransactionCategoryResponse represents the result of a category override
+type OverrideTransactionCategoryResponse struct {
+	Transaction      *models.Transaction     `json:"transaction"`
+	PreviousCategory string                  `json:"previousCategory"`
+	MerchantMapping  *models.MerchantMapping `json:"merchantMapping,omitempty"`
+}
+
+// ReverseTransactionRequest represents an admin request to reverse a completed transaction
+type ReverseTransactionRequest struct {
+	Reason string `json:"reason" validate:"required,min=1,max=500"`
+}
+
+// ReverseTransactionResponse represents a reversal accepted for processing. For a
+// transfer leg, both legs are reversed.
+type ReverseTransactionResponse struct {
+	TransactionID  string   `json:"transactionId"`
+	TransferID     *string  `json:"transferId,omitempty"`
+	TransactionIDs []string `json:"transactionIds"`
+	Reason         string   `json:"reason"`
+	Status         string   `json:"status"`
+}
+
+// Transaction import modes
+const (
+	// ImportModeAtomic applies each account's rows all or nothing
+	ImportModeAtomic = "atomic"
+	// ImportModePartial applies every row that can be applied
+	ImportModePartial = "partial"
+)
+
+// Transaction import row statuses
+const (
+	ImportRowImported = "imported"
+	ImportRowValid    = "valid"
+	ImportRowRejected = "rejected"
+	ImportRowFailed   = "failed"
+	ImportRowSkipped  = "skipped"
+)
+
+// ImportTransactionsOptions controls how a transaction import file is applied
+type ImportTransactionsOptions struct {
+	Format     string
+	Mode       string
+	DryRun     bool
+	ImportedBy uuid.UUID
+}
+
+// TransactionImportRow reports what happened to one row of an import file. Row
+// is the line of the file the row starts on. Rows are imported, or valid on a dry
+// run; rejected when they fail validation; failed when the account cannot take
+// them; or skipped when another row for the same account stopped an atomic import.
+type TransactionImportRow struct {
+	Row           int        `json:"row"`
+	AccountNumber string     `json:"accountNumber,omitempty"`
+	Reference     string     `json:"reference,omitempty"`
+	Status        string     `json:"status"`
+	TransactionID *uuid.UUID `json:"transactionId,omitempty"`
+	Category      string     `json:"category,omitempty"`
+	BalanceAfter  string     `json:"balanceAfter,omitempty"`
+	Errors        []string   `json:"errors,omitempty"`
+}
+
+// TransactionImportReport is the row-by-row result of a transaction import.
+// Accepted counts the rows imported, or that would be imported on a dry run.
+// LargestAmount is the largest amount on any row and TotalAmount the sum of the
+// positive amounts on every row, valid or not.
+type TransactionImportReport struct {
+	DryRun        bool                   `json:"dryRun"`
+	Mode          string                 `json:"mode"`
+	Format        string                 `json:"format"`
+	TotalRows     int                    `json:"totalRows"`
+	Accepted      int                    `json:"accepted"`
+	Rejected      int                    `json:"rejected"`
+	Failed        int                    `json:"failed"`
+	Skipped       int                    `json:"skipped"`
+	LargestAmount decimal.Decimal        `json:"largestAmount"`
+	TotalAmount   decimal.Decimal        `json:"totalAmount"`
+	Rows          []TransactionImportRow `json:"rows"`
+}
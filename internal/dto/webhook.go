@@ -0,0 +1,27 @@
+package dto
+
+import "array-assessment/internal/models"
+
+// CreateWebhookSubscriptionRequest represents the request payload for a webhook
+// subscription. EventTypes selects which events are sent to the URL.
+type CreateWebhookSubscriptionRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=2048"`
+	Description string   `json:"description,omitempty" validate:"max=255"`
+	EventTypes  []string `json:"eventTypes" validate:"required,min=1,dive,required"`
+}
+
+// UpdateWebhookSubscriptionRequest represents changes to a webhook subscription.
+// Omitted fields are left unchanged.
+type UpdateWebhookSubscriptionRequest struct {
+	URL         *string  `json:"url,omitempty" validate:"omitempty,url,max=2048"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=255"`
+	EventTypes  []string `json:"eventTypes,omitempty" validate:"omitempty,min=1,dive,required"`
+	Active      *bool    `json:"active,omitempty"`
+}
+
+// WebhookSubscriptionCreatedResponse is a newly created subscription together with
+// the secret its payloads are signed with. The secret is not shown again.
+type WebhookSubscriptionCreatedResponse struct {
+	*models.WebhookSubscription
+	Secret string `json:"secret"`
+}
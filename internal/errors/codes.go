@@ -11,6 +11,12 @@ const (
 	AuthInvalidTokenFormat     ErrorCode = "AUTH_004"
 	AuthInsufficientPermission ErrorCode = "AUTH_005"
 	AuthAccountLocked          ErrorCode = "AUTH_006"
+	AuthInvalidMFACode         ErrorCode = "AUTH_007"
+	AuthStepUpRequired         ErrorCode = "AUTH_008"
+	AuthMFAAlreadyEnabled      ErrorCode = "AUTH_009"
+	AuthMFANotEnabled          ErrorCode = "AUTH_010"
+	AuthMFAEnforced            ErrorCode = "AUTH_011"
+	AuthSessionNotFound        ErrorCode = "AUTH_012"
 )
 
 // Validation error codes (VALIDATION_*)
@@ -35,11 +41,13 @@ const (
 
 // Account error codes (ACCOUNT_*)
 const (
-	AccountNotFound              ErrorCode = "ACCOUNT_001"
-	AccountInactive              ErrorCode = "ACCOUNT_002"
-	AccountInsufficientBalance   ErrorCode = "ACCOUNT_003"
-	AccountInvalidNumber         ErrorCode = "ACCOUNT_004"
-	AccountOperationNotPermitted ErrorCode = "ACCOUNT_005"
+	AccountNotFound                ErrorCode = "ACCOUNT_001"
+	AccountInactive                ErrorCode = "ACCOUNT_002"
+	AccountInsufficientBalance     ErrorCode = "ACCOUNT_003"
+	AccountInvalidNumber           ErrorCode = "ACCOUNT_004"
+	AccountOperationNotPermitted   ErrorCode = "ACCOUNT_005"
+	AccountUnsupportedCurrency     ErrorCode = "ACCOUNT_006"
+	AccountExchangeRateUnavailable ErrorCode = "ACCOUNT_007"
 )
 
 // Transaction error codes (TRANSACTION_*)
@@ -50,6 +58,12 @@ const (
 	TransactionDuplicate         ErrorCode = "TRANSACTION_004"
 	TransactionValidationFailed  ErrorCode = "TRANSACTION_005"
 	TransactionInvalidType       ErrorCode = "TRANSACTION_006"
+	TransactionVersionConflict   ErrorCode = "TRANSACTION_007"
+	TransactionCategoryUnchanged ErrorCode = "TRANSACTION_008"
+	TransactionHoldNotFound      ErrorCode = "TRANSACTION_009"
+	TransactionHoldNotActive     ErrorCode = "TRANSACTION_010"
+	TransactionAlreadyReversed   ErrorCode = "TRANSACTION_011"
+	TransactionNotReversible     ErrorCode = "TRANSACTION_012"
 )
 
 // Transfer error codes (TRANSFER_*)
@@ -60,6 +74,57 @@ const (
 	TransferNotFound          ErrorCode = "TRANSFER_004"
 	TransferInsufficientFunds ErrorCode = "TRANSFER_005"
 	TransferInvalidAmount     ErrorCode = "TRANSFER_006"
+	TransferScheduleNotFound  ErrorCode = "TRANSFER_007"
+	TransferScheduleState     ErrorCode = "TRANSFER_008"
+	ExternalTransferNotFound  ErrorCode = "TRANSFER_009"
+	ExternalTransferState     ErrorCode = "TRANSFER_010"
+	ExternalAccountUnverified ErrorCode = "TRANSFER_011"
+)
+
+// Category error codes (CATEGORY_*)
+const (
+	CategoryNotFound             ErrorCode = "CATEGORY_001"
+	CategoryAlreadyExists        ErrorCode = "CATEGORY_002"
+	CategoryInvalidParent        ErrorCode = "CATEGORY_003"
+	CategoryProtected            ErrorCode = "CATEGORY_004"
+	MerchantMappingNotFound      ErrorCode = "CATEGORY_005"
+	RecategorizationNotFound     ErrorCode = "CATEGORY_006"
+	RecategorizationInvalidState ErrorCode = "CATEGORY_007"
+)
+
+// Limit error codes (LIMIT_*)
+const (
+	LimitExceeded          ErrorCode = "LIMIT_001"
+	LimitNotConfigured     ErrorCode = "LIMIT_002"
+	LimitOverrideNotFound  ErrorCode = "LIMIT_003"
+	LimitOverrideNotActive ErrorCode = "LIMIT_004"
+)
+
+// Fraud review error codes (FRAUD_*)
+const (
+	FraudReviewNotFound   ErrorCode = "FRAUD_001"
+	FraudReviewNotPending ErrorCode = "FRAUD_002"
+	FraudReviewExpired    ErrorCode = "FRAUD_003"
+)
+
+// Queue error codes (QUEUE_*)
+const (
+	QueueItemNotFound ErrorCode = "QUEUE_001"
+)
+
+// Webhook error codes (WEBHOOK_*)
+const (
+	WebhookSubscriptionNotFound ErrorCode = "WEBHOOK_001"
+	WebhookDeliveryNotFound     ErrorCode = "WEBHOOK_002"
+	WebhookDeliveryPending      ErrorCode = "WEBHOOK_003"
+)
+
+// Approval error codes (APPROVAL_*)
+const (
+	ApprovalNotFound   ErrorCode = "APPROVAL_001"
+	ApprovalNotPending ErrorCode = "APPROVAL_002"
+	ApprovalExpired    ErrorCode = "APPROVAL_003"
+	ApprovalOwnRequest ErrorCode = "APPROVAL_004"
 )
 
 // System error codes (SYSTEM_*)
@@ -87,6 +152,12 @@ var errorMessages = map[ErrorCode]string{
 	AuthInvalidTokenFormat:     "Invalid authorization token format",
 	AuthInsufficientPermission: "Insufficient permissions to access this resource",
 	AuthAccountLocked:          "Account is locked or disabled",
+	AuthInvalidMFACode:         "Invalid or expired verification code",
+	AuthStepUpRequired:         "Recent multi-factor verification is required for this operation",
+	AuthMFAAlreadyEnabled:      "Multi-factor authentication is already enabled",
+	AuthMFANotEnabled:          "Multi-factor authentication is not enabled",
+	AuthMFAEnforced:            "Multi-factor authentication is required for this user",
+	AuthSessionNotFound:        "Session not found",
 
 	// Validation errors
 	ValidationGeneral:       "Validation failed",
@@ -105,11 +176,13 @@ var errorMessages = map[ErrorCode]string{
 	CustomerNoResults:     "Customer search returned no results",
 
 	// Account errors
-	AccountNotFound:              "Account not found",
-	AccountInactive:              "Account is closed or inactive",
-	AccountInsufficientBalance:   "Insufficient account balance",
-	AccountInvalidNumber:         "Invalid account number or type",
-	AccountOperationNotPermitted: "Account operation not permitted",
+	AccountNotFound:                "Account not found",
+	AccountInactive:                "Account is closed or inactive",
+	AccountInsufficientBalance:     "Insufficient account balance",
+	AccountInvalidNumber:           "Invalid account number or type",
+	AccountOperationNotPermitted:   "Account operation not permitted",
+	AccountUnsupportedCurrency:     "Unsupported currency",
+	AccountExchangeRateUnavailable: "No exchange rate is available for this currency pair",
 
 	// Transaction errors
 	TransactionNotFound:          "Transaction not found",
@@ -118,6 +191,12 @@ var errorMessages = map[ErrorCode]string{
 	TransactionDuplicate:         "Transaction with this idempotency key already exists",
 	TransactionValidationFailed:  "Transaction validation failed",
 	TransactionInvalidType:       "Invalid transaction type",
+	TransactionVersionConflict:   "Transaction was modified by another request, please retry",
+	TransactionCategoryUnchanged: "Transaction already has this category",
+	TransactionHoldNotFound:      "Hold not found",
+	TransactionHoldNotActive:     "Hold has already been captured, released or expired",
+	TransactionAlreadyReversed:   "Transaction has already been reversed or has a reversal in progress",
+	TransactionNotReversible:     "Only completed transactions can be reversed",
 
 	// Transfer errors
 	TransferSameAccount:       "Cannot transfer to the same account",
@@ -126,6 +205,45 @@ var errorMessages = map[ErrorCode]string{
 	TransferNotFound:          "Transfer not found",
 	TransferInsufficientFunds: "Source account has insufficient balance for this transfer",
 	TransferInvalidAmount:     "Invalid transfer amount",
+	TransferScheduleNotFound:  "Transfer schedule not found",
+	TransferScheduleState:     "Transfer schedule cannot be changed in its current state",
+	ExternalTransferNotFound:  "External transfer not found",
+	ExternalTransferState:     "External transfer cannot be changed in its current state",
+	ExternalAccountUnverified: "External account could not be verified",
+
+	// Category errors
+	CategoryNotFound:             "Category not found",
+	CategoryAlreadyExists:        "A category with this code already exists",
+	CategoryInvalidParent:        "Invalid parent category",
+	CategoryProtected:            "This category cannot be deactivated",
+	MerchantMappingNotFound:      "Merchant mapping not found",
+	RecategorizationNotFound:     "Recategorization job not found",
+	RecategorizationInvalidState: "Recategorization job cannot be changed in its current state",
+
+	// Limit errors
+	LimitExceeded:          "Transaction limit exceeded",
+	LimitNotConfigured:     "No transaction limits configured",
+	LimitOverrideNotFound:  "Limit override not found",
+	LimitOverrideNotActive: "Limit override has expired or been revoked",
+
+	// Fraud review errors
+	FraudReviewNotFound:   "Fraud review not found",
+	FraudReviewNotPending: "Fraud review has already been decided",
+	FraudReviewExpired:    "Fraud review expired before it was decided",
+
+	// Queue errors
+	QueueItemNotFound: "Queue item not found",
+
+	// Webhook errors
+	WebhookSubscriptionNotFound: "Webhook subscription not found",
+	WebhookDeliveryNotFound:     "Webhook delivery not found",
+	WebhookDeliveryPending:      "Webhook delivery has not finished its attempts yet",
+
+	// Approval errors
+	ApprovalNotFound:   "Approval request not found",
+	ApprovalNotPending: "Approval request has already been decided",
+	ApprovalExpired:    "Approval request expired before it was decided",
+	ApprovalOwnRequest: "Approval requests must be decided by a different admin",
 
 	// System errors
 	SystemInternalError:      "An unexpected error occurred. Please contact support with trace ID",
@@ -83,6 +83,12 @@ func (s *CodesTestSuite) TestIsValidErrorCode_ValidCodes() {
 		AuthInvalidTokenFormat,
 		AuthInsufficientPermission,
 		AuthAccountLocked,
+		AuthInvalidMFACode,
+		AuthStepUpRequired,
+		AuthMFAAlreadyEnabled,
+		AuthMFANotEnabled,
+		AuthMFAEnforced,
+		AuthSessionNotFound,
 		ValidationGeneral,
 		ValidationRequiredField,
 		ValidationInvalidFormat,
@@ -100,12 +106,42 @@ func (s *CodesTestSuite) TestIsValidErrorCode_ValidCodes() {
 		AccountInsufficientBalance,
 		AccountInvalidNumber,
 		AccountOperationNotPermitted,
+		AccountUnsupportedCurrency,
+		AccountExchangeRateUnavailable,
 		TransactionNotFound,
 		TransactionInvalidAmount,
 		TransactionInsufficientFunds,
 		TransactionDuplicate,
 		TransactionValidationFailed,
 		TransactionInvalidType,
+		TransactionVersionConflict,
+		TransactionCategoryUnchanged,
+		TransactionHoldNotFound,
+		TransactionHoldNotActive,
+		TransactionAlreadyReversed,
+		TransactionNotReversible,
+		CategoryNotFound,
+		CategoryAlreadyExists,
+		CategoryInvalidParent,
+		CategoryProtected,
+		MerchantMappingNotFound,
+		RecategorizationNotFound,
+		RecategorizationInvalidState,
+		LimitExceeded,
+		LimitNotConfigured,
+		LimitOverrideNotFound,
+		LimitOverrideNotActive,
+		FraudReviewNotFound,
+		FraudReviewNotPending,
+		FraudReviewExpired,
+		QueueItemNotFound,
+		WebhookSubscriptionNotFound,
+		WebhookDeliveryNotFound,
+		WebhookDeliveryPending,
+		ApprovalNotFound,
+		ApprovalNotPending,
+		ApprovalExpired,
+		ApprovalOwnRequest,
 		SystemInternalError,
 		SystemDatabaseError,
 		SystemServiceUnavailable,
@@ -146,6 +182,12 @@ func (s *CodesTestSuite) TestErrorCodeConstants_Uniqueness() {
 		AuthInvalidTokenFormat,
 		AuthInsufficientPermission,
 		AuthAccountLocked,
+		AuthInvalidMFACode,
+		AuthStepUpRequired,
+		AuthMFAAlreadyEnabled,
+		AuthMFANotEnabled,
+		AuthMFAEnforced,
+		AuthSessionNotFound,
 		ValidationGeneral,
 		ValidationRequiredField,
 		ValidationInvalidFormat,
@@ -163,12 +205,42 @@ func (s *CodesTestSuite) TestErrorCodeConstants_Uniqueness() {
 		AccountInsufficientBalance,
 		AccountInvalidNumber,
 		AccountOperationNotPermitted,
+		AccountUnsupportedCurrency,
+		AccountExchangeRateUnavailable,
 		TransactionNotFound,
 		TransactionInvalidAmount,
 		TransactionInsufficientFunds,
 		TransactionDuplicate,
 		TransactionValidationFailed,
 		TransactionInvalidType,
+		TransactionVersionConflict,
+		TransactionCategoryUnchanged,
+		TransactionHoldNotFound,
+		TransactionHoldNotActive,
+		TransactionAlreadyReversed,
+		TransactionNotReversible,
+		CategoryNotFound,
+		CategoryAlreadyExists,
+		CategoryInvalidParent,
+		CategoryProtected,
+		MerchantMappingNotFound,
+		RecategorizationNotFound,
+		RecategorizationInvalidState,
+		LimitExceeded,
+		LimitNotConfigured,
+		LimitOverrideNotFound,
+		LimitOverrideNotActive,
+		FraudReviewNotFound,
+		FraudReviewNotPending,
+		FraudReviewExpired,
+		QueueItemNotFound,
+		WebhookSubscriptionNotFound,
+		WebhookDeliveryNotFound,
+		WebhookDeliveryPending,
+		ApprovalNotFound,
+		ApprovalNotPending,
+		ApprovalExpired,
+		ApprovalOwnRequest,
 		SystemInternalError,
 		SystemDatabaseError,
 		SystemServiceUnavailable,
@@ -199,6 +271,12 @@ func (s *CodesTestSuite) TestErrorCodeConstants_Format() {
 				AuthInvalidTokenFormat,
 				AuthInsufficientPermission,
 				AuthAccountLocked,
+				AuthInvalidMFACode,
+				AuthStepUpRequired,
+				AuthMFAAlreadyEnabled,
+				AuthMFANotEnabled,
+				AuthMFAEnforced,
+				AuthSessionNotFound,
 			},
 		},
 		{
@@ -231,6 +309,8 @@ func (s *CodesTestSuite) TestErrorCodeConstants_Format() {
 				AccountInsufficientBalance,
 				AccountInvalidNumber,
 				AccountOperationNotPermitted,
+				AccountUnsupportedCurrency,
+				AccountExchangeRateUnavailable,
 			},
 		},
 		{
@@ -242,6 +322,56 @@ func (s *CodesTestSuite) TestErrorCodeConstants_Format() {
 				TransactionDuplicate,
 				TransactionValidationFailed,
 				TransactionInvalidType,
+				TransactionVersionConflict,
+				TransactionCategoryUnchanged,
+				TransactionHoldNotFound,
+				TransactionHoldNotActive,
+				TransactionAlreadyReversed,
+				TransactionNotReversible,
+			},
+		},
+		{
+			prefix: "CATEGORY_",
+			codes: []ErrorCode{
+				CategoryNotFound,
+				CategoryAlreadyExists,
+				CategoryInvalidParent,
+				CategoryProtected,
+				MerchantMappingNotFound,
+				RecategorizationNotFound,
+				RecategorizationInvalidState,
+			},
+		},
+		{
+			prefix: "LIMIT_",
+			codes: []ErrorCode{
+				LimitExceeded,
+				LimitNotConfigured,
+				LimitOverrideNotFound,
+				LimitOverrideNotActive,
+			},
+		},
+		{
+			prefix: "FRAUD_",
+			codes: []ErrorCode{
+				FraudReviewNotFound,
+				FraudReviewNotPending,
+				FraudReviewExpired,
+			},
+		},
+		{
+			prefix: "APPROVAL_",
+			codes: []ErrorCode{
+				ApprovalNotFound,
+				ApprovalNotPending,
+				ApprovalExpired,
+				ApprovalOwnRequest,
+			},
+		},
+		{
+			prefix: "QUEUE_",
+			codes: []ErrorCode{
+				QueueItemNotFound,
 			},
 		},
 		{
@@ -275,6 +405,12 @@ func (s *CodesTestSuite) TestAllErrorCodesHaveMessages() {
 		AuthInvalidTokenFormat,
 		AuthInsufficientPermission,
 		AuthAccountLocked,
+		AuthInvalidMFACode,
+		AuthStepUpRequired,
+		AuthMFAAlreadyEnabled,
+		AuthMFANotEnabled,
+		AuthMFAEnforced,
+		AuthSessionNotFound,
 		ValidationGeneral,
 		ValidationRequiredField,
 		ValidationInvalidFormat,
@@ -292,12 +428,42 @@ func (s *CodesTestSuite) TestAllErrorCodesHaveMessages() {
 		AccountInsufficientBalance,
 		AccountInvalidNumber,
 		AccountOperationNotPermitted,
+		AccountUnsupportedCurrency,
+		AccountExchangeRateUnavailable,
 		TransactionNotFound,
 		TransactionInvalidAmount,
 		TransactionInsufficientFunds,
 		TransactionDuplicate,
 		TransactionValidationFailed,
 		TransactionInvalidType,
+		TransactionVersionConflict,
+		TransactionCategoryUnchanged,
+		TransactionHoldNotFound,
+		TransactionHoldNotActive,
+		TransactionAlreadyReversed,
+		TransactionNotReversible,
+		CategoryNotFound,
+		CategoryAlreadyExists,
+		CategoryInvalidParent,
+		CategoryProtected,
+		MerchantMappingNotFound,
+		RecategorizationNotFound,
+		RecategorizationInvalidState,
+		LimitExceeded,
+		LimitNotConfigured,
+		LimitOverrideNotFound,
+		LimitOverrideNotActive,
+		FraudReviewNotFound,
+		FraudReviewNotPending,
+		FraudReviewExpired,
+		QueueItemNotFound,
+		WebhookSubscriptionNotFound,
+		WebhookDeliveryNotFound,
+		WebhookDeliveryPending,
+		ApprovalNotFound,
+		ApprovalNotPending,
+		ApprovalExpired,
+		ApprovalOwnRequest,
 		SystemInternalError,
 		SystemDatabaseError,
 		SystemServiceUnavailable,
@@ -126,32 +126,46 @@ func GetHTTPStatus(code ErrorCode) int {
 	case ValidationGeneral, ValidationRequiredField, ValidationInvalidFormat,
 		ValidationOutOfRange, ValidationInvalidEmail, ValidationInvalidPhone,
 		ValidationInvalidDate, CustomerInvalidID, TransactionInvalidAmount,
-		TransferSameAccount, TransferInvalidAmount:
+		TransferSameAccount, TransferInvalidAmount, AccountUnsupportedCurrency:
 		return http.StatusBadRequest
 
 	// 401 Unauthorized - Authentication failures
-	case AuthInvalidCredentials, AuthMissingToken, AuthExpiredToken, AuthInvalidTokenFormat:
+	case AuthInvalidCredentials, AuthMissingToken, AuthExpiredToken, AuthInvalidTokenFormat,
+		AuthInvalidMFACode:
 		return http.StatusUnauthorized
 
 	// 403 Forbidden - Authorization failures
-	case AuthInsufficientPermission, AuthAccountLocked:
+	case AuthInsufficientPermission, AuthAccountLocked, AuthStepUpRequired, AuthMFAEnforced,
+		ApprovalOwnRequest:
 		return http.StatusForbidden
 
 	// 404 Not Found - Resource not found
-	case CustomerNotFound, AccountNotFound, TransactionNotFound, TransferNotFound:
+	case CustomerNotFound, AccountNotFound, TransactionNotFound, TransferNotFound,
+		CategoryNotFound, MerchantMappingNotFound, RecategorizationNotFound,
+		TransferScheduleNotFound, TransactionHoldNotFound, QueueItemNotFound,
+		ExternalTransferNotFound, LimitNotConfigured, LimitOverrideNotFound,
+		FraudReviewNotFound, WebhookSubscriptionNotFound, WebhookDeliveryNotFound,
+		AuthSessionNotFound, ApprovalNotFound:
 		return http.StatusNotFound
 
 	// 409 Conflict - Resource state conflict
-	case TransferPending, TransferFailed:
+	case TransferPending, TransferFailed, TransactionVersionConflict,
+		RecategorizationInvalidState, TransferScheduleState, TransactionHoldNotActive,
+		TransactionAlreadyReversed, ExternalTransferState, LimitOverrideNotActive,
+		FraudReviewNotPending, FraudReviewExpired, WebhookDeliveryPending,
+		AuthMFAAlreadyEnabled, AuthMFANotEnabled, ApprovalNotPending, ApprovalExpired:
 		return http.StatusConflict
 
 	// 422 Unprocessable Entity - Semantic validation failures
 	case CustomerAlreadyExists, CustomerInactive, AccountInactive,
 		AccountInsufficientBalance, AccountOperationNotPermitted,
 		TransactionInsufficientFunds, TransactionDuplicate,
-		TransactionValidationFailed, TransactionInvalidType,
+		TransactionValidationFailed, TransactionInvalidType, TransactionCategoryUnchanged,
+		TransactionNotReversible,
 		AccountInvalidNumber, CustomerNoResults,
-		TransferInsufficientFunds:
+		TransferInsufficientFunds, CategoryAlreadyExists,
+		CategoryInvalidParent, CategoryProtected, AccountExchangeRateUnavailable,
+		ExternalAccountUnverified, LimitExceeded:
 		return http.StatusUnprocessableEntity
 
 	// 429 Too Many Requests - Rate limiting
@@ -235,21 +235,30 @@ func (s *ResponseTestSuite) TestGetHTTPStatus_AllErrorCodes() {
 		{"Auth Missing Token", AuthMissingToken, http.StatusUnauthorized},
 		{"Auth Expired Token", AuthExpiredToken, http.StatusUnauthorized},
 		{"Auth Invalid Token Format", AuthInvalidTokenFormat, http.StatusUnauthorized},
+		{"Auth Invalid MFA Code", AuthInvalidMFACode, http.StatusUnauthorized},
 
 		// 403 Forbidden
 		{"Auth Insufficient Permission", AuthInsufficientPermission, http.StatusForbidden},
 		{"Auth Account Locked", AuthAccountLocked, http.StatusForbidden},
+		{"Auth Step Up Required", AuthStepUpRequired, http.StatusForbidden},
+		{"Auth MFA Enforced", AuthMFAEnforced, http.StatusForbidden},
+		{"Approval Own Request", ApprovalOwnRequest, http.StatusForbidden},
 
 		// 404 Not Found
 		{"Customer Not Found", CustomerNotFound, http.StatusNotFound},
 		{"Account Not Found", AccountNotFound, http.StatusNotFound},
 		{"Transaction Not Found", TransactionNotFound, http.StatusNotFound},
+		{"Fraud Review Not Found", FraudReviewNotFound, http.StatusNotFound},
+		{"Auth Session Not Found", AuthSessionNotFound, http.StatusNotFound},
+		{"Webhook Subscription Not Found", WebhookSubscriptionNotFound, http.StatusNotFound},
+		{"Approval Not Found", ApprovalNotFound, http.StatusNotFound},
 
 		// 422 Unprocessable Entity
 		{"Customer Already Exists", CustomerAlreadyExists, http.StatusUnprocessableEntity},
 		{"Customer Inactive", CustomerInactive, http.StatusUnprocessableEntity},
 		{"Account Insufficient Balance", AccountInsufficientBalance, http.StatusUnprocessableEntity},
 		{"Transaction Duplicate", TransactionDuplicate, http.StatusUnprocessableEntity},
+		{"Limit Exceeded", LimitExceeded, http.StatusUnprocessableEntity},
 
 		// 429 Too Many Requests
 		{"System Rate Limit Exceeded", SystemRateLimitExceeded, http.StatusTooManyRequests},
@@ -19,14 +19,16 @@ import (
 // AccountHandler handles account-related HTTP requests
 type AccountHandler struct {
 	accountService   services.AccountServiceInterface
+	approvalService  services.ApprovalServiceInterface
 	auditLogger      services.AuditLoggerInterface
 	metricsCollector services.MetricsRecorderInterface
 }
 
 // NewAccountHandler creates a new account handler
-func NewAccountHandler(accountService services.AccountServiceInterface, auditLogger services.AuditLoggerInterface, metricsCollector services.MetricsRecorderInterface) *AccountHandler {
+func NewAccountHandler(accountService services.AccountServiceInterface, approvalService services.ApprovalServiceInterface, auditLogger services.AuditLoggerInterface, metricsCollector services.MetricsRecorderInterface) *AccountHandler {
 	return &AccountHandler{
 		accountService:   accountService,
+		approvalService:  approvalService,
 		auditLogger:      auditLogger,
 		metricsCollector: metricsCollector,
 	}
@@ -34,14 +36,14 @@ func NewAccountHandler(accountService services.AccountServiceInterface, auditLog
 
 // CreateAccount creates a new bank account for the authenticated user
 // @Summary Create a new account
-// @Description Create a new bank account (checking, savings, or money_market) with optional initial deposit
+// @Description Create a new bank account (checking, savings, or money_market) with optional initial deposit. The currency is an ISO-4217 code and defaults to USD.
 // @Tags Accounts
 // @Security BearerAuth
 // @Accept json
 // @Produce json
 // @Param request body dto.CreateAccountRequest true "Account creation details"
 // @Success 201 {object} dto.CreateAccountResponse "Account created successfully"
-// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or validation error"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or validation error, ACCOUNT_006 - Unsupported currency"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
 // @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid initial deposit amount"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
@@ -63,11 +65,14 @@ func (h *AccountHandler) CreateAccount(c echo.Context) error {
 
 	initialDeposit := getAvailableBalanceFromContext(c)
 
-	account, err := h.accountService.CreateAccount(userID, req.AccountType, req.AccountNumber, req.RoutingNumber, initialDeposit)
+	account, err := h.accountService.CreateAccount(userID, req.AccountType, req.AccountNumber, req.RoutingNumber, req.Currency, initialDeposit)
 	if err != nil {
 		if err == services.ErrAccountAlreadyExists {
 			return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
 		}
+		if err == services.ErrUnsupportedCurrency {
+			return SendError(c, errors.AccountUnsupportedCurrency, errors.WithDetails(err.Error()))
+		}
 		if err == services.ErrInvalidAmount {
 			return SendError(c, errors.TransactionInvalidAmount, errors.WithDetails(err.Error()))
 		}
@@ -243,7 +248,7 @@ func (h *AccountHandler) CloseAccount(c echo.Context) error {
 
 // PerformTransaction creates a new transaction on an account
 // @Summary Create a transaction
-// @Description Create a new transaction (credit or debit) on an account
+// @Description Create a new transaction (credit or debit) on an account. A debit flagged by fraud screening is not posted: its amount is placed on hold and the pending hold is returned with 202 until an admin approves or rejects it.
 // @Tags Accounts
 // @Security BearerAuth
 // @Accept json
@@ -251,11 +256,12 @@ func (h *AccountHandler) CloseAccount(c echo.Context) error {
 // @Param accountId path string true "Account ID (UUID)"
 // @Param request body dto.TransactionRequest true "Transaction details"
 // @Success 201 {object} models.Transaction "Transaction created successfully"
+// @Success 202 {object} models.Transaction "Debit held for fraud review"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or account ID"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
 // @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Account belongs to another user"
 // @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
-// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid transaction amount, TRANSACTION_003 - Insufficient funds, ACCOUNT_002 - Account not active"
+// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid transaction amount, TRANSACTION_003 - Insufficient funds, ACCOUNT_002 - Account not active, LIMIT_001 - Transaction limit exceeded"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /accounts/{accountId}/transactions [post]
 func (h *AccountHandler) PerformTransaction(c echo.Context) error {
@@ -264,6 +270,36 @@ func (h *AccountHandler) PerformTransaction(c echo.Context) error {
 		return SendError(c, errors.AuthMissingToken)
 	}
 
+	return h.performTransaction(c, &userID)
+}
+
+// PostTellerTransaction posts a deposit or withdrawal to any account
+// @Summary Post a teller transaction (admin)
+// @Description Staff endpoint for tellers to post a deposit (credit) or withdrawal (debit) to a customer's account. Limits and fraud screening apply as for the customer's own transactions. Transactions at or above the approval threshold are held with 202 until a second admin approves them. Requires the transactions:post permission.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param accountId path string true "Account ID (UUID)"
+// @Param request body dto.TransactionRequest true "Transaction details"
+// @Success 201 {object} models.Transaction "Transaction created successfully"
+// @Success 202 {object} models.Transaction "Debit held for fraud review, or SuccessResponse{data=models.PendingAction} awaiting approval"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or account ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
+// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid transaction amount, TRANSACTION_003 - Insufficient funds, ACCOUNT_002 - Account not active, LIMIT_001 - Transaction limit exceeded"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/accounts/{accountId}/transactions [post]
+func (h *AccountHandler) PostTellerTransaction(c echo.Context) error {
+	// The route's permission check authorizes access to any account
+	return h.performTransaction(c, nil)
+}
+
+// performTransaction posts a transaction, checking the account belongs to
+// userID unless it is nil. A nil userID marks a teller transaction, which is
+// subject to the approval policy.
+func (h *AccountHandler) performTransaction(c echo.Context, userID *uuid.UUID) error {
 	accountIDStr := c.Param("accountId")
 	accountID, err := uuid.Parse(accountIDStr)
 	if err != nil {
@@ -284,17 +320,31 @@ func (h *AccountHandler) PerformTransaction(c echo.Context) error {
 		return SendError(c, errors.TransactionInvalidAmount, errors.WithDetails("Amount must be greater than 0"))
 	}
 
-	transaction, err := h.accountService.PerformTransaction(accountID, amount, req.Type, req.Description, &userID)
+	// Large teller transactions wait for a second admin
+	if userID == nil && h.approvalService.RequiresApproval(models.PendingActionManualTransaction, amount) {
+		return submitForApproval(c, h.approvalService, models.PendingActionManualTransaction, accountID.String(), map[string]interface{}{
+			"account_id":  accountID.String(),
+			"amount":      amount.String(),
+			"type":        req.Type,
+			"description": req.Description,
+		})
+	}
+
+	transaction, err := h.accountService.PerformTransaction(accountID, amount, req.Type, req.Description, userID)
 	if err != nil {
 		return mapTransactionErr(c, err)
 	}
 
+	if transaction.IsPending() {
+		return c.JSON(http.StatusAccepted, transaction)
+	}
+
 	return c.JSON(http.StatusCreated, transaction)
 }
 
 // Transfer performs an atomic transfer between user's accounts with idempotency support
 // @Summary Transfer between accounts
-// @Description Perform an atomic transfer between user's accounts. Requires Idempotency-Key header. Both accounts must belong to the authenticated user.
+// @Description Perform an atomic transfer between user's accounts. Requires Idempotency-Key header. Both accounts must belong to the authenticated user. Between accounts in different currencies the current exchange rate is locked and recorded on the transfer and both transactions, and the destination is credited the converted amount rounded to its currency's minor units. A transfer flagged by fraud screening is returned pending with 202; its amount is held on the source account until an admin approves or rejects it.
 // @Tags Accounts
 // @Security BearerAuth
 // @Accept json
@@ -303,12 +353,13 @@ func (h *AccountHandler) PerformTransaction(c echo.Context) error {
 // @Param Idempotency-Key header string true "Unique key to ensure idempotent transfers"
 // @Param request body dto.TransferRequest true "Transfer details"
 // @Success 200 {object} dto.TransferResponse "Transfer completed successfully"
+// @Success 202 {object} dto.TransferResponse "Transfer held for fraud review"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_002 - Missing Idempotency-Key header"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
 // @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Account belongs to another user"
 // @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
 // @Failure 409 {object} errors.ErrorResponse "Duplicate idempotency key with pending or failed transfer"
-// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid amount, TRANSACTION_003 - Insufficient funds, ACCOUNT_002 - Account not active"
+// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid amount, TRANSACTION_003 - Insufficient funds, ACCOUNT_002 - Account not active, ACCOUNT_007 - No exchange rate for a cross-currency transfer, LIMIT_001 - Transaction limit exceeded"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /accounts/{accountId}/transfer [post]
 func (h *AccountHandler) Transfer(c echo.Context) error {
@@ -371,6 +422,14 @@ func (h *AccountHandler) Transfer(c echo.Context) error {
 		return h.mapTransferErr(c, ctx, transfer, idempotencyKey, err)
 	}
 
+	if transfer.Status == models.TransferStatusPending {
+		if h.metricsCollector != nil {
+			h.metricsCollector.IncrementCounter("transfers_total", map[string]string{"status": "held"})
+		}
+
+		return c.JSON(http.StatusAccepted, newTransferResponse(transfer, "Transfer held for review"))
+	}
+
 	if h.auditLogger != nil {
 		h.auditLogger.LogTransferCompleted(ctx, transfer.ID, duration.Milliseconds(), transfer.DebitTransactionID, transfer.CreditTransactionID)
 	}
@@ -383,12 +442,28 @@ func (h *AccountHandler) Transfer(c echo.Context) error {
 		h.metricsCollector.RecordGauge("transfer_amount", amountFloat, nil)
 	}
 
+	return c.JSON(http.StatusOK, newTransferResponse(transfer, "Transfer completed successfully"))
+}
+
+// newTransferResponse describes a transfer, including the locked rate of a
+// cross-currency transfer and the transactions of a completed one
+func newTransferResponse(transfer *models.Transfer, message string) dto.TransferResponse {
 	response := dto.TransferResponse{
-		Message:       "Transfer completed successfully",
+		Message:       message,
 		TransferID:    transfer.ID.String(),
+		Status:        transfer.Status,
 		FromAccountID: transfer.FromAccountID.String(),
 		ToAccountID:   transfer.ToAccountID.String(),
 		Amount:        transfer.Amount.String(),
+		FromCurrency:  transfer.FromCurrency,
+		ToCurrency:    transfer.ToCurrency,
+	}
+
+	if transfer.IsCrossCurrency() && transfer.ExchangeRate != nil {
+		convertedAmount := transfer.CreditAmount().String()
+		exchangeRate := transfer.ExchangeRate.String()
+		response.ConvertedAmount = &convertedAmount
+		response.ExchangeRate = &exchangeRate
 	}
 
 	if transfer.DebitTransactionID != nil {
@@ -401,19 +476,21 @@ func (h *AccountHandler) Transfer(c echo.Context) error {
 		response.CreditTransactionID = &creditTxID
 	}
 
-	return c.JSON(http.StatusOK, response)
+	return response
 }
 
 // GetTransferHistory retrieves transfer history for the authenticated user
 // @Summary Get my transfer history
-// @Description Retrieve paginated transfer history for the authenticated user with optional status filter
+// @Description Retrieve paginated transfer history for the authenticated user with optional status and schedule filters. Transfers made by a schedule carry its schedule_id and schedule_occurrence.
 // @Tags Customers
 // @Security BearerAuth
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Results per page (max 100)" default(20)
 // @Param status query string false "Filter by status" Enums(completed, failed, pending)
+// @Param schedule_id query string false "Filter by transfer schedule ID (UUID)"
 // @Success 200 {object} dto.TransferHistoryResponse "Transfer history with pagination"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid schedule ID"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /customers/me/transfers [get]
@@ -442,6 +519,14 @@ func (h *AccountHandler) GetTransferHistory(c echo.Context) error {
 		Status: c.QueryParam("status"),
 	}
 
+	if scheduleIDStr := c.QueryParam("schedule_id"); scheduleIDStr != "" {
+		scheduleID, err := uuid.Parse(scheduleIDStr)
+		if err != nil {
+			return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid schedule ID"))
+		}
+		filters.ScheduleID = &scheduleID
+	}
+
 	transfers, total, err := h.accountService.GetUserTransfers(userID, filters, offset, limit)
 	if err != nil {
 		return SendSystemError(c, err)
@@ -473,7 +558,7 @@ func (h *AccountHandler) GetTransferHistory(c echo.Context) error {
 // @Param account_type query string false "Filter by account type" Enums(checking, savings, money_market)
 // @Param status query string false "Filter by status" Enums(active, inactive, frozen, closed)
 // @Success 200 {object} object{accounts=[]models.Account,total=int,offset=int,limit=int} "List of all accounts"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /admin/accounts [get]
 func (h *AccountHandler) GetAllAccounts(c echo.Context) error {
@@ -518,7 +603,7 @@ func (h *AccountHandler) GetAllAccounts(c echo.Context) error {
 // @Param accountId path string true "Account ID (UUID)"
 // @Success 200 {object} models.Account "Account details"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid account ID format"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /admin/accounts/{accountId} [get]
@@ -549,7 +634,7 @@ func (h *AccountHandler) GetAccountByIDAdmin(c echo.Context) error {
 // @Param userId path string true "User ID (UUID)"
 // @Success 200 {array} models.Account "List of user's accounts"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid user ID format"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /admin/users/{userId}/accounts [get]
 func (h *AccountHandler) GetUserAccountsAdmin(c echo.Context) error {
@@ -584,6 +669,9 @@ func mapTransactionErr(c echo.Context, err error) error {
 	if mappedErr := mapCommonErr(c, err); mappedErr != nil {
 		return mappedErr
 	}
+	if detail, ok := limitExceededDetail(err); ok {
+		return SendError(c, errors.LimitExceeded, errors.WithDetails(detail))
+	}
 	if err == services.ErrInsufficientFunds {
 		return SendError(c, errors.TransactionInsufficientFunds)
 	}
@@ -598,6 +686,9 @@ func (h *AccountHandler) mapTransferErr(c echo.Context, ctx context.Context, tra
 	if mappedErr := mapCommonErr(c, svcErr); mappedErr != nil {
 		return mappedErr
 	}
+	if detail, ok := limitExceededDetail(svcErr); ok {
+		return SendError(c, errors.LimitExceeded, errors.WithDetails(detail))
+	}
 	if svcErr == services.ErrInsufficientFunds {
 		return SendError(c, errors.TransferInsufficientFunds)
 	}
@@ -607,6 +698,9 @@ func (h *AccountHandler) mapTransferErr(c echo.Context, ctx context.Context, tra
 	if svcErr == services.ErrSameAccountTransfer {
 		return SendError(c, errors.TransferSameAccount)
 	}
+	if svcErr == services.ErrExchangeRateUnavailable {
+		return SendError(c, errors.AccountExchangeRateUnavailable)
+	}
 	if svcErr == services.ErrTransferPending {
 		if h.auditLogger != nil && transfer != nil {
 			h.auditLogger.LogTransferIdempotencyCheck(ctx, idempotencyKey, transfer.ID, "pending")
@@ -3,9 +3,11 @@ package handlers
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"array-assessment/internal/dto"
 	"array-assessment/internal/models"
@@ -25,6 +27,7 @@ type AccountHandlerSuite struct {
 	suite.Suite
 	ctrl             *gomock.Controller
 	mockService      *service_mocks.MockAccountServiceInterface
+	approvalService  *service_mocks.MockApprovalServiceInterface
 	handler          *AccountHandler
 	echo             *echo.Echo
 	testUserID       uuid.UUID
@@ -37,9 +40,10 @@ type AccountHandlerSuite struct {
 func (s *AccountHandlerSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.mockService = service_mocks.NewMockAccountServiceInterface(s.ctrl)
+	s.approvalService = service_mocks.NewMockApprovalServiceInterface(s.ctrl)
 	s.auditLogger = service_mocks.NewMockAuditLoggerInterface(s.ctrl)
 	s.metricsCollector = service_mocks.NewMockMetricsRecorderInterface(s.ctrl)
-	s.handler = NewAccountHandler(s.mockService, s.auditLogger, s.metricsCollector)
+	s.handler = NewAccountHandler(s.mockService, s.approvalService, s.auditLogger, s.metricsCollector)
 
 	s.echo = echo.New()
 	s.echo.Validator = &CustomValidator{validator: validator.New()}
@@ -100,8 +104,8 @@ func (s *AccountHandlerSuite) TestCreateAccount_WithInitialDeposit() {
 	}
 
 	s.mockService.EXPECT().
-		CreateAccount(s.testUserID, "CHECKING", gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(userID uuid.UUID, accountType, accountNumber, routingNumber string, initialDeposit decimal.Decimal) (*models.Account, error) {
+		CreateAccount(s.testUserID, "CHECKING", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(userID uuid.UUID, accountType, accountNumber, routingNumber, currency string, initialDeposit decimal.Decimal) (*models.Account, error) {
 			if !initialDeposit.Equal(decimal.NewFromFloat(100.00)) {
 				s.T().Errorf("expected amount 100.00, got %s", initialDeposit.String())
 			}
@@ -147,7 +151,7 @@ func (s *AccountHandlerSuite) TestCreateAccount_AccountAlreadyExists() {
 	}
 
 	s.mockService.EXPECT().
-		CreateAccount(s.testUserID, "CHECKING", gomock.Any(), gomock.Any(), gomock.Any()).
+		CreateAccount(s.testUserID, "CHECKING", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, services.ErrAccountAlreadyExists)
 
 	c, rec := s.createContextWithAuth("POST", "/accounts", reqBody, s.testUserID, "user")
@@ -299,6 +303,60 @@ func (s *AccountHandlerSuite) TestPerformTransaction_Credit() {
 	s.Equal(expectedTransaction.ID, transaction.ID)
 }
 
+func (s *AccountHandlerSuite) TestPostTellerTransaction_SkipsOwnershipCheck() {
+	accountID := uuid.New()
+
+	reqBody := dto.TransactionRequest{
+		Amount:      "200.00",
+		Type:        "credit",
+		Description: "Cash deposit",
+	}
+
+	s.approvalService.EXPECT().
+		RequiresApproval(models.PendingActionManualTransaction, gomock.Any()).
+		Return(false)
+	s.mockService.EXPECT().
+		PerformTransaction(accountID, gomock.Any(), "credit", "Cash deposit", nil).
+		Return(&models.Transaction{ID: uuid.New(), AccountID: accountID, Status: "completed"}, nil)
+
+	c, rec := s.createContextWithAuth("POST", "/admin/accounts/"+accountID.String()+"/transactions", reqBody, s.testUserID, models.RoleTeller)
+	c.SetParamNames("accountId")
+	c.SetParamValues(accountID.String())
+
+	err := s.handler.PostTellerTransaction(c)
+	s.NoError(err)
+	s.Equal(http.StatusCreated, rec.Code)
+}
+
+func (s *AccountHandlerSuite) TestPostTellerTransaction_LargeAmountAwaitsApproval() {
+	accountID := uuid.New()
+
+	reqBody := dto.TransactionRequest{
+		Amount:      "25000.00",
+		Type:        "debit",
+		Description: "Cash withdrawal",
+	}
+
+	s.approvalService.EXPECT().
+		RequiresApproval(models.PendingActionManualTransaction, gomock.Any()).
+		Return(true)
+	s.approvalService.EXPECT().
+		SubmitAction(models.PendingActionManualTransaction, accountID.String(), gomock.Any(), s.testUserID, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(actionType, resourceID string, payload map[string]interface{}, requestedBy uuid.UUID, _, _ string) (*models.PendingAction, error) {
+			s.Equal("25000", payload["amount"])
+			s.Equal("debit", payload["type"])
+			return &models.PendingAction{ID: uuid.New(), ActionType: actionType, ResourceID: resourceID, Status: models.PendingActionStatusPending}, nil
+		})
+
+	c, rec := s.createContextWithAuth("POST", "/admin/accounts/"+accountID.String()+"/transactions", reqBody, s.testUserID, models.RoleTeller)
+	c.SetParamNames("accountId")
+	c.SetParamValues(accountID.String())
+
+	err := s.handler.PostTellerTransaction(c)
+	s.NoError(err)
+	s.Equal(http.StatusAccepted, rec.Code)
+}
+
 func (s *AccountHandlerSuite) TestPerformTransaction_InsufficientFunds() {
 	accountID := uuid.New()
 
@@ -335,6 +393,65 @@ func (s *AccountHandlerSuite) TestPerformTransaction_InsufficientFunds() {
 	s.Contains(errorResp.Error.Message, "Insufficient account balance")
 }
 
+func (s *AccountHandlerSuite) TestPerformTransaction_LimitExceeded() {
+	accountID := uuid.New()
+
+	reqBody := dto.TransactionRequest{
+		Amount:      "600.00",
+		Type:        "debit",
+		Description: "Withdrawal",
+	}
+
+	s.mockService.EXPECT().
+		PerformTransaction(accountID, gomock.Any(), "debit", "Withdrawal", &s.testUserID).
+		Return(nil, fmt.Errorf("%w: daily withdrawal limit of 1000.00 has 500.00 remaining", services.ErrLimitExceeded))
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/"+accountID.String()+"/transactions", reqBody, s.testUserID, "user")
+	c.SetParamNames("accountId")
+	c.SetParamValues(accountID.String())
+
+	err := s.handler.PerformTransaction(c)
+	s.NoError(err)
+	s.Equal(http.StatusUnprocessableEntity, rec.Code)
+
+	var errorResp ErrorResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &errorResp)
+	s.NoError(err)
+	s.Equal("LIMIT_001", errorResp.Error.Code)
+	s.Equal([]string{"daily withdrawal limit of 1000.00 has 500.00 remaining"}, errorResp.Error.Details)
+}
+
+func (s *AccountHandlerSuite) TestPerformTransaction_HeldForReview() {
+	accountID := uuid.New()
+	pendingUntil := time.Now().Add(72 * time.Hour)
+
+	reqBody := dto.TransactionRequest{
+		Amount:      "5000.00",
+		Type:        "debit",
+		Description: "Jewellery",
+	}
+
+	s.mockService.EXPECT().
+		PerformTransaction(accountID, gomock.Any(), "debit", "Jewellery", &s.testUserID).
+		Return(&models.Transaction{
+			ID:              uuid.New(),
+			AccountID:       accountID,
+			Amount:          decimal.NewFromFloat(5000.00),
+			TransactionType: models.TransactionTypeDebit,
+			Description:     "Jewellery",
+			Status:          models.TransactionStatusPending,
+			PendingUntil:    &pendingUntil,
+		}, nil)
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/"+accountID.String()+"/transactions", reqBody, s.testUserID, "user")
+	c.SetParamNames("accountId")
+	c.SetParamValues(accountID.String())
+
+	err := s.handler.PerformTransaction(c)
+	s.NoError(err)
+	s.Equal(http.StatusAccepted, rec.Code)
+}
+
 // Test Transfer functionality
 func (s *AccountHandlerSuite) TestTransfer_Success() {
 	fromAccountID := uuid.New()
@@ -399,6 +516,51 @@ func (s *AccountHandlerSuite) TestTransfer_Success() {
 	s.Equal(http.StatusOK, rec.Code)
 }
 
+func (s *AccountHandlerSuite) TestTransfer_HeldForReview() {
+	fromAccountID := uuid.New()
+	toAccountID := uuid.New()
+	idempotencyKey := uuid.New().String()
+
+	reqBody := dto.TransferRequest{
+		ToAccountID: toAccountID.String(),
+		Amount:      "5000.00",
+		Description: "Rent",
+	}
+
+	s.auditLogger.EXPECT().
+		LogTransferInitiated(gomock.Any(), gomock.Any(), fromAccountID, toAccountID, "5000.00", idempotencyKey, s.testUserID).
+		Times(1)
+
+	s.mockService.EXPECT().
+		TransferBetweenAccounts(fromAccountID, toAccountID, gomock.Any(), "Rent", idempotencyKey, s.testUserID).
+		Return(&models.Transfer{
+			ID:            uuid.New(),
+			FromAccountID: fromAccountID,
+			ToAccountID:   toAccountID,
+			Amount:        decimal.NewFromFloat(5000.00),
+			Description:   "Rent",
+			Status:        models.TransferStatusPending,
+		}, nil)
+
+	s.metricsCollector.EXPECT().
+		IncrementCounter("transfers_total", map[string]string{"status": "held"}).
+		Times(1)
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/"+fromAccountID.String()+"/transfer", reqBody, s.testUserID, "user")
+	c.SetParamNames("accountId")
+	c.SetParamValues(fromAccountID.String())
+	c.Request().Header.Set("Idempotency-Key", idempotencyKey)
+
+	err := s.handler.Transfer(c)
+	s.NoError(err)
+	s.Equal(http.StatusAccepted, rec.Code)
+
+	var response dto.TransferResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(models.TransferStatusPending, response.Status)
+	s.Equal("Transfer held for review", response.Message)
+}
+
 func (s *AccountHandlerSuite) TestTransfer_SameAccount() {
 	fromAccountID := uuid.New()
 	idempotencyKey := uuid.New().String()
@@ -838,7 +1000,7 @@ func (s *AccountHandlerSuite) TestGetAllAccounts_AdminSuccess() {
 }
 
 func (s *AccountHandlerSuite) TestGetAllAccounts_NonAdminFails() {
-	// NOTE: Admin authorization check was moved to middleware (RequireAdmin)
+	// NOTE: Admin authorization check was moved to middleware (RequirePermission)
 	// This test now verifies that the handler processes requests when called directly
 	// In production, non-admin users are blocked by middleware before reaching handler
 	c, _ := s.createContextWithAuth("GET", "/admin/accounts", nil, s.testUserID, "user")
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
 	"array-assessment/internal/services"
 
 	"github.com/google/uuid"
@@ -37,21 +39,24 @@ func NewAccountSummaryHandler(
 // Authentication: Required (JWT)
 //
 // Query parameters:
-//   - userId: UUID of target user (optional, admin only)
+//   - userId: UUID of target user (optional, requires customers:read)
+//   - baseCurrency: ISO-4217 code to report the total in (optional, defaults to USD)
 //
 // Success Response: 200 OK
 //   - user_id: UUID of the user
-//   - total_balance: Decimal total across all accounts
+//   - total_balance: Decimal total across all accounts, converted into the base currency
 //   - account_count: Integer number of accounts
-//   - currency: String currency code
-//   - accounts: Array of account summary items
+//   - currency: String base currency code
+//   - accounts: Array of account summary items with balances in their own currency
+//     and converted_balance in the base currency
 //   - generated_at: ISO 8601 timestamp
 //
 // Error Responses:
-//   - 400: Invalid userId format
+//   - 400: Invalid userId format or unsupported baseCurrency
 //   - 401: Unauthorized (missing JWT)
-//   - 403: Forbidden (non-admin accessing other user)
+//   - 403: Forbidden (accessing another user without customers:read)
 //   - 404: User not found
+//   - 422: No exchange rate from an account currency to the base currency
 //   - 500: Internal server error
 func (h *AccountSummaryHandler) GetAccountSummary(c echo.Context) error {
 	requestorID, err := getUserIDFromContext(c)
@@ -59,7 +64,7 @@ func (h *AccountSummaryHandler) GetAccountSummary(c echo.Context) error {
 		return SendError(c, apierrors.AuthMissingToken)
 	}
 
-	isAdmin := getIsAdminFromContext(c)
+	canViewAll := hasPermissionInContext(c, models.PermissionCustomersRead)
 
 	var targetUserID *uuid.UUID
 	userIDParam := c.QueryParam("userId")
@@ -71,7 +76,7 @@ func (h *AccountSummaryHandler) GetAccountSummary(c echo.Context) error {
 		targetUserID = &parsedUserID
 	}
 
-	summary, err := h.summaryService.GetAccountSummary(requestorID, targetUserID, isAdmin)
+	summary, err := h.summaryService.GetAccountSummary(requestorID, targetUserID, c.QueryParam("baseCurrency"), canViewAll)
 	if err != nil {
 		return h.handleServiceError(c, err)
 	}
@@ -120,7 +125,7 @@ func (h *AccountSummaryHandler) GetAccountMetrics(c echo.Context) error {
 		return err
 	}
 
-	isAdmin := getIsAdminFromContext(c)
+	canViewAll := hasPermissionInContext(c, models.PermissionCustomersRead)
 
 	accountIDParam := c.QueryParam("accountId")
 	if accountIDParam == "" {
@@ -152,7 +157,78 @@ func (h *AccountSummaryHandler) GetAccountMetrics(c echo.Context) error {
 		endDate = &parsed
 	}
 
-	metrics, err := h.metricsService.GetAccountMetrics(requestorID, accountID, startDate, endDate, isAdmin)
+	metrics, err := h.metricsService.GetAccountMetrics(requestorID, accountID, startDate, endDate, canViewAll)
+	if err != nil {
+		return h.handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: metrics,
+	})
+}
+
+// GetAggregateMetrics retrieves metrics totalled across all of a user's accounts
+//
+// Method: GET /api/v1/accounts/metrics/aggregate
+// Authentication: Required (JWT)
+//
+// Query parameters:
+//   - userId: UUID of target user (optional, requires customers:read)
+//   - startDate: ISO 8601 date (optional, defaults to 14 days before endDate)
+//   - endDate: ISO 8601 date (optional, defaults to today)
+//   - baseCurrency: ISO-4217 code to report the totals in (optional, defaults to USD)
+//
+// Success Response: 200 OK
+//   - user_id: UUID of the user
+//   - currency: String base currency code
+//   - total_deposits, total_withdrawals, net_change: Decimal totals in the base currency
+//   - total_transaction_count: Integer total transactions
+//   - account_count: Integer number of accounts
+//   - account_metrics: Array of per-account metrics in each account's currency
+//   - generated_at: ISO 8601 timestamp
+//
+// Error Responses:
+//   - 400: Invalid parameters (userId, date formats, baseCurrency)
+//   - 401: Unauthorized (missing JWT)
+//   - 403: Forbidden (accessing another user without customers:read)
+//   - 404: User not found
+//   - 422: No exchange rate from an account currency to the base currency
+//   - 500: Internal server error
+func (h *AccountSummaryHandler) GetAggregateMetrics(c echo.Context) error {
+	requestorID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	canViewAll := hasPermissionInContext(c, models.PermissionCustomersRead)
+
+	targetUserID := requestorID
+	if userIDParam := c.QueryParam("userId"); userIDParam != "" {
+		targetUserID, err = uuid.Parse(userIDParam)
+		if err != nil {
+			return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("invalid userId format"))
+		}
+	}
+
+	var startDate, endDate *time.Time
+
+	if startDateParam := c.QueryParam("startDate"); startDateParam != "" {
+		parsed, err := time.Parse("2006-01-02", startDateParam)
+		if err != nil {
+			return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("invalid startDate format, expected YYYY-MM-DD"))
+		}
+		startDate = &parsed
+	}
+
+	if endDateParam := c.QueryParam("endDate"); endDateParam != "" {
+		parsed, err := time.Parse("2006-01-02", endDateParam)
+		if err != nil {
+			return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("invalid endDate format, expected YYYY-MM-DD"))
+		}
+		endDate = &parsed
+	}
+
+	metrics, err := h.metricsService.GetUserAggregateMetrics(requestorID, targetUserID, startDate, endDate, c.QueryParam("baseCurrency"), canViewAll)
 	if err != nil {
 		return h.handleServiceError(c, err)
 	}
@@ -203,7 +279,7 @@ func (h *AccountSummaryHandler) GetStatement(c echo.Context) error {
 		return err
 	}
 
-	isAdmin := getIsAdminFromContext(c)
+	canViewAll := hasPermissionInContext(c, models.PermissionCustomersRead)
 
 	accountIDParam := c.Param("accountId")
 	if accountIDParam == "" {
@@ -241,7 +317,7 @@ func (h *AccountSummaryHandler) GetStatement(c echo.Context) error {
 		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("invalid period format"))
 	}
 
-	statement, err := h.statementService.GenerateStatement(requestorID, accountID, periodType, year, period, isAdmin)
+	statement, err := h.statementService.GenerateStatement(requestorID, accountID, periodType, year, period, canViewAll)
 	if err != nil {
 		return h.handleServiceError(c, err)
 	}
@@ -251,6 +327,131 @@ func (h *AccountSummaryHandler) GetStatement(c echo.Context) error {
 	})
 }
 
+// DownloadStatement returns a monthly or quarterly statement as PDF, CSV or JSON
+//
+// Method: GET /api/v1/accounts/:accountId/statements/:year/:period
+// Authentication: Required (JWT)
+//
+// Path parameters:
+//   - accountId: UUID of account
+//   - year: Integer year
+//   - period: Integer month (1-12) or quarter (1-4)
+//
+// Query parameters:
+//   - periodType: "monthly" or "quarterly" (optional, default "monthly")
+//
+// Headers:
+//   - Accept: application/pdf, text/csv or application/json (default). Quality
+//     values are honoured; unsupported media types fall back to JSON.
+//
+// Success Response: 200 OK
+//   - PDF or CSV: the archived statement document as an attachment. The first
+//     download of a period is archived and later downloads return the identical
+//     document. ETag and X-Content-SHA256 carry its SHA-256 content hash.
+//   - JSON: the statement, as returned by GetStatement
+//
+// Error Responses:
+//   - 400: Invalid parameters (accountId, periodType, year, period)
+//   - 401: Unauthorized (missing JWT)
+//   - 403: Forbidden (accessing other user's account)
+//   - 404: Account not found
+//   - 500: Internal server error
+func (h *AccountSummaryHandler) DownloadStatement(c echo.Context) error {
+	requestorID, err := getUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	canViewAll := hasPermissionInContext(c, models.PermissionCustomersRead)
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("invalid accountId format"))
+	}
+
+	periodType := c.QueryParam("periodType")
+	if periodType == "" {
+		periodType = services.PeriodTypeMonthly
+	}
+
+	if periodType != services.PeriodTypeMonthly && periodType != services.PeriodTypeQuarterly {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("periodType must be 'monthly' or 'quarterly'"))
+	}
+
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("invalid year format"))
+	}
+
+	period, err := strconv.Atoi(c.Param("period"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("invalid period format"))
+	}
+
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+
+	format := negotiateStatementFormat(c.Request().Header.Get(echo.HeaderAccept))
+	if format == "" {
+		statement, err := h.statementService.GenerateStatement(requestorID, accountID, periodType, year, period, canViewAll)
+		if err != nil {
+			return h.handleServiceError(c, err)
+		}
+
+		return c.JSON(http.StatusOK, SuccessResponse{
+			Data: statement,
+		})
+	}
+
+	document, err := h.statementService.RenderStatement(requestorID, accountID, periodType, year, period, format, canViewAll)
+	if err != nil {
+		return h.handleServiceError(c, err)
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition, `attachment; filename="`+document.Filename()+`"`)
+	header.Set("ETag", `"`+document.ContentHash+`"`)
+	header.Set("X-Content-SHA256", document.ContentHash)
+
+	return c.Blob(http.StatusOK, document.ContentType, document.Content)
+}
+
+// statementMediaTypes maps the media types a statement can be served as to their
+// document format; JSON is served by GenerateStatement and has no document format
+var statementMediaTypes = map[string]string{
+	"application/pdf":  models.StatementFormatPDF,
+	"text/csv":         models.StatementFormatCSV,
+	"application/json": "",
+	"*/*":              "",
+}
+
+// negotiateStatementFormat picks the statement document format from an Accept
+// header, preferring the highest quality value and then the earliest listed. It
+// returns an empty string when JSON should be served.
+func negotiateStatementFormat(accept string) string {
+	best, bestQuality := "", 0.0
+	for _, mediaRange := range strings.Split(accept, ",") {
+		params := strings.Split(mediaRange, ";")
+		format, ok := statementMediaTypes[strings.ToLower(strings.TrimSpace(params[0]))]
+		if !ok {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range params[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					quality = parsed
+				}
+			}
+		}
+
+		if quality > bestQuality {
+			best, bestQuality = format, quality
+		}
+	}
+	return best
+}
+
 func (h *AccountSummaryHandler) handleServiceError(c echo.Context, err error) error {
 	if errors.Is(err, services.ErrUnauthorized) {
 		return SendError(c, apierrors.AuthInsufficientPermission)
@@ -260,6 +461,14 @@ func (h *AccountSummaryHandler) handleServiceError(c echo.Context, err error) er
 		return SendError(c, apierrors.AccountNotFound, apierrors.WithDetails("resource not found"))
 	}
 
+	if errors.Is(err, services.ErrUnsupportedCurrency) {
+		return SendError(c, apierrors.AccountUnsupportedCurrency, apierrors.WithDetails("baseCurrency must be a supported ISO-4217 currency code"))
+	}
+
+	if errors.Is(err, services.ErrExchangeRateUnavailable) {
+		return SendError(c, apierrors.AccountExchangeRateUnavailable)
+	}
+
 	if errors.Is(err, services.ErrInvalidDateRange) {
 		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("start date must be before end date"))
 	}
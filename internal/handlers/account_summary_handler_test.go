@@ -64,7 +64,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountSummary_RegularUser_Succe
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 
 	summary := &models.UserAccountSummary{
 		UserID:       s.regularUserID,
@@ -93,7 +92,7 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountSummary_RegularUser_Succe
 	}
 
 	s.mockSummaryService.EXPECT().
-		GetAccountSummary(s.regularUserID, (*uuid.UUID)(nil), false).
+		GetAccountSummary(s.regularUserID, (*uuid.UUID)(nil), "", false).
 		Return(summary, nil)
 
 	err := s.handler.GetAccountSummary(c)
@@ -112,7 +111,7 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountSummary_AdminAccessingOth
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.adminUserID)
-	c.Set("is_admin", true)
+	c.Set("user_permissions", []string{models.PermissionCustomersRead})
 	c.QueryParams().Add("userId", s.otherUserID.String())
 
 	summary := &models.UserAccountSummary{
@@ -125,7 +124,7 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountSummary_AdminAccessingOth
 	}
 
 	s.mockSummaryService.EXPECT().
-		GetAccountSummary(s.adminUserID, &s.otherUserID, true).
+		GetAccountSummary(s.adminUserID, &s.otherUserID, "", true).
 		Return(summary, nil)
 
 	err := s.handler.GetAccountSummary(c)
@@ -151,7 +150,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountSummary_InvalidUserID_Que
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("userId", "invalid-uuid")
 
 	err := s.handler.GetAccountSummary(c)
@@ -166,11 +164,10 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountSummary_ServiceError_Unau
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("userId", s.otherUserID.String())
 
 	s.mockSummaryService.EXPECT().
-		GetAccountSummary(s.regularUserID, &s.otherUserID, false).
+		GetAccountSummary(s.regularUserID, &s.otherUserID, "", false).
 		Return(nil, services.ErrUnauthorized)
 
 	err := s.handler.GetAccountSummary(c)
@@ -185,10 +182,9 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountSummary_ServiceError_NotF
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 
 	s.mockSummaryService.EXPECT().
-		GetAccountSummary(s.regularUserID, (*uuid.UUID)(nil), false).
+		GetAccountSummary(s.regularUserID, (*uuid.UUID)(nil), "", false).
 		Return(nil, services.ErrNotFound)
 
 	err := s.handler.GetAccountSummary(c)
@@ -210,7 +206,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountMetrics_WithDates_Success
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("accountId", s.accountID.String())
 	c.QueryParams().Add("startDate", startDate)
 	c.QueryParams().Add("endDate", endDate)
@@ -256,7 +251,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountMetrics_WithoutDates_Succ
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("accountId", s.accountID.String())
 
 	metrics := &models.AccountMetrics{
@@ -283,7 +277,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountMetrics_MissingAccountID(
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 
 	err := s.handler.GetAccountMetrics(c)
 
@@ -297,7 +290,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountMetrics_InvalidAccountID(
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("accountId", "invalid-uuid")
 
 	err := s.handler.GetAccountMetrics(c)
@@ -313,7 +305,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountMetrics_InvalidDateFormat
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("accountId", s.accountID.String())
 	c.QueryParams().Add("startDate", "invalid-date")
 
@@ -329,7 +320,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetAccountMetrics_ServiceError_Unau
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("accountId", s.accountID.String())
 
 	s.mockMetricsService.EXPECT().
@@ -355,7 +345,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_Monthly_Success() {
 	c.SetParamNames("accountId")
 	c.SetParamValues(s.accountID.String())
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("periodType", "monthly")
 	c.QueryParams().Add("year", "2024")
 	c.QueryParams().Add("period", "1")
@@ -404,7 +393,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_Quarterly_Success() {
 	c.SetParamNames("accountId")
 	c.SetParamValues(s.accountID.String())
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("periodType", "quarterly")
 	c.QueryParams().Add("year", "2024")
 	c.QueryParams().Add("period", "2")
@@ -446,7 +434,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_MissingAccountID() {
 	c := s.echo.NewContext(req, rec)
 	c.SetPath("/api/v1/accounts/:accountId/statements")
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("periodType", "monthly")
 	c.QueryParams().Add("year", "2024")
 	c.QueryParams().Add("period", "1")
@@ -466,7 +453,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_InvalidAccountID() {
 	c.SetParamNames("accountId")
 	c.SetParamValues("invalid-uuid")
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("periodType", "monthly")
 	c.QueryParams().Add("year", "2024")
 	c.QueryParams().Add("period", "1")
@@ -486,7 +472,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_MissingPeriodType() {
 	c.SetParamNames("accountId")
 	c.SetParamValues(s.accountID.String())
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("year", "2024")
 	c.QueryParams().Add("period", "1")
 
@@ -505,7 +490,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_InvalidPeriodType() {
 	c.SetParamNames("accountId")
 	c.SetParamValues(s.accountID.String())
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("periodType", "invalid")
 	c.QueryParams().Add("year", "2024")
 	c.QueryParams().Add("period", "1")
@@ -525,7 +509,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_MissingYearOrPeriod()
 	c.SetParamNames("accountId")
 	c.SetParamValues(s.accountID.String())
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("periodType", "monthly")
 
 	err := s.handler.GetStatement(c)
@@ -543,7 +526,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_InvalidYearFormat() {
 	c.SetParamNames("accountId")
 	c.SetParamValues(s.accountID.String())
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("periodType", "monthly")
 	c.QueryParams().Add("year", "invalid")
 	c.QueryParams().Add("period", "1")
@@ -563,7 +545,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_ServiceError_InvalidPe
 	c.SetParamNames("accountId")
 	c.SetParamValues(s.accountID.String())
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("periodType", "monthly")
 	c.QueryParams().Add("year", "2024")
 	c.QueryParams().Add("period", "13")
@@ -587,7 +568,6 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_ServiceError_Unauthori
 	c.SetParamNames("accountId")
 	c.SetParamValues(s.accountID.String())
 	c.Set("user_id", s.regularUserID)
-	c.Set("is_admin", false)
 	c.QueryParams().Add("periodType", "monthly")
 	c.QueryParams().Add("year", "2024")
 	c.QueryParams().Add("period", "1")
@@ -602,3 +582,149 @@ func (s *AccountSummaryHandlerTestSuite) TestGetStatement_ServiceError_Unauthori
 	s.Equal(http.StatusForbidden, rec.Code)
 	s.Contains(rec.Body.String(), "AUTH_005")
 }
+
+// ========================================
+// GET /api/v1/accounts/:accountId/statements/:year/:period Tests
+// ========================================
+
+func (s *AccountSummaryHandlerTestSuite) newDownloadContext(query, accept, year, period string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/accounts/%s/statements/%s/%s%s", s.accountID.String(), year, period, query), nil)
+	if accept != "" {
+		req.Header.Set(echo.HeaderAccept, accept)
+	}
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/api/v1/accounts/:accountId/statements/:year/:period")
+	c.SetParamNames("accountId", "year", "period")
+	c.SetParamValues(s.accountID.String(), year, period)
+	c.Set("user_id", s.regularUserID)
+	return c, rec
+}
+
+func (s *AccountSummaryHandlerTestSuite) TestDownloadStatement_PDF() {
+	c, rec := s.newDownloadContext("", "application/pdf", "2025", "9")
+
+	document := &models.ArchivedStatement{
+		AccountID:   s.accountID,
+		PeriodType:  "monthly",
+		Year:        2025,
+		Period:      9,
+		Format:      models.StatementFormatPDF,
+		ContentType: "application/pdf",
+		Content:     []byte("%PDF-1.4"),
+		ContentHash: "4b5d1c",
+	}
+
+	s.mockStatementService.EXPECT().
+		RenderStatement(s.regularUserID, s.accountID, "monthly", 2025, 9, models.StatementFormatPDF, false).
+		Return(document, nil)
+
+	err := s.handler.DownloadStatement(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("application/pdf", rec.Header().Get(echo.HeaderContentType))
+	s.Equal(`attachment; filename="statement-2025-09.pdf"`, rec.Header().Get(echo.HeaderContentDisposition))
+	s.Equal(`"4b5d1c"`, rec.Header().Get("ETag"))
+	s.Equal("4b5d1c", rec.Header().Get("X-Content-SHA256"))
+	s.Equal(echo.HeaderAccept, rec.Header().Get(echo.HeaderVary))
+	s.Equal("%PDF-1.4", rec.Body.String())
+}
+
+func (s *AccountSummaryHandlerTestSuite) TestDownloadStatement_QuarterlyCSV() {
+	c, rec := s.newDownloadContext("?periodType=quarterly", "text/csv", "2025", "3")
+
+	document := &models.ArchivedStatement{
+		PeriodType:  "quarterly",
+		Year:        2025,
+		Period:      3,
+		Format:      models.StatementFormatCSV,
+		ContentType: "text/csv; charset=utf-8",
+		Content:     []byte("date,amount\n"),
+	}
+
+	s.mockStatementService.EXPECT().
+		RenderStatement(s.regularUserID, s.accountID, "quarterly", 2025, 3, models.StatementFormatCSV, false).
+		Return(document, nil)
+
+	err := s.handler.DownloadStatement(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+	s.Equal(`attachment; filename="statement-2025-Q3.csv"`, rec.Header().Get(echo.HeaderContentDisposition))
+}
+
+func (s *AccountSummaryHandlerTestSuite) TestDownloadStatement_DefaultsToJSON() {
+	c, rec := s.newDownloadContext("", "", "2025", "9")
+
+	s.mockStatementService.EXPECT().
+		GenerateStatement(s.regularUserID, s.accountID, "monthly", 2025, 9, false).
+		Return(&models.AccountStatement{AccountID: s.accountID}, nil)
+
+	err := s.handler.DownloadStatement(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Header().Get(echo.HeaderContentType), "application/json")
+}
+
+func (s *AccountSummaryHandlerTestSuite) TestDownloadStatement_ServiceError_Unauthorized() {
+	c, rec := s.newDownloadContext("", "application/pdf", "2025", "9")
+
+	s.mockStatementService.EXPECT().
+		RenderStatement(s.regularUserID, s.accountID, "monthly", 2025, 9, models.StatementFormatPDF, false).
+		Return(nil, services.ErrUnauthorized)
+
+	err := s.handler.DownloadStatement(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *AccountSummaryHandlerTestSuite) TestDownloadStatement_InvalidParams() {
+	tests := []struct {
+		name   string
+		query  string
+		year   string
+		period string
+	}{
+		{"invalid period type", "?periodType=weekly", "2025", "9"},
+		{"invalid year", "", "last", "9"},
+		{"invalid period", "", "2025", "Q3"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			c, rec := s.newDownloadContext(tt.query, "application/pdf", tt.year, tt.period)
+
+			err := s.handler.DownloadStatement(c)
+
+			s.NoError(err)
+			s.Equal(http.StatusBadRequest, rec.Code)
+			s.Contains(rec.Body.String(), "VALIDATION_001")
+		})
+	}
+}
+
+func (s *AccountSummaryHandlerTestSuite) TestNegotiateStatementFormat() {
+	tests := []struct {
+		accept   string
+		expected string
+	}{
+		{"", ""},
+		{"application/pdf", models.StatementFormatPDF},
+		{"text/csv", models.StatementFormatCSV},
+		{"application/json", ""},
+		{"text/html, application/pdf", models.StatementFormatPDF},
+		{"application/pdf;q=0.5, text/csv", models.StatementFormatCSV},
+		{"text/csv;q=0.8, application/pdf;q=0.9", models.StatementFormatPDF},
+		{"application/json, text/csv", ""},
+		{"image/png", ""},
+		{"Application/PDF", models.StatementFormatPDF},
+	}
+
+	for _, tt := range tests {
+		s.Equal(tt.expected, negotiateStatementFormat(tt.accept), tt.accept)
+	}
+}
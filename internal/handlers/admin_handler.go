@@ -34,7 +34,7 @@ func NewAdminHandler(userRepo repositories.UserRepositoryInterface, auditRepo re
 // @Success 200 {object} SuccessResponse "User unlocked successfully"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid user ID"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - User not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /admin/users/{userId}/unlock [post]
@@ -81,7 +81,7 @@ func (h *AdminHandler) UnlockUser(c echo.Context) error {
 // @Success 200 {object} SuccessResponse "Users retrieved successfully with pagination metadata"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /admin/users [get]
 func (h *AdminHandler) ListUsers(c echo.Context) error {
@@ -138,7 +138,7 @@ func (h *AdminHandler) ListUsers(c echo.Context) error {
 // @Success 200 {object} SuccessResponse "User retrieved successfully"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid user ID"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - User not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /admin/users/{userId} [get]
@@ -184,7 +184,7 @@ func (h *AdminHandler) GetUserByID(c echo.Context) error {
 // @Success 200 {object} SuccessResponse "User deleted successfully"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid user ID or cannot delete own account"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - User not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /admin/users/{userId} [delete]
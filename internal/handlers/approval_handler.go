@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// ApprovalHandler handles maker-checker approval of high-risk staff actions
+type ApprovalHandler struct {
+	approvalService services.ApprovalServiceInterface
+}
+
+// NewApprovalHandler creates a new approval handler
+func NewApprovalHandler(approvalService services.ApprovalServiceInterface) *ApprovalHandler {
+	return &ApprovalHandler{
+		approvalService: approvalService,
+	}
+}
+
+// ListApprovals lists actions held for approval
+// @Summary List approval requests (admin)
+// @Description Lists high-risk staff actions held for a second admin's approval, oldest first. Requires the approvals:review permission.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param status query string false "Filter by status" Enums(pending_approval, approved, rejected, expired)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.PendingAction} "Approval requests"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid status or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/approvals [get]
+func (h *ApprovalHandler) ListApprovals(c echo.Context) error {
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	actions, total, err := h.approvalService.ListActions(c.QueryParam("status"), (page-1)*limit, limit)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidPendingActionStatus) {
+			return SendError(c, apierrors.ValidationGeneral,
+				apierrors.WithDetails("status: must be one of pending_approval, approved, rejected, expired"))
+		}
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: actions,
+		Meta: map[string]interface{}{
+			"total":       total,
+			"page":        page,
+			"limit":       limit,
+			"total_pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetApproval retrieves an action held for approval
+// @Summary Get approval request (admin)
+// @Description Retrieves an action held for approval with its payload and any decision. Requires the approvals:review permission.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Approval request ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.PendingAction} "Approval request"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid approval request ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "APPROVAL_001 - Approval request not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/approvals/{id} [get]
+func (h *ApprovalHandler) GetApproval(c echo.Context) error {
+	actionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Approval request ID must be a valid UUID"))
+	}
+
+	action, err := h.approvalService.GetAction(actionID)
+	if err != nil {
+		return sendApprovalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: action,
+	})
+}
+
+// Approve approves an action held for approval and carries it out
+// @Summary Approve a request (admin)
+// @Description Approves an action another admin submitted and carries it out. The approver needs the approvals:review permission and the permission the action itself requires. If the action then fails, for example because the customer still has a balance, the request stays approved and its failure_reason says why. The result of an approved password reset carries the temporary password, which is shown only here.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Approval request ID (UUID)"
+// @Param request body dto.ApprovalDecisionRequest true "Decision reason"
+// @Success 200 {object} SuccessResponse{data=dto.ApprovalDecisionResponse} "Request approved"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid approval request ID or reason"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission, AUTH_008 - MFA step-up required, APPROVAL_004 - Own request"
+// @Failure 404 {object} errors.ErrorResponse "APPROVAL_001 - Approval request not found"
+// @Failure 409 {object} errors.ErrorResponse "APPROVAL_002 - Request already decided, APPROVAL_003 - Request expired"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/approvals/{id}/approve [post]
+func (h *ApprovalHandler) Approve(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	actionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Approval request ID must be a valid UUID"))
+	}
+
+	var req dto.ApprovalDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	action, result, err := h.approvalService.ApproveAction(actionID, adminID, getPermissionsFromContext(c), req.Reason, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return sendApprovalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: dto.ApprovalDecisionResponse{
+			Action: action,
+			Result: result,
+		},
+		Message: "Request approved",
+	})
+}
+
+// Reject rejects an action held for approval so it is never carried out
+// @Summary Reject a request (admin)
+// @Description Rejects an action another admin submitted; it is never carried out. Requires the approvals:review permission.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Approval request ID (UUID)"
+// @Param request body dto.ApprovalDecisionRequest true "Decision reason"
+// @Success 200 {object} SuccessResponse{data=dto.ApprovalDecisionResponse} "Request rejected"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid approval request ID or reason"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission, APPROVAL_004 - Own request"
+// @Failure 404 {object} errors.ErrorResponse "APPROVAL_001 - Approval request not found"
+// @Failure 409 {object} errors.ErrorResponse "APPROVAL_002 - Request already decided, APPROVAL_003 - Request expired"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/approvals/{id}/reject [post]
+func (h *ApprovalHandler) Reject(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	actionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Approval request ID must be a valid UUID"))
+	}
+
+	var req dto.ApprovalDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	action, err := h.approvalService.RejectAction(actionID, adminID, req.Reason, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return sendApprovalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: dto.ApprovalDecisionResponse{
+			Action: action,
+		},
+		Message: "Request rejected",
+	})
+}
+
+// submitForApproval holds an action for a second admin's approval instead of
+// carrying it out, and responds with the pending request
+func submitForApproval(c echo.Context, approvalService services.ApprovalServiceInterface, actionType, resourceID string, payload map[string]interface{}) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	action, err := approvalService.SubmitAction(actionType, resourceID, payload, adminID, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, SuccessResponse{
+		Data:    action,
+		Message: "Awaiting approval by a second admin",
+	})
+}
+
+func sendApprovalError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrPendingActionNotFound):
+		return SendError(c, apierrors.ApprovalNotFound)
+	case errors.Is(err, services.ErrPendingActionNotPending):
+		return SendError(c, apierrors.ApprovalNotPending)
+	case errors.Is(err, services.ErrPendingActionExpired):
+		return SendError(c, apierrors.ApprovalExpired)
+	case errors.Is(err, services.ErrCannotDecideOwnAction):
+		return SendError(c, apierrors.ApprovalOwnRequest)
+	case errors.Is(err, services.ErrMissingActionPermission):
+		return SendError(c, apierrors.AuthInsufficientPermission,
+			apierrors.WithDetails("Approving this request requires the permission the action itself requires"))
+	default:
+		return SendSystemError(c, err)
+	}
+}
@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// ApprovalHandlerSuite defines the test suite for ApprovalHandler
+type ApprovalHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockApprovalServiceInterface
+	handler     *ApprovalHandler
+	echo        *echo.Echo
+	adminID     uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *ApprovalHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockApprovalServiceInterface(s.ctrl)
+	s.handler = NewApprovalHandler(s.mockService)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *ApprovalHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestApprovalHandlerSuite runs the test suite
+func TestApprovalHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ApprovalHandlerSuite))
+}
+
+func (s *ApprovalHandlerSuite) assertErrorCode(rec *httptest.ResponseRecorder, expectedCode string) {
+	if expectedCode == "" {
+		return
+	}
+	var resp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(expectedCode, resp.Error.Code)
+}
+
+func (s *ApprovalHandlerSuite) TestListApprovals() {
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:  "lists pending requests",
+			query: "?status=pending_approval&page=2&limit=10",
+			setupMocks: func() {
+				s.mockService.EXPECT().ListActions(models.PendingActionStatusPending, 10, 10).Return([]models.PendingAction{{ID: uuid.New()}}, int64(11), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "invalid status",
+			query: "?status=bogus",
+			setupMocks: func() {
+				s.mockService.EXPECT().ListActions("bogus", 0, 20).Return(nil, int64(0), services.ErrInvalidPendingActionStatus)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_001",
+		},
+		{
+			name:           "limit out of range",
+			query:          "?limit=500",
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_001",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/approvals"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.Set("user_id", s.adminID)
+
+			s.NoError(s.handler.ListApprovals(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
+
+func (s *ApprovalHandlerSuite) TestGetApproval_NotFound() {
+	actionID := uuid.New()
+	s.mockService.EXPECT().GetAction(actionID).Return(nil, services.ErrPendingActionNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/approvals/"+actionID.String(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(actionID.String())
+	c.Set("user_id", s.adminID)
+
+	s.NoError(s.handler.GetApproval(c))
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.assertErrorCode(rec, "APPROVAL_001")
+}
+
+func (s *ApprovalHandlerSuite) TestDecisions() {
+	actionID := uuid.New()
+	permissions := []string{models.PermissionApprovalsReview, models.PermissionCustomersResetPassword}
+	action := &models.PendingAction{
+		ID:          actionID,
+		ActionType:  models.PendingActionResetPassword,
+		ResourceID:  uuid.New().String(),
+		Status:      models.PendingActionStatusApproved,
+		RequestedBy: uuid.New(),
+	}
+
+	tests := []struct {
+		name           string
+		reject         bool
+		body           string
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+		expectedResult map[string]interface{}
+	}{
+		{
+			name: "approves and returns the result",
+			body: `{"reason":"Identity verified by phone"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().ApproveAction(actionID, s.adminID, permissions, "Identity verified by phone", gomock.Any(), gomock.Any()).
+					Return(action, map[string]interface{}{"temporary_password": "Tmp-Pass-123"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResult: map[string]interface{}{"temporary_password": "Tmp-Pass-123"},
+		},
+		{
+			name:   "rejects",
+			reject: true,
+			body:   `{"reason":"Caller could not be verified"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().RejectAction(actionID, s.adminID, "Caller could not be verified", gomock.Any(), gomock.Any()).Return(action, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "reason is required",
+			body:           `{}`,
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "own request",
+			body: `{"reason":"Looks fine"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().ApproveAction(actionID, s.adminID, permissions, "Looks fine", gomock.Any(), gomock.Any()).Return(nil, nil, services.ErrCannotDecideOwnAction)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "APPROVAL_004",
+		},
+		{
+			name: "approver lacks the action's permission",
+			body: `{"reason":"Looks fine"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().ApproveAction(actionID, s.adminID, permissions, "Looks fine", gomock.Any(), gomock.Any()).Return(nil, nil, services.ErrMissingActionPermission)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "AUTH_005",
+		},
+		{
+			name:   "already decided",
+			reject: true,
+			body:   `{"reason":"Looks wrong"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().RejectAction(actionID, s.adminID, "Looks wrong", gomock.Any(), gomock.Any()).Return(nil, services.ErrPendingActionNotPending)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "APPROVAL_002",
+		},
+		{
+			name: "expired",
+			body: `{"reason":"Looks fine"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().ApproveAction(actionID, s.adminID, permissions, "Looks fine", gomock.Any(), gomock.Any()).Return(nil, nil, services.ErrPendingActionExpired)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "APPROVAL_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			decision, decide := "approve", s.handler.Approve
+			if tt.reject {
+				decision, decide = "reject", s.handler.Reject
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/approvals/"+actionID.String()+"/"+decision, bytes.NewReader([]byte(tt.body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(actionID.String())
+			c.Set("user_id", s.adminID)
+			c.Set("user_permissions", permissions)
+
+			s.NoError(decide(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+
+			if tt.expectedResult != nil {
+				var resp struct {
+					Data struct {
+						Result map[string]interface{} `json:"result"`
+					} `json:"data"`
+				}
+				s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+				s.Equal(tt.expectedResult, resp.Data.Result)
+			}
+		})
+	}
+}
@@ -74,12 +74,12 @@ func (h *AuthHandler) Register(c echo.Context) error {
 
 // Login handles user authentication
 // @Summary Login user
-// @Description Authenticate user with email and password, receive JWT access and refresh tokens
+// @Description Authenticate user with email and password, receive JWT access and refresh tokens. Users with MFA enabled, or required to use it, receive mfaRequired and a challengeToken instead, to exchange for tokens at POST /auth/login/mfa; mfaEnrollmentRequired means they must enroll first.
 // @Tags Authentication
 // @Accept json
 // @Produce json
 // @Param request body dto.LoginRequest true "Login credentials"
-// @Success 200 {object} dto.TokenResponse "Login successful with JWT tokens"
+// @Success 200 {object} dto.LoginResponse "Login successful with JWT tokens, or an MFA challenge"
 // @Failure 400 {object} errors.ErrorResponse "Validation error - AUTH_001"
 // @Failure 401 {object} errors.ErrorResponse "Invalid credentials - AUTH_002"
 // @Failure 403 {object} errors.ErrorResponse "Account locked - AUTH_006"
@@ -99,7 +99,7 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	ipAddress := getClientIP(c)
 	userAgent := c.Request().UserAgent()
 
-	tokens, err := h.authService.Login(&req, ipAddress, userAgent)
+	response, err := h.authService.Login(&req, ipAddress, userAgent)
 	if err != nil {
 		if err == services.ErrAccountLocked {
 			return SendError(c, errors.AuthAccountLocked)
@@ -110,6 +110,114 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		return SendSystemError(c, err)
 	}
 
+	return c.JSON(http.StatusOK, response)
+}
+
+// CompleteMFALogin exchanges a login challenge and a code for tokens
+// @Summary Complete MFA login
+// @Description Exchange the challengeToken from login and a code from the authenticator app, or a recovery code, for JWT tokens. Users enrolling during login confirm their new authenticator with its first code, and the response includes their recovery codes. Each challenge can be used once.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param request body dto.MFALoginRequest true "Challenge and code"
+// @Success 200 {object} dto.LoginResponse "Login successful with JWT tokens"
+// @Failure 400 {object} errors.ErrorResponse "Validation error - VALIDATION_001"
+// @Failure 401 {object} errors.ErrorResponse "Invalid or expired challenge - AUTH_004, invalid code - AUTH_007"
+// @Failure 403 {object} errors.ErrorResponse "Account locked - AUTH_006"
+// @Failure 409 {object} errors.ErrorResponse "Enrollment not started - AUTH_010"
+// @Failure 500 {object} errors.ErrorResponse "System error - SYSTEM_001 or SYSTEM_002"
+// @Router /auth/login/mfa [post]
+func (h *AuthHandler) CompleteMFALogin(c echo.Context) error {
+	var req dto.MFALoginRequest
+
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	response, err := h.authService.CompleteMFALogin(&req, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return sendMFAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// BeginMFALoginEnrollment starts MFA enrollment during login
+// @Summary Start MFA enrollment during login
+// @Description For users required to use MFA who have not enrolled (mfaEnrollmentRequired at login). Generates an authenticator secret using the login challengeToken; render provisioningUri as a QR code, then complete login at POST /auth/login/mfa with the first code.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param request body dto.MFAChallengeRequest true "Login challenge"
+// @Success 200 {object} SuccessResponse{data=dto.MFAEnrollmentResponse} "Enrollment started"
+// @Failure 400 {object} errors.ErrorResponse "Validation error - VALIDATION_001"
+// @Failure 401 {object} errors.ErrorResponse "Invalid or expired challenge - AUTH_004"
+// @Failure 403 {object} errors.ErrorResponse "Account locked - AUTH_006"
+// @Failure 409 {object} errors.ErrorResponse "MFA already enabled - AUTH_009"
+// @Failure 500 {object} errors.ErrorResponse "System error - SYSTEM_001 or SYSTEM_002"
+// @Router /auth/login/mfa/enroll [post]
+func (h *AuthHandler) BeginMFALoginEnrollment(c echo.Context) error {
+	var req dto.MFAChallengeRequest
+
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	enrollment, err := h.authService.BeginMFALoginEnrollment(req.ChallengeToken, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return sendMFAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    enrollment,
+		Message: "Scan the QR code with your authenticator app and complete login with a code",
+	})
+}
+
+// StepUp verifies a code for a signed-in user
+// @Summary Verify MFA for a sensitive operation
+// @Description Verify a code from the authenticator app, or a recovery code, and receive a new access token recording the verification. Transfers, email and password changes and admin password resets require one from the last few minutes (AUTH_008) for users with MFA enabled.
+// @Tags Authentication
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.MFACodeRequest true "Authenticator or recovery code"
+// @Success 200 {object} dto.TokenResponse "Access token with recent MFA"
+// @Failure 400 {object} errors.ErrorResponse "Validation error - VALIDATION_001"
+// @Failure 401 {object} errors.ErrorResponse "Unauthorized - AUTH_002, invalid code - AUTH_007"
+// @Failure 403 {object} errors.ErrorResponse "Account locked - AUTH_006"
+// @Failure 409 {object} errors.ErrorResponse "MFA not enabled - AUTH_010"
+// @Failure 500 {object} errors.ErrorResponse "System error - SYSTEM_001"
+// @Router /auth/mfa/verify [post]
+func (h *AuthHandler) StepUp(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	var req dto.MFACodeRequest
+
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	tokens, err := h.authService.StepUp(userID, getSessionIDFromContext(c), req.Code, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return sendMFAError(c, err)
+	}
+
 	return c.JSON(http.StatusOK, tokens)
 }
 
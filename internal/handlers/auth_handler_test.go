@@ -206,10 +206,10 @@ func (s *AuthHandlerSuite) TestLogin() {
 		// Setup mock expectations
 		s.authService.EXPECT().
 			Login(gomock.Any(), gomock.Any(), gomock.Any()).
-			DoAndReturn(func(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+			DoAndReturn(func(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.LoginResponse, error) {
 				s.Equal(email, req.Email)
 				s.Equal(password, req.Password)
-				return expectedTokens, nil
+				return &dto.LoginResponse{TokenResponse: expectedTokens}, nil
 			}).
 			Times(1)
 
@@ -528,3 +528,120 @@ func (s *AuthHandlerSuite) TestLogout() {
 		s.Equal("Logout successful", response.Message)
 	})
 }
+
+func (s *AuthHandlerSuite) TestLogin_MFAChallenge() {
+	expiresAt := time.Now().Add(5 * time.Minute)
+	s.authService.EXPECT().
+		Login(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dto.LoginResponse{MFARequired: true, ChallengeToken: "challenge.token", ChallengeExpiresAt: &expiresAt}, nil).
+		Times(1)
+
+	body, _ := json.Marshal(map[string]string{"email": "mfa@example.com", "password": "SecurePassword123!"})
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	s.NoError(s.handler.Login(s.e.NewContext(req, rec)))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var response map[string]interface{}
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(true, response["mfaRequired"])
+	s.Equal("challenge.token", response["challengeToken"])
+	s.NotContains(response, "accessToken")
+}
+
+func (s *AuthHandlerSuite) TestCompleteMFALogin() {
+	s.Run("successful verification", func() {
+		ctrl := gomock.NewController(s.T())
+		defer ctrl.Finish()
+		s.authService = service_mocks.NewMockAuthServiceInterface(ctrl)
+		s.handler = NewAuthHandler(s.authService)
+
+		s.authService.EXPECT().
+			CompleteMFALogin(&dto.MFALoginRequest{ChallengeToken: "challenge.token", Code: "123456"}, gomock.Any(), gomock.Any()).
+			Return(&dto.LoginResponse{TokenResponse: &dto.TokenResponse{AccessToken: "access.token", RefreshToken: "refresh.token", TokenType: "Bearer"}}, nil).
+			Times(1)
+
+		body, _ := json.Marshal(map[string]string{"challengeToken": "challenge.token", "code": "123456"})
+		req := httptest.NewRequest(http.MethodPost, "/login/mfa", bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		s.NoError(s.handler.CompleteMFALogin(s.e.NewContext(req, rec)))
+		s.Equal(http.StatusOK, rec.Code)
+
+		var response map[string]interface{}
+		s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+		s.Equal("access.token", response["accessToken"])
+	})
+
+	s.Run("invalid code", func() {
+		ctrl := gomock.NewController(s.T())
+		defer ctrl.Finish()
+		s.authService = service_mocks.NewMockAuthServiceInterface(ctrl)
+		s.handler = NewAuthHandler(s.authService)
+
+		s.authService.EXPECT().
+			CompleteMFALogin(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, services.ErrInvalidMFACode).
+			Times(1)
+
+		body, _ := json.Marshal(map[string]string{"challengeToken": "challenge.token", "code": "000000"})
+		req := httptest.NewRequest(http.MethodPost, "/login/mfa", bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		s.NoError(s.handler.CompleteMFALogin(s.e.NewContext(req, rec)))
+		s.Equal(http.StatusUnauthorized, rec.Code)
+
+		var errorResp ErrorResponse
+		s.NoError(json.Unmarshal(rec.Body.Bytes(), &errorResp))
+		s.Equal("AUTH_007", errorResp.Error.Code)
+	})
+
+	s.Run("used challenge", func() {
+		ctrl := gomock.NewController(s.T())
+		defer ctrl.Finish()
+		s.authService = service_mocks.NewMockAuthServiceInterface(ctrl)
+		s.handler = NewAuthHandler(s.authService)
+
+		s.authService.EXPECT().
+			CompleteMFALogin(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, services.ErrInvalidMFAChallenge).
+			Times(1)
+
+		body, _ := json.Marshal(map[string]string{"challengeToken": "challenge.token", "code": "123456"})
+		req := httptest.NewRequest(http.MethodPost, "/login/mfa", bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		s.NoError(s.handler.CompleteMFALogin(s.e.NewContext(req, rec)))
+		s.Equal(http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func (s *AuthHandlerSuite) TestStepUp() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	s.authService.EXPECT().
+		StepUp(userID, sessionID, "123456", gomock.Any(), gomock.Any()).
+		Return(&dto.TokenResponse{AccessToken: "access.token", TokenType: "Bearer"}, nil).
+		Times(1)
+
+	body, _ := json.Marshal(map[string]string{"code": "123456"})
+	req := httptest.NewRequest(http.MethodPost, "/mfa/verify", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.e.NewContext(req, rec)
+	c.Set("user_id", userID)
+	c.Set("session_id", sessionID)
+
+	s.NoError(s.handler.StepUp(c))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var response map[string]interface{}
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal("access.token", response["accessToken"])
+	s.NotContains(response, "refreshToken")
+}
@@ -0,0 +1,528 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Audit resources for category administration
+const (
+	auditResourceCategory        = "transaction_category"
+	auditResourceMerchantMapping = "merchant_mapping"
+)
+
+// CategoryHandler handles admin management of transaction categories and merchant mapping rules
+type CategoryHandler struct {
+	categoryService services.CategoryManagementServiceInterface
+	auditRepo       repositories.AuditLogRepositoryInterface
+}
+
+// NewCategoryHandler creates a new category handler
+func NewCategoryHandler(categoryService services.CategoryManagementServiceInterface, auditRepo repositories.AuditLogRepositoryInterface) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+		auditRepo:       auditRepo,
+	}
+}
+
+// ListCategories lists the category hierarchy
+// @Summary List categories (admin)
+// @Description Admin endpoint to list transaction categories nested under their parent categories
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param include_inactive query bool false "Include deactivated categories" default(false)
+// @Success 200 {object} SuccessResponse{data=[]models.CategoryNode} "Category hierarchy"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/categories [get]
+func (h *CategoryHandler) ListCategories(c echo.Context) error {
+	includeInactive, _ := strconv.ParseBool(c.QueryParam("include_inactive"))
+
+	categories, err := h.categoryService.ListCategories(includeInactive)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: categories,
+	})
+}
+
+// GetCategory retrieves a category by code
+// @Summary Get category (admin)
+// @Description Admin endpoint to retrieve a transaction category by its code
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param code path string true "Category code"
+// @Success 200 {object} SuccessResponse{data=models.TransactionCategory} "Category"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_001 - Category not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/categories/{code} [get]
+func (h *CategoryHandler) GetCategory(c echo.Context) error {
+	category, err := h.categoryService.GetCategory(c.Param("code"))
+	if err != nil {
+		return h.sendCategoryError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: category,
+	})
+}
+
+// CreateCategory creates a new category
+// @Summary Create category (admin)
+// @Description Admin endpoint to create a transaction category, optionally nested under a parent category
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateCategoryRequest true "Category details"
+// @Success 201 {object} SuccessResponse{data=models.TransactionCategory} "Category created"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 422 {object} errors.ErrorResponse "CATEGORY_002 - Category already exists or CATEGORY_003 - Invalid parent category"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/categories [post]
+func (h *CategoryHandler) CreateCategory(c echo.Context) error {
+	var req dto.CreateCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	category, err := h.categoryService.CreateCategory(&req)
+	if err != nil {
+		return h.sendCategoryError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionCreate, auditResourceCategory, category.Code, models.JSONBMap{
+		"name":                 category.Name,
+		"parent_category_code": category.ParentCategoryCode,
+	})
+
+	return c.JSON(http.StatusCreated, SuccessResponse{
+		Data:    category,
+		Message: "Category created successfully",
+	})
+}
+
+// UpdateCategory updates a category
+// @Summary Update category (admin)
+// @Description Admin endpoint to update a transaction category. An empty parent_category_code moves the category to the top level.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param code path string true "Category code"
+// @Param request body dto.UpdateCategoryRequest true "Category updates"
+// @Success 200 {object} SuccessResponse{data=models.TransactionCategory} "Category updated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_001 - Category not found"
+// @Failure 422 {object} errors.ErrorResponse "CATEGORY_003 - Invalid parent category or CATEGORY_004 - Category cannot be deactivated"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/categories/{code} [patch]
+func (h *CategoryHandler) UpdateCategory(c echo.Context) error {
+	var req dto.UpdateCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	if req == (dto.UpdateCategoryRequest{}) {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("At least one field must be provided for update"))
+	}
+
+	category, err := h.categoryService.UpdateCategory(c.Param("code"), &req)
+	if err != nil {
+		return h.sendCategoryError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionUpdate, auditResourceCategory, category.Code, models.JSONBMap{
+		"changes": req,
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    category,
+		Message: "Category updated successfully",
+	})
+}
+
+// DeactivateCategory deactivates a category
+// @Summary Deactivate category (admin)
+// @Description Admin endpoint to deactivate a transaction category. The OTHER fallback category cannot be deactivated.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param code path string true "Category code"
+// @Success 200 {object} SuccessResponse{data=models.TransactionCategory} "Category deactivated"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_001 - Category not found"
+// @Failure 422 {object} errors.ErrorResponse "CATEGORY_004 - Category cannot be deactivated"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/categories/{code} [delete]
+func (h *CategoryHandler) DeactivateCategory(c echo.Context) error {
+	category, err := h.categoryService.DeactivateCategory(c.Param("code"))
+	if err != nil {
+		return h.sendCategoryError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionDelete, auditResourceCategory, category.Code, nil)
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    category,
+		Message: "Category deactivated successfully",
+	})
+}
+
+// ReorderCategories moves and reorders categories
+// @Summary Reorder categories (admin)
+// @Description Admin endpoint to change the parent and display order of several categories at once
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.ReorderCategoriesRequest true "Category positions"
+// @Success 200 {object} SuccessResponse{data=[]models.CategoryNode} "Updated category hierarchy"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_001 - Category not found"
+// @Failure 422 {object} errors.ErrorResponse "CATEGORY_003 - Invalid parent category"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/categories/reorder [put]
+func (h *CategoryHandler) ReorderCategories(c echo.Context) error {
+	var req dto.ReorderCategoriesRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	categories, err := h.categoryService.ReorderCategories(&req)
+	if err != nil {
+		return h.sendCategoryError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionUpdate, auditResourceCategory, "", models.JSONBMap{
+		"positions": req.Categories,
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    categories,
+		Message: "Categories reordered successfully",
+	})
+}
+
+// ListMerchantMappings lists merchant mapping rules
+// @Summary List merchant mappings (admin)
+// @Description Admin endpoint to list categorization rules with filtering and pagination
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param category query string false "Filter by category code"
+// @Param match_type query string false "Filter by match type" Enums(EXACT, PARTIAL, FUZZY, REGEX, MCC, KEYWORD)
+// @Param is_active query bool false "Filter by active status"
+// @Param search query string false "Search merchant pattern and normalized name"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.MerchantMapping} "Merchant mappings with pagination metadata"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid query parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/merchant-mappings [get]
+func (h *CategoryHandler) ListMerchantMappings(c echo.Context) error {
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	filters := models.MerchantMappingFilters{
+		CategoryCode: c.QueryParam("category"),
+		MatchType:    c.QueryParam("match_type"),
+		Search:       c.QueryParam("search"),
+	}
+
+	if filters.MatchType != "" && !models.IsValidMatchType(filters.MatchType) {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("match_type: must be one of EXACT, PARTIAL, FUZZY, REGEX, MCC, KEYWORD"))
+	}
+
+	if isActiveParam := c.QueryParam("is_active"); isActiveParam != "" {
+		isActive, err := strconv.ParseBool(isActiveParam)
+		if err != nil {
+			return SendError(c, apierrors.ValidationGeneral,
+				apierrors.WithDetails("is_active: must be true or false"))
+		}
+		filters.IsActive = &isActive
+	}
+
+	offset := (page - 1) * limit
+
+	mappings, total, err := h.categoryService.ListMerchantMappings(filters, offset, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: mappings,
+		Meta: map[string]interface{}{
+			"total":       total,
+			"page":        page,
+			"limit":       limit,
+			"total_pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetMerchantMapping retrieves a merchant mapping rule
+// @Summary Get merchant mapping (admin)
+// @Description Admin endpoint to retrieve a categorization rule by ID
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Merchant mapping ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.MerchantMapping} "Merchant mapping"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid merchant mapping ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_005 - Merchant mapping not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/merchant-mappings/{id} [get]
+func (h *CategoryHandler) GetMerchantMapping(c echo.Context) error {
+	mappingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Merchant mapping ID must be a valid UUID"))
+	}
+
+	mapping, err := h.categoryService.GetMerchantMapping(mappingID)
+	if err != nil {
+		return h.sendCategoryError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: mapping,
+	})
+}
+
+// CreateMerchantMapping creates a merchant mapping rule
+// @Summary Create merchant mapping (admin)
+// @Description Admin endpoint to create a categorization rule. Changes take effect immediately.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateMerchantMappingRequest true "Merchant mapping details"
+// @Success 201 {object} SuccessResponse{data=models.MerchantMapping} "Merchant mapping created"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_001 - Category not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/merchant-mappings [post]
+func (h *CategoryHandler) CreateMerchantMapping(c echo.Context) error {
+	var req dto.CreateMerchantMappingRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	mapping, err := h.categoryService.CreateMerchantMapping(&req)
+	if err != nil {
+		return h.sendCategoryError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionCreate, auditResourceMerchantMapping, mapping.ID.String(), models.JSONBMap{
+		"merchant_pattern": mapping.MerchantPattern,
+		"match_type":       mapping.MatchType,
+		"category_code":    mapping.CategoryCode,
+	})
+
+	return c.JSON(http.StatusCreated, SuccessResponse{
+		Data:    mapping,
+		Message: "Merchant mapping created successfully",
+	})
+}
+
+// UpdateMerchantMapping updates a merchant mapping rule
+// @Summary Update merchant mapping (admin)
+// @Description Admin endpoint to update a categorization rule. Changes take effect immediately.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Merchant mapping ID (UUID)"
+// @Param request body dto.UpdateMerchantMappingRequest true "Merchant mapping updates"
+// @Success 200 {object} SuccessResponse{data=models.MerchantMapping} "Merchant mapping updated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or merchant mapping ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_005 - Merchant mapping not found or CATEGORY_001 - Category not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/merchant-mappings/{id} [patch]
+func (h *CategoryHandler) UpdateMerchantMapping(c echo.Context) error {
+	mappingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Merchant mapping ID must be a valid UUID"))
+	}
+
+	var req dto.UpdateMerchantMappingRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	if req == (dto.UpdateMerchantMappingRequest{}) {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("At least one field must be provided for update"))
+	}
+
+	mapping, err := h.categoryService.UpdateMerchantMapping(mappingID, &req)
+	if err != nil {
+		return h.sendCategoryError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionUpdate, auditResourceMerchantMapping, mapping.ID.String(), models.JSONBMap{
+		"changes": req,
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    mapping,
+		Message: "Merchant mapping updated successfully",
+	})
+}
+
+// DeactivateMerchantMapping deactivates a merchant mapping rule
+// @Summary Deactivate merchant mapping (admin)
+// @Description Admin endpoint to deactivate a categorization rule. Changes take effect immediately.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Merchant mapping ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.MerchantMapping} "Merchant mapping deactivated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid merchant mapping ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_005 - Merchant mapping not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/merchant-mappings/{id} [delete]
+func (h *CategoryHandler) DeactivateMerchantMapping(c echo.Context) error {
+	mappingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Merchant mapping ID must be a valid UUID"))
+	}
+
+	mapping, err := h.categoryService.DeactivateMerchantMapping(mappingID)
+	if err != nil {
+		return h.sendCategoryError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionDelete, auditResourceMerchantMapping, mapping.ID.String(), nil)
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    mapping,
+		Message: "Merchant mapping deactivated successfully",
+	})
+}
+
+// TestCategorization runs a sample transaction through the categorization rules
+// @Summary Test categorization rules (admin)
+// @Description Admin endpoint to preview how a sample description, merchant name and MCC code would be categorized. Rule usage statistics are not affected.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.TestCategorizationRequest true "Sample transaction"
+// @Success 200 {object} SuccessResponse{data=models.CategorizationResult} "Categorization result"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Router /admin/merchant-mappings/test [post]
+func (h *CategoryHandler) TestCategorization(c echo.Context) error {
+	var req dto.TestCategorizationRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	if req == (dto.TestCategorizationRequest{}) {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("At least one of description, merchant_name or mcc_code must be provided"))
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: h.categoryService.TestCategorization(&req),
+	})
+}
+
+// sendCategoryError maps category management errors to API error responses
+func (h *CategoryHandler) sendCategoryError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrCategoryNotFound):
+		return SendError(c, apierrors.CategoryNotFound)
+	case errors.Is(err, services.ErrCategoryAlreadyExists):
+		return SendError(c, apierrors.CategoryAlreadyExists)
+	case errors.Is(err, services.ErrInvalidParentCategory):
+		return SendError(c, apierrors.CategoryInvalidParent, apierrors.WithDetails(err.Error()))
+	case errors.Is(err, services.ErrCategoryProtected):
+		return SendError(c, apierrors.CategoryProtected)
+	case errors.Is(err, services.ErrMerchantMappingNotFound):
+		return SendError(c, apierrors.MerchantMappingNotFound)
+	case errors.Is(err, services.ErrInvalidMerchantMapping):
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	default:
+		return SendSystemError(c, err)
+	}
+}
+
+// createAuditLog records an admin change to the categorization rules
+func (h *CategoryHandler) createAuditLog(c echo.Context, action, resource, resourceID string, metadata models.JSONBMap) {
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		Action:     action,
+		Resource:   resource,
+		ResourceID: resourceID,
+		Metadata:   metadata,
+	})
+}
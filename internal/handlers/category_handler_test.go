@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// CategoryHandlerSuite defines the test suite for CategoryHandler
+type CategoryHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockCategoryManagementServiceInterface
+	auditRepo   *repository_mocks.MockAuditLogRepositoryInterface
+	handler     *CategoryHandler
+	echo        *echo.Echo
+	adminID     uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *CategoryHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockCategoryManagementServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewCategoryHandler(s.mockService, s.auditRepo)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *CategoryHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestCategoryHandlerSuite runs the test suite
+func TestCategoryHandlerSuite(t *testing.T) {
+	suite.Run(t, new(CategoryHandlerSuite))
+}
+
+// newContext builds a request context authenticated as the admin user
+func (s *CategoryHandlerSuite) newContext(method, target string, body interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+
+	req := httptest.NewRequest(method, target, bytes.NewReader(payload))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+
+	return c, rec
+}
+
+// errorCode extracts the error code from an error response
+func (s *CategoryHandlerSuite) errorCode(rec *httptest.ResponseRecorder) string {
+	var resp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp.Error.Code
+}
+
+func (s *CategoryHandlerSuite) TestListCategories_IncludeInactive() {
+	parent := "FOOD"
+	tree := []*models.CategoryNode{{
+		TransactionCategory: models.TransactionCategory{Code: parent, Name: "Food", IsActive: true},
+		Children: []*models.CategoryNode{{
+			TransactionCategory: models.TransactionCategory{Code: "COFFEE", Name: "Coffee", ParentCategoryCode: &parent},
+		}},
+	}}
+	s.mockService.EXPECT().ListCategories(true).Return(tree, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/api/v1/admin/categories?include_inactive=true", nil)
+
+	s.NoError(s.handler.ListCategories(c))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data []*models.CategoryNode `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Require().Len(resp.Data, 1)
+	s.Require().Len(resp.Data[0].Children, 1)
+	s.Equal("COFFEE", resp.Data[0].Children[0].Code)
+}
+
+func (s *CategoryHandlerSuite) TestCreateCategory() {
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "creates category and writes audit log",
+			body: dto.CreateCategoryRequest{Code: "COFFEE", Name: "Coffee"},
+			setupMocks: func() {
+				s.mockService.EXPECT().CreateCategory(gomock.Any()).
+					Return(&models.TransactionCategory{Code: "COFFEE", Name: "Coffee", IsActive: true}, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(models.AuditActionCreate, log.Action)
+					s.Equal(auditResourceCategory, log.Resource)
+					s.Equal("COFFEE", log.ResourceID)
+					s.Equal(s.adminID, *log.UserID)
+					return nil
+				})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "missing name fails validation",
+			body:           dto.CreateCategoryRequest{Code: "COFFEE"},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "duplicate code",
+			body: dto.CreateCategoryRequest{Code: "DINING", Name: "Dining"},
+			setupMocks: func() {
+				s.mockService.EXPECT().CreateCategory(gomock.Any()).Return(nil, services.ErrCategoryAlreadyExists)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "CATEGORY_002",
+		},
+		{
+			name: "unknown parent",
+			body: dto.CreateCategoryRequest{Code: "COFFEE", Name: "Coffee"},
+			setupMocks: func() {
+				s.mockService.EXPECT().CreateCategory(gomock.Any()).Return(nil, services.ErrInvalidParentCategory)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "CATEGORY_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			c, rec := s.newContext(http.MethodPost, "/api/v1/admin/categories", tt.body)
+
+			s.NoError(s.handler.CreateCategory(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				s.Equal(tt.expectedCode, s.errorCode(rec))
+			}
+		})
+	}
+}
+
+func (s *CategoryHandlerSuite) TestUpdateCategory_EmptyBody() {
+	c, rec := s.newContext(http.MethodPatch, "/api/v1/admin/categories/DINING", map[string]interface{}{})
+	c.SetParamNames("code")
+	c.SetParamValues("DINING")
+
+	s.NoError(s.handler.UpdateCategory(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *CategoryHandlerSuite) TestDeactivateCategory_Protected() {
+	s.mockService.EXPECT().DeactivateCategory(models.CategoryOther).Return(nil, services.ErrCategoryProtected)
+
+	c, rec := s.newContext(http.MethodDelete, "/api/v1/admin/categories/OTHER", nil)
+	c.SetParamNames("code")
+	c.SetParamValues(models.CategoryOther)
+
+	s.NoError(s.handler.DeactivateCategory(c))
+	s.Equal(http.StatusUnprocessableEntity, rec.Code)
+	s.Equal("CATEGORY_004", s.errorCode(rec))
+}
+
+func (s *CategoryHandlerSuite) TestGetMerchantMapping() {
+	tests := []struct {
+		name           string
+		id             string
+		setupMocks     func(id uuid.UUID)
+		expectedStatus int
+	}{
+		{
+			name: "found",
+			id:   uuid.New().String(),
+			setupMocks: func(id uuid.UUID) {
+				s.mockService.EXPECT().GetMerchantMapping(id).Return(&models.MerchantMapping{ID: id}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "not found",
+			id:   uuid.New().String(),
+			setupMocks: func(id uuid.UUID) {
+				s.mockService.EXPECT().GetMerchantMapping(id).Return(nil, services.ErrMerchantMappingNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid id",
+			id:             "not-a-uuid",
+			setupMocks:     func(uuid.UUID) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			id, _ := uuid.Parse(tt.id)
+			tt.setupMocks(id)
+
+			c, rec := s.newContext(http.MethodGet, "/api/v1/admin/merchant-mappings/"+tt.id, nil)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			s.NoError(s.handler.GetMerchantMapping(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func (s *CategoryHandlerSuite) TestListMerchantMappings_AppliesFilters() {
+	active := true
+	expectedFilters := models.MerchantMappingFilters{
+		CategoryCode: "GROCERIES",
+		MatchType:    models.MatchTypeRegex,
+		IsActive:     &active,
+	}
+	s.mockService.EXPECT().ListMerchantMappings(expectedFilters, 10, 10).
+		Return([]models.MerchantMapping{{MerchantPattern: "^KROGER"}}, int64(11), nil)
+
+	c, rec := s.newContext(http.MethodGet,
+		"/api/v1/admin/merchant-mappings?category=GROCERIES&match_type=REGEX&is_active=true&page=2&limit=10", nil)
+
+	s.NoError(s.handler.ListMerchantMappings(c))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp struct {
+		Meta map[string]interface{} `json:"meta"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(float64(2), resp.Meta["total_pages"])
+}
+
+func (s *CategoryHandlerSuite) TestListMerchantMappings_InvalidMatchType() {
+	c, rec := s.newContext(http.MethodGet, "/api/v1/admin/merchant-mappings?match_type=SOUNDEX", nil)
+
+	s.NoError(s.handler.ListMerchantMappings(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *CategoryHandlerSuite) TestCreateMerchantMapping_InvalidRule() {
+	s.mockService.EXPECT().CreateMerchantMapping(gomock.Any()).Return(nil, services.ErrInvalidMerchantMapping)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/admin/merchant-mappings", dto.CreateMerchantMappingRequest{
+		MerchantPattern: "([",
+		CategoryCode:    "GROCERIES",
+		MatchType:       models.MatchTypeRegex,
+	})
+
+	s.NoError(s.handler.CreateMerchantMapping(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *CategoryHandlerSuite) TestTestCategorization() {
+	result := &models.CategorizationResult{
+		Category:   models.CategoryGroceries,
+		Method:     models.CategorizationMethodMerchant,
+		Confidence: 0.95,
+	}
+	s.mockService.EXPECT().
+		TestCategorization(&dto.TestCategorizationRequest{MerchantName: "Whole Foods"}).
+		Return(result)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/admin/merchant-mappings/test",
+		dto.TestCategorizationRequest{MerchantName: "Whole Foods"})
+
+	s.NoError(s.handler.TestCategorization(c))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data models.CategorizationResult `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(models.CategoryGroceries, resp.Data.Category)
+}
+
+func (s *CategoryHandlerSuite) TestTestCategorization_EmptySample() {
+	c, rec := s.newContext(http.MethodPost, "/api/v1/admin/merchant-mappings/test", dto.TestCategorizationRequest{})
+
+	s.NoError(s.handler.TestCategorization(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 )
 
 // CustomerHandler handles customer-related HTTP requests
@@ -21,6 +22,7 @@ type CustomerHandler struct {
 	accountService  services.AccountAssociationServiceInterface
 	passwordService services.PasswordServiceInterface
 	auditService    services.AuditServiceInterface
+	approvalService services.ApprovalServiceInterface
 	logger          services.CustomerLoggerInterface
 	metrics         services.MetricsRecorderInterface
 }
@@ -32,6 +34,7 @@ func NewCustomerHandler(
 	accountService services.AccountAssociationServiceInterface,
 	passwordService services.PasswordServiceInterface,
 	auditService services.AuditServiceInterface,
+	approvalService services.ApprovalServiceInterface,
 	logger services.CustomerLoggerInterface,
 	metrics services.MetricsRecorderInterface,
 ) *CustomerHandler {
@@ -41,6 +44,7 @@ func NewCustomerHandler(
 		accountService:  accountService,
 		passwordService: passwordService,
 		auditService:    auditService,
+		approvalService: approvalService,
 		logger:          logger,
 		metrics:         metrics,
 	}
@@ -48,19 +52,19 @@ func NewCustomerHandler(
 
 // SearchCustomers searches for customers (admin only)
 // @Summary Search customers (admin)
-// @Description Admin endpoint to search for customers by email, name, or account number
+// @Description Admin endpoint to search for customers by email, name, or account number. Searches are exact and case-insensitive except for the fuzzy type, which ranks customers by how closely their name and email resemble the query, tolerating typos; digit-only words in a fuzzy query match part of an account number, so "jon smth 4821" finds a John Smith holding an account containing 4821. Each result carries a match score from 0 to 1; exact matches score 1.
 // @Tags Customers
 // @Security BearerAuth
 // @Accept json
 // @Produce json
 // @Param q query string true "Search query"
-// @Param type query string false "Search type" Enums(email, name, first_name, last_name, account_number) default(email)
+// @Param type query string false "Search type" Enums(email, name, first_name, last_name, account_number, fuzzy) default(email)
 // @Param limit query int false "Results limit (max 1000)" default(10)
 // @Param offset query int false "Results offset" default(0)
 // @Success 200 {object} dto.SearchCustomersResponse "Customer search results"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request parameters"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /customers/search [get]
 func (h *CustomerHandler) SearchCustomers(c echo.Context) error {
@@ -88,7 +92,10 @@ func (h *CustomerHandler) SearchCustomers(c echo.Context) error {
 		req.Limit = 10
 	}
 
-	searchType := models.SearchTypeEmail
+	searchType := models.SearchType(req.Type)
+	if searchType == "" {
+		searchType = models.SearchTypeEmail
+	}
 
 	h.logger.LogCustomerSearchStarted(ctx, req.Query, string(searchType), adminUserID)
 
@@ -99,6 +106,9 @@ func (h *CustomerHandler) SearchCustomers(c echo.Context) error {
 		h.metrics.IncrementCounter("customer_search_request", map[string]string{"status": "failed"})
 		h.metrics.RecordProcessingTime("customer_search", duration)
 		h.logger.LogCustomerSearchFailed(ctx, err.Error(), duration.Milliseconds())
+		if err == services.ErrInvalidSearchType {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("type: must be one of email, name, first_name, last_name, account_number, fuzzy"))
+		}
 		return SendSystemError(c, err)
 	}
 
@@ -125,6 +135,7 @@ func (h *CustomerHandler) SearchCustomers(c echo.Context) error {
 			AccountCount: int(result.AccountCount),
 			LastLoginAt:  lastLoginAt,
 			CreatedAt:    createdAt,
+			Score:        result.Score,
 		}
 	}
 
@@ -152,7 +163,7 @@ func (h *CustomerHandler) SearchCustomers(c echo.Context) error {
 // @Success 200 {object} dto.GetCustomerProfileResponse "Customer profile"
 // @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID format"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - Customer not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /customers/{id} [get]
@@ -217,7 +228,7 @@ func (h *CustomerHandler) GetMyProfile(c echo.Context) error {
 // @Success 201 {object} dto.CreateCustomerResponse "Customer created successfully with temporary password"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 422 {object} errors.ErrorResponse "CUSTOMER_002 - Email already exists"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /customers [post]
@@ -271,7 +282,7 @@ func (h *CustomerHandler) CreateCustomer(c echo.Context) error {
 // @Success 200 {object} SuccessResponse{message=string} "Profile updated successfully"
 // @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID or VALIDATION_001 - Invalid request body"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - Customer not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /customers/{id} [put]
@@ -379,15 +390,16 @@ func (h *CustomerHandler) UpdateMyEmail(c echo.Context) error {
 
 // DeleteCustomer soft-deletes a customer (admin only)
 // @Summary Delete customer (admin)
-// @Description Admin endpoint to soft-delete a customer. Cannot delete customers with non-zero account balances.
+// @Description Admin endpoint to soft-delete a customer. Cannot delete customers with non-zero account balances. When the approval policy covers deletions, the request is held with 202 until a second admin approves it.
 // @Tags Customers
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "Customer ID (UUID)"
 // @Success 200 {object} dto.DeleteCustomerResponse "Customer deleted successfully"
+// @Success 202 {object} SuccessResponse{data=models.PendingAction} "Deletion awaiting approval"
 // @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - Customer not found"
 // @Failure 422 {object} errors.ErrorResponse "ACCOUNT_005 - Customer has non-zero balances"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
@@ -399,6 +411,12 @@ func (h *CustomerHandler) DeleteCustomer(c echo.Context) error {
 		return SendError(c, errors.CustomerInvalidID)
 	}
 
+	if h.approvalService.RequiresApproval(models.PendingActionDeleteCustomer, decimal.Zero) {
+		return submitForApproval(c, h.approvalService, models.PendingActionDeleteCustomer, customerID.String(), map[string]interface{}{
+			"customer_id": customerID.String(),
+		})
+	}
+
 	err = h.profileService.DeleteCustomer(customerID, "Admin deletion")
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
@@ -425,7 +443,7 @@ func (h *CustomerHandler) DeleteCustomer(c echo.Context) error {
 // @Success 200 {object} object{accounts=[]models.Account,count=int} "Customer accounts"
 // @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - Customer not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /customers/{id}/accounts [get]
@@ -493,7 +511,7 @@ func (h *CustomerHandler) GetMyAccounts(c echo.Context) error {
 // @Success 201 {object} object{account=models.Account,message=string} "Account created successfully"
 // @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID or VALIDATION_001 - Invalid request body"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - Customer not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /customers/{id}/accounts [post]
@@ -539,7 +557,7 @@ func (h *CustomerHandler) CreateAccountForCustomer(c echo.Context) error {
 
 // TransferAccountOwnership transfers account ownership (admin only)
 // @Summary Transfer account ownership (admin)
-// @Description Admin endpoint to transfer account ownership from one customer to another
+// @Description Admin endpoint to transfer account ownership from one customer to another. When the approval policy covers ownership transfers, the request is held with 202 until a second admin approves it.
 // @Tags Customers
 // @Security BearerAuth
 // @Accept json
@@ -547,9 +565,10 @@ func (h *CustomerHandler) CreateAccountForCustomer(c echo.Context) error {
 // @Param accountId path string true "Account ID (UUID)"
 // @Param request body object{from_customer_id=string,to_customer_id=string} true "Transfer details"
 // @Success 200 {object} SuccessResponse{message=string} "Ownership transferred successfully"
+// @Success 202 {object} SuccessResponse{data=models.PendingAction} "Transfer awaiting approval"
 // @Failure 400 {object} errors.ErrorResponse "ACCOUNT_004 - Invalid account ID or VALIDATION_001 - Invalid request body"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found or CUSTOMER_001 - Customer not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /accounts/{accountId}/transfer-ownership [post]
@@ -577,6 +596,14 @@ func (h *CustomerHandler) TransferAccountOwnership(c echo.Context) error {
 		return SendError(c, errors.AuthMissingToken)
 	}
 
+	if h.approvalService.RequiresApproval(models.PendingActionTransferOwnership, decimal.Zero) {
+		return submitForApproval(c, h.approvalService, models.PendingActionTransferOwnership, accountID.String(), map[string]interface{}{
+			"account_id":       accountID.String(),
+			"from_customer_id": req.FromCustomerID.String(),
+			"to_customer_id":   req.ToCustomerID.String(),
+		})
+	}
+
 	ipAddress := c.RealIP()
 	userAgent := c.Request().UserAgent()
 
@@ -608,7 +635,7 @@ func (h *CustomerHandler) TransferAccountOwnership(c echo.Context) error {
 // @Success 200 {object} object{activities=[]models.AuditLog,total=int,limit=int,offset=int} "Customer activity logs"
 // @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /customers/{id}/activity [get]
 func (h *CustomerHandler) GetCustomerActivity(c echo.Context) error {
@@ -692,15 +719,16 @@ func (h *CustomerHandler) GetMyActivity(c echo.Context) error {
 
 // ResetCustomerPassword resets a customer's password (admin only)
 // @Summary Reset customer password (admin)
-// @Description Admin endpoint to reset a customer's password and generate a temporary password
+// @Description Admin endpoint to reset a customer's password and generate a temporary password. When the approval policy covers password resets, the request is held with 202 and the temporary password is returned to the admin who approves it.
 // @Tags Customers
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "Customer ID (UUID)"
 // @Success 200 {object} object{temporary_password=string,message=string} "Password reset successfully with temporary password"
+// @Success 202 {object} SuccessResponse{data=models.PendingAction} "Reset awaiting approval"
 // @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
-// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
 // @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - Customer not found"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /customers/{id}/password/reset [put]
@@ -716,6 +744,12 @@ func (h *CustomerHandler) ResetCustomerPassword(c echo.Context) error {
 		return SendError(c, errors.AuthMissingToken)
 	}
 
+	if h.approvalService.RequiresApproval(models.PendingActionResetPassword, decimal.Zero) {
+		return submitForApproval(c, h.approvalService, models.PendingActionResetPassword, customerID.String(), map[string]interface{}{
+			"customer_id": customerID.String(),
+		})
+	}
+
 	tempPassword, err := h.passwordService.AdminResetPassword(customerID, adminID)
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
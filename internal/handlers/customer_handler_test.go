@@ -18,6 +18,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -32,6 +33,7 @@ type CustomerHandlerTestSuite struct {
 	mockMetrics         *service_mocks.MockMetricsRecorderInterface
 	logger              *service_mocks.MockCustomerLoggerInterface
 	mockPasswordService *service_mocks.MockPasswordServiceInterface
+	mockApprovalService *service_mocks.MockApprovalServiceInterface
 }
 
 func (s *CustomerHandlerTestSuite) SetupTest() {
@@ -42,6 +44,7 @@ func (s *CustomerHandlerTestSuite) SetupTest() {
 	s.mockAuditService = service_mocks.NewMockAuditServiceInterface(s.ctrl)
 	s.mockMetrics = service_mocks.NewMockMetricsRecorderInterface(s.ctrl)
 	s.mockPasswordService = service_mocks.NewMockPasswordServiceInterface(s.ctrl)
+	s.mockApprovalService = service_mocks.NewMockApprovalServiceInterface(s.ctrl)
 	s.logger = service_mocks.NewMockCustomerLoggerInterface(s.ctrl)
 }
 
@@ -96,7 +99,7 @@ func (s *CustomerHandlerTestSuite) TestSearchCustomers_SuccessfulSearchWithResul
 	s.mockMetrics.EXPECT().IncrementCounter("customer_search_request", map[string]string{"status": "success"}).Times(1)
 	s.mockMetrics.EXPECT().RecordProcessingTime("customer_search", gomock.Any()).Times(1)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.SearchCustomers(c)
 
 	s.NoError(err)
@@ -126,7 +129,7 @@ func (s *CustomerHandlerTestSuite) TestSearchCustomers_MissingQueryParameter() {
 	// Logger expectation for validation failure
 	s.logger.EXPECT().LogValidationFailure(gomock.Any(), "customer_search", gomock.Any()).Times(1)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.SearchCustomers(c)
 
 	s.Error(err) // Validation returns an error through Echo's validator
@@ -148,7 +151,7 @@ func (s *CustomerHandlerTestSuite) TestSearchCustomers_InvalidLimit() {
 	// Logger expectation for validation failure
 	s.logger.EXPECT().LogValidationFailure(gomock.Any(), "customer_search", gomock.Any()).Times(1)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.SearchCustomers(c)
 
 	s.Error(err) // Validation returns an error through Echo's validator
@@ -181,7 +184,7 @@ func (s *CustomerHandlerTestSuite) TestSearchCustomers_ServiceError() {
 	s.mockMetrics.EXPECT().IncrementCounter("customer_search_request", map[string]string{"status": "failed"}).Times(1)
 	s.mockMetrics.EXPECT().RecordProcessingTime("customer_search", gomock.Any()).Times(1)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.SearchCustomers(c)
 
 	s.NoError(err)
@@ -194,6 +197,84 @@ func (s *CustomerHandlerTestSuite) TestSearchCustomers_ServiceError() {
 	s.Equal("SYSTEM_001", errorResp.Error.Code)
 }
 
+// Test SearchCustomers - fuzzy search returns match scores
+func (s *CustomerHandlerTestSuite) TestSearchCustomers_FuzzySearchReturnsScores() {
+	e := echo.New()
+	e.Validator = &CustomValidator{validator: validator.New()}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/customers/search?q=jon+smth+4821&type=fuzzy", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	adminID := uuid.New()
+	c.Set("user_id", adminID)
+	c.Set("user_role", models.RoleAdmin)
+
+	nowStr := time.Now().Format(time.RFC3339)
+	results := []*models.CustomerSearchResult{
+		{
+			ID:        uuid.New(),
+			Email:     "john.smith@example.com",
+			FirstName: "John",
+			LastName:  "Smith",
+			Role:      models.RoleCustomer,
+			CreatedAt: nowStr,
+			Score:     0.8,
+		},
+	}
+
+	s.logger.EXPECT().LogCustomerSearchStarted(gomock.Any(), "jon smth 4821", string(models.SearchTypeFuzzy), adminID).Times(1)
+	s.logger.EXPECT().LogCustomerSearchCompleted(gomock.Any(), 1, gomock.Any()).Times(1)
+	s.mockSearchService.EXPECT().
+		SearchCustomers("jon smth 4821", models.SearchTypeFuzzy, 0, 10).
+		Return(results, int64(1), nil)
+	s.mockMetrics.EXPECT().IncrementCounter("customer_search_request", map[string]string{"status": "success"}).Times(1)
+	s.mockMetrics.EXPECT().RecordProcessingTime("customer_search", gomock.Any()).Times(1)
+
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
+	err := handler.SearchCustomers(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+
+	var response dto.SearchCustomersResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Customers, 1)
+	s.Equal(0.8, response.Customers[0].Score)
+}
+
+// Test SearchCustomers - unknown search type
+func (s *CustomerHandlerTestSuite) TestSearchCustomers_InvalidSearchType() {
+	e := echo.New()
+	e.Validator = &CustomValidator{validator: validator.New()}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/customers/search?q=john&type=phone", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	adminID := uuid.New()
+	c.Set("user_id", adminID)
+	c.Set("user_role", models.RoleAdmin)
+
+	s.logger.EXPECT().LogCustomerSearchStarted(gomock.Any(), "john", "phone", adminID).Times(1)
+	s.logger.EXPECT().LogCustomerSearchFailed(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	s.mockSearchService.EXPECT().
+		SearchCustomers("john", models.SearchType("phone"), 0, 10).
+		Return(nil, int64(0), services.ErrInvalidSearchType)
+	s.mockMetrics.EXPECT().IncrementCounter("customer_search_request", map[string]string{"status": "failed"}).Times(1)
+	s.mockMetrics.EXPECT().RecordProcessingTime("customer_search", gomock.Any()).Times(1)
+
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
+	err := handler.SearchCustomers(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+
+	var errorResp ErrorResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &errorResp))
+	s.Equal("VALIDATION_001", errorResp.Error.Code)
+}
+
 // Test GetCustomerProfile - admin successfully retrieves customer profile
 func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_AdminRetrievesCustomerProfile() {
 	customerID := uuid.New()
@@ -221,7 +302,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_AdminRetrievesCustomer
 		GetCustomerProfile(customerID).
 		Return(user, nil)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.GetCustomerProfile(c)
 
 	s.NoError(err)
@@ -260,7 +341,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_CustomerRetrievesOwnPr
 		GetCustomerProfile(customerID).
 		Return(user, nil)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.GetCustomerProfile(c)
 
 	s.NoError(err)
@@ -274,7 +355,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_CustomerRetrievesOwnPr
 
 // Test GetCustomerProfile - customer cannot access other customer profile
 func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_CustomerCannotAccessOther() {
-	// NOTE: Authorization check was moved to middleware (RequireAdmin)
+	// NOTE: Authorization check was moved to middleware (RequirePermission)
 	// This endpoint is admin-only and non-admin users are blocked by middleware
 	// This test verifies handler processes request when called directly
 	customerID := uuid.New()
@@ -302,7 +383,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_CustomerCannotAccessOt
 		GetCustomerProfile(otherCustomerID).
 		Return(expectedCustomer, nil)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.GetCustomerProfile(c)
 
 	// Handler itself doesn't check authorization - middleware does
@@ -324,7 +405,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_InvalidCustomerID() {
 	c.Set("user_id", adminID)
 	c.Set("user_role", models.RoleAdmin)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.GetCustomerProfile(c)
 
 	s.NoError(err)
@@ -357,7 +438,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_CustomerNotFound() {
 		GetCustomerProfile(customerID).
 		Return(nil, services.ErrCustomerNotFound)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.GetCustomerProfile(c)
 
 	s.NoError(err)
@@ -418,7 +499,7 @@ func (s *CustomerHandlerTestSuite) TestCreateCustomer_Successful() {
 	s.mockMetrics.EXPECT().IncrementCounter("customer_created", map[string]string{}).Times(1)
 	s.logger.EXPECT().LogCustomerCreated(gomock.Any(), newUserID, "newcustomer@example.com", adminID).Times(1)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.CreateCustomer(c)
 
 	s.NoError(err)
@@ -458,7 +539,7 @@ func (s *CustomerHandlerTestSuite) TestCreateCustomer_InvalidEmail() {
 	// Logger expectation for validation failure
 	s.logger.EXPECT().LogValidationFailure(gomock.Any(), "customer_create", gomock.Any()).Times(1)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.CreateCustomer(c)
 
 	s.NoError(err)
@@ -484,7 +565,7 @@ func (s *CustomerHandlerTestSuite) TestCreateCustomer_MissingRequiredFields() {
 	// Logger expectation for validation failure
 	s.logger.EXPECT().LogValidationFailure(gomock.Any(), "customer_create", gomock.Any()).Times(1)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.CreateCustomer(c)
 
 	s.NoError(err)
@@ -520,9 +601,46 @@ func (s *CustomerHandlerTestSuite) TestCreateCustomer_EmailAlreadyExists() {
 		CreateCustomer("existing@example.com", "Jane", "Smith", models.RoleCustomer).
 		Return(nil, "", services.ErrEmailAlreadyExists)
 
-	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
 	err := handler.CreateCustomer(c)
 
 	s.NoError(err)
 	s.Equal(http.StatusUnprocessableEntity, rec.Code)
 }
+
+// Test DeleteCustomer - deletion is held for a second admin's approval
+func (s *CustomerHandlerTestSuite) TestDeleteCustomer_AwaitsApproval() {
+	adminID := uuid.New()
+	customerID := uuid.New()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/customers/"+customerID.String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(customerID.String())
+
+	c.Set("user_id", adminID)
+	c.Set("user_role", models.RoleAdmin)
+
+	// Setup mock expectations; the customer is not deleted until approved
+	action := &models.PendingAction{
+		ID:          uuid.New(),
+		ActionType:  models.PendingActionDeleteCustomer,
+		ResourceID:  customerID.String(),
+		Status:      models.PendingActionStatusPending,
+		RequestedBy: adminID,
+	}
+	s.mockApprovalService.EXPECT().
+		RequiresApproval(models.PendingActionDeleteCustomer, decimal.Zero).
+		Return(true)
+	s.mockApprovalService.EXPECT().
+		SubmitAction(models.PendingActionDeleteCustomer, customerID.String(), map[string]interface{}{"customer_id": customerID.String()}, adminID, gomock.Any(), gomock.Any()).
+		Return(action, nil)
+
+	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.mockApprovalService, s.logger, s.mockMetrics)
+	err := handler.DeleteCustomer(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusAccepted, rec.Code)
+}
@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// auditResourceExternalTransfer is the audit resource for external transfers
+const auditResourceExternalTransfer = "external_transfer"
+
+// ExternalTransferHandler handles transfers to and from accounts at other institutions
+type ExternalTransferHandler struct {
+	externalTransferService services.ExternalTransferServiceInterface
+	auditRepo               repositories.AuditLogRepositoryInterface
+}
+
+// NewExternalTransferHandler creates a new external transfer handler
+func NewExternalTransferHandler(
+	externalTransferService services.ExternalTransferServiceInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+) *ExternalTransferHandler {
+	return &ExternalTransferHandler{
+		externalTransferService: externalTransferService,
+		auditRepo:               auditRepo,
+	}
+}
+
+// InitiateTransfer starts an external transfer
+// @Summary Initiate external transfer
+// @Description Send funds from one of the authenticated user's accounts to an account at another institution (outbound), or pull funds from one into it (inbound). Requires Idempotency-Key header. The counterparty account is verified with NorthWind first. Outbound transfers hold the amount on the account immediately; inbound transfers credit the account only when they settle. The transfer is submitted to NorthWind and settles after the settlement delay unless it is returned with an ACH return code first. A transfer NorthWind could not be reached for stays initiated and is resubmitted automatically; one NorthWind refuses is returned with status failed and its hold released. Transfers are checked against the account's transaction limits. An outbound transfer flagged by fraud screening is returned pending_review with 202, its amount held, and is submitted only once an admin approves the fraud review.
+// @Tags Accounts
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param accountId path string true "Account ID (UUID)"
+// @Param Idempotency-Key header string true "Unique key to ensure idempotent transfers"
+// @Param request body dto.CreateExternalTransferRequest true "Transfer details"
+// @Success 201 {object} SuccessResponse{data=models.ExternalTransfer} "Transfer initiated"
+// @Success 202 {object} SuccessResponse{data=models.ExternalTransfer} "Transfer held for fraud review"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_002 - Missing Idempotency-Key header, VALIDATION_003 - Invalid account ID, TRANSFER_006 - Invalid amount"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Account belongs to another user"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
+// @Failure 422 {object} errors.ErrorResponse "ACCOUNT_002 - Account not active, TRANSFER_005 - Insufficient available balance, TRANSFER_011 - External account could not be verified, LIMIT_001 - Transaction limit exceeded"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Failure 503 {object} errors.ErrorResponse "SYSTEM_003 - NorthWind unavailable"
+// @Router /accounts/{accountId}/external-transfers [post]
+func (h *ExternalTransferHandler) InitiateTransfer(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Account ID must be a valid UUID"))
+	}
+
+	idempotencyKey := c.Request().Header.Get("Idempotency-Key")
+	if idempotencyKey == "" {
+		return SendError(c, apierrors.ValidationRequiredField, apierrors.WithDetails("Idempotency-Key header is required"))
+	}
+
+	var req dto.CreateExternalTransferRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	transfer, err := h.externalTransferService.InitiateTransfer(c.Request().Context(), userID, accountID, &req, idempotencyKey)
+	if err != nil {
+		return h.sendExternalTransferError(c, err)
+	}
+
+	if transfer.Status == models.ExternalTransferStatusPendingReview {
+		return c.JSON(http.StatusAccepted, SuccessResponse{
+			Data:    transfer,
+			Message: "External transfer held for review",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, SuccessResponse{
+		Data:    transfer,
+		Message: "External transfer initiated",
+	})
+}
+
+// ListTransfers lists the user's external transfers
+// @Summary List my external transfers
+// @Description Retrieve the authenticated user's external transfers, newest first
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.ExternalTransfer} "External transfers"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/external-transfers [get]
+func (h *ExternalTransferHandler) ListTransfers(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	transfers, total, err := h.externalTransferService.ListTransfers(userID, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: transfers,
+		Meta: map[string]interface{}{
+			"total":       total,
+			"page":        page,
+			"limit":       limit,
+			"total_pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetTransfer retrieves one of the user's external transfers
+// @Summary Get my external transfer
+// @Description Retrieve an external transfer with its status, settlement date and any return code
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "External transfer ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.ExternalTransfer} "External transfer"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid transfer ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "TRANSFER_009 - External transfer not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/external-transfers/{id} [get]
+func (h *ExternalTransferHandler) GetTransfer(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid transfer ID"))
+	}
+
+	transfer, err := h.externalTransferService.GetTransfer(id, userID)
+	if err != nil {
+		return h.sendExternalTransferError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: transfer,
+	})
+}
+
+// ReturnTransfer records an ACH return for an external transfer
+// @Summary Return external transfer (admin)
+// @Description Admin endpoint to record an ACH return received outside NorthWind's status API, such as from a returns file. Only transfers that have not settled can be returned. An outbound transfer's hold is released; an inbound transfer never credited the account, so no funds move.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "External transfer ID (UUID)"
+// @Param request body dto.ReturnExternalTransferRequest true "Return code and reason"
+// @Success 200 {object} SuccessResponse{data=models.ExternalTransfer} "Transfer returned"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid transfer ID or unsupported return code"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "TRANSFER_009 - External transfer not found"
+// @Failure 409 {object} errors.ErrorResponse "TRANSFER_010 - Transfer already settled, returned or failed"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/external-transfers/{id}/return [post]
+func (h *ExternalTransferHandler) ReturnTransfer(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid transfer ID"))
+	}
+
+	var req dto.ReturnExternalTransferRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	transfer, err := h.externalTransferService.ReturnTransfer(id, &req)
+	if err != nil {
+		return h.sendExternalTransferError(c, err)
+	}
+
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     models.AuditActionUpdate,
+		Resource:   auditResourceExternalTransfer,
+		ResourceID: transfer.ID.String(),
+		Metadata: models.JSONBMap{
+			"account_id":  transfer.AccountID.String(),
+			"status":      transfer.Status,
+			"return_code": transfer.ReturnCode,
+		},
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    transfer,
+		Message: "External transfer returned",
+	})
+}
+
+// sendExternalTransferError maps service errors to API error responses
+func (h *ExternalTransferHandler) sendExternalTransferError(c echo.Context, err error) error {
+	if detail, ok := limitExceededDetail(err); ok {
+		return SendError(c, apierrors.LimitExceeded, apierrors.WithDetails(detail))
+	}
+
+	switch {
+	case errors.Is(err, services.ErrExternalTransferNotFound):
+		return SendError(c, apierrors.ExternalTransferNotFound)
+	case errors.Is(err, services.ErrExternalTransferState):
+		return SendError(c, apierrors.ExternalTransferState)
+	case errors.Is(err, services.ErrExternalAccountNotVerified):
+		return SendError(c, apierrors.ExternalAccountUnverified)
+	case errors.Is(err, services.ErrNorthWindUnavailable):
+		return SendError(c, apierrors.SystemServiceUnavailable, apierrors.WithDetails("NorthWind is unavailable"))
+	case errors.Is(err, models.ErrInvalidACHReturnCode):
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	case errors.Is(err, services.ErrAccountNotFound):
+		return SendError(c, apierrors.AccountNotFound)
+	case errors.Is(err, services.ErrUnauthorized):
+		return SendError(c, apierrors.AuthInsufficientPermission)
+	case errors.Is(err, services.ErrAccountNotActive):
+		return SendError(c, apierrors.AccountInactive)
+	case errors.Is(err, services.ErrInsufficientFunds):
+		return SendError(c, apierrors.TransferInsufficientFunds)
+	case errors.Is(err, services.ErrInvalidAmount):
+		return SendError(c, apierrors.TransferInvalidAmount)
+	default:
+		return SendSystemError(c, err)
+	}
+}
@@ -0,0 +1,281 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+// ExternalTransferHandlerSuite defines the test suite for ExternalTransferHandler
+type ExternalTransferHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockExternalTransferServiceInterface
+	auditRepo   *repository_mocks.MockAuditLogRepositoryInterface
+	handler     *ExternalTransferHandler
+	echo        *echo.Echo
+	userID      uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *ExternalTransferHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockExternalTransferServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewExternalTransferHandler(s.mockService, s.auditRepo)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.userID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *ExternalTransferHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestExternalTransferHandlerSuite runs the test suite
+func TestExternalTransferHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ExternalTransferHandlerSuite))
+}
+
+func (s *ExternalTransferHandlerSuite) TestInitiateTransfer() {
+	accountID := uuid.New()
+	validBody := dto.CreateExternalTransferRequest{
+		Direction:   models.ExternalTransferOutbound,
+		Amount:      "125.00",
+		Description: "Rent",
+		Counterparty: dto.ExternalTransferCounterparty{
+			AccountHolderName: "Jane Doe",
+			AccountNumber:     "987654321",
+			RoutingNumber:     "011000015",
+		},
+	}
+	transfer := &models.ExternalTransfer{
+		ID:        uuid.New(),
+		AccountID: accountID,
+		Direction: models.ExternalTransferOutbound,
+		Amount:    decimal.RequireFromString("125.00"),
+		Status:    models.ExternalTransferStatusSubmitted,
+	}
+
+	held := &models.ExternalTransfer{
+		ID:        uuid.New(),
+		AccountID: accountID,
+		Direction: models.ExternalTransferOutbound,
+		Amount:    decimal.RequireFromString("125.00"),
+		Status:    models.ExternalTransferStatusPendingReview,
+	}
+
+	badRouting := validBody
+	badRouting.Counterparty.RoutingNumber = "12345"
+
+	tests := []struct {
+		name           string
+		idempotencyKey string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:           "initiates transfer",
+			idempotencyKey: "key-1",
+			body:           validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().InitiateTransfer(gomock.Any(), s.userID, accountID, gomock.Any(), "key-1").Return(transfer, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "flagged transfer held for review",
+			idempotencyKey: "key-1",
+			body:           validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().InitiateTransfer(gomock.Any(), s.userID, accountID, gomock.Any(), "key-1").Return(held, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "transaction limit exceeded",
+			idempotencyKey: "key-1",
+			body:           validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().InitiateTransfer(gomock.Any(), s.userID, accountID, gomock.Any(), "key-1").
+					Return(nil, fmt.Errorf("%w: transfers may not exceed 100.00", services.ErrLimitExceeded))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "LIMIT_001",
+		},
+		{
+			name:           "idempotency key is required",
+			body:           validBody,
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_002",
+		},
+		{
+			name:           "routing number must have nine digits",
+			idempotencyKey: "key-1",
+			body:           badRouting,
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name:           "counterparty not verified",
+			idempotencyKey: "key-1",
+			body:           validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().InitiateTransfer(gomock.Any(), s.userID, accountID, gomock.Any(), "key-1").
+					Return(nil, services.ErrExternalAccountNotVerified)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "TRANSFER_011",
+		},
+		{
+			name:           "northwind unavailable",
+			idempotencyKey: "key-1",
+			body:           validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().InitiateTransfer(gomock.Any(), s.userID, accountID, gomock.Any(), "key-1").
+					Return(nil, services.ErrNorthWindUnavailable)
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedCode:   "SYSTEM_003",
+		},
+		{
+			name:           "insufficient available balance",
+			idempotencyKey: "key-1",
+			body:           validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().InitiateTransfer(gomock.Any(), s.userID, accountID, gomock.Any(), "key-1").
+					Return(nil, services.ErrInsufficientFunds)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "TRANSFER_005",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			payload, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/accounts/"+accountID.String()+"/external-transfers", bytes.NewReader(payload))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.idempotencyKey != "" {
+				req.Header.Set("Idempotency-Key", tt.idempotencyKey)
+			}
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("accountId")
+			c.SetParamValues(accountID.String())
+			c.Set("user_id", s.userID)
+
+			s.NoError(s.handler.InitiateTransfer(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+
+			if tt.expectedCode != "" {
+				var resp ErrorResponse
+				s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+				s.Equal(tt.expectedCode, resp.Error.Code)
+			}
+		})
+	}
+}
+
+func (s *ExternalTransferHandlerSuite) TestReturnTransfer() {
+	transferID := uuid.New()
+	returned := &models.ExternalTransfer{
+		ID:         transferID,
+		AccountID:  uuid.New(),
+		Status:     models.ExternalTransferStatusReturned,
+		ReturnCode: "R01",
+	}
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "returns transfer and writes audit log",
+			setupMocks: func() {
+				s.mockService.EXPECT().ReturnTransfer(transferID, gomock.Any()).Return(returned, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(auditResourceExternalTransfer, log.Resource)
+					s.Equal(transferID.String(), log.ResourceID)
+					s.Equal("R01", log.Metadata["return_code"])
+					return nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "already settled",
+			setupMocks: func() {
+				s.mockService.EXPECT().ReturnTransfer(transferID, gomock.Any()).Return(nil, services.ErrExternalTransferState)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "TRANSFER_010",
+		},
+		{
+			name: "unknown transfer",
+			setupMocks: func() {
+				s.mockService.EXPECT().ReturnTransfer(transferID, gomock.Any()).Return(nil, services.ErrExternalTransferNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "TRANSFER_009",
+		},
+		{
+			name: "unsupported return code",
+			setupMocks: func() {
+				s.mockService.EXPECT().ReturnTransfer(transferID, gomock.Any()).Return(nil, models.ErrInvalidACHReturnCode)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/external-transfers/"+transferID.String()+"/return",
+				bytes.NewReader([]byte(`{"returnCode":"R01"}`)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(transferID.String())
+			c.Set("user_id", uuid.New())
+
+			s.NoError(s.handler.ReturnTransfer(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+
+			if tt.expectedCode != "" {
+				var resp ErrorResponse
+				s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+				s.Equal(tt.expectedCode, resp.Error.Code)
+			}
+		})
+	}
+}
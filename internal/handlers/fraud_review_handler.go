@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// auditResourceFraudReview is the audit resource for fraud review decisions
+const auditResourceFraudReview = "fraud_review"
+
+// FraudReviewHandler handles admin review of debits and transfers held by fraud screening
+type FraudReviewHandler struct {
+	reviewService services.FraudReviewServiceInterface
+	auditRepo     repositories.AuditLogRepositoryInterface
+}
+
+// NewFraudReviewHandler creates a new fraud review handler
+func NewFraudReviewHandler(reviewService services.FraudReviewServiceInterface, auditRepo repositories.AuditLogRepositoryInterface) *FraudReviewHandler {
+	return &FraudReviewHandler{
+		reviewService: reviewService,
+		auditRepo:     auditRepo,
+	}
+}
+
+// ListReviews lists fraud reviews
+// @Summary List fraud reviews (admin)
+// @Description Admin endpoint to list debits and transfers held by fraud screening, oldest first. Each review lists the rules that fired and why.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param status query string false "Filter by status" Enums(pending_review, approved, rejected, expired)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.FraudReview} "Fraud reviews"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid status or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/fraud-reviews [get]
+func (h *FraudReviewHandler) ListReviews(c echo.Context) error {
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	reviews, total, err := h.reviewService.ListReviews(c.QueryParam("status"), (page-1)*limit, limit)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidFraudReviewStatus) {
+			return SendError(c, apierrors.ValidationGeneral,
+				apierrors.WithDetails("status: must be one of pending_review, approved, rejected, expired"))
+		}
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: reviews,
+		Meta: map[string]interface{}{
+			"total":       total,
+			"page":        page,
+			"limit":       limit,
+			"total_pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetReview retrieves a fraud review
+// @Summary Get fraud review (admin)
+// @Description Admin endpoint to retrieve a fraud review with the rules that fired and any decision
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Fraud review ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.FraudReview} "Fraud review"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid review ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "FRAUD_001 - Fraud review not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/fraud-reviews/{id} [get]
+func (h *FraudReviewHandler) GetReview(c echo.Context) error {
+	reviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Review ID must be a valid UUID"))
+	}
+
+	review, err := h.reviewService.GetReview(reviewID)
+	if err != nil {
+		return h.sendFraudReviewError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: review,
+	})
+}
+
+// ApproveReview lets a held debit or transfer through
+// @Summary Approve fraud review (admin)
+// @Description Admin endpoint to approve an item held by fraud screening. An approved debit captures its hold; an approved transfer releases its hold and moves the funds; an approved external transfer keeps its hold and is submitted to NorthWind by the worker. If the item can no longer be completed, for example because the account was closed, the review is still approved and its failure_reason says why. The decision is written to the audit trail with the rules that fired.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Fraud review ID (UUID)"
+// @Param request body dto.FraudReviewDecisionRequest true "Decision reason"
+// @Success 200 {object} SuccessResponse{data=models.FraudReview} "Review approved"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid review ID or reason"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "FRAUD_001 - Fraud review not found"
+// @Failure 409 {object} errors.ErrorResponse "FRAUD_002 - Review already decided, FRAUD_003 - Review expired"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/fraud-reviews/{id}/approve [post]
+func (h *FraudReviewHandler) ApproveReview(c echo.Context) error {
+	return h.decide(c, h.reviewService.ApproveReview, models.AuditActionFraudApproved, "Fraud review approved")
+}
+
+// RejectReview blocks a held debit or transfer
+// @Summary Reject fraud review (admin)
+// @Description Admin endpoint to reject an item held by fraud screening. The hold is released and a held transfer or external transfer is failed. The decision is written to the audit trail with the rules that fired.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Fraud review ID (UUID)"
+// @Param request body dto.FraudReviewDecisionRequest true "Decision reason"
+// @Success 200 {object} SuccessResponse{data=models.FraudReview} "Review rejected"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid review ID or reason"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "FRAUD_001 - Fraud review not found"
+// @Failure 409 {object} errors.ErrorResponse "FRAUD_002 - Review already decided, FRAUD_003 - Review expired"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/fraud-reviews/{id}/reject [post]
+func (h *FraudReviewHandler) RejectReview(c echo.Context) error {
+	return h.decide(c, h.reviewService.RejectReview, models.AuditActionFraudRejected, "Fraud review rejected")
+}
+
+// decide applies an approval or rejection and audits it with the rules that fired
+func (h *FraudReviewHandler) decide(
+	c echo.Context,
+	decision func(reviewID, adminID uuid.UUID, reason string) (*models.FraudReview, error),
+	action, message string,
+) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	reviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Review ID must be a valid UUID"))
+	}
+
+	var req dto.FraudReviewDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	review, err := decision(reviewID, adminID, req.Reason)
+	if err != nil {
+		return h.sendFraudReviewError(c, err)
+	}
+
+	metadata := models.JSONBMap{
+		"subject_type": review.SubjectType,
+		"account_id":   review.AccountID.String(),
+		"amount":       review.Amount.String(),
+		"rules":        review.Rules,
+		"reason":       req.Reason,
+	}
+	if review.TransferID != nil {
+		metadata["transfer_id"] = review.TransferID.String()
+	}
+	if review.ExternalTransferID != nil {
+		metadata["external_transfer_id"] = review.ExternalTransferID.String()
+	}
+	if review.FailureReason != nil {
+		metadata["failure_reason"] = *review.FailureReason
+	}
+
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     action,
+		Resource:   auditResourceFraudReview,
+		ResourceID: review.ID.String(),
+		Metadata:   metadata,
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    review,
+		Message: message,
+	})
+}
+
+func (h *FraudReviewHandler) sendFraudReviewError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrFraudReviewNotFound):
+		return SendError(c, apierrors.FraudReviewNotFound)
+	case errors.Is(err, services.ErrFraudReviewNotPending):
+		return SendError(c, apierrors.FraudReviewNotPending)
+	case errors.Is(err, services.ErrFraudReviewExpired):
+		return SendError(c, apierrors.FraudReviewExpired)
+	default:
+		return SendSystemError(c, err)
+	}
+}
@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+// FraudReviewHandlerSuite defines the test suite for FraudReviewHandler
+type FraudReviewHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockFraudReviewServiceInterface
+	auditRepo   *repository_mocks.MockAuditLogRepositoryInterface
+	handler     *FraudReviewHandler
+	echo        *echo.Echo
+	adminID     uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *FraudReviewHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockFraudReviewServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewFraudReviewHandler(s.mockService, s.auditRepo)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *FraudReviewHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestFraudReviewHandlerSuite runs the test suite
+func TestFraudReviewHandlerSuite(t *testing.T) {
+	suite.Run(t, new(FraudReviewHandlerSuite))
+}
+
+func (s *FraudReviewHandlerSuite) assertErrorCode(rec *httptest.ResponseRecorder, expectedCode string) {
+	if expectedCode == "" {
+		return
+	}
+	var resp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(expectedCode, resp.Error.Code)
+}
+
+func (s *FraudReviewHandlerSuite) TestListReviews() {
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:  "lists pending reviews",
+			query: "?status=pending_review&page=2&limit=10",
+			setupMocks: func() {
+				s.mockService.EXPECT().ListReviews(models.FraudReviewStatusPending, 10, 10).
+					Return([]models.FraudReview{{ID: uuid.New()}}, int64(11), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "invalid status",
+			query: "?status=bogus",
+			setupMocks: func() {
+				s.mockService.EXPECT().ListReviews("bogus", 0, 20).Return(nil, int64(0), services.ErrInvalidFraudReviewStatus)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_001",
+		},
+		{
+			name:           "limit out of range",
+			query:          "?limit=500",
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_001",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/fraud-reviews"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.Set("user_id", s.adminID)
+
+			s.NoError(s.handler.ListReviews(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
+
+func (s *FraudReviewHandlerSuite) TestGetReview_NotFound() {
+	reviewID := uuid.New()
+	s.mockService.EXPECT().GetReview(reviewID).Return(nil, services.ErrFraudReviewNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/fraud-reviews/"+reviewID.String(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(reviewID.String())
+	c.Set("user_id", s.adminID)
+
+	s.NoError(s.handler.GetReview(c))
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.assertErrorCode(rec, "FRAUD_001")
+}
+
+func (s *FraudReviewHandlerSuite) TestDecisions() {
+	reviewID := uuid.New()
+	transferID := uuid.New()
+	review := &models.FraudReview{
+		ID:          reviewID,
+		AccountID:   uuid.New(),
+		SubjectType: models.FraudSubjectTransfer,
+		TransferID:  &transferID,
+		Amount:      decimal.NewFromInt(5000),
+		Rules:       models.JSONBMap{models.FraudRuleNewDestination: "first transfer to account 2000000002"},
+	}
+
+	tests := []struct {
+		name           string
+		reject         bool
+		body           string
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "approves and writes audit log",
+			body: `{"reason":"Customer confirmed by phone"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().ApproveReview(reviewID, s.adminID, "Customer confirmed by phone").Return(review, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(models.AuditActionFraudApproved, log.Action)
+					s.Equal(auditResourceFraudReview, log.Resource)
+					s.Equal(reviewID.String(), log.ResourceID)
+					s.Equal(transferID.String(), log.Metadata["transfer_id"])
+					s.Equal(review.Rules, log.Metadata["rules"])
+					s.Equal("Customer confirmed by phone", log.Metadata["reason"])
+					return nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "rejects and writes audit log",
+			reject: true,
+			body:   `{"reason":"Account takeover suspected"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().RejectReview(reviewID, s.adminID, "Account takeover suspected").Return(review, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(models.AuditActionFraudRejected, log.Action)
+					return nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "reason is required",
+			body:           `{}`,
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "already decided",
+			body: `{"reason":"Looks fine"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().ApproveReview(reviewID, s.adminID, "Looks fine").Return(nil, services.ErrFraudReviewNotPending)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "FRAUD_002",
+		},
+		{
+			name:   "expired",
+			reject: true,
+			body:   `{"reason":"Looks wrong"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().RejectReview(reviewID, s.adminID, "Looks wrong").Return(nil, services.ErrFraudReviewExpired)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "FRAUD_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			action, decide := "approve", s.handler.ApproveReview
+			if tt.reject {
+				action, decide = "reject", s.handler.RejectReview
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/fraud-reviews/"+reviewID.String()+"/"+action, bytes.NewReader([]byte(tt.body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(reviewID.String())
+			c.Set("user_id", s.adminID)
+
+			s.NoError(decide(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// auditResourceHold is the audit resource for authorization holds
+const auditResourceHold = "hold"
+
+// HoldHandler handles admin management of authorization holds
+type HoldHandler struct {
+	holdService services.HoldServiceInterface
+	auditRepo   repositories.AuditLogRepositoryInterface
+}
+
+// NewHoldHandler creates a new hold handler
+func NewHoldHandler(holdService services.HoldServiceInterface, auditRepo repositories.AuditLogRepositoryInterface) *HoldHandler {
+	return &HoldHandler{
+		holdService: holdService,
+		auditRepo:   auditRepo,
+	}
+}
+
+// PlaceHold places an authorization hold on an account
+// @Summary Place authorization hold (admin)
+// @Description Admin endpoint to reserve funds on an account. The hold is a pending debit that lowers the account's available balance immediately but leaves the ledger balance unchanged until it is captured. Without expiresAt the hold expires after 7 days; it may last at most 30 days. Holds still pending at their expiry are released automatically through the processing queue.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param accountId path string true "Account ID (UUID)"
+// @Param request body dto.PlaceHoldRequest true "Hold details"
+// @Success 201 {object} SuccessResponse{data=models.Transaction} "Hold placed"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid account ID or expiry, TRANSACTION_002 - Invalid amount"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
+// @Failure 422 {object} errors.ErrorResponse "ACCOUNT_002 - Account not active or TRANSACTION_003 - Insufficient available balance"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/accounts/{accountId}/holds [post]
+func (h *HoldHandler) PlaceHold(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Account ID must be a valid UUID"))
+	}
+
+	var req dto.PlaceHoldRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	hold, err := h.holdService.PlaceHold(accountID, &req)
+	if err != nil {
+		return h.sendHoldError(c, err)
+	}
+
+	h.audit(c, adminID, models.AuditActionCreate, hold)
+
+	return c.JSON(http.StatusCreated, SuccessResponse{
+		Data:    hold,
+		Message: "Hold placed",
+	})
+}
+
+// ListHolds lists an account's active holds
+// @Summary List active holds (admin)
+// @Description Admin endpoint to list the holds that currently reserve funds on an account, soonest to expire first.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param accountId path string true "Account ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=[]models.Transaction} "Active holds"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid account ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/accounts/{accountId}/holds [get]
+func (h *HoldHandler) ListHolds(c echo.Context) error {
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Account ID must be a valid UUID"))
+	}
+
+	holds, err := h.holdService.GetAccountHolds(accountID)
+	if err != nil {
+		return h.sendHoldError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: holds,
+	})
+}
+
+// CaptureHold captures an authorization hold
+// @Summary Capture authorization hold (admin)
+// @Description Admin endpoint to complete a hold as a debit. Without an amount the full held amount is captured; a smaller amount captures part of the hold and returns the remainder to the available balance. Holds past their expiry cannot be captured.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Hold ID (UUID)"
+// @Param request body dto.CaptureHoldRequest false "Amount to capture"
+// @Success 200 {object} SuccessResponse{data=models.Transaction} "Hold captured"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid hold ID, TRANSACTION_002 - Invalid amount or more than the held amount"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "TRANSACTION_009 - Hold not found"
+// @Failure 409 {object} errors.ErrorResponse "TRANSACTION_010 - Hold already captured, released or expired"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/holds/{id}/capture [post]
+func (h *HoldHandler) CaptureHold(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	holdID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Hold ID must be a valid UUID"))
+	}
+
+	var req dto.CaptureHoldRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	hold, err := h.holdService.CaptureHold(holdID, &req)
+	if err != nil {
+		return h.sendHoldError(c, err)
+	}
+
+	h.audit(c, adminID, models.AuditActionUpdate, hold)
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    hold,
+		Message: "Hold captured",
+	})
+}
+
+// ReleaseHold releases an authorization hold
+// @Summary Release authorization hold (admin)
+// @Description Admin endpoint to cancel a hold without moving funds. The held amount is returned to the account's available balance.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Hold ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.Transaction} "Hold released"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid hold ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "TRANSACTION_009 - Hold not found"
+// @Failure 409 {object} errors.ErrorResponse "TRANSACTION_010 - Hold already captured, released or expired"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/holds/{id}/release [post]
+func (h *HoldHandler) ReleaseHold(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	holdID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Hold ID must be a valid UUID"))
+	}
+
+	hold, err := h.holdService.ReleaseHold(holdID)
+	if err != nil {
+		return h.sendHoldError(c, err)
+	}
+
+	h.audit(c, adminID, models.AuditActionUpdate, hold)
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    hold,
+		Message: "Hold released",
+	})
+}
+
+// audit records a hold change
+func (h *HoldHandler) audit(c echo.Context, adminID uuid.UUID, action string, hold *models.Transaction) {
+	metadata := models.JSONBMap{
+		"account_id": hold.AccountID.String(),
+		"amount":     hold.Amount.StringFixed(2),
+		"status":     hold.Status,
+	}
+	if outcome := hold.HoldOutcome(); outcome != "" {
+		metadata["outcome"] = outcome
+	}
+
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     action,
+		Resource:   auditResourceHold,
+		ResourceID: hold.ID.String(),
+		Metadata:   metadata,
+	})
+}
+
+func (h *HoldHandler) sendHoldError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrHoldNotFound):
+		return SendError(c, apierrors.TransactionHoldNotFound)
+	case errors.Is(err, services.ErrHoldNotActive):
+		return SendError(c, apierrors.TransactionHoldNotActive)
+	case errors.Is(err, services.ErrInvalidHoldExpiry):
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	case errors.Is(err, services.ErrInvalidAmount):
+		return SendError(c, apierrors.TransactionInvalidAmount)
+	case errors.Is(err, services.ErrInsufficientFunds):
+		return SendError(c, apierrors.TransactionInsufficientFunds)
+	case errors.Is(err, services.ErrAccountNotFound):
+		return SendError(c, apierrors.AccountNotFound)
+	case errors.Is(err, services.ErrAccountNotActive):
+		return SendError(c, apierrors.AccountInactive)
+	default:
+		return SendSystemError(c, err)
+	}
+}
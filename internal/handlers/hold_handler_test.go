@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+// HoldHandlerSuite defines the test suite for HoldHandler
+type HoldHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockHoldServiceInterface
+	auditRepo   *repository_mocks.MockAuditLogRepositoryInterface
+	handler     *HoldHandler
+	echo        *echo.Echo
+	adminID     uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *HoldHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockHoldServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewHoldHandler(s.mockService, s.auditRepo)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *HoldHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestHoldHandlerSuite runs the test suite
+func TestHoldHandlerSuite(t *testing.T) {
+	suite.Run(t, new(HoldHandlerSuite))
+}
+
+func (s *HoldHandlerSuite) TestPlaceHold() {
+	accountID := uuid.New()
+	hold := models.NewHold(accountID, decimal.RequireFromString("25.00"), "Card authorization", time.Now().Add(time.Hour))
+	hold.ID = uuid.New()
+
+	tests := []struct {
+		name           string
+		accountID      string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:      "places hold and writes audit log",
+			accountID: accountID.String(),
+			body:      dto.PlaceHoldRequest{Amount: "25.00", Description: "Card authorization"},
+			setupMocks: func() {
+				s.mockService.EXPECT().PlaceHold(accountID, gomock.Any()).Return(hold, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(auditResourceHold, log.Resource)
+					s.Equal(models.AuditActionCreate, log.Action)
+					s.Equal(hold.ID.String(), log.ResourceID)
+					return nil
+				})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "invalid account ID",
+			accountID:      "not-a-uuid",
+			body:           dto.PlaceHoldRequest{Amount: "25.00", Description: "Card authorization"},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name:           "description is required",
+			accountID:      accountID.String(),
+			body:           dto.PlaceHoldRequest{Amount: "25.00"},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name:      "insufficient available balance",
+			accountID: accountID.String(),
+			body:      dto.PlaceHoldRequest{Amount: "5000.00", Description: "Card authorization"},
+			setupMocks: func() {
+				s.mockService.EXPECT().PlaceHold(accountID, gomock.Any()).Return(nil, services.ErrInsufficientFunds)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "TRANSACTION_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			payload, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/accounts/"+tt.accountID+"/holds", bytes.NewReader(payload))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("accountId")
+			c.SetParamValues(tt.accountID)
+			c.Set("user_id", s.adminID)
+
+			s.NoError(s.handler.PlaceHold(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+
+			if tt.expectedCode != "" {
+				var resp ErrorResponse
+				s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+				s.Equal(tt.expectedCode, resp.Error.Code)
+			}
+		})
+	}
+}
+
+func (s *HoldHandlerSuite) TestCaptureAndRelease() {
+	holdID := uuid.New()
+
+	tests := []struct {
+		name           string
+		action         func(c echo.Context) error
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:   "capture of a settled hold",
+			action: s.handler.CaptureHold,
+			setupMocks: func() {
+				s.mockService.EXPECT().CaptureHold(holdID, gomock.Any()).Return(nil, services.ErrHoldNotActive)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "TRANSACTION_010",
+		},
+		{
+			name:   "capture of more than the held amount",
+			action: s.handler.CaptureHold,
+			setupMocks: func() {
+				s.mockService.EXPECT().CaptureHold(holdID, gomock.Any()).Return(nil, services.ErrInvalidAmount)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "TRANSACTION_002",
+		},
+		{
+			name:   "release of an unknown hold",
+			action: s.handler.ReleaseHold,
+			setupMocks: func() {
+				s.mockService.EXPECT().ReleaseHold(holdID).Return(nil, services.ErrHoldNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "TRANSACTION_009",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/holds/"+holdID.String(), bytes.NewReader([]byte(`{}`)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(holdID.String())
+			c.Set("user_id", s.adminID)
+
+			s.NoError(tt.action(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+
+			var resp ErrorResponse
+			s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+			s.Equal(tt.expectedCode, resp.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/labstack/echo/v4"
+)
+
+// auditResourceInterest is the audit resource for interest backfills
+const auditResourceInterest = "interest"
+
+// InterestHandler handles admin control of interest accrual
+type InterestHandler struct {
+	interestService services.InterestServiceInterface
+	auditRepo       repositories.AuditLogRepositoryInterface
+}
+
+// NewInterestHandler creates a new interest handler
+func NewInterestHandler(interestService services.InterestServiceInterface, auditRepo repositories.AuditLogRepositoryInterface) *InterestHandler {
+	return &InterestHandler{
+		interestService: interestService,
+		auditRepo:       auditRepo,
+	}
+}
+
+// Backfill accrues interest for missed days
+// @Summary Backfill interest accruals (admin)
+// @Description Admin endpoint to accrue interest on savings and money market accounts for days the accrual worker missed. Each day is accrued on that day's closing balance at balance * rate / 365; days that already have an accrual are left unchanged, so the backfill can be re-run safely. Months that are fully accrued afterwards are posted as an INCOME credit. Accruals added to a month that was already posted are paid with the account's next monthly posting. The range is inclusive, must end before today and may cover at most 366 days.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.InterestBackfillRequest true "Backfill range and optional account"
+// @Success 200 {object} SuccessResponse{data=models.InterestBackfillResult} "Backfill completed"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or VALIDATION_003 - Invalid date range or account does not earn interest"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
+// @Failure 422 {object} errors.ErrorResponse "ACCOUNT_002 - Account not active"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/interest/backfill [post]
+func (h *InterestHandler) Backfill(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.InterestBackfillRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	result, err := h.interestService.Backfill(&req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidInterestBackfill):
+			return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+		case errors.Is(err, services.ErrAccountNotFound):
+			return SendError(c, apierrors.AccountNotFound)
+		case errors.Is(err, services.ErrAccountNotActive):
+			return SendError(c, apierrors.AccountInactive)
+		default:
+			return SendSystemError(c, err)
+		}
+	}
+
+	resourceID := "all"
+	if req.AccountID != nil {
+		resourceID = req.AccountID.String()
+	}
+
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     models.AuditActionCreate,
+		Resource:   auditResourceInterest,
+		ResourceID: resourceID,
+		Metadata: models.JSONBMap{
+			"operation":        "backfill",
+			"start_date":       req.StartDate,
+			"end_date":         req.EndDate,
+			"accruals_created": result.AccrualsCreated,
+			"postings_created": result.PostingsCreated,
+			"interest_posted":  result.InterestPosted.StringFixed(2),
+		},
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    result,
+		Message: "Interest backfill completed",
+	})
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+// InterestHandlerSuite defines the test suite for InterestHandler
+type InterestHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockInterestServiceInterface
+	auditRepo   *repository_mocks.MockAuditLogRepositoryInterface
+	handler     *InterestHandler
+	echo        *echo.Echo
+	adminID     uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *InterestHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockInterestServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewInterestHandler(s.mockService, s.auditRepo)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *InterestHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestInterestHandlerSuite runs the test suite
+func TestInterestHandlerSuite(t *testing.T) {
+	suite.Run(t, new(InterestHandlerSuite))
+}
+
+func (s *InterestHandlerSuite) TestBackfill() {
+	accountID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "runs backfill and writes audit log",
+			body: dto.InterestBackfillRequest{StartDate: "2026-09-01", EndDate: "2026-09-30"},
+			setupMocks: func() {
+				s.mockService.EXPECT().Backfill(gomock.Any()).Return(&models.InterestBackfillResult{
+					AccountsProcessed: 3,
+					AccrualsCreated:   90,
+					PostingsCreated:   3,
+					InterestPosted:    decimal.RequireFromString("4.56"),
+				}, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(auditResourceInterest, log.Resource)
+					s.Equal("all", log.ResourceID)
+					s.Equal(90, log.Metadata["accruals_created"])
+					return nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "dates must be calendar days",
+			body:           dto.InterestBackfillRequest{StartDate: "2026-09-01T00:00:00Z", EndDate: "2026-09-30"},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "invalid range",
+			body: dto.InterestBackfillRequest{StartDate: "2026-09-30", EndDate: "2026-09-01"},
+			setupMocks: func() {
+				s.mockService.EXPECT().Backfill(gomock.Any()).
+					Return(nil, fmt.Errorf("%w: end date must not be before start date", services.ErrInvalidInterestBackfill))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "unknown account",
+			body: dto.InterestBackfillRequest{AccountID: &accountID, StartDate: "2026-09-01", EndDate: "2026-09-30"},
+			setupMocks: func() {
+				s.mockService.EXPECT().Backfill(gomock.Any()).Return(nil, services.ErrAccountNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "ACCOUNT_001",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			payload, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/interest/backfill", bytes.NewReader(payload))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.Set("user_id", s.adminID)
+
+			s.NoError(s.handler.Backfill(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+
+			if tt.expectedCode != "" {
+				var resp ErrorResponse
+				s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+				s.Equal(tt.expectedCode, resp.Error.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+
+	"array-assessment/internal/services"
+
+	"github.com/labstack/echo/v4"
+)
+
+// jwksCacheControl lets verifiers cache the key set briefly. A scheduled key is
+// published well before it signs anything, so a short cache never hides it.
+const jwksCacheControl = "public, max-age=300"
+
+// JWKSHandler publishes the public keys access tokens are signed with
+type JWKSHandler struct {
+	tokenService services.TokenServiceInterface
+}
+
+// NewJWKSHandler creates a new JWKS handler
+func NewJWKSHandler(tokenService services.TokenServiceInterface) *JWKSHandler {
+	return &JWKSHandler{
+		tokenService: tokenService,
+	}
+}
+
+// GetJWKS serves the JSON Web Key Set
+// @Summary JSON Web Key Set
+// @Description Public keys for verifying the RS256 tokens this API issues. Match a token's kid header to a key's kid. During a key rotation the set also holds the scheduled next key and the replaced key until the tokens it signed have expired.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} dto.JWKSResponse "Token verification keys"
+// @Router /.well-known/jwks.json [get]
+func (h *JWKSHandler) GetJWKS(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, jwksCacheControl)
+	return c.JSON(http.StatusOK, h.tokenService.JWKS())
+}
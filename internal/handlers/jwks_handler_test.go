@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// JWKSHandlerSuite defines the test suite for JWKSHandler
+type JWKSHandlerSuite struct {
+	suite.Suite
+	ctrl             *gomock.Controller
+	mockTokenService *service_mocks.MockTokenServiceInterface
+	handler          *JWKSHandler
+	echo             *echo.Echo
+}
+
+// SetupTest runs before each test in the suite
+func (s *JWKSHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockTokenService = service_mocks.NewMockTokenServiceInterface(s.ctrl)
+	s.handler = NewJWKSHandler(s.mockTokenService)
+	s.echo = echo.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *JWKSHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestJWKSHandlerSuite runs the test suite
+func TestJWKSHandlerSuite(t *testing.T) {
+	suite.Run(t, new(JWKSHandlerSuite))
+}
+
+func (s *JWKSHandlerSuite) TestGetJWKS() {
+	s.mockTokenService.EXPECT().JWKS().Return(dto.JWKSResponse{
+		Keys: []dto.JWK{
+			{Kty: "RSA", Use: "sig", Alg: "RS256", Kid: "current", N: "modulus", E: "AQAB"},
+			{Kty: "RSA", Use: "sig", Alg: "RS256", Kid: "next", N: "modulus", E: "AQAB"},
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	s.Require().NoError(s.handler.GetJWKS(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal(jwksCacheControl, rec.Header().Get(echo.HeaderCacheControl))
+
+	var response dto.JWKSResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Keys, 2)
+	s.Equal("current", response.Keys[0].Kid)
+}
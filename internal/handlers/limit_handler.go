@@ -0,0 +1,405 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Audit resources for transaction limits
+const (
+	auditResourceTransactionLimit = "transaction_limit"
+	auditResourceLimitOverride    = "limit_override"
+)
+
+// LimitHandler handles transaction limit reporting and admin limit management
+type LimitHandler struct {
+	limitService services.LimitServiceInterface
+	auditRepo    repositories.AuditLogRepositoryInterface
+}
+
+// NewLimitHandler creates a new limit handler
+func NewLimitHandler(limitService services.LimitServiceInterface, auditRepo repositories.AuditLogRepositoryInterface) *LimitHandler {
+	return &LimitHandler{
+		limitService: limitService,
+		auditRepo:    auditRepo,
+	}
+}
+
+// GetAccountLimits reports an account's limits and current usage
+// @Summary Get account limits and usage
+// @Description Returns each of the account's effective limits, where it comes from (an override, the customer's limits or the account type's limits) and how much of it has been used. Withdrawal usage resets at midnight UTC and on the first of the month; the hourly count covers the past hour. A null limit is unlimited. Customers may view their own accounts; callers with customers:read may view any account.
+// @Tags Accounts
+// @Security BearerAuth
+// @Produce json
+// @Param accountId path string true "Account ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.AccountLimits} "Account limits"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid account ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Account belongs to another user"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /accounts/{accountId}/limits [get]
+func (h *LimitHandler) GetAccountLimits(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Account ID must be a valid UUID"))
+	}
+
+	limits, err := h.limitService.GetAccountLimits(userID, accountID, hasPermissionInContext(c, models.PermissionCustomersRead))
+	if err != nil {
+		return h.sendLimitError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: limits,
+	})
+}
+
+// ListAccountTypeLimits lists the limits configured for each account type
+// @Summary List account type limits (admin)
+// @Description Admin endpoint to list the limits that apply to every account of each account type unless the customer has their own limits.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]models.TransactionLimit} "Account type limits"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/limits [get]
+func (h *LimitHandler) ListAccountTypeLimits(c echo.Context) error {
+	limits, err := h.limitService.ListAccountTypeLimits()
+	if err != nil {
+		return h.sendLimitError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: limits,
+	})
+}
+
+// SetAccountTypeLimits replaces the limits for an account type
+// @Summary Set account type limits (admin)
+// @Description Admin endpoint to replace the limits for every account of an account type. Omitted limits are unlimited.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param accountType path string true "Account type" Enums(CHECKING, SAVINGS)
+// @Param request body dto.SetTransactionLimitsRequest true "Limits"
+// @Success 200 {object} SuccessResponse{data=models.TransactionLimit} "Limits updated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid account type or limit"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/limits/{accountType} [put]
+func (h *LimitHandler) SetAccountTypeLimits(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.SetTransactionLimitsRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	limit, err := h.limitService.SetAccountTypeLimits(strings.ToUpper(c.Param("accountType")), &req, adminID)
+	if err != nil {
+		return h.sendLimitError(c, err)
+	}
+
+	h.audit(c, adminID, models.AuditActionLimitsUpdated, auditResourceTransactionLimit, limit.ID.String(), limitMetadata(limit))
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    limit,
+		Message: "Limits updated",
+	})
+}
+
+// GetUserLimits retrieves a customer's own limits
+// @Summary Get customer limits (admin)
+// @Description Admin endpoint to retrieve the limits configured for a customer, which replace their account types' limits.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param userId path string true "User ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.TransactionLimit} "Customer limits"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid user ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "LIMIT_002 - Customer has no limits of their own"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/users/{userId}/limits [get]
+func (h *LimitHandler) GetUserLimits(c echo.Context) error {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("User ID must be a valid UUID"))
+	}
+
+	limit, err := h.limitService.GetUserLimits(userID)
+	if err != nil {
+		return h.sendLimitError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: limit,
+	})
+}
+
+// SetUserLimits replaces a customer's limits
+// @Summary Set customer limits (admin)
+// @Description Admin endpoint to replace a customer's limits, which apply to all of their accounts. Each limit set here replaces the account type's limit; omitted limits fall back to the account type.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID (UUID)"
+// @Param request body dto.SetTransactionLimitsRequest true "Limits"
+// @Success 200 {object} SuccessResponse{data=models.TransactionLimit} "Limits updated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid user ID or limit"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/users/{userId}/limits [put]
+func (h *LimitHandler) SetUserLimits(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("User ID must be a valid UUID"))
+	}
+
+	var req dto.SetTransactionLimitsRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	limit, err := h.limitService.SetUserLimits(userID, &req, adminID)
+	if err != nil {
+		return h.sendLimitError(c, err)
+	}
+
+	h.audit(c, adminID, models.AuditActionLimitsUpdated, auditResourceTransactionLimit, limit.ID.String(), limitMetadata(limit))
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    limit,
+		Message: "Limits updated",
+	})
+}
+
+// DeleteUserLimits removes a customer's limits
+// @Summary Delete customer limits (admin)
+// @Description Admin endpoint to remove a customer's limits so their accounts fall back to their account types' limits.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param userId path string true "User ID (UUID)"
+// @Success 200 {object} SuccessResponse "Limits deleted"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid user ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "LIMIT_002 - Customer has no limits of their own"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/users/{userId}/limits [delete]
+func (h *LimitHandler) DeleteUserLimits(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("User ID must be a valid UUID"))
+	}
+
+	if err := h.limitService.DeleteUserLimits(userID); err != nil {
+		return h.sendLimitError(c, err)
+	}
+
+	h.audit(c, adminID, models.AuditActionLimitsDeleted, auditResourceTransactionLimit, userID.String(), models.JSONBMap{
+		"user_id": userID.String(),
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Limits deleted",
+	})
+}
+
+// GrantOverride grants a temporary limit override on an account
+// @Summary Grant limit override (admin)
+// @Description Admin endpoint to temporarily replace one of an account's limits, for example to allow a one-off large payment. The override applies until it expires or is revoked and may last at most 30 days; the most recently granted override for a limit wins.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param accountId path string true "Account ID (UUID)"
+// @Param request body dto.GrantLimitOverrideRequest true "Override details"
+// @Success 201 {object} SuccessResponse{data=models.LimitOverride} "Override granted"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid account ID, value or expiry"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/accounts/{accountId}/limit-overrides [post]
+func (h *LimitHandler) GrantOverride(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Account ID must be a valid UUID"))
+	}
+
+	var req dto.GrantLimitOverrideRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	override, err := h.limitService.GrantOverride(accountID, &req, adminID)
+	if err != nil {
+		return h.sendLimitError(c, err)
+	}
+
+	h.audit(c, adminID, models.AuditActionOverrideGranted, auditResourceLimitOverride, override.ID.String(), models.JSONBMap{
+		"account_id": override.AccountID.String(),
+		"limit_type": override.LimitType,
+		"value":      override.Value.String(),
+		"reason":     override.Reason,
+		"expires_at": override.ExpiresAt,
+	})
+
+	return c.JSON(http.StatusCreated, SuccessResponse{
+		Data:    override,
+		Message: "Limit override granted",
+	})
+}
+
+// RevokeOverride ends a limit override before it expires
+// @Summary Revoke limit override (admin)
+// @Description Admin endpoint to end a limit override before it expires. The account's limit falls back to any older active override, the customer's limits or the account type's limits.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Override ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.LimitOverride} "Override revoked"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid override ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "LIMIT_003 - Override not found"
+// @Failure 409 {object} errors.ErrorResponse "LIMIT_004 - Override already expired or revoked"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/limit-overrides/{id}/revoke [post]
+func (h *LimitHandler) RevokeOverride(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	overrideID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Override ID must be a valid UUID"))
+	}
+
+	override, err := h.limitService.RevokeOverride(overrideID, adminID)
+	if err != nil {
+		return h.sendLimitError(c, err)
+	}
+
+	h.audit(c, adminID, models.AuditActionOverrideRevoked, auditResourceLimitOverride, override.ID.String(), models.JSONBMap{
+		"account_id": override.AccountID.String(),
+		"limit_type": override.LimitType,
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    override,
+		Message: "Limit override revoked",
+	})
+}
+
+// audit records a limit change
+func (h *LimitHandler) audit(c echo.Context, adminID uuid.UUID, action, resource, resourceID string, metadata models.JSONBMap) {
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     action,
+		Resource:   resource,
+		ResourceID: resourceID,
+		Metadata:   metadata,
+	})
+}
+
+// limitMetadata records which limits were set, and to what
+func limitMetadata(limit *models.TransactionLimit) models.JSONBMap {
+	metadata := models.JSONBMap{}
+	if limit.AccountType != nil {
+		metadata["account_type"] = *limit.AccountType
+	}
+	if limit.UserID != nil {
+		metadata["user_id"] = limit.UserID.String()
+	}
+	for _, limitType := range models.LimitTypes {
+		if value := limit.Value(limitType); value != nil {
+			metadata[limitType] = value.String()
+		}
+	}
+	return metadata
+}
+
+// limitExceededDetail returns the breached limit described by a limit error, and
+// whether err is a limit breach at all
+func limitExceededDetail(err error) (string, bool) {
+	if !errors.Is(err, services.ErrLimitExceeded) {
+		return "", false
+	}
+	return strings.TrimPrefix(err.Error(), services.ErrLimitExceeded.Error()+": "), true
+}
+
+func (h *LimitHandler) sendLimitError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrTransactionLimitNotSet):
+		return SendError(c, apierrors.LimitNotConfigured)
+	case errors.Is(err, services.ErrLimitOverrideNotFound):
+		return SendError(c, apierrors.LimitOverrideNotFound)
+	case errors.Is(err, services.ErrLimitOverrideNotActive):
+		return SendError(c, apierrors.LimitOverrideNotActive)
+	case errors.Is(err, services.ErrInvalidLimit), errors.Is(err, services.ErrInvalidLimitOverride):
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	case errors.Is(err, services.ErrAccountNotFound):
+		return SendError(c, apierrors.AccountNotFound)
+	case errors.Is(err, services.ErrUnauthorized):
+		return SendError(c, apierrors.AuthInsufficientPermission)
+	default:
+		return SendSystemError(c, err)
+	}
+}
@@ -0,0 +1,382 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+// LimitHandlerSuite defines the test suite for LimitHandler
+type LimitHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockLimitServiceInterface
+	auditRepo   *repository_mocks.MockAuditLogRepositoryInterface
+	handler     *LimitHandler
+	echo        *echo.Echo
+	userID      uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *LimitHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockLimitServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewLimitHandler(s.mockService, s.auditRepo)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.userID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *LimitHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestLimitHandlerSuite runs the test suite
+func TestLimitHandlerSuite(t *testing.T) {
+	suite.Run(t, new(LimitHandlerSuite))
+}
+
+func (s *LimitHandlerSuite) assertErrorCode(rec *httptest.ResponseRecorder, expectedCode string) {
+	if expectedCode == "" {
+		return
+	}
+	var resp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(expectedCode, resp.Error.Code)
+}
+
+func (s *LimitHandlerSuite) TestGetAccountLimits() {
+	accountID := uuid.New()
+
+	tests := []struct {
+		name           string
+		accountID      string
+		canViewAll     bool
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:      "returns usage",
+			accountID: accountID.String(),
+			setupMocks: func() {
+				s.mockService.EXPECT().GetAccountLimits(s.userID, accountID, false).
+					Return(&models.AccountLimits{AccountID: accountID}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "admin may view any account",
+			accountID:  accountID.String(),
+			canViewAll: true,
+			setupMocks: func() {
+				s.mockService.EXPECT().GetAccountLimits(s.userID, accountID, true).
+					Return(&models.AccountLimits{AccountID: accountID}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "another customer's account",
+			accountID: accountID.String(),
+			setupMocks: func() {
+				s.mockService.EXPECT().GetAccountLimits(s.userID, accountID, false).Return(nil, services.ErrUnauthorized)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "AUTH_005",
+		},
+		{
+			name:           "invalid account ID",
+			accountID:      "not-a-uuid",
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts/"+tt.accountID+"/limits", nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("accountId")
+			c.SetParamValues(tt.accountID)
+			c.Set("user_id", s.userID)
+			if tt.canViewAll {
+				c.Set("user_permissions", []string{models.PermissionCustomersRead})
+			}
+
+			s.NoError(s.handler.GetAccountLimits(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
+
+func (s *LimitHandlerSuite) TestSetUserLimits() {
+	userID := uuid.New()
+	daily := decimal.RequireFromString("750.00")
+	limit := &models.TransactionLimit{ID: uuid.New(), UserID: &userID, DailyWithdrawalLimit: &daily}
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "updates limits and writes audit log",
+			body: `{"dailyWithdrawalLimit":"750.00"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().SetUserLimits(userID, gomock.Any(), s.userID).Return(limit, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(models.AuditActionLimitsUpdated, log.Action)
+					s.Equal(auditResourceTransactionLimit, log.Resource)
+					s.Equal("750", log.Metadata[models.LimitTypeDailyWithdrawal])
+					return nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "hourly limit must be positive",
+			body:           `{"hourlyTransactionLimit":0}`,
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "invalid amount",
+			body: `{"maxSingleTransfer":"abc"}`,
+			setupMocks: func() {
+				s.mockService.EXPECT().SetUserLimits(userID, gomock.Any(), s.userID).
+					Return(nil, fmt.Errorf("%w: maxSingleTransfer must be a number", services.ErrInvalidLimit))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/users/"+userID.String()+"/limits", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("userId")
+			c.SetParamValues(userID.String())
+			c.Set("user_id", s.userID)
+
+			s.NoError(s.handler.SetUserLimits(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
+
+func (s *LimitHandlerSuite) TestDeleteUserLimits_NotConfigured() {
+	userID := uuid.New()
+	s.mockService.EXPECT().DeleteUserLimits(userID).Return(services.ErrTransactionLimitNotSet)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/admin/users/"+userID.String()+"/limits", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetParamNames("userId")
+	c.SetParamValues(userID.String())
+	c.Set("user_id", s.userID)
+
+	s.NoError(s.handler.DeleteUserLimits(c))
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.assertErrorCode(rec, "LIMIT_002")
+}
+
+func (s *LimitHandlerSuite) TestGrantOverride() {
+	accountID := uuid.New()
+	validBody := dto.GrantLimitOverrideRequest{
+		LimitType: models.LimitTypeSingleTransfer,
+		Value:     "10000.00",
+		Reason:    "Car purchase",
+		ExpiresAt: time.Now().Add(24 * time.Hour),
+	}
+	override := &models.LimitOverride{
+		ID:        uuid.New(),
+		AccountID: accountID,
+		LimitType: models.LimitTypeSingleTransfer,
+		Value:     decimal.RequireFromString("10000.00"),
+		Reason:    "Car purchase",
+		ExpiresAt: validBody.ExpiresAt,
+	}
+
+	badType := validBody
+	badType.LimitType = "weekly_withdrawal"
+
+	noReason := validBody
+	noReason.Reason = ""
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "grants override and writes audit log",
+			body: validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().GrantOverride(accountID, gomock.Any(), s.userID).Return(override, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(models.AuditActionOverrideGranted, log.Action)
+					s.Equal(auditResourceLimitOverride, log.Resource)
+					s.Equal(override.ID.String(), log.ResourceID)
+					s.Equal("Car purchase", log.Metadata["reason"])
+					return nil
+				})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "unknown limit type",
+			body:           badType,
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name:           "reason is required",
+			body:           noReason,
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "expiry too far away",
+			body: validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().GrantOverride(accountID, gomock.Any(), s.userID).
+					Return(nil, fmt.Errorf("%w: overrides may last at most 720h0m0s", services.ErrInvalidLimitOverride))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "account not found",
+			body: validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().GrantOverride(accountID, gomock.Any(), s.userID).Return(nil, services.ErrAccountNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "ACCOUNT_001",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			payload, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/accounts/"+accountID.String()+"/limit-overrides", bytes.NewReader(payload))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("accountId")
+			c.SetParamValues(accountID.String())
+			c.Set("user_id", s.userID)
+
+			s.NoError(s.handler.GrantOverride(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
+
+func (s *LimitHandlerSuite) TestRevokeOverride() {
+	overrideID := uuid.New()
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "revokes override and writes audit log",
+			setupMocks: func() {
+				s.mockService.EXPECT().RevokeOverride(overrideID, s.userID).
+					Return(&models.LimitOverride{ID: overrideID, LimitType: models.LimitTypeDailyWithdrawal}, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(models.AuditActionOverrideRevoked, log.Action)
+					s.Equal(overrideID.String(), log.ResourceID)
+					return nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "already revoked",
+			setupMocks: func() {
+				s.mockService.EXPECT().RevokeOverride(overrideID, s.userID).Return(nil, services.ErrLimitOverrideNotActive)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "LIMIT_004",
+		},
+		{
+			name: "unknown override",
+			setupMocks: func() {
+				s.mockService.EXPECT().RevokeOverride(overrideID, s.userID).Return(nil, services.ErrLimitOverrideNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "LIMIT_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/limit-overrides/"+overrideID.String()+"/revoke", nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(overrideID.String())
+			c.Set("user_id", s.userID)
+
+			s.NoError(s.handler.RevokeOverride(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
+
+func (s *LimitHandlerSuite) TestLimitExceededDetail() {
+	_, ok := limitExceededDetail(services.ErrInsufficientFunds)
+	s.False(ok)
+
+	detail, ok := limitExceededDetail(fmt.Errorf("%w: transfers may not exceed 2500.00", services.ErrLimitExceeded))
+	s.True(ok)
+	s.Equal("transfers may not exceed 2500.00", detail)
+}
@@ -0,0 +1,300 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// MFAHandler handles TOTP enrollment for signed-in users and admin MFA management
+type MFAHandler struct {
+	mfaService services.MFAServiceInterface
+}
+
+// NewMFAHandler creates a new MFA handler
+func NewMFAHandler(mfaService services.MFAServiceInterface) *MFAHandler {
+	return &MFAHandler{
+		mfaService: mfaService,
+	}
+}
+
+// GetStatus reports the user's MFA enrollment
+// @Summary Get MFA status
+// @Description Reports whether the authenticated user has MFA enabled, whether an admin requires it, and how many recovery codes remain.
+// @Tags Authentication
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=dto.MFAStatusResponse} "MFA status"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /auth/mfa [get]
+func (h *MFAHandler) GetStatus(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	status, err := h.mfaService.GetStatus(userID)
+	if err != nil {
+		return sendMFAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: status,
+	})
+}
+
+// BeginEnrollment starts TOTP enrollment
+// @Summary Start MFA enrollment
+// @Description Generates a new authenticator secret. Render provisioningUri as a QR code for the user to scan, then confirm with a code from their app. Starting again before confirming replaces the secret.
+// @Tags Authentication
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=dto.MFAEnrollmentResponse} "Enrollment started"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 409 {object} errors.ErrorResponse "AUTH_009 - MFA already enabled"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /auth/mfa/enroll [post]
+func (h *MFAHandler) BeginEnrollment(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	enrollment, err := h.mfaService.BeginEnrollment(userID, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return sendMFAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    enrollment,
+		Message: "Scan the QR code with your authenticator app and confirm with a code",
+	})
+}
+
+// ConfirmEnrollment enables MFA with a code from the user's authenticator
+// @Summary Confirm MFA enrollment
+// @Description Enables MFA once a code from the newly enrolled authenticator is verified. The response contains single-use recovery codes, which are shown only once.
+// @Tags Authentication
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.MFACodeRequest true "Authenticator code"
+// @Success 200 {object} SuccessResponse{data=dto.MFARecoveryCodesResponse} "MFA enabled"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication, AUTH_007 - Invalid code"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_006 - Account locked"
+// @Failure 409 {object} errors.ErrorResponse "AUTH_009 - MFA already enabled, AUTH_010 - Enrollment not started"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /auth/mfa/enroll/confirm [post]
+func (h *MFAHandler) ConfirmEnrollment(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.MFACodeRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	codes, err := h.mfaService.ConfirmEnrollment(userID, req.Code, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return sendMFAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    codes,
+		Message: "Multi-factor authentication enabled. Store these recovery codes somewhere safe",
+	})
+}
+
+// Disable turns MFA off
+// @Summary Disable MFA
+// @Description Removes the user's authenticator after verifying a code or recovery code. Not allowed when an admin requires MFA for the user.
+// @Tags Authentication
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.MFACodeRequest true "Authenticator or recovery code"
+// @Success 200 {object} SuccessResponse{message=string} "MFA disabled"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication, AUTH_007 - Invalid code"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_006 - Account locked, AUTH_011 - MFA required by an admin"
+// @Failure 409 {object} errors.ErrorResponse "AUTH_010 - MFA not enabled"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /auth/mfa/disable [post]
+func (h *MFAHandler) Disable(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.MFACodeRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	if err := h.mfaService.Disable(userID, req.Code, getClientIP(c), c.Request().UserAgent()); err != nil {
+		return sendMFAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Multi-factor authentication disabled",
+	})
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes
+// @Summary Regenerate MFA recovery codes
+// @Description Replaces all of the user's recovery codes after verifying a code. The previous codes stop working.
+// @Tags Authentication
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.MFACodeRequest true "Authenticator or recovery code"
+// @Success 200 {object} SuccessResponse{data=dto.MFARecoveryCodesResponse} "New recovery codes"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication, AUTH_007 - Invalid code"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_006 - Account locked"
+// @Failure 409 {object} errors.ErrorResponse "AUTH_010 - MFA not enabled"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /auth/mfa/recovery-codes [post]
+func (h *MFAHandler) RegenerateRecoveryCodes(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.MFACodeRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	codes, err := h.mfaService.RegenerateRecoveryCodes(userID, req.Code, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return sendMFAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    codes,
+		Message: "Recovery codes regenerated",
+	})
+}
+
+// SetRequirement makes MFA mandatory or optional for a user
+// @Summary Require MFA for a user (admin)
+// @Description Admin endpoint to require a user to use MFA. A user who is required to and has not enrolled must enroll at their next login, and cannot disable MFA.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID (UUID)"
+// @Param request body dto.SetMFARequirementRequest true "Requirement"
+// @Success 200 {object} SuccessResponse{message=string} "Requirement updated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid user ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - User not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/users/{userId}/mfa [put]
+func (h *MFAHandler) SetRequirement(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("User ID must be a valid UUID"))
+	}
+
+	var req dto.SetMFARequirementRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	if err := h.mfaService.SetRequired(userID, adminID, *req.Required, getClientIP(c), c.Request().UserAgent()); err != nil {
+		return sendMFAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Message: "MFA requirement updated",
+	})
+}
+
+// Reset removes a user's authenticator
+// @Summary Reset a user's MFA (admin)
+// @Description Admin endpoint to remove a user's authenticator and recovery codes, for users who lost both. The user can then enroll again, and must at their next login if MFA is required for them.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param userId path string true "User ID (UUID)"
+// @Success 200 {object} SuccessResponse{message=string} "MFA reset"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid user ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission, AUTH_008 - Recent MFA required"
+// @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - User not found"
+// @Failure 409 {object} errors.ErrorResponse "AUTH_010 - MFA not enabled"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/users/{userId}/mfa [delete]
+func (h *MFAHandler) Reset(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("User ID must be a valid UUID"))
+	}
+
+	if err := h.mfaService.Reset(userID, adminID, getClientIP(c), c.Request().UserAgent()); err != nil {
+		return sendMFAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Message: "MFA reset",
+	})
+}
+
+// sendMFAError maps MFA service errors, shared with the MFA login endpoints
+func sendMFAError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidMFACode):
+		return SendError(c, apierrors.AuthInvalidMFACode)
+	case errors.Is(err, services.ErrInvalidMFAChallenge):
+		return SendError(c, apierrors.AuthInvalidTokenFormat, apierrors.WithDetails("Invalid or expired MFA challenge"))
+	case errors.Is(err, services.ErrAccountLocked):
+		return SendError(c, apierrors.AuthAccountLocked)
+	case errors.Is(err, services.ErrMFAAlreadyEnabled):
+		return SendError(c, apierrors.AuthMFAAlreadyEnabled)
+	case errors.Is(err, services.ErrMFANotEnabled):
+		return SendError(c, apierrors.AuthMFANotEnabled)
+	case errors.Is(err, services.ErrMFAEnrollmentNotStarted):
+		return SendError(c, apierrors.AuthMFANotEnabled, apierrors.WithDetails("Start enrollment before confirming it"))
+	case errors.Is(err, services.ErrMFAEnforced):
+		return SendError(c, apierrors.AuthMFAEnforced)
+	case errors.Is(err, services.ErrUserNotFound):
+		return SendError(c, apierrors.CustomerNotFound)
+	default:
+		return SendSystemError(c, err)
+	}
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// MFAHandlerSuite defines the test suite for MFAHandler
+type MFAHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockMFAServiceInterface
+	handler     *MFAHandler
+	echo        *echo.Echo
+	userID      uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *MFAHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockMFAServiceInterface(s.ctrl)
+	s.handler = NewMFAHandler(s.mockService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.userID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *MFAHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestMFAHandlerSuite runs the test suite
+func TestMFAHandlerSuite(t *testing.T) {
+	suite.Run(t, new(MFAHandlerSuite))
+}
+
+// newContext builds an authenticated request context
+func (s *MFAHandlerSuite) newContext(method, target, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.userID)
+	return c, rec
+}
+
+func (s *MFAHandlerSuite) TestBeginEnrollment_ReturnsProvisioningURI() {
+	s.mockService.EXPECT().BeginEnrollment(s.userID, gomock.Any(), gomock.Any()).
+		Return(&dto.MFAEnrollmentResponse{Secret: "SECRET", ProvisioningURI: "otpauth://totp/x", Digits: 6, Period: 30}, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/auth/mfa/enroll", "")
+	s.Require().NoError(s.handler.BeginEnrollment(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"provisioningUri":"otpauth://totp/x"`)
+}
+
+func (s *MFAHandlerSuite) TestBeginEnrollment_AlreadyEnabled() {
+	s.mockService.EXPECT().BeginEnrollment(s.userID, gomock.Any(), gomock.Any()).Return(nil, services.ErrMFAAlreadyEnabled)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/auth/mfa/enroll", "")
+	s.Require().NoError(s.handler.BeginEnrollment(c))
+
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "AUTH_009")
+}
+
+func (s *MFAHandlerSuite) TestConfirmEnrollment_ReturnsRecoveryCodes() {
+	s.mockService.EXPECT().ConfirmEnrollment(s.userID, "123456", gomock.Any(), gomock.Any()).
+		Return(&dto.MFARecoveryCodesResponse{RecoveryCodes: []string{"aaaaa-bbbbb"}}, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/auth/mfa/enroll/confirm", `{"code":"123456"}`)
+	s.Require().NoError(s.handler.ConfirmEnrollment(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response SuccessResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal([]interface{}{"aaaaa-bbbbb"}, response.Data.(map[string]interface{})["recoveryCodes"])
+}
+
+func (s *MFAHandlerSuite) TestConfirmEnrollment_RequiresCode() {
+	c, _ := s.newContext(http.MethodPost, "/api/v1/auth/mfa/enroll/confirm", `{}`)
+
+	s.Error(s.handler.ConfirmEnrollment(c), "validation errors are left to the error handler middleware")
+}
+
+func (s *MFAHandlerSuite) TestDisable_EnforcedByAdmin() {
+	s.mockService.EXPECT().Disable(s.userID, "123456", gomock.Any(), gomock.Any()).Return(services.ErrMFAEnforced)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/auth/mfa/disable", `{"code":"123456"}`)
+	s.Require().NoError(s.handler.Disable(c))
+
+	s.Equal(http.StatusForbidden, rec.Code)
+	s.Contains(rec.Body.String(), "AUTH_011")
+}
+
+func (s *MFAHandlerSuite) TestRegenerateRecoveryCodes_InvalidCode() {
+	s.mockService.EXPECT().RegenerateRecoveryCodes(s.userID, "000000", gomock.Any(), gomock.Any()).Return(nil, services.ErrInvalidMFACode)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/auth/mfa/recovery-codes", `{"code":"000000"}`)
+	s.Require().NoError(s.handler.RegenerateRecoveryCodes(c))
+
+	s.Equal(http.StatusUnauthorized, rec.Code)
+	s.Contains(rec.Body.String(), "AUTH_007")
+}
+
+func (s *MFAHandlerSuite) TestSetRequirement() {
+	targetID := uuid.New()
+	s.mockService.EXPECT().SetRequired(targetID, s.userID, true, gomock.Any(), gomock.Any()).Return(nil)
+
+	c, rec := s.newContext(http.MethodPut, "/api/v1/admin/users/"+targetID.String()+"/mfa", `{"required":true}`)
+	c.SetParamNames("userId")
+	c.SetParamValues(targetID.String())
+	s.Require().NoError(s.handler.SetRequirement(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+func (s *MFAHandlerSuite) TestSetRequirement_UserNotFound() {
+	targetID := uuid.New()
+	s.mockService.EXPECT().SetRequired(targetID, s.userID, false, gomock.Any(), gomock.Any()).Return(services.ErrUserNotFound)
+
+	c, rec := s.newContext(http.MethodPut, "/api/v1/admin/users/"+targetID.String()+"/mfa", `{"required":false}`)
+	c.SetParamNames("userId")
+	c.SetParamValues(targetID.String())
+	s.Require().NoError(s.handler.SetRequirement(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *MFAHandlerSuite) TestReset_NotEnabled() {
+	targetID := uuid.New()
+	s.mockService.EXPECT().Reset(targetID, s.userID, gomock.Any(), gomock.Any()).Return(services.ErrMFANotEnabled)
+
+	c, rec := s.newContext(http.MethodDelete, "/api/v1/admin/users/"+targetID.String()+"/mfa", "")
+	c.SetParamNames("userId")
+	c.SetParamValues(targetID.String())
+	s.Require().NoError(s.handler.Reset(c))
+
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "AUTH_010")
+}
@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// auditResourceQueueItem is the audit resource for processing queue items
+const auditResourceQueueItem = "queue_item"
+
+// QueueHandler handles admin monitoring of the processing queue and its failed items
+type QueueHandler struct {
+	processingService services.TransactionProcessingServiceInterface
+	deadLetterService services.DeadLetterServiceInterface
+	auditRepo         repositories.AuditLogRepositoryInterface
+}
+
+// NewQueueHandler creates a new queue handler
+func NewQueueHandler(
+	processingService services.TransactionProcessingServiceInterface,
+	deadLetterService services.DeadLetterServiceInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+) *QueueHandler {
+	return &QueueHandler{
+		processingService: processingService,
+		deadLetterService: deadLetterService,
+		auditRepo:         auditRepo,
+	}
+}
+
+// GetMetrics returns processing queue metrics
+// @Summary Get queue metrics (admin)
+// @Description Admin endpoint to retrieve processing queue depth, throughput and the number of failed items per operation
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=dto.QueueMetrics} "Queue metrics"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/metrics [get]
+func (h *QueueHandler) GetMetrics(c echo.Context) error {
+	metrics, err := h.processingService.GetQueueMetrics()
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: metrics,
+	})
+}
+
+// ListFailed lists failed queue items
+// @Summary List failed queue items (admin)
+// @Description Admin endpoint to list queue items that failed after exhausting their retries, most recently failed first. Each item includes its last error and retry history. Age filters are Go durations such as 30m or 24h, measured from when the item failed.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param operation query string false "Filter by operation" Enums(process, reverse, expire)
+// @Param older_than query string false "Only items that failed more than this long ago"
+// @Param newer_than query string false "Only items that failed within this long"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.ProcessingQueueItem} "Failed queue items"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid operation, age or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/failed [get]
+func (h *QueueHandler) ListFailed(c echo.Context) error {
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	filters := models.QueueItemFilters{
+		Operation: c.QueryParam("operation"),
+	}
+
+	if filters.Operation != "" && !models.IsValidQueueOperation(filters.Operation) {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("operation: must be one of process, reverse, expire"))
+	}
+
+	now := time.Now()
+	if olderThan := c.QueryParam("older_than"); olderThan != "" {
+		age, err := time.ParseDuration(olderThan)
+		if err != nil || age < 0 {
+			return SendError(c, apierrors.ValidationGeneral,
+				apierrors.WithDetails("older_than: must be a positive duration such as 24h"))
+		}
+		before := now.Add(-age)
+		filters.FailedBefore = &before
+	}
+	if newerThan := c.QueryParam("newer_than"); newerThan != "" {
+		age, err := time.ParseDuration(newerThan)
+		if err != nil || age < 0 {
+			return SendError(c, apierrors.ValidationGeneral,
+				apierrors.WithDetails("newer_than: must be a positive duration such as 24h"))
+		}
+		after := now.Add(-age)
+		filters.FailedAfter = &after
+	}
+
+	items, total, err := h.deadLetterService.ListFailed(filters, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: items,
+		Meta: map[string]interface{}{
+			"total":       total,
+			"page":        page,
+			"limit":       limit,
+			"total_pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetItem retrieves a queue item
+// @Summary Get queue item (admin)
+// @Description Admin endpoint to retrieve a processing queue item with its last error and retry history
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Queue item ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.ProcessingQueueItem} "Queue item"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid queue item ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "QUEUE_001 - Queue item not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/failed/{id} [get]
+func (h *QueueHandler) GetItem(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid queue item ID"))
+	}
+
+	item, err := h.deadLetterService.GetItem(id)
+	if err != nil {
+		return h.sendQueueError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: item,
+	})
+}
+
+// ReplayFailed queues failed items for processing again
+// @Summary Replay failed queue items (admin)
+// @Description Admin endpoint to replay up to 100 failed queue items. Each item is scheduled immediately with its retry count reset; its retry history is kept. Items that do not exist or are not failed are reported as skipped.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.ReplayQueueItemsRequest true "Queue item IDs"
+// @Success 200 {object} SuccessResponse{data=dto.QueueItemsActionResponse} "Items replayed"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Missing or invalid queue item IDs"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/failed/replay [post]
+func (h *QueueHandler) ReplayFailed(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.ReplayQueueItemsRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	result, err := h.deadLetterService.Replay(&req)
+	if err != nil {
+		return h.sendQueueError(c, err)
+	}
+
+	h.createAuditLog(c, adminID, models.AuditActionQueueItemsReplayed, models.JSONBMap{
+		"item_ids":    result.ItemIDs,
+		"skipped_ids": result.SkippedIDs,
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    result,
+		Message: "Queue items replayed",
+	})
+}
+
+// PurgeFailed purges failed items with a recorded reason
+// @Summary Purge failed queue items (admin)
+// @Description Admin endpoint to purge up to 100 failed queue items. Purged items are kept with the reason and the admin who purged them, but are no longer listed or replayable. Items that do not exist or are not failed are reported as skipped.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.PurgeQueueItemsRequest true "Queue item IDs and purge reason"
+// @Success 200 {object} SuccessResponse{data=dto.QueueItemsActionResponse} "Items purged"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Missing or invalid queue item IDs or reason"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/failed/purge [post]
+func (h *QueueHandler) PurgeFailed(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.PurgeQueueItemsRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	result, err := h.deadLetterService.Purge(adminID, &req)
+	if err != nil {
+		return h.sendQueueError(c, err)
+	}
+
+	h.createAuditLog(c, adminID, models.AuditActionQueueItemsPurged, models.JSONBMap{
+		"reason":      req.Reason,
+		"item_ids":    result.ItemIDs,
+		"skipped_ids": result.SkippedIDs,
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    result,
+		Message: "Queue items purged",
+	})
+}
+
+// createAuditLog records a replay or purge
+func (h *QueueHandler) createAuditLog(c echo.Context, adminID uuid.UUID, action string, metadata models.JSONBMap) {
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		UserID:   &adminID,
+		Action:   action,
+		Resource: auditResourceQueueItem,
+		Metadata: metadata,
+	})
+}
+
+func (h *QueueHandler) sendQueueError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrQueueItemNotFound):
+		return SendError(c, apierrors.QueueItemNotFound)
+	case errors.Is(err, services.ErrInvalidQueueItemID):
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	default:
+		return SendSystemError(c, err)
+	}
+}
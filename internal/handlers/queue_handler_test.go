@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// QueueHandlerSuite defines the test suite for QueueHandler
+type QueueHandlerSuite struct {
+	suite.Suite
+	ctrl              *gomock.Controller
+	processingService *service_mocks.MockTransactionProcessingServiceInterface
+	deadLetterService *service_mocks.MockDeadLetterServiceInterface
+	auditRepo         *repository_mocks.MockAuditLogRepositoryInterface
+	handler           *QueueHandler
+	echo              *echo.Echo
+	adminID           uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *QueueHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.processingService = service_mocks.NewMockTransactionProcessingServiceInterface(s.ctrl)
+	s.deadLetterService = service_mocks.NewMockDeadLetterServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewQueueHandler(s.processingService, s.deadLetterService, s.auditRepo)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *QueueHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestQueueHandlerSuite runs the test suite
+func TestQueueHandlerSuite(t *testing.T) {
+	suite.Run(t, new(QueueHandlerSuite))
+}
+
+func (s *QueueHandlerSuite) assertErrorCode(rec *httptest.ResponseRecorder, expectedCode string) {
+	if expectedCode == "" {
+		return
+	}
+	var resp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(expectedCode, resp.Error.Code)
+}
+
+func (s *QueueHandlerSuite) TestGetMetrics_IncludesFailuresByOperation() {
+	s.processingService.EXPECT().GetQueueMetrics().Return(&dto.QueueMetrics{
+		FailedCount:       3,
+		FailedByOperation: map[string]int64{models.QueueOperationProcess: 2, models.QueueOperationReverse: 1},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/queue/metrics", nil)
+	rec := httptest.NewRecorder()
+
+	s.NoError(s.handler.GetMetrics(s.echo.NewContext(req, rec)))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data dto.QueueMetrics `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(int64(2), resp.Data.FailedByOperation[models.QueueOperationProcess])
+}
+
+func (s *QueueHandlerSuite) TestListFailed() {
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:  "filters by operation and age",
+			query: "?operation=reverse&older_than=1h&newer_than=24h&page=2&limit=10",
+			setupMocks: func() {
+				s.deadLetterService.EXPECT().ListFailed(gomock.Any(), 10, 10).
+					DoAndReturn(func(filters models.QueueItemFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error) {
+						s.Equal(models.QueueOperationReverse, filters.Operation)
+						s.Require().NotNil(filters.FailedBefore)
+						s.Require().NotNil(filters.FailedAfter)
+						s.WithinDuration(time.Now().Add(-time.Hour), *filters.FailedBefore, time.Minute)
+						s.WithinDuration(time.Now().Add(-24*time.Hour), *filters.FailedAfter, time.Minute)
+						return []models.ProcessingQueueItem{}, 0, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown operation",
+			query:          "?operation=refund",
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_001",
+		},
+		{
+			name:           "invalid age",
+			query:          "?older_than=yesterday",
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_001",
+		},
+		{
+			name:           "limit too large",
+			query:          "?limit=500",
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_001",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/queue/failed"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			s.NoError(s.handler.ListFailed(s.echo.NewContext(req, rec)))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
+
+func (s *QueueHandlerSuite) TestGetItem() {
+	itemID := uuid.New()
+
+	tests := []struct {
+		name           string
+		itemID         string
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:   "returns item with retry history",
+			itemID: itemID.String(),
+			setupMocks: func() {
+				item := &models.ProcessingQueueItem{ID: itemID, Status: models.QueueStatusFailed}
+				item.RecordAttempt("connection reset")
+				s.deadLetterService.EXPECT().GetItem(itemID).Return(item, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "not found",
+			itemID: itemID.String(),
+			setupMocks: func() {
+				s.deadLetterService.EXPECT().GetItem(itemID).Return(nil, services.ErrQueueItemNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "QUEUE_001",
+		},
+		{
+			name:           "invalid ID",
+			itemID:         "not-a-uuid",
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/queue/failed/"+tt.itemID, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.itemID)
+
+			s.NoError(s.handler.GetItem(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
+
+func (s *QueueHandlerSuite) TestReplayFailed() {
+	replayedID := uuid.NewString()
+	skippedID := uuid.NewString()
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "replays items and writes audit log",
+			body: dto.ReplayQueueItemsRequest{IDs: []string{replayedID, skippedID}},
+			setupMocks: func() {
+				s.deadLetterService.EXPECT().Replay(gomock.Any()).Return(&dto.QueueItemsActionResponse{
+					ItemIDs:    []string{replayedID},
+					SkippedIDs: []string{skippedID},
+				}, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(models.AuditActionQueueItemsReplayed, log.Action)
+					s.Equal(auditResourceQueueItem, log.Resource)
+					s.Equal(&s.adminID, log.UserID)
+					return nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "IDs are required",
+			body:           dto.ReplayQueueItemsRequest{},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name:           "IDs must be UUIDs",
+			body:           dto.ReplayQueueItemsRequest{IDs: []string{"not-a-uuid"}},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			payload, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/queue/failed/replay", bytes.NewReader(payload))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.Set("user_id", s.adminID)
+
+			s.NoError(s.handler.ReplayFailed(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
+
+func (s *QueueHandlerSuite) TestPurgeFailed() {
+	itemID := uuid.NewString()
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "purges items and records reason",
+			body: dto.PurgeQueueItemsRequest{IDs: []string{itemID}, Reason: "Duplicate of a settled transfer"},
+			setupMocks: func() {
+				s.deadLetterService.EXPECT().Purge(s.adminID, gomock.Any()).Return(&dto.QueueItemsActionResponse{
+					ItemIDs:    []string{itemID},
+					SkippedIDs: []string{},
+				}, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(models.AuditActionQueueItemsPurged, log.Action)
+					s.Equal("Duplicate of a settled transfer", log.Metadata["reason"])
+					return nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "reason is required",
+			body:           dto.PurgeQueueItemsRequest{IDs: []string{itemID}},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			payload, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/queue/failed/purge", bytes.NewReader(payload))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.Set("user_id", s.adminID)
+
+			s.NoError(s.handler.PurgeFailed(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			s.assertErrorCode(rec, tt.expectedCode)
+		})
+	}
+}
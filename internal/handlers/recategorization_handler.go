@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// auditResourceRecategorizationJob is the audit resource for recategorization jobs
+const auditResourceRecategorizationJob = "recategorization_job"
+
+// RecategorizationHandler handles admin control of recategorization backfill jobs
+type RecategorizationHandler struct {
+	recategorizationService services.RecategorizationServiceInterface
+	auditRepo               repositories.AuditLogRepositoryInterface
+}
+
+// NewRecategorizationHandler creates a new recategorization handler
+func NewRecategorizationHandler(recategorizationService services.RecategorizationServiceInterface, auditRepo repositories.AuditLogRepositoryInterface) *RecategorizationHandler {
+	return &RecategorizationHandler{
+		recategorizationService: recategorizationService,
+		auditRepo:               auditRepo,
+	}
+}
+
+// StartJob queues a recategorization backfill
+// @Summary Start recategorization job (admin)
+// @Description Admin endpoint to re-run the categorization rules over historical transactions, optionally limited to an account and a date range. Manually overridden transactions are skipped. With dry_run the job only reports what would change.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.StartRecategorizationRequest true "Job scope and options"
+// @Success 202 {object} SuccessResponse{data=models.RecategorizationJob} "Job queued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or VALIDATION_003 - Invalid batch size or date range"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/recategorization-jobs [post]
+func (h *RecategorizationHandler) StartJob(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.StartRecategorizationRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	job, err := h.recategorizationService.CreateJob(&req, adminID)
+	if err != nil {
+		return h.sendJobError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionCreate, job, models.JSONBMap{
+		"dry_run":    job.DryRun,
+		"account_id": job.AccountID,
+		"start_date": job.StartDate,
+		"end_date":   job.EndDate,
+	})
+
+	return c.JSON(http.StatusAccepted, SuccessResponse{
+		Data:    job,
+		Message: "Recategorization job queued",
+	})
+}
+
+// ListJobs lists recategorization jobs
+// @Summary List recategorization jobs (admin)
+// @Description Admin endpoint to list recategorization jobs, newest first
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.RecategorizationJob} "Recategorization jobs"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/recategorization-jobs [get]
+func (h *RecategorizationHandler) ListJobs(c echo.Context) error {
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	jobs, total, err := h.recategorizationService.ListJobs((page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: jobs,
+		Meta: map[string]interface{}{
+			"total":       total,
+			"page":        page,
+			"limit":       limit,
+			"total_pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetJob retrieves a recategorization job
+// @Summary Get recategorization job (admin)
+// @Description Admin endpoint to retrieve a recategorization job's progress and counts per category transition
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Job ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.RecategorizationJob} "Recategorization job"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid job ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_006 - Recategorization job not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/recategorization-jobs/{id} [get]
+func (h *RecategorizationHandler) GetJob(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid job ID"))
+	}
+
+	job, err := h.recategorizationService.GetJob(id)
+	if err != nil {
+		return h.sendJobError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: job,
+	})
+}
+
+// CancelJob cancels a recategorization job
+// @Summary Cancel recategorization job (admin)
+// @Description Admin endpoint to cancel a pending or running recategorization job. A running job stops after its current batch and can be resumed later.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Job ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.RecategorizationJob} "Job cancelled"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid job ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_006 - Recategorization job not found"
+// @Failure 409 {object} errors.ErrorResponse "CATEGORY_007 - Job has already finished"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/recategorization-jobs/{id}/cancel [post]
+func (h *RecategorizationHandler) CancelJob(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid job ID"))
+	}
+
+	job, err := h.recategorizationService.CancelJob(id)
+	if err != nil {
+		return h.sendJobError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionUpdate, job, models.JSONBMap{
+		"status": job.Status,
+	})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    job,
+		Message: "Recategorization job cancelled",
+	})
+}
+
+// ResumeJob resumes a recategorization job from its last checkpoint
+// @Summary Resume recategorization job (admin)
+// @Description Admin endpoint to queue a failed or cancelled recategorization job again. It continues from its last checkpoint.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Job ID (UUID)"
+// @Success 202 {object} SuccessResponse{data=models.RecategorizationJob} "Job queued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid job ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CATEGORY_006 - Recategorization job not found"
+// @Failure 409 {object} errors.ErrorResponse "CATEGORY_007 - Job is not failed or cancelled"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/recategorization-jobs/{id}/resume [post]
+func (h *RecategorizationHandler) ResumeJob(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid job ID"))
+	}
+
+	job, err := h.recategorizationService.ResumeJob(id)
+	if err != nil {
+		return h.sendJobError(c, err)
+	}
+
+	h.createAuditLog(c, models.AuditActionUpdate, job, models.JSONBMap{
+		"status": job.Status,
+	})
+
+	return c.JSON(http.StatusAccepted, SuccessResponse{
+		Data:    job,
+		Message: "Recategorization job resumed",
+	})
+}
+
+// sendJobError maps service errors to API error responses
+func (h *RecategorizationHandler) sendJobError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrRecategorizationJobNotFound):
+		return SendError(c, apierrors.RecategorizationNotFound)
+	case errors.Is(err, services.ErrRecategorizationJobState):
+		return SendError(c, apierrors.RecategorizationInvalidState)
+	case errors.Is(err, services.ErrInvalidRecategorizationJob):
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	default:
+		return SendSystemError(c, err)
+	}
+}
+
+// createAuditLog records an admin action on a recategorization job
+func (h *RecategorizationHandler) createAuditLog(c echo.Context, action string, job *models.RecategorizationJob, metadata models.JSONBMap) {
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		Action:     action,
+		Resource:   auditResourceRecategorizationJob,
+		ResourceID: job.ID.String(),
+		Metadata:   metadata,
+	})
+}
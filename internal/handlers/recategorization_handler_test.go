@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// RecategorizationHandlerSuite defines the test suite for RecategorizationHandler
+type RecategorizationHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockRecategorizationServiceInterface
+	auditRepo   *repository_mocks.MockAuditLogRepositoryInterface
+	handler     *RecategorizationHandler
+	echo        *echo.Echo
+	adminID     uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *RecategorizationHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockRecategorizationServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewRecategorizationHandler(s.mockService, s.auditRepo)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *RecategorizationHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestRecategorizationHandlerSuite runs the test suite
+func TestRecategorizationHandlerSuite(t *testing.T) {
+	suite.Run(t, new(RecategorizationHandlerSuite))
+}
+
+// newContext builds a request context authenticated as the admin user
+func (s *RecategorizationHandlerSuite) newContext(method, target string, body interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+
+	req := httptest.NewRequest(method, target, bytes.NewReader(payload))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+
+	return c, rec
+}
+
+func (s *RecategorizationHandlerSuite) TestStartJob() {
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+	}{
+		{
+			name: "queues job and writes audit log",
+			body: dto.StartRecategorizationRequest{DryRun: true},
+			setupMocks: func() {
+				s.mockService.EXPECT().CreateJob(&dto.StartRecategorizationRequest{DryRun: true}, s.adminID).
+					Return(&models.RecategorizationJob{ID: uuid.New(), Status: models.RecategorizationStatusPending, DryRun: true}, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(auditResourceRecategorizationJob, log.Resource)
+					s.Equal(true, log.Metadata["dry_run"])
+					return nil
+				})
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "batch size out of range",
+			body:           dto.StartRecategorizationRequest{BatchSize: 100000},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid date range",
+			body: dto.StartRecategorizationRequest{},
+			setupMocks: func() {
+				s.mockService.EXPECT().CreateJob(gomock.Any(), s.adminID).Return(nil, services.ErrInvalidRecategorizationJob)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			c, rec := s.newContext(http.MethodPost, "/api/v1/admin/recategorization-jobs", tt.body)
+
+			s.NoError(s.handler.StartJob(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func (s *RecategorizationHandlerSuite) TestGetJob_ReportsTransitions() {
+	id := uuid.New()
+	s.mockService.EXPECT().GetJob(id).Return(&models.RecategorizationJob{
+		ID:          id,
+		Status:      models.RecategorizationStatusCompleted,
+		Transitions: models.CategoryTransitionCounts{"OTHER->DINING": 42},
+	}, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/api/v1/admin/recategorization-jobs/"+id.String(), nil)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	s.NoError(s.handler.GetJob(c))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data models.RecategorizationJob `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(int64(42), resp.Data.Transitions["OTHER->DINING"])
+}
+
+func (s *RecategorizationHandlerSuite) TestCancelJob_AlreadyFinished() {
+	id := uuid.New()
+	s.mockService.EXPECT().CancelJob(id).Return(nil, services.ErrRecategorizationJobState)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/admin/recategorization-jobs/"+id.String()+"/cancel", nil)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	s.NoError(s.handler.CancelJob(c))
+	s.Equal(http.StatusConflict, rec.Code)
+
+	var resp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal("CATEGORY_007", resp.Error.Code)
+}
+
+func (s *RecategorizationHandlerSuite) TestResumeJob_NotFound() {
+	id := uuid.New()
+	s.mockService.EXPECT().ResumeJob(id).Return(nil, services.ErrRecategorizationJobNotFound)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/admin/recategorization-jobs/"+id.String()+"/resume", nil)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	s.NoError(s.handler.ResumeJob(c))
+	s.Equal(http.StatusNotFound, rec.Code)
+}
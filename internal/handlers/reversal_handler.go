@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// auditResourceTransaction is the audit resource for transaction reversals
+const auditResourceTransaction = "transaction"
+
+// ReversalHandler handles admin transaction reversals
+type ReversalHandler struct {
+	reversalService services.ReversalServiceInterface
+	auditRepo       repositories.AuditLogRepositoryInterface
+}
+
+// NewReversalHandler creates a new reversal handler
+func NewReversalHandler(reversalService services.ReversalServiceInterface, auditRepo repositories.AuditLogRepositoryInterface) *ReversalHandler {
+	return &ReversalHandler{
+		reversalService: reversalService,
+		auditRepo:       auditRepo,
+	}
+}
+
+// ReverseTransaction queues the reversal of a completed transaction
+// @Summary Reverse transaction (admin)
+// @Description Admin endpoint to reverse a completed transaction. The reversal is queued at high priority; processing writes an offsetting transaction of the opposite type, linked to the original through reversal_of, and marks the original reversed. Reversing either leg of a transfer reverses both legs and marks the transfer reversed. A transaction can be reversed only once, and a credit can be reversed only while the account still has the amount available.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Transaction ID (UUID)"
+// @Param request body dto.ReverseTransactionRequest true "Reversal reason"
+// @Success 202 {object} SuccessResponse{data=dto.ReverseTransactionResponse} "Reversal queued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid transaction ID or missing reason"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "TRANSACTION_001 - Transaction not found"
+// @Failure 409 {object} errors.ErrorResponse "TRANSACTION_011 - Already reversed or reversal in progress"
+// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_012 - Transaction not completed or TRANSACTION_003 - Reversal would overdraw the account"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/transactions/{id}/reverse [post]
+func (h *ReversalHandler) ReverseTransaction(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Transaction ID must be a valid UUID"))
+	}
+
+	var req dto.ReverseTransactionRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	reversal, err := h.reversalService.RequestReversal(transactionID, adminID, &req)
+	if err != nil {
+		return h.sendReversalError(c, err)
+	}
+
+	metadata := models.JSONBMap{
+		"reason":          req.Reason,
+		"transaction_ids": reversal.TransactionIDs,
+	}
+	if reversal.TransferID != nil {
+		metadata["transfer_id"] = *reversal.TransferID
+	}
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     models.AuditActionReversalRequested,
+		Resource:   auditResourceTransaction,
+		ResourceID: transactionID.String(),
+		Metadata:   metadata,
+	})
+
+	return c.JSON(http.StatusAccepted, SuccessResponse{
+		Data:    reversal,
+		Message: "Reversal queued",
+	})
+}
+
+func (h *ReversalHandler) sendReversalError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrTransactionNotFound):
+		return SendError(c, apierrors.TransactionNotFound)
+	case errors.Is(err, services.ErrTransactionAlreadyReversed):
+		return SendError(c, apierrors.TransactionAlreadyReversed)
+	case errors.Is(err, services.ErrTransactionNotReversible):
+		return SendError(c, apierrors.TransactionNotReversible)
+	case errors.Is(err, services.ErrInsufficientFunds):
+		return SendError(c, apierrors.TransactionInsufficientFunds)
+	case errors.Is(err, services.ErrAccountNotFound):
+		return SendError(c, apierrors.AccountNotFound)
+	default:
+		return SendSystemError(c, err)
+	}
+}
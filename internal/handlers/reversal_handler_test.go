@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// ReversalHandlerSuite defines the test suite for ReversalHandler
+type ReversalHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockReversalServiceInterface
+	auditRepo   *repository_mocks.MockAuditLogRepositoryInterface
+	handler     *ReversalHandler
+	echo        *echo.Echo
+	adminID     uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *ReversalHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockReversalServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewReversalHandler(s.mockService, s.auditRepo)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *ReversalHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestReversalHandlerSuite runs the test suite
+func TestReversalHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ReversalHandlerSuite))
+}
+
+func (s *ReversalHandlerSuite) TestReverseTransaction() {
+	transactionID := uuid.New()
+	transferID := uuid.NewString()
+
+	tests := []struct {
+		name           string
+		transactionID  string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:          "queues reversal and writes audit log",
+			transactionID: transactionID.String(),
+			body:          dto.ReverseTransactionRequest{Reason: "Sent in error"},
+			setupMocks: func() {
+				s.mockService.EXPECT().RequestReversal(transactionID, s.adminID, gomock.Any()).
+					Return(&dto.ReverseTransactionResponse{
+						TransactionID:  transactionID.String(),
+						TransferID:     &transferID,
+						TransactionIDs: []string{transactionID.String(), uuid.NewString()},
+						Reason:         "Sent in error",
+						Status:         services.ReversalStatusQueued,
+					}, nil)
+				s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+					s.Equal(models.AuditActionReversalRequested, log.Action)
+					s.Equal(auditResourceTransaction, log.Resource)
+					s.Equal(transactionID.String(), log.ResourceID)
+					s.Equal("Sent in error", log.Metadata["reason"])
+					s.Equal(transferID, log.Metadata["transfer_id"])
+					return nil
+				})
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "reason is required",
+			transactionID:  transactionID.String(),
+			body:           dto.ReverseTransactionRequest{},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name:          "already reversed",
+			transactionID: transactionID.String(),
+			body:          dto.ReverseTransactionRequest{Reason: "again"},
+			setupMocks: func() {
+				s.mockService.EXPECT().RequestReversal(transactionID, s.adminID, gomock.Any()).
+					Return(nil, services.ErrTransactionAlreadyReversed)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "TRANSACTION_011",
+		},
+		{
+			name:          "would overdraw the account",
+			transactionID: transactionID.String(),
+			body:          dto.ReverseTransactionRequest{Reason: "Chargeback"},
+			setupMocks: func() {
+				s.mockService.EXPECT().RequestReversal(transactionID, s.adminID, gomock.Any()).
+					Return(nil, services.ErrInsufficientFunds)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "TRANSACTION_003",
+		},
+		{
+			name:          "pending transaction",
+			transactionID: transactionID.String(),
+			body:          dto.ReverseTransactionRequest{Reason: "x"},
+			setupMocks: func() {
+				s.mockService.EXPECT().RequestReversal(transactionID, s.adminID, gomock.Any()).
+					Return(nil, services.ErrTransactionNotReversible)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "TRANSACTION_012",
+		},
+		{
+			name:           "invalid transaction ID",
+			transactionID:  "not-a-uuid",
+			body:           dto.ReverseTransactionRequest{Reason: "x"},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			payload, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/transactions/"+tt.transactionID+"/reverse", bytes.NewReader(payload))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.transactionID)
+			c.Set("user_id", s.adminID)
+
+			s.NoError(s.handler.ReverseTransaction(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+
+			if tt.expectedCode != "" {
+				var resp ErrorResponse
+				s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+				s.Equal(tt.expectedCode, resp.Error.Code)
+			}
+		})
+	}
+}
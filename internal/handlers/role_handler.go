@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// RoleHandler handles role listing and assignment for admins
+type RoleHandler struct {
+	roleService services.RoleServiceInterface
+}
+
+// NewRoleHandler creates a new role handler
+func NewRoleHandler(roleService services.RoleServiceInterface) *RoleHandler {
+	return &RoleHandler{
+		roleService: roleService,
+	}
+}
+
+// ListRoles lists every role and the permissions it grants
+// @Summary List roles (admin)
+// @Description Lists every role users can be assigned and the permissions each grants. Requires the roles:manage permission.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.RoleListResponse "Roles"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/roles [get]
+func (h *RoleHandler) ListRoles(c echo.Context) error {
+	roles, err := h.roleService.ListRoles()
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.RoleListResponse{
+		Roles: roles,
+	})
+}
+
+// AssignRole changes a user's role
+// @Summary Assign a role (admin)
+// @Description Changes a user's role. The user is signed out everywhere, since their access tokens carry the previous role's permissions, and gets the new permissions at their next login. Admins cannot change their own role. Requires the roles:manage permission.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID (UUID)"
+// @Param request body dto.AssignRoleRequest true "Role"
+// @Success 200 {object} SuccessResponse{message=string} "Role assigned"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or own role, VALIDATION_003 - Invalid user ID or unknown role"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "CUSTOMER_001 - User not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/users/{userId}/role [put]
+func (h *RoleHandler) AssignRole(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("User ID must be a valid UUID"))
+	}
+
+	var req dto.AssignRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	user, err := h.roleService.AssignRole(userID, req.Role, adminID, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrCannotChangeOwnRole):
+			return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Cannot change your own role"))
+		case errors.Is(err, services.ErrRoleNotFound):
+			return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Unknown role: "+req.Role))
+		case errors.Is(err, services.ErrUserNotFound):
+			return SendError(c, apierrors.CustomerNotFound)
+		default:
+			return SendSystemError(c, err)
+		}
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Role assigned",
+		Data: map[string]interface{}{
+			"user_id": user.ID,
+			"role":    user.Role,
+		},
+	})
+}
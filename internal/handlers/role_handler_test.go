@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// RoleHandlerSuite defines the test suite for RoleHandler
+type RoleHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockRoleServiceInterface
+	handler     *RoleHandler
+	echo        *echo.Echo
+	adminID     uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *RoleHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockRoleServiceInterface(s.ctrl)
+	s.handler = NewRoleHandler(s.mockService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *RoleHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestRoleHandlerSuite runs the test suite
+func TestRoleHandlerSuite(t *testing.T) {
+	suite.Run(t, new(RoleHandlerSuite))
+}
+
+// newContext builds an admin request context for a user's role
+func (s *RoleHandlerSuite) newContext(userID, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/users/"+userID+"/role", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	c.SetParamNames("userId")
+	c.SetParamValues(userID)
+	return c, rec
+}
+
+func (s *RoleHandlerSuite) TestListRoles() {
+	s.mockService.EXPECT().ListRoles().Return([]dto.RoleResponse{
+		{Name: models.RoleSupport, Permissions: []string{models.PermissionCustomersRead}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/roles", nil)
+	rec := httptest.NewRecorder()
+	s.Require().NoError(s.handler.ListRoles(s.echo.NewContext(req, rec)))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.RoleListResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Roles, 1)
+	s.Equal(models.RoleSupport, response.Roles[0].Name)
+}
+
+func (s *RoleHandlerSuite) TestAssignRole() {
+	userID := uuid.New()
+	s.mockService.EXPECT().
+		AssignRole(userID, models.RoleCompliance, s.adminID, gomock.Any(), gomock.Any()).
+		Return(&models.User{ID: userID, Role: models.RoleCompliance}, nil)
+
+	c, rec := s.newContext(userID.String(), `{"role":"compliance"}`)
+	s.Require().NoError(s.handler.AssignRole(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"role":"compliance"`)
+}
+
+func (s *RoleHandlerSuite) TestAssignRole_UnknownRole() {
+	userID := uuid.New()
+	s.mockService.EXPECT().
+		AssignRole(userID, "auditor", s.adminID, gomock.Any(), gomock.Any()).
+		Return(nil, services.ErrRoleNotFound)
+
+	c, rec := s.newContext(userID.String(), `{"role":"auditor"}`)
+	s.Require().NoError(s.handler.AssignRole(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Contains(rec.Body.String(), "VALIDATION_003")
+}
+
+func (s *RoleHandlerSuite) TestAssignRole_UserNotFound() {
+	userID := uuid.New()
+	s.mockService.EXPECT().
+		AssignRole(userID, models.RoleTeller, s.adminID, gomock.Any(), gomock.Any()).
+		Return(nil, services.ErrUserNotFound)
+
+	c, rec := s.newContext(userID.String(), `{"role":"teller"}`)
+	s.Require().NoError(s.handler.AssignRole(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *RoleHandlerSuite) TestAssignRole_InvalidUserID() {
+	c, rec := s.newContext("not-a-uuid", `{"role":"teller"}`)
+	s.Require().NoError(s.handler.AssignRole(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
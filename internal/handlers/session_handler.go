@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// SessionHandler handles signed-in session listing and sign-out for customers
+// and admins
+type SessionHandler struct {
+	sessionService services.SessionServiceInterface
+}
+
+// NewSessionHandler creates a new session handler
+func NewSessionHandler(sessionService services.SessionServiceInterface) *SessionHandler {
+	return &SessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+// ListMySessions lists the authenticated customer's signed-in devices
+// @Summary List my sessions
+// @Description Lists the devices the authenticated customer is signed in on, most recently used first, with the IP address and user agent of each session's last use. The session making the request is marked current.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.SessionListResponse "Active sessions"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/sessions [get]
+func (h *SessionHandler) ListMySessions(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	return h.listSessions(c, userID, getSessionIDFromContext(c))
+}
+
+// RevokeMySession signs out one of the authenticated customer's sessions
+// @Summary Sign out a session
+// @Description Signs out one of the authenticated customer's devices. Its refresh token stops working and its access tokens are rejected immediately.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param sessionId path string true "Session ID (UUID)"
+// @Success 200 {object} SuccessResponse{message=string} "Session signed out"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid session ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "AUTH_012 - Session not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/sessions/{sessionId} [delete]
+func (h *SessionHandler) RevokeMySession(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	return h.revokeSession(c, userID, userID, models.SessionRevokedByUser)
+}
+
+// RevokeAllMySessions signs the authenticated customer out everywhere
+// @Summary Sign out everywhere
+// @Description Signs out every device the authenticated customer is signed in on, including the one making the request.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=dto.RevokeSessionsResponse} "Signed out everywhere"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/sessions [delete]
+func (h *SessionHandler) RevokeAllMySessions(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	return h.revokeAllSessions(c, userID, userID, models.SessionRevokedSignOutAll)
+}
+
+// ListCustomerSessions lists a customer's signed-in devices
+// @Summary List customer sessions (admin)
+// @Description Admin endpoint to list the devices a customer is signed in on, most recently used first.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Customer ID (UUID)"
+// @Success 200 {object} dto.SessionListResponse "Active sessions"
+// @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/{id}/sessions [get]
+func (h *SessionHandler) ListCustomerSessions(c echo.Context) error {
+	customerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.CustomerInvalidID)
+	}
+
+	return h.listSessions(c, customerID, uuid.Nil)
+}
+
+// RevokeCustomerSession signs out one of a customer's sessions
+// @Summary Sign out a customer session (admin)
+// @Description Admin endpoint to sign out one of a customer's devices.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Customer ID (UUID)"
+// @Param sessionId path string true "Session ID (UUID)"
+// @Success 200 {object} SuccessResponse{message=string} "Session signed out"
+// @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID, VALIDATION_003 - Invalid session ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 404 {object} errors.ErrorResponse "AUTH_012 - Session not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/{id}/sessions/{sessionId} [delete]
+func (h *SessionHandler) RevokeCustomerSession(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	customerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.CustomerInvalidID)
+	}
+
+	return h.revokeSession(c, customerID, adminID, models.SessionRevokedByAdmin)
+}
+
+// RevokeAllCustomerSessions signs a customer out everywhere
+// @Summary Sign out a customer everywhere (admin)
+// @Description Admin endpoint to sign out every device a customer is signed in on, e.g. when their credentials may be compromised.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Customer ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=dto.RevokeSessionsResponse} "Signed out everywhere"
+// @Failure 400 {object} errors.ErrorResponse "CUSTOMER_004 - Invalid customer ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/{id}/sessions [delete]
+func (h *SessionHandler) RevokeAllCustomerSessions(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	customerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.CustomerInvalidID)
+	}
+
+	return h.revokeAllSessions(c, customerID, adminID, models.SessionRevokedByAdmin)
+}
+
+func (h *SessionHandler) listSessions(c echo.Context, userID, currentSessionID uuid.UUID) error {
+	sessions, err := h.sessionService.ListSessions(userID, currentSessionID)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SessionListResponse{
+		Sessions: sessions,
+	})
+}
+
+func (h *SessionHandler) revokeSession(c echo.Context, userID, performedBy uuid.UUID, reason string) error {
+	sessionID, err := uuid.Parse(c.Param("sessionId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Session ID must be a valid UUID"))
+	}
+
+	if err := h.sessionService.RevokeSession(userID, sessionID, performedBy, reason, getClientIP(c), c.Request().UserAgent()); err != nil {
+		if errors.Is(err, services.ErrSessionNotFound) {
+			return SendError(c, apierrors.AuthSessionNotFound)
+		}
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Session signed out",
+	})
+}
+
+func (h *SessionHandler) revokeAllSessions(c echo.Context, userID, performedBy uuid.UUID, reason string) error {
+	count, err := h.sessionService.RevokeAllSessions(userID, performedBy, reason, getClientIP(c), c.Request().UserAgent())
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    dto.RevokeSessionsResponse{RevokedCount: count},
+		Message: "Signed out everywhere",
+	})
+}
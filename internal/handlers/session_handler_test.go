@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// SessionHandlerSuite defines the test suite for SessionHandler
+type SessionHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockSessionServiceInterface
+	handler     *SessionHandler
+	echo        *echo.Echo
+	userID      uuid.UUID
+	sessionID   uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *SessionHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockSessionServiceInterface(s.ctrl)
+	s.handler = NewSessionHandler(s.mockService)
+	s.echo = echo.New()
+	s.userID = uuid.New()
+	s.sessionID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *SessionHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestSessionHandlerSuite runs the test suite
+func TestSessionHandlerSuite(t *testing.T) {
+	suite.Run(t, new(SessionHandlerSuite))
+}
+
+// newContext builds a request context authenticated with a session token
+func (s *SessionHandlerSuite) newContext(method, target string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.userID)
+	c.Set("session_id", s.sessionID)
+	return c, rec
+}
+
+func (s *SessionHandlerSuite) TestListMySessions_MarksCurrentSession() {
+	s.mockService.EXPECT().ListSessions(s.userID, s.sessionID).Return([]dto.SessionResponse{
+		{ID: s.sessionID, DeviceName: "Firefox on Linux", Current: true},
+		{ID: uuid.New(), DeviceName: "Safari on iOS"},
+	}, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/api/v1/customers/me/sessions")
+	s.Require().NoError(s.handler.ListMySessions(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.SessionListResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Len(response.Sessions, 2)
+	s.True(response.Sessions[0].Current)
+}
+
+func (s *SessionHandlerSuite) TestRevokeMySession() {
+	otherSessionID := uuid.New()
+	s.mockService.EXPECT().
+		RevokeSession(s.userID, otherSessionID, s.userID, models.SessionRevokedByUser, gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	c, rec := s.newContext(http.MethodDelete, "/api/v1/customers/me/sessions/"+otherSessionID.String())
+	c.SetParamNames("sessionId")
+	c.SetParamValues(otherSessionID.String())
+	s.Require().NoError(s.handler.RevokeMySession(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+func (s *SessionHandlerSuite) TestRevokeMySession_NotFound() {
+	otherSessionID := uuid.New()
+	s.mockService.EXPECT().
+		RevokeSession(s.userID, otherSessionID, s.userID, gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(services.ErrSessionNotFound)
+
+	c, rec := s.newContext(http.MethodDelete, "/api/v1/customers/me/sessions/"+otherSessionID.String())
+	c.SetParamNames("sessionId")
+	c.SetParamValues(otherSessionID.String())
+	s.Require().NoError(s.handler.RevokeMySession(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "AUTH_012")
+}
+
+func (s *SessionHandlerSuite) TestRevokeMySession_InvalidID() {
+	c, rec := s.newContext(http.MethodDelete, "/api/v1/customers/me/sessions/not-a-uuid")
+	c.SetParamNames("sessionId")
+	c.SetParamValues("not-a-uuid")
+	s.Require().NoError(s.handler.RevokeMySession(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *SessionHandlerSuite) TestRevokeAllMySessions() {
+	s.mockService.EXPECT().
+		RevokeAllSessions(s.userID, s.userID, models.SessionRevokedSignOutAll, gomock.Any(), gomock.Any()).
+		Return(3, nil)
+
+	c, rec := s.newContext(http.MethodDelete, "/api/v1/customers/me/sessions")
+	s.Require().NoError(s.handler.RevokeAllMySessions(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"revokedCount":3`)
+}
+
+func (s *SessionHandlerSuite) TestRevokeAllCustomerSessions_RecordsAdmin() {
+	customerID := uuid.New()
+	s.mockService.EXPECT().
+		RevokeAllSessions(customerID, s.userID, models.SessionRevokedByAdmin, gomock.Any(), gomock.Any()).
+		Return(1, nil)
+
+	c, rec := s.newContext(http.MethodDelete, "/api/v1/customers/"+customerID.String()+"/sessions")
+	c.SetParamNames("id")
+	c.SetParamValues(customerID.String())
+	s.Require().NoError(s.handler.RevokeAllCustomerSessions(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+func (s *SessionHandlerSuite) TestListCustomerSessions_InvalidCustomerID() {
+	c, rec := s.newContext(http.MethodGet, "/api/v1/customers/not-a-uuid/sessions")
+	c.SetParamNames("id")
+	c.SetParamValues("not-a-uuid")
+	s.Require().NoError(s.handler.ListCustomerSessions(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// TransactionCategoryHandler handles manual category corrections on transactions
+type TransactionCategoryHandler struct {
+	transactionCategoryService services.TransactionCategoryServiceInterface
+}
+
+// NewTransactionCategoryHandler creates a new transaction category handler
+func NewTransactionCategoryHandler(transactionCategoryService services.TransactionCategoryServiceInterface) *TransactionCategoryHandler {
+	return &TransactionCategoryHandler{
+		transactionCategoryService: transactionCategoryService,
+	}
+}
+
+// OverrideCategory manually sets the category of a transaction
+// @Summary Override transaction category
+// @Description Manually correct the category of a transaction. The change is persisted with optimistic locking and recorded in the audit log. Set createRule to add a merchant mapping so future transactions from the same merchant receive the corrected category; creating a rule requires the categories:manage permission. Customers may only correct their own transactions; the transactions:manage permission allows any.
+// @Tags Transactions
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Transaction ID (UUID)"
+// @Param request body dto.OverrideTransactionCategoryRequest true "Category override"
+// @Success 200 {object} SuccessResponse{data=dto.OverrideTransactionCategoryResponse} "Category overridden"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or VALIDATION_003 - Invalid transaction ID, rule cannot be created or caller may not create rules"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Transaction belongs to another user"
+// @Failure 404 {object} errors.ErrorResponse "TRANSACTION_001 - Transaction not found or CATEGORY_001 - Category not found"
+// @Failure 409 {object} errors.ErrorResponse "TRANSACTION_007 - Transaction was modified concurrently"
+// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_008 - Transaction already has this category"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /transactions/{id}/category [patch]
+func (h *TransactionCategoryHandler) OverrideCategory(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid transaction ID"))
+	}
+
+	var req dto.OverrideTransactionCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	result, err := h.transactionCategoryService.OverrideTransactionCategory(
+		transactionID,
+		userID,
+		hasPermissionInContext(c, models.PermissionTransactionsManage),
+		hasPermissionInContext(c, models.PermissionCategoriesManage),
+		&req,
+		getClientIP(c),
+		c.Request().UserAgent(),
+	)
+	if err != nil {
+		return h.sendOverrideError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    result,
+		Message: "Transaction category updated successfully",
+	})
+}
+
+// sendOverrideError maps service errors to API error responses
+func (h *TransactionCategoryHandler) sendOverrideError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrTransactionNotFound):
+		return SendError(c, apierrors.TransactionNotFound)
+	case errors.Is(err, services.ErrUnauthorized):
+		return SendError(c, apierrors.AuthInsufficientPermission)
+	case errors.Is(err, services.ErrInvalidCategory):
+		return SendError(c, apierrors.CategoryNotFound)
+	case errors.Is(err, services.ErrReasonRequired), errors.Is(err, services.ErrMerchantNameRequired),
+		errors.Is(err, services.ErrRuleCreationDenied):
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	case errors.Is(err, services.ErrCategoryNotChanged):
+		return SendError(c, apierrors.TransactionCategoryUnchanged)
+	case errors.Is(err, models.ErrOptimisticLockConflict):
+		return SendError(c, apierrors.TransactionVersionConflict)
+	default:
+		return SendSystemError(c, err)
+	}
+}
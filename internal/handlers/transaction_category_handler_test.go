@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// TransactionCategoryHandlerSuite defines the test suite for TransactionCategoryHandler
+type TransactionCategoryHandlerSuite struct {
+	suite.Suite
+	ctrl          *gomock.Controller
+	mockService   *service_mocks.MockTransactionCategoryServiceInterface
+	handler       *TransactionCategoryHandler
+	echo          *echo.Echo
+	userID        uuid.UUID
+	transactionID uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *TransactionCategoryHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockTransactionCategoryServiceInterface(s.ctrl)
+	s.handler = NewTransactionCategoryHandler(s.mockService)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.userID = uuid.New()
+	s.transactionID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *TransactionCategoryHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestTransactionCategoryHandlerSuite runs the test suite
+func TestTransactionCategoryHandlerSuite(t *testing.T) {
+	suite.Run(t, new(TransactionCategoryHandlerSuite))
+}
+
+// newContext builds an authenticated PATCH request for the given transaction ID
+func (s *TransactionCategoryHandlerSuite) newContext(id string, body interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	payload, _ := json.Marshal(body)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/transactions/"+id+"/category", bytes.NewReader(payload))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.userID)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+
+	return c, rec
+}
+
+func (s *TransactionCategoryHandlerSuite) TestOverrideCategory() {
+	validBody := dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "Coffee shop"}
+
+	tests := []struct {
+		name           string
+		id             string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "success",
+			id:   s.transactionID.String(),
+			body: validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().
+					OverrideTransactionCategory(s.transactionID, s.userID, false, false, &validBody, gomock.Any(), gomock.Any()).
+					Return(&dto.OverrideTransactionCategoryResponse{
+						Transaction:      &models.Transaction{ID: s.transactionID, Category: models.CategoryDining},
+						PreviousCategory: models.CategoryOther,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid transaction id",
+			id:             "not-a-uuid",
+			body:           validBody,
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name:           "missing reason",
+			id:             s.transactionID.String(),
+			body:           dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining},
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "transaction not found",
+			id:   s.transactionID.String(),
+			body: validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().OverrideTransactionCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, services.ErrTransactionNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "TRANSACTION_001",
+		},
+		{
+			name: "another user's transaction",
+			id:   s.transactionID.String(),
+			body: validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().OverrideTransactionCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, services.ErrUnauthorized)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "AUTH_005",
+		},
+		{
+			name: "unknown category",
+			id:   s.transactionID.String(),
+			body: validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().OverrideTransactionCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, services.ErrInvalidCategory)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "CATEGORY_001",
+		},
+		{
+			name: "version conflict",
+			id:   s.transactionID.String(),
+			body: validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().OverrideTransactionCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, models.ErrOptimisticLockConflict)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "TRANSACTION_007",
+		},
+		{
+			name: "category unchanged",
+			id:   s.transactionID.String(),
+			body: validBody,
+			setupMocks: func() {
+				s.mockService.EXPECT().OverrideTransactionCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, services.ErrCategoryNotChanged)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "TRANSACTION_008",
+		},
+		{
+			name: "rule creation without categories permission",
+			id:   s.transactionID.String(),
+			body: dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "Coffee shop", CreateRule: true},
+			setupMocks: func() {
+				s.mockService.EXPECT().OverrideTransactionCategory(s.transactionID, s.userID, false, false, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, services.ErrRuleCreationDenied)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			c, rec := s.newContext(tt.id, tt.body)
+
+			s.NoError(s.handler.OverrideCategory(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var resp ErrorResponse
+				s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+				s.Equal(tt.expectedCode, resp.Error.Code)
+			}
+		})
+	}
+}
+
+func (s *TransactionCategoryHandlerSuite) TestOverrideCategory_PassesPermissions() {
+	body := dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "Coffee shop"}
+	s.mockService.EXPECT().
+		OverrideTransactionCategory(s.transactionID, s.userID, true, false, &body, gomock.Any(), gomock.Any()).
+		Return(&dto.OverrideTransactionCategoryResponse{
+			Transaction: &models.Transaction{ID: s.transactionID, Category: models.CategoryDining},
+		}, nil)
+
+	c, rec := s.newContext(s.transactionID.String(), body)
+	c.Set("user_permissions", []string{models.PermissionTransactionsManage})
+
+	s.NoError(s.handler.OverrideCategory(c))
+	s.Equal(http.StatusOK, rec.Code)
+}
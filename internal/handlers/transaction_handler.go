@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"array-assessment/internal/errors"
 	"array-assessment/internal/models"
 	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -28,16 +30,19 @@ const (
 type TransactionHandler struct {
 	transactionRepo repositories.TransactionRepositoryInterface
 	accountRepo     repositories.AccountRepositoryInterface
+	exportService   services.TransactionExportServiceInterface
 }
 
 // NewTransactionHandler creates a new transaction handler
 func NewTransactionHandler(
 	transactionRepo repositories.TransactionRepositoryInterface,
 	accountRepo repositories.AccountRepositoryInterface,
+	exportService services.TransactionExportServiceInterface,
 ) *TransactionHandler {
 	return &TransactionHandler{
 		transactionRepo: transactionRepo,
 		accountRepo:     accountRepo,
+		exportService:   exportService,
 	}
 }
 
@@ -399,3 +404,85 @@ func (h *TransactionHandler) GetTransaction(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, response)
 }
+
+// ExportTransactions streams an account's transactions as a file for personal finance tools
+// @Summary Export transactions
+// @Description Download every transaction on an account matching the filters, oldest first, as CSV, OFX 2.2 or QIF. Rows are streamed as they are read, so large histories do not need to fit in memory. Amounts are signed, with debits negative, and each row carries its running balance, category and merchant; OFX and QIF have no fields for category and running balance, so those go in the memo.
+// @Tags Transactions
+// @Security BearerAuth
+// @Produce text/csv
+// @Produce application/x-ofx
+// @Produce application/qif
+// @Param accountId path string true "Account ID (UUID)"
+// @Param format query string false "Export format" Enums(csv, ofx, qif) default(csv)
+// @Param start_date query string false "Filter by start date (YYYY-MM-DD)"
+// @Param end_date query string false "Filter by end date (YYYY-MM-DD)"
+// @Param type query string false "Filter by transaction type" Enums(credit, debit)
+// @Param status query string false "Filter by status" Enums(pending, completed, failed, reversed)
+// @Param category query string false "Filter by category code"
+// @Param min_amount query string false "Filter by minimum amount"
+// @Param max_amount query string false "Filter by maximum amount"
+// @Param merchant query string false "Filter by merchant name"
+// @Success 200 {file} file "Transaction export"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid format or filters, VALIDATION_003 - Invalid account ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Account belongs to another user"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /accounts/{accountId}/transactions/export [get]
+func (h *TransactionHandler) ExportTransactions(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid account ID"))
+	}
+
+	account, err := h.accountRepo.GetByID(accountID)
+	if err != nil {
+		if err == repositories.ErrAccountNotFound {
+			return SendError(c, errors.AccountNotFound)
+		}
+		return SendSystemError(c, err)
+	}
+
+	if account.UserID != userID {
+		return SendError(c, errors.AuthInsufficientPermission)
+	}
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = services.ExportFormatCSV
+	}
+	contentType, ok := services.ExportContentTypes[format]
+	if !ok {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("format: must be one of csv, ofx, qif"))
+	}
+
+	filters, err := parseTransactionFilters(c)
+	if err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, contentType)
+	response.Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf(`attachment; filename="transactions-%s.%s"`, account.AccountNumber, format))
+	response.Header().Set("Cache-Control", "no-store")
+	response.WriteHeader(http.StatusOK)
+
+	// The status line has been sent, so a failure part way through can only be logged
+	if err := h.exportService.ExportTransactions(account, filters, format, response); err != nil {
+		slog.Error("transaction export failed",
+			slog.String("account_id", account.ID.String()),
+			slog.String("format", format),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,6 +13,8 @@ import (
 	"array-assessment/internal/dto"
 	"array-assessment/internal/models"
 	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/golang/mock/gomock"
@@ -30,6 +33,7 @@ type TransactionHandlerTestSuite struct {
 	ctrl                *gomock.Controller
 	mockAccountRepo     *repository_mocks.MockAccountRepositoryInterface
 	mockTransactionRepo *repository_mocks.MockTransactionRepositoryInterface
+	mockExportService   *service_mocks.MockTransactionExportServiceInterface
 }
 
 func TestTransactionHandlerSuite(t *testing.T) {
@@ -43,6 +47,7 @@ func (s *TransactionHandlerTestSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.mockAccountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
 	s.mockTransactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
+	s.mockExportService = service_mocks.NewMockTransactionExportServiceInterface(s.ctrl)
 }
 
 // Cursor Encoding/Decoding Tests
@@ -93,7 +98,7 @@ func (s *TransactionHandlerTestSuite) TestDecodeCursor_InvalidCursor() {
 // Pagination Tests
 
 func (s *TransactionHandlerTestSuite) TestListTransactions_FirstPage() {
-	handler := NewTransactionHandler(s.mockTransactionRepo, s.mockAccountRepo)
+	handler := NewTransactionHandler(s.mockTransactionRepo, s.mockAccountRepo, s.mockExportService)
 
 	// Setup test account
 	account := &models.Account{
@@ -167,7 +172,7 @@ func (s *TransactionHandlerTestSuite) TestListTransactions_FirstPage() {
 }
 
 func (s *TransactionHandlerTestSuite) TestListTransactions_WithCursor() {
-	handler := NewTransactionHandler(s.mockTransactionRepo, s.mockAccountRepo)
+	handler := NewTransactionHandler(s.mockTransactionRepo, s.mockAccountRepo, s.mockExportService)
 
 	// Setup test account
 	account := &models.Account{
@@ -245,7 +250,7 @@ func (s *TransactionHandlerTestSuite) TestListTransactions_WithCursor() {
 }
 
 func (s *TransactionHandlerTestSuite) TestListTransactions_EmptyResults() {
-	handler := NewTransactionHandler(s.mockTransactionRepo, s.mockAccountRepo)
+	handler := NewTransactionHandler(s.mockTransactionRepo, s.mockAccountRepo, s.mockExportService)
 
 	// Setup test account
 	account := &models.Account{
@@ -652,3 +657,82 @@ func (s *TransactionHandlerTestSuite) TestValidateTransactionFilters_ValidInputs
 
 	s.True(models.IsValidCategory(filters.Category))
 }
+
+// Export Tests
+
+func (s *TransactionHandlerTestSuite) newExportContext(query string) (echo.Context, *httptest.ResponseRecorder) {
+	url := fmt.Sprintf("/api/v1/accounts/%s/transactions/export?%s", s.accountID, query)
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetParamNames("accountId")
+	c.SetParamValues(s.accountID.String())
+	c.Set("user_id", s.userID)
+	return c, rec
+}
+
+func (s *TransactionHandlerTestSuite) TestExportTransactions_StreamsFile() {
+	handler := NewTransactionHandler(s.mockTransactionRepo, s.mockAccountRepo, s.mockExportService)
+	account := &models.Account{ID: s.accountID, UserID: s.userID, AccountNumber: "1234567890"}
+
+	s.mockAccountRepo.EXPECT().GetByID(s.accountID).Return(account, nil)
+	s.mockExportService.EXPECT().
+		ExportTransactions(account, gomock.Any(), services.ExportFormatOFX, gomock.Any()).
+		DoAndReturn(func(_ *models.Account, filters models.TransactionFilters, _ string, w io.Writer) error {
+			s.Equal(models.TransactionTypeDebit, filters.Type)
+			s.Equal(models.CategoryGroceries, filters.Category)
+			_, err := io.WriteString(w, "<OFX></OFX>")
+			return err
+		})
+
+	c, rec := s.newExportContext("format=ofx&type=debit&category=GROCERIES")
+	s.NoError(handler.ExportTransactions(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("application/x-ofx", rec.Header().Get(echo.HeaderContentType))
+	s.Equal(`attachment; filename="transactions-1234567890.ofx"`, rec.Header().Get(echo.HeaderContentDisposition))
+	s.Equal("<OFX></OFX>", rec.Body.String())
+}
+
+func (s *TransactionHandlerTestSuite) TestExportTransactions_DefaultsToCSV() {
+	handler := NewTransactionHandler(s.mockTransactionRepo, s.mockAccountRepo, s.mockExportService)
+	account := &models.Account{ID: s.accountID, UserID: s.userID, AccountNumber: "1234567890"}
+
+	s.mockAccountRepo.EXPECT().GetByID(s.accountID).Return(account, nil)
+	s.mockExportService.EXPECT().
+		ExportTransactions(account, gomock.Any(), services.ExportFormatCSV, gomock.Any()).
+		Return(nil)
+
+	c, rec := s.newExportContext("")
+	s.NoError(handler.ExportTransactions(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("text/csv", rec.Header().Get(echo.HeaderContentType))
+}
+
+func (s *TransactionHandlerTestSuite) TestExportTransactions_Rejected() {
+	otherUsersAccount := &models.Account{ID: s.accountID, UserID: uuid.New()}
+	ownAccount := &models.Account{ID: s.accountID, UserID: s.userID}
+
+	testCases := []struct {
+		name    string
+		query   string
+		account *models.Account
+		status  int
+	}{
+		{"unsupported format", "format=xlsx", ownAccount, http.StatusBadRequest},
+		{"invalid filter", "format=qif&start_date=yesterday", ownAccount, http.StatusBadRequest},
+		{"another user's account", "format=csv", otherUsersAccount, http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			handler := NewTransactionHandler(s.mockTransactionRepo, s.mockAccountRepo, s.mockExportService)
+			s.mockAccountRepo.EXPECT().GetByID(s.accountID).Return(tc.account, nil)
+
+			c, rec := s.newExportContext(tc.query)
+			s.NoError(handler.ExportTransactions(c))
+			s.Equal(tc.status, rec.Code)
+		})
+	}
+}
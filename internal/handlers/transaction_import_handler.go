@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxImportFileSize is the largest transaction import file accepted
+const maxImportFileSize = 10 << 20
+
+// importFormatsByExtension infers an import file's format from its name
+var importFormatsByExtension = map[string]string{
+	".csv":    services.ImportFormatCSV,
+	".jsonl":  services.ImportFormatJSONL,
+	".ndjson": services.ImportFormatJSONL,
+}
+
+// TransactionImportHandler handles back-office transaction imports
+type TransactionImportHandler struct {
+	importService   services.TransactionImportServiceInterface
+	approvalService services.ApprovalServiceInterface
+	auditRepo       repositories.AuditLogRepositoryInterface
+}
+
+// NewTransactionImportHandler creates a new transaction import handler
+func NewTransactionImportHandler(
+	importService services.TransactionImportServiceInterface,
+	approvalService services.ApprovalServiceInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+) *TransactionImportHandler {
+	return &TransactionImportHandler{
+		importService:   importService,
+		approvalService: approvalService,
+		auditRepo:       auditRepo,
+	}
+}
+
+// ImportTransactions loads a file of credits and debits
+// @Summary Import transactions (admin)
+// @Description Admin endpoint to upload a CSV or JSON-lines file of credits and debits. Each row names an account_number, type (credit or debit), amount, description and a unique reference, and may give merchant_name, mcc_code and category; rows without a category are auto-categorized. CSV files need a header row. Every row is validated and duplicate references, in the file or already on a transaction, are rejected. In atomic mode an account's rows are posted all or nothing; in partial mode every row that can be posted is. Rows are posted in file order and bypass limits and fraud screening; instead, a file with a row at or above the approval threshold is validated as on a dry run and held for a second admin's approval, returned as 202 with the approval request. The response reports what happened to each row; with dry_run nothing is written. Imports are recorded in the audit log.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV or JSON-lines file, at most 10 MB and 10000 rows"
+// @Param format formData string false "File format, inferred from the file name if omitted" Enums(csv, jsonl)
+// @Param mode formData string false "How rows are applied" Enums(atomic, partial) default(atomic)
+// @Param dry_run formData bool false "Validate and report without writing anything" default(false)
+// @Success 200 {object} SuccessResponse{data=dto.TransactionImportReport} "Row-by-row import report"
+// @Success 202 {object} SuccessResponse{data=models.PendingAction} "Import held for approval by a second admin"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Missing or unreadable file, unsupported format or invalid mode"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Missing required permission"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/transactions/import [post]
+func (h *TransactionImportHandler) ImportTransactions(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("file: a file upload is required"))
+	}
+	if fileHeader.Size > maxImportFileSize {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("file: must be at most 10 MB"))
+	}
+
+	format := strings.ToLower(c.FormValue("format"))
+	if format == "" {
+		format = importFormatsByExtension[strings.ToLower(filepath.Ext(fileHeader.Filename))]
+	}
+
+	mode := c.FormValue("mode")
+	if mode == "" {
+		mode = dto.ImportModeAtomic
+	}
+
+	dryRun := false
+	if value := c.FormValue("dry_run"); value != "" {
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("dry_run: must be true or false"))
+		}
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("file: could not be read"))
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("file: could not be read"))
+	}
+
+	options := dto.ImportTransactionsOptions{
+		Format:     format,
+		Mode:       mode,
+		DryRun:     true,
+		ImportedBy: adminID,
+	}
+
+	// Large imports wait for a second admin, so the file is checked first. A file
+	// is large when any one row, or all of its rows together, reach the threshold.
+	if !dryRun {
+		preview, err := h.importService.ImportTransactions(bytes.NewReader(content), options)
+		if err != nil {
+			return h.sendImportError(c, err)
+		}
+		if h.approvalService.RequiresApproval(models.PendingActionTransactionImport, preview.LargestAmount) ||
+			h.approvalService.RequiresApproval(models.PendingActionTransactionImport, preview.TotalAmount) {
+			digest := sha256.Sum256(content)
+			return submitForApproval(c, h.approvalService, models.PendingActionTransactionImport, hex.EncodeToString(digest[:]), map[string]interface{}{
+				"filename":       fileHeader.Filename,
+				"format":         preview.Format,
+				"mode":           preview.Mode,
+				"total_rows":     preview.TotalRows,
+				"largest_amount": preview.LargestAmount.String(),
+				"total_amount":   preview.TotalAmount.String(),
+				"content":        string(content),
+			})
+		}
+	}
+
+	options.DryRun = dryRun
+	report, err := h.importService.ImportTransactions(bytes.NewReader(content), options)
+	if err != nil {
+		return h.sendImportError(c, err)
+	}
+
+	message := "Transactions imported"
+	if dryRun {
+		message = "Dry run completed, nothing was imported"
+	} else {
+		recordAuditLog(c, h.auditRepo, &models.AuditLog{
+			UserID:   &adminID,
+			Action:   models.AuditActionTransactionImport,
+			Resource: auditResourceTransaction,
+			Metadata: models.JSONBMap{
+				"filename":   fileHeader.Filename,
+				"format":     report.Format,
+				"mode":       report.Mode,
+				"total_rows": report.TotalRows,
+				"accepted":   report.Accepted,
+				"rejected":   report.Rejected,
+				"failed":     report.Failed,
+				"skipped":    report.Skipped,
+			},
+		})
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    report,
+		Message: message,
+	})
+}
+
+func (h *TransactionImportHandler) sendImportError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrUnsupportedImportFormat):
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("format: must be csv or jsonl"))
+	case errors.Is(err, services.ErrInvalidImportMode):
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails(fmt.Sprintf("mode: must be %s or %s", dto.ImportModeAtomic, dto.ImportModePartial)))
+	case errors.Is(err, services.ErrInvalidImportFile):
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails(err.Error()))
+	default:
+		return SendSystemError(c, err)
+	}
+}
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+// TransactionImportHandlerSuite defines the test suite for TransactionImportHandler
+type TransactionImportHandlerSuite struct {
+	suite.Suite
+	ctrl            *gomock.Controller
+	mockService     *service_mocks.MockTransactionImportServiceInterface
+	approvalService *service_mocks.MockApprovalServiceInterface
+	auditRepo       *repository_mocks.MockAuditLogRepositoryInterface
+	handler         *TransactionImportHandler
+	echo            *echo.Echo
+	adminID         uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *TransactionImportHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockTransactionImportServiceInterface(s.ctrl)
+	s.approvalService = service_mocks.NewMockApprovalServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewTransactionImportHandler(s.mockService, s.approvalService, s.auditRepo)
+	s.echo = echo.New()
+	s.adminID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *TransactionImportHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestTransactionImportHandlerSuite runs the test suite
+func TestTransactionImportHandlerSuite(t *testing.T) {
+	suite.Run(t, new(TransactionImportHandlerSuite))
+}
+
+// importContext builds a multipart upload of filename with the given form fields
+func (s *TransactionImportHandlerSuite) importContext(filename, content string, fields map[string]string) (echo.Context, *httptest.ResponseRecorder) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if filename != "" {
+		part, err := writer.CreateFormFile("file", filename)
+		s.Require().NoError(err)
+		_, err = io.WriteString(part, content)
+		s.Require().NoError(err)
+	}
+	for name, value := range fields {
+		s.Require().NoError(writer.WriteField(name, value))
+	}
+	s.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/transactions/import", &body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	return c, rec
+}
+
+func (s *TransactionImportHandlerSuite) TestImportTransactions_AppliesAndAudits() {
+	const content = "account_number,type,amount,description,reference\n"
+
+	preview := s.mockService.EXPECT().ImportTransactions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(file io.Reader, options dto.ImportTransactionsOptions) (*dto.TransactionImportReport, error) {
+			s.True(options.DryRun, "the file is checked before it is applied")
+			return &dto.TransactionImportReport{DryRun: true, LargestAmount: decimal.NewFromInt(500), TotalAmount: decimal.NewFromInt(1500)}, nil
+		})
+	s.approvalService.EXPECT().RequiresApproval(models.PendingActionTransactionImport, decimal.NewFromInt(500)).Return(false)
+	s.approvalService.EXPECT().RequiresApproval(models.PendingActionTransactionImport, decimal.NewFromInt(1500)).Return(false)
+	s.mockService.EXPECT().ImportTransactions(gomock.Any(), gomock.Any()).After(preview).
+		DoAndReturn(func(file io.Reader, options dto.ImportTransactionsOptions) (*dto.TransactionImportReport, error) {
+			data, err := io.ReadAll(file)
+			s.Require().NoError(err)
+			s.Equal(content, string(data))
+			s.Equal(services.ImportFormatJSONL, options.Format)
+			s.Equal(dto.ImportModeAtomic, options.Mode)
+			s.False(options.DryRun)
+			s.Equal(s.adminID, options.ImportedBy)
+			return &dto.TransactionImportReport{Format: options.Format, Mode: options.Mode, TotalRows: 3, Accepted: 2, Rejected: 1}, nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionTransactionImport, log.Action)
+		s.Equal(auditResourceTransaction, log.Resource)
+		s.Equal("ledger.ndjson", log.Metadata["filename"])
+		s.Equal(2, log.Metadata["accepted"])
+		s.Equal(1, log.Metadata["rejected"])
+		return nil
+	})
+
+	c, rec := s.importContext("ledger.ndjson", content, nil)
+	s.NoError(s.handler.ImportTransactions(c))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data dto.TransactionImportReport `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(2, resp.Data.Accepted)
+}
+
+func (s *TransactionImportHandlerSuite) TestImportTransactions_LargeImportHeldForApproval() {
+	const content = "account_number,type,amount,description,reference\n1012345678,credit,25000.00,Payroll,PAY-1\n"
+	action := &models.PendingAction{ID: uuid.New(), ActionType: models.PendingActionTransactionImport, Status: models.PendingActionStatusPending}
+
+	s.mockService.EXPECT().ImportTransactions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ io.Reader, options dto.ImportTransactionsOptions) (*dto.TransactionImportReport, error) {
+			s.True(options.DryRun)
+			return &dto.TransactionImportReport{DryRun: true, Format: options.Format, Mode: options.Mode, TotalRows: 1, LargestAmount: decimal.NewFromInt(25000), TotalAmount: decimal.NewFromInt(25000)}, nil
+		})
+	s.approvalService.EXPECT().RequiresApproval(models.PendingActionTransactionImport, decimal.NewFromInt(25000)).Return(true)
+	s.approvalService.EXPECT().
+		SubmitAction(models.PendingActionTransactionImport, gomock.Any(), gomock.Any(), s.adminID, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_, resourceID string, payload map[string]interface{}, _ uuid.UUID, _, _ string) (*models.PendingAction, error) {
+			s.Len(resourceID, 64, "the file is identified by its SHA-256")
+			s.Equal(content, payload["content"])
+			s.Equal(services.ImportFormatCSV, payload["format"])
+			s.Equal(dto.ImportModeAtomic, payload["mode"])
+			s.Equal("25000", payload["largest_amount"])
+			s.Equal("25000", payload["total_amount"])
+			return action, nil
+		})
+
+	c, rec := s.importContext("payroll.csv", content, nil)
+	s.NoError(s.handler.ImportTransactions(c))
+	s.Equal(http.StatusAccepted, rec.Code)
+}
+
+func (s *TransactionImportHandlerSuite) TestImportTransactions_ManySmallRowsHeldForApproval() {
+	const content = "account_number,type,amount,description,reference\n1012345678,debit,9000.00,Rent,RENT-1\n1012345678,debit,9000.00,Rent,RENT-2\n"
+	action := &models.PendingAction{ID: uuid.New(), ActionType: models.PendingActionTransactionImport, Status: models.PendingActionStatusPending}
+
+	s.mockService.EXPECT().ImportTransactions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ io.Reader, options dto.ImportTransactionsOptions) (*dto.TransactionImportReport, error) {
+			return &dto.TransactionImportReport{DryRun: true, Format: options.Format, Mode: options.Mode, TotalRows: 2, LargestAmount: decimal.NewFromInt(9000), TotalAmount: decimal.NewFromInt(18000)}, nil
+		})
+	s.approvalService.EXPECT().RequiresApproval(models.PendingActionTransactionImport, decimal.NewFromInt(9000)).Return(false)
+	s.approvalService.EXPECT().RequiresApproval(models.PendingActionTransactionImport, decimal.NewFromInt(18000)).Return(true)
+	s.approvalService.EXPECT().
+		SubmitAction(models.PendingActionTransactionImport, gomock.Any(), gomock.Any(), s.adminID, gomock.Any(), gomock.Any()).
+		Return(action, nil)
+
+	c, rec := s.importContext("rent.csv", content, nil)
+	s.NoError(s.handler.ImportTransactions(c))
+	s.Equal(http.StatusAccepted, rec.Code)
+}
+
+func (s *TransactionImportHandlerSuite) TestImportTransactions_DryRunIsNotAudited() {
+	s.mockService.EXPECT().ImportTransactions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ io.Reader, options dto.ImportTransactionsOptions) (*dto.TransactionImportReport, error) {
+			s.Equal(services.ImportFormatCSV, options.Format)
+			s.Equal(dto.ImportModePartial, options.Mode)
+			s.True(options.DryRun)
+			return &dto.TransactionImportReport{DryRun: true}, nil
+		})
+
+	c, rec := s.importContext("upload.txt", "", map[string]string{"format": "CSV", "mode": "partial", "dry_run": "true"})
+	s.NoError(s.handler.ImportTransactions(c))
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+func (s *TransactionImportHandlerSuite) TestImportTransactions_Rejected() {
+	tests := []struct {
+		name       string
+		filename   string
+		fields     map[string]string
+		serviceErr error
+	}{
+		{name: "missing file"},
+		{name: "invalid dry_run", filename: "a.csv", fields: map[string]string{"dry_run": "maybe"}},
+		{name: "unknown format", filename: "a.xlsx", serviceErr: services.ErrUnsupportedImportFormat},
+		{name: "invalid mode", filename: "a.csv", fields: map[string]string{"mode": "eventually"}, serviceErr: services.ErrInvalidImportMode},
+		{name: "invalid file", filename: "a.csv", serviceErr: fmt.Errorf("%w: missing column reference", services.ErrInvalidImportFile)},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			if tt.serviceErr != nil {
+				s.mockService.EXPECT().ImportTransactions(gomock.Any(), gomock.Any()).Return(nil, tt.serviceErr)
+			}
+
+			c, rec := s.importContext(tt.filename, "", tt.fields)
+			s.NoError(s.handler.ImportTransactions(c))
+			s.Equal(http.StatusBadRequest, rec.Code)
+
+			var resp ErrorResponse
+			s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+			s.Equal("VALIDATION_001", resp.Error.Code)
+		})
+	}
+}
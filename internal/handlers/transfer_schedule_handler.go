@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// TransferScheduleHandler handles customer standing orders
+type TransferScheduleHandler struct {
+	transferScheduleService services.TransferScheduleServiceInterface
+}
+
+// NewTransferScheduleHandler creates a new transfer schedule handler
+func NewTransferScheduleHandler(transferScheduleService services.TransferScheduleServiceInterface) *TransferScheduleHandler {
+	return &TransferScheduleHandler{
+		transferScheduleService: transferScheduleService,
+	}
+}
+
+// CreateSchedule creates a recurring transfer
+// @Summary Create transfer schedule
+// @Description Create a standing order from one of the authenticated user's accounts. Frequency is weekly, biweekly, monthly (on the start date's day) or day_of_month (on dayOfMonth, clamped to the month's last day). The schedule ends at endDate or after maxOccurrences, whichever comes first. Each occurrence runs as a transfer with the idempotency key schedule:{id}:{occurrence} and appears in the transfer history.
+// @Tags Customers
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateTransferScheduleRequest true "Schedule details"
+// @Success 201 {object} SuccessResponse{data=models.TransferSchedule} "Schedule created"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid schedule, TRANSFER_001 - Same account, TRANSFER_006 - Invalid amount"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Source account belongs to another user"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
+// @Failure 422 {object} errors.ErrorResponse "ACCOUNT_002 - Account not active"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/transfer-schedules [post]
+func (h *TransferScheduleHandler) CreateSchedule(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.CreateTransferScheduleRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	schedule, err := h.transferScheduleService.CreateSchedule(userID, &req)
+	if err != nil {
+		return h.sendScheduleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, SuccessResponse{
+		Data:    schedule,
+		Message: "Transfer schedule created",
+	})
+}
+
+// ListSchedules lists the user's transfer schedules
+// @Summary List my transfer schedules
+// @Description Retrieve the authenticated user's transfer schedules, newest first. Use the transfer history with schedule_id to see each occurrence's outcome.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.TransferSchedule} "Transfer schedules"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/transfer-schedules [get]
+func (h *TransferScheduleHandler) ListSchedules(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	schedules, total, err := h.transferScheduleService.ListSchedules(userID, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: schedules,
+		Meta: map[string]interface{}{
+			"total":       total,
+			"page":        page,
+			"limit":       limit,
+			"total_pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetSchedule retrieves one of the user's transfer schedules
+// @Summary Get my transfer schedule
+// @Description Retrieve a transfer schedule with its next run date and the number of occurrences run
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Schedule ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.TransferSchedule} "Transfer schedule"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid schedule ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "TRANSFER_007 - Transfer schedule not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/transfer-schedules/{id} [get]
+func (h *TransferScheduleHandler) GetSchedule(c echo.Context) error {
+	return h.handleSchedule(c, h.transferScheduleService.GetSchedule, http.StatusOK, "")
+}
+
+// PauseSchedule pauses an active transfer schedule
+// @Summary Pause transfer schedule
+// @Description Pause an active transfer schedule. No occurrences run until it is resumed.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Schedule ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.TransferSchedule} "Schedule paused"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid schedule ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "TRANSFER_007 - Transfer schedule not found"
+// @Failure 409 {object} errors.ErrorResponse "TRANSFER_008 - Schedule is not active"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/transfer-schedules/{id}/pause [post]
+func (h *TransferScheduleHandler) PauseSchedule(c echo.Context) error {
+	return h.handleSchedule(c, h.transferScheduleService.PauseSchedule, http.StatusOK, "Transfer schedule paused")
+}
+
+// ResumeSchedule resumes a paused transfer schedule
+// @Summary Resume transfer schedule
+// @Description Resume a paused transfer schedule. Occurrences dated while it was paused are skipped.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Schedule ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.TransferSchedule} "Schedule resumed"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid schedule ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "TRANSFER_007 - Transfer schedule not found"
+// @Failure 409 {object} errors.ErrorResponse "TRANSFER_008 - Schedule is not paused"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/transfer-schedules/{id}/resume [post]
+func (h *TransferScheduleHandler) ResumeSchedule(c echo.Context) error {
+	return h.handleSchedule(c, h.transferScheduleService.ResumeSchedule, http.StatusOK, "Transfer schedule resumed")
+}
+
+// CancelSchedule cancels a transfer schedule
+// @Summary Cancel transfer schedule
+// @Description Permanently stop a transfer schedule. Transfers already made are not affected.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Schedule ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.TransferSchedule} "Schedule cancelled"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid schedule ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "TRANSFER_007 - Transfer schedule not found"
+// @Failure 409 {object} errors.ErrorResponse "TRANSFER_008 - Schedule has already ended"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/transfer-schedules/{id}/cancel [post]
+func (h *TransferScheduleHandler) CancelSchedule(c echo.Context) error {
+	return h.handleSchedule(c, h.transferScheduleService.CancelSchedule, http.StatusOK, "Transfer schedule cancelled")
+}
+
+// handleSchedule runs a service operation on the schedule named in the path
+func (h *TransferScheduleHandler) handleSchedule(
+	c echo.Context,
+	operation func(id, userID uuid.UUID) (*models.TransferSchedule, error),
+	status int,
+	message string,
+) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid schedule ID"))
+	}
+
+	schedule, err := operation(id, userID)
+	if err != nil {
+		return h.sendScheduleError(c, err)
+	}
+
+	return c.JSON(status, SuccessResponse{
+		Data:    schedule,
+		Message: message,
+	})
+}
+
+// sendScheduleError maps service errors to API error responses
+func (h *TransferScheduleHandler) sendScheduleError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrAccountNotFound):
+		return SendError(c, apierrors.AccountNotFound)
+	case errors.Is(err, services.ErrUnauthorized):
+		return SendError(c, apierrors.AuthInsufficientPermission)
+	case errors.Is(err, services.ErrAccountNotActive):
+		return SendError(c, apierrors.AccountInactive)
+	case errors.Is(err, services.ErrTransferScheduleNotFound):
+		return SendError(c, apierrors.TransferScheduleNotFound)
+	case errors.Is(err, services.ErrTransferScheduleState):
+		return SendError(c, apierrors.TransferScheduleState)
+	case errors.Is(err, services.ErrInvalidTransferSchedule):
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	case errors.Is(err, services.ErrInvalidAmount):
+		return SendError(c, apierrors.TransferInvalidAmount)
+	case errors.Is(err, services.ErrSameAccountTransfer):
+		return SendError(c, apierrors.TransferSameAccount)
+	default:
+		return SendSystemError(c, err)
+	}
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// TransferScheduleHandlerSuite defines the test suite for TransferScheduleHandler
+type TransferScheduleHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockTransferScheduleServiceInterface
+	handler     *TransferScheduleHandler
+	echo        *echo.Echo
+	userID      uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *TransferScheduleHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockTransferScheduleServiceInterface(s.ctrl)
+	s.handler = NewTransferScheduleHandler(s.mockService)
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.userID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *TransferScheduleHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestTransferScheduleHandlerSuite runs the test suite
+func TestTransferScheduleHandlerSuite(t *testing.T) {
+	suite.Run(t, new(TransferScheduleHandlerSuite))
+}
+
+// newContext builds a request context authenticated as the test user
+func (s *TransferScheduleHandlerSuite) newContext(method, target string, body interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+
+	req := httptest.NewRequest(method, target, bytes.NewReader(payload))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.userID)
+
+	return c, rec
+}
+
+func (s *TransferScheduleHandlerSuite) TestCreateSchedule() {
+	dayOfMonth := 15
+	valid := dto.CreateTransferScheduleRequest{
+		FromAccountID: uuid.New().String(),
+		ToAccountID:   uuid.New().String(),
+		Amount:        "100.00",
+		Description:   "Rent",
+		Frequency:     models.TransferFrequencyDayOfMonth,
+		DayOfMonth:    &dayOfMonth,
+		StartDate:     time.Now().Add(24 * time.Hour),
+	}
+
+	missingDay := valid
+	missingDay.DayOfMonth = nil
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "creates schedule",
+			body: valid,
+			setupMocks: func() {
+				s.mockService.EXPECT().CreateSchedule(s.userID, gomock.Any()).
+					Return(&models.TransferSchedule{ID: uuid.New(), Status: models.TransferScheduleStatusActive}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "day of month required for day_of_month frequency",
+			body:           missingDay,
+			setupMocks:     func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_003",
+		},
+		{
+			name: "source account belongs to another user",
+			body: valid,
+			setupMocks: func() {
+				s.mockService.EXPECT().CreateSchedule(s.userID, gomock.Any()).Return(nil, services.ErrUnauthorized)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "AUTH_005",
+		},
+		{
+			name: "same account",
+			body: valid,
+			setupMocks: func() {
+				s.mockService.EXPECT().CreateSchedule(s.userID, gomock.Any()).Return(nil, services.ErrSameAccountTransfer)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "TRANSFER_001",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			c, rec := s.newContext(http.MethodPost, "/api/v1/customers/me/transfer-schedules", tt.body)
+
+			s.NoError(s.handler.CreateSchedule(c))
+			s.Equal(tt.expectedStatus, rec.Code)
+
+			if tt.expectedCode != "" {
+				var resp ErrorResponse
+				s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+				s.Equal(tt.expectedCode, resp.Error.Code)
+			}
+		})
+	}
+}
+
+func (s *TransferScheduleHandlerSuite) TestGetSchedule_NotFound() {
+	id := uuid.New()
+	s.mockService.EXPECT().GetSchedule(id, s.userID).Return(nil, services.ErrTransferScheduleNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/api/v1/customers/me/transfer-schedules/"+id.String(), nil)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	s.NoError(s.handler.GetSchedule(c))
+	s.Equal(http.StatusNotFound, rec.Code)
+
+	var resp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal("TRANSFER_007", resp.Error.Code)
+}
+
+func (s *TransferScheduleHandlerSuite) TestPauseSchedule_NotActive() {
+	id := uuid.New()
+	s.mockService.EXPECT().PauseSchedule(id, s.userID).Return(nil, services.ErrTransferScheduleState)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/customers/me/transfer-schedules/"+id.String()+"/pause", nil)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	s.NoError(s.handler.PauseSchedule(c))
+	s.Equal(http.StatusConflict, rec.Code)
+
+	var resp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal("TRANSFER_008", resp.Error.Code)
+}
+
+func (s *TransferScheduleHandlerSuite) TestResumeSchedule_InvalidID() {
+	c, rec := s.newContext(http.MethodPost, "/api/v1/customers/me/transfer-schedules/not-a-uuid/resume", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("not-a-uuid")
+
+	s.NoError(s.handler.ResumeSchedule(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *TransferScheduleHandlerSuite) TestListSchedules_ReturnsPagination() {
+	s.mockService.EXPECT().ListSchedules(s.userID, 20, 20).
+		Return([]models.TransferSchedule{{ID: uuid.New()}}, int64(21), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/api/v1/customers/me/transfer-schedules?page=2", nil)
+
+	s.NoError(s.handler.ListSchedules(c))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp struct {
+		Meta map[string]interface{} `json:"meta"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(float64(2), resp.Meta["total_pages"])
+}
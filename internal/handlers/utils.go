@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"fmt"
+	"log/slog"
 	"strings"
 
 	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -30,6 +32,29 @@ func getUserIDFromContext(c echo.Context) (uuid.UUID, error) {
 	return userID, nil
 }
 
+// getSessionIDFromContext returns the session the access token was issued to, or
+// uuid.Nil for tokens issued before sessions existed
+func getSessionIDFromContext(c echo.Context) uuid.UUID {
+	sessionID, _ := c.Get("session_id").(uuid.UUID)
+	return sessionID
+}
+
+// getPermissionsFromContext returns the permissions the access token carries
+func getPermissionsFromContext(c echo.Context) []string {
+	permissions, _ := c.Get("user_permissions").([]string)
+	return permissions
+}
+
+// hasPermissionInContext reports whether the access token carries a permission
+func hasPermissionInContext(c echo.Context, permission string) bool {
+	for _, granted := range getPermissionsFromContext(c) {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
 func getAvailableBalanceFromContext(c echo.Context) decimal.Decimal {
 	availableBalanceValue := c.Get("initialDeposit")
 	if availableBalanceValue == nil {
@@ -72,39 +97,35 @@ func getRoutingNumberFromContext(c echo.Context) string {
 	return routingNumber
 }
 
-// getIsAdminFromContext extracts the is_admin boolean from context
-// Returns false if the value is not set or not a boolean
-func getIsAdminFromContext(c echo.Context) bool {
-	isAdminValue := c.Get("is_admin")
-	if isAdminValue == nil {
-		return false
-	}
-
-	isAdmin, ok := isAdminValue.(bool)
-	if !ok {
-		return false
-	}
-
-	return isAdmin
-}
-
 func (h *AdminHandler) createAuditLog(adminID uuid.UUID, action, targetUserID string, c echo.Context) {
-	m := models.JSONBMap{
-		"target_user_id": targetUserID,
-	}
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		UserID: &adminID,
+		Action: action,
+		Metadata: models.JSONBMap{
+			"target_user_id": targetUserID,
+		},
+	})
+}
 
-	log := &models.AuditLog{
-		UserID:    &adminID,
-		Action:    action,
-		IPAddress: getClientIP(c),
-		UserAgent: c.Request().UserAgent(),
-		Metadata:  m,
+// recordAuditLog saves an audit entry for the request, filling in the client's IP
+// address and user agent, and the authenticated user when the entry names none.
+// Audit logging failure should not block the operation, so it is only logged.
+func recordAuditLog(c echo.Context, auditRepo repositories.AuditLogRepositoryInterface, log *models.AuditLog) {
+	log.IPAddress = getClientIP(c)
+	log.UserAgent = c.Request().UserAgent()
+	if log.UserID == nil {
+		if userID, err := getUserIDFromContext(c); err == nil {
+			log.UserID = &userID
+		}
 	}
 
-	if err := h.auditRepo.Create(log); err != nil {
-		// Audit logging failure should not block the operation
-		// Log error to monitoring system in production
-		_ = err
+	if err := auditRepo.Create(log); err != nil {
+		slog.Error("failed to create audit log",
+			slog.String("action", log.Action),
+			slog.String("resource", log.Resource),
+			slog.String("resource_id", log.ResourceID),
+			slog.String("error", err.Error()),
+		)
 	}
 }
 
@@ -0,0 +1,377 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"array-assessment/internal/dto"
+	apierrors "array-assessment/internal/errors"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// auditResourceWebhookSubscription is the audit resource for webhook subscriptions
+const auditResourceWebhookSubscription = "webhook_subscription"
+
+// WebhookHandler handles webhook subscriptions and their delivery logs
+type WebhookHandler struct {
+	webhookService services.WebhookServiceInterface
+	auditRepo      repositories.AuditLogRepositoryInterface
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(
+	webhookService services.WebhookServiceInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		auditRepo:      auditRepo,
+	}
+}
+
+// CreateSubscription registers a webhook URL
+// @Summary Create webhook subscription
+// @Description Register an https URL to receive the selected events: transaction.completed, transfer.completed, transfer.failed, account.closed and customer.email_updated. A customer's subscription receives events about their own accounts; one created by staff with customers:read receives events for every customer. Each delivery is a POST of the event as JSON with X-Webhook-Event, X-Webhook-Delivery and X-Webhook-Signature headers. The signature is "t=<unix time>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed with the secret>". The secret is only returned in this response. Deliveries not acknowledged with a 2xx response are retried with exponential backoff.
+// @Tags Webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateWebhookSubscriptionRequest true "URL and event types"
+// @Success 201 {object} SuccessResponse{data=dto.WebhookSubscriptionCreatedResponse} "Subscription created, with its signing secret"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid URL or event type"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /webhooks [post]
+func (h *WebhookHandler) CreateSubscription(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	var req dto.CreateWebhookSubscriptionRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	created, err := h.webhookService.CreateSubscription(userID, hasPermissionInContext(c, models.PermissionCustomersRead), &req)
+	if err != nil {
+		return h.sendWebhookError(c, err)
+	}
+
+	h.audit(c, userID, models.AuditActionCreate, created.WebhookSubscription)
+
+	return c.JSON(http.StatusCreated, SuccessResponse{
+		Data:    created,
+		Message: "Webhook subscription created. Store the secret now, it will not be shown again.",
+	})
+}
+
+// ListSubscriptions lists the user's webhook subscriptions
+// @Summary List my webhook subscriptions
+// @Description Retrieve the authenticated user's webhook subscriptions, newest first. Secrets are not included.
+// @Tags Webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.WebhookSubscription} "Webhook subscriptions"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /webhooks [get]
+func (h *WebhookHandler) ListSubscriptions(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	subscriptions, total, err := h.webhookService.ListSubscriptions(userID, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: subscriptions,
+		Meta: map[string]interface{}{
+			"total":       total,
+			"page":        page,
+			"limit":       limit,
+			"total_pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetSubscription retrieves one of the user's webhook subscriptions
+// @Summary Get my webhook subscription
+// @Description Retrieve a webhook subscription. The secret is not included.
+// @Tags Webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=models.WebhookSubscription} "Webhook subscription"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid subscription ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "WEBHOOK_001 - Webhook subscription not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetSubscription(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid subscription ID"))
+	}
+
+	subscription, err := h.webhookService.GetSubscription(id, userID)
+	if err != nil {
+		return h.sendWebhookError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: subscription,
+	})
+}
+
+// UpdateSubscription changes a webhook subscription
+// @Summary Update webhook subscription
+// @Description Change a subscription's URL, description or event types, or pause and resume it with active. Omitted fields are left unchanged. Pending deliveries to an inactive subscription are failed without being sent.
+// @Tags Webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Param request body dto.UpdateWebhookSubscriptionRequest true "Fields to change"
+// @Success 200 {object} SuccessResponse{data=models.WebhookSubscription} "Subscription updated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_003 - Invalid subscription ID, URL or event type"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "WEBHOOK_001 - Webhook subscription not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /webhooks/{id} [patch]
+func (h *WebhookHandler) UpdateSubscription(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid subscription ID"))
+	}
+
+	var req dto.UpdateWebhookSubscriptionRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, apierrors.ValidationGeneral, apierrors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	}
+
+	subscription, err := h.webhookService.UpdateSubscription(id, userID, &req)
+	if err != nil {
+		return h.sendWebhookError(c, err)
+	}
+
+	h.audit(c, userID, models.AuditActionUpdate, subscription)
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    subscription,
+		Message: "Webhook subscription updated",
+	})
+}
+
+// DeleteSubscription removes a webhook subscription
+// @Summary Delete webhook subscription
+// @Description Delete a webhook subscription together with its delivery log. Pending deliveries are not sent.
+// @Tags Webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Success 200 {object} SuccessResponse "Subscription deleted"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid subscription ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "WEBHOOK_001 - Webhook subscription not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteSubscription(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid subscription ID"))
+	}
+
+	if err := h.webhookService.DeleteSubscription(id, userID); err != nil {
+		return h.sendWebhookError(c, err)
+	}
+
+	h.audit(c, userID, models.AuditActionDelete, &models.WebhookSubscription{ID: id})
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Webhook subscription deleted",
+	})
+}
+
+// ListDeliveries lists a subscription's delivery log
+// @Summary List webhook deliveries
+// @Description Retrieve the deliveries made to one of the user's subscriptions, newest first, with every attempt and the response status or error it got
+// @Tags Webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Param status query string false "Filter by delivery status" Enums(pending, delivered, failed)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.WebhookDelivery} "Webhook deliveries"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters or status, VALIDATION_003 - Invalid subscription ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "WEBHOOK_001 - Webhook subscription not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid subscription ID"))
+	}
+
+	status := c.QueryParam("status")
+	if status != "" && !models.IsValidWebhookDeliveryStatus(status) {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("status: must be pending, delivered or failed"))
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, apierrors.ValidationGeneral,
+			apierrors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	deliveries, total, err := h.webhookService.ListDeliveries(id, userID, status, (page-1)*limit, limit)
+	if err != nil {
+		return h.sendWebhookError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: deliveries,
+		Meta: map[string]interface{}{
+			"total":       total,
+			"page":        page,
+			"limit":       limit,
+			"total_pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// Redeliver sends a finished delivery again
+// @Summary Redeliver webhook
+// @Description Queue a delivered or failed delivery to be sent again. The redelivery is a new delivery with the same event ID and payload and a fresh set of attempts; it records the delivery it was redelivered from.
+// @Tags Webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Param deliveryId path string true "Delivery ID (UUID)"
+// @Success 202 {object} SuccessResponse{data=models.WebhookDelivery} "Redelivery queued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid subscription or delivery ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "WEBHOOK_001 - Webhook subscription not found, WEBHOOK_002 - Webhook delivery not found"
+// @Failure 409 {object} errors.ErrorResponse "WEBHOOK_003 - Delivery is still pending"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, apierrors.AuthMissingToken)
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid subscription ID"))
+	}
+
+	deliveryID, err := uuid.Parse(c.Param("deliveryId"))
+	if err != nil {
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails("Invalid delivery ID"))
+	}
+
+	delivery, err := h.webhookService.Redeliver(id, deliveryID, userID)
+	if err != nil {
+		return h.sendWebhookError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, SuccessResponse{
+		Data:    delivery,
+		Message: "Webhook redelivery queued",
+	})
+}
+
+// audit records a change to a subscription
+func (h *WebhookHandler) audit(c echo.Context, userID uuid.UUID, action string, subscription *models.WebhookSubscription) {
+	metadata := models.JSONBMap{}
+	if subscription.URL != "" {
+		metadata["url"] = subscription.URL
+		metadata["event_types"] = []string(subscription.EventTypes)
+		metadata["active"] = subscription.Active
+	}
+
+	recordAuditLog(c, h.auditRepo, &models.AuditLog{
+		UserID:     &userID,
+		Action:     action,
+		Resource:   auditResourceWebhookSubscription,
+		ResourceID: subscription.ID.String(),
+		Metadata:   metadata,
+	})
+}
+
+// sendWebhookError maps service errors to API error responses
+func (h *WebhookHandler) sendWebhookError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrWebhookSubscriptionNotFound):
+		return SendError(c, apierrors.WebhookSubscriptionNotFound)
+	case errors.Is(err, services.ErrWebhookDeliveryNotFound):
+		return SendError(c, apierrors.WebhookDeliveryNotFound)
+	case errors.Is(err, services.ErrWebhookDeliveryPending):
+		return SendError(c, apierrors.WebhookDeliveryPending)
+	case errors.Is(err, services.ErrInvalidWebhookSubscription):
+		return SendError(c, apierrors.ValidationInvalidFormat, apierrors.WithDetails(err.Error()))
+	default:
+		return SendSystemError(c, err)
+	}
+}
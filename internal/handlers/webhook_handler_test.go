@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// WebhookHandlerSuite defines the test suite for WebhookHandler
+type WebhookHandlerSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockService *service_mocks.MockWebhookServiceInterface
+	auditRepo   *repository_mocks.MockAuditLogRepositoryInterface
+	handler     *WebhookHandler
+	echo        *echo.Echo
+	userID      uuid.UUID
+}
+
+// SetupTest runs before each test in the suite
+func (s *WebhookHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockService = service_mocks.NewMockWebhookServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.handler = NewWebhookHandler(s.mockService, s.auditRepo)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.userID = uuid.New()
+}
+
+// TearDownTest runs after each test in the suite
+func (s *WebhookHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestWebhookHandlerSuite runs the test suite
+func TestWebhookHandlerSuite(t *testing.T) {
+	suite.Run(t, new(WebhookHandlerSuite))
+}
+
+// newContext builds an authenticated request context
+func (s *WebhookHandlerSuite) newContext(method, target, body string, allCustomers bool) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.userID)
+	if allCustomers {
+		c.Set("user_permissions", []string{models.PermissionCustomersRead})
+	}
+	return c, rec
+}
+
+func (s *WebhookHandlerSuite) TestCreateSubscription_ReturnsSecretAndAudits() {
+	subscription := &models.WebhookSubscription{
+		ID:         uuid.New(),
+		UserID:     s.userID,
+		URL:        "https://example.com/hooks",
+		EventTypes: models.WebhookEventTypes{models.WebhookEventTransferFailed},
+		Active:     true,
+	}
+	s.mockService.EXPECT().CreateSubscription(s.userID, true, gomock.Any()).
+		DoAndReturn(func(_ uuid.UUID, _ bool, req *dto.CreateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionCreatedResponse, error) {
+			s.Equal("https://example.com/hooks", req.URL)
+			s.Equal([]string{models.WebhookEventTransferFailed}, req.EventTypes)
+			return &dto.WebhookSubscriptionCreatedResponse{WebhookSubscription: subscription, Secret: "whsec_abc"}, nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionCreate, log.Action)
+		s.Equal(subscription.ID.String(), log.ResourceID)
+		return nil
+	})
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/webhooks",
+		`{"url":"https://example.com/hooks","eventTypes":["transfer.failed"]}`, true)
+
+	s.Require().NoError(s.handler.CreateSubscription(c))
+	s.Equal(http.StatusCreated, rec.Code)
+
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal("whsec_abc", response.Data["secret"])
+	s.Equal(subscription.ID.String(), response.Data["id"])
+}
+
+func (s *WebhookHandlerSuite) TestCreateSubscription_InvalidSubscription() {
+	s.mockService.EXPECT().CreateSubscription(s.userID, false, gomock.Any()).
+		Return(nil, services.ErrInvalidWebhookSubscription)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/webhooks",
+		`{"url":"http://example.com/hooks","eventTypes":["transfer.failed"]}`, false)
+
+	s.Require().NoError(s.handler.CreateSubscription(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *WebhookHandlerSuite) TestGetSubscription_NotFound() {
+	id := uuid.New()
+	s.mockService.EXPECT().GetSubscription(id, s.userID).Return(nil, services.ErrWebhookSubscriptionNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/api/v1/webhooks/"+id.String(), "", false)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	s.Require().NoError(s.handler.GetSubscription(c))
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "WEBHOOK_001")
+}
+
+func (s *WebhookHandlerSuite) TestListDeliveries_FiltersByStatus() {
+	id := uuid.New()
+	s.mockService.EXPECT().ListDeliveries(id, s.userID, models.WebhookDeliveryStatusFailed, 20, 20).
+		Return([]models.WebhookDelivery{{ID: uuid.New()}}, int64(21), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/api/v1/webhooks/"+id.String()+"/deliveries?status=failed&page=2", "", false)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	s.Require().NoError(s.handler.ListDeliveries(c))
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"total_pages":2`)
+}
+
+func (s *WebhookHandlerSuite) TestListDeliveries_InvalidStatus() {
+	id := uuid.New()
+	c, rec := s.newContext(http.MethodGet, "/api/v1/webhooks/"+id.String()+"/deliveries?status=sent", "", false)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	s.Require().NoError(s.handler.ListDeliveries(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *WebhookHandlerSuite) TestRedeliver() {
+	id, deliveryID := uuid.New(), uuid.New()
+	s.mockService.EXPECT().Redeliver(id, deliveryID, s.userID).
+		Return(&models.WebhookDelivery{ID: uuid.New(), RedeliveryOf: &deliveryID}, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/webhooks/"+id.String()+"/deliveries/"+deliveryID.String()+"/redeliver", "", false)
+	c.SetParamNames("id", "deliveryId")
+	c.SetParamValues(id.String(), deliveryID.String())
+
+	s.Require().NoError(s.handler.Redeliver(c))
+	s.Equal(http.StatusAccepted, rec.Code)
+}
+
+func (s *WebhookHandlerSuite) TestRedeliver_StillPending() {
+	id, deliveryID := uuid.New(), uuid.New()
+	s.mockService.EXPECT().Redeliver(id, deliveryID, s.userID).Return(nil, services.ErrWebhookDeliveryPending)
+
+	c, rec := s.newContext(http.MethodPost, "/api/v1/webhooks/"+id.String()+"/deliveries/"+deliveryID.String()+"/redeliver", "", false)
+	c.SetParamNames("id", "deliveryId")
+	c.SetParamValues(id.String(), deliveryID.String())
+
+	s.Require().NoError(s.handler.Redeliver(c))
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "WEBHOOK_003")
+}
@@ -1,9 +1,10 @@
 package middleware
 
 import (
+	"time"
+
 	"array-assessment/internal/errors"
 	"array-assessment/internal/handlers"
-	"array-assessment/internal/models"
 	"array-assessment/internal/repositories"
 	"array-assessment/internal/services"
 
@@ -12,7 +13,8 @@ import (
 )
 
 // RequireAuth creates a middleware that requires a valid JWT token
-// and checks that the token has not been blacklisted (e.g., after logout)
+// and checks that neither the token nor the session it was issued to has been
+// blacklisted (e.g., after logout)
 func RequireAuth(tokenService services.TokenServiceInterface, blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -44,11 +46,29 @@ func RequireAuth(tokenService services.TokenServiceInterface, blacklistedTokenRe
 				return handlers.SendError(c, errors.AuthInvalidTokenFormat, errors.WithDetails("Invalid user ID in token"))
 			}
 
+			if claims.SessionID != "" {
+				sessionID, err := uuid.Parse(claims.SessionID)
+				if err != nil {
+					return handlers.SendError(c, errors.AuthInvalidTokenFormat, errors.WithDetails("Invalid session ID in token"))
+				}
+
+				// Revoked sessions are blacklisted by session ID
+				revokedSession, err := blacklistedTokenRepo.GetByJTI(claims.SessionID)
+				if err == nil && revokedSession != nil {
+					return handlers.SendError(c, errors.AuthInvalidTokenFormat, errors.WithDetails("Session has been revoked"))
+				}
+
+				c.Set("session_id", sessionID)
+			}
+
 			c.Set("user_id", userID)
 			c.Set("user_email", claims.Email)
 			c.Set("user_role", claims.Role)
 			c.Set("token_jti", claims.ID)
-			c.Set("is_admin", claims.Role == models.RoleAdmin)
+			c.Set("user_permissions", claims.Permissions)
+			if claims.MFAVerifiedAt != 0 {
+				c.Set("mfa_verified_at", time.Unix(claims.MFAVerifiedAt, 0))
+			}
 
 			user := map[string]interface{}{
 				"id":    userID,
@@ -82,7 +102,53 @@ func RequireRole(requiredRoles ...string) echo.MiddlewareFunc {
 	}
 }
 
-// RequireAdmin is a convenience middleware that requires admin role
-func RequireAdmin() echo.MiddlewareFunc {
-	return RequireRole(models.RoleAdmin)
+// RequirePermission creates a middleware that requires the access token to grant
+// a permission. Tokens carry the permissions of the user's role when they were
+// issued. It must run after RequireAuth.
+func RequirePermission(permission string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			permissions, _ := c.Get("user_permissions").([]string)
+			for _, granted := range permissions {
+				if granted == permission {
+					return next(c)
+				}
+			}
+
+			return handlers.SendError(c, errors.AuthInsufficientPermission, errors.WithDetails("Requires the "+permission+" permission"))
+		}
+	}
+}
+
+// RequireRecentMFA creates a middleware for sensitive operations that requires
+// users enrolled in or required to use MFA to have verified a code within the
+// window. Clients get a fresh access token from POST /auth/mfa/verify and retry.
+// It must run after RequireAuth.
+func RequireRecentMFA(mfaService services.MFAServiceInterface, window time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID, ok := c.Get("user_id").(uuid.UUID)
+			if !ok {
+				return handlers.SendError(c, errors.AuthInvalidTokenFormat, errors.WithDetails("User ID not found in token"))
+			}
+
+			required, err := mfaService.StepUpRequired(userID)
+			if err != nil {
+				if err == services.ErrUserNotFound {
+					return handlers.SendError(c, errors.AuthInvalidTokenFormat, errors.WithDetails("User no longer exists"))
+				}
+				return handlers.SendSystemError(c, err)
+			}
+			if !required {
+				return next(c)
+			}
+
+			verifiedAt, ok := c.Get("mfa_verified_at").(time.Time)
+			if !ok || time.Since(verifiedAt) > window {
+				return handlers.SendError(c, errors.AuthStepUpRequired)
+			}
+
+			return next(c)
+		}
+	}
 }
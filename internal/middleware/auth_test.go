@@ -8,8 +8,10 @@ import (
 
 	"array-assessment/internal/config"
 	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
 	"array-assessment/internal/repositories/repository_mocks"
 	"array-assessment/internal/services"
+	"array-assessment/internal/services/service_mocks"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -315,3 +317,167 @@ func (s *AuthMiddlewareSuite) TestRequireRole_AllowsMultipleRoles() {
 	s.NoError(err)
 	s.Equal(http.StatusOK, rec.Code)
 }
+
+func (s *AuthMiddlewareSuite) TestRequirePermission_GrantedByToken() {
+	user := &models.User{ID: uuid.New(), Email: "support@example.com", Role: models.RoleSupport}
+	permissions := []string{models.PermissionCustomersRead, models.PermissionCustomersResetPassword}
+	token, _, err := s.tokenService.GenerateSessionAccessToken(user, permissions, uuid.Nil, time.Time{})
+	s.Require().NoError(err)
+
+	s.mockBlacklistedTokenRepo.EXPECT().GetByJTI(gomock.Any()).Return(nil, nil).Times(2)
+
+	handler := func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}
+	requireAuth := RequireAuth(s.tokenService, s.mockBlacklistedTokenRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	s.NoError(requireAuth(RequirePermission(models.PermissionCustomersRead)(handler))(s.e.NewContext(req, rec)))
+	s.Equal(http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, "/admin/users", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec = httptest.NewRecorder()
+	s.NoError(requireAuth(RequirePermission(models.PermissionCustomersWrite)(handler))(s.e.NewContext(req, rec)))
+	s.Equal(http.StatusForbidden, rec.Code)
+	s.Contains(rec.Body.String(), "AUTH_005")
+}
+
+func (s *AuthMiddlewareSuite) TestRequirePermission_AdminRoleWithoutPermission() {
+	handler := RequirePermission(models.PermissionRolesManage)(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/roles", nil)
+	rec := httptest.NewRecorder()
+	c := s.e.NewContext(req, rec)
+
+	// Tokens issued before permissions existed carry only the role
+	c.Set("user_role", models.RoleAdmin)
+
+	s.NoError(handler(c))
+	s.Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *AuthMiddlewareSuite) TestRequireAuth_SetsMFAVerifiedAt() {
+	middleware := RequireAuth(s.tokenService, s.mockBlacklistedTokenRepo)
+
+	user := &models.User{ID: uuid.New(), Email: "test@example.com", Role: models.RoleCustomer}
+	verifiedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	token, _, err := s.tokenService.GenerateSessionAccessToken(user, nil, uuid.Nil, verifiedAt)
+	s.Require().NoError(err)
+
+	s.mockBlacklistedTokenRepo.EXPECT().GetByJTI(gomock.Any()).Return(nil, nil)
+
+	var contextVerifiedAt interface{}
+	handler := middleware(func(c echo.Context) error {
+		contextVerifiedAt = c.Get("mfa_verified_at")
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	s.NoError(handler(s.e.NewContext(req, rec)))
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal(verifiedAt.Unix(), contextVerifiedAt.(time.Time).Unix())
+}
+
+func (s *AuthMiddlewareSuite) TestRequireAuth_SessionToken() {
+	middleware := RequireAuth(s.tokenService, s.mockBlacklistedTokenRepo)
+
+	user := &models.User{ID: uuid.New(), Email: "test@example.com", Role: models.RoleCustomer}
+	sessionID := uuid.New()
+	token, _, err := s.tokenService.GenerateSessionAccessToken(user, nil, sessionID, time.Time{})
+	s.Require().NoError(err)
+
+	s.mockBlacklistedTokenRepo.EXPECT().GetByJTI(gomock.Not(sessionID.String())).Return(nil, repositories.ErrTokenNotFound)
+	s.mockBlacklistedTokenRepo.EXPECT().GetByJTI(sessionID.String()).Return(nil, repositories.ErrTokenNotFound)
+
+	var contextSessionID interface{}
+	handler := middleware(func(c echo.Context) error {
+		contextSessionID = c.Get("session_id")
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	s.NoError(handler(s.e.NewContext(req, rec)))
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal(sessionID, contextSessionID)
+}
+
+func (s *AuthMiddlewareSuite) TestRequireAuth_RevokedSession() {
+	middleware := RequireAuth(s.tokenService, s.mockBlacklistedTokenRepo)
+
+	user := &models.User{ID: uuid.New(), Email: "test@example.com", Role: models.RoleCustomer}
+	sessionID := uuid.New()
+	token, _, err := s.tokenService.GenerateSessionAccessToken(user, nil, sessionID, time.Time{})
+	s.Require().NoError(err)
+
+	s.mockBlacklistedTokenRepo.EXPECT().GetByJTI(gomock.Not(sessionID.String())).Return(nil, repositories.ErrTokenNotFound)
+	s.mockBlacklistedTokenRepo.EXPECT().GetByJTI(sessionID.String()).Return(&models.BlacklistedToken{JTI: sessionID.String()}, nil)
+
+	handler := middleware(func(c echo.Context) error {
+		s.Fail("handler should not be called for a revoked session")
+		return nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	s.NoError(handler(s.e.NewContext(req, rec)))
+	s.Equal(http.StatusUnauthorized, rec.Code)
+	s.Contains(rec.Body.String(), "Session has been revoked")
+}
+
+func (s *AuthMiddlewareSuite) TestRequireRecentMFA() {
+	mfaService := service_mocks.NewMockMFAServiceInterface(s.ctrl)
+	middleware := RequireRecentMFA(mfaService, 5*time.Minute)
+	handler := middleware(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	userID := uuid.New()
+
+	testCases := []struct {
+		name           string
+		required       bool
+		verifiedAt     *time.Time
+		expectedStatus int
+	}{
+		{"MFA not enabled", false, nil, http.StatusOK},
+		{"No verification in token", true, nil, http.StatusForbidden},
+		{"Verification too old", true, timePtr(time.Now().Add(-10 * time.Minute)), http.StatusForbidden},
+		{"Recent verification", true, timePtr(time.Now().Add(-time.Minute)), http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			mfaService.EXPECT().StepUpRequired(userID).Return(tc.required, nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/transfer", nil)
+			rec := httptest.NewRecorder()
+			c := s.e.NewContext(req, rec)
+			c.Set("user_id", userID)
+			if tc.verifiedAt != nil {
+				c.Set("mfa_verified_at", *tc.verifiedAt)
+			}
+
+			s.NoError(handler(c))
+			s.Equal(tc.expectedStatus, rec.Code)
+			if tc.expectedStatus == http.StatusForbidden {
+				s.Contains(rec.Body.String(), "AUTH_008")
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -42,6 +42,7 @@ type Account struct {
 	UserID        uuid.UUID       `gorm:"type:uuid;not null;index" json:"user_id"`
 	AccountType   string          `gorm:"type:varchar(20);not null" json:"account_type"`
 	Balance       decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0" json:"balance"`
+	HeldBalance   decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0" json:"held_balance"`
 	Status        string          `gorm:"type:varchar(20);not null;default:'active'" json:"status"`
 	Currency      string          `gorm:"type:varchar(3);not null;default:'USD'" json:"currency"`
 	InterestRate  decimal.Decimal `gorm:"type:decimal(5,4);default:0" json:"interest_rate,omitempty"`
@@ -50,6 +51,10 @@ type Account struct {
 	ClosedAt      *time.Time      `gorm:"index" json:"closed_at,omitempty"`
 	DeletedAt     gorm.DeletedAt  `gorm:"index" json:"deleted_at,omitempty"`
 
+	// AvailableBalance is the ledger balance less active authorization holds.
+	// It is derived from Balance and HeldBalance whenever the account is loaded.
+	AvailableBalance decimal.Decimal `gorm:"-" json:"available_balance"`
+
 	// Associations
 	User         User          `gorm:"foreignKey:UserID" json:"-"`
 	Transactions []Transaction `gorm:"foreignKey:AccountID" json:"-"`
@@ -69,7 +74,7 @@ func (a *Account) BeforeCreate(tx *gorm.DB) error {
 
 	// Set default currency if not provided
 	if a.Currency == "" {
-		a.Currency = "USD"
+		a.Currency = DefaultCurrency
 	}
 
 	// Set timestamps if not already set (for tests)
@@ -92,6 +97,8 @@ func (a *Account) BeforeCreate(tx *gorm.DB) error {
 		}
 	}
 
+	a.AvailableBalance = a.GetAvailableBalance()
+
 	return a.Validate()
 }
 
@@ -101,6 +108,12 @@ func (a *Account) BeforeUpdate(tx *gorm.DB) error {
 	return a.Validate()
 }
 
+// AfterFind hook for Account
+func (a *Account) AfterFind(tx *gorm.DB) error {
+	a.AvailableBalance = a.GetAvailableBalance()
+	return nil
+}
+
 // Validate validates the account fields
 func (a *Account) Validate() error {
 	if a.UserID == uuid.Nil {
@@ -123,10 +136,19 @@ func (a *Account) Validate() error {
 		return ErrInvalidAccountStatus
 	}
 
+	// An empty currency is defaulted on create
+	if a.Currency != "" && !IsValidCurrency(a.Currency) {
+		return ErrUnsupportedCurrency
+	}
+
 	if a.Balance.LessThan(decimal.Zero) {
 		return ErrInvalidBalance
 	}
 
+	if a.HeldBalance.LessThan(decimal.Zero) {
+		return errors.New("held balance cannot be negative")
+	}
+
 	// Business rule: Account number prefix must match account type
 	// expectedPrefix := GetAccountPrefix(a.AccountType)
 	// if a.AccountNumber[:2] != expectedPrefix {
@@ -177,9 +199,27 @@ func (a *Account) Activate() error {
 	return nil
 }
 
+// CurrencyOrDefault returns the account currency, or DefaultCurrency if it is not set
+func (a *Account) CurrencyOrDefault() string {
+	if a.Currency == "" {
+		return DefaultCurrency
+	}
+	return a.Currency
+}
+
+// GetAvailableBalance returns the ledger balance less active authorization holds
+func (a *Account) GetAvailableBalance() decimal.Decimal {
+	return a.Balance.Sub(a.HeldBalance)
+}
+
+// HasAvailableFunds checks if the available balance covers the amount
+func (a *Account) HasAvailableFunds(amount decimal.Decimal) bool {
+	return a.GetAvailableBalance().GreaterThanOrEqual(amount)
+}
+
 // CanWithdraw checks if the amount can be withdrawn
 func (a *Account) CanWithdraw(amount decimal.Decimal) bool {
-	return a.IsActive() && a.Balance.GreaterThanOrEqual(amount) && amount.GreaterThan(decimal.Zero)
+	return a.IsActive() && a.HasAvailableFunds(amount) && amount.GreaterThan(decimal.Zero)
 }
 
 // Debit debits the account
@@ -192,7 +232,7 @@ func (a *Account) Debit(amount decimal.Decimal) error {
 		return errors.New("debit amount must be positive")
 	}
 
-	if a.Balance.LessThan(amount) {
+	if !a.HasAvailableFunds(amount) {
 		return ErrInsufficientFunds
 	}
 
@@ -214,6 +254,26 @@ func (a *Account) Credit(amount decimal.Decimal) error {
 	return nil
 }
 
+// Post applies a credit or debit to the balance and records the balance before and
+// after it on the transaction
+func (a *Account) Post(transaction *Transaction) error {
+	before := a.Balance
+
+	var err error
+	if transaction.TransactionType == TransactionTypeDebit {
+		err = a.Debit(transaction.Amount)
+	} else {
+		err = a.Credit(transaction.Amount)
+	}
+	if err != nil {
+		return err
+	}
+
+	transaction.BalanceBefore = before
+	transaction.BalanceAfter = a.Balance
+	return nil
+}
+
 // TableName returns the table name for Account
 func (a *Account) TableName() string {
 	return "accounts"
@@ -10,6 +10,7 @@ import (
 // AccountMetrics represents performance metrics for a single account
 type AccountMetrics struct {
 	AccountID                uuid.UUID       `json:"account_id"`
+	Currency                 string          `json:"currency"`
 	StartDate                time.Time       `json:"start_date"`
 	EndDate                  time.Time       `json:"end_date"`
 	TotalDeposits            decimal.Decimal `json:"total_deposits"`
@@ -29,6 +30,7 @@ type AccountMetrics struct {
 // UserAggregateMetrics represents aggregate metrics across all user accounts
 type UserAggregateMetrics struct {
 	UserID                uuid.UUID          `json:"user_id"`
+	Currency              string             `json:"currency"`
 	StartDate             time.Time          `json:"start_date"`
 	EndDate               time.Time          `json:"end_date"`
 	TotalDeposits         decimal.Decimal    `json:"total_deposits"`
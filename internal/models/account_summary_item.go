@@ -13,6 +13,7 @@ type AccountSummaryItem struct {
 	Balance             decimal.Decimal `json:"balance"`
 	Status              string          `json:"status"`
 	Currency            string          `json:"currency"`
+	ConvertedBalance    decimal.Decimal `json:"converted_balance"`
 	InterestRate        decimal.Decimal `json:"interest_rate,omitempty"`
 	CreatedAt           string          `json:"created_at"`
 }
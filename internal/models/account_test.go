@@ -478,6 +478,29 @@ func TestAccount_Credit(t *testing.T) {
 	}
 }
 
+func TestAccount_Post(t *testing.T) {
+	account := Account{
+		Status:      AccountStatusActive,
+		Balance:     decimal.NewFromFloat(100.00),
+		HeldBalance: decimal.NewFromFloat(30.00),
+	}
+
+	credit := &Transaction{TransactionType: TransactionTypeCredit, Amount: decimal.NewFromFloat(50.00)}
+	require.NoError(t, account.Post(credit))
+	assert.True(t, decimal.NewFromFloat(100.00).Equal(credit.BalanceBefore))
+	assert.True(t, decimal.NewFromFloat(150.00).Equal(credit.BalanceAfter))
+
+	debit := &Transaction{TransactionType: TransactionTypeDebit, Amount: decimal.NewFromFloat(120.00)}
+	require.NoError(t, account.Post(debit))
+	assert.True(t, decimal.NewFromFloat(150.00).Equal(debit.BalanceBefore))
+	assert.True(t, decimal.NewFromFloat(30.00).Equal(debit.BalanceAfter))
+
+	overdraw := &Transaction{TransactionType: TransactionTypeDebit, Amount: decimal.NewFromFloat(0.01)}
+	assert.ErrorIs(t, account.Post(overdraw), ErrInsufficientFunds)
+	assert.True(t, overdraw.BalanceAfter.IsZero(), "a rejected transaction keeps no balances")
+	assert.True(t, decimal.NewFromFloat(30.00).Equal(account.Balance))
+}
+
 func TestAccount_CanWithdraw(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Statement document formats
+const (
+	StatementFormatPDF = "pdf"
+	StatementFormatCSV = "csv"
+)
+
+// StatementContentTypes maps each statement document format to its media type
+var StatementContentTypes = map[string]string{
+	StatementFormatPDF: "application/pdf",
+	StatementFormatCSV: "text/csv; charset=utf-8",
+}
+
+// ArchivedStatement is a rendered statement document for a closed period. Each
+// account, period and format is rendered once; later downloads return the stored
+// document so it is byte-for-byte identical and ContentHash stays the same.
+type ArchivedStatement struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key" json:"id"`
+	AccountID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_archived_statements_period,priority:1" json:"account_id"`
+	PeriodType  string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_archived_statements_period,priority:2" json:"period_type"`
+	Year        int       `gorm:"not null;uniqueIndex:idx_archived_statements_period,priority:3" json:"year"`
+	Period      int       `gorm:"not null;uniqueIndex:idx_archived_statements_period,priority:4" json:"period"`
+	Format      string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_archived_statements_period,priority:5" json:"format"`
+	ContentType string    `gorm:"type:varchar(100);not null" json:"content_type"`
+	Content     []byte    `gorm:"not null" json:"-"`
+	ContentHash string    `gorm:"type:varchar(64);not null" json:"content_hash"`
+	SizeBytes   int       `gorm:"not null" json:"size_bytes"`
+	GeneratedAt time.Time `gorm:"not null" json:"generated_at"`
+	CreatedAt   time.Time `gorm:"not null" json:"created_at"`
+}
+
+// TableName specifies the table name for ArchivedStatement
+func (a *ArchivedStatement) TableName() string {
+	return "archived_statements"
+}
+
+// BeforeCreate hook for ArchivedStatement
+func (a *ArchivedStatement) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = time.Now()
+	}
+	return nil
+}
+
+// NewArchivedStatement wraps a rendered statement document, recording its SHA-256
+// content hash
+func NewArchivedStatement(statement *AccountStatement, format string, content []byte) *ArchivedStatement {
+	hash := sha256.Sum256(content)
+	return &ArchivedStatement{
+		AccountID:   statement.AccountID,
+		PeriodType:  statement.PeriodType,
+		Year:        statement.Year,
+		Period:      statement.Period,
+		Format:      format,
+		ContentType: StatementContentTypes[format],
+		Content:     content,
+		ContentHash: hex.EncodeToString(hash[:]),
+		SizeBytes:   len(content),
+		GeneratedAt: statement.GeneratedAt,
+	}
+}
+
+// Filename returns the download file name, such as statement-2025-09.pdf for a
+// monthly statement or statement-2025-Q3.csv for a quarterly one
+func (a *ArchivedStatement) Filename() string {
+	if a.PeriodType == "quarterly" {
+		return fmt.Sprintf("statement-%d-Q%d.%s", a.Year, a.Period, a.Format)
+	}
+	return fmt.Sprintf("statement-%d-%02d.%s", a.Year, a.Period, a.Format)
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewArchivedStatement(t *testing.T) {
+	statement := &AccountStatement{
+		AccountID:   uuid.New(),
+		PeriodType:  "monthly",
+		Year:        2025,
+		Period:      9,
+		GeneratedAt: time.Date(2025, 10, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	archived := NewArchivedStatement(statement, StatementFormatCSV, []byte("abc"))
+
+	assert.Equal(t, statement.AccountID, archived.AccountID)
+	assert.Equal(t, "text/csv; charset=utf-8", archived.ContentType)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", archived.ContentHash)
+	assert.Equal(t, 3, archived.SizeBytes)
+	assert.Equal(t, statement.GeneratedAt, archived.GeneratedAt)
+}
+
+func TestArchivedStatement_Filename(t *testing.T) {
+	monthly := &ArchivedStatement{PeriodType: "monthly", Year: 2025, Period: 9, Format: StatementFormatPDF}
+	assert.Equal(t, "statement-2025-09.pdf", monthly.Filename())
+
+	quarterly := &ArchivedStatement{PeriodType: "quarterly", Year: 2025, Period: 3, Format: StatementFormatCSV}
+	assert.Equal(t, "statement-2025-Q3.csv", quarterly.Filename())
+}
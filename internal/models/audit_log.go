@@ -31,6 +31,37 @@ const (
 	AuditActionAccountTransferred = "account_transferred"
 	AuditActionCustomerViewed     = "customer_viewed"
 	AuditActionActivityViewed     = "activity_viewed"
+	AuditActionCategoryOverridden = "category_overridden"
+	AuditActionReversalRequested  = "reversal_requested"
+	AuditActionQueueItemsReplayed = "queue_items_replayed"
+	AuditActionQueueItemsPurged   = "queue_items_purged"
+	AuditActionLimitsUpdated      = "limits_updated"
+	AuditActionLimitsDeleted      = "limits_deleted"
+	AuditActionOverrideGranted    = "limit_override_granted"
+	AuditActionOverrideRevoked    = "limit_override_revoked"
+	AuditActionFraudFlagged       = "fraud_flagged"
+	AuditActionFraudApproved      = "fraud_review_approved"
+	AuditActionFraudRejected      = "fraud_review_rejected"
+	AuditActionTransactionImport  = "transactions_imported"
+	AuditActionMFAEnrollment      = "mfa_enrollment_started"
+	AuditActionMFAEnabled         = "mfa_enabled"
+	AuditActionMFADisabled        = "mfa_disabled"
+	AuditActionMFAChallenged      = "mfa_challenged"
+	AuditActionMFAVerified        = "mfa_verified"
+	AuditActionMFAFailed          = "mfa_failed"
+	AuditActionMFARecoveryUsed    = "mfa_recovery_code_used"
+	AuditActionMFARecoveryReset   = "mfa_recovery_codes_regenerated"
+	AuditActionMFARequirement     = "mfa_requirement_updated"
+	AuditActionMFAReset           = "mfa_reset"
+	AuditActionSessionRevoked     = "session_revoked"
+	AuditActionSessionsRevoked    = "sessions_revoked_all"
+	AuditActionRefreshTokenReuse  = "refresh_token_reuse_detected"
+	AuditActionRoleAssigned       = "role_assigned"
+	AuditActionApprovalRequested  = "approval_requested"
+	AuditActionApprovalApproved   = "approval_approved"
+	AuditActionApprovalRejected   = "approval_rejected"
+	AuditActionApprovalExpired    = "approval_expired"
+	AuditActionApprovalFailed     = "approval_execution_failed"
 )
 
 type AuditLog struct {
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency is the currency of accounts opened without one and the default
+// reporting currency for summaries and metrics
+const DefaultCurrency = "USD"
+
+var ErrUnsupportedCurrency = errors.New("unsupported currency")
+
+// currencyMinorUnits maps the supported ISO-4217 currency codes to their number of
+// minor units. Ledger amounts are stored with two decimal places, so currencies with
+// three minor units (BHD, KWD, OMR, ...) are not supported.
+var currencyMinorUnits = map[string]int32{
+	"AED": 2,
+	"AUD": 2,
+	"BRL": 2,
+	"CAD": 2,
+	"CHF": 2,
+	"CNY": 2,
+	"CZK": 2,
+	"DKK": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"HKD": 2,
+	"HUF": 2,
+	"ILS": 2,
+	"INR": 2,
+	"ISK": 0,
+	"JPY": 0,
+	"KRW": 0,
+	"MXN": 2,
+	"NOK": 2,
+	"NZD": 2,
+	"PLN": 2,
+	"SAR": 2,
+	"SEK": 2,
+	"SGD": 2,
+	"THB": 2,
+	"TRY": 2,
+	"USD": 2,
+	"ZAR": 2,
+}
+
+// NormalizeCurrency upper-cases and trims a currency code
+func NormalizeCurrency(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsValidCurrency returns true if code is a supported ISO-4217 currency code
+func IsValidCurrency(code string) bool {
+	_, ok := currencyMinorUnits[code]
+	return ok
+}
+
+// CurrencyMinorUnits returns the number of minor units of a supported currency
+func CurrencyMinorUnits(code string) (int32, error) {
+	units, ok := currencyMinorUnits[code]
+	if !ok {
+		return 0, ErrUnsupportedCurrency
+	}
+	return units, nil
+}
+
+// RoundToCurrency rounds amount half away from zero to the minor units of currency.
+// Unsupported currencies are rounded to two decimal places.
+func RoundToCurrency(amount decimal.Decimal, currency string) decimal.Decimal {
+	units, err := CurrencyMinorUnits(currency)
+	if err != nil {
+		units = 2
+	}
+	return amount.Round(units)
+}
+
+// HasCurrencyPrecision returns true if amount has no more decimal places than the
+// minor units of currency
+func HasCurrencyPrecision(amount decimal.Decimal, currency string) bool {
+	return RoundToCurrency(amount, currency).Equal(amount)
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidCurrency(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected bool
+	}{
+		{"USD", true},
+		{"EUR", true},
+		{"JPY", true},
+		{"usd", false},
+		{"KWD", false},
+		{"XXX", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsValidCurrency(tt.code))
+		})
+	}
+}
+
+func TestRoundToCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		expected string
+	}{
+		{"two minor units", "10.125", "EUR", "10.13"},
+		{"zero minor units", "1495.5", "JPY", "1496"},
+		{"already rounded", "10.10", "USD", "10.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rounded := RoundToCurrency(decimal.RequireFromString(tt.amount), tt.currency)
+			assert.Equal(t, tt.expected, rounded.String())
+		})
+	}
+
+	assert.True(t, HasCurrencyPrecision(decimal.RequireFromString("10.10"), "USD"))
+	assert.False(t, HasCurrencyPrecision(decimal.RequireFromString("10.5"), "JPY"))
+}
+
+func TestAccount_Validate_Currency(t *testing.T) {
+	account := Account{
+		UserID:        uuid.New(),
+		AccountNumber: "1012345678",
+		AccountType:   AccountTypeChecking,
+		Status:        AccountStatusActive,
+		Currency:      "EUR",
+	}
+	assert.NoError(t, account.Validate())
+
+	account.Currency = "ABC"
+	assert.ErrorIs(t, account.Validate(), ErrUnsupportedCurrency)
+}
+
+func TestExchangeRate_ConvertAndInverse(t *testing.T) {
+	rate := &ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "JPY", Rate: decimal.RequireFromString("149.5")}
+	require.NoError(t, rate.Validate())
+
+	assert.Equal(t, "1869", rate.Convert(decimal.RequireFromString("12.50")).String())
+
+	inverse := rate.Inverse()
+	assert.Equal(t, "JPY", inverse.BaseCurrency)
+	assert.Equal(t, "USD", inverse.QuoteCurrency)
+	assert.Equal(t, "10", inverse.Convert(decimal.NewFromInt(1495)).String())
+}
+
+func TestTransfer_LockRate(t *testing.T) {
+	transfer := &Transfer{Amount: decimal.RequireFromString("100.00"), FromCurrency: "USD", ToCurrency: "EUR"}
+	assert.True(t, transfer.IsCrossCurrency())
+	assert.Equal(t, "100", transfer.CreditAmount().String())
+
+	transfer.LockRate(&ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: decimal.RequireFromString("0.91234")})
+
+	require.NotNil(t, transfer.ExchangeRate)
+	require.NotNil(t, transfer.RateLockedAt)
+	assert.Equal(t, "0.91234", transfer.ExchangeRate.String())
+	assert.Equal(t, "91.23", transfer.CreditAmount().String())
+}
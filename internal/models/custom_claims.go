@@ -9,4 +9,14 @@ type CustomClaims struct {
 	Email     string `json:"email,omitempty"`
 	Role      string `json:"role,omitempty"`
 	TokenType string `json:"token_type"`
+
+	// SessionID is the signed-in session an access token was issued to
+	SessionID string `json:"sid,omitempty"`
+
+	// MFAVerifiedAt is the Unix time the user last entered an MFA code, on access
+	// tokens issued after MFA login or step-up verification
+	MFAVerifiedAt int64 `json:"mfa_at,omitempty"`
+
+	// Permissions are those of the user's role when the access token was issued
+	Permissions []string `json:"perms,omitempty"`
 }
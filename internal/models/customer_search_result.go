@@ -12,4 +12,12 @@ type CustomerSearchResult struct {
 	AccountCount int64     `json:"account_count"`
 	LastLoginAt  *string   `json:"last_login_at,omitempty"`
 	CreatedAt    string    `json:"created_at"`
+	Score        float64   `json:"score"`
+}
+
+// UserSearchMatch is a user found by a fuzzy search with how closely it matched,
+// from 0 (no match) to 1 (exact match)
+type UserSearchMatch struct {
+	User  *User
+	Score float64
 }
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// ExchangeRateScale is the number of decimal places kept on exchange rates
+const ExchangeRateScale = 10
+
+var ErrInvalidExchangeRate = errors.New("exchange rate must be positive")
+
+// ExchangeRate is the current rate for one currency pair: one unit of
+// BaseCurrency buys Rate units of QuoteCurrency. Each pair has a single row that
+// is replaced when the rate is refreshed.
+type ExchangeRate struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
+	BaseCurrency  string          `gorm:"type:varchar(3);not null;uniqueIndex:idx_exchange_rates_pair,priority:1" json:"base_currency"`
+	QuoteCurrency string          `gorm:"type:varchar(3);not null;uniqueIndex:idx_exchange_rates_pair,priority:2" json:"quote_currency"`
+	Rate          decimal.Decimal `gorm:"type:decimal(20,10);not null" json:"rate"`
+	Source        string          `gorm:"type:varchar(50);not null" json:"source"`
+	EffectiveAt   time.Time       `gorm:"not null" json:"effective_at"`
+	CreatedAt     time.Time       `gorm:"not null" json:"created_at"`
+	UpdatedAt     time.Time       `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for ExchangeRate
+func (r *ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
+
+// BeforeCreate hook for ExchangeRate
+func (r *ExchangeRate) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+
+	now := time.Now()
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = now
+	}
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = now
+	}
+	if r.EffectiveAt.IsZero() {
+		r.EffectiveAt = now
+	}
+
+	return r.Validate()
+}
+
+// Validate validates the exchange rate fields
+func (r *ExchangeRate) Validate() error {
+	if !IsValidCurrency(r.BaseCurrency) || !IsValidCurrency(r.QuoteCurrency) {
+		return ErrUnsupportedCurrency
+	}
+
+	if r.BaseCurrency == r.QuoteCurrency {
+		return errors.New("base and quote currencies must differ")
+	}
+
+	if r.Rate.LessThanOrEqual(decimal.Zero) {
+		return ErrInvalidExchangeRate
+	}
+
+	return nil
+}
+
+// Convert converts an amount in BaseCurrency to QuoteCurrency, rounded to the
+// minor units of QuoteCurrency
+func (r *ExchangeRate) Convert(amount decimal.Decimal) decimal.Decimal {
+	return RoundToCurrency(amount.Mul(r.Rate), r.QuoteCurrency)
+}
+
+// Inverse returns the rate for the opposite direction of the pair
+func (r *ExchangeRate) Inverse() *ExchangeRate {
+	return &ExchangeRate{
+		ID:            r.ID,
+		BaseCurrency:  r.QuoteCurrency,
+		QuoteCurrency: r.BaseCurrency,
+		Rate:          decimal.NewFromInt(1).DivRound(r.Rate, ExchangeRateScale),
+		Source:        r.Source,
+		EffectiveAt:   r.EffectiveAt,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
@@ -0,0 +1,237 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// External transfer directions
+const (
+	ExternalTransferOutbound = "outbound"
+	ExternalTransferInbound  = "inbound"
+)
+
+// External transfer statuses. A transfer is initiated when recorded, submitted once
+// NorthWind accepts it, and ends settled, returned, or failed when NorthWind
+// rejects the submission outright. An outbound transfer flagged by fraud screening
+// is recorded pending review and becomes initiated only once an admin approves it.
+const (
+	ExternalTransferStatusPendingReview = "pending_review"
+	ExternalTransferStatusInitiated     = "initiated"
+	ExternalTransferStatusSubmitted     = "submitted"
+	ExternalTransferStatusSettled       = "settled"
+	ExternalTransferStatusReturned      = "returned"
+	ExternalTransferStatusFailed        = "failed"
+)
+
+// ExternalTransferTypeACH is the NorthWind transfer type used for external transfers
+const ExternalTransferTypeACH = "ACH"
+
+var (
+	ErrInvalidExternalTransferDirection = errors.New("invalid external transfer direction")
+	ErrInvalidExternalTransferStatus    = errors.New("invalid external transfer status")
+	ErrInvalidACHReturnCode             = errors.New("invalid ACH return code")
+)
+
+// ACHReturnCodes describes the ACH return codes accepted when a transfer is returned
+var ACHReturnCodes = map[string]string{
+	"R01": "Insufficient funds",
+	"R02": "Account closed",
+	"R03": "No account or unable to locate account",
+	"R04": "Invalid account number",
+	"R05": "Unauthorized debit to consumer account",
+	"R06": "Returned per originating institution's request",
+	"R07": "Authorization revoked by customer",
+	"R08": "Payment stopped",
+	"R09": "Uncollected funds",
+	"R10": "Customer advises not authorized",
+	"R11": "Check truncation entry return",
+	"R12": "Branch sold to another institution",
+	"R13": "Invalid routing number",
+	"R14": "Representative payee deceased",
+	"R15": "Beneficiary or account holder deceased",
+	"R16": "Account frozen",
+	"R17": "File record edit criteria",
+	"R20": "Non-transaction account",
+	"R23": "Credit entry refused by receiver",
+	"R24": "Duplicate entry",
+	"R29": "Corporate customer advises not authorized",
+}
+
+// ExternalTransfer moves funds between one of our accounts and an account at
+// another institution verified through NorthWind. Outbound transfers hold the
+// amount on the account when initiated and capture the hold when they settle;
+// inbound transfers credit the account only when they settle, so the funds are
+// never available before the return window closes.
+type ExternalTransfer struct {
+	ID              uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
+	UserID          uuid.UUID       `gorm:"type:uuid;not null;index" json:"user_id"`
+	AccountID       uuid.UUID       `gorm:"type:uuid;not null;index" json:"account_id"`
+	Direction       string          `gorm:"type:varchar(10);not null" json:"direction"`
+	Amount          decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"amount"`
+	Currency        string          `gorm:"type:varchar(3);not null;default:'USD'" json:"currency"`
+	Description     string          `gorm:"type:text;not null" json:"description"`
+	IdempotencyKey  string          `gorm:"type:varchar(255);uniqueIndex;not null" json:"idempotency_key"`
+	ReferenceNumber string          `gorm:"type:varchar(50);uniqueIndex;not null" json:"reference_number"`
+
+	CounterpartyName          string `gorm:"type:varchar(100);not null" json:"counterparty_name"`
+	CounterpartyAccountNumber string `gorm:"type:varchar(20);not null" json:"counterparty_account_number"`
+	CounterpartyRoutingNumber string `gorm:"type:varchar(20);not null" json:"counterparty_routing_number"`
+	CounterpartyInstitution   string `gorm:"type:varchar(255)" json:"counterparty_institution,omitempty"`
+
+	Status             string `gorm:"type:varchar(20);not null;index:idx_external_transfers_due,priority:1" json:"status"`
+	ProviderTransferID string `gorm:"type:varchar(100);index" json:"provider_transfer_id,omitempty"`
+
+	// HoldTransactionID is the hold placed on an outbound transfer's account; once
+	// captured it is also the settlement transaction
+	HoldTransactionID       *uuid.UUID `gorm:"type:uuid" json:"hold_transaction_id,omitempty"`
+	SettlementTransactionID *uuid.UUID `gorm:"type:uuid" json:"settlement_transaction_id,omitempty"`
+
+	ReturnCode    string `gorm:"type:varchar(3)" json:"return_code,omitempty"`
+	ReturnReason  string `gorm:"type:text" json:"return_reason,omitempty"`
+	FailureReason string `gorm:"type:text" json:"failure_reason,omitempty"`
+
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	SettleAfter *time.Time `gorm:"index:idx_external_transfers_due,priority:2" json:"settle_after,omitempty"`
+	SettledAt   *time.Time `json:"settled_at,omitempty"`
+	ReturnedAt  *time.Time `json:"returned_at,omitempty"`
+	CreatedAt   time.Time  `gorm:"not null" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for ExternalTransfer
+func (t *ExternalTransfer) TableName() string {
+	return "external_transfers"
+}
+
+// BeforeCreate hook for ExternalTransfer
+func (t *ExternalTransfer) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	if t.Status == "" {
+		t.Status = ExternalTransferStatusInitiated
+	}
+	if t.Currency == "" {
+		t.Currency = DefaultCurrency
+	}
+	if t.ReferenceNumber == "" {
+		t.ReferenceNumber = GenerateExternalTransferReference()
+	}
+
+	now := time.Now()
+	if t.CreatedAt.IsZero() {
+		t.CreatedAt = now
+	}
+	if t.UpdatedAt.IsZero() {
+		t.UpdatedAt = now
+	}
+
+	return t.Validate()
+}
+
+// BeforeUpdate hook for ExternalTransfer
+func (t *ExternalTransfer) BeforeUpdate(tx *gorm.DB) error {
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// Validate validates the external transfer fields
+func (t *ExternalTransfer) Validate() error {
+	if t.UserID == uuid.Nil || t.AccountID == uuid.Nil {
+		return errors.New("user and account IDs are required")
+	}
+
+	if t.Direction != ExternalTransferOutbound && t.Direction != ExternalTransferInbound {
+		return ErrInvalidExternalTransferDirection
+	}
+
+	if t.Amount.LessThanOrEqual(decimal.Zero) {
+		return ErrInvalidTransferAmount
+	}
+
+	if t.IdempotencyKey == "" {
+		return errors.New("idempotency key is required")
+	}
+
+	if t.CounterpartyName == "" || t.CounterpartyAccountNumber == "" || t.CounterpartyRoutingNumber == "" {
+		return errors.New("counterparty name, account number and routing number are required")
+	}
+
+	switch t.Status {
+	case ExternalTransferStatusPendingReview, ExternalTransferStatusInitiated, ExternalTransferStatusSubmitted,
+		ExternalTransferStatusSettled, ExternalTransferStatusReturned, ExternalTransferStatusFailed:
+	default:
+		return ErrInvalidExternalTransferStatus
+	}
+
+	if t.ReturnCode != "" && !IsValidACHReturnCode(t.ReturnCode) {
+		return ErrInvalidACHReturnCode
+	}
+
+	return nil
+}
+
+// IsOutbound returns true if funds leave our account
+func (t *ExternalTransfer) IsOutbound() bool {
+	return t.Direction == ExternalTransferOutbound
+}
+
+// IsFinal returns true once the transfer has settled, been returned or failed
+func (t *ExternalTransfer) IsFinal() bool {
+	switch t.Status {
+	case ExternalTransferStatusSettled, ExternalTransferStatusReturned, ExternalTransferStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarkSubmitted records NorthWind's acceptance of the transfer. It settles no
+// earlier than settleAfter.
+func (t *ExternalTransfer) MarkSubmitted(providerTransferID string, submittedAt, settleAfter time.Time) {
+	t.Status = ExternalTransferStatusSubmitted
+	t.ProviderTransferID = providerTransferID
+	t.SubmittedAt = &submittedAt
+	t.SettleAfter = &settleAfter
+}
+
+// Settle marks the transfer settled by the given ledger transaction
+func (t *ExternalTransfer) Settle(transactionID uuid.UUID, settledAt time.Time) {
+	t.Status = ExternalTransferStatusSettled
+	t.SettlementTransactionID = &transactionID
+	t.SettledAt = &settledAt
+}
+
+// Return marks the transfer returned with an ACH return code. An empty reason
+// is filled from the code's description.
+func (t *ExternalTransfer) Return(returnCode, reason string, returnedAt time.Time) {
+	if reason == "" {
+		reason = ACHReturnCodes[returnCode]
+	}
+	t.Status = ExternalTransferStatusReturned
+	t.ReturnCode = returnCode
+	t.ReturnReason = reason
+	t.ReturnedAt = &returnedAt
+}
+
+// Fail marks a transfer NorthWind refused to accept or fraud review blocked
+func (t *ExternalTransfer) Fail(reason string) {
+	t.Status = ExternalTransferStatusFailed
+	t.FailureReason = reason
+}
+
+// IsValidACHReturnCode checks if the code is a supported ACH return code
+func IsValidACHReturnCode(code string) bool {
+	_, ok := ACHReturnCodes[code]
+	return ok
+}
+
+// GenerateExternalTransferReference generates the reference number sent to NorthWind
+func GenerateExternalTransferReference() string {
+	return "EXT-" + uuid.New().String()[:8] + "-" + time.Now().Format("20060102150405")
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestExternalTransfer() *ExternalTransfer {
+	return &ExternalTransfer{
+		UserID:                    uuid.New(),
+		AccountID:                 uuid.New(),
+		Direction:                 ExternalTransferOutbound,
+		Amount:                    decimal.RequireFromString("50.00"),
+		IdempotencyKey:            "key-1",
+		CounterpartyName:          "Jane Doe",
+		CounterpartyAccountNumber: "987654321",
+		CounterpartyRoutingNumber: "011000015",
+		Status:                    ExternalTransferStatusInitiated,
+	}
+}
+
+func TestExternalTransfer_Validate(t *testing.T) {
+	assert.NoError(t, newTestExternalTransfer().Validate())
+
+	transfer := newTestExternalTransfer()
+	transfer.Direction = "sideways"
+	assert.ErrorIs(t, transfer.Validate(), ErrInvalidExternalTransferDirection)
+
+	transfer = newTestExternalTransfer()
+	transfer.Amount = decimal.Zero
+	assert.ErrorIs(t, transfer.Validate(), ErrInvalidTransferAmount)
+
+	transfer = newTestExternalTransfer()
+	transfer.Status = "pending"
+	assert.ErrorIs(t, transfer.Validate(), ErrInvalidExternalTransferStatus)
+
+	transfer = newTestExternalTransfer()
+	transfer.Status = ExternalTransferStatusPendingReview
+	assert.NoError(t, transfer.Validate())
+
+	transfer = newTestExternalTransfer()
+	transfer.ReturnCode = "R99"
+	assert.ErrorIs(t, transfer.Validate(), ErrInvalidACHReturnCode)
+
+	transfer = newTestExternalTransfer()
+	transfer.CounterpartyRoutingNumber = ""
+	assert.Error(t, transfer.Validate())
+}
+
+func TestExternalTransfer_Lifecycle(t *testing.T) {
+	transfer := newTestExternalTransfer()
+	assert.True(t, transfer.IsOutbound())
+	assert.False(t, transfer.IsFinal())
+
+	now := time.Now()
+	transfer.MarkSubmitted("nw-1", now, now.Add(48*time.Hour))
+	assert.Equal(t, ExternalTransferStatusSubmitted, transfer.Status)
+	assert.Equal(t, "nw-1", transfer.ProviderTransferID)
+	assert.False(t, transfer.IsFinal())
+
+	transfer.Return("R02", "", now)
+	assert.Equal(t, ExternalTransferStatusReturned, transfer.Status)
+	assert.Equal(t, "Account closed", transfer.ReturnReason)
+	assert.True(t, transfer.IsFinal())
+
+	transfer = newTestExternalTransfer()
+	transfer.Return("R01", "Customer out of funds", now)
+	assert.Equal(t, "Customer out of funds", transfer.ReturnReason)
+
+	transfer = newTestExternalTransfer()
+	transactionID := uuid.New()
+	transfer.Settle(transactionID, now)
+	assert.Equal(t, ExternalTransferStatusSettled, transfer.Status)
+	assert.Equal(t, transactionID, *transfer.SettlementTransactionID)
+	assert.True(t, transfer.IsFinal())
+}
@@ -0,0 +1,187 @@
+package models
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Fraud review statuses. A review is pending until an admin approves or rejects
+// it, or until its hold expires.
+const (
+	FraudReviewStatusPending  = "pending_review"
+	FraudReviewStatusApproved = "approved"
+	FraudReviewStatusRejected = "rejected"
+	FraudReviewStatusExpired  = "expired"
+)
+
+// Fraud review subjects
+const (
+	FraudSubjectTransaction      = "transaction"
+	FraudSubjectTransfer         = "transfer"
+	FraudSubjectExternalTransfer = "external_transfer"
+)
+
+// Fraud screening rules
+const (
+	FraudRuleLargeAmount    = "large_amount"
+	FraudRuleNewDestination = "new_destination"
+	FraudRuleNewLoginIP     = "new_login_ip"
+	FraudRuleRapidTransfers = "rapid_transfers"
+)
+
+var (
+	ErrInvalidFraudReviewStatus = errors.New("invalid fraud review status")
+	ErrInvalidFraudSubject      = errors.New("invalid fraud review subject")
+)
+
+// FraudReview is a debit or outgoing transfer flagged by fraud screening. The
+// amount is reserved by a hold on the account while the review is pending; an
+// approved debit captures the hold and an approved transfer releases it and moves
+// the funds. An outbound external transfer is reserved by its own hold, which it
+// keeps when approved until it settles. Rules maps each rule that fired to the
+// reason it gave.
+type FraudReview struct {
+	ID                 uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
+	AccountID          uuid.UUID       `gorm:"type:uuid;not null;index" json:"account_id"`
+	UserID             uuid.UUID       `gorm:"type:uuid;not null;index" json:"user_id"`
+	SubjectType        string          `gorm:"type:varchar(20);not null" json:"subject_type"`
+	HoldID             uuid.UUID       `gorm:"type:uuid;not null" json:"hold_id"`
+	TransferID         *uuid.UUID      `gorm:"type:uuid;index" json:"transfer_id,omitempty"`
+	ExternalTransferID *uuid.UUID      `gorm:"type:uuid;index" json:"external_transfer_id,omitempty"`
+	Amount             decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"amount"`
+	Description        string          `gorm:"type:text;not null" json:"description"`
+	Rules              JSONBMap        `gorm:"type:jsonb;not null" json:"rules"`
+	Status             string          `gorm:"type:varchar(20);not null;default:'pending_review';index" json:"status"`
+	ExpiresAt          time.Time       `gorm:"not null" json:"expires_at"`
+	ReviewedBy         *uuid.UUID      `gorm:"type:uuid" json:"reviewed_by,omitempty"`
+	ReviewedAt         *time.Time      `json:"reviewed_at,omitempty"`
+	DecisionReason     *string         `gorm:"type:text" json:"decision_reason,omitempty"`
+	FailureReason      *string         `gorm:"type:text" json:"failure_reason,omitempty"`
+	CreatedAt          time.Time       `gorm:"not null" json:"created_at"`
+	UpdatedAt          time.Time       `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for FraudReview
+func (r *FraudReview) TableName() string {
+	return "fraud_reviews"
+}
+
+// BeforeCreate hook for FraudReview
+func (r *FraudReview) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+
+	if r.Status == "" {
+		r.Status = FraudReviewStatusPending
+	}
+
+	now := time.Now()
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = now
+	}
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = now
+	}
+
+	return r.Validate()
+}
+
+// BeforeUpdate hook for FraudReview
+func (r *FraudReview) BeforeUpdate(tx *gorm.DB) error {
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// Validate validates the fraud review fields
+func (r *FraudReview) Validate() error {
+	if !IsValidFraudReviewStatus(r.Status) {
+		return ErrInvalidFraudReviewStatus
+	}
+
+	switch r.SubjectType {
+	case FraudSubjectTransaction:
+		if r.TransferID != nil || r.ExternalTransferID != nil {
+			return ErrInvalidFraudSubject
+		}
+	case FraudSubjectTransfer:
+		if r.TransferID == nil || r.ExternalTransferID != nil {
+			return ErrInvalidFraudSubject
+		}
+	case FraudSubjectExternalTransfer:
+		if r.ExternalTransferID == nil || r.TransferID != nil {
+			return ErrInvalidFraudSubject
+		}
+	default:
+		return ErrInvalidFraudSubject
+	}
+
+	if len(r.Rules) == 0 {
+		return errors.New("fraud review requires at least one rule")
+	}
+
+	return nil
+}
+
+// IsValidFraudReviewStatus checks if the status is a valid fraud review status
+func IsValidFraudReviewStatus(status string) bool {
+	switch status {
+	case FraudReviewStatusPending, FraudReviewStatusApproved, FraudReviewStatusRejected, FraudReviewStatusExpired:
+		return true
+	}
+	return false
+}
+
+// IsPending returns true if the review is awaiting a decision
+func (r *FraudReview) IsPending() bool {
+	return r.Status == FraudReviewStatusPending
+}
+
+// IsExpired returns true if the review's hold has expired at the given time
+func (r *FraudReview) IsExpired(at time.Time) bool {
+	return !r.ExpiresAt.After(at)
+}
+
+// RuleNames returns the names of the rules that fired, sorted
+func (r *FraudReview) RuleNames() []string {
+	names := make([]string, 0, len(r.Rules))
+	for name := range r.Rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Approve records an admin's decision to let the flagged item through
+func (r *FraudReview) Approve(reviewedBy uuid.UUID, reason string, at time.Time) {
+	r.decide(FraudReviewStatusApproved, &reviewedBy, reason, at)
+}
+
+// Reject records an admin's decision to block the flagged item
+func (r *FraudReview) Reject(reviewedBy uuid.UUID, reason string, at time.Time) {
+	r.decide(FraudReviewStatusRejected, &reviewedBy, reason, at)
+}
+
+// Expire closes a review whose hold expired before it was decided
+func (r *FraudReview) Expire(at time.Time) {
+	r.decide(FraudReviewStatusExpired, nil, "", at)
+}
+
+// Fail records why an approved item could not be completed
+func (r *FraudReview) Fail(reason string) {
+	r.FailureReason = &reason
+}
+
+func (r *FraudReview) decide(status string, reviewedBy *uuid.UUID, reason string, at time.Time) {
+	r.Status = status
+	r.ReviewedBy = reviewedBy
+	r.ReviewedAt = &at
+	if reason != "" {
+		r.DecisionReason = &reason
+	}
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFraudReview_Validate(t *testing.T) {
+	transferID := uuid.New()
+	rules := JSONBMap{FraudRuleNewDestination: "first transfer to account 1234567890"}
+
+	assert.NoError(t, (&FraudReview{Status: FraudReviewStatusPending, SubjectType: FraudSubjectTransaction, Rules: rules}).Validate())
+	assert.NoError(t, (&FraudReview{Status: FraudReviewStatusPending, SubjectType: FraudSubjectTransfer, TransferID: &transferID, Rules: rules}).Validate())
+	assert.NoError(t, (&FraudReview{Status: FraudReviewStatusPending, SubjectType: FraudSubjectExternalTransfer, ExternalTransferID: &transferID, Rules: rules}).Validate())
+
+	assert.ErrorIs(t, (&FraudReview{Status: "held", SubjectType: FraudSubjectTransaction, Rules: rules}).Validate(), ErrInvalidFraudReviewStatus)
+	assert.ErrorIs(t, (&FraudReview{Status: FraudReviewStatusPending, SubjectType: FraudSubjectTransfer, Rules: rules}).Validate(), ErrInvalidFraudSubject)
+	assert.ErrorIs(t, (&FraudReview{Status: FraudReviewStatusPending, SubjectType: FraudSubjectTransaction, TransferID: &transferID, Rules: rules}).Validate(), ErrInvalidFraudSubject)
+	assert.ErrorIs(t, (&FraudReview{Status: FraudReviewStatusPending, SubjectType: FraudSubjectExternalTransfer, Rules: rules}).Validate(), ErrInvalidFraudSubject)
+	assert.ErrorIs(t, (&FraudReview{Status: FraudReviewStatusPending, SubjectType: FraudSubjectTransfer, TransferID: &transferID, ExternalTransferID: &transferID, Rules: rules}).Validate(), ErrInvalidFraudSubject)
+	assert.ErrorIs(t, (&FraudReview{Status: FraudReviewStatusPending, SubjectType: "payment", Rules: rules}).Validate(), ErrInvalidFraudSubject)
+	assert.Error(t, (&FraudReview{Status: FraudReviewStatusPending, SubjectType: FraudSubjectTransaction}).Validate())
+}
+
+func TestFraudReview_Decisions(t *testing.T) {
+	now := time.Now()
+	adminID := uuid.New()
+
+	review := &FraudReview{Status: FraudReviewStatusPending, ExpiresAt: now.Add(time.Hour)}
+	assert.True(t, review.IsPending())
+	assert.False(t, review.IsExpired(now))
+	assert.True(t, review.IsExpired(now.Add(time.Hour)))
+
+	review.Approve(adminID, "Customer confirmed by phone", now)
+	assert.Equal(t, FraudReviewStatusApproved, review.Status)
+	assert.Equal(t, adminID, *review.ReviewedBy)
+	assert.Equal(t, "Customer confirmed by phone", *review.DecisionReason)
+	assert.False(t, review.IsPending())
+
+	review = &FraudReview{Status: FraudReviewStatusPending}
+	review.Reject(adminID, "Account takeover", now)
+	assert.Equal(t, FraudReviewStatusRejected, review.Status)
+
+	review = &FraudReview{Status: FraudReviewStatusPending}
+	review.Expire(now)
+	assert.Equal(t, FraudReviewStatusExpired, review.Status)
+	assert.Nil(t, review.ReviewedBy)
+	assert.Nil(t, review.DecisionReason)
+	assert.Equal(t, now, *review.ReviewedAt)
+}
+
+func TestFraudReview_RuleNames(t *testing.T) {
+	review := &FraudReview{Rules: JSONBMap{
+		FraudRuleRapidTransfers: "4 transfers from this account within 10m0s",
+		FraudRuleLargeAmount:    "amount 5000.00 is more than 5 times the average transaction of 100.00",
+	}}
+
+	assert.Equal(t, []string{FraudRuleLargeAmount, FraudRuleRapidTransfers}, review.RuleNames())
+}
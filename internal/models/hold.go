@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultHoldDuration is how long a hold stays active when no expiry is given
+	DefaultHoldDuration = 7 * 24 * time.Hour
+
+	// MaxHoldDuration is the longest a hold may be placed for
+	MaxHoldDuration = 30 * 24 * time.Hour
+
+	// HoldOutcomeReleased, HoldOutcomeExpired and HoldOutcomeCaptured record in the
+	// transaction metadata how a hold left the pending status
+	HoldOutcomeReleased = "released"
+	HoldOutcomeExpired  = "expired"
+	HoldOutcomeCaptured = "captured"
+
+	holdMetadataOutcome    = "hold_outcome"
+	holdMetadataHeldAmount = "held_amount"
+)
+
+// NewHold builds an authorization hold: a pending debit that reserves funds until it
+// is captured, released or expires at expiresAt. The hold reduces the account's
+// available balance but leaves the ledger balance unchanged until it is captured.
+func NewHold(accountID uuid.UUID, amount decimal.Decimal, description string, expiresAt time.Time) *Transaction {
+	return &Transaction{
+		AccountID:       accountID,
+		TransactionType: TransactionTypeDebit,
+		Amount:          amount,
+		Description:     description,
+		Status:          TransactionStatusPending,
+		PendingUntil:    &expiresAt,
+		Reference:       GenerateTransactionReference(),
+	}
+}
+
+// IsHold returns true if the transaction is an authorization hold
+func (t *Transaction) IsHold() bool {
+	return t.TransactionType == TransactionTypeDebit && t.PendingUntil != nil
+}
+
+// IsActiveHold returns true if the transaction is a hold that still reserves funds
+func (t *Transaction) IsActiveHold() bool {
+	return t.IsHold() && t.IsPending()
+}
+
+// HoldOutcome returns how a settled hold left the pending status, or an empty string
+// while the hold is active
+func (t *Transaction) HoldOutcome() string {
+	if t.Metadata == nil {
+		return ""
+	}
+	outcome, _ := t.Metadata[holdMetadataOutcome].(string)
+	return outcome
+}
+
+// CaptureHold completes the hold as a debit of amount against the given ledger balance.
+// The originally held amount is kept in the metadata.
+func (t *Transaction) CaptureHold(amount, balanceBefore decimal.Decimal) {
+	t.setHoldOutcome(HoldOutcomeCaptured)
+	t.Metadata[holdMetadataHeldAmount] = t.Amount.StringFixed(2)
+	t.Amount = amount
+	t.BalanceBefore = balanceBefore
+	t.BalanceAfter = balanceBefore.Sub(amount)
+	t.Complete()
+}
+
+// ReleaseHold fails the hold without moving funds, recording whether it was released
+// or expired
+func (t *Transaction) ReleaseHold(outcome string) {
+	t.setHoldOutcome(outcome)
+	t.Fail()
+}
+
+func (t *Transaction) setHoldOutcome(outcome string) {
+	if t.Metadata == nil {
+		t.Metadata = make(JSONBMap)
+	}
+	t.Metadata[holdMetadataOutcome] = outcome
+}
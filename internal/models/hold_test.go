@@ -0,0 +1,62 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccount_AvailableBalance(t *testing.T) {
+	account := &Account{
+		Status:      AccountStatusActive,
+		Balance:     decimal.RequireFromString("100.00"),
+		HeldBalance: decimal.RequireFromString("60.00"),
+	}
+
+	assert.Equal(t, "40.00", account.GetAvailableBalance().StringFixed(2))
+	assert.True(t, account.HasAvailableFunds(decimal.RequireFromString("40.00")))
+	assert.False(t, account.CanWithdraw(decimal.RequireFromString("40.01")))
+	assert.ErrorIs(t, account.Debit(decimal.RequireFromString("50.00")), ErrInsufficientFunds)
+}
+
+func TestNewHold(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	hold := NewHold(uuid.New(), decimal.NewFromInt(25), "Card authorization", expiresAt)
+
+	assert.True(t, hold.IsHold())
+	assert.True(t, hold.IsActiveHold())
+	assert.False(t, hold.IsPendingExpired())
+	assert.Empty(t, hold.HoldOutcome())
+
+	// A pending debit without an expiry is not a hold
+	hold.PendingUntil = nil
+	assert.False(t, hold.IsHold())
+}
+
+func TestTransaction_CaptureHold(t *testing.T) {
+	hold := NewHold(uuid.New(), decimal.NewFromInt(50), "Hotel pre-authorization", time.Now().Add(time.Hour))
+
+	hold.CaptureHold(decimal.RequireFromString("42.50"), decimal.NewFromInt(200))
+
+	assert.Equal(t, TransactionStatusCompleted, hold.Status)
+	assert.Equal(t, HoldOutcomeCaptured, hold.HoldOutcome())
+	assert.Equal(t, "42.50", hold.Amount.StringFixed(2))
+	assert.Equal(t, "157.50", hold.BalanceAfter.StringFixed(2))
+	assert.Equal(t, "50.00", hold.Metadata["held_amount"])
+	assert.NoError(t, hold.Validate())
+	assert.False(t, hold.IsActiveHold())
+}
+
+func TestTransaction_ReleaseHold(t *testing.T) {
+	hold := NewHold(uuid.New(), decimal.NewFromInt(50), "Fuel pre-authorization", time.Now().Add(-time.Minute))
+	assert.True(t, hold.IsPendingExpired())
+
+	hold.ReleaseHold(HoldOutcomeExpired)
+
+	assert.Equal(t, TransactionStatusFailed, hold.Status)
+	assert.Equal(t, HoldOutcomeExpired, hold.HoldOutcome())
+	assert.NotNil(t, hold.ProcessedAt)
+}
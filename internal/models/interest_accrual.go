@@ -0,0 +1,138 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+const (
+	// InterestDaysInYear is the day-count basis for daily accrual (actual/365)
+	InterestDaysInYear = 365
+
+	// InterestAccrualScale is the number of decimal places kept on each daily accrual
+	InterestAccrualScale = 10
+
+	// InterestTransactionDescription is the description of monthly interest credits
+	InterestTransactionDescription = "Interest Payment"
+
+	// InterestTransactionCategory is the category of monthly interest credits
+	InterestTransactionCategory = "INCOME"
+)
+
+// InterestAccrual is the interest earned by an account on one day's closing
+// balance. Accruals keep sub-cent precision and are paid out by a monthly
+// InterestPosting; PostingID is set once the accrual has been paid.
+type InterestAccrual struct {
+	ID          uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
+	AccountID   uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_interest_accruals_account_date,priority:1" json:"account_id"`
+	AccrualDate time.Time       `gorm:"type:date;not null;uniqueIndex:idx_interest_accruals_account_date,priority:2" json:"accrual_date"`
+	Balance     decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"balance"`
+	Rate        decimal.Decimal `gorm:"type:decimal(5,4);not null" json:"rate"`
+	Amount      decimal.Decimal `gorm:"type:decimal(20,10);not null" json:"amount"`
+	PostingID   *uuid.UUID      `gorm:"type:uuid;index" json:"posting_id,omitempty"`
+	CreatedAt   time.Time       `gorm:"not null" json:"created_at"`
+}
+
+// TableName specifies the table name for InterestAccrual
+func (a *InterestAccrual) TableName() string {
+	return "interest_accruals"
+}
+
+// BeforeCreate hook for InterestAccrual
+func (a *InterestAccrual) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = time.Now()
+	}
+	return nil
+}
+
+// NewInterestAccrual computes the interest earned on a day's closing balance at
+// an annual rate. Zero and negative balances earn nothing but are still recorded
+// so the day is not accrued again.
+func NewInterestAccrual(accountID uuid.UUID, date time.Time, balance, rate decimal.Decimal) *InterestAccrual {
+	amount := decimal.Zero
+	if balance.IsPositive() && rate.IsPositive() {
+		amount = balance.Mul(rate).
+			DivRound(decimal.NewFromInt(InterestDaysInYear), InterestAccrualScale)
+	}
+
+	return &InterestAccrual{
+		AccountID:   accountID,
+		AccrualDate: InterestDate(date),
+		Balance:     balance,
+		Rate:        rate,
+		Amount:      amount,
+	}
+}
+
+// InterestPosting records the monthly payment of an account's accrued interest.
+// Amount is the accrued total rounded down to the cent; the remainder is carried
+// into the next month's posting so no fraction of a cent is lost or overpaid.
+type InterestPosting struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
+	AccountID     uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_interest_postings_account_period,priority:1" json:"account_id"`
+	PeriodStart   time.Time       `gorm:"type:date;not null;uniqueIndex:idx_interest_postings_account_period,priority:2" json:"period_start"`
+	AccrualCount  int             `gorm:"not null;default:0" json:"accrual_count"`
+	Accrued       decimal.Decimal `gorm:"type:decimal(20,10);not null" json:"accrued"`
+	Amount        decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"amount"`
+	Carry         decimal.Decimal `gorm:"type:decimal(20,10);not null" json:"carry"`
+	TransactionID *uuid.UUID      `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	CreatedAt     time.Time       `gorm:"not null" json:"created_at"`
+}
+
+// TableName specifies the table name for InterestPosting
+func (p *InterestPosting) TableName() string {
+	return "interest_postings"
+}
+
+// BeforeCreate hook for InterestPosting
+func (p *InterestPosting) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now()
+	}
+	return nil
+}
+
+// SplitInterest splits an accrued total into the whole cents to pay and the
+// sub-cent remainder to carry forward
+func SplitInterest(accrued decimal.Decimal) (amount, carry decimal.Decimal) {
+	amount = accrued.RoundFloor(2)
+	return amount, accrued.Sub(amount)
+}
+
+// InterestBackfillResult summarizes an interest backfill run
+type InterestBackfillResult struct {
+	StartDate         time.Time       `json:"start_date"`
+	EndDate           time.Time       `json:"end_date"`
+	AccountsProcessed int             `json:"accounts_processed"`
+	AccrualsCreated   int             `json:"accruals_created"`
+	PostingsCreated   int             `json:"postings_created"`
+	InterestPosted    decimal.Decimal `json:"interest_posted"`
+	FailedAccounts    []uuid.UUID     `json:"failed_accounts,omitempty"`
+}
+
+// IsInterestBearingAccountType returns true for account types that earn interest
+func IsInterestBearingAccountType(accountType string) bool {
+	return accountType == AccountTypeSavings || accountType == AccountTypeMoneyMarket
+}
+
+// InterestDate truncates a time to its UTC calendar day
+func InterestDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// InterestPeriodStart returns the first day of the UTC month containing t
+func InterestPeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInterestAccrual(t *testing.T) {
+	accountID := uuid.New()
+	day := time.Date(2026, 9, 14, 18, 30, 0, 0, time.FixedZone("EST", -5*3600))
+	rate := decimal.RequireFromString("0.0250")
+
+	tests := []struct {
+		name     string
+		balance  decimal.Decimal
+		expected string
+	}{
+		{"positive balance", decimal.RequireFromString("10000.00"), "0.6849315068"},
+		{"whole cents", decimal.RequireFromString("730.00"), "0.05"},
+		{"zero balance", decimal.Zero, "0"},
+		{"negative balance", decimal.NewFromInt(-50), "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accrual := NewInterestAccrual(accountID, day, tt.balance, rate)
+			assert.Equal(t, tt.expected, accrual.Amount.String())
+			assert.True(t, tt.balance.Equal(accrual.Balance))
+			// Accrual days are UTC calendar days
+			assert.Equal(t, time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC), accrual.AccrualDate)
+		})
+	}
+}
+
+func TestSplitInterest(t *testing.T) {
+	amount, carry := SplitInterest(decimal.RequireFromString("1.2328767120"))
+	assert.Equal(t, "1.23", amount.String())
+	assert.Equal(t, "0.002876712", carry.String())
+
+	amount, carry = SplitInterest(decimal.RequireFromString("0.0099999999"))
+	assert.True(t, amount.IsZero())
+	assert.Equal(t, "0.0099999999", carry.String())
+}
+
+func TestInterestPeriodStart(t *testing.T) {
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		InterestPeriodStart(time.Date(2026, 2, 28, 23, 59, 0, 0, time.UTC)))
+	assert.True(t, IsInterestBearingAccountType(AccountTypeMoneyMarket))
+	assert.False(t, IsInterestBearingAccountType(AccountTypeChecking))
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Merchant mapping match types
+const (
+	MatchTypeExact   = "EXACT"
+	MatchTypePartial = "PARTIAL"
+	MatchTypeFuzzy   = "FUZZY"
+	MatchTypeRegex   = "REGEX"
+	MatchTypeMCC     = "MCC"
+	MatchTypeKeyword = "KEYWORD"
+)
+
+var (
+	ErrInvalidMatchType        = errors.New("invalid match type")
+	ErrInvalidConfidenceScore  = errors.New("confidence score must be between 0 and 1")
+	ErrMerchantPatternRequired = errors.New("merchant pattern is required")
+	ErrMappingCategoryRequired = errors.New("category code is required")
+	ErrInvalidRegexPattern     = errors.New("merchant pattern is not a valid regular expression")
+)
+
+// MerchantMapping represents a categorization rule matched against a transaction's
+// merchant name, MCC code or description
+type MerchantMapping struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	MerchantPattern string     `gorm:"type:varchar(255);not null;index:idx_merchant_mappings_pattern" json:"merchant_pattern"`
+	NormalizedName  string     `gorm:"type:varchar(255);not null;index:idx_merchant_mappings_normalized_name" json:"normalized_name"`
+	CategoryCode    string     `gorm:"type:varchar(50);not null;index:idx_merchant_mappings_category" json:"category_code"`
+	MCCCode         *string    `gorm:"column:mcc_code;type:varchar(10);index:idx_merchant_mappings_mcc" json:"mcc_code,omitempty"`
+	MatchType       string     `gorm:"type:varchar(20);not null;default:'EXACT'" json:"match_type"`
+	ConfidenceScore float64    `gorm:"type:decimal(3,2);not null;default:1.00" json:"confidence_score"`
+	IsActive        bool       `gorm:"not null;index:idx_merchant_mappings_is_active" json:"is_active"`
+	UsageCount      int        `gorm:"not null;default:0" json:"usage_count"`
+	LastUsedAt      *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt       time.Time  `gorm:"not null" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"not null" json:"updated_at"`
+}
+
+// BeforeCreate hook for MerchantMapping
+func (m *MerchantMapping) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	if m.MatchType == "" {
+		m.MatchType = MatchTypeExact
+	}
+	return m.Validate()
+}
+
+// BeforeUpdate hook for MerchantMapping
+func (m *MerchantMapping) BeforeUpdate(tx *gorm.DB) error {
+	return m.Validate()
+}
+
+// Validate validates the mapping fields
+func (m *MerchantMapping) Validate() error {
+	if m.MerchantPattern == "" {
+		return ErrMerchantPatternRequired
+	}
+
+	if m.CategoryCode == "" {
+		return ErrMappingCategoryRequired
+	}
+
+	if !IsValidMatchType(m.MatchType) {
+		return ErrInvalidMatchType
+	}
+
+	if m.ConfidenceScore < 0 || m.ConfidenceScore > 1 {
+		return ErrInvalidConfidenceScore
+	}
+
+	if m.MatchType == MatchTypeRegex {
+		if _, err := regexp.Compile(m.MerchantPattern); err != nil {
+			return ErrInvalidRegexPattern
+		}
+	}
+
+	return nil
+}
+
+// TableName returns the table name for MerchantMapping
+func (m *MerchantMapping) TableName() string {
+	return "merchant_mappings"
+}
+
+// IsValidMatchType checks if the match type is supported
+func IsValidMatchType(matchType string) bool {
+	switch matchType {
+	case MatchTypeExact, MatchTypePartial, MatchTypeFuzzy, MatchTypeRegex, MatchTypeMCC, MatchTypeKeyword:
+		return true
+	default:
+		return false
+	}
+}
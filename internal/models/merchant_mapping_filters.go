@@ -0,0 +1,9 @@
+package models
+
+// MerchantMappingFilters contains filter criteria for merchant mapping queries
+type MerchantMappingFilters struct {
+	CategoryCode string
+	MatchType    string
+	IsActive     *bool
+	Search       string
+}
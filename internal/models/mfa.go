@@ -0,0 +1,133 @@
+package models
+
+import (
+	"crypto/subtle"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MFARecoveryCodeCount is how many recovery codes are issued at a time
+const MFARecoveryCodeCount = 10
+
+// MFAEnrollment is a user's TOTP authenticator. It is pending until the user
+// confirms it with a valid code; from then on logins and sensitive operations
+// require a code. Recovery codes are stored hashed and removed once used.
+type MFAEnrollment struct {
+	ID            uuid.UUID        `gorm:"type:uuid;primary_key" json:"id"`
+	UserID        uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex" json:"user_id"`
+	Secret        string           `gorm:"type:varchar(255);not null" json:"-"`
+	ConfirmedAt   *time.Time       `json:"confirmed_at,omitempty"`
+	LastUsedStep  int64            `gorm:"not null;default:0" json:"-"`
+	RecoveryCodes MFARecoveryCodes `gorm:"type:jsonb" json:"-"`
+	CreatedAt     time.Time        `gorm:"not null" json:"created_at"`
+	UpdatedAt     time.Time        `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for MFAEnrollment
+func (e *MFAEnrollment) TableName() string {
+	return "mfa_enrollments"
+}
+
+// BeforeCreate hook for MFAEnrollment
+func (e *MFAEnrollment) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+
+	now := time.Now()
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = now
+	}
+	if e.UpdatedAt.IsZero() {
+		e.UpdatedAt = now
+	}
+
+	return e.Validate()
+}
+
+// BeforeUpdate hook for MFAEnrollment
+func (e *MFAEnrollment) BeforeUpdate(tx *gorm.DB) error {
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Validate validates the enrollment fields
+func (e *MFAEnrollment) Validate() error {
+	if e.UserID == uuid.Nil {
+		return errors.New("user ID is required")
+	}
+	if e.Secret == "" {
+		return errors.New("MFA secret is required")
+	}
+	return nil
+}
+
+// IsConfirmed returns true once the user has proved the authenticator works
+func (e *MFAEnrollment) IsConfirmed() bool {
+	return e.ConfirmedAt != nil
+}
+
+// Confirm marks the enrollment confirmed and replaces its recovery codes
+func (e *MFAEnrollment) Confirm(recoveryCodeHashes []string, at time.Time) {
+	e.ConfirmedAt = &at
+	e.RecoveryCodes = MFARecoveryCodes(recoveryCodeHashes)
+}
+
+// UseRecoveryCode removes the recovery code with the given hash and returns true
+// if it was one of the unused codes
+func (e *MFAEnrollment) UseRecoveryCode(codeHash string) bool {
+	for i, stored := range e.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(stored), []byte(codeHash)) == 1 {
+			e.RecoveryCodes = append(e.RecoveryCodes[:i:i], e.RecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// MFARecoveryCodes is the list of unused recovery code hashes
+type MFARecoveryCodes []string
+
+// Value implements driver.Valuer interface
+func (c MFARecoveryCodes) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+	bytes, err := json.Marshal([]string(c))
+	if err != nil {
+		return nil, err
+	}
+	// Return string for SQLite compatibility
+	return string(bytes), nil
+}
+
+// Scan implements sql.Scanner interface
+func (c *MFARecoveryCodes) Scan(value interface{}) error {
+	if value == nil {
+		*c = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into MFARecoveryCodes", value)
+	}
+
+	if len(bytes) == 0 {
+		*c = nil
+		return nil
+	}
+
+	return json.Unmarshal(bytes, (*[]string)(c))
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMFAEnrollment_Validate(t *testing.T) {
+	enrollment := &MFAEnrollment{UserID: uuid.New(), Secret: "JBSWY3DPEHPK3PXP"}
+	assert.NoError(t, enrollment.Validate())
+
+	assert.Error(t, (&MFAEnrollment{Secret: "JBSWY3DPEHPK3PXP"}).Validate())
+	assert.Error(t, (&MFAEnrollment{UserID: uuid.New()}).Validate())
+}
+
+func TestMFAEnrollment_Confirm(t *testing.T) {
+	enrollment := &MFAEnrollment{UserID: uuid.New(), Secret: "JBSWY3DPEHPK3PXP"}
+	assert.False(t, enrollment.IsConfirmed())
+
+	enrollment.Confirm([]string{"a", "b"}, time.Now())
+
+	assert.True(t, enrollment.IsConfirmed())
+	assert.Equal(t, MFARecoveryCodes{"a", "b"}, enrollment.RecoveryCodes)
+}
+
+func TestMFAEnrollment_UseRecoveryCode(t *testing.T) {
+	original := []string{"a", "b", "c"}
+	enrollment := &MFAEnrollment{RecoveryCodes: MFARecoveryCodes(original)}
+
+	assert.True(t, enrollment.UseRecoveryCode("b"))
+	assert.Equal(t, MFARecoveryCodes{"a", "c"}, enrollment.RecoveryCodes)
+	assert.Equal(t, []string{"a", "b", "c"}, original, "the original slice is left untouched")
+
+	assert.False(t, enrollment.UseRecoveryCode("b"), "a code can only be used once")
+	assert.False(t, enrollment.UseRecoveryCode("unknown"))
+}
+
+func TestMFARecoveryCodes_ValueAndScan(t *testing.T) {
+	value, err := MFARecoveryCodes{"a", "b"}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `["a","b"]`, value)
+
+	var codes MFARecoveryCodes
+	assert.NoError(t, codes.Scan([]byte(`["a","b"]`)))
+	assert.Equal(t, MFARecoveryCodes{"a", "b"}, codes)
+
+	assert.NoError(t, codes.Scan(nil))
+	assert.Nil(t, codes)
+}
@@ -0,0 +1,162 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Pending action statuses. An action is pending until a second admin approves or
+// rejects it, or until it expires.
+const (
+	PendingActionStatusPending  = "pending_approval"
+	PendingActionStatusApproved = "approved"
+	PendingActionStatusRejected = "rejected"
+	PendingActionStatusExpired  = "expired"
+)
+
+// Pending action types: the high-risk staff actions that can be held for approval
+const (
+	PendingActionDeleteCustomer    = "delete_customer"
+	PendingActionTransferOwnership = "transfer_account_ownership"
+	PendingActionResetPassword     = "reset_customer_password"
+	PendingActionManualTransaction = "manual_transaction"
+	PendingActionTransactionImport = "transaction_import"
+)
+
+var (
+	ErrInvalidPendingActionStatus = errors.New("invalid pending action status")
+	ErrInvalidPendingActionType   = errors.New("invalid pending action type")
+)
+
+// PendingAction is a high-risk staff action held for maker-checker approval. The
+// admin who requests it cannot decide it; approval by a second admin carries out
+// the action with the stored payload. The decision stands even if the action then
+// fails, and FailureReason records why.
+type PendingAction struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	ActionType     string     `gorm:"type:varchar(50);not null" json:"action_type"`
+	ResourceID     string     `gorm:"type:varchar(255);not null;index" json:"resource_id"`
+	Payload        JSONBMap   `gorm:"type:jsonb;not null" json:"payload"`
+	Status         string     `gorm:"type:varchar(20);not null;default:'pending_approval';index" json:"status"`
+	RequestedBy    uuid.UUID  `gorm:"type:uuid;not null" json:"requested_by"`
+	ExpiresAt      time.Time  `gorm:"not null" json:"expires_at"`
+	ReviewedBy     *uuid.UUID `gorm:"type:uuid" json:"reviewed_by,omitempty"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`
+	DecisionReason *string    `gorm:"type:text" json:"decision_reason,omitempty"`
+	FailureReason  *string    `gorm:"type:text" json:"failure_reason,omitempty"`
+	CreatedAt      time.Time  `gorm:"not null" json:"created_at"`
+	UpdatedAt      time.Time  `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for PendingAction
+func (a *PendingAction) TableName() string {
+	return "pending_actions"
+}
+
+// BeforeCreate hook for PendingAction
+func (a *PendingAction) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+
+	if a.Status == "" {
+		a.Status = PendingActionStatusPending
+	}
+
+	now := time.Now()
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = now
+	}
+	if a.UpdatedAt.IsZero() {
+		a.UpdatedAt = now
+	}
+
+	return a.Validate()
+}
+
+// BeforeUpdate hook for PendingAction
+func (a *PendingAction) BeforeUpdate(tx *gorm.DB) error {
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// Validate validates the pending action fields
+func (a *PendingAction) Validate() error {
+	if !IsValidPendingActionStatus(a.Status) {
+		return ErrInvalidPendingActionStatus
+	}
+
+	if !IsValidPendingActionType(a.ActionType) {
+		return ErrInvalidPendingActionType
+	}
+
+	if a.ResourceID == "" {
+		return errors.New("pending action requires a resource ID")
+	}
+
+	if a.RequestedBy == uuid.Nil {
+		return errors.New("pending action requires the requesting admin")
+	}
+
+	return nil
+}
+
+// IsValidPendingActionStatus checks if the status is a valid pending action status
+func IsValidPendingActionStatus(status string) bool {
+	switch status {
+	case PendingActionStatusPending, PendingActionStatusApproved, PendingActionStatusRejected, PendingActionStatusExpired:
+		return true
+	}
+	return false
+}
+
+// IsValidPendingActionType checks if the type is an action that can be held for approval
+func IsValidPendingActionType(actionType string) bool {
+	switch actionType {
+	case PendingActionDeleteCustomer, PendingActionTransferOwnership, PendingActionResetPassword, PendingActionManualTransaction, PendingActionTransactionImport:
+		return true
+	}
+	return false
+}
+
+// IsPending returns true if the action is awaiting a decision
+func (a *PendingAction) IsPending() bool {
+	return a.Status == PendingActionStatusPending
+}
+
+// IsExpired returns true if the action can no longer be decided at the given time
+func (a *PendingAction) IsExpired(at time.Time) bool {
+	return !a.ExpiresAt.After(at)
+}
+
+// Approve records a second admin's decision to carry out the action
+func (a *PendingAction) Approve(reviewedBy uuid.UUID, reason string, at time.Time) {
+	a.decide(PendingActionStatusApproved, &reviewedBy, reason, at)
+}
+
+// Reject records a second admin's decision not to carry out the action
+func (a *PendingAction) Reject(reviewedBy uuid.UUID, reason string, at time.Time) {
+	a.decide(PendingActionStatusRejected, &reviewedBy, reason, at)
+}
+
+// Expire closes an action that was not decided in time
+func (a *PendingAction) Expire(at time.Time) {
+	a.decide(PendingActionStatusExpired, nil, "", at)
+}
+
+// Fail records why an approved action could not be carried out
+func (a *PendingAction) Fail(reason string) {
+	a.FailureReason = &reason
+}
+
+func (a *PendingAction) decide(status string, reviewedBy *uuid.UUID, reason string, at time.Time) {
+	a.Status = status
+	a.ReviewedBy = reviewedBy
+	a.ReviewedAt = &at
+	if reason != "" {
+		a.DecisionReason = &reason
+	}
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingAction_Validate(t *testing.T) {
+	adminID := uuid.New()
+	customerID := uuid.New().String()
+
+	assert.NoError(t, (&PendingAction{Status: PendingActionStatusPending, ActionType: PendingActionDeleteCustomer, ResourceID: customerID, RequestedBy: adminID}).Validate())
+
+	assert.ErrorIs(t, (&PendingAction{Status: "queued", ActionType: PendingActionDeleteCustomer, ResourceID: customerID, RequestedBy: adminID}).Validate(), ErrInvalidPendingActionStatus)
+	assert.ErrorIs(t, (&PendingAction{Status: PendingActionStatusPending, ActionType: "close_account", ResourceID: customerID, RequestedBy: adminID}).Validate(), ErrInvalidPendingActionType)
+	assert.Error(t, (&PendingAction{Status: PendingActionStatusPending, ActionType: PendingActionDeleteCustomer, RequestedBy: adminID}).Validate())
+	assert.Error(t, (&PendingAction{Status: PendingActionStatusPending, ActionType: PendingActionDeleteCustomer, ResourceID: customerID}).Validate())
+}
+
+func TestPendingAction_Decisions(t *testing.T) {
+	now := time.Now()
+	checkerID := uuid.New()
+
+	action := &PendingAction{Status: PendingActionStatusPending, ExpiresAt: now.Add(time.Hour)}
+	assert.True(t, action.IsPending())
+	assert.False(t, action.IsExpired(now))
+	assert.True(t, action.IsExpired(now.Add(time.Hour)))
+
+	action.Approve(checkerID, "Verified closure request", now)
+	assert.Equal(t, PendingActionStatusApproved, action.Status)
+	assert.Equal(t, checkerID, *action.ReviewedBy)
+	assert.Equal(t, "Verified closure request", *action.DecisionReason)
+	assert.False(t, action.IsPending())
+
+	action = &PendingAction{Status: PendingActionStatusPending}
+	action.Reject(checkerID, "Customer still has a dispute open", now)
+	assert.Equal(t, PendingActionStatusRejected, action.Status)
+
+	action = &PendingAction{Status: PendingActionStatusPending}
+	action.Expire(now)
+	assert.Equal(t, PendingActionStatusExpired, action.Status)
+	assert.Nil(t, action.ReviewedBy)
+	assert.Nil(t, action.DecisionReason)
+	assert.Equal(t, now, *action.ReviewedAt)
+}
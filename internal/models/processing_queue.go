@@ -1,6 +1,9 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,11 +13,13 @@ import (
 const (
 	QueueOperationProcess = "process"
 	QueueOperationReverse = "reverse"
+	QueueOperationExpire  = "expire"
 
 	QueueStatusPending    = "pending"
 	QueueStatusProcessing = "processing"
 	QueueStatusCompleted  = "completed"
 	QueueStatusFailed     = "failed"
+	QueueStatusPurged     = "purged"
 
 	QueuePriorityNormal = 100
 	QueuePriorityHigh   = 200
@@ -32,8 +37,21 @@ type ProcessingQueueItem struct {
 	ProcessedAt   *time.Time `json:"processed_at,omitempty"`
 	ErrorMessage  string     `gorm:"type:text" json:"error_message,omitempty"`
 	Metadata      string     `gorm:"type:jsonb" json:"metadata,omitempty"`
-	CreatedAt     time.Time  `gorm:"not null" json:"created_at"`
-	UpdatedAt     time.Time  `gorm:"not null" json:"updated_at"`
+
+	// WorkerID and LeaseExpiresAt are set while a worker holds the item in processing.
+	// An item whose lease expires is returned to pending by the lease reaper.
+	WorkerID       string     `gorm:"type:varchar(100)" json:"worker_id,omitempty"`
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
+
+	// RetryHistory records every failed attempt, including those before a replay
+	RetryHistory QueueAttempts `gorm:"type:jsonb" json:"retry_history,omitempty"`
+	ReplayCount  int           `gorm:"not null;default:0" json:"replay_count"`
+	PurgedAt     *time.Time    `json:"purged_at,omitempty"`
+	PurgeReason  string        `gorm:"type:text" json:"purge_reason,omitempty"`
+	PurgedBy     *uuid.UUID    `gorm:"type:uuid" json:"purged_by,omitempty"`
+
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
 
 	Transaction Transaction `gorm:"foreignKey:TransactionID;constraint:OnDelete:CASCADE" json:"-"`
 }
@@ -57,3 +75,70 @@ func (q *ProcessingQueueItem) CalculateNextScheduledTime() time.Time {
 func (q *ProcessingQueueItem) CanRetry() bool {
 	return q.RetryCount < q.MaxRetries
 }
+
+// IsValidQueueOperation checks if the operation is one the processing queue handles
+func IsValidQueueOperation(operation string) bool {
+	switch operation {
+	case QueueOperationProcess, QueueOperationReverse, QueueOperationExpire:
+		return true
+	default:
+		return false
+	}
+}
+
+// RecordAttempt appends a failed attempt to the retry history
+func (q *ProcessingQueueItem) RecordAttempt(errorMessage string) {
+	q.RetryHistory = append(q.RetryHistory, QueueAttempt{
+		Attempt:  len(q.RetryHistory) + 1,
+		Error:    errorMessage,
+		FailedAt: time.Now(),
+	})
+}
+
+// QueueAttempt is a failed attempt to process a queue item
+type QueueAttempt struct {
+	Attempt  int       `json:"attempt"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// QueueAttempts is the retry history of a queue item
+type QueueAttempts []QueueAttempt
+
+// Value implements driver.Valuer interface
+func (a QueueAttempts) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal([]QueueAttempt(a))
+	if err != nil {
+		return nil, err
+	}
+	// Return string for SQLite compatibility
+	return string(bytes), nil
+}
+
+// Scan implements sql.Scanner interface
+func (a *QueueAttempts) Scan(value interface{}) error {
+	if value == nil {
+		*a = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into QueueAttempts", value)
+	}
+
+	if len(bytes) == 0 {
+		*a = nil
+		return nil
+	}
+
+	return json.Unmarshal(bytes, (*[]QueueAttempt)(a))
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// QueueItemFilters contains filter criteria for failed queue item queries.
+// FailedBefore and FailedAfter bound the time the item was marked failed.
+type QueueItemFilters struct {
+	Operation    string
+	FailedBefore *time.Time
+	FailedAfter  *time.Time
+}
@@ -0,0 +1,189 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Recategorization job statuses
+const (
+	RecategorizationStatusPending   = "pending"
+	RecategorizationStatusRunning   = "running"
+	RecategorizationStatusCompleted = "completed"
+	RecategorizationStatusFailed    = "failed"
+	RecategorizationStatusCancelled = "cancelled"
+)
+
+var (
+	ErrInvalidRecategorizationStatus = errors.New("invalid recategorization job status")
+	ErrInvalidRecategorizationRange  = errors.New("start date must be before end date")
+	ErrInvalidBatchSize              = errors.New("batch size must be positive")
+)
+
+// RecategorizationJob tracks a backfill that re-runs the categorization rules over
+// historical transactions. The cursor columns checkpoint progress after every batch
+// so an interrupted job resumes where it stopped.
+type RecategorizationJob struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	Status      string     `gorm:"type:varchar(20);not null;index" json:"status"`
+	DryRun      bool       `gorm:"not null" json:"dry_run"`
+	AccountID   *uuid.UUID `gorm:"type:uuid" json:"account_id,omitempty"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	EndDate     *time.Time `json:"end_date,omitempty"`
+	BatchSize   int        `gorm:"not null" json:"batch_size"`
+	RequestedBy *uuid.UUID `gorm:"type:uuid" json:"requested_by,omitempty"`
+
+	// Checkpoint: the last transaction processed, in (created_at, id) order
+	CursorCreatedAt     *time.Time `json:"cursor_created_at,omitempty"`
+	CursorTransactionID *uuid.UUID `gorm:"type:uuid" json:"cursor_transaction_id,omitempty"`
+
+	ProcessedCount  int64                    `gorm:"not null;default:0" json:"processed_count"`
+	ChangedCount    int64                    `gorm:"not null;default:0" json:"changed_count"`
+	UnchangedCount  int64                    `gorm:"not null;default:0" json:"unchanged_count"`
+	OverriddenCount int64                    `gorm:"not null;default:0" json:"overridden_count"`
+	ConflictCount   int64                    `gorm:"not null;default:0" json:"conflict_count"`
+	Transitions     CategoryTransitionCounts `gorm:"type:jsonb" json:"transitions"`
+
+	ErrorMessage string     `gorm:"type:text" json:"error_message,omitempty"`
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	CreatedAt    time.Time  `gorm:"not null" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for RecategorizationJob
+func (j *RecategorizationJob) TableName() string {
+	return "recategorization_jobs"
+}
+
+// BeforeCreate hook for RecategorizationJob
+func (j *RecategorizationJob) BeforeCreate(tx *gorm.DB) error {
+	if j.ID == uuid.Nil {
+		j.ID = uuid.New()
+	}
+	if j.Status == "" {
+		j.Status = RecategorizationStatusPending
+	}
+	return j.Validate()
+}
+
+// BeforeUpdate hook for RecategorizationJob
+func (j *RecategorizationJob) BeforeUpdate(tx *gorm.DB) error {
+	return j.Validate()
+}
+
+// Validate validates the recategorization job fields
+func (j *RecategorizationJob) Validate() error {
+	switch j.Status {
+	case RecategorizationStatusPending, RecategorizationStatusRunning, RecategorizationStatusCompleted,
+		RecategorizationStatusFailed, RecategorizationStatusCancelled:
+	default:
+		return ErrInvalidRecategorizationStatus
+	}
+
+	if j.BatchSize <= 0 {
+		return ErrInvalidBatchSize
+	}
+
+	if j.StartDate != nil && j.EndDate != nil && !j.StartDate.Before(*j.EndDate) {
+		return ErrInvalidRecategorizationRange
+	}
+
+	return nil
+}
+
+// IsFinished reports whether the job has stopped and will not be picked up again
+func (j *RecategorizationJob) IsFinished() bool {
+	return j.Status == RecategorizationStatusCompleted ||
+		j.Status == RecategorizationStatusFailed ||
+		j.Status == RecategorizationStatusCancelled
+}
+
+// CanResume reports whether a stopped job can be queued again from its checkpoint
+func (j *RecategorizationJob) CanResume() bool {
+	return j.Status == RecategorizationStatusFailed || j.Status == RecategorizationStatusCancelled
+}
+
+// Checkpoint records the last transaction processed by the job
+func (j *RecategorizationJob) Checkpoint(last *Transaction) {
+	createdAt := last.CreatedAt
+	id := last.ID
+	j.CursorCreatedAt = &createdAt
+	j.CursorTransactionID = &id
+}
+
+// RecordTransition counts a transaction moving from one category to another
+func (j *RecategorizationJob) RecordTransition(from, to string) {
+	if j.Transitions == nil {
+		j.Transitions = CategoryTransitionCounts{}
+	}
+	j.Transitions[CategoryTransitionKey(from, to)]++
+	j.ChangedCount++
+}
+
+// CategoryTransitionKey formats the key used to count a category transition.
+// Uncategorized transactions are reported as coming from "NONE".
+func CategoryTransitionKey(from, to string) string {
+	if from == "" {
+		from = "NONE"
+	}
+	return from + "->" + to
+}
+
+// CategoryTransitionCounts maps "FROM->TO" category transitions to the number
+// of transactions that moved between them
+// swaggertype: object
+// additionalProperties: integer
+type CategoryTransitionCounts map[string]int64
+
+// Value implements driver.Valuer interface
+func (c CategoryTransitionCounts) Value() (driver.Value, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(map[string]int64(c))
+	if err != nil {
+		return nil, err
+	}
+	// Return string for SQLite compatibility
+	return string(bytes), nil
+}
+
+// Scan implements sql.Scanner interface
+func (c *CategoryTransitionCounts) Scan(value interface{}) error {
+	if value == nil {
+		*c = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into CategoryTransitionCounts", value)
+	}
+
+	if len(bytes) == 0 {
+		*c = nil
+		return nil
+	}
+
+	return json.Unmarshal(bytes, (*map[string]int64)(c))
+}
+
+// TransactionCategoryChange is a category update computed by a recategorization
+// job. ExpectedVersion guards against overwriting a concurrent change.
+type TransactionCategoryChange struct {
+	TransactionID   uuid.UUID
+	ExpectedVersion int
+	Category        string
+}
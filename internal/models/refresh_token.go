@@ -10,9 +10,11 @@ import (
 type RefreshToken struct {
 	ID        uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
 	UserID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	SessionID *uuid.UUID `gorm:"type:uuid;index" json:"session_id,omitempty"`
 	TokenHash string     `gorm:"type:varchar(255);not null;index" json:"-"`
 	ExpiresAt time.Time  `gorm:"not null;index" json:"expires_at"`
 	RevokedAt *time.Time `gorm:"index" json:"revoked_at,omitempty"`
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
 	CreatedAt time.Time  `gorm:"not null" json:"created_at"`
 
 	User User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
@@ -26,8 +28,13 @@ func (rt *RefreshToken) IsRevoked() bool {
 	return rt.RevokedAt != nil
 }
 
+// IsRotated returns true once the token has been exchanged for a new one
+func (rt *RefreshToken) IsRotated() bool {
+	return rt.RotatedAt != nil
+}
+
 func (rt *RefreshToken) IsValid() bool {
-	return !rt.IsExpired() && !rt.IsRevoked()
+	return !rt.IsExpired() && !rt.IsRevoked() && !rt.IsRotated()
 }
 
 func (rt *RefreshToken) Revoke() {
@@ -107,6 +107,14 @@ func TestRefreshToken_IsValid(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "rotated token",
+			token: RefreshToken{
+				ExpiresAt: time.Now().Add(time.Hour),
+				RotatedAt: &now,
+			},
+			valid: false,
+		},
 	}
 
 	for _, tt := range tests {
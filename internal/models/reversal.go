@@ -0,0 +1,92 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrTransactionAlreadyReversed = errors.New("transaction has already been reversed")
+	ErrTransactionNotReversible   = errors.New("only completed transactions can be reversed")
+)
+
+// reversalMetadataReason records in the offsetting transaction why it was reversed
+const reversalMetadataReason = "reversal_reason"
+
+// ReversalRequest is stored as the metadata of a queued reverse operation
+type ReversalRequest struct {
+	Reason      string    `json:"reason"`
+	RequestedBy uuid.UUID `json:"requested_by"`
+}
+
+// CheckReversible returns an error if the transaction cannot be reversed. Only
+// completed transactions that are not themselves reversals can be reversed, and
+// only once.
+func (t *Transaction) CheckReversible() error {
+	if t.Status == TransactionStatusReversed || t.ReversedAt != nil {
+		return ErrTransactionAlreadyReversed
+	}
+	if !t.IsCompleted() || t.IsReversal() {
+		return ErrTransactionNotReversible
+	}
+	return nil
+}
+
+// IsReversal returns true if the transaction offsets an earlier transaction
+func (t *Transaction) IsReversal() bool {
+	return t.ReversalOf != nil
+}
+
+// CountsTowardTotals returns true if the transaction is included in deposit,
+// withdrawal and category totals. A reversed transaction and its offset cancel out,
+// so neither is counted.
+func (t *Transaction) CountsTowardTotals() bool {
+	return t.IsCompleted() && !t.IsReversal()
+}
+
+// OffsettingType returns the transaction type that undoes this transaction
+func (t *Transaction) OffsettingType() string {
+	if t.TransactionType == TransactionTypeCredit {
+		return TransactionTypeDebit
+	}
+	return TransactionTypeCredit
+}
+
+// NewReversal builds the completed transaction that offsets t against the account's
+// current ledger balance. The offset is linked to t through ReversalOf.
+func (t *Transaction) NewReversal(balanceBefore decimal.Decimal, reason string) *Transaction {
+	originalID := t.ID
+	offset := &Transaction{
+		AccountID:       t.AccountID,
+		TransactionType: t.OffsettingType(),
+		Amount:          t.Amount,
+		BalanceBefore:   balanceBefore,
+		Description:     "Reversal: " + t.Description,
+		Status:          TransactionStatusCompleted,
+		Category:        t.Category,
+		MerchantName:    t.MerchantName,
+		Reference:       GenerateTransactionReference(),
+		ReversalOf:      &originalID,
+		ExchangeRate:    t.ExchangeRate,
+		Metadata:        JSONBMap{reversalMetadataReason: reason},
+	}
+
+	if offset.TransactionType == TransactionTypeCredit {
+		offset.BalanceAfter = balanceBefore.Add(t.Amount)
+	} else {
+		offset.BalanceAfter = balanceBefore.Sub(t.Amount)
+	}
+
+	return offset
+}
+
+// ReversalReason returns the reason recorded on an offsetting transaction
+func (t *Transaction) ReversalReason() string {
+	if t.Metadata == nil {
+		return ""
+	}
+	reason, _ := t.Metadata[reversalMetadataReason].(string)
+	return reason
+}
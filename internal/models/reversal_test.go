@@ -0,0 +1,55 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransaction_CheckReversible(t *testing.T) {
+	transaction := &Transaction{
+		ID:              uuid.New(),
+		AccountID:       uuid.New(),
+		TransactionType: TransactionTypeDebit,
+		Amount:          decimal.NewFromInt(40),
+		Status:          TransactionStatusCompleted,
+	}
+	assert.NoError(t, transaction.CheckReversible())
+
+	transaction.Status = TransactionStatusPending
+	assert.ErrorIs(t, transaction.CheckReversible(), ErrTransactionNotReversible)
+
+	transaction.ReverseWithReference("TXN-offset")
+	assert.ErrorIs(t, transaction.CheckReversible(), ErrTransactionAlreadyReversed)
+}
+
+func TestTransaction_NewReversal(t *testing.T) {
+	purchase := &Transaction{
+		ID:              uuid.New(),
+		AccountID:       uuid.New(),
+		TransactionType: TransactionTypeDebit,
+		Amount:          decimal.RequireFromString("40.00"),
+		BalanceBefore:   decimal.RequireFromString("100.00"),
+		BalanceAfter:    decimal.RequireFromString("60.00"),
+		Description:     "Debit Card Purchase - Grocery Store",
+		Status:          TransactionStatusCompleted,
+		Category:        "GROCERIES",
+	}
+
+	offset := purchase.NewReversal(decimal.RequireFromString("75.00"), "Duplicate charge")
+
+	assert.Equal(t, TransactionTypeCredit, offset.TransactionType)
+	assert.Equal(t, "115.00", offset.BalanceAfter.StringFixed(2))
+	assert.Equal(t, purchase.ID, *offset.ReversalOf)
+	assert.Equal(t, "Duplicate charge", offset.ReversalReason())
+	assert.Equal(t, "Reversal: Debit Card Purchase - Grocery Store", offset.Description)
+	assert.NoError(t, offset.Validate())
+
+	// An offset cannot itself be reversed and is left out of totals
+	assert.True(t, offset.IsReversal())
+	assert.False(t, offset.CountsTowardTotals())
+	assert.ErrorIs(t, offset.CheckReversible(), ErrTransactionNotReversible)
+	assert.True(t, purchase.CountsTowardTotals())
+}
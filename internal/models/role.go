@@ -0,0 +1,44 @@
+package models
+
+// Permissions checked by RequirePermission. Roles are granted permissions in the
+// role_permissions table, and access tokens carry the permissions of the user's
+// role at the time they were issued.
+const (
+	PermissionCustomersRead          = "customers:read"
+	PermissionCustomersWrite         = "customers:write"
+	PermissionCustomersResetPassword = "customers:reset_password"
+	PermissionSessionsRevoke         = "sessions:revoke"
+	PermissionMFAManage              = "mfa:manage"
+	PermissionTransactionsPost       = "transactions:post"
+	PermissionTransactionsManage     = "transactions:manage"
+	PermissionAuditRead              = "audit:read"
+	PermissionHoldsManage            = "holds:manage"
+	PermissionLimitsManage           = "limits:manage"
+	PermissionFraudReview            = "fraud:review"
+	PermissionQueueManage            = "queue:manage"
+	PermissionCategoriesManage       = "categories:manage"
+	PermissionInterestManage         = "interest:manage"
+	PermissionRolesManage            = "roles:manage"
+	PermissionApprovalsReview        = "approvals:review"
+)
+
+// Role is a named set of permissions a user can be assigned
+type Role struct {
+	Name        string       `gorm:"type:varchar(20);primary_key" json:"name"`
+	Description string       `gorm:"type:varchar(255);not null;default:''" json:"description"`
+	Permissions []Permission `gorm:"many2many:role_permissions;joinForeignKey:RoleName;joinReferences:PermissionName" json:"permissions"`
+}
+
+func (Role) TableName() string {
+	return "roles"
+}
+
+// Permission is an operation a role can be allowed to perform
+type Permission struct {
+	Name        string `gorm:"type:varchar(50);primary_key" json:"name"`
+	Description string `gorm:"type:varchar(255);not null;default:''" json:"description"`
+}
+
+func (Permission) TableName() string {
+	return "permissions"
+}
@@ -9,4 +9,8 @@ const (
 	SearchTypeName          SearchType = "name"
 	SearchTypeEmail         SearchType = "email"
 	SearchTypeAccountNumber SearchType = "account_number"
+
+	// SearchTypeFuzzy ranks customers by how closely their name or email resembles
+	// the query; digit-only terms match partial account numbers
+	SearchTypeFuzzy SearchType = "fuzzy"
 )
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Reasons a session was revoked
+const (
+	SessionRevokedLogout            = "logout"
+	SessionRevokedByUser            = "user_revoked"
+	SessionRevokedSignOutAll        = "signed_out_everywhere"
+	SessionRevokedByAdmin           = "admin_revoked"
+	SessionRevokedRefreshTokenReuse = "token_reuse"
+	SessionRevokedRoleChanged       = "role_changed"
+)
+
+// Session is a signed-in device. Each login starts a session, and the refresh
+// tokens rotated from that login form its token family. Revoking the session
+// revokes the family and the access tokens issued to it.
+type Session struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	DeviceName    string     `gorm:"type:varchar(100);not null;default:''" json:"device_name"`
+	IPAddress     string     `gorm:"type:varchar(45);not null;default:''" json:"ip_address"`
+	UserAgent     string     `gorm:"type:text;not null;default:''" json:"user_agent"`
+	ExpiresAt     time.Time  `gorm:"not null" json:"expires_at"`
+	LastUsedAt    time.Time  `gorm:"not null" json:"last_used_at"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty"`
+	RevokedReason *string    `gorm:"type:varchar(50)" json:"revoked_reason,omitempty"`
+	CreatedAt     time.Time  `gorm:"not null" json:"created_at"`
+}
+
+// TableName specifies the table name for Session
+func (s *Session) TableName() string {
+	return "sessions"
+}
+
+// BeforeCreate hook for Session
+func (s *Session) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+
+	now := time.Now()
+	if s.CreatedAt.IsZero() {
+		s.CreatedAt = now
+	}
+	if s.LastUsedAt.IsZero() {
+		s.LastUsedAt = now
+	}
+
+	return nil
+}
+
+// IsActive returns true if the session has not been revoked or expired
+func (s *Session) IsActive() bool {
+	return s.RevokedAt == nil && time.Now().Before(s.ExpiresAt)
+}
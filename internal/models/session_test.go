@@ -0,0 +1,16 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSession_IsActive(t *testing.T) {
+	now := time.Now()
+
+	assert.True(t, (&Session{ExpiresAt: now.Add(time.Hour)}).IsActive())
+	assert.False(t, (&Session{ExpiresAt: now.Add(-time.Hour)}).IsActive(), "expired")
+	assert.False(t, (&Session{ExpiresAt: now.Add(time.Hour), RevokedAt: &now}).IsActive(), "revoked")
+}
@@ -12,6 +12,7 @@ type AccountStatement struct {
 	AccountID          uuid.UUID              `json:"account_id"`
 	AccountNumber      string                 `json:"account_number"`
 	AccountType        string                 `json:"account_type"`
+	Currency           string                 `json:"currency"`
 	PeriodType         string                 `json:"period_type"`
 	Year               int                    `json:"year"`
 	Period             int                    `json:"period"`
@@ -28,27 +28,31 @@ var (
 
 // Transaction represents a bank transaction
 type Transaction struct {
-	ID                uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
-	AccountID         uuid.UUID       `gorm:"type:uuid;not null;index" json:"account_id"`
-	TransactionType   string          `gorm:"type:varchar(20);not null" json:"transaction_type"`
-	Amount            decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"amount"`
-	BalanceBefore     decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"balance_before"`
-	BalanceAfter      decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"balance_after"`
-	Description       string          `gorm:"type:text" json:"description"`
-	Reference         string          `gorm:"type:varchar(100);index" json:"reference,omitempty"`
-	Status            string          `gorm:"type:varchar(20);not null;default:'completed'" json:"status"`
-	Metadata          JSONBMap        `gorm:"type:jsonb" json:"metadata,omitempty"`
-	Category          string          `gorm:"type:varchar(50)" json:"category,omitempty"`
-	MerchantName      string          `gorm:"type:varchar(255)" json:"merchant_name,omitempty"`
-	MCCCode           string          `gorm:"type:varchar(10)" json:"mcc_code,omitempty"`
-	PendingUntil      *time.Time      `json:"pending_until,omitempty"`
-	ReversedAt        *time.Time      `json:"reversed_at,omitempty"`
-	ReversalReference string          `gorm:"type:varchar(100)" json:"reversal_reference,omitempty"`
-	ProcessingFee     decimal.Decimal `gorm:"type:decimal(15,2);default:0" json:"processing_fee"`
-	Version           int             `gorm:"default:1" json:"version"`
-	CreatedAt         time.Time       `gorm:"not null;index" json:"created_at"`
-	UpdatedAt         time.Time       `gorm:"not null" json:"updated_at"`
-	ProcessedAt       *time.Time      `json:"processed_at,omitempty"`
+	ID                   uuid.UUID        `gorm:"type:uuid;primary_key" json:"id"`
+	AccountID            uuid.UUID        `gorm:"type:uuid;not null;index" json:"account_id"`
+	TransactionType      string           `gorm:"type:varchar(20);not null" json:"transaction_type"`
+	Amount               decimal.Decimal  `gorm:"type:decimal(15,2);not null" json:"amount"`
+	BalanceBefore        decimal.Decimal  `gorm:"type:decimal(15,2);not null" json:"balance_before"`
+	BalanceAfter         decimal.Decimal  `gorm:"type:decimal(15,2);not null" json:"balance_after"`
+	Description          string           `gorm:"type:text" json:"description"`
+	Reference            string           `gorm:"type:varchar(100);index" json:"reference,omitempty"`
+	Status               string           `gorm:"type:varchar(20);not null;default:'completed'" json:"status"`
+	Metadata             JSONBMap         `gorm:"type:jsonb" json:"metadata,omitempty"`
+	Category             string           `gorm:"type:varchar(50)" json:"category,omitempty"`
+	MerchantName         string           `gorm:"type:varchar(255)" json:"merchant_name,omitempty"`
+	MCCCode              string           `gorm:"type:varchar(10)" json:"mcc_code,omitempty"`
+	CategoryOverriddenAt *time.Time       `gorm:"index" json:"category_overridden_at,omitempty"`
+	CategoryOverriddenBy *uuid.UUID       `gorm:"type:uuid" json:"category_overridden_by,omitempty"`
+	PendingUntil         *time.Time       `json:"pending_until,omitempty"`
+	ReversedAt           *time.Time       `json:"reversed_at,omitempty"`
+	ReversalReference    string           `gorm:"type:varchar(100)" json:"reversal_reference,omitempty"`
+	ReversalOf           *uuid.UUID       `gorm:"type:uuid;uniqueIndex" json:"reversal_of,omitempty"`
+	ProcessingFee        decimal.Decimal  `gorm:"type:decimal(15,2);default:0" json:"processing_fee"`
+	ExchangeRate         *decimal.Decimal `gorm:"type:decimal(20,10)" json:"exchange_rate,omitempty"`
+	Version              int              `gorm:"default:1" json:"version"`
+	CreatedAt            time.Time        `gorm:"not null;index" json:"created_at"`
+	UpdatedAt            time.Time        `gorm:"not null" json:"updated_at"`
+	ProcessedAt          *time.Time       `json:"processed_at,omitempty"`
 
 	// Associations
 	Account Account `gorm:"foreignKey:AccountID" json:"-"`
@@ -265,6 +269,17 @@ func (t *Transaction) CheckAndUpdateVersion(expectedVersion int) error {
 	return nil
 }
 
+// IsCategoryOverridden returns true if the category was set manually
+func (t *Transaction) IsCategoryOverridden() bool {
+	return t.CategoryOverriddenAt != nil
+}
+
+// MarkCategoryOverridden records who manually set the category and when
+func (t *Transaction) MarkCategoryOverridden(userID uuid.UUID, at time.Time) {
+	t.CategoryOverriddenAt = &at
+	t.CategoryOverriddenBy = &userID
+}
+
 // GetTotalAmount returns the total amount including processing fees
 func (t *Transaction) GetTotalAmount() decimal.Decimal {
 	if t.ProcessingFee.IsZero() {
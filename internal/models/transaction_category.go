@@ -0,0 +1,72 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCategoryCodeRequired = errors.New("category code is required")
+	ErrCategoryNameRequired = errors.New("category name is required")
+)
+
+// TransactionCategory represents a configurable transaction category
+type TransactionCategory struct {
+	ID                 uuid.UUID `gorm:"type:uuid;primary_key" json:"id"`
+	Code               string    `gorm:"type:varchar(50);not null;uniqueIndex" json:"code"`
+	Name               string    `gorm:"type:varchar(100);not null" json:"name"`
+	Description        string    `gorm:"type:text" json:"description,omitempty"`
+	ParentCategoryCode *string   `gorm:"type:varchar(50);index:idx_transaction_categories_parent_category" json:"parent_category_code,omitempty"`
+	Icon               string    `gorm:"type:varchar(50)" json:"icon,omitempty"`
+	IsActive           bool      `gorm:"not null;index:idx_transaction_categories_is_active" json:"is_active"`
+	DisplayOrder       int       `gorm:"not null;default:0" json:"display_order"`
+	CreatedAt          time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt          time.Time `gorm:"not null" json:"updated_at"`
+}
+
+// BeforeCreate hook for TransactionCategory
+func (c *TransactionCategory) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return c.Validate()
+}
+
+// BeforeUpdate hook for TransactionCategory
+func (c *TransactionCategory) BeforeUpdate(tx *gorm.DB) error {
+	return c.Validate()
+}
+
+// Validate validates the category fields
+func (c *TransactionCategory) Validate() error {
+	if c.Code == "" {
+		return ErrCategoryCodeRequired
+	}
+
+	if c.Name == "" {
+		return ErrCategoryNameRequired
+	}
+
+	return nil
+}
+
+// TableName returns the table name for TransactionCategory
+func (c *TransactionCategory) TableName() string {
+	return "transaction_categories"
+}
+
+// CategoryNode is a category with its nested child categories
+type CategoryNode struct {
+	TransactionCategory
+	Children []*CategoryNode `json:"children,omitempty"`
+}
+
+// CategoryPosition describes where a category sits in the hierarchy
+type CategoryPosition struct {
+	Code               string
+	ParentCategoryCode *string
+	DisplayOrder       int
+}
@@ -0,0 +1,251 @@
+package models
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Limit types. Withdrawal limits cap the total debited from an account in a UTC
+// calendar day or month; the single transfer limit caps each outgoing transfer;
+// the hourly limit caps the number of transactions posted in the past hour.
+const (
+	LimitTypeDailyWithdrawal    = "daily_withdrawal"
+	LimitTypeMonthlyWithdrawal  = "monthly_withdrawal"
+	LimitTypeSingleTransfer     = "single_transfer"
+	LimitTypeHourlyTransactions = "hourly_transactions"
+)
+
+// LimitTypes lists the limit types in the order they are reported
+var LimitTypes = []string{
+	LimitTypeDailyWithdrawal,
+	LimitTypeMonthlyWithdrawal,
+	LimitTypeSingleTransfer,
+	LimitTypeHourlyTransactions,
+}
+
+// Activities checked against an account's limits
+const (
+	LimitActivityDeposit    = "deposit"
+	LimitActivityWithdrawal = "withdrawal"
+	LimitActivityTransfer   = "transfer"
+)
+
+// Sources of an account's effective limit
+const (
+	LimitSourceAccountType = "account_type"
+	LimitSourceCustomer    = "customer"
+	LimitSourceOverride    = "override"
+	LimitSourceNone        = "none"
+)
+
+// MaxLimitOverrideDuration is the longest a temporary limit override may last
+const MaxLimitOverrideDuration = 30 * 24 * time.Hour
+
+var (
+	ErrInvalidLimitType  = errors.New("invalid limit type")
+	ErrInvalidLimitValue = errors.New("limit values must be positive")
+	ErrInvalidLimitScope = errors.New("limits apply to exactly one account type or customer")
+)
+
+// TransactionLimit configures the limits for every account of an account type, or
+// for every account of one customer. A customer's limits replace their account
+// type's limits field by field; a nil field falls back to the account type, and a
+// limit unset at both levels is unlimited. Amounts are in the account's currency.
+type TransactionLimit struct {
+	ID                     uuid.UUID        `gorm:"type:uuid;primary_key" json:"id"`
+	AccountType            *string          `gorm:"type:varchar(50);uniqueIndex" json:"account_type,omitempty"`
+	UserID                 *uuid.UUID       `gorm:"type:uuid;uniqueIndex" json:"user_id,omitempty"`
+	DailyWithdrawalLimit   *decimal.Decimal `gorm:"type:decimal(15,2)" json:"daily_withdrawal_limit"`
+	MonthlyWithdrawalLimit *decimal.Decimal `gorm:"type:decimal(15,2)" json:"monthly_withdrawal_limit"`
+	MaxSingleTransfer      *decimal.Decimal `gorm:"type:decimal(15,2)" json:"max_single_transfer"`
+	HourlyTransactionLimit *int             `json:"hourly_transaction_limit"`
+	UpdatedBy              *uuid.UUID       `gorm:"type:uuid" json:"updated_by,omitempty"`
+	CreatedAt              time.Time        `gorm:"not null" json:"created_at"`
+	UpdatedAt              time.Time        `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for TransactionLimit
+func (l *TransactionLimit) TableName() string {
+	return "transaction_limits"
+}
+
+// BeforeCreate hook for TransactionLimit
+func (l *TransactionLimit) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+
+	now := time.Now()
+	if l.CreatedAt.IsZero() {
+		l.CreatedAt = now
+	}
+	if l.UpdatedAt.IsZero() {
+		l.UpdatedAt = now
+	}
+
+	return l.Validate()
+}
+
+// BeforeUpdate hook for TransactionLimit
+func (l *TransactionLimit) BeforeUpdate(tx *gorm.DB) error {
+	l.UpdatedAt = time.Now()
+	return l.Validate()
+}
+
+// Validate validates the transaction limit fields
+func (l *TransactionLimit) Validate() error {
+	if (l.AccountType == nil) == (l.UserID == nil) {
+		return ErrInvalidLimitScope
+	}
+
+	for _, value := range []*decimal.Decimal{l.DailyWithdrawalLimit, l.MonthlyWithdrawalLimit, l.MaxSingleTransfer} {
+		if value != nil && !value.IsPositive() {
+			return ErrInvalidLimitValue
+		}
+	}
+
+	if l.HourlyTransactionLimit != nil && *l.HourlyTransactionLimit <= 0 {
+		return ErrInvalidLimitValue
+	}
+
+	return nil
+}
+
+// Value returns the configured value of a limit type, or nil if it is not set
+func (l *TransactionLimit) Value(limitType string) *decimal.Decimal {
+	switch limitType {
+	case LimitTypeDailyWithdrawal:
+		return l.DailyWithdrawalLimit
+	case LimitTypeMonthlyWithdrawal:
+		return l.MonthlyWithdrawalLimit
+	case LimitTypeSingleTransfer:
+		return l.MaxSingleTransfer
+	case LimitTypeHourlyTransactions:
+		if l.HourlyTransactionLimit == nil {
+			return nil
+		}
+		count := decimal.NewFromInt(int64(*l.HourlyTransactionLimit))
+		return &count
+	default:
+		return nil
+	}
+}
+
+// LimitOverride temporarily replaces one of an account's limits, for example to
+// let a customer make a one-off large purchase. It applies until it expires or is
+// revoked.
+type LimitOverride struct {
+	ID        uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
+	AccountID uuid.UUID       `gorm:"type:uuid;not null;index" json:"account_id"`
+	LimitType string          `gorm:"type:varchar(30);not null" json:"limit_type"`
+	Value     decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"value"`
+	Reason    string          `gorm:"type:text;not null" json:"reason"`
+	GrantedBy uuid.UUID       `gorm:"type:uuid;not null" json:"granted_by"`
+	ExpiresAt time.Time       `gorm:"not null" json:"expires_at"`
+	RevokedAt *time.Time      `json:"revoked_at,omitempty"`
+	RevokedBy *uuid.UUID      `gorm:"type:uuid" json:"revoked_by,omitempty"`
+	CreatedAt time.Time       `gorm:"not null" json:"created_at"`
+}
+
+// TableName specifies the table name for LimitOverride
+func (o *LimitOverride) TableName() string {
+	return "limit_overrides"
+}
+
+// BeforeCreate hook for LimitOverride
+func (o *LimitOverride) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = time.Now()
+	}
+
+	return o.Validate()
+}
+
+// Validate validates the limit override fields
+func (o *LimitOverride) Validate() error {
+	if !IsValidLimitType(o.LimitType) {
+		return ErrInvalidLimitType
+	}
+
+	if !o.Value.IsPositive() {
+		return ErrInvalidLimitValue
+	}
+
+	if o.LimitType == LimitTypeHourlyTransactions && !o.Value.IsInteger() {
+		return ErrInvalidLimitValue
+	}
+
+	if o.Reason == "" {
+		return errors.New("override reason is required")
+	}
+
+	return nil
+}
+
+// IsActive returns true if the override applies at the given time
+func (o *LimitOverride) IsActive(at time.Time) bool {
+	return o.RevokedAt == nil && o.ExpiresAt.After(at)
+}
+
+// Revoke ends the override before it expires
+func (o *LimitOverride) Revoke(revokedBy uuid.UUID, at time.Time) {
+	o.RevokedAt = &at
+	o.RevokedBy = &revokedBy
+}
+
+// LimitUsage reports one of an account's limits and how much of it has been used.
+// A nil limit is unlimited.
+type LimitUsage struct {
+	LimitType         string           `json:"limit_type"`
+	Limit             *decimal.Decimal `json:"limit"`
+	Used              decimal.Decimal  `json:"used"`
+	Remaining         *decimal.Decimal `json:"remaining"`
+	Source            string           `json:"source"`
+	OverrideID        *uuid.UUID       `json:"override_id,omitempty"`
+	OverrideExpiresAt *time.Time       `json:"override_expires_at,omitempty"`
+	ResetsAt          *time.Time       `json:"resets_at,omitempty"`
+}
+
+// AccountLimits reports an account's effective limits and current usage
+type AccountLimits struct {
+	AccountID   uuid.UUID    `json:"account_id"`
+	AccountType string       `json:"account_type"`
+	Currency    string       `json:"currency"`
+	Limits      []LimitUsage `json:"limits"`
+	GeneratedAt time.Time    `json:"generated_at"`
+}
+
+// Usage returns the usage of a limit type, or nil if it is not reported
+func (a *AccountLimits) Usage(limitType string) *LimitUsage {
+	for i := range a.Limits {
+		if a.Limits[i].LimitType == limitType {
+			return &a.Limits[i]
+		}
+	}
+	return nil
+}
+
+// IsValidLimitType checks if the limit type is supported
+func IsValidLimitType(limitType string) bool {
+	return slices.Contains(LimitTypes, limitType)
+}
+
+// StartOfDay returns midnight UTC of the day containing t
+func StartOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// StartOfMonth returns midnight UTC of the first day of the month containing t
+func StartOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionLimit_Validate(t *testing.T) {
+	accountType := AccountTypeChecking
+	userID := uuid.New()
+	daily := decimal.RequireFromString("1000")
+	zero := decimal.Zero
+	hourly := 0
+
+	assert.NoError(t, (&TransactionLimit{AccountType: &accountType, DailyWithdrawalLimit: &daily}).Validate())
+	assert.NoError(t, (&TransactionLimit{UserID: &userID}).Validate())
+
+	assert.ErrorIs(t, (&TransactionLimit{}).Validate(), ErrInvalidLimitScope)
+	assert.ErrorIs(t, (&TransactionLimit{AccountType: &accountType, UserID: &userID}).Validate(), ErrInvalidLimitScope)
+	assert.ErrorIs(t, (&TransactionLimit{AccountType: &accountType, MaxSingleTransfer: &zero}).Validate(), ErrInvalidLimitValue)
+	assert.ErrorIs(t, (&TransactionLimit{AccountType: &accountType, HourlyTransactionLimit: &hourly}).Validate(), ErrInvalidLimitValue)
+}
+
+func TestTransactionLimit_Value(t *testing.T) {
+	daily := decimal.RequireFromString("1000")
+	hourly := 5
+	limit := &TransactionLimit{DailyWithdrawalLimit: &daily, HourlyTransactionLimit: &hourly}
+
+	assert.True(t, limit.Value(LimitTypeDailyWithdrawal).Equal(daily))
+	assert.True(t, limit.Value(LimitTypeHourlyTransactions).Equal(decimal.NewFromInt(5)))
+	assert.Nil(t, limit.Value(LimitTypeMonthlyWithdrawal))
+	assert.Nil(t, limit.Value("weekly_withdrawal"))
+}
+
+func TestLimitOverride_Validate(t *testing.T) {
+	newOverride := func() *LimitOverride {
+		return &LimitOverride{
+			AccountID: uuid.New(),
+			LimitType: LimitTypeHourlyTransactions,
+			Value:     decimal.NewFromInt(20),
+			Reason:    "Payroll run",
+			GrantedBy: uuid.New(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	}
+
+	assert.NoError(t, newOverride().Validate())
+
+	override := newOverride()
+	override.LimitType = "weekly_withdrawal"
+	assert.ErrorIs(t, override.Validate(), ErrInvalidLimitType)
+
+	override = newOverride()
+	override.Value = decimal.RequireFromString("-1")
+	assert.ErrorIs(t, override.Validate(), ErrInvalidLimitValue)
+
+	override = newOverride()
+	override.Value = decimal.RequireFromString("20.5")
+	assert.ErrorIs(t, override.Validate(), ErrInvalidLimitValue)
+
+	override = newOverride()
+	override.Reason = ""
+	assert.Error(t, override.Validate())
+}
+
+func TestLimitOverride_IsActive(t *testing.T) {
+	now := time.Now()
+	override := &LimitOverride{ExpiresAt: now.Add(time.Hour)}
+
+	assert.True(t, override.IsActive(now))
+	assert.False(t, override.IsActive(now.Add(2*time.Hour)))
+
+	override.Revoke(uuid.New(), now)
+	assert.False(t, override.IsActive(now))
+}
+
+func TestLimitWindows(t *testing.T) {
+	at := time.Date(2024, time.March, 15, 22, 30, 0, 0, time.FixedZone("EST", -5*60*60))
+
+	assert.Equal(t, time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC), StartOfDay(at))
+	assert.Equal(t, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), StartOfMonth(at))
+}
@@ -14,6 +14,7 @@ const (
 	TransferStatusPending   = "pending"
 	TransferStatusCompleted = "completed"
 	TransferStatusFailed    = "failed"
+	TransferStatusReversed  = "reversed"
 )
 
 var (
@@ -23,20 +24,28 @@ var (
 
 // Transfer represents an account-to-account transfer
 type Transfer struct {
-	ID                  uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
-	FromAccountID       uuid.UUID       `gorm:"type:uuid;not null;index:idx_transfer_from_account" json:"from_account_id"`
-	ToAccountID         uuid.UUID       `gorm:"type:uuid;not null;index:idx_transfer_to_account" json:"to_account_id"`
-	Amount              decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"amount"`
-	Description         string          `gorm:"type:text;not null" json:"description"`
-	IdempotencyKey      string          `gorm:"type:varchar(255);uniqueIndex;not null" json:"idempotency_key"`
-	Status              string          `gorm:"type:varchar(20);not null;default:'pending';index:idx_transfer_status" json:"status"`
-	DebitTransactionID  *uuid.UUID      `gorm:"type:uuid;index" json:"debit_transaction_id,omitempty"`
-	CreditTransactionID *uuid.UUID      `gorm:"type:uuid;index" json:"credit_transaction_id,omitempty"`
-	ErrorMessage        *string         `gorm:"type:text" json:"error_message,omitempty"`
-	CreatedAt           time.Time       `gorm:"not null;index:idx_transfer_created_at" json:"created_at"`
-	UpdatedAt           time.Time       `gorm:"not null" json:"updated_at"`
-	CompletedAt         *time.Time      `json:"completed_at,omitempty"`
-	FailedAt            *time.Time      `json:"failed_at,omitempty"`
+	ID                  uuid.UUID        `gorm:"type:uuid;primary_key" json:"id"`
+	FromAccountID       uuid.UUID        `gorm:"type:uuid;not null;index:idx_transfer_from_account" json:"from_account_id"`
+	ToAccountID         uuid.UUID        `gorm:"type:uuid;not null;index:idx_transfer_to_account" json:"to_account_id"`
+	Amount              decimal.Decimal  `gorm:"type:decimal(15,2);not null" json:"amount"`
+	FromCurrency        string           `gorm:"type:varchar(3);not null;default:'USD'" json:"from_currency"`
+	ToCurrency          string           `gorm:"type:varchar(3);not null;default:'USD'" json:"to_currency"`
+	ConvertedAmount     *decimal.Decimal `gorm:"type:decimal(15,2)" json:"converted_amount,omitempty"`
+	ExchangeRate        *decimal.Decimal `gorm:"type:decimal(20,10)" json:"exchange_rate,omitempty"`
+	RateLockedAt        *time.Time       `json:"rate_locked_at,omitempty"`
+	Description         string           `gorm:"type:text;not null" json:"description"`
+	IdempotencyKey      string           `gorm:"type:varchar(255);uniqueIndex;not null" json:"idempotency_key"`
+	Status              string           `gorm:"type:varchar(20);not null;default:'pending';index:idx_transfer_status" json:"status"`
+	DebitTransactionID  *uuid.UUID       `gorm:"type:uuid;index" json:"debit_transaction_id,omitempty"`
+	CreditTransactionID *uuid.UUID       `gorm:"type:uuid;index" json:"credit_transaction_id,omitempty"`
+	ErrorMessage        *string          `gorm:"type:text" json:"error_message,omitempty"`
+	ScheduleID          *uuid.UUID       `gorm:"type:uuid;index" json:"schedule_id,omitempty"`
+	ScheduleOccurrence  *int             `json:"schedule_occurrence,omitempty"`
+	CreatedAt           time.Time        `gorm:"not null;index:idx_transfer_created_at" json:"created_at"`
+	UpdatedAt           time.Time        `gorm:"not null" json:"updated_at"`
+	CompletedAt         *time.Time       `json:"completed_at,omitempty"`
+	FailedAt            *time.Time       `json:"failed_at,omitempty"`
+	ReversedAt          *time.Time       `json:"reversed_at,omitempty"`
 
 	// Associations
 	FromAccount       Account      `gorm:"foreignKey:FromAccountID" json:"-"`
@@ -55,6 +64,13 @@ func (t *Transfer) BeforeCreate(tx *gorm.DB) error {
 		t.Status = TransferStatusPending
 	}
 
+	if t.FromCurrency == "" {
+		t.FromCurrency = DefaultCurrency
+	}
+	if t.ToCurrency == "" {
+		t.ToCurrency = DefaultCurrency
+	}
+
 	now := time.Now()
 	if t.CreatedAt.IsZero() {
 		t.CreatedAt = now
@@ -137,12 +153,44 @@ func (t *Transfer) Fail(errorMessage string) {
 	t.ErrorMessage = &errorMessage
 }
 
+// LockRate records the exchange rate a cross-currency transfer is executed at and
+// the amount credited to the destination account
+func (t *Transfer) LockRate(rate *ExchangeRate) {
+	exchangeRate := rate.Rate
+	converted := rate.Convert(t.Amount)
+	now := time.Now()
+	t.ExchangeRate = &exchangeRate
+	t.ConvertedAmount = &converted
+	t.RateLockedAt = &now
+}
+
+// IsCrossCurrency returns true if the accounts of the transfer hold different currencies
+func (t *Transfer) IsCrossCurrency() bool {
+	return t.FromCurrency != t.ToCurrency
+}
+
+// CreditAmount returns the amount credited to the destination account
+func (t *Transfer) CreditAmount() decimal.Decimal {
+	if t.ConvertedAmount != nil {
+		return *t.ConvertedAmount
+	}
+	return t.Amount
+}
+
+// Reverse marks a completed transfer as reversed once both legs have been offset
+func (t *Transfer) Reverse() {
+	t.Status = TransferStatusReversed
+	now := time.Now()
+	t.ReversedAt = &now
+}
+
 // CanTransitionTo checks if a transfer can transition to a new status
 func (t *Transfer) CanTransitionTo(newStatus string) bool {
 	validTransitions := map[string][]string{
 		TransferStatusPending:   {TransferStatusCompleted, TransferStatusFailed},
-		TransferStatusCompleted: {},
+		TransferStatusCompleted: {TransferStatusReversed},
 		TransferStatusFailed:    {},
+		TransferStatusReversed:  {},
 	}
 
 	allowedStatuses, exists := validTransitions[t.Status]
@@ -163,7 +211,7 @@ func (t *Transfer) TableName() string {
 // IsValidTransferStatus checks if the transfer status is valid
 func IsValidTransferStatus(status string) bool {
 	switch status {
-	case TransferStatusPending, TransferStatusCompleted, TransferStatusFailed:
+	case TransferStatusPending, TransferStatusCompleted, TransferStatusFailed, TransferStatusReversed:
 		return true
 	default:
 		return false
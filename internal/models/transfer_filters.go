@@ -9,4 +9,5 @@ type TransferFilters struct {
 	ToAccountID   *uuid.UUID
 	MinAmount     *string
 	MaxAmount     *string
+	ScheduleID    *uuid.UUID
 }
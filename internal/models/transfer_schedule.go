@@ -0,0 +1,228 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Transfer schedule frequencies
+const (
+	TransferFrequencyWeekly     = "weekly"
+	TransferFrequencyBiweekly   = "biweekly"
+	TransferFrequencyMonthly    = "monthly"
+	TransferFrequencyDayOfMonth = "day_of_month"
+)
+
+// Transfer schedule statuses
+const (
+	TransferScheduleStatusActive    = "active"
+	TransferScheduleStatusPaused    = "paused"
+	TransferScheduleStatusCancelled = "cancelled"
+	TransferScheduleStatusCompleted = "completed"
+)
+
+var (
+	ErrInvalidTransferFrequency      = errors.New("invalid transfer schedule frequency")
+	ErrInvalidTransferScheduleStatus = errors.New("invalid transfer schedule status")
+	ErrInvalidDayOfMonth             = errors.New("day of month must be between 1 and 31")
+	ErrInvalidTransferScheduleEnd    = errors.New("end date must not be before start date")
+	ErrInvalidMaxOccurrences         = errors.New("occurrence count must be positive")
+)
+
+// TransferSchedule is a standing order that repeats an account-to-account transfer.
+// Occurrences are numbered from 1 and their dates are derived from the start date,
+// so a monthly schedule starting on the 31st runs on the last day of shorter months
+// without drifting.
+type TransferSchedule struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
+	UserID         uuid.UUID       `gorm:"type:uuid;not null;index" json:"user_id"`
+	FromAccountID  uuid.UUID       `gorm:"type:uuid;not null" json:"from_account_id"`
+	ToAccountID    uuid.UUID       `gorm:"type:uuid;not null" json:"to_account_id"`
+	Amount         decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"amount"`
+	Description    string          `gorm:"type:text;not null" json:"description"`
+	Frequency      string          `gorm:"type:varchar(20);not null" json:"frequency"`
+	DayOfMonth     *int            `json:"day_of_month,omitempty"`
+	StartDate      time.Time       `gorm:"not null" json:"start_date"`
+	EndDate        *time.Time      `json:"end_date,omitempty"`
+	MaxOccurrences *int            `json:"max_occurrences,omitempty"`
+	Status         string          `gorm:"type:varchar(20);not null;index:idx_transfer_schedules_due,priority:1" json:"status"`
+
+	// NextOccurrence is the number of the next occurrence to run; skipped
+	// occurrences are not counted in OccurrencesRun
+	NextOccurrence int        `gorm:"not null;default:1" json:"next_occurrence"`
+	OccurrencesRun int        `gorm:"not null;default:0" json:"occurrences_run"`
+	NextRunAt      *time.Time `gorm:"index:idx_transfer_schedules_due,priority:2" json:"next_run_at,omitempty"`
+	LastRunAt      *time.Time `json:"last_run_at,omitempty"`
+
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for TransferSchedule
+func (s *TransferSchedule) TableName() string {
+	return "transfer_schedules"
+}
+
+// BeforeCreate hook for TransferSchedule
+func (s *TransferSchedule) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	if s.Status == "" {
+		s.Status = TransferScheduleStatusActive
+	}
+	if s.NextOccurrence == 0 {
+		s.NextOccurrence = 1
+	}
+	return s.Validate()
+}
+
+// BeforeUpdate hook for TransferSchedule
+func (s *TransferSchedule) BeforeUpdate(tx *gorm.DB) error {
+	return s.Validate()
+}
+
+// Validate validates the transfer schedule fields
+func (s *TransferSchedule) Validate() error {
+	if s.UserID == uuid.Nil {
+		return errors.New("user ID is required")
+	}
+
+	if s.FromAccountID == uuid.Nil || s.ToAccountID == uuid.Nil {
+		return errors.New("from and to account IDs are required")
+	}
+
+	if s.FromAccountID == s.ToAccountID {
+		return errors.New("from and to accounts cannot be the same")
+	}
+
+	if s.Amount.LessThanOrEqual(decimal.Zero) {
+		return ErrInvalidTransferAmount
+	}
+
+	if s.Description == "" {
+		return errors.New("description is required")
+	}
+
+	if !IsValidTransferFrequency(s.Frequency) {
+		return ErrInvalidTransferFrequency
+	}
+
+	if s.Frequency == TransferFrequencyDayOfMonth {
+		if s.DayOfMonth == nil || *s.DayOfMonth < 1 || *s.DayOfMonth > 31 {
+			return ErrInvalidDayOfMonth
+		}
+	}
+
+	if s.StartDate.IsZero() {
+		return errors.New("start date is required")
+	}
+
+	if s.EndDate != nil && s.EndDate.Before(s.StartDate) {
+		return ErrInvalidTransferScheduleEnd
+	}
+
+	if s.MaxOccurrences != nil && *s.MaxOccurrences < 1 {
+		return ErrInvalidMaxOccurrences
+	}
+
+	switch s.Status {
+	case TransferScheduleStatusActive, TransferScheduleStatusPaused,
+		TransferScheduleStatusCancelled, TransferScheduleStatusCompleted:
+	default:
+		return ErrInvalidTransferScheduleStatus
+	}
+
+	return nil
+}
+
+// IsFinished reports whether the schedule will not run again
+func (s *TransferSchedule) IsFinished() bool {
+	return s.Status == TransferScheduleStatusCancelled || s.Status == TransferScheduleStatusCompleted
+}
+
+// OccurrenceDate returns the date of the given occurrence, numbered from 1
+func (s *TransferSchedule) OccurrenceDate(occurrence int) time.Time {
+	n := occurrence - 1
+
+	switch s.Frequency {
+	case TransferFrequencyWeekly:
+		return s.StartDate.AddDate(0, 0, 7*n)
+	case TransferFrequencyBiweekly:
+		return s.StartDate.AddDate(0, 0, 14*n)
+	case TransferFrequencyDayOfMonth:
+		offset := 0
+		if addMonthsClamped(s.StartDate, 0, *s.DayOfMonth).Before(s.StartDate) {
+			offset = 1
+		}
+		return addMonthsClamped(s.StartDate, offset+n, *s.DayOfMonth)
+	default:
+		return addMonthsClamped(s.StartDate, n, s.StartDate.Day())
+	}
+}
+
+// OccurrenceKey returns the idempotency key for an occurrence. It is deterministic
+// so a retried occurrence can never transfer twice.
+func (s *TransferSchedule) OccurrenceKey(occurrence int) string {
+	return fmt.Sprintf("schedule:%s:%d", s.ID, occurrence)
+}
+
+// ScheduleNext sets NextRunAt from NextOccurrence, completing the schedule once
+// the occurrence count or end date is reached
+func (s *TransferSchedule) ScheduleNext() {
+	next := s.OccurrenceDate(s.NextOccurrence)
+
+	if (s.MaxOccurrences != nil && s.OccurrencesRun >= *s.MaxOccurrences) ||
+		(s.EndDate != nil && next.After(*s.EndDate)) {
+		s.Status = TransferScheduleStatusCompleted
+		s.NextRunAt = nil
+		return
+	}
+
+	s.NextRunAt = &next
+}
+
+// RecordRun advances the schedule past an occurrence that has run
+func (s *TransferSchedule) RecordRun(ranAt time.Time) {
+	s.OccurrencesRun++
+	s.NextOccurrence++
+	s.LastRunAt = &ranAt
+	s.ScheduleNext()
+}
+
+// SkipMissed moves the schedule to its first occurrence on or after now. Occurrences
+// dated while the schedule was paused are skipped rather than paid late.
+func (s *TransferSchedule) SkipMissed(now time.Time) {
+	for s.OccurrenceDate(s.NextOccurrence).Before(now) {
+		if s.EndDate != nil && s.OccurrenceDate(s.NextOccurrence).After(*s.EndDate) {
+			break
+		}
+		s.NextOccurrence++
+	}
+	s.ScheduleNext()
+}
+
+// addMonthsClamped returns the given day of the month that is months after t,
+// clamped to the last day of that month, keeping t's time of day
+func addMonthsClamped(t time.Time, months, day int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// IsValidTransferFrequency checks if the transfer schedule frequency is valid
+func IsValidTransferFrequency(frequency string) bool {
+	switch frequency {
+	case TransferFrequencyWeekly, TransferFrequencyBiweekly, TransferFrequencyMonthly, TransferFrequencyDayOfMonth:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,208 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// TransferScheduleTestSuite is the test suite for TransferSchedule model
+type TransferScheduleTestSuite struct {
+	suite.Suite
+}
+
+// TestTransferScheduleTestSuite runs the test suite
+func TestTransferScheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferScheduleTestSuite))
+}
+
+// newTestSchedule builds a valid active schedule starting on the given date
+func newTestSchedule(frequency string, start time.Time) *TransferSchedule {
+	return &TransferSchedule{
+		UserID:         uuid.New(),
+		FromAccountID:  uuid.New(),
+		ToAccountID:    uuid.New(),
+		Amount:         decimal.NewFromInt(50),
+		Description:    "Rent",
+		Frequency:      frequency,
+		StartDate:      start,
+		Status:         TransferScheduleStatusActive,
+		NextOccurrence: 1,
+	}
+}
+
+func scheduleDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+}
+
+// TestOccurrenceDate tests the date of the first occurrences for each frequency
+func (s *TransferScheduleTestSuite) TestOccurrenceDate() {
+	tests := []struct {
+		name       string
+		frequency  string
+		start      time.Time
+		dayOfMonth int
+		expected   []time.Time
+	}{
+		{
+			name:      "weekly",
+			frequency: TransferFrequencyWeekly,
+			start:     scheduleDate(2025, time.January, 1),
+			expected:  []time.Time{scheduleDate(2025, time.January, 1), scheduleDate(2025, time.January, 8), scheduleDate(2025, time.January, 15)},
+		},
+		{
+			name:      "biweekly",
+			frequency: TransferFrequencyBiweekly,
+			start:     scheduleDate(2025, time.January, 1),
+			expected:  []time.Time{scheduleDate(2025, time.January, 1), scheduleDate(2025, time.January, 15), scheduleDate(2025, time.January, 29)},
+		},
+		{
+			name:      "monthly clamps to short months without drifting",
+			frequency: TransferFrequencyMonthly,
+			start:     scheduleDate(2025, time.January, 31),
+			expected:  []time.Time{scheduleDate(2025, time.January, 31), scheduleDate(2025, time.February, 28), scheduleDate(2025, time.March, 31)},
+		},
+		{
+			name:       "day of month starts in the following month when the day has passed",
+			frequency:  TransferFrequencyDayOfMonth,
+			start:      scheduleDate(2025, time.January, 20),
+			dayOfMonth: 15,
+			expected:   []time.Time{scheduleDate(2025, time.February, 15), scheduleDate(2025, time.March, 15), scheduleDate(2025, time.April, 15)},
+		},
+		{
+			name:       "day of month clamps to the last day",
+			frequency:  TransferFrequencyDayOfMonth,
+			start:      scheduleDate(2024, time.January, 1),
+			dayOfMonth: 30,
+			expected:   []time.Time{scheduleDate(2024, time.January, 30), scheduleDate(2024, time.February, 29), scheduleDate(2024, time.March, 30)},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			schedule := newTestSchedule(tt.frequency, tt.start)
+			if tt.dayOfMonth != 0 {
+				schedule.DayOfMonth = &tt.dayOfMonth
+			}
+
+			for i, expected := range tt.expected {
+				assert.Equal(s.T(), expected, schedule.OccurrenceDate(i+1), "occurrence %d", i+1)
+			}
+		})
+	}
+}
+
+// TestRecordRun_CompletesAfterMaxOccurrences tests that the schedule stops after its occurrence count
+func (s *TransferScheduleTestSuite) TestRecordRun_CompletesAfterMaxOccurrences() {
+	schedule := newTestSchedule(TransferFrequencyWeekly, scheduleDate(2025, time.January, 1))
+	maxOccurrences := 2
+	schedule.MaxOccurrences = &maxOccurrences
+	schedule.ScheduleNext()
+
+	schedule.RecordRun(scheduleDate(2025, time.January, 1))
+	assert.Equal(s.T(), TransferScheduleStatusActive, schedule.Status)
+	assert.Equal(s.T(), scheduleDate(2025, time.January, 8), *schedule.NextRunAt)
+
+	schedule.RecordRun(scheduleDate(2025, time.January, 8))
+	assert.Equal(s.T(), TransferScheduleStatusCompleted, schedule.Status)
+	assert.Nil(s.T(), schedule.NextRunAt)
+	assert.Equal(s.T(), 2, schedule.OccurrencesRun)
+}
+
+// TestRecordRun_CompletesAtEndDate tests that no occurrence is scheduled after the end date
+func (s *TransferScheduleTestSuite) TestRecordRun_CompletesAtEndDate() {
+	schedule := newTestSchedule(TransferFrequencyMonthly, scheduleDate(2025, time.January, 10))
+	endDate := scheduleDate(2025, time.February, 20)
+	schedule.EndDate = &endDate
+	schedule.ScheduleNext()
+
+	schedule.RecordRun(scheduleDate(2025, time.January, 10))
+	assert.Equal(s.T(), TransferScheduleStatusActive, schedule.Status)
+
+	schedule.RecordRun(scheduleDate(2025, time.February, 10))
+	assert.Equal(s.T(), TransferScheduleStatusCompleted, schedule.Status)
+}
+
+// TestSkipMissed tests that occurrences dated while paused are skipped and not counted
+func (s *TransferScheduleTestSuite) TestSkipMissed() {
+	schedule := newTestSchedule(TransferFrequencyWeekly, scheduleDate(2025, time.January, 1))
+	schedule.ScheduleNext()
+
+	schedule.SkipMissed(scheduleDate(2025, time.January, 20))
+
+	assert.Equal(s.T(), 4, schedule.NextOccurrence)
+	assert.Equal(s.T(), 0, schedule.OccurrencesRun)
+	assert.Equal(s.T(), scheduleDate(2025, time.January, 22), *schedule.NextRunAt)
+}
+
+// TestOccurrenceKey_IsDeterministic tests that the idempotency key only depends on the schedule and occurrence
+func (s *TransferScheduleTestSuite) TestOccurrenceKey_IsDeterministic() {
+	schedule := newTestSchedule(TransferFrequencyWeekly, scheduleDate(2025, time.January, 1))
+	schedule.ID = uuid.MustParse("3f1c2a9e-3d7b-4d59-9a53-0c1b5f2f6a10")
+
+	assert.Equal(s.T(), "schedule:3f1c2a9e-3d7b-4d59-9a53-0c1b5f2f6a10:3", schedule.OccurrenceKey(3))
+	assert.Equal(s.T(), schedule.OccurrenceKey(3), schedule.OccurrenceKey(3))
+}
+
+// TestValidate tests schedule validation
+func (s *TransferScheduleTestSuite) TestValidate() {
+	tests := []struct {
+		name     string
+		modify   func(*TransferSchedule)
+		expected error
+	}{
+		{
+			name:   "valid schedule",
+			modify: func(*TransferSchedule) {},
+		},
+		{
+			name:     "invalid frequency",
+			modify:   func(t *TransferSchedule) { t.Frequency = "daily" },
+			expected: ErrInvalidTransferFrequency,
+		},
+		{
+			name:     "day of month missing",
+			modify:   func(t *TransferSchedule) { t.Frequency = TransferFrequencyDayOfMonth },
+			expected: ErrInvalidDayOfMonth,
+		},
+		{
+			name: "end date before start date",
+			modify: func(t *TransferSchedule) {
+				end := t.StartDate.AddDate(0, 0, -1)
+				t.EndDate = &end
+			},
+			expected: ErrInvalidTransferScheduleEnd,
+		},
+		{
+			name: "zero occurrences",
+			modify: func(t *TransferSchedule) {
+				zero := 0
+				t.MaxOccurrences = &zero
+			},
+			expected: ErrInvalidMaxOccurrences,
+		},
+		{
+			name:     "non-positive amount",
+			modify:   func(t *TransferSchedule) { t.Amount = decimal.Zero },
+			expected: ErrInvalidTransferAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			schedule := newTestSchedule(TransferFrequencyMonthly, scheduleDate(2025, time.January, 1))
+			tt.modify(schedule)
+
+			err := schedule.Validate()
+			if tt.expected == nil {
+				assert.NoError(s.T(), err)
+			} else {
+				assert.ErrorIs(s.T(), err, tt.expected)
+			}
+		})
+	}
+}
@@ -14,6 +14,11 @@ const (
 	RoleCustomer = "customer"
 	RoleAdmin    = "admin"
 
+	// Staff roles with a subset of the admin's permissions
+	RoleSupport    = "support"
+	RoleTeller     = "teller"
+	RoleCompliance = "compliance"
+
 	MaxFailedLoginAttempts = 3
 )
 
@@ -31,6 +36,7 @@ type User struct {
 	FailedLoginAttempts int        `gorm:"default:0" json:"-"`
 	LockedAt            *time.Time `gorm:"index" json:"locked_at,omitempty"`
 	LastLoginAt         *time.Time      `gorm:"index" json:"last_login_at,omitempty"`
+	MFARequired         bool            `gorm:"not null;default:false" json:"mfa_required"`
 	CreatedAt           time.Time       `gorm:"not null" json:"created_at"`
 	UpdatedAt           time.Time       `gorm:"not null" json:"updated_at"`
 	DeletedAt           gorm.DeletedAt  `gorm:"index" json:"deleted_at,omitempty"`
@@ -88,8 +94,9 @@ func (u *User) Validate() error {
 		return errors.New("last name is required")
 	}
 
-	if u.Role != RoleCustomer && u.Role != RoleAdmin {
-		return fmt.Errorf("invalid role: %s", u.Role)
+	// Roles live in the roles table, which the users.role foreign key enforces
+	if u.Role == "" || len(u.Role) > 20 {
+		return fmt.Errorf("invalid role: %q", u.Role)
 	}
 
 	return nil
@@ -138,6 +145,12 @@ func (u *User) IsCustomer() bool {
 	return u.Role == RoleCustomer
 }
 
+// MustUseMFA reports whether the user has to sign in and step up with MFA: an
+// admin requires it, or the user is staff, whose roles grant staff permissions
+func (u *User) MustUseMFA() bool {
+	return u.MFARequired || !u.IsCustomer()
+}
+
 func (u *User) TableName() string {
 	return "users"
 }
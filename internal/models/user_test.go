@@ -71,12 +71,12 @@ func TestUser_Validate(t *testing.T) {
 			errMsg:  "last name is required",
 		},
 		{
-			name: "invalid role",
+			name: "missing role",
 			user: User{
 				Email:     "test@example.com",
 				FirstName: "John",
 				LastName:  "Doe",
-				Role:      "invalid",
+				Role:      "",
 			},
 			wantErr: true,
 			errMsg:  "invalid role",
@@ -227,4 +227,10 @@ func TestUser_UpdateLastLogin(t *testing.T) {
 
 	require.NotNil(t, user.LastLoginAt)
 	assert.True(t, user.LastLoginAt.After(firstLogin))
-}
\ No newline at end of file
+}
+func TestUser_MustUseMFA(t *testing.T) {
+	assert.False(t, (&User{Role: RoleCustomer}).MustUseMFA())
+	assert.True(t, (&User{Role: RoleCustomer, MFARequired: true}).MustUseMFA())
+	assert.True(t, (&User{Role: RoleTeller}).MustUseMFA())
+	assert.True(t, (&User{Role: RoleAdmin}).MustUseMFA())
+}
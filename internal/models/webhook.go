@@ -0,0 +1,359 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Webhook event types
+const (
+	WebhookEventTransactionCompleted = "transaction.completed"
+	WebhookEventTransferCompleted    = "transfer.completed"
+	WebhookEventTransferFailed       = "transfer.failed"
+	WebhookEventAccountClosed        = "account.closed"
+	WebhookEventCustomerEmailUpdated = "customer.email_updated"
+)
+
+// Webhook delivery statuses. A delivery is pending until it is acknowledged with
+// a 2xx response or runs out of attempts.
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusDelivered = "delivered"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// DefaultWebhookMaxAttempts is how many times a delivery is attempted before it fails
+const DefaultWebhookMaxAttempts = 8
+
+var (
+	ErrInvalidWebhookURL       = errors.New("invalid webhook URL")
+	ErrInvalidWebhookEventType = errors.New("invalid webhook event type")
+)
+
+// WebhookEventTypeList lists every event type a subscription can select
+var WebhookEventTypeList = []string{
+	WebhookEventTransactionCompleted,
+	WebhookEventTransferCompleted,
+	WebhookEventTransferFailed,
+	WebhookEventAccountClosed,
+	WebhookEventCustomerEmailUpdated,
+}
+
+// WebhookSubscription is a URL that receives events for its owner's accounts.
+// Subscriptions created by admins have AllCustomers set and receive events for
+// every customer. Payloads are signed with Secret, which is only shown once.
+type WebhookSubscription struct {
+	ID           uuid.UUID         `gorm:"type:uuid;primary_key" json:"id"`
+	UserID       uuid.UUID         `gorm:"type:uuid;not null;index" json:"user_id"`
+	URL          string            `gorm:"type:text;not null" json:"url"`
+	Description  string            `gorm:"type:text" json:"description,omitempty"`
+	EventTypes   WebhookEventTypes `gorm:"type:jsonb;not null" json:"event_types"`
+	Secret       string            `gorm:"type:varchar(100);not null" json:"-"`
+	AllCustomers bool              `gorm:"not null;default:false" json:"all_customers"`
+	Active       bool              `gorm:"not null;default:true" json:"active"`
+	CreatedAt    time.Time         `gorm:"not null" json:"created_at"`
+	UpdatedAt    time.Time         `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for WebhookSubscription
+func (s *WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// BeforeCreate hook for WebhookSubscription
+func (s *WebhookSubscription) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+
+	now := time.Now()
+	if s.CreatedAt.IsZero() {
+		s.CreatedAt = now
+	}
+	if s.UpdatedAt.IsZero() {
+		s.UpdatedAt = now
+	}
+
+	return s.Validate()
+}
+
+// BeforeUpdate hook for WebhookSubscription
+func (s *WebhookSubscription) BeforeUpdate(tx *gorm.DB) error {
+	s.UpdatedAt = time.Now()
+	return s.Validate()
+}
+
+// Validate validates the subscription fields
+func (s *WebhookSubscription) Validate() error {
+	if s.UserID == uuid.Nil {
+		return errors.New("user ID is required")
+	}
+
+	parsed, err := url.Parse(s.URL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return ErrInvalidWebhookURL
+	}
+
+	if len(s.EventTypes) == 0 {
+		return errors.New("webhook subscription requires at least one event type")
+	}
+	for _, eventType := range s.EventTypes {
+		if !IsValidWebhookEventType(eventType) {
+			return fmt.Errorf("%w: %s", ErrInvalidWebhookEventType, eventType)
+		}
+	}
+
+	if s.Secret == "" {
+		return errors.New("webhook secret is required")
+	}
+
+	return nil
+}
+
+// Subscribes returns true if the subscription is active and selected the event type
+func (s *WebhookSubscription) Subscribes(eventType string) bool {
+	if !s.Active {
+		return false
+	}
+	for _, selected := range s.EventTypes {
+		if selected == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidWebhookEventType checks if the event type is one webhooks are sent for
+func IsValidWebhookEventType(eventType string) bool {
+	for _, valid := range WebhookEventTypeList {
+		if eventType == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is an event queued for delivery to a subscription. Deliveries
+// of the same event to different subscriptions share an EventID. A failed attempt
+// is retried with exponential backoff until MaxAttempts is reached.
+type WebhookDelivery struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primary_key" json:"id"`
+	SubscriptionID uuid.UUID       `gorm:"type:uuid;not null;index" json:"subscription_id"`
+	EventID        uuid.UUID       `gorm:"type:uuid;not null" json:"event_id"`
+	EventType      string          `gorm:"type:varchar(50);not null" json:"event_type"`
+	Payload        string          `gorm:"type:text;not null" json:"payload"`
+	Status         string          `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	AttemptCount   int             `gorm:"not null;default:0" json:"attempt_count"`
+	MaxAttempts    int             `gorm:"not null;default:8" json:"max_attempts"`
+	NextAttemptAt  time.Time       `gorm:"not null;index" json:"next_attempt_at"`
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	LastError      *string         `gorm:"type:text" json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	Attempts       WebhookAttempts `gorm:"type:jsonb" json:"attempts,omitempty"`
+	RedeliveryOf   *uuid.UUID      `gorm:"type:uuid" json:"redelivery_of,omitempty"`
+	CreatedAt      time.Time       `gorm:"not null" json:"created_at"`
+	UpdatedAt      time.Time       `gorm:"not null" json:"updated_at"`
+}
+
+// TableName specifies the table name for WebhookDelivery
+func (d *WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// BeforeCreate hook for WebhookDelivery
+func (d *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+
+	if d.Status == "" {
+		d.Status = WebhookDeliveryStatusPending
+	}
+
+	if d.MaxAttempts == 0 {
+		d.MaxAttempts = DefaultWebhookMaxAttempts
+	}
+
+	now := time.Now()
+	if d.NextAttemptAt.IsZero() {
+		d.NextAttemptAt = now
+	}
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = now
+	}
+	if d.UpdatedAt.IsZero() {
+		d.UpdatedAt = now
+	}
+
+	return nil
+}
+
+// BeforeUpdate hook for WebhookDelivery
+func (d *WebhookDelivery) BeforeUpdate(tx *gorm.DB) error {
+	d.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsPending returns true if the delivery still has attempts to make
+func (d *WebhookDelivery) IsPending() bool {
+	return d.Status == WebhookDeliveryStatusPending
+}
+
+// CanRetry returns true if another attempt can be made after a failure
+func (d *WebhookDelivery) CanRetry() bool {
+	return d.AttemptCount < d.MaxAttempts
+}
+
+// CalculateNextAttemptTime returns when to retry after a failed attempt. The wait
+// doubles with every attempt made: two minutes after the first, four after the second.
+func (d *WebhookDelivery) CalculateNextAttemptTime() time.Time {
+	backoffMinutes := 1 << uint(d.AttemptCount)
+	return time.Now().Add(time.Duration(backoffMinutes) * time.Minute)
+}
+
+// RecordAttempt appends an attempt to the log and moves the delivery on: a 2xx
+// response delivers it, otherwise it is scheduled for a retry or failed once it
+// is out of attempts. responseStatus is zero when no response was received.
+func (d *WebhookDelivery) RecordAttempt(responseStatus int, attemptErr error, at time.Time) {
+	d.AttemptCount++
+	d.LastAttemptAt = &at
+
+	attempt := WebhookAttempt{Attempt: d.AttemptCount, ResponseStatus: responseStatus, AttemptedAt: at}
+	if responseStatus != 0 {
+		d.ResponseStatus = &responseStatus
+	} else {
+		d.ResponseStatus = nil
+	}
+
+	if attemptErr == nil && responseStatus >= 200 && responseStatus < 300 {
+		d.Status = WebhookDeliveryStatusDelivered
+		d.DeliveredAt = &at
+		d.LastError = nil
+		d.Attempts = append(d.Attempts, attempt)
+		return
+	}
+
+	message := fmt.Sprintf("endpoint responded with status %d", responseStatus)
+	if attemptErr != nil {
+		message = attemptErr.Error()
+	}
+	attempt.Error = message
+	d.LastError = &message
+	d.Attempts = append(d.Attempts, attempt)
+
+	if d.CanRetry() {
+		d.NextAttemptAt = d.CalculateNextAttemptTime()
+	} else {
+		d.Status = WebhookDeliveryStatusFailed
+	}
+}
+
+// Abandon fails a delivery that can no longer be made, without attempting it
+func (d *WebhookDelivery) Abandon(reason string) {
+	d.Status = WebhookDeliveryStatusFailed
+	d.LastError = &reason
+}
+
+// IsValidWebhookDeliveryStatus checks if the status is a valid webhook delivery status
+func IsValidWebhookDeliveryStatus(status string) bool {
+	switch status {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+// WebhookAttempt is one attempt to deliver a webhook
+type WebhookAttempt struct {
+	Attempt        int       `json:"attempt"`
+	ResponseStatus int       `json:"response_status,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	AttemptedAt    time.Time `json:"attempted_at"`
+}
+
+// WebhookAttempts is the attempt log of a webhook delivery
+type WebhookAttempts []WebhookAttempt
+
+// Value implements driver.Valuer interface
+func (a WebhookAttempts) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal([]WebhookAttempt(a))
+	if err != nil {
+		return nil, err
+	}
+	// Return string for SQLite compatibility
+	return string(bytes), nil
+}
+
+// Scan implements sql.Scanner interface
+func (a *WebhookAttempts) Scan(value interface{}) error {
+	if value == nil {
+		*a = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into WebhookAttempts", value)
+	}
+
+	if len(bytes) == 0 {
+		*a = nil
+		return nil
+	}
+
+	return json.Unmarshal(bytes, (*[]WebhookAttempt)(a))
+}
+
+// WebhookEventTypes is the list of event types a subscription selected
+type WebhookEventTypes []string
+
+// Value implements driver.Valuer interface
+func (t WebhookEventTypes) Value() (driver.Value, error) {
+	bytes, err := json.Marshal([]string(t))
+	if err != nil {
+		return nil, err
+	}
+	// Return string for SQLite compatibility
+	return string(bytes), nil
+}
+
+// Scan implements sql.Scanner interface
+func (t *WebhookEventTypes) Scan(value interface{}) error {
+	if value == nil {
+		*t = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into WebhookEventTypes", value)
+	}
+
+	if len(bytes) == 0 {
+		*t = nil
+		return nil
+	}
+
+	return json.Unmarshal(bytes, (*[]string)(t))
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSubscription_Validate(t *testing.T) {
+	valid := func() *WebhookSubscription {
+		return &WebhookSubscription{
+			UserID:     uuid.New(),
+			URL:        "https://example.com/hooks",
+			EventTypes: WebhookEventTypes{WebhookEventTransactionCompleted},
+			Secret:     "whsec_test",
+		}
+	}
+
+	assert.NoError(t, valid().Validate())
+
+	subscription := valid()
+	subscription.URL = "ftp://example.com/hooks"
+	assert.ErrorIs(t, subscription.Validate(), ErrInvalidWebhookURL)
+
+	subscription = valid()
+	subscription.URL = "/hooks"
+	assert.ErrorIs(t, subscription.Validate(), ErrInvalidWebhookURL)
+
+	subscription = valid()
+	subscription.EventTypes = WebhookEventTypes{"transaction.created"}
+	assert.ErrorIs(t, subscription.Validate(), ErrInvalidWebhookEventType)
+
+	subscription = valid()
+	subscription.EventTypes = nil
+	assert.Error(t, subscription.Validate())
+
+	subscription = valid()
+	subscription.Secret = ""
+	assert.Error(t, subscription.Validate())
+}
+
+func TestWebhookSubscription_Subscribes(t *testing.T) {
+	subscription := &WebhookSubscription{
+		EventTypes: WebhookEventTypes{WebhookEventTransferFailed, WebhookEventAccountClosed},
+		Active:     true,
+	}
+
+	assert.True(t, subscription.Subscribes(WebhookEventAccountClosed))
+	assert.False(t, subscription.Subscribes(WebhookEventTransactionCompleted))
+
+	subscription.Active = false
+	assert.False(t, subscription.Subscribes(WebhookEventAccountClosed))
+}
+
+func TestWebhookDelivery_RecordAttempt(t *testing.T) {
+	now := time.Now()
+
+	delivery := &WebhookDelivery{Status: WebhookDeliveryStatusPending, MaxAttempts: 2}
+	delivery.RecordAttempt(500, nil, now)
+	assert.True(t, delivery.IsPending())
+	assert.Equal(t, 1, delivery.AttemptCount)
+	assert.Equal(t, 500, *delivery.ResponseStatus)
+	assert.Equal(t, "endpoint responded with status 500", *delivery.LastError)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), delivery.NextAttemptAt, 5*time.Second)
+
+	delivery.RecordAttempt(0, errors.New("connection refused"), now)
+	assert.Equal(t, WebhookDeliveryStatusFailed, delivery.Status)
+	assert.Nil(t, delivery.ResponseStatus)
+	assert.Equal(t, "connection refused", *delivery.LastError)
+	assert.Len(t, delivery.Attempts, 2)
+	assert.Equal(t, 2, delivery.Attempts[1].Attempt)
+
+	delivery = &WebhookDelivery{Status: WebhookDeliveryStatusPending, MaxAttempts: 2}
+	delivery.RecordAttempt(204, nil, now)
+	assert.Equal(t, WebhookDeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, now, *delivery.DeliveredAt)
+	assert.Nil(t, delivery.LastError)
+}
+
+func TestWebhookEventTypes_ValueAndScan(t *testing.T) {
+	value, err := WebhookEventTypes{WebhookEventTransferCompleted}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `["transfer.completed"]`, value)
+
+	var eventTypes WebhookEventTypes
+	assert.NoError(t, eventTypes.Scan([]byte(`["account.closed"]`)))
+	assert.Equal(t, WebhookEventTypes{WebhookEventAccountClosed}, eventTypes)
+	assert.Error(t, eventTypes.Scan(42))
+}
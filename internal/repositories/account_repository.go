@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"sync"
@@ -17,6 +18,7 @@ var (
 	ErrAccountNumberExists = errors.New("account number already exists")
 	ErrInsufficientFunds   = errors.New("insufficient funds")
 	ErrAccountNotActive    = errors.New("account is not active")
+	ErrCurrencyMismatch    = errors.New("accounts hold different currencies")
 )
 
 // accountRepository implements AccountRepository interface
@@ -205,18 +207,14 @@ func (r *accountRepository) CreateWithTransaction(account *models.Account, trans
 	})
 }
 
-// UpdateBalance updates account balance within a transaction
+// UpdateBalance updates account balance within a transaction. The account row is
+// locked so holds placed or settled concurrently are seen, and only the balance is
+// written back.
 func (r *accountRepository) UpdateBalance(accountID uuid.UUID, amount decimal.Decimal, transactionType string) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		account := &models.Account{ID: accountID}
-
-		// Row-level locking prevents concurrent balance modifications
-		if err := tx.Set("gorm:query_option", "FOR UPDATE").
-			First(&account).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return ErrAccountNotFound
-			}
-			return fmt.Errorf("failed to get account for update: %w", err)
+		account, err := lockAccount(tx, accountID)
+		if err != nil {
+			return err
 		}
 
 		if !account.IsActive() {
@@ -224,7 +222,8 @@ func (r *accountRepository) UpdateBalance(accountID uuid.UUID, amount decimal.De
 		}
 
 		if transactionType == models.TransactionTypeDebit {
-			if account.Balance.LessThan(amount) {
+			// Funds reserved by authorization holds cannot be spent
+			if !account.HasAvailableFunds(amount) {
 				return ErrInsufficientFunds
 			}
 			account.Balance = account.Balance.Sub(amount)
@@ -234,7 +233,7 @@ func (r *accountRepository) UpdateBalance(accountID uuid.UUID, amount decimal.De
 			return fmt.Errorf("invalid transaction type: %s", transactionType)
 		}
 
-		if err := tx.Save(account).Error; err != nil {
+		if err := tx.Model(account).Update("balance", account.Balance).Error; err != nil {
 			return fmt.Errorf("failed to update account balance: %w", err)
 		}
 
@@ -281,28 +280,93 @@ func (r *accountRepository) ExistsForUser(userID uuid.UUID, accountType string)
 	return count > 0, nil
 }
 
-// ExecuteAtomicTransfer performs an atomic account-to-account transfer with row locking
-func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string) (debitTxID, creditTxID uuid.UUID, err error) {
-	err = r.db.Transaction(func(tx *gorm.DB) error {
-		// Debit from source account with row locking
-		fromAcct := &models.Account{ID: fromAccountID}
-		if err := tx.Set("gorm:query_option", "FOR UPDATE").
-			First(&fromAcct).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return ErrAccountNotFound
+// PostTransaction records a completed transaction and moves the account balance in
+// one database transaction. check, if set, runs once the account row is locked. The
+// balances before and after are taken from the locked row.
+func (r *accountRepository) PostTransaction(transaction *models.Transaction, check AccountCheck) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		account, err := lockAccount(tx, transaction.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if !account.IsActive() {
+			return ErrAccountNotActive
+		}
+
+		if check != nil {
+			if err := check(account, &limitRepository{db: tx}); err != nil {
+				return err
+			}
+		}
+
+		balanceAfter := account.Balance
+		switch transaction.TransactionType {
+		case models.TransactionTypeDebit:
+			// Funds reserved by authorization holds cannot be spent
+			if !account.HasAvailableFunds(transaction.Amount) {
+				return ErrInsufficientFunds
 			}
-			return fmt.Errorf("failed to lock source account: %w", err)
+			balanceAfter = balanceAfter.Sub(transaction.Amount)
+		case models.TransactionTypeCredit:
+			balanceAfter = balanceAfter.Add(transaction.Amount)
+		default:
+			return fmt.Errorf("invalid transaction type: %s", transaction.TransactionType)
+		}
+
+		transaction.BalanceBefore = account.Balance
+		transaction.BalanceAfter = balanceAfter
+		if err := tx.Create(transaction).Error; err != nil {
+			return fmt.Errorf("failed to create transaction: %w", err)
+		}
+
+		if err := tx.Model(account).Update("balance", balanceAfter).Error; err != nil {
+			return fmt.Errorf("failed to update account balance: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ExecuteAtomicTransfer performs an atomic account-to-account transfer with row locking.
+// Both accounts must hold the same currency. check, if set, runs against the source
+// account once both rows are locked.
+func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string, check AccountCheck) (debitTxID, creditTxID uuid.UUID, err error) {
+	return r.executeAtomicTransfer(fromAccountID, toAccountID, amount, amount, nil, fromDescription, toDescription, check)
+}
+
+// ExecuteAtomicFXTransfer performs an atomic transfer between accounts in different
+// currencies. The source is debited debitAmount and the destination credited
+// creditAmount; the locked rate is recorded on both transactions.
+func (r *accountRepository) ExecuteAtomicFXTransfer(fromAccountID, toAccountID uuid.UUID, debitAmount, creditAmount, rate decimal.Decimal, fromDescription, toDescription string, check AccountCheck) (debitTxID, creditTxID uuid.UUID, err error) {
+	return r.executeAtomicTransfer(fromAccountID, toAccountID, debitAmount, creditAmount, &rate, fromDescription, toDescription, check)
+}
+
+func (r *accountRepository) executeAtomicTransfer(fromAccountID, toAccountID uuid.UUID, debitAmount, creditAmount decimal.Decimal, rate *decimal.Decimal, fromDescription, toDescription string, check AccountCheck) (debitTxID, creditTxID uuid.UUID, err error) {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		fromAcct, toAcct, err := lockAccountPair(tx, fromAccountID, toAccountID)
+		if err != nil {
+			return err
 		}
 
 		if !fromAcct.IsActive() {
 			return ErrAccountNotActive
 		}
 
-		if fromAcct.Balance.LessThan(amount) {
+		if check != nil {
+			if err := check(fromAcct, &limitRepository{db: tx}); err != nil {
+				return err
+			}
+		}
+
+		// Funds reserved by authorization holds cannot be transferred
+		if !fromAcct.HasAvailableFunds(debitAmount) {
 			return ErrInsufficientFunds
 		}
 
-		newFromBalance := fromAcct.Balance.Sub(amount)
+		// Update mutates the model, so keep the balance the debit starts from
+		fromBalanceBefore := fromAcct.Balance
+		newFromBalance := fromAcct.Balance.Sub(debitAmount)
 		if err := tx.Model(fromAcct).Update("balance", newFromBalance).Error; err != nil {
 			return fmt.Errorf("failed to debit source account: %w", err)
 		}
@@ -310,12 +374,13 @@ func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uui
 		debitTx := &models.Transaction{
 			AccountID:       fromAccountID,
 			TransactionType: models.TransactionTypeDebit,
-			Amount:          amount,
-			BalanceBefore:   fromAcct.Balance,
+			Amount:          debitAmount,
+			BalanceBefore:   fromBalanceBefore,
 			BalanceAfter:    newFromBalance,
 			Description:     fromDescription,
 			Status:          models.TransactionStatusCompleted,
 			Reference:       models.GenerateTransactionReference(),
+			ExchangeRate:    rate,
 		}
 
 		if err := tx.Create(debitTx).Error; err != nil {
@@ -323,21 +388,17 @@ func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uui
 		}
 		debitTxID = debitTx.ID
 
-		// Credit destination account with row locking
-		toAcct := &models.Account{ID: toAccountID}
-		if err := tx.Set("gorm:query_option", "FOR UPDATE").
-			First(&toAcct).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return ErrAccountNotFound
-			}
-			return fmt.Errorf("failed to lock destination account: %w", err)
-		}
-
 		if !toAcct.IsActive() {
 			return ErrAccountNotActive
 		}
 
-		newToBalance := toAcct.Balance.Add(amount)
+		// A transfer without a rate moves the same amount, so the currencies must match
+		if rate == nil && fromAcct.Currency != toAcct.Currency {
+			return ErrCurrencyMismatch
+		}
+
+		toBalanceBefore := toAcct.Balance
+		newToBalance := toAcct.Balance.Add(creditAmount)
 		if err := tx.Model(toAcct).Update("balance", newToBalance).Error; err != nil {
 			return fmt.Errorf("failed to credit destination account: %w", err)
 		}
@@ -345,12 +406,13 @@ func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uui
 		creditTx := &models.Transaction{
 			AccountID:       toAccountID,
 			TransactionType: models.TransactionTypeCredit,
-			Amount:          amount,
-			BalanceBefore:   toAcct.Balance,
+			Amount:          creditAmount,
+			BalanceBefore:   toBalanceBefore,
 			BalanceAfter:    newToBalance,
 			Description:     toDescription,
 			Status:          models.TransactionStatusCompleted,
 			Reference:       models.GenerateTransactionReference(),
+			ExchangeRate:    rate,
 		}
 
 		if err := tx.Create(creditTx).Error; err != nil {
@@ -364,6 +426,31 @@ func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uui
 	return debitTxID, creditTxID, err
 }
 
+// lockAccountPair locks both sides of a transfer, always taking the lower account ID
+// first so concurrent transfers in opposite directions cannot deadlock
+func lockAccountPair(tx *gorm.DB, fromAccountID, toAccountID uuid.UUID) (fromAcct, toAcct *models.Account, err error) {
+	firstID, secondID := fromAccountID, toAccountID
+	if bytes.Compare(secondID[:], firstID[:]) < 0 {
+		firstID, secondID = secondID, firstID
+	}
+
+	first, err := lockAccount(tx, firstID)
+	if err != nil {
+		return nil, nil, err
+	}
+	second := first
+	if secondID != firstID {
+		if second, err = lockAccount(tx, secondID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if firstID == fromAccountID {
+		return first, second, nil
+	}
+	return second, first, nil
+}
+
 // GetByUserIDExcludingStatus retrieves all accounts for a user excluding a specific status
 func (r *accountRepository) GetByUserIDExcludingStatus(userID uuid.UUID, excludeStatus string) ([]*models.Account, error) {
 	var accounts []*models.Account
@@ -1,8 +1,10 @@
 package repositories
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"array-assessment/internal/database"
 	"array-assessment/internal/models"
@@ -370,6 +372,113 @@ func (s *AccountRepositorySuite) TestUpdateBalance_InsufficientFunds() {
 	s.Equal(decimal.NewFromFloat(100.00).String(), updated.Balance.String())
 }
 
+func (s *AccountRepositorySuite) TestUpdateBalance_LeavesHeldBalance() {
+	account := &models.Account{
+		UserID:        s.testUser.ID,
+		AccountNumber: "1012345678",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(1000.00),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	s.Require().NoError(s.repo.Create(account))
+	s.Require().NoError(s.db.Model(account).Update("held_balance", decimal.NewFromFloat(800.00)).Error)
+
+	// Funds reserved by a hold cannot be spent
+	err := s.repo.UpdateBalance(account.ID, decimal.NewFromFloat(300.00), models.TransactionTypeDebit)
+	s.ErrorIs(err, ErrInsufficientFunds)
+
+	s.Require().NoError(s.repo.UpdateBalance(account.ID, decimal.NewFromFloat(200.00), models.TransactionTypeDebit))
+
+	updated, err := s.repo.GetByID(account.ID)
+	s.Require().NoError(err)
+	s.Equal("800", updated.Balance.String())
+	s.Equal("800", updated.HeldBalance.String())
+}
+
+func (s *AccountRepositorySuite) TestPostTransaction_RunsCheckUnderLock() {
+	account := &models.Account{
+		UserID:        s.testUser.ID,
+		AccountNumber: "1012345678",
+		RoutingNumber: "021000021",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(1000.00),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	s.Require().NoError(s.repo.Create(account))
+
+	debit := func(amount float64) *models.Transaction {
+		return &models.Transaction{
+			AccountID:       account.ID,
+			TransactionType: models.TransactionTypeDebit,
+			Amount:          decimal.NewFromFloat(amount),
+			Description:     "ATM withdrawal",
+			Status:          models.TransactionStatusCompleted,
+			Reference:       models.GenerateTransactionReference(),
+		}
+	}
+
+	first := debit(100.00)
+	s.Require().NoError(s.repo.PostTransaction(first, nil))
+	s.Equal("1000", first.BalanceBefore.String())
+	s.Equal("900", first.BalanceAfter.String())
+
+	// The check sees the earlier debit, and rejecting leaves the account untouched
+	errOverLimit := errors.New("over limit")
+	var used decimal.Decimal
+	err := s.repo.PostTransaction(debit(50.00), func(locked *models.Account, usage LimitUsageReader) error {
+		total, err := usage.GetWithdrawalTotal(locked.ID, time.Now().Add(-time.Hour))
+		used = total
+		if err != nil {
+			return err
+		}
+		return errOverLimit
+	})
+	s.ErrorIs(err, errOverLimit)
+	s.Equal("100", used.String())
+
+	updated, err := s.repo.GetByID(account.ID)
+	s.Require().NoError(err)
+	s.Equal("900", updated.Balance.String())
+}
+
+func (s *AccountRepositorySuite) TestExecuteAtomicTransfer_BothDirections() {
+	a := &models.Account{
+		UserID:        s.testUser.ID,
+		AccountNumber: "1012345678",
+		RoutingNumber: "021000021",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(500.00),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	b := &models.Account{
+		UserID:        s.testUser.ID,
+		AccountNumber: "2012345678",
+		RoutingNumber: "021000022",
+		AccountType:   models.AccountTypeSavings,
+		Balance:       decimal.NewFromFloat(100.00),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	s.Require().NoError(s.repo.Create(a))
+	s.Require().NoError(s.repo.Create(b))
+
+	// Rows are locked lowest ID first, so each direction must still debit the source
+	_, _, err := s.repo.ExecuteAtomicTransfer(a.ID, b.ID, decimal.NewFromFloat(200.00), "out", "in", nil)
+	s.Require().NoError(err)
+	_, _, err = s.repo.ExecuteAtomicTransfer(b.ID, a.ID, decimal.NewFromFloat(50.00), "out", "in", nil)
+	s.Require().NoError(err)
+
+	updatedA, err := s.repo.GetByID(a.ID)
+	s.Require().NoError(err)
+	updatedB, err := s.repo.GetByID(b.ID)
+	s.Require().NoError(err)
+	s.Equal("350", updatedA.Balance.String())
+	s.Equal("250", updatedB.Balance.String())
+}
+
 // Test GetAccountsByStatus functionality
 func (s *AccountRepositorySuite) TestGetAccountsByStatus() {
 	// Create active accounts
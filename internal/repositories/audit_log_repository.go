@@ -209,6 +209,20 @@ func (r *AuditLogRepository) GetFailedLoginAttempts(email string, since time.Tim
 	return count, nil
 }
 
+// GetRecentLogins retrieves a user's most recent successful logins, newest first
+func (r *AuditLogRepository) GetRecentLogins(userID uuid.UUID, limit int) ([]*models.AuditLog, error) {
+	var logs []*models.AuditLog
+
+	if err := r.db.Where("user_id = ? AND action = ?", userID, models.AuditActionLogin).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&logs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get recent logins: %w", err)
+	}
+
+	return logs, nil
+}
+
 // DeleteOlderThan removes audit logs older than the specified duration
 func (r *AuditLogRepository) DeleteOlderThan(duration time.Duration) (int64, error) {
 	cutoffTime := time.Now().Add(-duration)
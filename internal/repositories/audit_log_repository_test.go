@@ -287,3 +287,43 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByTimeRange() {
 	s.Len(logs, 0)
 	s.Equal(int64(0), total)
 }
+
+func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetRecentLogins() {
+	userID := uuid.New()
+	now := time.Now()
+
+	for i, ip := range []string{"192.168.1.1", "192.168.1.2", "203.0.113.7"} {
+		err := s.repo.Create(&models.AuditLog{
+			UserID:     &userID,
+			Action:     models.AuditActionLogin,
+			Resource:   "user",
+			ResourceID: userID.String(),
+			IPAddress:  ip,
+			CreatedAt:  now.Add(time.Duration(i) * time.Minute),
+		})
+		s.NoError(err)
+	}
+
+	// Other actions and other users' logins are ignored
+	s.NoError(s.repo.Create(&models.AuditLog{
+		UserID:    &userID,
+		Action:    models.AuditActionLogout,
+		Resource:  "user",
+		IPAddress: "198.51.100.1",
+		CreatedAt: now.Add(time.Hour),
+	}))
+	otherUserID := uuid.New()
+	s.NoError(s.repo.Create(&models.AuditLog{
+		UserID:    &otherUserID,
+		Action:    models.AuditActionLogin,
+		Resource:  "user",
+		IPAddress: "198.51.100.2",
+		CreatedAt: now.Add(time.Hour),
+	}))
+
+	logins, err := s.repo.GetRecentLogins(userID, 2)
+	s.NoError(err)
+	s.Require().Len(logins, 2)
+	s.Equal("203.0.113.7", logins[0].IPAddress)
+	s.Equal("192.168.1.2", logins[1].IPAddress)
+}
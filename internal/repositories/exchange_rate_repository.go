@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrExchangeRateNotFound = errors.New("exchange rate not found")
+)
+
+// exchangeRateRepository implements ExchangeRateRepositoryInterface
+type exchangeRateRepository struct {
+	db *gorm.DB
+}
+
+// NewExchangeRateRepository creates a new exchange rate repository
+func NewExchangeRateRepository(db *gorm.DB) ExchangeRateRepositoryInterface {
+	return &exchangeRateRepository{
+		db: db,
+	}
+}
+
+// Upsert stores the rate for a currency pair, replacing any existing rate for the pair
+func (r *exchangeRateRepository) Upsert(rate *models.ExchangeRate) error {
+	rate.UpdatedAt = time.Now()
+
+	if err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "source", "effective_at", "updated_at"}),
+	}).Create(rate).Error; err != nil {
+		return fmt.Errorf("failed to store exchange rate: %w", err)
+	}
+	return nil
+}
+
+// GetRate retrieves the stored rate for converting baseCurrency into quoteCurrency
+func (r *exchangeRateRepository) GetRate(baseCurrency, quoteCurrency string) (*models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+	if err := r.db.Where("base_currency = ? AND quote_currency = ?", baseCurrency, quoteCurrency).
+		First(&rate).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrExchangeRateNotFound
+		}
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+	return &rate, nil
+}
+
+// List retrieves all stored rates ordered by currency pair
+func (r *exchangeRateRepository) List() ([]models.ExchangeRate, error) {
+	var rates []models.ExchangeRate
+	if err := r.db.Order("base_currency ASC, quote_currency ASC").
+		Find(&rates).Error; err != nil {
+		return nil, fmt.Errorf("failed to list exchange rates: %w", err)
+	}
+	return rates, nil
+}
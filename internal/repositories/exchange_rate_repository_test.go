@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"testing"
+
+	"array-assessment/internal/models"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// ExchangeRateRepositoryTestSuite is the test suite for ExchangeRate repository and
+// cross-currency transfers
+type ExchangeRateRepositoryTestSuite struct {
+	suite.Suite
+	db          *gorm.DB
+	repo        ExchangeRateRepositoryInterface
+	accountRepo AccountRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *ExchangeRateRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.ExchangeRate{}, &models.Account{}, &models.Transaction{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewExchangeRateRepository(db)
+	s.accountRepo = NewAccountRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *ExchangeRateRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestExchangeRateRepositoryTestSuite runs the test suite
+func TestExchangeRateRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeRateRepositoryTestSuite))
+}
+
+// Helper function to create a persisted account in a currency
+func (s *ExchangeRateRepositoryTestSuite) createTestAccount(currency string, balance decimal.Decimal) *models.Account {
+	account := &models.Account{
+		AccountNumber: gofakeit.Numerify("##########"),
+		RoutingNumber: gofakeit.Numerify("#########"),
+		UserID:        uuid.New(),
+		AccountType:   models.AccountTypeChecking,
+		Balance:       balance,
+		Currency:      currency,
+	}
+	require.NoError(s.T(), s.db.Create(account).Error)
+	return account
+}
+
+// TestUpsert_ReplacesRateForPair tests that a pair keeps a single current rate
+func (s *ExchangeRateRepositoryTestSuite) TestUpsert_ReplacesRateForPair() {
+	require.NoError(s.T(), s.repo.Upsert(&models.ExchangeRate{
+		BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: decimal.RequireFromString("0.90"), Source: "file",
+	}))
+	require.NoError(s.T(), s.repo.Upsert(&models.ExchangeRate{
+		BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: decimal.RequireFromString("0.92"), Source: "ecb",
+	}))
+
+	rate, err := s.repo.GetRate("USD", "EUR")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "0.92", rate.Rate.String())
+	assert.Equal(s.T(), "ecb", rate.Source)
+
+	rates, err := s.repo.List()
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), rates, 1)
+
+	_, err = s.repo.GetRate("EUR", "USD")
+	assert.ErrorIs(s.T(), err, ErrExchangeRateNotFound)
+}
+
+// TestExecuteAtomicFXTransfer_RecordsRateOnBothLegs tests that each account moves in its own currency
+func (s *ExchangeRateRepositoryTestSuite) TestExecuteAtomicFXTransfer_RecordsRateOnBothLegs() {
+	from := s.createTestAccount("USD", decimal.NewFromInt(100))
+	to := s.createTestAccount("JPY", decimal.NewFromInt(1000))
+	rate := decimal.RequireFromString("149.5")
+
+	debitTxID, creditTxID, err := s.accountRepo.ExecuteAtomicFXTransfer(
+		from.ID, to.ID, decimal.NewFromInt(10), decimal.NewFromInt(1495), rate, "out", "in", nil)
+	require.NoError(s.T(), err)
+
+	fromAccount, err := s.accountRepo.GetByID(from.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "90.00", fromAccount.Balance.StringFixed(2))
+
+	toAccount, err := s.accountRepo.GetByID(to.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "2495", toAccount.Balance.StringFixed(0))
+
+	for _, id := range []uuid.UUID{debitTxID, creditTxID} {
+		var txn models.Transaction
+		require.NoError(s.T(), s.db.First(&txn, "id = ?", id).Error)
+		require.NotNil(s.T(), txn.ExchangeRate)
+		assert.True(s.T(), rate.Equal(*txn.ExchangeRate))
+	}
+}
+
+// TestExecuteAtomicTransfer_RejectsCurrencyMismatch tests that a transfer without a rate needs matching currencies
+func (s *ExchangeRateRepositoryTestSuite) TestExecuteAtomicTransfer_RejectsCurrencyMismatch() {
+	from := s.createTestAccount("USD", decimal.NewFromInt(100))
+	to := s.createTestAccount("EUR", decimal.Zero)
+
+	_, _, err := s.accountRepo.ExecuteAtomicTransfer(from.ID, to.ID, decimal.NewFromInt(10), "out", "in", nil)
+	assert.ErrorIs(s.T(), err, ErrCurrencyMismatch)
+
+	fromAccount, err := s.accountRepo.GetByID(from.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "100.00", fromAccount.Balance.StringFixed(2))
+}
@@ -0,0 +1,400 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrExternalTransferNotFound     = errors.New("external transfer not found")
+	ErrExternalTransferStateChanged = errors.New("external transfer is not in the expected status")
+)
+
+// externalTransferRepository implements ExternalTransferRepositoryInterface
+type externalTransferRepository struct {
+	db *gorm.DB
+}
+
+// NewExternalTransferRepository creates a new external transfer repository
+func NewExternalTransferRepository(db *gorm.DB) ExternalTransferRepositoryInterface {
+	return &externalTransferRepository{
+		db: db,
+	}
+}
+
+// Create records an initiated external transfer. An outbound transfer also places
+// a hold for its amount on the account in the same database transaction, so the
+// funds cannot be spent while the transfer is in flight.
+func (r *externalTransferRepository) Create(transfer *models.ExternalTransfer) error {
+	if transfer.ReferenceNumber == "" {
+		transfer.ReferenceNumber = models.GenerateExternalTransferReference()
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		account, err := lockAccount(tx, transfer.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if !account.IsActive() {
+			return ErrAccountNotActive
+		}
+
+		if transfer.IsOutbound() {
+			if !account.HasAvailableFunds(transfer.Amount) {
+				return ErrInsufficientFunds
+			}
+
+			hold := models.NewHold(
+				account.ID,
+				transfer.Amount,
+				"External transfer "+transfer.ReferenceNumber+": "+transfer.Description,
+				time.Now().Add(models.MaxHoldDuration),
+			)
+			hold.BalanceBefore = account.Balance
+			hold.BalanceAfter = account.Balance.Sub(transfer.Amount)
+
+			if err := tx.Create(hold).Error; err != nil {
+				return fmt.Errorf("failed to create hold: %w", err)
+			}
+
+			if err := tx.Model(account).Update("held_balance", account.HeldBalance.Add(transfer.Amount)).Error; err != nil {
+				return fmt.Errorf("failed to update held balance: %w", err)
+			}
+
+			transfer.HoldTransactionID = &hold.ID
+		}
+
+		if err := tx.Create(transfer).Error; err != nil {
+			return fmt.Errorf("failed to create external transfer: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetByID retrieves an external transfer by ID
+func (r *externalTransferRepository) GetByID(id uuid.UUID) (*models.ExternalTransfer, error) {
+	var transfer models.ExternalTransfer
+	if err := r.db.Where("id = ?", id).First(&transfer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrExternalTransferNotFound
+		}
+		return nil, fmt.Errorf("failed to get external transfer: %w", err)
+	}
+	return &transfer, nil
+}
+
+// GetByIdempotencyKey retrieves an external transfer by its idempotency key
+func (r *externalTransferRepository) GetByIdempotencyKey(key string) (*models.ExternalTransfer, error) {
+	var transfer models.ExternalTransfer
+	if err := r.db.Where("idempotency_key = ?", key).First(&transfer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrExternalTransferNotFound
+		}
+		return nil, fmt.Errorf("failed to get external transfer: %w", err)
+	}
+	return &transfer, nil
+}
+
+// GetByUserID retrieves a user's external transfers, newest first
+func (r *externalTransferRepository) GetByUserID(userID uuid.UUID, offset, limit int) ([]models.ExternalTransfer, int64, error) {
+	var transfers []models.ExternalTransfer
+	var total int64
+
+	query := r.db.Model(&models.ExternalTransfer{}).Where("user_id = ?", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count external transfers: %w", err)
+	}
+
+	if err := query.Order("created_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&transfers).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list external transfers: %w", err)
+	}
+
+	return transfers, total, nil
+}
+
+// GetUnsubmitted retrieves transfers initiated before the given time that have not
+// been accepted by NorthWind, oldest first
+func (r *externalTransferRepository) GetUnsubmitted(before time.Time, limit int) ([]models.ExternalTransfer, error) {
+	var transfers []models.ExternalTransfer
+	if err := r.db.Where("status = ? AND created_at <= ?", models.ExternalTransferStatusInitiated, before).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&transfers).Error; err != nil {
+		return nil, fmt.Errorf("failed to get unsubmitted external transfers: %w", err)
+	}
+	return transfers, nil
+}
+
+// GetDueForSettlement retrieves submitted transfers whose settlement delay has
+// passed, oldest first
+func (r *externalTransferRepository) GetDueForSettlement(now time.Time, limit int) ([]models.ExternalTransfer, error) {
+	var transfers []models.ExternalTransfer
+	if err := r.db.Where("status = ? AND settle_after <= ?", models.ExternalTransferStatusSubmitted, now).
+		Order("settle_after ASC").
+		Limit(limit).
+		Find(&transfers).Error; err != nil {
+		return nil, fmt.Errorf("failed to get external transfers due for settlement: %w", err)
+	}
+	return transfers, nil
+}
+
+// MarkSubmitted saves NorthWind's acceptance of an initiated transfer. It returns
+// ErrExternalTransferStateChanged if the transfer is no longer initiated.
+func (r *externalTransferRepository) MarkSubmitted(transfer *models.ExternalTransfer) error {
+	result := r.db.Model(transfer).
+		Where("status = ?", models.ExternalTransferStatusInitiated).
+		Select("status", "provider_transfer_id", "submitted_at", "settle_after", "updated_at").
+		Updates(transfer)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark external transfer submitted: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrExternalTransferStateChanged
+	}
+	return nil
+}
+
+// Settle completes a submitted transfer. An outbound transfer captures its hold,
+// debiting the account; an inbound transfer credits the account.
+func (r *externalTransferRepository) Settle(id uuid.UUID) (*models.ExternalTransfer, error) {
+	var transfer *models.ExternalTransfer
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var account *models.Account
+		var err error
+		transfer, account, err = r.lockTransfer(tx, id, models.ExternalTransferStatusSubmitted)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		var settlementID uuid.UUID
+
+		if transfer.IsOutbound() {
+			settlementID, err = captureExternalTransferHold(tx, transfer, account)
+		} else {
+			settlementID, err = creditExternalTransfer(tx, transfer, account)
+		}
+		if err != nil {
+			return err
+		}
+
+		transfer.Settle(settlementID, now)
+		return saveExternalTransfer(tx, transfer, models.ExternalTransferStatusSubmitted)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// ReleaseFromReview makes a transfer approved by fraud review initiated, so the
+// worker submits it. Its hold is kept. It returns ErrExternalTransferStateChanged
+// if the transfer is no longer pending review.
+func (r *externalTransferRepository) ReleaseFromReview(id uuid.UUID) error {
+	result := r.db.Model(&models.ExternalTransfer{}).
+		Where("id = ? AND status = ?", id, models.ExternalTransferStatusPendingReview).
+		Updates(map[string]interface{}{
+			"status":     models.ExternalTransferStatusInitiated,
+			"updated_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to release external transfer from review: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrExternalTransferStateChanged
+	}
+	return nil
+}
+
+// Return closes an initiated or submitted transfer with an ACH return code. An
+// outbound transfer's hold is released; an inbound transfer never credited the
+// account, so no funds move.
+func (r *externalTransferRepository) Return(id uuid.UUID, returnCode, reason string) (*models.ExternalTransfer, error) {
+	return r.close(id, func(transfer *models.ExternalTransfer) {
+		transfer.Return(returnCode, reason, time.Now())
+	}, models.ExternalTransferStatusInitiated, models.ExternalTransferStatusSubmitted)
+}
+
+// Fail closes an initiated transfer that NorthWind refused, or one pending review
+// that fraud review blocked, releasing an outbound transfer's hold
+func (r *externalTransferRepository) Fail(id uuid.UUID, reason string) (*models.ExternalTransfer, error) {
+	return r.close(id, func(transfer *models.ExternalTransfer) {
+		transfer.Fail(reason)
+	}, models.ExternalTransferStatusPendingReview, models.ExternalTransferStatusInitiated, models.ExternalTransferStatusSubmitted)
+}
+
+// close ends an unsettled transfer in one of the given statuses without moving funds
+func (r *externalTransferRepository) close(id uuid.UUID, apply func(*models.ExternalTransfer), statuses ...string) (*models.ExternalTransfer, error) {
+	var transfer *models.ExternalTransfer
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var account *models.Account
+		var err error
+		transfer, account, err = r.lockTransfer(tx, id, statuses...)
+		if err != nil {
+			return err
+		}
+
+		if transfer.IsOutbound() {
+			if err := releaseExternalTransferHold(tx, transfer, account); err != nil {
+				return err
+			}
+		}
+
+		expectedStatus := transfer.Status
+		apply(transfer)
+		return saveExternalTransfer(tx, transfer, expectedStatus)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// lockTransfer locks the transfer's account and then loads the transfer, which
+// must be in one of the given statuses. The account is locked first to take locks
+// in the same order as the hold repository.
+func (r *externalTransferRepository) lockTransfer(tx *gorm.DB, id uuid.UUID, statuses ...string) (*models.ExternalTransfer, *models.Account, error) {
+	var transfer models.ExternalTransfer
+	if err := tx.Where("id = ?", id).First(&transfer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrExternalTransferNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get external transfer: %w", err)
+	}
+
+	account, err := lockAccount(tx, transfer.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Re-read under the account lock in case the transfer was closed concurrently
+	if err := tx.First(&transfer, "id = ?", id).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to get external transfer: %w", err)
+	}
+
+	for _, status := range statuses {
+		if transfer.Status == status {
+			return &transfer, account, nil
+		}
+	}
+	return nil, nil, ErrExternalTransferStateChanged
+}
+
+// captureExternalTransferHold debits the account by capturing an outbound
+// transfer's hold in full
+func captureExternalTransferHold(tx *gorm.DB, transfer *models.ExternalTransfer, account *models.Account) (uuid.UUID, error) {
+	hold, err := loadPendingHold(tx, transfer)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	expectedVersion := hold.Version
+	hold.CaptureHold(transfer.Amount, account.Balance)
+
+	if err := tx.Model(account).Updates(map[string]interface{}{
+		"balance":      account.Balance.Sub(transfer.Amount),
+		"held_balance": account.HeldBalance.Sub(transfer.Amount),
+	}).Error; err != nil {
+		return uuid.Nil, fmt.Errorf("failed to debit account: %w", err)
+	}
+
+	if err := updateHold(tx, hold, expectedVersion); err != nil {
+		return uuid.Nil, err
+	}
+	return hold.ID, nil
+}
+
+// releaseExternalTransferHold returns an outbound transfer's held amount to the
+// account's available balance
+func releaseExternalTransferHold(tx *gorm.DB, transfer *models.ExternalTransfer, account *models.Account) error {
+	hold, err := loadPendingHold(tx, transfer)
+	if err != nil {
+		return err
+	}
+
+	expectedVersion := hold.Version
+	hold.ReleaseHold(models.HoldOutcomeReleased)
+
+	if err := tx.Model(account).Update("held_balance", account.HeldBalance.Sub(transfer.Amount)).Error; err != nil {
+		return fmt.Errorf("failed to release held balance: %w", err)
+	}
+
+	return updateHold(tx, hold, expectedVersion)
+}
+
+// creditExternalTransfer posts an inbound transfer's amount to the account
+func creditExternalTransfer(tx *gorm.DB, transfer *models.ExternalTransfer, account *models.Account) (uuid.UUID, error) {
+	newBalance := account.Balance.Add(transfer.Amount)
+
+	credit := &models.Transaction{
+		AccountID:       account.ID,
+		TransactionType: models.TransactionTypeCredit,
+		Amount:          transfer.Amount,
+		BalanceBefore:   account.Balance,
+		BalanceAfter:    newBalance,
+		Description:     "External transfer " + transfer.ReferenceNumber + ": " + transfer.Description,
+		Status:          models.TransactionStatusCompleted,
+	}
+
+	if err := tx.Create(credit).Error; err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create credit transaction: %w", err)
+	}
+
+	if err := tx.Model(account).Update("balance", newBalance).Error; err != nil {
+		return uuid.Nil, fmt.Errorf("failed to credit account: %w", err)
+	}
+
+	return credit.ID, nil
+}
+
+// loadPendingHold loads an outbound transfer's hold, which must still reserve funds
+func loadPendingHold(tx *gorm.DB, transfer *models.ExternalTransfer) (*models.Transaction, error) {
+	if transfer.HoldTransactionID == nil {
+		return nil, ErrHoldNotFound
+	}
+
+	var hold models.Transaction
+	if err := tx.First(&hold, "id = ?", *transfer.HoldTransactionID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrHoldNotFound
+		}
+		return nil, fmt.Errorf("failed to get hold: %w", err)
+	}
+
+	if !hold.IsActiveHold() {
+		return nil, ErrHoldNotActive
+	}
+	return &hold, nil
+}
+
+// saveExternalTransfer saves a transfer's new status, failing if its status
+// changed since it was loaded
+func saveExternalTransfer(tx *gorm.DB, transfer *models.ExternalTransfer, expectedStatus string) error {
+	result := tx.Model(transfer).
+		Where("status = ?", expectedStatus).
+		Select("status", "settlement_transaction_id", "return_code", "return_reason", "failure_reason",
+			"settled_at", "returned_at", "updated_at").
+		Updates(transfer)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update external transfer: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrExternalTransferStateChanged
+	}
+	return nil
+}
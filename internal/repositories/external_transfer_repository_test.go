@@ -0,0 +1,311 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// ExternalTransferRepositoryTestSuite is the test suite for ExternalTransfer repository
+type ExternalTransferRepositoryTestSuite struct {
+	suite.Suite
+	db          *gorm.DB
+	repo        ExternalTransferRepositoryInterface
+	accountRepo AccountRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *ExternalTransferRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.Account{}, &models.Transaction{}, &models.ExternalTransfer{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewExternalTransferRepository(db)
+	s.accountRepo = NewAccountRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *ExternalTransferRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestExternalTransferRepositoryTestSuite runs the test suite
+func TestExternalTransferRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ExternalTransferRepositoryTestSuite))
+}
+
+// Helper function to create a persisted checking account
+func (s *ExternalTransferRepositoryTestSuite) createTestAccount(balance decimal.Decimal) *models.Account {
+	account := &models.Account{
+		AccountNumber: gofakeit.Numerify("##########"),
+		RoutingNumber: gofakeit.Numerify("#########"),
+		UserID:        uuid.New(),
+		AccountType:   models.AccountTypeChecking,
+		Balance:       balance,
+	}
+	require.NoError(s.T(), s.db.Create(account).Error)
+	return account
+}
+
+// Helper function to create an initiated transfer
+func (s *ExternalTransferRepositoryTestSuite) createTransfer(account *models.Account, direction, amount string) *models.ExternalTransfer {
+	transfer := &models.ExternalTransfer{
+		UserID:                    account.UserID,
+		AccountID:                 account.ID,
+		Direction:                 direction,
+		Amount:                    decimal.RequireFromString(amount),
+		Description:               "Rent",
+		IdempotencyKey:            uuid.NewString(),
+		CounterpartyName:          "Jane Doe",
+		CounterpartyAccountNumber: "987654321",
+		CounterpartyRoutingNumber: "011000015",
+	}
+	require.NoError(s.T(), s.repo.Create(transfer))
+	return transfer
+}
+
+// Helper function to mark a transfer submitted with the given settlement time
+func (s *ExternalTransferRepositoryTestSuite) submit(transfer *models.ExternalTransfer, settleAfter time.Time) {
+	transfer.MarkSubmitted("nw-"+transfer.ID.String()[:8], time.Now(), settleAfter)
+	require.NoError(s.T(), s.repo.MarkSubmitted(transfer))
+}
+
+// Helper function to reload an account
+func (s *ExternalTransferRepositoryTestSuite) reload(account *models.Account) *models.Account {
+	var reloaded models.Account
+	require.NoError(s.T(), s.db.First(&reloaded, "id = ?", account.ID).Error)
+	return &reloaded
+}
+
+// TestCreate_OutboundHoldsFunds tests that an outbound transfer reserves its amount
+func (s *ExternalTransferRepositoryTestSuite) TestCreate_OutboundHoldsFunds() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+
+	transfer := s.createTransfer(account, models.ExternalTransferOutbound, "40.00")
+	require.NotNil(s.T(), transfer.HoldTransactionID)
+	assert.Equal(s.T(), models.ExternalTransferStatusInitiated, transfer.Status)
+	assert.NotEmpty(s.T(), transfer.ReferenceNumber)
+
+	updated := s.reload(account)
+	assert.Equal(s.T(), "100.00", updated.Balance.StringFixed(2))
+	assert.Equal(s.T(), "60.00", updated.AvailableBalance.StringFixed(2))
+
+	err := s.repo.Create(&models.ExternalTransfer{
+		UserID:                    account.UserID,
+		AccountID:                 account.ID,
+		Direction:                 models.ExternalTransferOutbound,
+		Amount:                    decimal.RequireFromString("60.01"),
+		Description:               "Too much",
+		IdempotencyKey:            uuid.NewString(),
+		CounterpartyName:          "Jane Doe",
+		CounterpartyAccountNumber: "987654321",
+		CounterpartyRoutingNumber: "011000015",
+	})
+	assert.ErrorIs(s.T(), err, ErrInsufficientFunds)
+}
+
+// TestCreate_InboundDoesNotCredit tests that an inbound transfer moves no funds until it settles
+func (s *ExternalTransferRepositoryTestSuite) TestCreate_InboundDoesNotCredit() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+
+	transfer := s.createTransfer(account, models.ExternalTransferInbound, "40.00")
+	assert.Nil(s.T(), transfer.HoldTransactionID)
+
+	updated := s.reload(account)
+	assert.Equal(s.T(), "100.00", updated.Balance.StringFixed(2))
+	assert.Equal(s.T(), "100.00", updated.AvailableBalance.StringFixed(2))
+}
+
+// TestSettle_OutboundCapturesHold tests that settling debits the held amount
+func (s *ExternalTransferRepositoryTestSuite) TestSettle_OutboundCapturesHold() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	transfer := s.createTransfer(account, models.ExternalTransferOutbound, "40.00")
+	s.submit(transfer, time.Now().Add(-time.Minute))
+
+	settled, err := s.repo.Settle(transfer.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.ExternalTransferStatusSettled, settled.Status)
+	require.NotNil(s.T(), settled.SettlementTransactionID)
+	assert.Equal(s.T(), *transfer.HoldTransactionID, *settled.SettlementTransactionID)
+
+	updated := s.reload(account)
+	assert.Equal(s.T(), "60.00", updated.Balance.StringFixed(2))
+	assert.Equal(s.T(), "0.00", updated.HeldBalance.StringFixed(2))
+
+	var hold models.Transaction
+	require.NoError(s.T(), s.db.First(&hold, "id = ?", *transfer.HoldTransactionID).Error)
+	assert.Equal(s.T(), models.HoldOutcomeCaptured, hold.HoldOutcome())
+
+	_, err = s.repo.Settle(transfer.ID)
+	assert.ErrorIs(s.T(), err, ErrExternalTransferStateChanged)
+}
+
+// TestSettle_InboundCreditsAccount tests that settling an inbound transfer credits the account
+func (s *ExternalTransferRepositoryTestSuite) TestSettle_InboundCreditsAccount() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	transfer := s.createTransfer(account, models.ExternalTransferInbound, "40.00")
+	s.submit(transfer, time.Now().Add(-time.Minute))
+
+	settled, err := s.repo.Settle(transfer.ID)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), settled.SettlementTransactionID)
+
+	var credit models.Transaction
+	require.NoError(s.T(), s.db.First(&credit, "id = ?", *settled.SettlementTransactionID).Error)
+	assert.Equal(s.T(), models.TransactionTypeCredit, credit.TransactionType)
+	assert.Equal(s.T(), "40.00", credit.Amount.StringFixed(2))
+
+	assert.Equal(s.T(), "140.00", s.reload(account).Balance.StringFixed(2))
+}
+
+// TestReturn_OutboundReleasesHold tests that a returned transfer gives the held funds back
+func (s *ExternalTransferRepositoryTestSuite) TestReturn_OutboundReleasesHold() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	transfer := s.createTransfer(account, models.ExternalTransferOutbound, "40.00")
+	s.submit(transfer, time.Now().Add(time.Hour))
+
+	returned, err := s.repo.Return(transfer.ID, "R01", "")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.ExternalTransferStatusReturned, returned.Status)
+	assert.Equal(s.T(), "R01", returned.ReturnCode)
+	assert.Equal(s.T(), "Insufficient funds", returned.ReturnReason)
+
+	updated := s.reload(account)
+	assert.Equal(s.T(), "100.00", updated.Balance.StringFixed(2))
+	assert.Equal(s.T(), "100.00", updated.AvailableBalance.StringFixed(2))
+
+	_, err = s.repo.Settle(transfer.ID)
+	assert.ErrorIs(s.T(), err, ErrExternalTransferStateChanged)
+}
+
+// TestFail_InitiatedTransfer tests that a refused transfer is failed and its hold released
+func (s *ExternalTransferRepositoryTestSuite) TestFail_InitiatedTransfer() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	transfer := s.createTransfer(account, models.ExternalTransferOutbound, "40.00")
+
+	failed, err := s.repo.Fail(transfer.ID, "invalid routing number")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.ExternalTransferStatusFailed, failed.Status)
+	assert.Equal(s.T(), "invalid routing number", failed.FailureReason)
+	assert.Equal(s.T(), "0.00", s.reload(account).HeldBalance.StringFixed(2))
+
+	_, err = s.repo.Return(transfer.ID, "R01", "")
+	assert.ErrorIs(s.T(), err, ErrExternalTransferStateChanged)
+}
+
+// TestReleaseFromReview tests that a transfer held for review keeps its hold and
+// is submitted only once released
+func (s *ExternalTransferRepositoryTestSuite) TestReleaseFromReview() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	transfer := &models.ExternalTransfer{
+		UserID:                    account.UserID,
+		AccountID:                 account.ID,
+		Direction:                 models.ExternalTransferOutbound,
+		Amount:                    decimal.RequireFromString("40.00"),
+		Description:               "Rent",
+		IdempotencyKey:            uuid.NewString(),
+		CounterpartyName:          "Jane Doe",
+		CounterpartyAccountNumber: "987654321",
+		CounterpartyRoutingNumber: "011000015",
+		Status:                    models.ExternalTransferStatusPendingReview,
+	}
+	require.NoError(s.T(), s.repo.Create(transfer))
+	require.NotNil(s.T(), transfer.HoldTransactionID)
+	assert.Equal(s.T(), "60.00", s.reload(account).AvailableBalance.StringFixed(2))
+
+	pending, err := s.repo.GetUnsubmitted(time.Now(), 10)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), pending)
+
+	require.NoError(s.T(), s.repo.ReleaseFromReview(transfer.ID))
+	assert.ErrorIs(s.T(), s.repo.ReleaseFromReview(transfer.ID), ErrExternalTransferStateChanged)
+
+	pending, err = s.repo.GetUnsubmitted(time.Now(), 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), pending, 1)
+	assert.Equal(s.T(), transfer.ID, pending[0].ID)
+	assert.Equal(s.T(), "60.00", s.reload(account).AvailableBalance.StringFixed(2))
+}
+
+// TestFail_PendingReviewReleasesHold tests that a transfer blocked by fraud review gives the held funds back
+func (s *ExternalTransferRepositoryTestSuite) TestFail_PendingReviewReleasesHold() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	transfer := &models.ExternalTransfer{
+		UserID:                    account.UserID,
+		AccountID:                 account.ID,
+		Direction:                 models.ExternalTransferOutbound,
+		Amount:                    decimal.RequireFromString("40.00"),
+		Description:               "Rent",
+		IdempotencyKey:            uuid.NewString(),
+		CounterpartyName:          "Jane Doe",
+		CounterpartyAccountNumber: "987654321",
+		CounterpartyRoutingNumber: "011000015",
+		Status:                    models.ExternalTransferStatusPendingReview,
+	}
+	require.NoError(s.T(), s.repo.Create(transfer))
+
+	_, err := s.repo.Return(transfer.ID, "R01", "")
+	assert.ErrorIs(s.T(), err, ErrExternalTransferStateChanged)
+
+	failed, err := s.repo.Fail(transfer.ID, "rejected by fraud review")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.ExternalTransferStatusFailed, failed.Status)
+	assert.Equal(s.T(), "100.00", s.reload(account).AvailableBalance.StringFixed(2))
+}
+
+// TestWorkerQueries tests selection of unsubmitted and due transfers
+func (s *ExternalTransferRepositoryTestSuite) TestWorkerQueries() {
+	account := s.createTestAccount(decimal.NewFromInt(1000))
+	unsubmitted := s.createTransfer(account, models.ExternalTransferOutbound, "10.00")
+	due := s.createTransfer(account, models.ExternalTransferOutbound, "10.00")
+	notDue := s.createTransfer(account, models.ExternalTransferInbound, "10.00")
+	s.submit(due, time.Now().Add(-time.Minute))
+	s.submit(notDue, time.Now().Add(time.Hour))
+
+	pending, err := s.repo.GetUnsubmitted(time.Now(), 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), pending, 1)
+	assert.Equal(s.T(), unsubmitted.ID, pending[0].ID)
+
+	pending, err = s.repo.GetUnsubmitted(time.Now().Add(-time.Hour), 10)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), pending)
+
+	dueTransfers, err := s.repo.GetDueForSettlement(time.Now(), 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), dueTransfers, 1)
+	assert.Equal(s.T(), due.ID, dueTransfers[0].ID)
+
+	assert.ErrorIs(s.T(), s.repo.MarkSubmitted(due), ErrExternalTransferStateChanged)
+
+	transfers, total, err := s.repo.GetByUserID(account.UserID, 0, 2)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(3), total)
+	assert.Len(s.T(), transfers, 2)
+
+	found, err := s.repo.GetByIdempotencyKey(due.IdempotencyKey)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), due.ID, found.ID)
+
+	_, err = s.repo.GetByID(uuid.New())
+	assert.ErrorIs(s.T(), err, ErrExternalTransferNotFound)
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrFraudReviewNotFound   = errors.New("fraud review not found")
+	ErrFraudReviewNotPending = errors.New("fraud review has already been decided")
+)
+
+// fraudReviewRepository implements FraudReviewRepositoryInterface
+type fraudReviewRepository struct {
+	db *gorm.DB
+}
+
+// NewFraudReviewRepository creates a new fraud review repository
+func NewFraudReviewRepository(db *gorm.DB) FraudReviewRepositoryInterface {
+	return &fraudReviewRepository{
+		db: db,
+	}
+}
+
+// Create stores a new fraud review
+func (r *fraudReviewRepository) Create(review *models.FraudReview) error {
+	if err := r.db.Create(review).Error; err != nil {
+		return fmt.Errorf("failed to create fraud review: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a fraud review by ID
+func (r *fraudReviewRepository) GetByID(id uuid.UUID) (*models.FraudReview, error) {
+	var review models.FraudReview
+	if err := r.db.First(&review, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrFraudReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to get fraud review: %w", err)
+	}
+	return &review, nil
+}
+
+// List retrieves fraud reviews, oldest first so the longest-waiting items are
+// reviewed first. An empty status lists reviews in every status.
+func (r *fraudReviewRepository) List(status string, offset, limit int) ([]models.FraudReview, int64, error) {
+	var reviews []models.FraudReview
+	var total int64
+
+	query := r.db.Model(&models.FraudReview{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count fraud reviews: %w", err)
+	}
+
+	if err := query.Order("created_at ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&reviews).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list fraud reviews: %w", err)
+	}
+
+	return reviews, total, nil
+}
+
+// GetExpired retrieves up to limit reviews still awaiting a decision whose hold has
+// expired at the given time
+func (r *fraudReviewRepository) GetExpired(at time.Time, limit int) ([]models.FraudReview, error) {
+	var reviews []models.FraudReview
+	if err := r.db.
+		Where("status = ? AND expires_at <= ?", models.FraudReviewStatusPending, at).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&reviews).Error; err != nil {
+		return nil, fmt.Errorf("failed to get expired fraud reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+// Decide saves the decision on a review, failing if the review was decided
+// concurrently so each review is acted on only once
+func (r *fraudReviewRepository) Decide(review *models.FraudReview) error {
+	result := r.db.Model(review).
+		Where("status = ?", models.FraudReviewStatusPending).
+		Select("status", "reviewed_by", "reviewed_at", "decision_reason", "updated_at").
+		Updates(review)
+	if result.Error != nil {
+		return fmt.Errorf("failed to decide fraud review: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrFraudReviewNotPending
+	}
+	return nil
+}
+
+// RecordFailure saves why an approved review's item could not be completed
+func (r *fraudReviewRepository) RecordFailure(review *models.FraudReview) error {
+	if err := r.db.Model(review).
+		Select("failure_reason", "updated_at").
+		Updates(review).Error; err != nil {
+		return fmt.Errorf("failed to record fraud review failure: %w", err)
+	}
+	return nil
+}
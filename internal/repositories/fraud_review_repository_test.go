@@ -0,0 +1,158 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// FraudReviewRepositoryTestSuite is the test suite for the fraud review repository
+type FraudReviewRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo FraudReviewRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *FraudReviewRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.FraudReview{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewFraudReviewRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *FraudReviewRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestFraudReviewRepositoryTestSuite runs the test suite
+func TestFraudReviewRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(FraudReviewRepositoryTestSuite))
+}
+
+// Helper function to open a review on a held debit
+func (s *FraudReviewRepositoryTestSuite) createReview() *models.FraudReview {
+	review := &models.FraudReview{
+		AccountID:   uuid.New(),
+		UserID:      uuid.New(),
+		SubjectType: models.FraudSubjectTransaction,
+		HoldID:      uuid.New(),
+		Amount:      decimal.RequireFromString("2500.00"),
+		Description: "ATM withdrawal",
+		Rules:       models.JSONBMap{models.FraudRuleNewLoginIP: "latest login came from new IP address 203.0.113.7"},
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+	require.NoError(s.T(), s.repo.Create(review))
+	return review
+}
+
+// TestCreateAndGet tests that the rules that fired round-trip through storage
+func (s *FraudReviewRepositoryTestSuite) TestCreateAndGet() {
+	review := s.createReview()
+	assert.Equal(s.T(), models.FraudReviewStatusPending, review.Status)
+
+	saved, err := s.repo.GetByID(review.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), review.Rules, saved.Rules)
+	assert.True(s.T(), saved.Amount.Equal(review.Amount))
+
+	_, err = s.repo.GetByID(uuid.New())
+	assert.ErrorIs(s.T(), err, ErrFraudReviewNotFound)
+}
+
+// TestList tests status filtering and that the oldest reviews come first
+func (s *FraudReviewRepositoryTestSuite) TestList() {
+	newer := s.createReview()
+	older := s.createReview()
+	require.NoError(s.T(), s.db.Model(older).UpdateColumn("created_at", time.Now().Add(-time.Hour)).Error)
+
+	decided := s.createReview()
+	decided.Reject(uuid.New(), "Confirmed fraud", time.Now())
+	require.NoError(s.T(), s.repo.Decide(decided))
+
+	reviews, total, err := s.repo.List(models.FraudReviewStatusPending, 0, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), total)
+	require.Len(s.T(), reviews, 2)
+	assert.Equal(s.T(), older.ID, reviews[0].ID)
+	assert.Equal(s.T(), newer.ID, reviews[1].ID)
+
+	_, total, err = s.repo.List("", 0, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(3), total)
+}
+
+// TestGetExpired tests that only undecided reviews past their expiry are returned
+func (s *FraudReviewRepositoryTestSuite) TestGetExpired() {
+	now := time.Now()
+	expired := s.createReview()
+	require.NoError(s.T(), s.db.Model(expired).UpdateColumn("expires_at", now.Add(-time.Minute)).Error)
+	s.createReview()
+
+	decided := s.createReview()
+	require.NoError(s.T(), s.db.Model(decided).UpdateColumn("expires_at", now.Add(-time.Minute)).Error)
+	decided.Reject(uuid.New(), "Confirmed fraud", now.Add(-2*time.Minute))
+	require.NoError(s.T(), s.repo.Decide(decided))
+
+	reviews, err := s.repo.GetExpired(now, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), reviews, 1)
+	assert.Equal(s.T(), expired.ID, reviews[0].ID)
+}
+
+// TestDecide_OnlyOnce tests that a review cannot be decided twice
+func (s *FraudReviewRepositoryTestSuite) TestDecide_OnlyOnce() {
+	review := s.createReview()
+	adminID := uuid.New()
+
+	review.Approve(adminID, "Customer confirmed", time.Now())
+	require.NoError(s.T(), s.repo.Decide(review))
+
+	saved, err := s.repo.GetByID(review.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.FraudReviewStatusApproved, saved.Status)
+	assert.Equal(s.T(), adminID, *saved.ReviewedBy)
+	assert.Equal(s.T(), "Customer confirmed", *saved.DecisionReason)
+
+	review.Reject(uuid.New(), "Changed my mind", time.Now())
+	assert.ErrorIs(s.T(), s.repo.Decide(review), ErrFraudReviewNotPending)
+
+	saved, err = s.repo.GetByID(review.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.FraudReviewStatusApproved, saved.Status)
+}
+
+// TestRecordFailure tests that the failure reason of an approved review is saved
+func (s *FraudReviewRepositoryTestSuite) TestRecordFailure() {
+	review := s.createReview()
+	review.Approve(uuid.New(), "Customer confirmed", time.Now())
+	require.NoError(s.T(), s.repo.Decide(review))
+
+	review.Fail("hold has expired")
+	require.NoError(s.T(), s.repo.RecordFailure(review))
+
+	saved, err := s.repo.GetByID(review.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "hold has expired", *saved.FailureReason)
+	assert.Equal(s.T(), models.FraudReviewStatusApproved, saved.Status)
+}
@@ -0,0 +1,222 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrHoldNotFound       = errors.New("hold not found")
+	ErrHoldNotActive      = errors.New("hold is no longer active")
+	ErrHoldExpired        = errors.New("hold has expired")
+	ErrHoldNotExpired     = errors.New("hold has not expired")
+	ErrHoldAmountExceeded = errors.New("capture amount exceeds held amount")
+)
+
+// holdRepository implements HoldRepositoryInterface
+type holdRepository struct {
+	db *gorm.DB
+}
+
+// NewHoldRepository creates a new hold repository
+func NewHoldRepository(db *gorm.DB) HoldRepositoryInterface {
+	return &holdRepository{
+		db: db,
+	}
+}
+
+// PlaceHold reserves the hold amount against the account's available balance and
+// stores the hold as a pending debit
+func (r *holdRepository) PlaceHold(hold *models.Transaction) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		account, err := lockAccount(tx, hold.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if !account.IsActive() {
+			return ErrAccountNotActive
+		}
+
+		if !account.HasAvailableFunds(hold.Amount) {
+			return ErrInsufficientFunds
+		}
+
+		// The balance the account will have if the hold is captured in full
+		hold.BalanceBefore = account.Balance
+		hold.BalanceAfter = account.Balance.Sub(hold.Amount)
+
+		if err := tx.Create(hold).Error; err != nil {
+			return fmt.Errorf("failed to create hold: %w", err)
+		}
+
+		if err := tx.Model(account).Update("held_balance", account.HeldBalance.Add(hold.Amount)).Error; err != nil {
+			return fmt.Errorf("failed to update held balance: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetHold retrieves a hold by ID
+func (r *holdRepository) GetHold(id uuid.UUID) (*models.Transaction, error) {
+	var hold models.Transaction
+	if err := r.db.Where("id = ? AND transaction_type = ? AND pending_until IS NOT NULL", id, models.TransactionTypeDebit).
+		First(&hold).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrHoldNotFound
+		}
+		return nil, fmt.Errorf("failed to get hold: %w", err)
+	}
+	return &hold, nil
+}
+
+// GetActiveHolds retrieves an account's holds that still reserve funds, soonest to expire first
+func (r *holdRepository) GetActiveHolds(accountID uuid.UUID) ([]models.Transaction, error) {
+	var holds []models.Transaction
+	if err := r.db.Where("account_id = ? AND transaction_type = ? AND status = ? AND pending_until IS NOT NULL",
+		accountID, models.TransactionTypeDebit, models.TransactionStatusPending).
+		Order("pending_until ASC").
+		Find(&holds).Error; err != nil {
+		return nil, fmt.Errorf("failed to get active holds: %w", err)
+	}
+	return holds, nil
+}
+
+// CaptureHold debits the account for amount, up to the held amount, and completes
+// the hold. Any uncaptured remainder is returned to the available balance.
+func (r *holdRepository) CaptureHold(id uuid.UUID, amount decimal.Decimal) (*models.Transaction, error) {
+	var hold *models.Transaction
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var account *models.Account
+		var err error
+		hold, account, err = r.lockActiveHold(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if hold.IsPendingExpired() {
+			return ErrHoldExpired
+		}
+
+		if amount.GreaterThan(hold.Amount) {
+			return ErrHoldAmountExceeded
+		}
+
+		heldAmount := hold.Amount
+		expectedVersion := hold.Version
+		newBalance := account.Balance.Sub(amount)
+		hold.CaptureHold(amount, account.Balance)
+
+		if err := tx.Model(account).Updates(map[string]interface{}{
+			"balance":      newBalance,
+			"held_balance": account.HeldBalance.Sub(heldAmount),
+		}).Error; err != nil {
+			return fmt.Errorf("failed to capture hold: %w", err)
+		}
+
+		return updateHold(tx, hold, expectedVersion)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// ReleaseHold returns the held amount to the account's available balance without
+// moving funds. Holds released with the expired outcome must be past their expiry.
+func (r *holdRepository) ReleaseHold(id uuid.UUID, outcome string) (*models.Transaction, error) {
+	var hold *models.Transaction
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var account *models.Account
+		var err error
+		hold, account, err = r.lockActiveHold(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if outcome == models.HoldOutcomeExpired && !hold.IsPendingExpired() {
+			return ErrHoldNotExpired
+		}
+
+		expectedVersion := hold.Version
+		heldAmount := hold.Amount
+		hold.ReleaseHold(outcome)
+
+		if err := tx.Model(account).Update("held_balance", account.HeldBalance.Sub(heldAmount)).Error; err != nil {
+			return fmt.Errorf("failed to release hold: %w", err)
+		}
+
+		return updateHold(tx, hold, expectedVersion)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// lockActiveHold locks the hold's account and then loads the hold, which must still
+// be pending. The account is locked first so every path that changes the held
+// balance takes the locks in the same order.
+func (r *holdRepository) lockActiveHold(tx *gorm.DB, id uuid.UUID) (*models.Transaction, *models.Account, error) {
+	var hold models.Transaction
+	if err := tx.Where("id = ? AND transaction_type = ? AND pending_until IS NOT NULL", id, models.TransactionTypeDebit).
+		First(&hold).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrHoldNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get hold: %w", err)
+	}
+
+	account, err := lockAccount(tx, hold.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Re-read under the account lock in case the hold was settled concurrently
+	if err := tx.First(&hold, "id = ?", id).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to get hold: %w", err)
+	}
+
+	if !hold.IsPending() {
+		return nil, nil, ErrHoldNotActive
+	}
+
+	return &hold, account, nil
+}
+
+// lockAccount loads an account with a row lock for the rest of the database transaction
+func lockAccount(tx *gorm.DB, accountID uuid.UUID) (*models.Account, error) {
+	account := &models.Account{ID: accountID}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&account).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to lock account: %w", err)
+	}
+	return account, nil
+}
+
+// updateHold saves a settled hold, failing if it changed since it was loaded
+func updateHold(tx *gorm.DB, hold *models.Transaction, expectedVersion int) error {
+	result := tx.Model(hold).
+		Where("status = ? AND version = ?", models.TransactionStatusPending, expectedVersion).
+		Updates(hold)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update hold: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrHoldNotActive
+	}
+	return nil
+}
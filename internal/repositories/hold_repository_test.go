@@ -0,0 +1,214 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// HoldRepositoryTestSuite is the test suite for Hold repository
+type HoldRepositoryTestSuite struct {
+	suite.Suite
+	db          *gorm.DB
+	repo        HoldRepositoryInterface
+	accountRepo AccountRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *HoldRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.Account{}, &models.Transaction{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewHoldRepository(db)
+	s.accountRepo = NewAccountRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *HoldRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestHoldRepositoryTestSuite runs the test suite
+func TestHoldRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(HoldRepositoryTestSuite))
+}
+
+// Helper function to create a persisted checking account
+func (s *HoldRepositoryTestSuite) createTestAccount(balance decimal.Decimal) *models.Account {
+	account := &models.Account{
+		AccountNumber: gofakeit.Numerify("##########"),
+		RoutingNumber: gofakeit.Numerify("#########"),
+		UserID:        uuid.New(),
+		AccountType:   models.AccountTypeChecking,
+		Balance:       balance,
+	}
+	require.NoError(s.T(), s.db.Create(account).Error)
+	return account
+}
+
+// Helper function to place a hold that expires at the given time
+func (s *HoldRepositoryTestSuite) placeHold(account *models.Account, amount string, expiresAt time.Time) *models.Transaction {
+	hold := models.NewHold(account.ID, decimal.RequireFromString(amount), "Card authorization", expiresAt)
+	require.NoError(s.T(), s.repo.PlaceHold(hold))
+	return hold
+}
+
+// Helper function to reload an account
+func (s *HoldRepositoryTestSuite) reload(account *models.Account) *models.Account {
+	var reloaded models.Account
+	require.NoError(s.T(), s.db.First(&reloaded, "id = ?", account.ID).Error)
+	return &reloaded
+}
+
+// TestPlaceHold_ReducesAvailableBalanceOnly tests that a hold reserves funds without moving them
+func (s *HoldRepositoryTestSuite) TestPlaceHold_ReducesAvailableBalanceOnly() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+
+	hold := s.placeHold(account, "30.00", time.Now().Add(time.Hour))
+	assert.Equal(s.T(), models.TransactionStatusPending, hold.Status)
+
+	updated := s.reload(account)
+	assert.Equal(s.T(), "100.00", updated.Balance.StringFixed(2))
+	assert.Equal(s.T(), "30.00", updated.HeldBalance.StringFixed(2))
+	assert.Equal(s.T(), "70.00", updated.AvailableBalance.StringFixed(2))
+
+	holds, err := s.repo.GetActiveHolds(account.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), holds, 1)
+	assert.Equal(s.T(), hold.ID, holds[0].ID)
+}
+
+// TestPlaceHold_InsufficientAvailableBalance tests that holds cannot exceed the available balance
+func (s *HoldRepositoryTestSuite) TestPlaceHold_InsufficientAvailableBalance() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	s.placeHold(account, "80.00", time.Now().Add(time.Hour))
+
+	hold := models.NewHold(account.ID, decimal.RequireFromString("20.01"), "Card authorization", time.Now().Add(time.Hour))
+	assert.ErrorIs(s.T(), s.repo.PlaceHold(hold), ErrInsufficientFunds)
+}
+
+// TestHeldFunds_CannotBeSpent tests that debits and transfers check the available balance
+func (s *HoldRepositoryTestSuite) TestHeldFunds_CannotBeSpent() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	other := s.createTestAccount(decimal.Zero)
+	s.placeHold(account, "75.00", time.Now().Add(time.Hour))
+
+	err := s.accountRepo.UpdateBalance(account.ID, decimal.NewFromInt(30), models.TransactionTypeDebit)
+	assert.ErrorIs(s.T(), err, ErrInsufficientFunds)
+
+	_, _, err = s.accountRepo.ExecuteAtomicTransfer(account.ID, other.ID, decimal.NewFromInt(30), "out", "in", nil)
+	assert.ErrorIs(s.T(), err, ErrInsufficientFunds)
+
+	require.NoError(s.T(), s.accountRepo.UpdateBalance(account.ID, decimal.NewFromInt(25), models.TransactionTypeDebit))
+	assert.Equal(s.T(), "0.00", s.reload(account).AvailableBalance.StringFixed(2))
+}
+
+// TestCaptureHold_PartialCaptureReturnsRemainder tests capturing less than the held amount
+func (s *HoldRepositoryTestSuite) TestCaptureHold_PartialCaptureReturnsRemainder() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	hold := s.placeHold(account, "50.00", time.Now().Add(time.Hour))
+
+	captured, err := s.repo.CaptureHold(hold.ID, decimal.RequireFromString("42.50"))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TransactionStatusCompleted, captured.Status)
+	assert.Equal(s.T(), models.HoldOutcomeCaptured, captured.HoldOutcome())
+
+	var stored models.Transaction
+	require.NoError(s.T(), s.db.First(&stored, "id = ?", hold.ID).Error)
+	assert.Equal(s.T(), "42.50", stored.Amount.StringFixed(2))
+	assert.Equal(s.T(), "100.00", stored.BalanceBefore.StringFixed(2))
+	assert.Equal(s.T(), "57.50", stored.BalanceAfter.StringFixed(2))
+
+	updated := s.reload(account)
+	assert.Equal(s.T(), "57.50", updated.Balance.StringFixed(2))
+	assert.True(s.T(), updated.HeldBalance.IsZero())
+	assert.Equal(s.T(), "57.50", updated.AvailableBalance.StringFixed(2))
+
+	_, err = s.repo.CaptureHold(hold.ID, decimal.NewFromInt(1))
+	assert.ErrorIs(s.T(), err, ErrHoldNotActive)
+}
+
+// TestCaptureHold_RejectsExpiredAndExcess tests capture limits
+func (s *HoldRepositoryTestSuite) TestCaptureHold_RejectsExpiredAndExcess() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	hold := s.placeHold(account, "10.00", time.Now().Add(time.Hour))
+
+	_, err := s.repo.CaptureHold(hold.ID, decimal.RequireFromString("10.01"))
+	assert.ErrorIs(s.T(), err, ErrHoldAmountExceeded)
+
+	require.NoError(s.T(), s.db.Model(&models.Transaction{}).Where("id = ?", hold.ID).
+		UpdateColumn("pending_until", time.Now().Add(-time.Minute)).Error)
+
+	_, err = s.repo.CaptureHold(hold.ID, decimal.NewFromInt(10))
+	assert.ErrorIs(s.T(), err, ErrHoldExpired)
+}
+
+// TestReleaseHold_RestoresAvailableBalance tests releasing and expiring holds
+func (s *HoldRepositoryTestSuite) TestReleaseHold_RestoresAvailableBalance() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	released := s.placeHold(account, "20.00", time.Now().Add(time.Hour))
+	expired := s.placeHold(account, "30.00", time.Now().Add(time.Hour))
+
+	// An active hold cannot be expired early
+	_, err := s.repo.ReleaseHold(expired.ID, models.HoldOutcomeExpired)
+	assert.ErrorIs(s.T(), err, ErrHoldNotExpired)
+
+	hold, err := s.repo.ReleaseHold(released.ID, models.HoldOutcomeReleased)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TransactionStatusFailed, hold.Status)
+	assert.Equal(s.T(), "30.00", s.reload(account).HeldBalance.StringFixed(2))
+
+	require.NoError(s.T(), s.db.Model(&models.Transaction{}).Where("id = ?", expired.ID).
+		UpdateColumn("pending_until", time.Now().Add(-time.Minute)).Error)
+
+	hold, err = s.repo.ReleaseHold(expired.ID, models.HoldOutcomeExpired)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.HoldOutcomeExpired, hold.HoldOutcome())
+
+	updated := s.reload(account)
+	assert.Equal(s.T(), "100.00", updated.Balance.StringFixed(2))
+	assert.True(s.T(), updated.HeldBalance.IsZero())
+
+	_, err = s.repo.ReleaseHold(expired.ID, models.HoldOutcomeReleased)
+	assert.ErrorIs(s.T(), err, ErrHoldNotActive)
+}
+
+// TestGetHold_NotFound tests that ordinary transactions are not holds
+func (s *HoldRepositoryTestSuite) TestGetHold_NotFound() {
+	account := s.createTestAccount(decimal.NewFromInt(100))
+	debit := &models.Transaction{
+		AccountID:       account.ID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromInt(10),
+		BalanceBefore:   decimal.NewFromInt(100),
+		BalanceAfter:    decimal.NewFromInt(90),
+		Description:     "ATM Withdrawal",
+	}
+	require.NoError(s.T(), s.db.Create(debit).Error)
+
+	_, err := s.repo.GetHold(debit.ID)
+	assert.ErrorIs(s.T(), err, ErrHoldNotFound)
+
+	_, err = s.repo.GetHold(uuid.New())
+	assert.ErrorIs(s.T(), err, ErrHoldNotFound)
+}
@@ -0,0 +1,236 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrInterestPostingNotFound = errors.New("interest posting not found")
+	ErrInterestAlreadyPosted   = errors.New("interest already posted for this period")
+)
+
+// interestRepository implements InterestRepositoryInterface
+type interestRepository struct {
+	db *gorm.DB
+}
+
+// NewInterestRepository creates a new interest repository
+func NewInterestRepository(db *gorm.DB) InterestRepositoryInterface {
+	return &interestRepository{
+		db: db,
+	}
+}
+
+// GetInterestBearingAccounts retrieves active savings and money market accounts with a positive rate
+func (r *interestRepository) GetInterestBearingAccounts(offset, limit int) ([]models.Account, error) {
+	var accounts []models.Account
+	if err := r.db.Where("account_type IN ? AND status = ? AND interest_rate > 0",
+		[]string{models.AccountTypeSavings, models.AccountTypeMoneyMarket}, models.AccountStatusActive).
+		Order("created_at ASC, id ASC").
+		Offset(offset).Limit(limit).
+		Find(&accounts).Error; err != nil {
+		return nil, fmt.Errorf("failed to get interest bearing accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// CreateAccrual stores a daily accrual unless the account already has one for that
+// day. It reports whether a new accrual was stored.
+func (r *interestRepository) CreateAccrual(accrual *models.InterestAccrual) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "account_id"}, {Name: "accrual_date"}},
+		DoNothing: true,
+	}).Create(accrual)
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create interest accrual: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetLastAccrualDate returns the latest day accrued for an account, or nil if none has been
+func (r *interestRepository) GetLastAccrualDate(accountID uuid.UUID) (*time.Time, error) {
+	var accrual models.InterestAccrual
+	if err := r.db.Where("account_id = ?", accountID).
+		Order("accrual_date DESC").
+		First(&accrual).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get last interest accrual: %w", err)
+	}
+	return &accrual.AccrualDate, nil
+}
+
+// GetEarliestUnpostedAccrualDate returns the earliest accrued day not yet paid, or nil if all are paid
+func (r *interestRepository) GetEarliestUnpostedAccrualDate(accountID uuid.UUID) (*time.Time, error) {
+	var accrual models.InterestAccrual
+	if err := r.db.Where("account_id = ? AND posting_id IS NULL", accountID).
+		Order("accrual_date ASC").
+		First(&accrual).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get unposted interest accrual: %w", err)
+	}
+	return &accrual.AccrualDate, nil
+}
+
+// GetAccruals retrieves an account's accruals for the days between startDate and endDate inclusive
+func (r *interestRepository) GetAccruals(accountID uuid.UUID, startDate, endDate time.Time) ([]models.InterestAccrual, error) {
+	var accruals []models.InterestAccrual
+	if err := r.db.Where("account_id = ? AND accrual_date BETWEEN ? AND ?",
+		accountID, models.InterestDate(startDate), models.InterestDate(endDate)).
+		Order("accrual_date ASC").
+		Find(&accruals).Error; err != nil {
+		return nil, fmt.Errorf("failed to get interest accruals: %w", err)
+	}
+	return accruals, nil
+}
+
+// GetLastPosting retrieves an account's most recent interest posting
+func (r *interestRepository) GetLastPosting(accountID uuid.UUID) (*models.InterestPosting, error) {
+	var posting models.InterestPosting
+	if err := r.db.Where("account_id = ?", accountID).
+		Order("period_start DESC").
+		First(&posting).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInterestPostingNotFound
+		}
+		return nil, fmt.Errorf("failed to get last interest posting: %w", err)
+	}
+	return &posting, nil
+}
+
+// PostInterest pays an account's unpaid accruals up to the end of the month starting
+// at periodStart. Accruals from earlier months that were backfilled after their own
+// month was posted are included. The whole cents are credited to the account as an
+// INCOME transaction and the remainder is carried forward, all in one database
+// transaction; a month can only be posted once.
+func (r *interestRepository) PostInterest(accountID uuid.UUID, periodStart time.Time) (*models.InterestPosting, error) {
+	periodStart = models.InterestPeriodStart(periodStart)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+
+	var posting *models.InterestPosting
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		account := &models.Account{ID: accountID}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&account).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to lock account: %w", err)
+		}
+
+		if !account.IsActive() {
+			return ErrAccountNotActive
+		}
+
+		var existing int64
+		if err := tx.Model(&models.InterestPosting{}).
+			Where("account_id = ? AND period_start = ?", accountID, periodStart).
+			Count(&existing).Error; err != nil {
+			return fmt.Errorf("failed to check interest posting: %w", err)
+		}
+		if existing > 0 {
+			return ErrInterestAlreadyPosted
+		}
+
+		carry := decimal.Zero
+		var previous models.InterestPosting
+		if err := tx.Where("account_id = ?", accountID).
+			Order("period_start DESC").
+			First(&previous).Error; err == nil {
+			carry = previous.Carry
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get previous interest posting: %w", err)
+		}
+
+		var accruals []models.InterestAccrual
+		if err := tx.Where("account_id = ? AND posting_id IS NULL AND accrual_date < ?", accountID, periodEnd).
+			Find(&accruals).Error; err != nil {
+			return fmt.Errorf("failed to get unposted interest accruals: %w", err)
+		}
+
+		accrued := carry
+		accrualIDs := make([]uuid.UUID, 0, len(accruals))
+		for i := range accruals {
+			accrued = accrued.Add(accruals[i].Amount)
+			accrualIDs = append(accrualIDs, accruals[i].ID)
+		}
+
+		amount, remainder := models.SplitInterest(accrued)
+		posting = &models.InterestPosting{
+			ID:           uuid.New(),
+			AccountID:    accountID,
+			PeriodStart:  periodStart,
+			AccrualCount: len(accruals),
+			Accrued:      accrued,
+			Amount:       amount,
+			Carry:        remainder,
+		}
+
+		if amount.IsPositive() {
+			// Credit in place so a balance change committed by a path that does not
+			// take the account lock is never overwritten
+			if err := tx.Model(account).Update("balance", gorm.Expr("balance + ?", amount)).Error; err != nil {
+				return fmt.Errorf("failed to credit interest: %w", err)
+			}
+
+			var newBalance decimal.Decimal
+			if err := tx.Model(&models.Account{}).
+				Where("id = ?", accountID).
+				Pluck("balance", &newBalance).Error; err != nil {
+				return fmt.Errorf("failed to read credited balance: %w", err)
+			}
+			balanceBefore := newBalance.Sub(amount)
+
+			credit := &models.Transaction{
+				AccountID:       accountID,
+				TransactionType: models.TransactionTypeCredit,
+				Amount:          amount,
+				BalanceBefore:   balanceBefore,
+				BalanceAfter:    newBalance,
+				Description:     fmt.Sprintf("%s - %s", models.InterestTransactionDescription, periodStart.Format("January 2006")),
+				Status:          models.TransactionStatusCompleted,
+				Category:        models.InterestTransactionCategory,
+				Metadata: models.JSONBMap{
+					"interest_posting_id": posting.ID.String(),
+					"interest_period":     periodStart.Format("2006-01"),
+				},
+			}
+			if err := tx.Create(credit).Error; err != nil {
+				return fmt.Errorf("failed to create interest transaction: %w", err)
+			}
+			posting.TransactionID = &credit.ID
+		}
+
+		if err := tx.Create(posting).Error; err != nil {
+			return fmt.Errorf("failed to create interest posting: %w", err)
+		}
+
+		if len(accrualIDs) > 0 {
+			if err := tx.Model(&models.InterestAccrual{}).
+				Where("id IN ?", accrualIDs).
+				Update("posting_id", posting.ID).Error; err != nil {
+				return fmt.Errorf("failed to mark interest accruals posted: %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return posting, nil
+}
@@ -0,0 +1,193 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// InterestRepositoryTestSuite is the test suite for Interest repository
+type InterestRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo InterestRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *InterestRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.Account{}, &models.Transaction{}, &models.InterestAccrual{}, &models.InterestPosting{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewInterestRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *InterestRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestInterestRepositoryTestSuite runs the test suite
+func TestInterestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InterestRepositoryTestSuite))
+}
+
+// Helper function to create a persisted account of the given type
+func (s *InterestRepositoryTestSuite) createTestAccount(accountType string, balance decimal.Decimal) *models.Account {
+	account := &models.Account{
+		AccountNumber: gofakeit.Numerify("##########"),
+		RoutingNumber: gofakeit.Numerify("#########"),
+		UserID:        uuid.New(),
+		AccountType:   accountType,
+		Balance:       balance,
+	}
+	require.NoError(s.T(), s.db.Create(account).Error)
+	return account
+}
+
+// Helper function to store a day's accrual on the given balance
+func (s *InterestRepositoryTestSuite) accrue(account *models.Account, day time.Time, balance decimal.Decimal) {
+	_, err := s.repo.CreateAccrual(models.NewInterestAccrual(account.ID, day, balance, account.InterestRate))
+	require.NoError(s.T(), err)
+}
+
+// TestGetInterestBearingAccounts_ExcludesCheckingAndClosed tests account selection
+func (s *InterestRepositoryTestSuite) TestGetInterestBearingAccounts_ExcludesCheckingAndClosed() {
+	savings := s.createTestAccount(models.AccountTypeSavings, decimal.NewFromInt(100))
+	moneyMarket := s.createTestAccount(models.AccountTypeMoneyMarket, decimal.NewFromInt(100))
+	s.createTestAccount(models.AccountTypeChecking, decimal.NewFromInt(100))
+	closed := s.createTestAccount(models.AccountTypeSavings, decimal.NewFromInt(100))
+	require.NoError(s.T(), s.db.Model(closed).Update("status", models.AccountStatusClosed).Error)
+
+	accounts, err := s.repo.GetInterestBearingAccounts(0, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), accounts, 2)
+	assert.ElementsMatch(s.T(), []uuid.UUID{savings.ID, moneyMarket.ID}, []uuid.UUID{accounts[0].ID, accounts[1].ID})
+}
+
+// TestCreateAccrual_IsIdempotentPerDay tests that a day is only accrued once
+func (s *InterestRepositoryTestSuite) TestCreateAccrual_IsIdempotentPerDay() {
+	account := s.createTestAccount(models.AccountTypeSavings, decimal.NewFromInt(1000))
+	day := time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC)
+
+	created, err := s.repo.CreateAccrual(models.NewInterestAccrual(account.ID, day, decimal.NewFromInt(1000), account.InterestRate))
+	require.NoError(s.T(), err)
+	assert.True(s.T(), created)
+
+	created, err = s.repo.CreateAccrual(models.NewInterestAccrual(account.ID, day.Add(15*time.Hour), decimal.NewFromInt(2000), account.InterestRate))
+	require.NoError(s.T(), err)
+	assert.False(s.T(), created)
+
+	accruals, err := s.repo.GetAccruals(account.ID, day, day)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), accruals, 1)
+	assert.True(s.T(), decimal.NewFromInt(1000).Equal(accruals[0].Balance))
+
+	last, err := s.repo.GetLastAccrualDate(account.ID)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), last)
+	assert.True(s.T(), day.Equal(*last))
+}
+
+// TestPostInterest_CreditsWholeCentsAndCarriesRemainder tests a monthly posting
+func (s *InterestRepositoryTestSuite) TestPostInterest_CreditsWholeCentsAndCarriesRemainder() {
+	account := s.createTestAccount(models.AccountTypeSavings, decimal.NewFromInt(1000))
+	september := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	for day := september; day.Month() == time.September; day = day.AddDate(0, 0, 1) {
+		s.accrue(account, day, decimal.NewFromInt(1000))
+	}
+	// October accruals are not part of September's posting
+	s.accrue(account, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), decimal.NewFromInt(1000))
+
+	posting, err := s.repo.PostInterest(account.ID, september)
+	require.NoError(s.T(), err)
+
+	// 30 days * 1000 * 0.015 / 365 = 1.2328767120
+	assert.Equal(s.T(), 30, posting.AccrualCount)
+	assert.Equal(s.T(), "1.23", posting.Amount.StringFixed(2))
+	assert.True(s.T(), posting.Accrued.Sub(posting.Amount).Equal(posting.Carry))
+	assert.True(s.T(), posting.Carry.LessThan(decimal.RequireFromString("0.01")))
+	require.NotNil(s.T(), posting.TransactionID)
+
+	var credit models.Transaction
+	require.NoError(s.T(), s.db.First(&credit, "id = ?", *posting.TransactionID).Error)
+	assert.Equal(s.T(), models.TransactionTypeCredit, credit.TransactionType)
+	assert.Equal(s.T(), models.InterestTransactionCategory, credit.Category)
+	assert.Equal(s.T(), "1.23", credit.Amount.StringFixed(2))
+	assert.Equal(s.T(), "1001.23", credit.BalanceAfter.StringFixed(2))
+
+	var updated models.Account
+	require.NoError(s.T(), s.db.First(&updated, "id = ?", account.ID).Error)
+	assert.Equal(s.T(), "1001.23", updated.Balance.StringFixed(2))
+
+	earliest, err := s.repo.GetEarliestUnpostedAccrualDate(account.ID)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), earliest)
+	assert.Equal(s.T(), time.October, earliest.Month())
+}
+
+// TestPostInterest_OnlyOncePerMonth tests that re-posting a month is rejected
+func (s *InterestRepositoryTestSuite) TestPostInterest_OnlyOncePerMonth() {
+	account := s.createTestAccount(models.AccountTypeMoneyMarket, decimal.NewFromInt(5000))
+	august := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+	s.accrue(account, august, decimal.NewFromInt(5000))
+
+	_, err := s.repo.PostInterest(account.ID, august)
+	require.NoError(s.T(), err)
+
+	_, err = s.repo.PostInterest(account.ID, august.AddDate(0, 0, 10))
+	assert.ErrorIs(s.T(), err, ErrInterestAlreadyPosted)
+
+	var count int64
+	require.NoError(s.T(), s.db.Model(&models.Transaction{}).Where("account_id = ?", account.ID).Count(&count).Error)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+// TestPostInterest_SubCentCarriedForward tests that a month under a cent posts nothing but carries it
+func (s *InterestRepositoryTestSuite) TestPostInterest_SubCentCarriedForward() {
+	account := s.createTestAccount(models.AccountTypeSavings, decimal.NewFromInt(100))
+	july := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	s.accrue(account, july, decimal.NewFromInt(100))
+
+	posting, err := s.repo.PostInterest(account.ID, july)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), posting.Amount.IsZero())
+	assert.Nil(s.T(), posting.TransactionID)
+
+	// A day accrued late for July is paid with August's posting together with the carry
+	s.accrue(account, july.AddDate(0, 0, 1), decimal.NewFromInt(100))
+	posting, err = s.repo.PostInterest(account.ID, july.AddDate(0, 1, 0))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, posting.AccrualCount)
+
+	twoDays := models.NewInterestAccrual(account.ID, july, decimal.NewFromInt(100), account.InterestRate).Amount.Mul(decimal.NewFromInt(2))
+	assert.True(s.T(), twoDays.Equal(posting.Accrued))
+}
+
+// TestPostInterest_InactiveAccount tests that closed accounts are not credited
+func (s *InterestRepositoryTestSuite) TestPostInterest_InactiveAccount() {
+	account := s.createTestAccount(models.AccountTypeSavings, decimal.NewFromInt(100))
+	require.NoError(s.T(), s.db.Model(account).Update("status", models.AccountStatusClosed).Error)
+
+	_, err := s.repo.PostInterest(account.ID, time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+	assert.ErrorIs(s.T(), err, ErrAccountNotActive)
+}
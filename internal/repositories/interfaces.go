@@ -27,12 +27,25 @@ type AccountRepositoryInterface interface {
 	GenerateUniqueAccountNumber(accountType string) (string, error)
 	CreateWithTransaction(account *models.Account, transactions []models.Transaction) error
 	UpdateBalance(accountID uuid.UUID, amount decimal.Decimal, transactionType string) error
+	PostTransaction(transaction *models.Transaction, check AccountCheck) error
 	GetAccountsByStatus(status string, offset, limit int) ([]models.Account, error)
 	GetTotalBalanceByUserID(userID uuid.UUID) (decimal.Decimal, error)
 	ExistsForUser(userID uuid.UUID, accountType string) (bool, error)
-	ExecuteAtomicTransfer(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string) (debitTxID, creditTxID uuid.UUID, err error)
+	ExecuteAtomicTransfer(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string, check AccountCheck) (debitTxID, creditTxID uuid.UUID, err error)
+	ExecuteAtomicFXTransfer(fromAccountID, toAccountID uuid.UUID, debitAmount, creditAmount, rate decimal.Decimal, fromDescription, toDescription string, check AccountCheck) (debitTxID, creditTxID uuid.UUID, err error)
 }
 
+// LimitUsageReader reads the activity that counts towards an account's limits
+type LimitUsageReader interface {
+	GetWithdrawalTotal(accountID uuid.UUID, since time.Time) (decimal.Decimal, error)
+	CountTransactions(accountID uuid.UUID, since time.Time) (int64, error)
+}
+
+// AccountCheck runs inside the database transaction that moves an account's funds,
+// once the account row is locked, so checks against the account's earlier activity
+// are serialized per account. usage reads within the same transaction.
+type AccountCheck func(account *models.Account, usage LimitUsageReader) error
+
 // TransactionRepositoryInterface defines the contract for transaction repository operations
 type TransactionRepositoryInterface interface {
 	Create(transaction *models.Transaction) error
@@ -41,7 +54,10 @@ type TransactionRepositoryInterface interface {
 	GetByReference(reference string) (*models.Transaction, error)
 	GetRecentByAccountID(accountID uuid.UUID, limit int) ([]models.Transaction, error)
 	GetByDateRange(accountID uuid.UUID, startDate, endDate time.Time) ([]models.Transaction, error)
+	GetBalanceAsOf(accountID uuid.UUID, at time.Time) (decimal.Decimal, error)
 	CreateBatch(transactions []models.Transaction) error
+	GetExistingReferences(references []string) ([]string, error)
+	ImportForAccount(accountID uuid.UUID, transactions []*models.Transaction, allOrNothing bool) ([]error, error)
 	GetPendingTransactions(offset, limit int) ([]models.Transaction, error)
 	UpdateStatus(id uuid.UUID, status string) error
 	GetTotalsByAccountID(accountID uuid.UUID) (credits, debits int64, creditAmount, debitAmount string, err error)
@@ -49,9 +65,14 @@ type TransactionRepositoryInterface interface {
 	// Enhanced methods for category and filtering
 	GetByCategory(accountID uuid.UUID, category string, offset, limit int) ([]models.Transaction, int64, error)
 	GetWithFilters(filters models.TransactionFilters) ([]models.Transaction, int64, error)
+	StreamWithFilters(filters models.TransactionFilters, batchSize int, fn func(transaction *models.Transaction) error) error
 	UpdateWithOptimisticLock(transaction *models.Transaction, expectedVersion int) error
+	CompletePending(transaction *models.Transaction) (*models.Account, error)
 	GetExpiredPendingTransactions(limit int) ([]models.Transaction, error)
+	GetRecategorizationBatch(job *models.RecategorizationJob) ([]models.Transaction, error)
+	ApplyCategoryChanges(changes []models.TransactionCategoryChange) ([]uuid.UUID, error)
 	GetCategorySummary(accountID uuid.UUID, startDate, endDate time.Time) ([]models.CategorySummary, error)
+	Reverse(transactionID uuid.UUID, reason string) ([]*models.Transaction, error)
 }
 
 // UserSearchCriteria defines search criteria for users
@@ -60,6 +81,14 @@ type UserSearchCriteria struct {
 	SearchType string // "first_name", "last_name", "name", "email", "account_number"
 }
 
+// FuzzyUserSearchCriteria defines the terms of a fuzzy user search. Empty terms are
+// ignored and every account number fragment must appear in one of the user's accounts.
+type FuzzyUserSearchCriteria struct {
+	Name           string
+	Email          string
+	AccountNumbers []string
+}
+
 // UserRepositoryInterface defines the contract for user repository operations
 type UserRepositoryInterface interface {
 	Create(user *models.User) error
@@ -68,6 +97,8 @@ type UserRepositoryInterface interface {
 	GetByEmail(email string) (*models.User, error)
 	GetByEmailExcluding(email string, excludeUserID uuid.UUID) (*models.User, error)
 	SearchUsers(criteria UserSearchCriteria, offset, limit int) ([]*models.User, int64, error)
+	FuzzySearchUsers(criteria FuzzyUserSearchCriteria, offset, limit int) ([]*models.UserSearchMatch, int64, error)
+	ListSearchCandidates(accountNumbers []string) ([]*models.User, error)
 	Update(user *models.User) error
 	UpdateFields(userID uuid.UUID, fields map[string]interface{}) error
 	UpdateEmail(userID uuid.UUID, newEmail string) error
@@ -80,6 +111,13 @@ type UserRepositoryInterface interface {
 	CountAccountsByUserID(userID uuid.UUID) (int64, error)
 }
 
+// RoleRepositoryInterface defines the contract for role and permission lookups
+type RoleRepositoryInterface interface {
+	List() ([]*models.Role, error)
+	GetByName(name string) (*models.Role, error)
+	GetPermissions(role string) ([]string, error)
+}
+
 // AuditLogRepositoryInterface defines the contract for audit log repository operations
 type AuditLogRepositoryInterface interface {
 	Create(log *models.AuditLog) error
@@ -91,20 +129,29 @@ type AuditLogRepositoryInterface interface {
 	GetByTimeRange(startTime, endTime time.Time, offset, limit int) ([]*models.AuditLog, int64, error)
 	GetCustomerActivity(userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error)
 	GetFailedLoginAttempts(email string, since time.Time) (int64, error)
+	GetRecentLogins(userID uuid.UUID, limit int) ([]*models.AuditLog, error)
 	DeleteOlderThan(duration time.Duration) (int64, error)
 }
 
 // ProcessingQueueRepositoryInterface defines the contract for transaction processing queue operations
 type ProcessingQueueRepositoryInterface interface {
 	Enqueue(transactionID uuid.UUID, operation string, priority int) error
-	FetchPending(limit int) ([]*models.ProcessingQueueItem, error)
-	MarkProcessing(queueItemID uuid.UUID) error
-	MarkCompleted(queueItemID uuid.UUID) error
-	MarkFailed(queueItemID uuid.UUID, errorMessage string) error
-	IncrementRetry(queueItemID uuid.UUID) error
+	EnqueueWithMetadata(transactionID uuid.UUID, operation string, priority int, metadata string) error
+	IsQueued(transactionID uuid.UUID, operation string) (bool, error)
+	ClaimPending(workerID string, limit int, lease time.Duration) ([]*models.ProcessingQueueItem, error)
+	ReleaseExpiredLeases() (int64, error)
+	RenewLease(queueItemID uuid.UUID, workerID string, lease time.Duration) error
+	MarkCompleted(queueItemID uuid.UUID, workerID string) error
+	MarkFailed(queueItemID uuid.UUID, workerID, errorMessage string) error
+	IncrementRetry(queueItemID uuid.UUID, workerID, errorMessage string) error
+	GetByID(queueItemID uuid.UUID) (*models.ProcessingQueueItem, error)
+	ListFailed(filters models.QueueItemFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error)
+	Replay(queueItemIDs []uuid.UUID) ([]uuid.UUID, error)
+	Purge(queueItemIDs []uuid.UUID, reason string, purgedBy uuid.UUID) ([]uuid.UUID, error)
 	GetPendingCount() (int64, error)
 	GetProcessingCount() (int64, error)
 	GetFailedCount() (int64, error)
+	GetFailedCountsByOperation() (map[string]int64, error)
 	GetCompletedCount() (int64, error)
 	GetAverageProcessingTime() (float64, error)
 	GetOldestPendingAge() (*string, error)
@@ -117,9 +164,22 @@ type TransferRepositoryInterface interface {
 	Update(transfer *models.Transfer) error
 	FindByID(id uuid.UUID) (*models.Transfer, error)
 	FindByIdempotencyKey(key string) (*models.Transfer, error)
+	FindByTransactionID(transactionID uuid.UUID) (*models.Transfer, error)
 	FindByUserAccounts(accountIDs []uuid.UUID, offset, limit int) ([]models.Transfer, int64, error)
 	FindByUserAccountsWithFilters(accountIDs []uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error)
 	CountByUserAccounts(accountIDs []uuid.UUID) (int64, error)
+	CountCompletedBetween(fromAccountID, toAccountID uuid.UUID) (int64, error)
+	CountFromAccountSince(accountID uuid.UUID, since time.Time) (int64, error)
+}
+
+// TransferScheduleRepositoryInterface defines the contract for transfer schedule repository operations
+type TransferScheduleRepositoryInterface interface {
+	Create(schedule *models.TransferSchedule) error
+	GetByID(id uuid.UUID) (*models.TransferSchedule, error)
+	GetByUserID(userID uuid.UUID, offset, limit int) ([]models.TransferSchedule, int64, error)
+	GetDue(now time.Time, limit int) ([]models.TransferSchedule, error)
+	Update(schedule *models.TransferSchedule) error
+	SaveRun(schedule *models.TransferSchedule, expectedOccurrence int) error
 }
 
 type RefreshTokenRepositoryInterface interface {
@@ -128,6 +188,7 @@ type RefreshTokenRepositoryInterface interface {
 	GetByTokenHash(tokenHash string) (*models.RefreshToken, error)
 	GetActiveByUserID(userID uuid.UUID) ([]*models.RefreshToken, error)
 	Update(token *models.RefreshToken) error
+	MarkRotated(tokenID uuid.UUID) (bool, error)
 	Revoke(tokenID uuid.UUID) error
 	RevokeAllForUser(userID uuid.UUID) error
 	DeleteExpired() (int64, error)
@@ -140,3 +201,157 @@ type BlacklistedTokenRepositoryInterface interface {
 	GetByJTI(jti string) (*models.BlacklistedToken, error)
 	DeleteExpired() (int64, error)
 }
+
+// TransactionCategoryRepositoryInterface defines the contract for transaction category repository operations
+type TransactionCategoryRepositoryInterface interface {
+	GetActive() ([]models.TransactionCategory, error)
+	GetAll() ([]models.TransactionCategory, error)
+	GetByCode(code string) (*models.TransactionCategory, error)
+	Create(category *models.TransactionCategory) error
+	Update(category *models.TransactionCategory) error
+	UpdatePositions(positions []models.CategoryPosition) error
+}
+
+// MerchantMappingRepositoryInterface defines the contract for merchant mapping repository operations
+type MerchantMappingRepositoryInterface interface {
+	GetActive() ([]models.MerchantMapping, error)
+	GetByID(id uuid.UUID) (*models.MerchantMapping, error)
+	List(filters models.MerchantMappingFilters, offset, limit int) ([]models.MerchantMapping, int64, error)
+	Create(mapping *models.MerchantMapping) error
+	Update(mapping *models.MerchantMapping) error
+	RecordUsage(id uuid.UUID, usedAt time.Time) error
+}
+
+// RecategorizationJobRepositoryInterface defines the contract for recategorization job repository operations
+type RecategorizationJobRepositoryInterface interface {
+	Create(job *models.RecategorizationJob) error
+	GetByID(id uuid.UUID) (*models.RecategorizationJob, error)
+	List(offset, limit int) ([]models.RecategorizationJob, int64, error)
+	ClaimNextRunnable(staleBefore time.Time) (*models.RecategorizationJob, error)
+	UpdateStatus(job *models.RecategorizationJob, from ...string) error
+	SaveProgress(job *models.RecategorizationJob) error
+}
+
+// InterestRepositoryInterface defines the contract for interest accrual and posting operations
+type InterestRepositoryInterface interface {
+	GetInterestBearingAccounts(offset, limit int) ([]models.Account, error)
+	CreateAccrual(accrual *models.InterestAccrual) (bool, error)
+	GetLastAccrualDate(accountID uuid.UUID) (*time.Time, error)
+	GetEarliestUnpostedAccrualDate(accountID uuid.UUID) (*time.Time, error)
+	GetAccruals(accountID uuid.UUID, startDate, endDate time.Time) ([]models.InterestAccrual, error)
+	GetLastPosting(accountID uuid.UUID) (*models.InterestPosting, error)
+	PostInterest(accountID uuid.UUID, periodStart time.Time) (*models.InterestPosting, error)
+}
+
+// HoldRepositoryInterface defines the contract for authorization hold operations
+type HoldRepositoryInterface interface {
+	PlaceHold(hold *models.Transaction) error
+	GetHold(id uuid.UUID) (*models.Transaction, error)
+	GetActiveHolds(accountID uuid.UUID) ([]models.Transaction, error)
+	CaptureHold(id uuid.UUID, amount decimal.Decimal) (*models.Transaction, error)
+	ReleaseHold(id uuid.UUID, outcome string) (*models.Transaction, error)
+}
+
+// ExchangeRateRepositoryInterface defines the contract for exchange rate storage
+type ExchangeRateRepositoryInterface interface {
+	Upsert(rate *models.ExchangeRate) error
+	GetRate(baseCurrency, quoteCurrency string) (*models.ExchangeRate, error)
+	List() ([]models.ExchangeRate, error)
+}
+
+// ExternalTransferRepositoryInterface defines the contract for external transfer operations
+type ExternalTransferRepositoryInterface interface {
+	Create(transfer *models.ExternalTransfer) error
+	GetByID(id uuid.UUID) (*models.ExternalTransfer, error)
+	GetByIdempotencyKey(key string) (*models.ExternalTransfer, error)
+	GetByUserID(userID uuid.UUID, offset, limit int) ([]models.ExternalTransfer, int64, error)
+	GetUnsubmitted(before time.Time, limit int) ([]models.ExternalTransfer, error)
+	GetDueForSettlement(now time.Time, limit int) ([]models.ExternalTransfer, error)
+	MarkSubmitted(transfer *models.ExternalTransfer) error
+	ReleaseFromReview(id uuid.UUID) error
+	Settle(id uuid.UUID) (*models.ExternalTransfer, error)
+	Return(id uuid.UUID, returnCode, reason string) (*models.ExternalTransfer, error)
+	Fail(id uuid.UUID, reason string) (*models.ExternalTransfer, error)
+}
+
+// LimitRepositoryInterface defines the contract for transaction limits, limit
+// overrides and the usage they are checked against
+type LimitRepositoryInterface interface {
+	GetAccountTypeLimits() ([]models.TransactionLimit, error)
+	GetForAccountType(accountType string) (*models.TransactionLimit, error)
+	GetForUser(userID uuid.UUID) (*models.TransactionLimit, error)
+	Upsert(limit *models.TransactionLimit) error
+	DeleteForUser(userID uuid.UUID) error
+	CreateOverride(override *models.LimitOverride) error
+	GetOverrideByID(id uuid.UUID) (*models.LimitOverride, error)
+	GetActiveOverrides(accountID uuid.UUID, at time.Time) ([]models.LimitOverride, error)
+	RevokeOverride(override *models.LimitOverride) error
+	GetWithdrawalTotal(accountID uuid.UUID, since time.Time) (decimal.Decimal, error)
+	CountTransactions(accountID uuid.UUID, since time.Time) (int64, error)
+}
+
+// FraudReviewRepositoryInterface defines the contract for items held by fraud
+// screening for admin review
+type FraudReviewRepositoryInterface interface {
+	Create(review *models.FraudReview) error
+	GetByID(id uuid.UUID) (*models.FraudReview, error)
+	List(status string, offset, limit int) ([]models.FraudReview, int64, error)
+	GetExpired(at time.Time, limit int) ([]models.FraudReview, error)
+	Decide(review *models.FraudReview) error
+	RecordFailure(review *models.FraudReview) error
+}
+
+// PendingActionRepositoryInterface defines the contract for staff actions held for
+// maker-checker approval
+type PendingActionRepositoryInterface interface {
+	Create(action *models.PendingAction) error
+	GetByID(id uuid.UUID) (*models.PendingAction, error)
+	List(status string, offset, limit int) ([]models.PendingAction, int64, error)
+	GetExpired(at time.Time, limit int) ([]models.PendingAction, error)
+	Decide(action *models.PendingAction) error
+	RecordFailure(action *models.PendingAction) error
+}
+
+// StatementArchiveRepositoryInterface defines the contract for rendered statement
+// documents kept for closed periods
+type StatementArchiveRepositoryInterface interface {
+	Get(accountID uuid.UUID, periodType string, year, period int, format string) (*models.ArchivedStatement, error)
+	Create(statement *models.ArchivedStatement) (bool, error)
+}
+
+// WebhookRepositoryInterface defines the contract for webhook subscriptions and
+// the queue of deliveries made to them
+type WebhookRepositoryInterface interface {
+	CreateSubscription(subscription *models.WebhookSubscription) error
+	GetSubscription(id uuid.UUID) (*models.WebhookSubscription, error)
+	ListSubscriptions(userID uuid.UUID, offset, limit int) ([]models.WebhookSubscription, int64, error)
+	UpdateSubscription(subscription *models.WebhookSubscription) error
+	DeleteSubscription(id uuid.UUID) error
+	ListActiveSubscriptions(userID uuid.UUID) ([]models.WebhookSubscription, error)
+	CreateDeliveries(deliveries []*models.WebhookDelivery) error
+	ClaimDueDeliveries(limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+	SaveAttempt(delivery *models.WebhookDelivery) error
+	GetDelivery(subscriptionID, id uuid.UUID) (*models.WebhookDelivery, error)
+	ListDeliveries(subscriptionID uuid.UUID, status string, offset, limit int) ([]models.WebhookDelivery, int64, error)
+}
+
+// MFARepositoryInterface defines the contract for users' TOTP enrollments
+type MFARepositoryInterface interface {
+	Create(enrollment *models.MFAEnrollment) error
+	GetByUserID(userID uuid.UUID) (*models.MFAEnrollment, error)
+	Update(enrollment *models.MFAEnrollment) error
+	DeleteByUserID(userID uuid.UUID) error
+	AdvanceLastUsedStep(id uuid.UUID, step int64) (bool, error)
+	ConsumeRecoveryCode(id uuid.UUID, codeHash string) (int, bool, error)
+}
+
+// SessionRepositoryInterface defines the contract for signed-in session operations
+type SessionRepositoryInterface interface {
+	Create(session *models.Session) error
+	GetByID(id uuid.UUID) (*models.Session, error)
+	ListActiveByUserID(userID uuid.UUID) ([]*models.Session, error)
+	RecordUse(id uuid.UUID, ipAddress, userAgent string, expiresAt time.Time) error
+	// Revoke revokes the session and its refresh tokens in one transaction
+	Revoke(id uuid.UUID, reason string) (bool, error)
+	RevokeAllForUser(userID uuid.UUID, reason string) ([]*models.Session, error)
+}
@@ -0,0 +1,189 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTransactionLimitNotFound = errors.New("transaction limit not found")
+	ErrLimitOverrideNotFound    = errors.New("limit override not found")
+	ErrLimitOverrideNotActive   = errors.New("limit override has expired or been revoked")
+)
+
+// limitRepository implements LimitRepositoryInterface
+type limitRepository struct {
+	db *gorm.DB
+}
+
+// NewLimitRepository creates a new transaction limit repository
+func NewLimitRepository(db *gorm.DB) LimitRepositoryInterface {
+	return &limitRepository{
+		db: db,
+	}
+}
+
+// GetAccountTypeLimits retrieves the limits configured for each account type
+func (r *limitRepository) GetAccountTypeLimits() ([]models.TransactionLimit, error) {
+	var limits []models.TransactionLimit
+	if err := r.db.Where("account_type IS NOT NULL").
+		Order("account_type ASC").
+		Find(&limits).Error; err != nil {
+		return nil, fmt.Errorf("failed to get account type limits: %w", err)
+	}
+	return limits, nil
+}
+
+// GetForAccountType retrieves the limits configured for an account type
+func (r *limitRepository) GetForAccountType(accountType string) (*models.TransactionLimit, error) {
+	return r.getLimit("account_type = ?", accountType)
+}
+
+// GetForUser retrieves the limits configured for a customer
+func (r *limitRepository) GetForUser(userID uuid.UUID) (*models.TransactionLimit, error) {
+	return r.getLimit("user_id = ?", userID)
+}
+
+func (r *limitRepository) getLimit(query string, arg interface{}) (*models.TransactionLimit, error) {
+	var limit models.TransactionLimit
+	if err := r.db.Where(query, arg).First(&limit).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransactionLimitNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction limit: %w", err)
+	}
+	return &limit, nil
+}
+
+// Upsert creates the limits for an account type or customer, or replaces the
+// existing ones
+func (r *limitRepository) Upsert(limit *models.TransactionLimit) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var existing models.TransactionLimit
+		query := tx.Clauses(clause.Locking{Strength: "UPDATE"})
+		if limit.AccountType != nil {
+			query = query.Where("account_type = ?", *limit.AccountType)
+		} else {
+			query = query.Where("user_id = ?", limit.UserID)
+		}
+
+		err := query.First(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			if err := tx.Create(limit).Error; err != nil {
+				return fmt.Errorf("failed to create transaction limit: %w", err)
+			}
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to get transaction limit: %w", err)
+		}
+
+		limit.ID = existing.ID
+		limit.CreatedAt = existing.CreatedAt
+		if err := tx.Save(limit).Error; err != nil {
+			return fmt.Errorf("failed to update transaction limit: %w", err)
+		}
+		return nil
+	})
+}
+
+// DeleteForUser removes a customer's limits so their accounts fall back to the
+// account type limits
+func (r *limitRepository) DeleteForUser(userID uuid.UUID) error {
+	result := r.db.Where("user_id = ?", userID).Delete(&models.TransactionLimit{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete transaction limit: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrTransactionLimitNotFound
+	}
+	return nil
+}
+
+// CreateOverride records a temporary limit override
+func (r *limitRepository) CreateOverride(override *models.LimitOverride) error {
+	if err := r.db.Create(override).Error; err != nil {
+		return fmt.Errorf("failed to create limit override: %w", err)
+	}
+	return nil
+}
+
+// GetOverrideByID retrieves a limit override by ID
+func (r *limitRepository) GetOverrideByID(id uuid.UUID) (*models.LimitOverride, error) {
+	var override models.LimitOverride
+	if err := r.db.Where("id = ?", id).First(&override).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLimitOverrideNotFound
+		}
+		return nil, fmt.Errorf("failed to get limit override: %w", err)
+	}
+	return &override, nil
+}
+
+// GetActiveOverrides retrieves the overrides that apply to an account at the given
+// time, most recently granted first
+func (r *limitRepository) GetActiveOverrides(accountID uuid.UUID, at time.Time) ([]models.LimitOverride, error) {
+	var overrides []models.LimitOverride
+	if err := r.db.Where("account_id = ? AND revoked_at IS NULL AND expires_at > ?", accountID, at).
+		Order("created_at DESC").
+		Find(&overrides).Error; err != nil {
+		return nil, fmt.Errorf("failed to get limit overrides: %w", err)
+	}
+	return overrides, nil
+}
+
+// RevokeOverride saves an override ended with LimitOverride.Revoke. It returns
+// ErrLimitOverrideNotActive if the override had already expired or been revoked.
+func (r *limitRepository) RevokeOverride(override *models.LimitOverride) error {
+	result := r.db.Model(override).
+		Where("revoked_at IS NULL AND expires_at > ?", *override.RevokedAt).
+		Select("revoked_at", "revoked_by").
+		Updates(override)
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke limit override: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrLimitOverrideNotActive
+	}
+	return nil
+}
+
+// GetWithdrawalTotal sums the debits posted to an account since the given time.
+// Active holds count because they reserve funds that will leave the account;
+// released holds, reversed debits and reversal offsets do not.
+func (r *limitRepository) GetWithdrawalTotal(accountID uuid.UUID, since time.Time) (decimal.Decimal, error) {
+	var result struct {
+		Total decimal.Decimal
+	}
+
+	if err := r.db.Model(&models.Transaction{}).
+		Select("COALESCE(SUM(amount), 0) as total").
+		Where("account_id = ? AND transaction_type = ? AND status IN ? AND reversal_of IS NULL AND created_at >= ?",
+			accountID, models.TransactionTypeDebit,
+			[]string{models.TransactionStatusCompleted, models.TransactionStatusPending}, since).
+		Scan(&result).Error; err != nil {
+		return decimal.Zero, fmt.Errorf("failed to calculate withdrawal total: %w", err)
+	}
+
+	return result.Total, nil
+}
+
+// CountTransactions counts the transactions posted to an account since the given
+// time, excluding reversal offsets
+func (r *limitRepository) CountTransactions(accountID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+	if err := r.db.Model(&models.Transaction{}).
+		Where("account_id = ? AND reversal_of IS NULL AND created_at >= ?", accountID, since).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count transactions: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,218 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// LimitRepositoryTestSuite is the test suite for the transaction limit repository
+type LimitRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo LimitRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *LimitRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.Transaction{}, &models.TransactionLimit{}, &models.LimitOverride{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewLimitRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *LimitRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestLimitRepositoryTestSuite runs the test suite
+func TestLimitRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(LimitRepositoryTestSuite))
+}
+
+// Helper function to post a transaction at the given time
+func (s *LimitRepositoryTestSuite) createTransaction(accountID uuid.UUID, transactionType, status, amount string, at time.Time) *models.Transaction {
+	value := decimal.RequireFromString(amount)
+	balanceBefore, balanceAfter := value, decimal.Zero
+	if transactionType == models.TransactionTypeCredit {
+		balanceBefore, balanceAfter = decimal.Zero, value
+	}
+
+	transaction := &models.Transaction{
+		AccountID:       accountID,
+		TransactionType: transactionType,
+		Status:          status,
+		Amount:          value,
+		BalanceBefore:   balanceBefore,
+		BalanceAfter:    balanceAfter,
+		Description:     "Test transaction",
+		CreatedAt:       at,
+	}
+	require.NoError(s.T(), s.db.Create(transaction).Error)
+	return transaction
+}
+
+// Helper function to grant an override
+func (s *LimitRepositoryTestSuite) createOverride(accountID uuid.UUID, limitType, value string, expiresAt time.Time) *models.LimitOverride {
+	override := &models.LimitOverride{
+		AccountID: accountID,
+		LimitType: limitType,
+		Value:     decimal.RequireFromString(value),
+		Reason:    "One-off purchase",
+		GrantedBy: uuid.New(),
+		ExpiresAt: expiresAt,
+	}
+	require.NoError(s.T(), s.repo.CreateOverride(override))
+	return override
+}
+
+// TestUpsert_CreatesThenReplaces tests that saving limits twice keeps one row
+func (s *LimitRepositoryTestSuite) TestUpsert_CreatesThenReplaces() {
+	accountType := models.AccountTypeChecking
+	daily := decimal.RequireFromString("1000")
+
+	first := &models.TransactionLimit{AccountType: &accountType, DailyWithdrawalLimit: &daily}
+	require.NoError(s.T(), s.repo.Upsert(first))
+
+	monthly := decimal.RequireFromString("5000")
+	second := &models.TransactionLimit{AccountType: &accountType, MonthlyWithdrawalLimit: &monthly}
+	require.NoError(s.T(), s.repo.Upsert(second))
+	assert.Equal(s.T(), first.ID, second.ID)
+
+	saved, err := s.repo.GetForAccountType(accountType)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), saved.DailyWithdrawalLimit)
+	assert.True(s.T(), saved.MonthlyWithdrawalLimit.Equal(monthly))
+
+	limits, err := s.repo.GetAccountTypeLimits()
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), limits, 1)
+}
+
+// TestUserLimits tests customer limits are kept apart from account type limits
+func (s *LimitRepositoryTestSuite) TestUserLimits() {
+	userID := uuid.New()
+	hourly := 10
+	require.NoError(s.T(), s.repo.Upsert(&models.TransactionLimit{UserID: &userID, HourlyTransactionLimit: &hourly}))
+
+	saved, err := s.repo.GetForUser(userID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 10, *saved.HourlyTransactionLimit)
+
+	limits, err := s.repo.GetAccountTypeLimits()
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), limits)
+
+	require.NoError(s.T(), s.repo.DeleteForUser(userID))
+	_, err = s.repo.GetForUser(userID)
+	assert.ErrorIs(s.T(), err, ErrTransactionLimitNotFound)
+	assert.ErrorIs(s.T(), s.repo.DeleteForUser(userID), ErrTransactionLimitNotFound)
+}
+
+// TestGetActiveOverrides tests that expired and revoked overrides are excluded
+func (s *LimitRepositoryTestSuite) TestGetActiveOverrides() {
+	accountID := uuid.New()
+	now := time.Now()
+
+	older := s.createOverride(accountID, models.LimitTypeDailyWithdrawal, "2000", now.Add(time.Hour))
+	newer := s.createOverride(accountID, models.LimitTypeDailyWithdrawal, "3000", now.Add(time.Hour))
+	require.NoError(s.T(), s.db.Model(older).UpdateColumn("created_at", now.Add(-time.Minute)).Error)
+
+	expired := s.createOverride(accountID, models.LimitTypeSingleTransfer, "500", now.Add(time.Hour))
+	require.NoError(s.T(), s.db.Model(expired).UpdateColumn("expires_at", now.Add(-time.Minute)).Error)
+
+	revoked := s.createOverride(accountID, models.LimitTypeMonthlyWithdrawal, "9000", now.Add(time.Hour))
+	revoked.Revoke(uuid.New(), now)
+	require.NoError(s.T(), s.repo.RevokeOverride(revoked))
+
+	s.createOverride(uuid.New(), models.LimitTypeDailyWithdrawal, "100", now.Add(time.Hour))
+
+	overrides, err := s.repo.GetActiveOverrides(accountID, now)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), overrides, 2)
+	assert.Equal(s.T(), newer.ID, overrides[0].ID)
+	assert.Equal(s.T(), older.ID, overrides[1].ID)
+}
+
+// TestRevokeOverride_OnlyOnce tests that an override cannot be revoked twice
+func (s *LimitRepositoryTestSuite) TestRevokeOverride_OnlyOnce() {
+	override := s.createOverride(uuid.New(), models.LimitTypeDailyWithdrawal, "2000", time.Now().Add(time.Hour))
+
+	override.Revoke(uuid.New(), time.Now())
+	require.NoError(s.T(), s.repo.RevokeOverride(override))
+
+	saved, err := s.repo.GetOverrideByID(override.ID)
+	require.NoError(s.T(), err)
+	assert.NotNil(s.T(), saved.RevokedAt)
+	assert.Equal(s.T(), override.RevokedBy, saved.RevokedBy)
+
+	override.Revoke(uuid.New(), time.Now())
+	assert.ErrorIs(s.T(), s.repo.RevokeOverride(override), ErrLimitOverrideNotActive)
+
+	_, err = s.repo.GetOverrideByID(uuid.New())
+	assert.ErrorIs(s.T(), err, ErrLimitOverrideNotFound)
+}
+
+// TestGetWithdrawalTotal tests which debits count towards withdrawal limits
+func (s *LimitRepositoryTestSuite) TestGetWithdrawalTotal() {
+	accountID := uuid.New()
+	now := time.Now()
+	since := now.Add(-time.Hour)
+
+	s.createTransaction(accountID, models.TransactionTypeDebit, models.TransactionStatusCompleted, "100.00", now)
+	s.createTransaction(accountID, models.TransactionTypeDebit, models.TransactionStatusPending, "25.00", now)
+	s.createTransaction(accountID, models.TransactionTypeDebit, models.TransactionStatusFailed, "40.00", now)
+	s.createTransaction(accountID, models.TransactionTypeDebit, models.TransactionStatusReversed, "60.00", now)
+	s.createTransaction(accountID, models.TransactionTypeCredit, models.TransactionStatusCompleted, "500.00", now)
+	s.createTransaction(accountID, models.TransactionTypeDebit, models.TransactionStatusCompleted, "70.00", since.Add(-time.Minute))
+	s.createTransaction(uuid.New(), models.TransactionTypeDebit, models.TransactionStatusCompleted, "80.00", now)
+
+	total, err := s.repo.GetWithdrawalTotal(accountID, since)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), total.Equal(decimal.RequireFromString("125.00")), "got %s", total)
+}
+
+// TestCountTransactions tests that reversal offsets are not counted
+func (s *LimitRepositoryTestSuite) TestCountTransactions() {
+	accountID := uuid.New()
+	now := time.Now()
+	since := now.Add(-time.Hour)
+
+	debit := s.createTransaction(accountID, models.TransactionTypeDebit, models.TransactionStatusCompleted, "10.00", now)
+	s.createTransaction(accountID, models.TransactionTypeCredit, models.TransactionStatusCompleted, "10.00", now)
+	s.createTransaction(accountID, models.TransactionTypeDebit, models.TransactionStatusCompleted, "10.00", since.Add(-time.Minute))
+
+	offset := &models.Transaction{
+		AccountID:       accountID,
+		TransactionType: models.TransactionTypeCredit,
+		Amount:          debit.Amount,
+		BalanceAfter:    debit.Amount,
+		Description:     "Reversal",
+		ReversalOf:      &debit.ID,
+	}
+	require.NoError(s.T(), s.db.Create(offset).Error)
+
+	count, err := s.repo.CountTransactions(accountID, since)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), count)
+}
@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrMerchantMappingNotFound = errors.New("merchant mapping not found")
+)
+
+// merchantMappingRepository implements MerchantMappingRepositoryInterface
+type merchantMappingRepository struct {
+	db *gorm.DB
+}
+
+// NewMerchantMappingRepository creates a new merchant mapping repository
+func NewMerchantMappingRepository(db *gorm.DB) MerchantMappingRepositoryInterface {
+	return &merchantMappingRepository{
+		db: db,
+	}
+}
+
+// GetActive retrieves all active mappings, highest confidence first
+func (r *merchantMappingRepository) GetActive() ([]models.MerchantMapping, error) {
+	var mappings []models.MerchantMapping
+
+	err := r.db.Where("is_active = ?", true).
+		Order("confidence_score DESC, merchant_pattern ASC").
+		Find(&mappings).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active merchant mappings: %w", err)
+	}
+
+	return mappings, nil
+}
+
+// RecordUsage increments the usage counter of a mapping and stamps when it last matched
+func (r *merchantMappingRepository) RecordUsage(id uuid.UUID, usedAt time.Time) error {
+	result := r.db.Model(&models.MerchantMapping{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"usage_count":  gorm.Expr("usage_count + ?", 1),
+			"last_used_at": usedAt,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to record merchant mapping usage: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrMerchantMappingNotFound
+	}
+
+	return nil
+}
+
+// GetByID retrieves a mapping by ID
+func (r *merchantMappingRepository) GetByID(id uuid.UUID) (*models.MerchantMapping, error) {
+	var mapping models.MerchantMapping
+
+	if err := r.db.Where("id = ?", id).First(&mapping).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMerchantMappingNotFound
+		}
+		return nil, fmt.Errorf("failed to get merchant mapping: %w", err)
+	}
+
+	return &mapping, nil
+}
+
+// List retrieves mappings matching the filters with pagination
+func (r *merchantMappingRepository) List(filters models.MerchantMappingFilters, offset, limit int) ([]models.MerchantMapping, int64, error) {
+	var mappings []models.MerchantMapping
+	var total int64
+
+	query := r.db.Model(&models.MerchantMapping{})
+
+	if filters.CategoryCode != "" {
+		query = query.Where("category_code = ?", filters.CategoryCode)
+	}
+
+	if filters.MatchType != "" {
+		query = query.Where("match_type = ?", filters.MatchType)
+	}
+
+	if filters.IsActive != nil {
+		query = query.Where("is_active = ?", *filters.IsActive)
+	}
+
+	if filters.Search != "" {
+		search := "%" + strings.ToLower(filters.Search) + "%"
+		query = query.Where("LOWER(merchant_pattern) LIKE ? OR LOWER(normalized_name) LIKE ?", search, search)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count merchant mappings: %w", err)
+	}
+
+	err := query.Order("category_code ASC, confidence_score DESC, merchant_pattern ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&mappings).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list merchant mappings: %w", err)
+	}
+
+	return mappings, total, nil
+}
+
+// Create creates a new mapping
+func (r *merchantMappingRepository) Create(mapping *models.MerchantMapping) error {
+	if mapping == nil {
+		return errors.New("merchant mapping cannot be nil")
+	}
+
+	if err := r.db.Create(mapping).Error; err != nil {
+		return fmt.Errorf("failed to create merchant mapping: %w", err)
+	}
+
+	return nil
+}
+
+// Update updates an existing mapping
+func (r *merchantMappingRepository) Update(mapping *models.MerchantMapping) error {
+	if mapping == nil {
+		return errors.New("merchant mapping cannot be nil")
+	}
+
+	if err := r.db.Save(mapping).Error; err != nil {
+		return fmt.Errorf("failed to update merchant mapping: %w", err)
+	}
+
+	return nil
+}
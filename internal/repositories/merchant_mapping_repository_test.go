@@ -0,0 +1,211 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// MerchantMappingRepositoryTestSuite is the test suite for category and merchant mapping repositories
+type MerchantMappingRepositoryTestSuite struct {
+	suite.Suite
+	db           *gorm.DB
+	repo         MerchantMappingRepositoryInterface
+	categoryRepo TransactionCategoryRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *MerchantMappingRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.TransactionCategory{}, &models.MerchantMapping{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewMerchantMappingRepository(db)
+	s.categoryRepo = NewTransactionCategoryRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *MerchantMappingRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestMerchantMappingRepositoryTestSuite runs the test suite
+func TestMerchantMappingRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MerchantMappingRepositoryTestSuite))
+}
+
+// Helper function to create a persisted mapping
+func (s *MerchantMappingRepositoryTestSuite) createMapping(pattern string, confidence float64, active bool) *models.MerchantMapping {
+	mapping := &models.MerchantMapping{
+		MerchantPattern: pattern,
+		NormalizedName:  pattern,
+		CategoryCode:    models.CategoryGroceries,
+		MatchType:       models.MatchTypePartial,
+		ConfidenceScore: confidence,
+		IsActive:        active,
+	}
+	require.NoError(s.T(), s.db.Create(mapping).Error)
+	return mapping
+}
+
+// TestGetActive_ExcludesInactiveAndOrdersByConfidence tests active mapping retrieval
+func (s *MerchantMappingRepositoryTestSuite) TestGetActive_ExcludesInactiveAndOrdersByConfidence() {
+	s.createMapping("Kroger", 0.80, true)
+	s.createMapping("Walmart", 0.95, true)
+	s.createMapping("Safeway", 0.99, false)
+
+	mappings, err := s.repo.GetActive()
+
+	s.NoError(err)
+	s.Require().Len(mappings, 2)
+	s.Equal("Walmart", mappings[0].MerchantPattern)
+	s.Equal("Kroger", mappings[1].MerchantPattern)
+}
+
+// TestRecordUsage_IncrementsCountAndStampsLastUsed tests usage tracking
+func (s *MerchantMappingRepositoryTestSuite) TestRecordUsage_IncrementsCountAndStampsLastUsed() {
+	mapping := s.createMapping("Walmart", 0.95, true)
+	usedAt := time.Now().Truncate(time.Second)
+
+	s.NoError(s.repo.RecordUsage(mapping.ID, usedAt))
+	s.NoError(s.repo.RecordUsage(mapping.ID, usedAt))
+
+	var stored models.MerchantMapping
+	s.Require().NoError(s.db.First(&stored, "id = ?", mapping.ID).Error)
+	s.Equal(2, stored.UsageCount)
+	s.Require().NotNil(stored.LastUsedAt)
+	s.True(stored.LastUsedAt.Equal(usedAt))
+}
+
+// TestRecordUsage_NotFound tests usage tracking for a missing mapping
+func (s *MerchantMappingRepositoryTestSuite) TestRecordUsage_NotFound() {
+	err := s.repo.RecordUsage(uuid.New(), time.Now())
+
+	s.ErrorIs(err, ErrMerchantMappingNotFound)
+}
+
+// TestCategoryGetActive_OrdersByDisplayOrder tests active category retrieval
+func (s *MerchantMappingRepositoryTestSuite) TestCategoryGetActive_OrdersByDisplayOrder() {
+	categories := []*models.TransactionCategory{
+		{Code: models.CategoryDining, Name: "Dining", IsActive: true, DisplayOrder: 2},
+		{Code: models.CategoryGroceries, Name: "Groceries", IsActive: true, DisplayOrder: 1},
+		{Code: models.CategoryTravel, Name: "Travel", IsActive: false, DisplayOrder: 3},
+	}
+	for _, category := range categories {
+		s.Require().NoError(s.db.Create(category).Error)
+	}
+
+	active, err := s.categoryRepo.GetActive()
+
+	s.NoError(err)
+	s.Require().Len(active, 2)
+	s.Equal(models.CategoryGroceries, active[0].Code)
+	s.Equal(models.CategoryDining, active[1].Code)
+}
+
+// TestCategoryGetByCode_NotFound tests lookup of an unknown category
+func (s *MerchantMappingRepositoryTestSuite) TestCategoryGetByCode_NotFound() {
+	category, err := s.categoryRepo.GetByCode("UNKNOWN")
+
+	s.Nil(category)
+	s.ErrorIs(err, ErrCategoryNotFound)
+}
+
+// TestList_FiltersAndPaginates tests filtered mapping listing
+func (s *MerchantMappingRepositoryTestSuite) TestList_FiltersAndPaginates() {
+	s.createMapping("Kroger", 0.80, true)
+	s.createMapping("Walmart", 0.95, true)
+	s.createMapping("Walgreens", 0.90, false)
+
+	active := true
+	mappings, total, err := s.repo.List(models.MerchantMappingFilters{IsActive: &active}, 0, 1)
+
+	s.NoError(err)
+	s.Equal(int64(2), total)
+	s.Require().Len(mappings, 1)
+	s.Equal("Walmart", mappings[0].MerchantPattern)
+
+	mappings, total, err = s.repo.List(models.MerchantMappingFilters{Search: "WAL"}, 0, 10)
+
+	s.NoError(err)
+	s.Equal(int64(2), total)
+	s.Len(mappings, 2)
+}
+
+// TestUpdate_PersistsDeactivation tests that a mapping can be switched off
+func (s *MerchantMappingRepositoryTestSuite) TestUpdate_PersistsDeactivation() {
+	mapping := s.createMapping("Walmart", 0.95, true)
+
+	mapping.IsActive = false
+	s.NoError(s.repo.Update(mapping))
+
+	stored, err := s.repo.GetByID(mapping.ID)
+	s.NoError(err)
+	s.False(stored.IsActive)
+}
+
+// TestGetByID_NotFound tests lookup of a missing mapping
+func (s *MerchantMappingRepositoryTestSuite) TestGetByID_NotFound() {
+	mapping, err := s.repo.GetByID(uuid.New())
+
+	s.Nil(mapping)
+	s.ErrorIs(err, ErrMerchantMappingNotFound)
+}
+
+// TestCategoryCreate_DuplicateCode tests that category codes are unique
+func (s *MerchantMappingRepositoryTestSuite) TestCategoryCreate_DuplicateCode() {
+	s.Require().NoError(s.categoryRepo.Create(&models.TransactionCategory{Code: "COFFEE", Name: "Coffee", IsActive: true}))
+
+	err := s.categoryRepo.Create(&models.TransactionCategory{Code: "COFFEE", Name: "Coffee Shops", IsActive: true})
+
+	s.Error(err)
+}
+
+// TestCategoryUpdatePositions tests moving categories within the hierarchy
+func (s *MerchantMappingRepositoryTestSuite) TestCategoryUpdatePositions() {
+	s.Require().NoError(s.categoryRepo.Create(&models.TransactionCategory{Code: "FOOD", Name: "Food", IsActive: true}))
+	s.Require().NoError(s.categoryRepo.Create(&models.TransactionCategory{Code: "COFFEE", Name: "Coffee", IsActive: true, DisplayOrder: 5}))
+
+	parent := "FOOD"
+	err := s.categoryRepo.UpdatePositions([]models.CategoryPosition{
+		{Code: "COFFEE", ParentCategoryCode: &parent, DisplayOrder: 1},
+	})
+	s.NoError(err)
+
+	coffee, err := s.categoryRepo.GetByCode("COFFEE")
+	s.NoError(err)
+	s.Require().NotNil(coffee.ParentCategoryCode)
+	s.Equal("FOOD", *coffee.ParentCategoryCode)
+	s.Equal(1, coffee.DisplayOrder)
+}
+
+// TestCategoryUpdatePositions_UnknownCodeRollsBack tests that a bad position leaves the hierarchy untouched
+func (s *MerchantMappingRepositoryTestSuite) TestCategoryUpdatePositions_UnknownCodeRollsBack() {
+	s.Require().NoError(s.categoryRepo.Create(&models.TransactionCategory{Code: "COFFEE", Name: "Coffee", IsActive: true, DisplayOrder: 5}))
+
+	err := s.categoryRepo.UpdatePositions([]models.CategoryPosition{
+		{Code: "COFFEE", DisplayOrder: 1},
+		{Code: "MISSING", DisplayOrder: 2},
+	})
+	s.ErrorIs(err, ErrCategoryNotFound)
+
+	coffee, err := s.categoryRepo.GetByCode("COFFEE")
+	s.NoError(err)
+	s.Equal(5, coffee.DisplayOrder)
+}
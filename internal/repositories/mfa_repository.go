@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrMFAEnrollmentNotFound = errors.New("MFA enrollment not found")
+)
+
+// mfaRepository implements MFARepositoryInterface
+type mfaRepository struct {
+	db *gorm.DB
+}
+
+// NewMFARepository creates a new MFA enrollment repository
+func NewMFARepository(db *gorm.DB) MFARepositoryInterface {
+	return &mfaRepository{
+		db: db,
+	}
+}
+
+// Create stores a new MFA enrollment
+func (r *mfaRepository) Create(enrollment *models.MFAEnrollment) error {
+	if err := r.db.Create(enrollment).Error; err != nil {
+		return fmt.Errorf("failed to create MFA enrollment: %w", err)
+	}
+	return nil
+}
+
+// GetByUserID retrieves a user's MFA enrollment
+func (r *mfaRepository) GetByUserID(userID uuid.UUID) (*models.MFAEnrollment, error) {
+	var enrollment models.MFAEnrollment
+	if err := r.db.First(&enrollment, "user_id = ?", userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMFAEnrollmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get MFA enrollment: %w", err)
+	}
+	return &enrollment, nil
+}
+
+// Update saves the enrollment's secret, confirmation and recovery codes. The last
+// used step is only ever moved forward by AdvanceLastUsedStep.
+func (r *mfaRepository) Update(enrollment *models.MFAEnrollment) error {
+	result := r.db.Model(enrollment).
+		Select("secret", "confirmed_at", "recovery_codes", "updated_at").
+		Updates(enrollment)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update MFA enrollment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrMFAEnrollmentNotFound
+	}
+	return nil
+}
+
+// DeleteByUserID removes a user's MFA enrollment
+func (r *mfaRepository) DeleteByUserID(userID uuid.UUID) error {
+	result := r.db.Delete(&models.MFAEnrollment{}, "user_id = ?", userID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete MFA enrollment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrMFAEnrollmentNotFound
+	}
+	return nil
+}
+
+// AdvanceLastUsedStep records that the code for a time step was used. It returns
+// false, without changing anything, if a code for that step or a later one was
+// already used, so two requests racing with the same code cannot both succeed.
+func (r *mfaRepository) AdvanceLastUsedStep(id uuid.UUID, step int64) (bool, error) {
+	result := r.db.Model(&models.MFAEnrollment{}).
+		Where("id = ? AND last_used_step < ?", id, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to record MFA code use: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ConsumeRecoveryCode removes a recovery code from the enrollment and returns how
+// many remain. It returns false if the code is not one of the unused codes.
+func (r *mfaRepository) ConsumeRecoveryCode(id uuid.UUID, codeHash string) (int, bool, error) {
+	var remaining int
+	var used bool
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var enrollment models.MFAEnrollment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&enrollment, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMFAEnrollmentNotFound
+			}
+			return fmt.Errorf("failed to lock MFA enrollment: %w", err)
+		}
+
+		used = enrollment.UseRecoveryCode(codeHash)
+		remaining = len(enrollment.RecoveryCodes)
+		if !used {
+			return nil
+		}
+
+		if err := tx.Model(&enrollment).
+			Select("recovery_codes", "updated_at").
+			Updates(&enrollment).Error; err != nil {
+			return fmt.Errorf("failed to consume recovery code: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	return remaining, used, nil
+}
@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// MFARepositoryTestSuite is the test suite for the MFA enrollment repository
+type MFARepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo MFARepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *MFARepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.MFAEnrollment{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewMFARepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *MFARepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestMFARepositoryTestSuite runs the test suite
+func TestMFARepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MFARepositoryTestSuite))
+}
+
+// Helper function to create a confirmed enrollment with recovery codes
+func (s *MFARepositoryTestSuite) createEnrollment(userID uuid.UUID) *models.MFAEnrollment {
+	enrollment := &models.MFAEnrollment{UserID: userID, Secret: "JBSWY3DPEHPK3PXP"}
+	s.Require().NoError(s.repo.Create(enrollment))
+	enrollment.Confirm([]string{"hash-a", "hash-b"}, time.Now())
+	s.Require().NoError(s.repo.Update(enrollment))
+	return enrollment
+}
+
+func (s *MFARepositoryTestSuite) TestEnrollmentLifecycle() {
+	userID := uuid.New()
+	s.createEnrollment(userID)
+
+	found, err := s.repo.GetByUserID(userID)
+	s.Require().NoError(err)
+	s.True(found.IsConfirmed())
+	s.Equal(models.MFARecoveryCodes{"hash-a", "hash-b"}, found.RecoveryCodes)
+
+	s.Require().NoError(s.repo.DeleteByUserID(userID))
+
+	_, err = s.repo.GetByUserID(userID)
+	s.ErrorIs(err, ErrMFAEnrollmentNotFound)
+	s.ErrorIs(s.repo.DeleteByUserID(userID), ErrMFAEnrollmentNotFound)
+}
+
+func (s *MFARepositoryTestSuite) TestAdvanceLastUsedStep() {
+	enrollment := s.createEnrollment(uuid.New())
+
+	advanced, err := s.repo.AdvanceLastUsedStep(enrollment.ID, 100)
+	s.Require().NoError(err)
+	s.True(advanced)
+
+	// The same step, or an earlier one, cannot be used again
+	advanced, err = s.repo.AdvanceLastUsedStep(enrollment.ID, 100)
+	s.Require().NoError(err)
+	s.False(advanced)
+
+	advanced, err = s.repo.AdvanceLastUsedStep(enrollment.ID, 99)
+	s.Require().NoError(err)
+	s.False(advanced)
+
+	advanced, err = s.repo.AdvanceLastUsedStep(enrollment.ID, 101)
+	s.Require().NoError(err)
+	s.True(advanced)
+}
+
+func (s *MFARepositoryTestSuite) TestConsumeRecoveryCode() {
+	enrollment := s.createEnrollment(uuid.New())
+
+	remaining, used, err := s.repo.ConsumeRecoveryCode(enrollment.ID, "hash-a")
+	s.Require().NoError(err)
+	s.True(used)
+	s.Equal(1, remaining)
+
+	remaining, used, err = s.repo.ConsumeRecoveryCode(enrollment.ID, "hash-a")
+	s.Require().NoError(err)
+	s.False(used)
+	s.Equal(1, remaining)
+
+	found, err := s.repo.GetByUserID(enrollment.UserID)
+	s.Require().NoError(err)
+	s.Equal(models.MFARecoveryCodes{"hash-b"}, found.RecoveryCodes)
+
+	_, _, err = s.repo.ConsumeRecoveryCode(uuid.New(), "hash-b")
+	s.ErrorIs(err, ErrMFAEnrollmentNotFound)
+}
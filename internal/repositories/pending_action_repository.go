@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPendingActionNotFound   = errors.New("pending action not found")
+	ErrPendingActionNotPending = errors.New("pending action has already been decided")
+)
+
+// pendingActionRepository implements PendingActionRepositoryInterface
+type pendingActionRepository struct {
+	db *gorm.DB
+}
+
+// NewPendingActionRepository creates a new pending action repository
+func NewPendingActionRepository(db *gorm.DB) PendingActionRepositoryInterface {
+	return &pendingActionRepository{
+		db: db,
+	}
+}
+
+// Create stores a new pending action
+func (r *pendingActionRepository) Create(action *models.PendingAction) error {
+	if err := r.db.Create(action).Error; err != nil {
+		return fmt.Errorf("failed to create pending action: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a pending action by ID
+func (r *pendingActionRepository) GetByID(id uuid.UUID) (*models.PendingAction, error) {
+	var action models.PendingAction
+	if err := r.db.First(&action, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPendingActionNotFound
+		}
+		return nil, fmt.Errorf("failed to get pending action: %w", err)
+	}
+	return &action, nil
+}
+
+// List retrieves pending actions, oldest first so the longest-waiting requests are
+// decided first. An empty status lists actions in every status.
+func (r *pendingActionRepository) List(status string, offset, limit int) ([]models.PendingAction, int64, error) {
+	var actions []models.PendingAction
+	var total int64
+
+	query := r.db.Model(&models.PendingAction{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count pending actions: %w", err)
+	}
+
+	if err := query.Order("created_at ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&actions).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list pending actions: %w", err)
+	}
+
+	return actions, total, nil
+}
+
+// GetExpired retrieves up to limit actions still awaiting a decision whose expiry
+// has passed at the given time
+func (r *pendingActionRepository) GetExpired(at time.Time, limit int) ([]models.PendingAction, error) {
+	var actions []models.PendingAction
+	if err := r.db.
+		Where("status = ? AND expires_at <= ?", models.PendingActionStatusPending, at).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&actions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get expired pending actions: %w", err)
+	}
+	return actions, nil
+}
+
+// Decide saves the decision on an action, failing if the action was decided
+// concurrently so each action is carried out at most once
+func (r *pendingActionRepository) Decide(action *models.PendingAction) error {
+	result := r.db.Model(action).
+		Where("status = ?", models.PendingActionStatusPending).
+		Select("status", "reviewed_by", "reviewed_at", "decision_reason", "updated_at").
+		Updates(action)
+	if result.Error != nil {
+		return fmt.Errorf("failed to decide pending action: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrPendingActionNotPending
+	}
+	return nil
+}
+
+// RecordFailure saves why an approved action could not be carried out
+func (r *pendingActionRepository) RecordFailure(action *models.PendingAction) error {
+	if err := r.db.Model(action).
+		Select("failure_reason", "updated_at").
+		Updates(action).Error; err != nil {
+		return fmt.Errorf("failed to record pending action failure: %w", err)
+	}
+	return nil
+}
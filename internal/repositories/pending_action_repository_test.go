@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// PendingActionRepositoryTestSuite is the test suite for the pending action repository
+type PendingActionRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo PendingActionRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *PendingActionRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.PendingAction{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewPendingActionRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *PendingActionRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestPendingActionRepositoryTestSuite runs the test suite
+func TestPendingActionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PendingActionRepositoryTestSuite))
+}
+
+// Helper function to request a customer deletion expiring at expiresAt
+func (s *PendingActionRepositoryTestSuite) createAction(expiresAt time.Time) *models.PendingAction {
+	customerID := uuid.New().String()
+	action := &models.PendingAction{
+		ActionType:  models.PendingActionDeleteCustomer,
+		ResourceID:  customerID,
+		Payload:     models.JSONBMap{"customer_id": customerID},
+		RequestedBy: uuid.New(),
+		ExpiresAt:   expiresAt,
+	}
+	require.NoError(s.T(), s.repo.Create(action))
+	return action
+}
+
+// TestCreateAndGet tests that the payload round-trips through storage
+func (s *PendingActionRepositoryTestSuite) TestCreateAndGet() {
+	action := s.createAction(time.Now().Add(time.Hour))
+	assert.Equal(s.T(), models.PendingActionStatusPending, action.Status)
+
+	saved, err := s.repo.GetByID(action.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), action.Payload, saved.Payload)
+	assert.Equal(s.T(), action.RequestedBy, saved.RequestedBy)
+
+	_, err = s.repo.GetByID(uuid.New())
+	assert.ErrorIs(s.T(), err, ErrPendingActionNotFound)
+}
+
+// TestList tests status filtering and that the oldest actions come first
+func (s *PendingActionRepositoryTestSuite) TestList() {
+	newer := s.createAction(time.Now().Add(time.Hour))
+	older := s.createAction(time.Now().Add(time.Hour))
+	require.NoError(s.T(), s.db.Model(older).UpdateColumn("created_at", time.Now().Add(-time.Hour)).Error)
+
+	decided := s.createAction(time.Now().Add(time.Hour))
+	decided.Reject(uuid.New(), "Customer withdrew the request", time.Now())
+	require.NoError(s.T(), s.repo.Decide(decided))
+
+	actions, total, err := s.repo.List(models.PendingActionStatusPending, 0, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), total)
+	require.Len(s.T(), actions, 2)
+	assert.Equal(s.T(), older.ID, actions[0].ID)
+	assert.Equal(s.T(), newer.ID, actions[1].ID)
+
+	_, total, err = s.repo.List("", 0, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(3), total)
+}
+
+// TestGetExpired tests that only undecided actions past their expiry are returned
+func (s *PendingActionRepositoryTestSuite) TestGetExpired() {
+	now := time.Now()
+	expired := s.createAction(now.Add(-time.Minute))
+	s.createAction(now.Add(time.Hour))
+
+	decided := s.createAction(now.Add(-time.Minute))
+	decided.Approve(uuid.New(), "Verified", now.Add(-2*time.Minute))
+	require.NoError(s.T(), s.repo.Decide(decided))
+
+	actions, err := s.repo.GetExpired(now, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), actions, 1)
+	assert.Equal(s.T(), expired.ID, actions[0].ID)
+}
+
+// TestDecide_OnlyOnce tests that an action cannot be decided twice
+func (s *PendingActionRepositoryTestSuite) TestDecide_OnlyOnce() {
+	action := s.createAction(time.Now().Add(time.Hour))
+	checkerID := uuid.New()
+
+	action.Approve(checkerID, "Verified with the customer", time.Now())
+	require.NoError(s.T(), s.repo.Decide(action))
+
+	saved, err := s.repo.GetByID(action.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.PendingActionStatusApproved, saved.Status)
+	assert.Equal(s.T(), checkerID, *saved.ReviewedBy)
+	assert.Equal(s.T(), "Verified with the customer", *saved.DecisionReason)
+
+	action.Reject(uuid.New(), "Changed my mind", time.Now())
+	assert.ErrorIs(s.T(), s.repo.Decide(action), ErrPendingActionNotPending)
+
+	saved, err = s.repo.GetByID(action.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.PendingActionStatusApproved, saved.Status)
+}
+
+// TestRecordFailure tests that the failure reason of an approved action is saved
+func (s *PendingActionRepositoryTestSuite) TestRecordFailure() {
+	action := s.createAction(time.Now().Add(time.Hour))
+	action.Approve(uuid.New(), "Verified", time.Now())
+	require.NoError(s.T(), s.repo.Decide(action))
+
+	action.Fail("customer has non-zero balances")
+	require.NoError(s.T(), s.repo.RecordFailure(action))
+
+	saved, err := s.repo.GetByID(action.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "customer has non-zero balances", *saved.FailureReason)
+	assert.Equal(s.T(), models.PendingActionStatusApproved, saved.Status)
+}
@@ -9,10 +9,12 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
 	ErrQueueItemNotFound = errors.New("queue item not found")
+	ErrQueueLeaseLost    = errors.New("queue item is no longer held by this worker")
 )
 
 type processingQueueRepository struct {
@@ -26,10 +28,22 @@ func NewProcessingQueueRepository(db *gorm.DB) ProcessingQueueRepositoryInterfac
 }
 
 func (r *processingQueueRepository) Enqueue(transactionID uuid.UUID, operation string, priority int) error {
+	return r.EnqueueWithMetadata(transactionID, operation, priority, "")
+}
+
+// EnqueueWithMetadata queues an operation along with the JSON metadata it needs, such
+// as the reason for a reversal
+func (r *processingQueueRepository) EnqueueWithMetadata(transactionID uuid.UUID, operation string, priority int, metadata string) error {
+	// The metadata column is JSONB, which rejects an empty string
+	if metadata == "" {
+		metadata = "{}"
+	}
+
 	item := &models.ProcessingQueueItem{
 		TransactionID: transactionID,
 		Operation:     operation,
 		Priority:      priority,
+		Metadata:      metadata,
 	}
 
 	if err := r.db.Create(item).Error; err != nil {
@@ -39,93 +53,294 @@ func (r *processingQueueRepository) Enqueue(transactionID uuid.UUID, operation s
 	return nil
 }
 
-func (r *processingQueueRepository) FetchPending(limit int) ([]*models.ProcessingQueueItem, error) {
+// IsQueued reports whether the transaction has a pending or in-flight item for the operation
+func (r *processingQueueRepository) IsQueued(transactionID uuid.UUID, operation string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&models.ProcessingQueueItem{}).
+		Where("transaction_id = ? AND operation = ? AND status IN ?",
+			transactionID, operation, []string{models.QueueStatusPending, models.QueueStatusProcessing}).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check queued item: %w", err)
+	}
+	return count > 0, nil
+}
+
+// ClaimPending atomically claims up to limit due items for the worker and moves them to
+// processing under a lease. Rows locked by another worker's claim are skipped, so
+// concurrent workers never claim the same item.
+func (r *processingQueueRepository) ClaimPending(workerID string, limit int, lease time.Duration) ([]*models.ProcessingQueueItem, error) {
 	var items []*models.ProcessingQueueItem
 
-	err := r.db.Where("status = ? AND scheduled_at <= ?", models.QueueStatusPending, time.Now()).
-		Order("priority DESC, scheduled_at ASC").
-		Limit(limit).
-		Find(&items).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		var ids []uuid.UUID
+		if err := tx.Model(&models.ProcessingQueueItem{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND scheduled_at <= ?", models.QueueStatusPending, now).
+			Order("priority DESC, scheduled_at ASC").
+			Limit(limit).
+			Pluck("id", &ids).Error; err != nil {
+			return fmt.Errorf("failed to select pending items: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&models.ProcessingQueueItem{}).
+			Where("id IN ? AND status = ?", ids, models.QueueStatusPending).
+			Updates(map[string]interface{}{
+				"status":           models.QueueStatusProcessing,
+				"worker_id":        workerID,
+				"lease_expires_at": now.Add(lease),
+			}).Error; err != nil {
+			return fmt.Errorf("failed to claim pending items: %w", err)
+		}
+
+		if err := tx.Where("id IN ? AND worker_id = ? AND status = ?", ids, workerID, models.QueueStatusProcessing).
+			Order("priority DESC, scheduled_at ASC").
+			Find(&items).Error; err != nil {
+			return fmt.Errorf("failed to load claimed items: %w", err)
+		}
 
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch pending items: %w", err)
+		return nil, err
 	}
 
 	return items, nil
 }
 
-func (r *processingQueueRepository) MarkProcessing(queueItemID uuid.UUID) error {
-	result := r.db.Model(&models.ProcessingQueueItem{ID: queueItemID}).
-		Update("status", models.QueueStatusProcessing)
+// ReleaseExpiredLeases returns items whose lease expired while in processing to
+// pending, counting the lost attempt as a retry. Items held by a worker that
+// stopped without finishing them are picked up again by any worker.
+func (r *processingQueueRepository) ReleaseExpiredLeases() (int64, error) {
+	result := r.db.Model(&models.ProcessingQueueItem{}).
+		Where("status = ? AND (lease_expires_at IS NULL OR lease_expires_at < ?)", models.QueueStatusProcessing, time.Now()).
+		Updates(map[string]interface{}{
+			"status":           models.QueueStatusPending,
+			"retry_count":      gorm.Expr("retry_count + 1"),
+			"error_message":    "processing lease expired",
+			"worker_id":        "",
+			"lease_expires_at": nil,
+			"scheduled_at":     time.Now(),
+		})
 
 	if result.Error != nil {
-		return fmt.Errorf("failed to mark item as processing: %w", result.Error)
+		return 0, fmt.Errorf("failed to release expired leases: %w", result.Error)
 	}
 
-	if result.RowsAffected == 0 {
-		return ErrQueueItemNotFound
+	return result.RowsAffected, nil
+}
+
+// RenewLease extends the lease on an item the worker still holds, so an item that
+// runs longer than one lease is not released to another worker mid-flight
+func (r *processingQueueRepository) RenewLease(queueItemID uuid.UUID, workerID string, lease time.Duration) error {
+	return r.updateHeld(queueItemID, workerID, map[string]interface{}{
+		"lease_expires_at": time.Now().Add(lease),
+	})
+}
+
+// MarkCompleted completes an item the worker still holds
+func (r *processingQueueRepository) MarkCompleted(queueItemID uuid.UUID, workerID string) error {
+	if err := r.updateHeld(queueItemID, workerID, map[string]interface{}{
+		"status":           models.QueueStatusCompleted,
+		"processed_at":     time.Now(),
+		"worker_id":        "",
+		"lease_expires_at": nil,
+	}); err != nil {
+		return fmt.Errorf("failed to mark item as completed: %w", err)
 	}
+	return nil
+}
 
+// MarkFailed fails an item the worker still holds
+func (r *processingQueueRepository) MarkFailed(queueItemID uuid.UUID, workerID, errorMessage string) error {
+	if err := r.updateHeld(queueItemID, workerID, map[string]interface{}{
+		"status":           models.QueueStatusFailed,
+		"error_message":    errorMessage,
+		"processed_at":     time.Now(),
+		"worker_id":        "",
+		"lease_expires_at": nil,
+	}); err != nil {
+		return fmt.Errorf("failed to mark item as failed: %w", err)
+	}
 	return nil
 }
 
-func (r *processingQueueRepository) MarkCompleted(queueItemID uuid.UUID) error {
-	now := time.Now()
-	result := r.db.Model(&models.ProcessingQueueItem{ID: queueItemID}).
-		Updates(map[string]interface{}{
-			"status":       models.QueueStatusCompleted,
-			"processed_at": now,
-		})
+// IncrementRetry schedules an item the worker still holds for another attempt with
+// exponential backoff and records the error that failed the current attempt in its
+// retry history
+func (r *processingQueueRepository) IncrementRetry(queueItemID uuid.UUID, workerID, errorMessage string) error {
+	item := &models.ProcessingQueueItem{ID: queueItemID}
+	if err := r.db.First(item).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrQueueItemNotFound
+		}
+		return fmt.Errorf("failed to find queue item: %w", err)
+	}
 
-	if result.Error != nil {
-		return fmt.Errorf("failed to mark item as completed: %w", result.Error)
+	item.RecordAttempt(errorMessage)
+	item.RetryCount++
+
+	if err := r.updateHeld(queueItemID, workerID, map[string]interface{}{
+		"status":           models.QueueStatusPending,
+		"error_message":    errorMessage,
+		"retry_count":      item.RetryCount,
+		"retry_history":    item.RetryHistory,
+		"scheduled_at":     item.CalculateNextScheduledTime(),
+		"worker_id":        "",
+		"lease_expires_at": nil,
+	}); err != nil {
+		return fmt.Errorf("failed to increment retry: %w", err)
 	}
+	return nil
+}
 
+// updateHeld updates an item only while the worker holds it in processing. An item
+// whose lease expired may already belong to another worker, so it is left alone and
+// ErrQueueLeaseLost is returned.
+func (r *processingQueueRepository) updateHeld(queueItemID uuid.UUID, workerID string, updates map[string]interface{}) error {
+	result := r.db.Model(&models.ProcessingQueueItem{}).
+		Where("id = ? AND worker_id = ? AND status = ?", queueItemID, workerID, models.QueueStatusProcessing).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
 	if result.RowsAffected == 0 {
-		return ErrQueueItemNotFound
+		return ErrQueueLeaseLost
 	}
-
 	return nil
 }
 
-func (r *processingQueueRepository) MarkFailed(queueItemID uuid.UUID, errorMessage string) error {
-	now := time.Now()
-	result := r.db.Model(&models.ProcessingQueueItem{ID: queueItemID}).
-		Updates(map[string]interface{}{
-			"status":        models.QueueStatusFailed,
-			"error_message": errorMessage,
-			"processed_at":  now,
-		})
+// GetByID retrieves a queue item by ID
+func (r *processingQueueRepository) GetByID(queueItemID uuid.UUID) (*models.ProcessingQueueItem, error) {
+	var item models.ProcessingQueueItem
+	if err := r.db.First(&item, "id = ?", queueItemID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrQueueItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get queue item: %w", err)
+	}
+	return &item, nil
+}
 
-	if result.Error != nil {
-		return fmt.Errorf("failed to mark item as failed: %w", result.Error)
+// ListFailed returns failed queue items matching the filters, most recently failed first
+func (r *processingQueueRepository) ListFailed(filters models.QueueItemFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error) {
+	var items []models.ProcessingQueueItem
+	var total int64
+
+	query := r.db.Model(&models.ProcessingQueueItem{}).Where("status = ?", models.QueueStatusFailed)
+
+	if filters.Operation != "" {
+		query = query.Where("operation = ?", filters.Operation)
+	}
+	if filters.FailedBefore != nil {
+		query = query.Where("processed_at < ?", *filters.FailedBefore)
+	}
+	if filters.FailedAfter != nil {
+		query = query.Where("processed_at >= ?", *filters.FailedAfter)
 	}
 
-	if result.RowsAffected == 0 {
-		return ErrQueueItemNotFound
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count failed items: %w", err)
 	}
 
-	return nil
+	if err := query.Order("processed_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&items).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list failed items: %w", err)
+	}
+
+	return items, total, nil
 }
 
-func (r *processingQueueRepository) IncrementRetry(queueItemID uuid.UUID) error {
-	item := &models.ProcessingQueueItem{ID: queueItemID}
-	if err := r.db.First(item).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return ErrQueueItemNotFound
+// Replay returns failed items to the queue for immediate processing. The retry count
+// is reset and the retry history is kept. Items that are not failed are skipped; the
+// IDs of the replayed items are returned.
+func (r *processingQueueRepository) Replay(queueItemIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var replayed []uuid.UUID
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		ids, err := failedItemIDs(tx, queueItemIDs)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("failed to find queue item: %w", err)
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&models.ProcessingQueueItem{}).
+			Where("id IN ? AND status = ?", ids, models.QueueStatusFailed).
+			Updates(map[string]interface{}{
+				"status":        models.QueueStatusPending,
+				"retry_count":   0,
+				"scheduled_at":  time.Now(),
+				"processed_at":  nil,
+				"error_message": "",
+				"replay_count":  gorm.Expr("replay_count + 1"),
+			}).Error; err != nil {
+			return fmt.Errorf("failed to replay queue items: %w", err)
+		}
+
+		replayed = ids
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	item.RetryCount++
-	item.ScheduledAt = item.CalculateNextScheduledTime()
-	item.Status = models.QueueStatusPending
+	return replayed, nil
+}
 
-	if err := r.db.Save(&item).Error; err != nil {
-		return fmt.Errorf("failed to increment retry: %w", err)
+// Purge marks failed items as purged so they are no longer listed or replayed. The
+// items are kept with the reason and the admin who purged them. Items that are not
+// failed are skipped; the IDs of the purged items are returned.
+func (r *processingQueueRepository) Purge(queueItemIDs []uuid.UUID, reason string, purgedBy uuid.UUID) ([]uuid.UUID, error) {
+	var purged []uuid.UUID
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		ids, err := failedItemIDs(tx, queueItemIDs)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&models.ProcessingQueueItem{}).
+			Where("id IN ? AND status = ?", ids, models.QueueStatusFailed).
+			Updates(map[string]interface{}{
+				"status":       models.QueueStatusPurged,
+				"purged_at":    time.Now(),
+				"purge_reason": reason,
+				"purged_by":    purgedBy,
+			}).Error; err != nil {
+			return fmt.Errorf("failed to purge queue items: %w", err)
+		}
+
+		purged = ids
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return purged, nil
+}
+
+// failedItemIDs locks and returns the IDs among queueItemIDs that are failed
+func failedItemIDs(tx *gorm.DB, queueItemIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := tx.Model(&models.ProcessingQueueItem{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ? AND status = ?", queueItemIDs, models.QueueStatusFailed).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to find failed queue items: %w", err)
+	}
+	return ids, nil
 }
 
 func (r *processingQueueRepository) GetPendingCount() (int64, error) {
@@ -167,6 +382,29 @@ func (r *processingQueueRepository) GetFailedCount() (int64, error) {
 	return count, nil
 }
 
+// GetFailedCountsByOperation returns the number of failed items for each operation
+func (r *processingQueueRepository) GetFailedCountsByOperation() (map[string]int64, error) {
+	var rows []struct {
+		Operation string
+		Count     int64
+	}
+
+	if err := r.db.Model(&models.ProcessingQueueItem{}).
+		Select("operation, COUNT(*) as count").
+		Where("status = ?", models.QueueStatusFailed).
+		Group("operation").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count failed items by operation: %w", err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Operation] = row.Count
+	}
+
+	return counts, nil
+}
+
 func (r *processingQueueRepository) GetCompletedCount() (int64, error) {
 	var count int64
 	err := r.db.Model(&models.ProcessingQueueItem{}).
@@ -0,0 +1,317 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// ProcessingQueueRepositoryTestSuite is the test suite for ProcessingQueue repository
+type ProcessingQueueRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo ProcessingQueueRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *ProcessingQueueRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.ProcessingQueueItem{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewProcessingQueueRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *ProcessingQueueRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestProcessingQueueRepositoryTestSuite runs the test suite
+func TestProcessingQueueRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessingQueueRepositoryTestSuite))
+}
+
+// Helper function to create a queue item that failed at the given time
+func (s *ProcessingQueueRepositoryTestSuite) createFailedItem(operation string, failedAt time.Time) *models.ProcessingQueueItem {
+	item := &models.ProcessingQueueItem{
+		TransactionID: uuid.New(),
+		Operation:     operation,
+		Priority:      models.QueuePriorityNormal,
+		Status:        models.QueueStatusFailed,
+		RetryCount:    3,
+		MaxRetries:    3,
+		ScheduledAt:   failedAt,
+		ProcessedAt:   &failedAt,
+		ErrorMessage:  "max retries exceeded",
+		Metadata:      "{}",
+	}
+	require.NoError(s.T(), s.db.Create(item).Error)
+	return item
+}
+
+// Helper function to reload a queue item
+func (s *ProcessingQueueRepositoryTestSuite) reload(id uuid.UUID) *models.ProcessingQueueItem {
+	item, err := s.repo.GetByID(id)
+	require.NoError(s.T(), err)
+	return item
+}
+
+// TestIncrementRetry_RecordsHistory tests that every failed attempt is kept
+func (s *ProcessingQueueRepositoryTestSuite) TestIncrementRetry_RecordsHistory() {
+	transactionID := uuid.New()
+	require.NoError(s.T(), s.repo.Enqueue(transactionID, models.QueueOperationProcess, models.QueuePriorityNormal))
+
+	claimed, err := s.repo.ClaimPending("worker-1", 1, time.Minute)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), claimed, 1)
+	item := claimed[0]
+	require.NoError(s.T(), s.repo.IncrementRetry(item.ID, "worker-1", "connection reset"))
+
+	// Make the retry due now rather than after its backoff
+	require.NoError(s.T(), s.db.Model(item).UpdateColumn("scheduled_at", time.Now()).Error)
+	_, err = s.repo.ClaimPending("worker-1", 1, time.Minute)
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.repo.IncrementRetry(item.ID, "worker-1", "deadlock detected"))
+
+	updated := s.reload(item.ID)
+	assert.Equal(s.T(), models.QueueStatusPending, updated.Status)
+	assert.Equal(s.T(), 2, updated.RetryCount)
+	assert.Equal(s.T(), "deadlock detected", updated.ErrorMessage)
+	assert.True(s.T(), updated.ScheduledAt.After(time.Now()), "retry is scheduled after a backoff")
+	require.Len(s.T(), updated.RetryHistory, 2)
+	assert.Equal(s.T(), 1, updated.RetryHistory[0].Attempt)
+	assert.Equal(s.T(), "connection reset", updated.RetryHistory[0].Error)
+	assert.Equal(s.T(), 2, updated.RetryHistory[1].Attempt)
+
+	assert.ErrorIs(s.T(), s.repo.IncrementRetry(uuid.New(), "worker-1", "x"), ErrQueueItemNotFound)
+}
+
+// TestLostLease_LeavesItemToNewOwner tests that a worker whose lease expired and
+// whose item was claimed by another worker can no longer change it
+func (s *ProcessingQueueRepositoryTestSuite) TestLostLease_LeavesItemToNewOwner() {
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationProcess, models.QueuePriorityNormal))
+
+	stale, err := s.repo.ClaimPending("slow-worker", 1, -time.Second)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), stale, 1)
+	itemID := stale[0].ID
+
+	_, err = s.repo.ReleaseExpiredLeases()
+	require.NoError(s.T(), err)
+	reclaimed, err := s.repo.ClaimPending("worker-2", 1, time.Minute)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), reclaimed, 1)
+
+	assert.ErrorIs(s.T(), s.repo.RenewLease(itemID, "slow-worker", time.Minute), ErrQueueLeaseLost)
+	assert.ErrorIs(s.T(), s.repo.MarkCompleted(itemID, "slow-worker"), ErrQueueLeaseLost)
+	assert.ErrorIs(s.T(), s.repo.MarkFailed(itemID, "slow-worker", "timeout"), ErrQueueLeaseLost)
+	assert.ErrorIs(s.T(), s.repo.IncrementRetry(itemID, "slow-worker", "timeout"), ErrQueueLeaseLost)
+
+	item := s.reload(itemID)
+	assert.Equal(s.T(), models.QueueStatusProcessing, item.Status)
+	assert.Equal(s.T(), "worker-2", item.WorkerID)
+
+	require.NoError(s.T(), s.repo.RenewLease(itemID, "worker-2", time.Hour))
+	renewed := s.reload(itemID)
+	require.NotNil(s.T(), renewed.LeaseExpiresAt)
+	assert.WithinDuration(s.T(), time.Now().Add(time.Hour), *renewed.LeaseExpiresAt, 5*time.Second)
+
+	require.NoError(s.T(), s.repo.MarkCompleted(itemID, "worker-2"))
+	assert.ErrorIs(s.T(), s.repo.MarkCompleted(itemID, "worker-2"), ErrQueueLeaseLost, "a completed item is no longer held")
+}
+
+// TestListFailed_FiltersByOperationAndAge tests the failed item filters
+func (s *ProcessingQueueRepositoryTestSuite) TestListFailed_FiltersByOperationAndAge() {
+	now := time.Now()
+	recent := s.createFailedItem(models.QueueOperationProcess, now.Add(-time.Hour))
+	old := s.createFailedItem(models.QueueOperationProcess, now.Add(-48*time.Hour))
+	reverse := s.createFailedItem(models.QueueOperationReverse, now.Add(-time.Hour))
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationProcess, models.QueuePriorityNormal))
+
+	items, total, err := s.repo.ListFailed(models.QueueItemFilters{}, 0, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(3), total)
+	assert.Len(s.T(), items, 3)
+
+	items, total, err = s.repo.ListFailed(models.QueueItemFilters{Operation: models.QueueOperationReverse}, 0, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), total)
+	assert.Equal(s.T(), reverse.ID, items[0].ID)
+
+	dayAgo := now.Add(-24 * time.Hour)
+	items, _, err = s.repo.ListFailed(models.QueueItemFilters{
+		Operation:    models.QueueOperationProcess,
+		FailedBefore: &dayAgo,
+	}, 0, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), items, 1)
+	assert.Equal(s.T(), old.ID, items[0].ID)
+
+	items, _, err = s.repo.ListFailed(models.QueueItemFilters{
+		Operation:   models.QueueOperationProcess,
+		FailedAfter: &dayAgo,
+	}, 0, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), items, 1)
+	assert.Equal(s.T(), recent.ID, items[0].ID)
+}
+
+// TestReplay_ResetsFailedItems tests that replay requeues only failed items
+func (s *ProcessingQueueRepositoryTestSuite) TestReplay_ResetsFailedItems() {
+	failed := s.createFailedItem(models.QueueOperationProcess, time.Now().Add(-time.Hour))
+	failed.RecordAttempt("connection reset")
+	require.NoError(s.T(), s.db.Model(failed).UpdateColumn("retry_history", failed.RetryHistory).Error)
+
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationProcess, models.QueuePriorityNormal))
+	var pending models.ProcessingQueueItem
+	require.NoError(s.T(), s.db.First(&pending, "status = ?", models.QueueStatusPending).Error)
+
+	replayed, err := s.repo.Replay([]uuid.UUID{failed.ID, pending.ID, uuid.New()})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []uuid.UUID{failed.ID}, replayed)
+
+	updated := s.reload(failed.ID)
+	assert.Equal(s.T(), models.QueueStatusPending, updated.Status)
+	assert.Equal(s.T(), 0, updated.RetryCount)
+	assert.Equal(s.T(), 1, updated.ReplayCount)
+	assert.Nil(s.T(), updated.ProcessedAt)
+	assert.Empty(s.T(), updated.ErrorMessage)
+	assert.WithinDuration(s.T(), time.Now(), updated.ScheduledAt, time.Minute)
+	assert.Len(s.T(), updated.RetryHistory, 1)
+
+	claimed, err := s.repo.ClaimPending("worker-1", 10, time.Minute)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), claimed, 2)
+
+	// A replayed item is no longer failed, so replaying again is a no-op
+	replayed, err = s.repo.Replay([]uuid.UUID{failed.ID})
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), replayed)
+}
+
+// TestPurge_RecordsReason tests that purged items keep the reason and leave the failed list
+func (s *ProcessingQueueRepositoryTestSuite) TestPurge_RecordsReason() {
+	item := s.createFailedItem(models.QueueOperationReverse, time.Now().Add(-time.Hour))
+	adminID := uuid.New()
+
+	purged, err := s.repo.Purge([]uuid.UUID{item.ID}, "Transaction settled manually", adminID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []uuid.UUID{item.ID}, purged)
+
+	updated := s.reload(item.ID)
+	assert.Equal(s.T(), models.QueueStatusPurged, updated.Status)
+	assert.Equal(s.T(), "Transaction settled manually", updated.PurgeReason)
+	assert.Equal(s.T(), &adminID, updated.PurgedBy)
+	assert.NotNil(s.T(), updated.PurgedAt)
+
+	_, total, err := s.repo.ListFailed(models.QueueItemFilters{}, 0, 10)
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), total)
+
+	replayed, err := s.repo.Replay([]uuid.UUID{item.ID})
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), replayed)
+}
+
+// TestClaimPending_ClaimsEachItemOnce tests that claimed items are leased to one worker
+func (s *ProcessingQueueRepositoryTestSuite) TestClaimPending_ClaimsEachItemOnce() {
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationProcess, models.QueuePriorityNormal))
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationReverse, models.QueuePriorityHigh))
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationProcess, models.QueuePriorityNormal))
+
+	// Items scheduled in the future are not due yet
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationProcess, models.QueuePriorityNormal))
+	require.NoError(s.T(), s.db.Model(&models.ProcessingQueueItem{}).
+		Where("id = (SELECT id FROM transaction_processing_queue ORDER BY created_at DESC LIMIT 1)").
+		UpdateColumn("scheduled_at", time.Now().Add(time.Hour)).Error)
+
+	first, err := s.repo.ClaimPending("worker-1", 2, time.Minute)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), first, 2)
+	assert.Equal(s.T(), models.QueueOperationReverse, first[0].Operation, "higher priority items are claimed first")
+	for _, item := range first {
+		assert.Equal(s.T(), models.QueueStatusProcessing, item.Status)
+		assert.Equal(s.T(), "worker-1", item.WorkerID)
+		require.NotNil(s.T(), item.LeaseExpiresAt)
+		assert.WithinDuration(s.T(), time.Now().Add(time.Minute), *item.LeaseExpiresAt, 5*time.Second)
+	}
+
+	second, err := s.repo.ClaimPending("worker-2", 10, time.Minute)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), second, 1)
+	assert.Equal(s.T(), "worker-2", second[0].WorkerID)
+	assert.NotContains(s.T(), []uuid.UUID{first[0].ID, first[1].ID}, second[0].ID)
+
+	none, err := s.repo.ClaimPending("worker-3", 10, time.Minute)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), none)
+
+	require.NoError(s.T(), s.repo.MarkCompleted(second[0].ID, "worker-2"))
+	completed := s.reload(second[0].ID)
+	assert.Empty(s.T(), completed.WorkerID)
+	assert.Nil(s.T(), completed.LeaseExpiresAt)
+}
+
+// TestReleaseExpiredLeases_ReturnsStuckItems tests that only expired leases are released
+func (s *ProcessingQueueRepositoryTestSuite) TestReleaseExpiredLeases_ReturnsStuckItems() {
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationProcess, models.QueuePriorityNormal))
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationProcess, models.QueuePriorityNormal))
+
+	stuck, err := s.repo.ClaimPending("crashed-worker", 1, -time.Second)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), stuck, 1)
+
+	healthy, err := s.repo.ClaimPending("worker-1", 1, time.Hour)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), healthy, 1)
+
+	released, err := s.repo.ReleaseExpiredLeases()
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), released)
+
+	item := s.reload(stuck[0].ID)
+	assert.Equal(s.T(), models.QueueStatusPending, item.Status)
+	assert.Equal(s.T(), 1, item.RetryCount)
+	assert.Empty(s.T(), item.WorkerID)
+	assert.Nil(s.T(), item.LeaseExpiresAt)
+
+	assert.Equal(s.T(), models.QueueStatusProcessing, s.reload(healthy[0].ID).Status)
+
+	reclaimed, err := s.repo.ClaimPending("worker-2", 10, time.Minute)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), reclaimed, 1)
+	assert.Equal(s.T(), stuck[0].ID, reclaimed[0].ID)
+}
+
+// TestGetFailedCountsByOperation tests the per-operation failure counts
+func (s *ProcessingQueueRepositoryTestSuite) TestGetFailedCountsByOperation() {
+	s.createFailedItem(models.QueueOperationProcess, time.Now())
+	s.createFailedItem(models.QueueOperationProcess, time.Now())
+	s.createFailedItem(models.QueueOperationExpire, time.Now())
+	require.NoError(s.T(), s.repo.Enqueue(uuid.New(), models.QueueOperationReverse, models.QueuePriorityNormal))
+
+	counts, err := s.repo.GetFailedCountsByOperation()
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), map[string]int64{
+		models.QueueOperationProcess: 2,
+		models.QueueOperationExpire:  1,
+	}, counts)
+}
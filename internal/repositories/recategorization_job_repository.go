@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrRecategorizationJobNotFound      = errors.New("recategorization job not found")
+	ErrRecategorizationJobNotRunning    = errors.New("recategorization job is no longer running")
+	ErrRecategorizationJobStatusChanged = errors.New("recategorization job status changed")
+)
+
+// recategorizationJobRepository implements RecategorizationJobRepositoryInterface
+type recategorizationJobRepository struct {
+	db *gorm.DB
+}
+
+// NewRecategorizationJobRepository creates a new recategorization job repository
+func NewRecategorizationJobRepository(db *gorm.DB) RecategorizationJobRepositoryInterface {
+	return &recategorizationJobRepository{
+		db: db,
+	}
+}
+
+// Create creates a new recategorization job
+func (r *recategorizationJobRepository) Create(job *models.RecategorizationJob) error {
+	if err := r.db.Create(job).Error; err != nil {
+		return fmt.Errorf("failed to create recategorization job: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a recategorization job by ID
+func (r *recategorizationJobRepository) GetByID(id uuid.UUID) (*models.RecategorizationJob, error) {
+	var job models.RecategorizationJob
+	if err := r.db.Where("id = ?", id).First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecategorizationJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get recategorization job: %w", err)
+	}
+	return &job, nil
+}
+
+// List retrieves recategorization jobs, newest first
+func (r *recategorizationJobRepository) List(offset, limit int) ([]models.RecategorizationJob, int64, error) {
+	var jobs []models.RecategorizationJob
+	var total int64
+
+	if err := r.db.Model(&models.RecategorizationJob{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count recategorization jobs: %w", err)
+	}
+
+	if err := r.db.Order("created_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&jobs).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list recategorization jobs: %w", err)
+	}
+
+	return jobs, total, nil
+}
+
+// ClaimNextRunnable atomically claims the job the worker should run next and marks
+// it running. A running job whose last checkpoint is older than staleBefore was left
+// by a worker that stopped, and is resumed before pending jobs are started. Rows
+// locked by another worker's claim are skipped, and claiming refreshes the job's
+// updated_at so no other worker takes it over while it keeps checkpointing.
+func (r *recategorizationJobRepository) ClaimNextRunnable(staleBefore time.Time) (*models.RecategorizationJob, error) {
+	var job models.RecategorizationJob
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND updated_at < ?)",
+				models.RecategorizationStatusPending, models.RecategorizationStatusRunning, staleBefore).
+			Order(fmt.Sprintf("CASE WHEN status = '%s' THEN 0 ELSE 1 END", models.RecategorizationStatusRunning)).
+			Order("created_at ASC").
+			First(&job).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		job.Status = models.RecategorizationStatusRunning
+		if job.StartedAt == nil {
+			job.StartedAt = &now
+		}
+		return tx.Model(&job).
+			Select("status", "started_at", "updated_at").
+			Updates(&job).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecategorizationJobNotFound
+		}
+		return nil, fmt.Errorf("failed to claim next recategorization job: %w", err)
+	}
+	return &job, nil
+}
+
+// UpdateStatus saves a job's status change, failing if the job is no longer in one
+// of the from statuses so a concurrent change is not overwritten
+func (r *recategorizationJobRepository) UpdateStatus(job *models.RecategorizationJob, from ...string) error {
+	result := r.db.Model(job).
+		Where("status IN ?", from).
+		Select("status", "error_message", "started_at", "completed_at", "updated_at").
+		Updates(job)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update recategorization job status: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecategorizationJobStatusChanged
+	}
+	return nil
+}
+
+// SaveProgress saves a running job's checkpoint and counters. It returns
+// ErrRecategorizationJobNotRunning when the job was cancelled in the meantime,
+// so the worker stops without overwriting the cancellation.
+func (r *recategorizationJobRepository) SaveProgress(job *models.RecategorizationJob) error {
+	result := r.db.Model(job).
+		Where("status = ?", models.RecategorizationStatusRunning).
+		Select("*").
+		Updates(job)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to save recategorization progress: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecategorizationJobNotRunning
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// RecategorizationJobRepositoryTestSuite is the test suite for RecategorizationJob repository
+type RecategorizationJobRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo RecategorizationJobRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *RecategorizationJobRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.RecategorizationJob{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewRecategorizationJobRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *RecategorizationJobRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestRecategorizationJobRepositoryTestSuite runs the test suite
+func TestRecategorizationJobRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RecategorizationJobRepositoryTestSuite))
+}
+
+// Helper function to create a persisted job with the given status
+func (s *RecategorizationJobRepositoryTestSuite) createTestJob(status string) *models.RecategorizationJob {
+	job := &models.RecategorizationJob{Status: status, BatchSize: 100}
+	require.NoError(s.T(), s.repo.Create(job))
+	return job
+}
+
+// TestClaimNextRunnable_PrefersInterruptedJobs tests that stale running jobs are
+// resumed before pending ones start, and that claimed jobs are not claimed again
+func (s *RecategorizationJobRepositoryTestSuite) TestClaimNextRunnable_PrefersInterruptedJobs() {
+	s.createTestJob(models.RecategorizationStatusCompleted)
+	pending := s.createTestJob(models.RecategorizationStatusPending)
+	running := s.createTestJob(models.RecategorizationStatusRunning)
+	staleBefore := time.Now().Add(time.Minute)
+
+	job, err := s.repo.ClaimNextRunnable(staleBefore)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), running.ID, job.ID)
+
+	job, err = s.repo.ClaimNextRunnable(time.Now().Add(-time.Minute))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), pending.ID, job.ID)
+	assert.Equal(s.T(), models.RecategorizationStatusRunning, job.Status)
+	assert.NotNil(s.T(), job.StartedAt)
+
+	_, err = s.repo.ClaimNextRunnable(time.Now().Add(-time.Minute))
+	assert.ErrorIs(s.T(), err, ErrRecategorizationJobNotFound, "jobs being checkpointed are not taken over")
+}
+
+// TestClaimNextRunnable_NoJobs tests the not found error when nothing is queued
+func (s *RecategorizationJobRepositoryTestSuite) TestClaimNextRunnable_NoJobs() {
+	s.createTestJob(models.RecategorizationStatusCancelled)
+
+	_, err := s.repo.ClaimNextRunnable(time.Now())
+	assert.ErrorIs(s.T(), err, ErrRecategorizationJobNotFound)
+}
+
+// TestUpdateStatus_OnlyFromExpectedStatus tests that a status change made
+// concurrently is not overwritten
+func (s *RecategorizationJobRepositoryTestSuite) TestUpdateStatus_OnlyFromExpectedStatus() {
+	job := s.createTestJob(models.RecategorizationStatusCompleted)
+
+	job.Status = models.RecategorizationStatusCancelled
+	err := s.repo.UpdateStatus(job, models.RecategorizationStatusPending, models.RecategorizationStatusRunning)
+	assert.ErrorIs(s.T(), err, ErrRecategorizationJobStatusChanged)
+
+	saved, err := s.repo.GetByID(job.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.RecategorizationStatusCompleted, saved.Status)
+}
+
+// TestSaveProgress_PersistsCheckpoint tests that counters, transitions and the cursor are saved
+func (s *RecategorizationJobRepositoryTestSuite) TestSaveProgress_PersistsCheckpoint() {
+	job := s.createTestJob(models.RecategorizationStatusRunning)
+
+	last := &models.Transaction{ID: uuid.New(), CreatedAt: time.Now().Truncate(time.Second)}
+	job.ProcessedCount = 100
+	job.RecordTransition(models.CategoryOther, models.CategoryDining)
+	job.Checkpoint(last)
+	require.NoError(s.T(), s.repo.SaveProgress(job))
+
+	saved, err := s.repo.GetByID(job.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(100), saved.ProcessedCount)
+	assert.Equal(s.T(), int64(1), saved.Transitions["OTHER->DINING"])
+	assert.Equal(s.T(), last.ID, *saved.CursorTransactionID)
+}
+
+// TestSaveProgress_CancelledJob tests that a cancellation is not overwritten by the worker
+func (s *RecategorizationJobRepositoryTestSuite) TestSaveProgress_CancelledJob() {
+	job := s.createTestJob(models.RecategorizationStatusRunning)
+
+	cancelled, err := s.repo.GetByID(job.ID)
+	require.NoError(s.T(), err)
+	cancelled.Status = models.RecategorizationStatusCancelled
+	require.NoError(s.T(), s.repo.UpdateStatus(cancelled, models.RecategorizationStatusRunning))
+
+	job.ProcessedCount = 50
+	err = s.repo.SaveProgress(job)
+	assert.ErrorIs(s.T(), err, ErrRecategorizationJobNotRunning)
+
+	saved, err := s.repo.GetByID(job.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.RecategorizationStatusCancelled, saved.Status)
+	assert.Equal(s.T(), int64(0), saved.ProcessedCount)
+}
+
+// TestGetByID_NotFound tests retrieving a job that does not exist
+func (s *RecategorizationJobRepositoryTestSuite) TestGetByID_NotFound() {
+	_, err := s.repo.GetByID(uuid.New())
+	assert.ErrorIs(s.T(), err, ErrRecategorizationJobNotFound)
+}
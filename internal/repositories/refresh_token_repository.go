@@ -94,6 +94,21 @@ func (r *RefreshTokenRepository) Update(token *models.RefreshToken) error {
 	return nil
 }
 
+// MarkRotated records that a token was exchanged for a new one. It returns false
+// if the token was already rotated or revoked, so a token replayed concurrently
+// is only rotated once.
+func (r *RefreshTokenRepository) MarkRotated(tokenID uuid.UUID) (bool, error) {
+	result := r.db.Model(&models.RefreshToken{}).
+		Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", tokenID).
+		Update("rotated_at", time.Now())
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to rotate refresh token: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
 // Revoke revokes a specific refresh token
 func (r *RefreshTokenRepository) Revoke(tokenID uuid.UUID) error {
 	now := time.Now()
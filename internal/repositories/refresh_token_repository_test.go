@@ -157,6 +157,29 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_Update() {
 	s.NotNil(updatedToken.RevokedAt)
 }
 
+func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_MarkRotated() {
+	token := &models.RefreshToken{
+		UserID:    uuid.New(),
+		TokenHash: s.hashToken("test.refresh.token"),
+		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+	}
+	s.Require().NoError(s.repo.Create(token))
+
+	rotated, err := s.repo.MarkRotated(token.ID)
+	s.NoError(err)
+	s.True(rotated)
+
+	// A token can only be rotated once
+	rotated, err = s.repo.MarkRotated(token.ID)
+	s.NoError(err)
+	s.False(rotated)
+
+	stored, err := s.repo.GetByTokenHash(token.TokenHash)
+	s.Require().NoError(err)
+	s.True(stored.IsRotated())
+	s.False(stored.IsValid())
+}
+
 func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_RevokeAllForUser() {
 	userID := uuid.New()
 	otherUserID := uuid.New()
@@ -96,10 +96,26 @@ func (mr *MockAccountRepositoryInterfaceMockRecorder) Delete(id interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Delete), id)
 }
 
+// ExecuteAtomicFXTransfer mocks base method.
+func (m *MockAccountRepositoryInterface) ExecuteAtomicFXTransfer(fromAccountID, toAccountID uuid.UUID, debitAmount, creditAmount, rate decimal.Decimal, fromDescription, toDescription string, check repositories.AccountCheck) (uuid.UUID, uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteAtomicFXTransfer", fromAccountID, toAccountID, debitAmount, creditAmount, rate, fromDescription, toDescription, check)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(uuid.UUID)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExecuteAtomicFXTransfer indicates an expected call of ExecuteAtomicFXTransfer.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) ExecuteAtomicFXTransfer(fromAccountID, toAccountID, debitAmount, creditAmount, rate, fromDescription, toDescription, check interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteAtomicFXTransfer", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).ExecuteAtomicFXTransfer), fromAccountID, toAccountID, debitAmount, creditAmount, rate, fromDescription, toDescription, check)
+}
+
 // ExecuteAtomicTransfer mocks base method.
-func (m *MockAccountRepositoryInterface) ExecuteAtomicTransfer(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string) (uuid.UUID, uuid.UUID, error) {
+func (m *MockAccountRepositoryInterface) ExecuteAtomicTransfer(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string, check repositories.AccountCheck) (uuid.UUID, uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExecuteAtomicTransfer", fromAccountID, toAccountID, amount, fromDescription, toDescription)
+	ret := m.ctrl.Call(m, "ExecuteAtomicTransfer", fromAccountID, toAccountID, amount, fromDescription, toDescription, check)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(uuid.UUID)
 	ret2, _ := ret[2].(error)
@@ -107,9 +123,9 @@ func (m *MockAccountRepositoryInterface) ExecuteAtomicTransfer(fromAccountID, to
 }
 
 // ExecuteAtomicTransfer indicates an expected call of ExecuteAtomicTransfer.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) ExecuteAtomicTransfer(fromAccountID, toAccountID, amount, fromDescription, toDescription interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) ExecuteAtomicTransfer(fromAccountID, toAccountID, amount, fromDescription, toDescription, check interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteAtomicTransfer", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).ExecuteAtomicTransfer), fromAccountID, toAccountID, amount, fromDescription, toDescription)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteAtomicTransfer", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).ExecuteAtomicTransfer), fromAccountID, toAccountID, amount, fromDescription, toDescription, check)
 }
 
 // ExistsForUser mocks base method.
@@ -279,6 +295,20 @@ func (mr *MockAccountRepositoryInterfaceMockRecorder) GetTotalBalanceByUserID(us
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalBalanceByUserID", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetTotalBalanceByUserID), userID)
 }
 
+// PostTransaction mocks base method.
+func (m *MockAccountRepositoryInterface) PostTransaction(transaction *models.Transaction, check repositories.AccountCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostTransaction", transaction, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostTransaction indicates an expected call of PostTransaction.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) PostTransaction(transaction, check interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostTransaction", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).PostTransaction), transaction, check)
+}
+
 // SoftDeleteByUserID mocks base method.
 func (m *MockAccountRepositoryInterface) SoftDeleteByUserID(userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -335,6 +365,59 @@ func (mr *MockAccountRepositoryInterfaceMockRecorder) UpdateOwnership(accountID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOwnership", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).UpdateOwnership), accountID, newUserID)
 }
 
+// MockLimitUsageReader is a mock of LimitUsageReader interface.
+type MockLimitUsageReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockLimitUsageReaderMockRecorder
+}
+
+// MockLimitUsageReaderMockRecorder is the mock recorder for MockLimitUsageReader.
+type MockLimitUsageReaderMockRecorder struct {
+	mock *MockLimitUsageReader
+}
+
+// NewMockLimitUsageReader creates a new mock instance.
+func NewMockLimitUsageReader(ctrl *gomock.Controller) *MockLimitUsageReader {
+	mock := &MockLimitUsageReader{ctrl: ctrl}
+	mock.recorder = &MockLimitUsageReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLimitUsageReader) EXPECT() *MockLimitUsageReaderMockRecorder {
+	return m.recorder
+}
+
+// CountTransactions mocks base method.
+func (m *MockLimitUsageReader) CountTransactions(accountID uuid.UUID, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTransactions", accountID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTransactions indicates an expected call of CountTransactions.
+func (mr *MockLimitUsageReaderMockRecorder) CountTransactions(accountID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTransactions", reflect.TypeOf((*MockLimitUsageReader)(nil).CountTransactions), accountID, since)
+}
+
+// GetWithdrawalTotal mocks base method.
+func (m *MockLimitUsageReader) GetWithdrawalTotal(accountID uuid.UUID, since time.Time) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawalTotal", accountID, since)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawalTotal indicates an expected call of GetWithdrawalTotal.
+func (mr *MockLimitUsageReaderMockRecorder) GetWithdrawalTotal(accountID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalTotal", reflect.TypeOf((*MockLimitUsageReader)(nil).GetWithdrawalTotal), accountID, since)
+}
+
 // MockTransactionRepositoryInterface is a mock of TransactionRepositoryInterface interface.
 type MockTransactionRepositoryInterface struct {
 	ctrl     *gomock.Controller
@@ -358,6 +441,36 @@ func (m *MockTransactionRepositoryInterface) EXPECT() *MockTransactionRepository
 	return m.recorder
 }
 
+// ApplyCategoryChanges mocks base method.
+func (m *MockTransactionRepositoryInterface) ApplyCategoryChanges(changes []models.TransactionCategoryChange) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyCategoryChanges", changes)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyCategoryChanges indicates an expected call of ApplyCategoryChanges.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) ApplyCategoryChanges(changes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyCategoryChanges", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).ApplyCategoryChanges), changes)
+}
+
+// CompletePending mocks base method.
+func (m *MockTransactionRepositoryInterface) CompletePending(transaction *models.Transaction) (*models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletePending", transaction)
+	ret0, _ := ret[0].(*models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompletePending indicates an expected call of CompletePending.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) CompletePending(transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletePending", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).CompletePending), transaction)
+}
+
 // Create mocks base method.
 func (m *MockTransactionRepositoryInterface) Create(transaction *models.Transaction) error {
 	m.ctrl.T.Helper()
@@ -386,6 +499,21 @@ func (mr *MockTransactionRepositoryInterfaceMockRecorder) CreateBatch(transactio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).CreateBatch), transactions)
 }
 
+// GetBalanceAsOf mocks base method.
+func (m *MockTransactionRepositoryInterface) GetBalanceAsOf(accountID uuid.UUID, at time.Time) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceAsOf", accountID, at)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceAsOf indicates an expected call of GetBalanceAsOf.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetBalanceAsOf(accountID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceAsOf", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetBalanceAsOf), accountID, at)
+}
+
 // GetByAccountID mocks base method.
 func (m *MockTransactionRepositoryInterface) GetByAccountID(accountID uuid.UUID, offset, limit int) ([]models.Transaction, int64, error) {
 	m.ctrl.T.Helper()
@@ -478,6 +606,21 @@ func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetCategorySummary(acc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategorySummary", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetCategorySummary), accountID, startDate, endDate)
 }
 
+// GetExistingReferences mocks base method.
+func (m *MockTransactionRepositoryInterface) GetExistingReferences(references []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingReferences", references)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingReferences indicates an expected call of GetExistingReferences.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetExistingReferences(references interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingReferences", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetExistingReferences), references)
+}
+
 // GetExpiredPendingTransactions mocks base method.
 func (m *MockTransactionRepositoryInterface) GetExpiredPendingTransactions(limit int) ([]models.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -508,6 +651,21 @@ func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetPendingTransactions
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTransactions", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetPendingTransactions), offset, limit)
 }
 
+// GetRecategorizationBatch mocks base method.
+func (m *MockTransactionRepositoryInterface) GetRecategorizationBatch(job *models.RecategorizationJob) ([]models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecategorizationBatch", job)
+	ret0, _ := ret[0].([]models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecategorizationBatch indicates an expected call of GetRecategorizationBatch.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetRecategorizationBatch(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecategorizationBatch", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetRecategorizationBatch), job)
+}
+
 // GetRecentByAccountID mocks base method.
 func (m *MockTransactionRepositoryInterface) GetRecentByAccountID(accountID uuid.UUID, limit int) ([]models.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -557,6 +715,50 @@ func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetWithFilters(filters
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithFilters", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetWithFilters), filters)
 }
 
+// ImportForAccount mocks base method.
+func (m *MockTransactionRepositoryInterface) ImportForAccount(accountID uuid.UUID, transactions []*models.Transaction, allOrNothing bool) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportForAccount", accountID, transactions, allOrNothing)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportForAccount indicates an expected call of ImportForAccount.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) ImportForAccount(accountID, transactions, allOrNothing interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportForAccount", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).ImportForAccount), accountID, transactions, allOrNothing)
+}
+
+// Reverse mocks base method.
+func (m *MockTransactionRepositoryInterface) Reverse(transactionID uuid.UUID, reason string) ([]*models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reverse", transactionID, reason)
+	ret0, _ := ret[0].([]*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reverse indicates an expected call of Reverse.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) Reverse(transactionID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reverse", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).Reverse), transactionID, reason)
+}
+
+// StreamWithFilters mocks base method.
+func (m *MockTransactionRepositoryInterface) StreamWithFilters(filters models.TransactionFilters, batchSize int, fn func(*models.Transaction) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWithFilters", filters, batchSize, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamWithFilters indicates an expected call of StreamWithFilters.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) StreamWithFilters(filters, batchSize, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWithFilters", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).StreamWithFilters), filters, batchSize, fn)
+}
+
 // UpdateStatus mocks base method.
 func (m *MockTransactionRepositoryInterface) UpdateStatus(id uuid.UUID, status string) error {
 	m.ctrl.T.Helper()
@@ -651,6 +853,22 @@ func (mr *MockUserRepositoryInterfaceMockRecorder) Delete(userID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Delete), userID)
 }
 
+// FuzzySearchUsers mocks base method.
+func (m *MockUserRepositoryInterface) FuzzySearchUsers(criteria repositories.FuzzyUserSearchCriteria, offset, limit int) ([]*models.UserSearchMatch, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzySearchUsers", criteria, offset, limit)
+	ret0, _ := ret[0].([]*models.UserSearchMatch)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FuzzySearchUsers indicates an expected call of FuzzySearchUsers.
+func (mr *MockUserRepositoryInterfaceMockRecorder) FuzzySearchUsers(criteria, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzySearchUsers", reflect.TypeOf((*MockUserRepositoryInterface)(nil).FuzzySearchUsers), criteria, offset, limit)
+}
+
 // GetByEmail mocks base method.
 func (m *MockUserRepositoryInterface) GetByEmail(email string) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -711,6 +929,21 @@ func (mr *MockUserRepositoryInterfaceMockRecorder) GetByIDActive(id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDActive", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByIDActive), id)
 }
 
+// ListSearchCandidates mocks base method.
+func (m *MockUserRepositoryInterface) ListSearchCandidates(accountNumbers []string) ([]*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSearchCandidates", accountNumbers)
+	ret0, _ := ret[0].([]*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSearchCandidates indicates an expected call of ListSearchCandidates.
+func (mr *MockUserRepositoryInterfaceMockRecorder) ListSearchCandidates(accountNumbers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSearchCandidates", reflect.TypeOf((*MockUserRepositoryInterface)(nil).ListSearchCandidates), accountNumbers)
+}
+
 // ListUsers mocks base method.
 func (m *MockUserRepositoryInterface) ListUsers(offset, limit int) ([]*models.User, int64, error) {
 	m.ctrl.T.Helper()
@@ -841,6 +1074,74 @@ func (mr *MockUserRepositoryInterfaceMockRecorder) UpdatePasswordHash(userID, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordHash", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdatePasswordHash), userID, passwordHash)
 }
 
+// MockRoleRepositoryInterface is a mock of RoleRepositoryInterface interface.
+type MockRoleRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleRepositoryInterfaceMockRecorder
+}
+
+// MockRoleRepositoryInterfaceMockRecorder is the mock recorder for MockRoleRepositoryInterface.
+type MockRoleRepositoryInterfaceMockRecorder struct {
+	mock *MockRoleRepositoryInterface
+}
+
+// NewMockRoleRepositoryInterface creates a new mock instance.
+func NewMockRoleRepositoryInterface(ctrl *gomock.Controller) *MockRoleRepositoryInterface {
+	mock := &MockRoleRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockRoleRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleRepositoryInterface) EXPECT() *MockRoleRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetByName mocks base method.
+func (m *MockRoleRepositoryInterface) GetByName(name string) (*models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", name)
+	ret0, _ := ret[0].(*models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) GetByName(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).GetByName), name)
+}
+
+// GetPermissions mocks base method.
+func (m *MockRoleRepositoryInterface) GetPermissions(role string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermissions", role)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermissions indicates an expected call of GetPermissions.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) GetPermissions(role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissions", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).GetPermissions), role)
+}
+
+// List mocks base method.
+func (m *MockRoleRepositoryInterface) List() ([]*models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]*models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).List))
+}
+
 // MockAuditLogRepositoryInterface is a mock of AuditLogRepositoryInterface interface.
 type MockAuditLogRepositoryInterface struct {
 	ctrl     *gomock.Controller
@@ -1019,6 +1320,21 @@ func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetFailedLoginAttempts(em
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedLoginAttempts", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetFailedLoginAttempts), email, since)
 }
 
+// GetRecentLogins mocks base method.
+func (m *MockAuditLogRepositoryInterface) GetRecentLogins(userID uuid.UUID, limit int) ([]*models.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentLogins", userID, limit)
+	ret0, _ := ret[0].([]*models.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentLogins indicates an expected call of GetRecentLogins.
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetRecentLogins(userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentLogins", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetRecentLogins), userID, limit)
+}
+
 // MockProcessingQueueRepositoryInterface is a mock of ProcessingQueueRepositoryInterface interface.
 type MockProcessingQueueRepositoryInterface struct {
 	ctrl     *gomock.Controller
@@ -1042,6 +1358,21 @@ func (m *MockProcessingQueueRepositoryInterface) EXPECT() *MockProcessingQueueRe
 	return m.recorder
 }
 
+// ClaimPending mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) ClaimPending(workerID string, limit int, lease time.Duration) ([]*models.ProcessingQueueItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPending", workerID, limit, lease)
+	ret0, _ := ret[0].([]*models.ProcessingQueueItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPending indicates an expected call of ClaimPending.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) ClaimPending(workerID, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPending", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).ClaimPending), workerID, limit, lease)
+}
+
 // CleanupCompleted mocks base method.
 func (m *MockProcessingQueueRepositoryInterface) CleanupCompleted(olderThan time.Duration) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1071,19 +1402,18 @@ func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) Enqueue(transactio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).Enqueue), transactionID, operation, priority)
 }
 
-// FetchPending mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) FetchPending(limit int) ([]*models.ProcessingQueueItem, error) {
+// EnqueueWithMetadata mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) EnqueueWithMetadata(transactionID uuid.UUID, operation string, priority int, metadata string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchPending", limit)
-	ret0, _ := ret[0].([]*models.ProcessingQueueItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EnqueueWithMetadata", transactionID, operation, priority, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// FetchPending indicates an expected call of FetchPending.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) FetchPending(limit interface{}) *gomock.Call {
+// EnqueueWithMetadata indicates an expected call of EnqueueWithMetadata.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) EnqueueWithMetadata(transactionID, operation, priority, metadata interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPending", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).FetchPending), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueWithMetadata", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).EnqueueWithMetadata), transactionID, operation, priority, metadata)
 }
 
 // GetAverageProcessingTime mocks base method.
@@ -1101,6 +1431,21 @@ func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetAverageProcessi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAverageProcessingTime", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetAverageProcessingTime))
 }
 
+// GetByID mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) GetByID(queueItemID uuid.UUID) (*models.ProcessingQueueItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", queueItemID)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetByID(queueItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetByID), queueItemID)
+}
+
 // GetCompletedCount mocks base method.
 func (m *MockProcessingQueueRepositoryInterface) GetCompletedCount() (int64, error) {
 	m.ctrl.T.Helper()
@@ -1131,6 +1476,21 @@ func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetFailedCount() *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedCount", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetFailedCount))
 }
 
+// GetFailedCountsByOperation mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) GetFailedCountsByOperation() (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedCountsByOperation")
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailedCountsByOperation indicates an expected call of GetFailedCountsByOperation.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetFailedCountsByOperation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedCountsByOperation", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetFailedCountsByOperation))
+}
+
 // GetOldestPendingAge mocks base method.
 func (m *MockProcessingQueueRepositoryInterface) GetOldestPendingAge() (*string, error) {
 	m.ctrl.T.Helper()
@@ -1177,59 +1537,135 @@ func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetProcessingCount
 }
 
 // IncrementRetry mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) IncrementRetry(queueItemID uuid.UUID) error {
+func (m *MockProcessingQueueRepositoryInterface) IncrementRetry(queueItemID uuid.UUID, workerID, errorMessage string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IncrementRetry", queueItemID)
+	ret := m.ctrl.Call(m, "IncrementRetry", queueItemID, workerID, errorMessage)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // IncrementRetry indicates an expected call of IncrementRetry.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) IncrementRetry(queueItemID interface{}) *gomock.Call {
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) IncrementRetry(queueItemID, workerID, errorMessage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementRetry", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).IncrementRetry), queueItemID, workerID, errorMessage)
+}
+
+// IsQueued mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) IsQueued(transactionID uuid.UUID, operation string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsQueued", transactionID, operation)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsQueued indicates an expected call of IsQueued.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) IsQueued(transactionID, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsQueued", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).IsQueued), transactionID, operation)
+}
+
+// ListFailed mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) ListFailed(filters models.QueueItemFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFailed", filters, offset, limit)
+	ret0, _ := ret[0].([]models.ProcessingQueueItem)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFailed indicates an expected call of ListFailed.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) ListFailed(filters, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementRetry", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).IncrementRetry), queueItemID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFailed", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).ListFailed), filters, offset, limit)
 }
 
 // MarkCompleted mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) MarkCompleted(queueItemID uuid.UUID) error {
+func (m *MockProcessingQueueRepositoryInterface) MarkCompleted(queueItemID uuid.UUID, workerID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkCompleted", queueItemID)
+	ret := m.ctrl.Call(m, "MarkCompleted", queueItemID, workerID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MarkCompleted indicates an expected call of MarkCompleted.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkCompleted(queueItemID interface{}) *gomock.Call {
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkCompleted(queueItemID, workerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCompleted", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkCompleted), queueItemID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCompleted", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkCompleted), queueItemID, workerID)
 }
 
 // MarkFailed mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) MarkFailed(queueItemID uuid.UUID, errorMessage string) error {
+func (m *MockProcessingQueueRepositoryInterface) MarkFailed(queueItemID uuid.UUID, workerID, errorMessage string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkFailed", queueItemID, errorMessage)
+	ret := m.ctrl.Call(m, "MarkFailed", queueItemID, workerID, errorMessage)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MarkFailed indicates an expected call of MarkFailed.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkFailed(queueItemID, errorMessage interface{}) *gomock.Call {
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkFailed(queueItemID, workerID, errorMessage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkFailed), queueItemID, workerID, errorMessage)
+}
+
+// Purge mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) Purge(queueItemIDs []uuid.UUID, reason string, purgedBy uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", queueItemIDs, reason, purgedBy)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) Purge(queueItemIDs, reason, purgedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).Purge), queueItemIDs, reason, purgedBy)
+}
+
+// ReleaseExpiredLeases mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) ReleaseExpiredLeases() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExpiredLeases")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseExpiredLeases indicates an expected call of ReleaseExpiredLeases.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) ReleaseExpiredLeases() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkFailed), queueItemID, errorMessage)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpiredLeases", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).ReleaseExpiredLeases))
 }
 
-// MarkProcessing mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) MarkProcessing(queueItemID uuid.UUID) error {
+// RenewLease mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) RenewLease(queueItemID uuid.UUID, workerID string, lease time.Duration) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkProcessing", queueItemID)
+	ret := m.ctrl.Call(m, "RenewLease", queueItemID, workerID, lease)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkProcessing indicates an expected call of MarkProcessing.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkProcessing(queueItemID interface{}) *gomock.Call {
+// RenewLease indicates an expected call of RenewLease.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) RenewLease(queueItemID, workerID, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewLease", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).RenewLease), queueItemID, workerID, lease)
+}
+
+// Replay mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) Replay(queueItemIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", queueItemIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) Replay(queueItemIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkProcessing", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkProcessing), queueItemID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).Replay), queueItemIDs)
 }
 
 // MockTransferRepositoryInterface is a mock of TransferRepositoryInterface interface.
@@ -1270,10 +1706,40 @@ func (mr *MockTransferRepositoryInterfaceMockRecorder) CountByUserAccounts(accou
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserAccounts", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).CountByUserAccounts), accountIDs)
 }
 
-// Create mocks base method.
-func (m *MockTransferRepositoryInterface) Create(transfer *models.Transfer) error {
+// CountCompletedBetween mocks base method.
+func (m *MockTransferRepositoryInterface) CountCompletedBetween(fromAccountID, toAccountID uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", transfer)
+	ret := m.ctrl.Call(m, "CountCompletedBetween", fromAccountID, toAccountID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCompletedBetween indicates an expected call of CountCompletedBetween.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) CountCompletedBetween(fromAccountID, toAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompletedBetween", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).CountCompletedBetween), fromAccountID, toAccountID)
+}
+
+// CountFromAccountSince mocks base method.
+func (m *MockTransferRepositoryInterface) CountFromAccountSince(accountID uuid.UUID, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromAccountSince", accountID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromAccountSince indicates an expected call of CountFromAccountSince.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) CountFromAccountSince(accountID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromAccountSince", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).CountFromAccountSince), accountID, since)
+}
+
+// Create mocks base method.
+func (m *MockTransferRepositoryInterface) Create(transfer *models.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", transfer)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
@@ -1314,6 +1780,21 @@ func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByIdempotencyKey(key
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdempotencyKey", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByIdempotencyKey), key)
 }
 
+// FindByTransactionID mocks base method.
+func (m *MockTransferRepositoryInterface) FindByTransactionID(transactionID uuid.UUID) (*models.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTransactionID", transactionID)
+	ret0, _ := ret[0].(*models.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTransactionID indicates an expected call of FindByTransactionID.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByTransactionID(transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTransactionID", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByTransactionID), transactionID)
+}
+
 // FindByUserAccounts mocks base method.
 func (m *MockTransferRepositoryInterface) FindByUserAccounts(accountIDs []uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
 	m.ctrl.T.Helper()
@@ -1360,6 +1841,117 @@ func (mr *MockTransferRepositoryInterfaceMockRecorder) Update(transfer interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).Update), transfer)
 }
 
+// MockTransferScheduleRepositoryInterface is a mock of TransferScheduleRepositoryInterface interface.
+type MockTransferScheduleRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferScheduleRepositoryInterfaceMockRecorder
+}
+
+// MockTransferScheduleRepositoryInterfaceMockRecorder is the mock recorder for MockTransferScheduleRepositoryInterface.
+type MockTransferScheduleRepositoryInterfaceMockRecorder struct {
+	mock *MockTransferScheduleRepositoryInterface
+}
+
+// NewMockTransferScheduleRepositoryInterface creates a new mock instance.
+func NewMockTransferScheduleRepositoryInterface(ctrl *gomock.Controller) *MockTransferScheduleRepositoryInterface {
+	mock := &MockTransferScheduleRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferScheduleRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferScheduleRepositoryInterface) EXPECT() *MockTransferScheduleRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTransferScheduleRepositoryInterface) Create(schedule *models.TransferSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransferScheduleRepositoryInterfaceMockRecorder) Create(schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferScheduleRepositoryInterface)(nil).Create), schedule)
+}
+
+// GetByID mocks base method.
+func (m *MockTransferScheduleRepositoryInterface) GetByID(id uuid.UUID) (*models.TransferSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.TransferSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTransferScheduleRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransferScheduleRepositoryInterface)(nil).GetByID), id)
+}
+
+// GetByUserID mocks base method.
+func (m *MockTransferScheduleRepositoryInterface) GetByUserID(userID uuid.UUID, offset, limit int) ([]models.TransferSchedule, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", userID, offset, limit)
+	ret0, _ := ret[0].([]models.TransferSchedule)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockTransferScheduleRepositoryInterfaceMockRecorder) GetByUserID(userID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockTransferScheduleRepositoryInterface)(nil).GetByUserID), userID, offset, limit)
+}
+
+// GetDue mocks base method.
+func (m *MockTransferScheduleRepositoryInterface) GetDue(now time.Time, limit int) ([]models.TransferSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDue", now, limit)
+	ret0, _ := ret[0].([]models.TransferSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDue indicates an expected call of GetDue.
+func (mr *MockTransferScheduleRepositoryInterfaceMockRecorder) GetDue(now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDue", reflect.TypeOf((*MockTransferScheduleRepositoryInterface)(nil).GetDue), now, limit)
+}
+
+// SaveRun mocks base method.
+func (m *MockTransferScheduleRepositoryInterface) SaveRun(schedule *models.TransferSchedule, expectedOccurrence int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRun", schedule, expectedOccurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRun indicates an expected call of SaveRun.
+func (mr *MockTransferScheduleRepositoryInterfaceMockRecorder) SaveRun(schedule, expectedOccurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRun", reflect.TypeOf((*MockTransferScheduleRepositoryInterface)(nil).SaveRun), schedule, expectedOccurrence)
+}
+
+// Update mocks base method.
+func (m *MockTransferScheduleRepositoryInterface) Update(schedule *models.TransferSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTransferScheduleRepositoryInterfaceMockRecorder) Update(schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransferScheduleRepositoryInterface)(nil).Update), schedule)
+}
+
 // MockRefreshTokenRepositoryInterface is a mock of RefreshTokenRepositoryInterface interface.
 type MockRefreshTokenRepositoryInterface struct {
 	ctrl     *gomock.Controller
@@ -1472,6 +2064,21 @@ func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) GetByTokenHash(tokenH
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).GetByTokenHash), tokenHash)
 }
 
+// MarkRotated mocks base method.
+func (m *MockRefreshTokenRepositoryInterface) MarkRotated(tokenID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRotated", tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRotated indicates an expected call of MarkRotated.
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) MarkRotated(tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRotated", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).MarkRotated), tokenID)
+}
+
 // Revoke mocks base method.
 func (m *MockRefreshTokenRepositoryInterface) Revoke(tokenID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -1580,3 +2187,1684 @@ func (mr *MockBlacklistedTokenRepositoryInterfaceMockRecorder) GetByJTI(jti inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByJTI", reflect.TypeOf((*MockBlacklistedTokenRepositoryInterface)(nil).GetByJTI), jti)
 }
+
+// MockTransactionCategoryRepositoryInterface is a mock of TransactionCategoryRepositoryInterface interface.
+type MockTransactionCategoryRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionCategoryRepositoryInterfaceMockRecorder
+}
+
+// MockTransactionCategoryRepositoryInterfaceMockRecorder is the mock recorder for MockTransactionCategoryRepositoryInterface.
+type MockTransactionCategoryRepositoryInterfaceMockRecorder struct {
+	mock *MockTransactionCategoryRepositoryInterface
+}
+
+// NewMockTransactionCategoryRepositoryInterface creates a new mock instance.
+func NewMockTransactionCategoryRepositoryInterface(ctrl *gomock.Controller) *MockTransactionCategoryRepositoryInterface {
+	mock := &MockTransactionCategoryRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockTransactionCategoryRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionCategoryRepositoryInterface) EXPECT() *MockTransactionCategoryRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTransactionCategoryRepositoryInterface) Create(category *models.TransactionCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransactionCategoryRepositoryInterfaceMockRecorder) Create(category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionCategoryRepositoryInterface)(nil).Create), category)
+}
+
+// GetActive mocks base method.
+func (m *MockTransactionCategoryRepositoryInterface) GetActive() ([]models.TransactionCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive")
+	ret0, _ := ret[0].([]models.TransactionCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockTransactionCategoryRepositoryInterfaceMockRecorder) GetActive() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockTransactionCategoryRepositoryInterface)(nil).GetActive))
+}
+
+// GetAll mocks base method.
+func (m *MockTransactionCategoryRepositoryInterface) GetAll() ([]models.TransactionCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]models.TransactionCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTransactionCategoryRepositoryInterfaceMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTransactionCategoryRepositoryInterface)(nil).GetAll))
+}
+
+// GetByCode mocks base method.
+func (m *MockTransactionCategoryRepositoryInterface) GetByCode(code string) (*models.TransactionCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", code)
+	ret0, _ := ret[0].(*models.TransactionCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockTransactionCategoryRepositoryInterfaceMockRecorder) GetByCode(code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockTransactionCategoryRepositoryInterface)(nil).GetByCode), code)
+}
+
+// Update mocks base method.
+func (m *MockTransactionCategoryRepositoryInterface) Update(category *models.TransactionCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTransactionCategoryRepositoryInterfaceMockRecorder) Update(category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransactionCategoryRepositoryInterface)(nil).Update), category)
+}
+
+// UpdatePositions mocks base method.
+func (m *MockTransactionCategoryRepositoryInterface) UpdatePositions(positions []models.CategoryPosition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePositions", positions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePositions indicates an expected call of UpdatePositions.
+func (mr *MockTransactionCategoryRepositoryInterfaceMockRecorder) UpdatePositions(positions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePositions", reflect.TypeOf((*MockTransactionCategoryRepositoryInterface)(nil).UpdatePositions), positions)
+}
+
+// MockMerchantMappingRepositoryInterface is a mock of MerchantMappingRepositoryInterface interface.
+type MockMerchantMappingRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchantMappingRepositoryInterfaceMockRecorder
+}
+
+// MockMerchantMappingRepositoryInterfaceMockRecorder is the mock recorder for MockMerchantMappingRepositoryInterface.
+type MockMerchantMappingRepositoryInterfaceMockRecorder struct {
+	mock *MockMerchantMappingRepositoryInterface
+}
+
+// NewMockMerchantMappingRepositoryInterface creates a new mock instance.
+func NewMockMerchantMappingRepositoryInterface(ctrl *gomock.Controller) *MockMerchantMappingRepositoryInterface {
+	mock := &MockMerchantMappingRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockMerchantMappingRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchantMappingRepositoryInterface) EXPECT() *MockMerchantMappingRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockMerchantMappingRepositoryInterface) Create(mapping *models.MerchantMapping) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", mapping)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMerchantMappingRepositoryInterfaceMockRecorder) Create(mapping interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMerchantMappingRepositoryInterface)(nil).Create), mapping)
+}
+
+// GetActive mocks base method.
+func (m *MockMerchantMappingRepositoryInterface) GetActive() ([]models.MerchantMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive")
+	ret0, _ := ret[0].([]models.MerchantMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockMerchantMappingRepositoryInterfaceMockRecorder) GetActive() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockMerchantMappingRepositoryInterface)(nil).GetActive))
+}
+
+// GetByID mocks base method.
+func (m *MockMerchantMappingRepositoryInterface) GetByID(id uuid.UUID) (*models.MerchantMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.MerchantMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockMerchantMappingRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMerchantMappingRepositoryInterface)(nil).GetByID), id)
+}
+
+// List mocks base method.
+func (m *MockMerchantMappingRepositoryInterface) List(filters models.MerchantMappingFilters, offset, limit int) ([]models.MerchantMapping, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", filters, offset, limit)
+	ret0, _ := ret[0].([]models.MerchantMapping)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockMerchantMappingRepositoryInterfaceMockRecorder) List(filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMerchantMappingRepositoryInterface)(nil).List), filters, offset, limit)
+}
+
+// RecordUsage mocks base method.
+func (m *MockMerchantMappingRepositoryInterface) RecordUsage(id uuid.UUID, usedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUsage", id, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordUsage indicates an expected call of RecordUsage.
+func (mr *MockMerchantMappingRepositoryInterfaceMockRecorder) RecordUsage(id, usedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUsage", reflect.TypeOf((*MockMerchantMappingRepositoryInterface)(nil).RecordUsage), id, usedAt)
+}
+
+// Update mocks base method.
+func (m *MockMerchantMappingRepositoryInterface) Update(mapping *models.MerchantMapping) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", mapping)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMerchantMappingRepositoryInterfaceMockRecorder) Update(mapping interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMerchantMappingRepositoryInterface)(nil).Update), mapping)
+}
+
+// MockRecategorizationJobRepositoryInterface is a mock of RecategorizationJobRepositoryInterface interface.
+type MockRecategorizationJobRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecategorizationJobRepositoryInterfaceMockRecorder
+}
+
+// MockRecategorizationJobRepositoryInterfaceMockRecorder is the mock recorder for MockRecategorizationJobRepositoryInterface.
+type MockRecategorizationJobRepositoryInterfaceMockRecorder struct {
+	mock *MockRecategorizationJobRepositoryInterface
+}
+
+// NewMockRecategorizationJobRepositoryInterface creates a new mock instance.
+func NewMockRecategorizationJobRepositoryInterface(ctrl *gomock.Controller) *MockRecategorizationJobRepositoryInterface {
+	mock := &MockRecategorizationJobRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockRecategorizationJobRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecategorizationJobRepositoryInterface) EXPECT() *MockRecategorizationJobRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ClaimNextRunnable mocks base method.
+func (m *MockRecategorizationJobRepositoryInterface) ClaimNextRunnable(staleBefore time.Time) (*models.RecategorizationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNextRunnable", staleBefore)
+	ret0, _ := ret[0].(*models.RecategorizationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNextRunnable indicates an expected call of ClaimNextRunnable.
+func (mr *MockRecategorizationJobRepositoryInterfaceMockRecorder) ClaimNextRunnable(staleBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNextRunnable", reflect.TypeOf((*MockRecategorizationJobRepositoryInterface)(nil).ClaimNextRunnable), staleBefore)
+}
+
+// Create mocks base method.
+func (m *MockRecategorizationJobRepositoryInterface) Create(job *models.RecategorizationJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecategorizationJobRepositoryInterfaceMockRecorder) Create(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecategorizationJobRepositoryInterface)(nil).Create), job)
+}
+
+// GetByID mocks base method.
+func (m *MockRecategorizationJobRepositoryInterface) GetByID(id uuid.UUID) (*models.RecategorizationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.RecategorizationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRecategorizationJobRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRecategorizationJobRepositoryInterface)(nil).GetByID), id)
+}
+
+// List mocks base method.
+func (m *MockRecategorizationJobRepositoryInterface) List(offset, limit int) ([]models.RecategorizationJob, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", offset, limit)
+	ret0, _ := ret[0].([]models.RecategorizationJob)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockRecategorizationJobRepositoryInterfaceMockRecorder) List(offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRecategorizationJobRepositoryInterface)(nil).List), offset, limit)
+}
+
+// SaveProgress mocks base method.
+func (m *MockRecategorizationJobRepositoryInterface) SaveProgress(job *models.RecategorizationJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProgress", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProgress indicates an expected call of SaveProgress.
+func (mr *MockRecategorizationJobRepositoryInterfaceMockRecorder) SaveProgress(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProgress", reflect.TypeOf((*MockRecategorizationJobRepositoryInterface)(nil).SaveProgress), job)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockRecategorizationJobRepositoryInterface) UpdateStatus(job *models.RecategorizationJob, from ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{job}
+	for _, a := range from {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockRecategorizationJobRepositoryInterfaceMockRecorder) UpdateStatus(job interface{}, from ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{job}, from...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockRecategorizationJobRepositoryInterface)(nil).UpdateStatus), varargs...)
+}
+
+// MockInterestRepositoryInterface is a mock of InterestRepositoryInterface interface.
+type MockInterestRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterestRepositoryInterfaceMockRecorder
+}
+
+// MockInterestRepositoryInterfaceMockRecorder is the mock recorder for MockInterestRepositoryInterface.
+type MockInterestRepositoryInterfaceMockRecorder struct {
+	mock *MockInterestRepositoryInterface
+}
+
+// NewMockInterestRepositoryInterface creates a new mock instance.
+func NewMockInterestRepositoryInterface(ctrl *gomock.Controller) *MockInterestRepositoryInterface {
+	mock := &MockInterestRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockInterestRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterestRepositoryInterface) EXPECT() *MockInterestRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateAccrual mocks base method.
+func (m *MockInterestRepositoryInterface) CreateAccrual(accrual *models.InterestAccrual) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccrual", accrual)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccrual indicates an expected call of CreateAccrual.
+func (mr *MockInterestRepositoryInterfaceMockRecorder) CreateAccrual(accrual interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccrual", reflect.TypeOf((*MockInterestRepositoryInterface)(nil).CreateAccrual), accrual)
+}
+
+// GetAccruals mocks base method.
+func (m *MockInterestRepositoryInterface) GetAccruals(accountID uuid.UUID, startDate, endDate time.Time) ([]models.InterestAccrual, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccruals", accountID, startDate, endDate)
+	ret0, _ := ret[0].([]models.InterestAccrual)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccruals indicates an expected call of GetAccruals.
+func (mr *MockInterestRepositoryInterfaceMockRecorder) GetAccruals(accountID, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccruals", reflect.TypeOf((*MockInterestRepositoryInterface)(nil).GetAccruals), accountID, startDate, endDate)
+}
+
+// GetEarliestUnpostedAccrualDate mocks base method.
+func (m *MockInterestRepositoryInterface) GetEarliestUnpostedAccrualDate(accountID uuid.UUID) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEarliestUnpostedAccrualDate", accountID)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEarliestUnpostedAccrualDate indicates an expected call of GetEarliestUnpostedAccrualDate.
+func (mr *MockInterestRepositoryInterfaceMockRecorder) GetEarliestUnpostedAccrualDate(accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEarliestUnpostedAccrualDate", reflect.TypeOf((*MockInterestRepositoryInterface)(nil).GetEarliestUnpostedAccrualDate), accountID)
+}
+
+// GetInterestBearingAccounts mocks base method.
+func (m *MockInterestRepositoryInterface) GetInterestBearingAccounts(offset, limit int) ([]models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterestBearingAccounts", offset, limit)
+	ret0, _ := ret[0].([]models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterestBearingAccounts indicates an expected call of GetInterestBearingAccounts.
+func (mr *MockInterestRepositoryInterfaceMockRecorder) GetInterestBearingAccounts(offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterestBearingAccounts", reflect.TypeOf((*MockInterestRepositoryInterface)(nil).GetInterestBearingAccounts), offset, limit)
+}
+
+// GetLastAccrualDate mocks base method.
+func (m *MockInterestRepositoryInterface) GetLastAccrualDate(accountID uuid.UUID) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastAccrualDate", accountID)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastAccrualDate indicates an expected call of GetLastAccrualDate.
+func (mr *MockInterestRepositoryInterfaceMockRecorder) GetLastAccrualDate(accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAccrualDate", reflect.TypeOf((*MockInterestRepositoryInterface)(nil).GetLastAccrualDate), accountID)
+}
+
+// GetLastPosting mocks base method.
+func (m *MockInterestRepositoryInterface) GetLastPosting(accountID uuid.UUID) (*models.InterestPosting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastPosting", accountID)
+	ret0, _ := ret[0].(*models.InterestPosting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastPosting indicates an expected call of GetLastPosting.
+func (mr *MockInterestRepositoryInterfaceMockRecorder) GetLastPosting(accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastPosting", reflect.TypeOf((*MockInterestRepositoryInterface)(nil).GetLastPosting), accountID)
+}
+
+// PostInterest mocks base method.
+func (m *MockInterestRepositoryInterface) PostInterest(accountID uuid.UUID, periodStart time.Time) (*models.InterestPosting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostInterest", accountID, periodStart)
+	ret0, _ := ret[0].(*models.InterestPosting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostInterest indicates an expected call of PostInterest.
+func (mr *MockInterestRepositoryInterfaceMockRecorder) PostInterest(accountID, periodStart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostInterest", reflect.TypeOf((*MockInterestRepositoryInterface)(nil).PostInterest), accountID, periodStart)
+}
+
+// MockHoldRepositoryInterface is a mock of HoldRepositoryInterface interface.
+type MockHoldRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockHoldRepositoryInterfaceMockRecorder
+}
+
+// MockHoldRepositoryInterfaceMockRecorder is the mock recorder for MockHoldRepositoryInterface.
+type MockHoldRepositoryInterfaceMockRecorder struct {
+	mock *MockHoldRepositoryInterface
+}
+
+// NewMockHoldRepositoryInterface creates a new mock instance.
+func NewMockHoldRepositoryInterface(ctrl *gomock.Controller) *MockHoldRepositoryInterface {
+	mock := &MockHoldRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockHoldRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHoldRepositoryInterface) EXPECT() *MockHoldRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CaptureHold mocks base method.
+func (m *MockHoldRepositoryInterface) CaptureHold(id uuid.UUID, amount decimal.Decimal) (*models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", id, amount)
+	ret0, _ := ret[0].(*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockHoldRepositoryInterfaceMockRecorder) CaptureHold(id, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockHoldRepositoryInterface)(nil).CaptureHold), id, amount)
+}
+
+// GetActiveHolds mocks base method.
+func (m *MockHoldRepositoryInterface) GetActiveHolds(accountID uuid.UUID) ([]models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveHolds", accountID)
+	ret0, _ := ret[0].([]models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveHolds indicates an expected call of GetActiveHolds.
+func (mr *MockHoldRepositoryInterfaceMockRecorder) GetActiveHolds(accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveHolds", reflect.TypeOf((*MockHoldRepositoryInterface)(nil).GetActiveHolds), accountID)
+}
+
+// GetHold mocks base method.
+func (m *MockHoldRepositoryInterface) GetHold(id uuid.UUID) (*models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", id)
+	ret0, _ := ret[0].(*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockHoldRepositoryInterfaceMockRecorder) GetHold(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockHoldRepositoryInterface)(nil).GetHold), id)
+}
+
+// PlaceHold mocks base method.
+func (m *MockHoldRepositoryInterface) PlaceHold(hold *models.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceHold", hold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaceHold indicates an expected call of PlaceHold.
+func (mr *MockHoldRepositoryInterfaceMockRecorder) PlaceHold(hold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceHold", reflect.TypeOf((*MockHoldRepositoryInterface)(nil).PlaceHold), hold)
+}
+
+// ReleaseHold mocks base method.
+func (m *MockHoldRepositoryInterface) ReleaseHold(id uuid.UUID, outcome string) (*models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", id, outcome)
+	ret0, _ := ret[0].(*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockHoldRepositoryInterfaceMockRecorder) ReleaseHold(id, outcome interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockHoldRepositoryInterface)(nil).ReleaseHold), id, outcome)
+}
+
+// MockExchangeRateRepositoryInterface is a mock of ExchangeRateRepositoryInterface interface.
+type MockExchangeRateRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateRepositoryInterfaceMockRecorder
+}
+
+// MockExchangeRateRepositoryInterfaceMockRecorder is the mock recorder for MockExchangeRateRepositoryInterface.
+type MockExchangeRateRepositoryInterfaceMockRecorder struct {
+	mock *MockExchangeRateRepositoryInterface
+}
+
+// NewMockExchangeRateRepositoryInterface creates a new mock instance.
+func NewMockExchangeRateRepositoryInterface(ctrl *gomock.Controller) *MockExchangeRateRepositoryInterface {
+	mock := &MockExchangeRateRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateRepositoryInterface) EXPECT() *MockExchangeRateRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetRate mocks base method.
+func (m *MockExchangeRateRepositoryInterface) GetRate(baseCurrency, quoteCurrency string) (*models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", baseCurrency, quoteCurrency)
+	ret0, _ := ret[0].(*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockExchangeRateRepositoryInterfaceMockRecorder) GetRate(baseCurrency, quoteCurrency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockExchangeRateRepositoryInterface)(nil).GetRate), baseCurrency, quoteCurrency)
+}
+
+// List mocks base method.
+func (m *MockExchangeRateRepositoryInterface) List() ([]models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockExchangeRateRepositoryInterfaceMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockExchangeRateRepositoryInterface)(nil).List))
+}
+
+// Upsert mocks base method.
+func (m *MockExchangeRateRepositoryInterface) Upsert(rate *models.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockExchangeRateRepositoryInterfaceMockRecorder) Upsert(rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockExchangeRateRepositoryInterface)(nil).Upsert), rate)
+}
+
+// MockExternalTransferRepositoryInterface is a mock of ExternalTransferRepositoryInterface interface.
+type MockExternalTransferRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalTransferRepositoryInterfaceMockRecorder
+}
+
+// MockExternalTransferRepositoryInterfaceMockRecorder is the mock recorder for MockExternalTransferRepositoryInterface.
+type MockExternalTransferRepositoryInterfaceMockRecorder struct {
+	mock *MockExternalTransferRepositoryInterface
+}
+
+// NewMockExternalTransferRepositoryInterface creates a new mock instance.
+func NewMockExternalTransferRepositoryInterface(ctrl *gomock.Controller) *MockExternalTransferRepositoryInterface {
+	mock := &MockExternalTransferRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockExternalTransferRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalTransferRepositoryInterface) EXPECT() *MockExternalTransferRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExternalTransferRepositoryInterface) Create(transfer *models.ExternalTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) Create(transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).Create), transfer)
+}
+
+// Fail mocks base method.
+func (m *MockExternalTransferRepositoryInterface) Fail(id uuid.UUID, reason string) (*models.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fail", id, reason)
+	ret0, _ := ret[0].(*models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fail indicates an expected call of Fail.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) Fail(id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fail", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).Fail), id, reason)
+}
+
+// GetByID mocks base method.
+func (m *MockExternalTransferRepositoryInterface) GetByID(id uuid.UUID) (*models.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).GetByID), id)
+}
+
+// GetByIdempotencyKey mocks base method.
+func (m *MockExternalTransferRepositoryInterface) GetByIdempotencyKey(key string) (*models.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIdempotencyKey", key)
+	ret0, _ := ret[0].(*models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIdempotencyKey indicates an expected call of GetByIdempotencyKey.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) GetByIdempotencyKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIdempotencyKey", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).GetByIdempotencyKey), key)
+}
+
+// GetByUserID mocks base method.
+func (m *MockExternalTransferRepositoryInterface) GetByUserID(userID uuid.UUID, offset, limit int) ([]models.ExternalTransfer, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", userID, offset, limit)
+	ret0, _ := ret[0].([]models.ExternalTransfer)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) GetByUserID(userID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).GetByUserID), userID, offset, limit)
+}
+
+// GetDueForSettlement mocks base method.
+func (m *MockExternalTransferRepositoryInterface) GetDueForSettlement(now time.Time, limit int) ([]models.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueForSettlement", now, limit)
+	ret0, _ := ret[0].([]models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueForSettlement indicates an expected call of GetDueForSettlement.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) GetDueForSettlement(now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueForSettlement", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).GetDueForSettlement), now, limit)
+}
+
+// GetUnsubmitted mocks base method.
+func (m *MockExternalTransferRepositoryInterface) GetUnsubmitted(before time.Time, limit int) ([]models.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnsubmitted", before, limit)
+	ret0, _ := ret[0].([]models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnsubmitted indicates an expected call of GetUnsubmitted.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) GetUnsubmitted(before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnsubmitted", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).GetUnsubmitted), before, limit)
+}
+
+// MarkSubmitted mocks base method.
+func (m *MockExternalTransferRepositoryInterface) MarkSubmitted(transfer *models.ExternalTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSubmitted", transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSubmitted indicates an expected call of MarkSubmitted.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) MarkSubmitted(transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSubmitted", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).MarkSubmitted), transfer)
+}
+
+// ReleaseFromReview mocks base method.
+func (m *MockExternalTransferRepositoryInterface) ReleaseFromReview(id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseFromReview", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseFromReview indicates an expected call of ReleaseFromReview.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) ReleaseFromReview(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseFromReview", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).ReleaseFromReview), id)
+}
+
+// Return mocks base method.
+func (m *MockExternalTransferRepositoryInterface) Return(id uuid.UUID, returnCode, reason string) (*models.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Return", id, returnCode, reason)
+	ret0, _ := ret[0].(*models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Return indicates an expected call of Return.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) Return(id, returnCode, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Return", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).Return), id, returnCode, reason)
+}
+
+// Settle mocks base method.
+func (m *MockExternalTransferRepositoryInterface) Settle(id uuid.UUID) (*models.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Settle", id)
+	ret0, _ := ret[0].(*models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Settle indicates an expected call of Settle.
+func (mr *MockExternalTransferRepositoryInterfaceMockRecorder) Settle(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Settle", reflect.TypeOf((*MockExternalTransferRepositoryInterface)(nil).Settle), id)
+}
+
+// MockLimitRepositoryInterface is a mock of LimitRepositoryInterface interface.
+type MockLimitRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockLimitRepositoryInterfaceMockRecorder
+}
+
+// MockLimitRepositoryInterfaceMockRecorder is the mock recorder for MockLimitRepositoryInterface.
+type MockLimitRepositoryInterfaceMockRecorder struct {
+	mock *MockLimitRepositoryInterface
+}
+
+// NewMockLimitRepositoryInterface creates a new mock instance.
+func NewMockLimitRepositoryInterface(ctrl *gomock.Controller) *MockLimitRepositoryInterface {
+	mock := &MockLimitRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockLimitRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLimitRepositoryInterface) EXPECT() *MockLimitRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CountTransactions mocks base method.
+func (m *MockLimitRepositoryInterface) CountTransactions(accountID uuid.UUID, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTransactions", accountID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTransactions indicates an expected call of CountTransactions.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) CountTransactions(accountID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTransactions", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).CountTransactions), accountID, since)
+}
+
+// CreateOverride mocks base method.
+func (m *MockLimitRepositoryInterface) CreateOverride(override *models.LimitOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOverride", override)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOverride indicates an expected call of CreateOverride.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) CreateOverride(override interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOverride", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).CreateOverride), override)
+}
+
+// DeleteForUser mocks base method.
+func (m *MockLimitRepositoryInterface) DeleteForUser(userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForUser", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteForUser indicates an expected call of DeleteForUser.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) DeleteForUser(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForUser", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).DeleteForUser), userID)
+}
+
+// GetAccountTypeLimits mocks base method.
+func (m *MockLimitRepositoryInterface) GetAccountTypeLimits() ([]models.TransactionLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountTypeLimits")
+	ret0, _ := ret[0].([]models.TransactionLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTypeLimits indicates an expected call of GetAccountTypeLimits.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) GetAccountTypeLimits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTypeLimits", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).GetAccountTypeLimits))
+}
+
+// GetActiveOverrides mocks base method.
+func (m *MockLimitRepositoryInterface) GetActiveOverrides(accountID uuid.UUID, at time.Time) ([]models.LimitOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveOverrides", accountID, at)
+	ret0, _ := ret[0].([]models.LimitOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveOverrides indicates an expected call of GetActiveOverrides.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) GetActiveOverrides(accountID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveOverrides", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).GetActiveOverrides), accountID, at)
+}
+
+// GetForAccountType mocks base method.
+func (m *MockLimitRepositoryInterface) GetForAccountType(accountType string) (*models.TransactionLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForAccountType", accountType)
+	ret0, _ := ret[0].(*models.TransactionLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForAccountType indicates an expected call of GetForAccountType.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) GetForAccountType(accountType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForAccountType", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).GetForAccountType), accountType)
+}
+
+// GetForUser mocks base method.
+func (m *MockLimitRepositoryInterface) GetForUser(userID uuid.UUID) (*models.TransactionLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUser", userID)
+	ret0, _ := ret[0].(*models.TransactionLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUser indicates an expected call of GetForUser.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) GetForUser(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUser", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).GetForUser), userID)
+}
+
+// GetOverrideByID mocks base method.
+func (m *MockLimitRepositoryInterface) GetOverrideByID(id uuid.UUID) (*models.LimitOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverrideByID", id)
+	ret0, _ := ret[0].(*models.LimitOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverrideByID indicates an expected call of GetOverrideByID.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) GetOverrideByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverrideByID", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).GetOverrideByID), id)
+}
+
+// GetWithdrawalTotal mocks base method.
+func (m *MockLimitRepositoryInterface) GetWithdrawalTotal(accountID uuid.UUID, since time.Time) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawalTotal", accountID, since)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawalTotal indicates an expected call of GetWithdrawalTotal.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) GetWithdrawalTotal(accountID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalTotal", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).GetWithdrawalTotal), accountID, since)
+}
+
+// RevokeOverride mocks base method.
+func (m *MockLimitRepositoryInterface) RevokeOverride(override *models.LimitOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOverride", override)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeOverride indicates an expected call of RevokeOverride.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) RevokeOverride(override interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOverride", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).RevokeOverride), override)
+}
+
+// Upsert mocks base method.
+func (m *MockLimitRepositoryInterface) Upsert(limit *models.TransactionLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockLimitRepositoryInterfaceMockRecorder) Upsert(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockLimitRepositoryInterface)(nil).Upsert), limit)
+}
+
+// MockFraudReviewRepositoryInterface is a mock of FraudReviewRepositoryInterface interface.
+type MockFraudReviewRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFraudReviewRepositoryInterfaceMockRecorder
+}
+
+// MockFraudReviewRepositoryInterfaceMockRecorder is the mock recorder for MockFraudReviewRepositoryInterface.
+type MockFraudReviewRepositoryInterfaceMockRecorder struct {
+	mock *MockFraudReviewRepositoryInterface
+}
+
+// NewMockFraudReviewRepositoryInterface creates a new mock instance.
+func NewMockFraudReviewRepositoryInterface(ctrl *gomock.Controller) *MockFraudReviewRepositoryInterface {
+	mock := &MockFraudReviewRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockFraudReviewRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFraudReviewRepositoryInterface) EXPECT() *MockFraudReviewRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFraudReviewRepositoryInterface) Create(review *models.FraudReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFraudReviewRepositoryInterfaceMockRecorder) Create(review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFraudReviewRepositoryInterface)(nil).Create), review)
+}
+
+// Decide mocks base method.
+func (m *MockFraudReviewRepositoryInterface) Decide(review *models.FraudReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decide", review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decide indicates an expected call of Decide.
+func (mr *MockFraudReviewRepositoryInterfaceMockRecorder) Decide(review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decide", reflect.TypeOf((*MockFraudReviewRepositoryInterface)(nil).Decide), review)
+}
+
+// GetByID mocks base method.
+func (m *MockFraudReviewRepositoryInterface) GetByID(id uuid.UUID) (*models.FraudReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.FraudReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockFraudReviewRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockFraudReviewRepositoryInterface)(nil).GetByID), id)
+}
+
+// GetExpired mocks base method.
+func (m *MockFraudReviewRepositoryInterface) GetExpired(at time.Time, limit int) ([]models.FraudReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpired", at, limit)
+	ret0, _ := ret[0].([]models.FraudReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpired indicates an expected call of GetExpired.
+func (mr *MockFraudReviewRepositoryInterfaceMockRecorder) GetExpired(at, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpired", reflect.TypeOf((*MockFraudReviewRepositoryInterface)(nil).GetExpired), at, limit)
+}
+
+// List mocks base method.
+func (m *MockFraudReviewRepositoryInterface) List(status string, offset, limit int) ([]models.FraudReview, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", status, offset, limit)
+	ret0, _ := ret[0].([]models.FraudReview)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockFraudReviewRepositoryInterfaceMockRecorder) List(status, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFraudReviewRepositoryInterface)(nil).List), status, offset, limit)
+}
+
+// RecordFailure mocks base method.
+func (m *MockFraudReviewRepositoryInterface) RecordFailure(review *models.FraudReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockFraudReviewRepositoryInterfaceMockRecorder) RecordFailure(review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockFraudReviewRepositoryInterface)(nil).RecordFailure), review)
+}
+
+// MockPendingActionRepositoryInterface is a mock of PendingActionRepositoryInterface interface.
+type MockPendingActionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPendingActionRepositoryInterfaceMockRecorder
+}
+
+// MockPendingActionRepositoryInterfaceMockRecorder is the mock recorder for MockPendingActionRepositoryInterface.
+type MockPendingActionRepositoryInterfaceMockRecorder struct {
+	mock *MockPendingActionRepositoryInterface
+}
+
+// NewMockPendingActionRepositoryInterface creates a new mock instance.
+func NewMockPendingActionRepositoryInterface(ctrl *gomock.Controller) *MockPendingActionRepositoryInterface {
+	mock := &MockPendingActionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockPendingActionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPendingActionRepositoryInterface) EXPECT() *MockPendingActionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPendingActionRepositoryInterface) Create(action *models.PendingAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPendingActionRepositoryInterfaceMockRecorder) Create(action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPendingActionRepositoryInterface)(nil).Create), action)
+}
+
+// Decide mocks base method.
+func (m *MockPendingActionRepositoryInterface) Decide(action *models.PendingAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decide", action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decide indicates an expected call of Decide.
+func (mr *MockPendingActionRepositoryInterfaceMockRecorder) Decide(action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decide", reflect.TypeOf((*MockPendingActionRepositoryInterface)(nil).Decide), action)
+}
+
+// GetByID mocks base method.
+func (m *MockPendingActionRepositoryInterface) GetByID(id uuid.UUID) (*models.PendingAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.PendingAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPendingActionRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPendingActionRepositoryInterface)(nil).GetByID), id)
+}
+
+// GetExpired mocks base method.
+func (m *MockPendingActionRepositoryInterface) GetExpired(at time.Time, limit int) ([]models.PendingAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpired", at, limit)
+	ret0, _ := ret[0].([]models.PendingAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpired indicates an expected call of GetExpired.
+func (mr *MockPendingActionRepositoryInterfaceMockRecorder) GetExpired(at, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpired", reflect.TypeOf((*MockPendingActionRepositoryInterface)(nil).GetExpired), at, limit)
+}
+
+// List mocks base method.
+func (m *MockPendingActionRepositoryInterface) List(status string, offset, limit int) ([]models.PendingAction, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", status, offset, limit)
+	ret0, _ := ret[0].([]models.PendingAction)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockPendingActionRepositoryInterfaceMockRecorder) List(status, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPendingActionRepositoryInterface)(nil).List), status, offset, limit)
+}
+
+// RecordFailure mocks base method.
+func (m *MockPendingActionRepositoryInterface) RecordFailure(action *models.PendingAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockPendingActionRepositoryInterfaceMockRecorder) RecordFailure(action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockPendingActionRepositoryInterface)(nil).RecordFailure), action)
+}
+
+// MockStatementArchiveRepositoryInterface is a mock of StatementArchiveRepositoryInterface interface.
+type MockStatementArchiveRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatementArchiveRepositoryInterfaceMockRecorder
+}
+
+// MockStatementArchiveRepositoryInterfaceMockRecorder is the mock recorder for MockStatementArchiveRepositoryInterface.
+type MockStatementArchiveRepositoryInterfaceMockRecorder struct {
+	mock *MockStatementArchiveRepositoryInterface
+}
+
+// NewMockStatementArchiveRepositoryInterface creates a new mock instance.
+func NewMockStatementArchiveRepositoryInterface(ctrl *gomock.Controller) *MockStatementArchiveRepositoryInterface {
+	mock := &MockStatementArchiveRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockStatementArchiveRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatementArchiveRepositoryInterface) EXPECT() *MockStatementArchiveRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStatementArchiveRepositoryInterface) Create(statement *models.ArchivedStatement) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", statement)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStatementArchiveRepositoryInterfaceMockRecorder) Create(statement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStatementArchiveRepositoryInterface)(nil).Create), statement)
+}
+
+// Get mocks base method.
+func (m *MockStatementArchiveRepositoryInterface) Get(accountID uuid.UUID, periodType string, year, period int, format string) (*models.ArchivedStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", accountID, periodType, year, period, format)
+	ret0, _ := ret[0].(*models.ArchivedStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStatementArchiveRepositoryInterfaceMockRecorder) Get(accountID, periodType, year, period, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStatementArchiveRepositoryInterface)(nil).Get), accountID, periodType, year, period, format)
+}
+
+// MockWebhookRepositoryInterface is a mock of WebhookRepositoryInterface interface.
+type MockWebhookRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryInterfaceMockRecorder
+}
+
+// MockWebhookRepositoryInterfaceMockRecorder is the mock recorder for MockWebhookRepositoryInterface.
+type MockWebhookRepositoryInterfaceMockRecorder struct {
+	mock *MockWebhookRepositoryInterface
+}
+
+// NewMockWebhookRepositoryInterface creates a new mock instance.
+func NewMockWebhookRepositoryInterface(ctrl *gomock.Controller) *MockWebhookRepositoryInterface {
+	mock := &MockWebhookRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepositoryInterface) EXPECT() *MockWebhookRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ClaimDueDeliveries mocks base method.
+func (m *MockWebhookRepositoryInterface) ClaimDueDeliveries(limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueDeliveries", limit, lease)
+	ret0, _ := ret[0].([]*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueDeliveries indicates an expected call of ClaimDueDeliveries.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) ClaimDueDeliveries(limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueDeliveries", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).ClaimDueDeliveries), limit, lease)
+}
+
+// CreateDeliveries mocks base method.
+func (m *MockWebhookRepositoryInterface) CreateDeliveries(deliveries []*models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeliveries", deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeliveries indicates an expected call of CreateDeliveries.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) CreateDeliveries(deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveries", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).CreateDeliveries), deliveries)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockWebhookRepositoryInterface) CreateSubscription(subscription *models.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) CreateSubscription(subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).CreateSubscription), subscription)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockWebhookRepositoryInterface) DeleteSubscription(id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) DeleteSubscription(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).DeleteSubscription), id)
+}
+
+// GetDelivery mocks base method.
+func (m *MockWebhookRepositoryInterface) GetDelivery(subscriptionID, id uuid.UUID) (*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", subscriptionID, id)
+	ret0, _ := ret[0].(*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) GetDelivery(subscriptionID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).GetDelivery), subscriptionID, id)
+}
+
+// GetSubscription mocks base method.
+func (m *MockWebhookRepositoryInterface) GetSubscription(id uuid.UUID) (*models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", id)
+	ret0, _ := ret[0].(*models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) GetSubscription(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).GetSubscription), id)
+}
+
+// ListActiveSubscriptions mocks base method.
+func (m *MockWebhookRepositoryInterface) ListActiveSubscriptions(userID uuid.UUID) ([]models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveSubscriptions", userID)
+	ret0, _ := ret[0].([]models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveSubscriptions indicates an expected call of ListActiveSubscriptions.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) ListActiveSubscriptions(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveSubscriptions", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).ListActiveSubscriptions), userID)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookRepositoryInterface) ListDeliveries(subscriptionID uuid.UUID, status string, offset, limit int) ([]models.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", subscriptionID, status, offset, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) ListDeliveries(subscriptionID, status, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).ListDeliveries), subscriptionID, status, offset, limit)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockWebhookRepositoryInterface) ListSubscriptions(userID uuid.UUID, offset, limit int) ([]models.WebhookSubscription, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", userID, offset, limit)
+	ret0, _ := ret[0].([]models.WebhookSubscription)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) ListSubscriptions(userID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).ListSubscriptions), userID, offset, limit)
+}
+
+// SaveAttempt mocks base method.
+func (m *MockWebhookRepositoryInterface) SaveAttempt(delivery *models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttempt", delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAttempt indicates an expected call of SaveAttempt.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) SaveAttempt(delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttempt", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).SaveAttempt), delivery)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockWebhookRepositoryInterface) UpdateSubscription(subscription *models.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookRepositoryInterfaceMockRecorder) UpdateSubscription(subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookRepositoryInterface)(nil).UpdateSubscription), subscription)
+}
+
+// MockMFARepositoryInterface is a mock of MFARepositoryInterface interface.
+type MockMFARepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFARepositoryInterfaceMockRecorder
+}
+
+// MockMFARepositoryInterfaceMockRecorder is the mock recorder for MockMFARepositoryInterface.
+type MockMFARepositoryInterfaceMockRecorder struct {
+	mock *MockMFARepositoryInterface
+}
+
+// NewMockMFARepositoryInterface creates a new mock instance.
+func NewMockMFARepositoryInterface(ctrl *gomock.Controller) *MockMFARepositoryInterface {
+	mock := &MockMFARepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockMFARepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFARepositoryInterface) EXPECT() *MockMFARepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AdvanceLastUsedStep mocks base method.
+func (m *MockMFARepositoryInterface) AdvanceLastUsedStep(id uuid.UUID, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceLastUsedStep", id, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceLastUsedStep indicates an expected call of AdvanceLastUsedStep.
+func (mr *MockMFARepositoryInterfaceMockRecorder) AdvanceLastUsedStep(id, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceLastUsedStep", reflect.TypeOf((*MockMFARepositoryInterface)(nil).AdvanceLastUsedStep), id, step)
+}
+
+// ConsumeRecoveryCode mocks base method.
+func (m *MockMFARepositoryInterface) ConsumeRecoveryCode(id uuid.UUID, codeHash string) (int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeRecoveryCode", id, codeHash)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConsumeRecoveryCode indicates an expected call of ConsumeRecoveryCode.
+func (mr *MockMFARepositoryInterfaceMockRecorder) ConsumeRecoveryCode(id, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeRecoveryCode", reflect.TypeOf((*MockMFARepositoryInterface)(nil).ConsumeRecoveryCode), id, codeHash)
+}
+
+// Create mocks base method.
+func (m *MockMFARepositoryInterface) Create(enrollment *models.MFAEnrollment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", enrollment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMFARepositoryInterfaceMockRecorder) Create(enrollment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMFARepositoryInterface)(nil).Create), enrollment)
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockMFARepositoryInterface) DeleteByUserID(userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockMFARepositoryInterfaceMockRecorder) DeleteByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockMFARepositoryInterface)(nil).DeleteByUserID), userID)
+}
+
+// GetByUserID mocks base method.
+func (m *MockMFARepositoryInterface) GetByUserID(userID uuid.UUID) (*models.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", userID)
+	ret0, _ := ret[0].(*models.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockMFARepositoryInterfaceMockRecorder) GetByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockMFARepositoryInterface)(nil).GetByUserID), userID)
+}
+
+// Update mocks base method.
+func (m *MockMFARepositoryInterface) Update(enrollment *models.MFAEnrollment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", enrollment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMFARepositoryInterfaceMockRecorder) Update(enrollment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMFARepositoryInterface)(nil).Update), enrollment)
+}
+
+// MockSessionRepositoryInterface is a mock of SessionRepositoryInterface interface.
+type MockSessionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepositoryInterfaceMockRecorder
+}
+
+// MockSessionRepositoryInterfaceMockRecorder is the mock recorder for MockSessionRepositoryInterface.
+type MockSessionRepositoryInterfaceMockRecorder struct {
+	mock *MockSessionRepositoryInterface
+}
+
+// NewMockSessionRepositoryInterface creates a new mock instance.
+func NewMockSessionRepositoryInterface(ctrl *gomock.Controller) *MockSessionRepositoryInterface {
+	mock := &MockSessionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockSessionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRepositoryInterface) EXPECT() *MockSessionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSessionRepositoryInterface) Create(session *models.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) Create(session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).Create), session)
+}
+
+// GetByID mocks base method.
+func (m *MockSessionRepositoryInterface) GetByID(id uuid.UUID) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).GetByID), id)
+}
+
+// ListActiveByUserID mocks base method.
+func (m *MockSessionRepositoryInterface) ListActiveByUserID(userID uuid.UUID) ([]*models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveByUserID", userID)
+	ret0, _ := ret[0].([]*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveByUserID indicates an expected call of ListActiveByUserID.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) ListActiveByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveByUserID", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).ListActiveByUserID), userID)
+}
+
+// RecordUse mocks base method.
+func (m *MockSessionRepositoryInterface) RecordUse(id uuid.UUID, ipAddress, userAgent string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUse", id, ipAddress, userAgent, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordUse indicates an expected call of RecordUse.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) RecordUse(id, ipAddress, userAgent, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUse", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).RecordUse), id, ipAddress, userAgent, expiresAt)
+}
+
+// Revoke mocks base method.
+func (m *MockSessionRepositoryInterface) Revoke(id uuid.UUID, reason string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", id, reason)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) Revoke(id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).Revoke), id, reason)
+}
+
+// RevokeAllForUser mocks base method.
+func (m *MockSessionRepositoryInterface) RevokeAllForUser(userID uuid.UUID, reason string) ([]*models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllForUser", userID, reason)
+	ret0, _ := ret[0].([]*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllForUser indicates an expected call of RevokeAllForUser.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) RevokeAllForUser(userID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllForUser", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).RevokeAllForUser), userID, reason)
+}
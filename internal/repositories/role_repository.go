@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"array-assessment/internal/models"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrRoleNotFound = errors.New("role not found")
+)
+
+// roleRepository implements RoleRepositoryInterface
+type roleRepository struct {
+	db *gorm.DB
+}
+
+// NewRoleRepository creates a new role repository
+func NewRoleRepository(db *gorm.DB) RoleRepositoryInterface {
+	return &roleRepository{
+		db: db,
+	}
+}
+
+// List retrieves every role with its permissions
+func (r *roleRepository) List() ([]*models.Role, error) {
+	var roles []*models.Role
+	err := r.db.Preload("Permissions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("permissions.name")
+	}).Order("name").Find(&roles).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	return roles, nil
+}
+
+// GetByName retrieves a role with its permissions
+func (r *roleRepository) GetByName(name string) (*models.Role, error) {
+	var role models.Role
+	err := r.db.Preload("Permissions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("permissions.name")
+	}).First(&role, "name = ?", name).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+	return &role, nil
+}
+
+// GetPermissions lists the names of the permissions a role grants. A role with
+// no permissions, or one that does not exist, grants none.
+func (r *roleRepository) GetPermissions(role string) ([]string, error) {
+	var permissions []string
+	err := r.db.Table("role_permissions").
+		Where("role_name = ?", role).
+		Order("permission_name").
+		Pluck("permission_name", &permissions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+	return permissions, nil
+}
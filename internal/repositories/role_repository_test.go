@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"testing"
+
+	"array-assessment/internal/models"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// RoleRepositoryTestSuite is the test suite for the role repository
+type RoleRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo RoleRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *RoleRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.Role{}, &models.Permission{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewRoleRepository(db)
+
+	s.seedRole(models.RoleCustomer)
+	s.seedRole(models.RoleSupport, models.PermissionCustomersResetPassword, models.PermissionCustomersRead)
+	s.seedRole(models.RoleCompliance, models.PermissionCustomersRead, models.PermissionAuditRead, models.PermissionHoldsManage)
+}
+
+// TearDownTest runs after each test
+func (s *RoleRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestRoleRepositoryTestSuite runs the test suite
+func TestRoleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RoleRepositoryTestSuite))
+}
+
+// Helper function to create a role granting the given permissions
+func (s *RoleRepositoryTestSuite) seedRole(name string, permissions ...string) {
+	role := &models.Role{Name: name}
+	for _, permission := range permissions {
+		role.Permissions = append(role.Permissions, models.Permission{Name: permission})
+	}
+	require.NoError(s.T(), s.db.Create(role).Error)
+}
+
+func (s *RoleRepositoryTestSuite) TestGetPermissions() {
+	permissions, err := s.repo.GetPermissions(models.RoleSupport)
+
+	s.Require().NoError(err)
+	s.Equal([]string{models.PermissionCustomersRead, models.PermissionCustomersResetPassword}, permissions)
+}
+
+func (s *RoleRepositoryTestSuite) TestGetPermissions_RoleWithoutPermissions() {
+	permissions, err := s.repo.GetPermissions(models.RoleCustomer)
+
+	s.Require().NoError(err)
+	s.Empty(permissions)
+}
+
+func (s *RoleRepositoryTestSuite) TestGetByName() {
+	role, err := s.repo.GetByName(models.RoleCompliance)
+
+	s.Require().NoError(err)
+	s.Require().Len(role.Permissions, 3)
+	s.Equal(models.PermissionAuditRead, role.Permissions[0].Name)
+}
+
+func (s *RoleRepositoryTestSuite) TestGetByName_NotFound() {
+	_, err := s.repo.GetByName("auditor")
+
+	s.ErrorIs(err, ErrRoleNotFound)
+}
+
+func (s *RoleRepositoryTestSuite) TestList() {
+	roles, err := s.repo.List()
+
+	s.Require().NoError(err)
+	s.Require().Len(roles, 3)
+	s.Equal(models.RoleCompliance, roles[0].Name)
+	s.Equal(models.RoleSupport, roles[2].Name)
+	s.Len(roles[2].Permissions, 2)
+}
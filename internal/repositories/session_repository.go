@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+// sessionRepository implements SessionRepositoryInterface
+type sessionRepository struct {
+	db *gorm.DB
+}
+
+// NewSessionRepository creates a new session repository
+func NewSessionRepository(db *gorm.DB) SessionRepositoryInterface {
+	return &sessionRepository{
+		db: db,
+	}
+}
+
+// Create stores a new session
+func (r *sessionRepository) Create(session *models.Session) error {
+	if err := r.db.Create(session).Error; err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a session by its ID
+func (r *sessionRepository) GetByID(id uuid.UUID) (*models.Session, error) {
+	var session models.Session
+	if err := r.db.First(&session, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return &session, nil
+}
+
+// ListActiveByUserID lists the user's unrevoked, unexpired sessions, most recently used first
+func (r *sessionRepository) ListActiveByUserID(userID uuid.UUID) ([]*models.Session, error) {
+	var sessions []*models.Session
+	err := r.db.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// RecordUse records a token refresh: where it came from and the new expiry
+func (r *sessionRepository) RecordUse(id uuid.UUID, ipAddress, userAgent string, expiresAt time.Time) error {
+	result := r.db.Model(&models.Session{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"ip_address":   ipAddress,
+			"user_agent":   userAgent,
+			"expires_at":   expiresAt,
+			"last_used_at": time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update session: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+// Revoke revokes a session and its refresh tokens. It returns false if the
+// session was already revoked.
+func (r *sessionRepository) Revoke(id uuid.UUID, reason string) (bool, error) {
+	var revoked bool
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		result := tx.Model(&models.Session{}).
+			Where("id = ? AND revoked_at IS NULL", id).
+			Updates(map[string]interface{}{"revoked_at": now, "revoked_reason": reason})
+		if result.Error != nil {
+			return fmt.Errorf("failed to revoke session: %w", result.Error)
+		}
+		revoked = result.RowsAffected == 1
+		if !revoked {
+			return nil
+		}
+
+		if err := tx.Model(&models.RefreshToken{}).
+			Where("session_id = ? AND revoked_at IS NULL", id).
+			Update("revoked_at", now).Error; err != nil {
+			return fmt.Errorf("failed to revoke session tokens: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+// RevokeAllForUser revokes every active session of the user and all their
+// refresh tokens, including any issued before sessions existed. It returns the
+// sessions it revoked.
+func (r *sessionRepository) RevokeAllForUser(userID uuid.UUID, reason string) ([]*models.Session, error) {
+	var sessions []*models.Session
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		if err := tx.Where("user_id = ? AND revoked_at IS NULL", userID).Find(&sessions).Error; err != nil {
+			return fmt.Errorf("failed to list sessions: %w", err)
+		}
+
+		if len(sessions) > 0 {
+			ids := make([]uuid.UUID, len(sessions))
+			for i, session := range sessions {
+				ids[i] = session.ID
+			}
+			if err := tx.Model(&models.Session{}).
+				Where("id IN ? AND revoked_at IS NULL", ids).
+				Updates(map[string]interface{}{"revoked_at": now, "revoked_reason": reason}).Error; err != nil {
+				return fmt.Errorf("failed to revoke sessions: %w", err)
+			}
+		}
+
+		if err := tx.Model(&models.RefreshToken{}).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Update("revoked_at", now).Error; err != nil {
+			return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// SessionRepositoryTestSuite is the test suite for the session repository
+type SessionRepositoryTestSuite struct {
+	suite.Suite
+	db        *gorm.DB
+	repo      SessionRepositoryInterface
+	tokenRepo RefreshTokenRepositoryInterface
+	userID    uuid.UUID
+}
+
+// SetupTest runs before each test
+func (s *SessionRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.User{}, &models.Session{}, &models.RefreshToken{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewSessionRepository(db)
+	s.tokenRepo = NewRefreshTokenRepository(db)
+	s.userID = uuid.New()
+}
+
+// TearDownTest runs after each test
+func (s *SessionRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestSessionRepositoryTestSuite runs the test suite
+func TestSessionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(SessionRepositoryTestSuite))
+}
+
+// Helper function to create a session with one refresh token
+func (s *SessionRepositoryTestSuite) createSession(deviceName string) (*models.Session, *models.RefreshToken) {
+	session := &models.Session{
+		UserID:     s.userID,
+		DeviceName: deviceName,
+		ExpiresAt:  time.Now().Add(7 * 24 * time.Hour),
+	}
+	s.Require().NoError(s.repo.Create(session))
+
+	token := &models.RefreshToken{
+		UserID:    s.userID,
+		SessionID: &session.ID,
+		TokenHash: uuid.NewString(),
+		ExpiresAt: session.ExpiresAt,
+	}
+	s.Require().NoError(s.tokenRepo.Create(token))
+
+	return session, token
+}
+
+func (s *SessionRepositoryTestSuite) TestListActiveByUserID_MostRecentFirst() {
+	older, _ := s.createSession("Laptop")
+	newer, _ := s.createSession("Phone")
+	s.Require().NoError(s.repo.RecordUse(newer.ID, "10.0.0.2", "Phone/1.0", time.Now().Add(7*24*time.Hour)))
+
+	revoked, _ := s.createSession("Old tablet")
+	_, err := s.repo.Revoke(revoked.ID, models.SessionRevokedByUser)
+	s.Require().NoError(err)
+
+	sessions, err := s.repo.ListActiveByUserID(s.userID)
+	s.Require().NoError(err)
+	s.Require().Len(sessions, 2)
+	s.Equal(newer.ID, sessions[0].ID)
+	s.Equal("10.0.0.2", sessions[0].IPAddress)
+	s.Equal(older.ID, sessions[1].ID)
+}
+
+func (s *SessionRepositoryTestSuite) TestRevoke_RevokesTokenFamily() {
+	session, token := s.createSession("Laptop")
+
+	revoked, err := s.repo.Revoke(session.ID, models.SessionRevokedRefreshTokenReuse)
+	s.Require().NoError(err)
+	s.True(revoked)
+
+	found, err := s.repo.GetByID(session.ID)
+	s.Require().NoError(err)
+	s.False(found.IsActive())
+	s.Equal(models.SessionRevokedRefreshTokenReuse, *found.RevokedReason)
+
+	storedToken, err := s.tokenRepo.GetByID(token.ID)
+	s.Require().NoError(err)
+	s.True(storedToken.IsRevoked())
+
+	revoked, err = s.repo.Revoke(session.ID, models.SessionRevokedByUser)
+	s.Require().NoError(err)
+	s.False(revoked, "an already revoked session is left as it was")
+
+	s.ErrorIs(s.repo.RecordUse(session.ID, "", "", time.Now()), ErrSessionNotFound)
+}
+
+func (s *SessionRepositoryTestSuite) TestRevokeAllForUser() {
+	first, firstToken := s.createSession("Laptop")
+	second, _ := s.createSession("Phone")
+
+	// A token issued before sessions existed
+	legacyToken := &models.RefreshToken{UserID: s.userID, TokenHash: uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour)}
+	s.Require().NoError(s.tokenRepo.Create(legacyToken))
+
+	revoked, err := s.repo.RevokeAllForUser(s.userID, models.SessionRevokedSignOutAll)
+	s.Require().NoError(err)
+	s.ElementsMatch([]uuid.UUID{first.ID, second.ID}, []uuid.UUID{revoked[0].ID, revoked[1].ID})
+
+	sessions, err := s.repo.ListActiveByUserID(s.userID)
+	s.Require().NoError(err)
+	s.Empty(sessions)
+
+	for _, id := range []uuid.UUID{firstToken.ID, legacyToken.ID} {
+		token, err := s.tokenRepo.GetByID(id)
+		s.Require().NoError(err)
+		s.True(token.IsRevoked())
+	}
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrArchivedStatementNotFound = errors.New("archived statement not found")
+)
+
+// statementArchiveRepository implements StatementArchiveRepositoryInterface
+type statementArchiveRepository struct {
+	db *gorm.DB
+}
+
+// NewStatementArchiveRepository creates a new statement archive repository
+func NewStatementArchiveRepository(db *gorm.DB) StatementArchiveRepositoryInterface {
+	return &statementArchiveRepository{
+		db: db,
+	}
+}
+
+// Get retrieves the archived document for an account's statement period in a format
+func (r *statementArchiveRepository) Get(accountID uuid.UUID, periodType string, year, period int, format string) (*models.ArchivedStatement, error) {
+	var statement models.ArchivedStatement
+	if err := r.db.Where("account_id = ? AND period_type = ? AND year = ? AND period = ? AND format = ?",
+		accountID, periodType, year, period, format).
+		First(&statement).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrArchivedStatementNotFound
+		}
+		return nil, fmt.Errorf("failed to get archived statement: %w", err)
+	}
+	return &statement, nil
+}
+
+// Create archives a rendered statement unless the period is already archived in
+// that format. It reports whether the document was stored; when it was not, the
+// existing document should be used instead.
+func (r *statementArchiveRepository) Create(statement *models.ArchivedStatement) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "account_id"}, {Name: "period_type"}, {Name: "year"}, {Name: "period"}, {Name: "format"},
+		},
+		DoNothing: true,
+	}).Create(statement)
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to archive statement: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
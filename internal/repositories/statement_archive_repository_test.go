@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// StatementArchiveRepositoryTestSuite is the test suite for the statement archive repository
+type StatementArchiveRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo StatementArchiveRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *StatementArchiveRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.ArchivedStatement{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewStatementArchiveRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *StatementArchiveRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestStatementArchiveRepositoryTestSuite runs the test suite
+func TestStatementArchiveRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(StatementArchiveRepositoryTestSuite))
+}
+
+func (s *StatementArchiveRepositoryTestSuite) newStatement(accountID uuid.UUID, format string, content string) *models.ArchivedStatement {
+	return models.NewArchivedStatement(&models.AccountStatement{
+		AccountID:   accountID,
+		PeriodType:  "monthly",
+		Year:        2025,
+		Period:      9,
+		GeneratedAt: time.Date(2025, 10, 1, 8, 0, 0, 0, time.UTC),
+	}, format, []byte(content))
+}
+
+func (s *StatementArchiveRepositoryTestSuite) TestCreateAndGet() {
+	accountID := uuid.New()
+	statement := s.newStatement(accountID, models.StatementFormatCSV, "Date,Amount\n")
+
+	stored, err := s.repo.Create(statement)
+	s.Require().NoError(err)
+	s.True(stored)
+
+	found, err := s.repo.Get(accountID, "monthly", 2025, 9, models.StatementFormatCSV)
+	s.Require().NoError(err)
+	s.Equal(statement.ID, found.ID)
+	s.Equal([]byte("Date,Amount\n"), found.Content)
+	s.Equal(statement.ContentHash, found.ContentHash)
+
+	_, err = s.repo.Get(accountID, "monthly", 2025, 9, models.StatementFormatPDF)
+	s.ErrorIs(err, ErrArchivedStatementNotFound)
+
+	_, err = s.repo.Get(accountID, "quarterly", 2025, 3, models.StatementFormatCSV)
+	s.ErrorIs(err, ErrArchivedStatementNotFound)
+}
+
+func (s *StatementArchiveRepositoryTestSuite) TestCreate_KeepsFirstDocument() {
+	accountID := uuid.New()
+	first := s.newStatement(accountID, models.StatementFormatPDF, "%PDF-1.4 first")
+	second := s.newStatement(accountID, models.StatementFormatPDF, "%PDF-1.4 second")
+
+	stored, err := s.repo.Create(first)
+	s.Require().NoError(err)
+	s.True(stored)
+
+	stored, err = s.repo.Create(second)
+	s.Require().NoError(err)
+	s.False(stored)
+
+	found, err := s.repo.Get(accountID, "monthly", 2025, 9, models.StatementFormatPDF)
+	s.Require().NoError(err)
+	s.Equal(first.ContentHash, found.ContentHash)
+	s.Equal([]byte("%PDF-1.4 first"), found.Content)
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCategoryNotFound   = errors.New("category not found")
+	ErrCategoryCodeExists = errors.New("category with code already exists")
+)
+
+// transactionCategoryRepository implements TransactionCategoryRepositoryInterface
+type transactionCategoryRepository struct {
+	db *gorm.DB
+}
+
+// NewTransactionCategoryRepository creates a new transaction category repository
+func NewTransactionCategoryRepository(db *gorm.DB) TransactionCategoryRepositoryInterface {
+	return &transactionCategoryRepository{
+		db: db,
+	}
+}
+
+// GetActive retrieves all active categories ordered for display
+func (r *transactionCategoryRepository) GetActive() ([]models.TransactionCategory, error) {
+	var categories []models.TransactionCategory
+
+	err := r.db.Where("is_active = ?", true).
+		Order("display_order ASC, code ASC").
+		Find(&categories).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// GetByCode retrieves a category by its code
+func (r *transactionCategoryRepository) GetByCode(code string) (*models.TransactionCategory, error) {
+	var category models.TransactionCategory
+
+	if err := r.db.Where("code = ?", code).First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	return &category, nil
+}
+
+// GetAll retrieves all categories, including inactive ones, ordered for display
+func (r *transactionCategoryRepository) GetAll() ([]models.TransactionCategory, error) {
+	var categories []models.TransactionCategory
+
+	if err := r.db.Order("display_order ASC, code ASC").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// Create creates a new category
+func (r *transactionCategoryRepository) Create(category *models.TransactionCategory) error {
+	if category == nil {
+		return errors.New("category cannot be nil")
+	}
+
+	if err := r.db.Create(category).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) || isDuplicateKeyError(err) {
+			return ErrCategoryCodeExists
+		}
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return nil
+}
+
+// Update updates an existing category
+func (r *transactionCategoryRepository) Update(category *models.TransactionCategory) error {
+	if category == nil {
+		return errors.New("category cannot be nil")
+	}
+
+	if err := r.db.Save(category).Error; err != nil {
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return nil
+}
+
+// UpdatePositions moves categories in the hierarchy and display order atomically
+func (r *transactionCategoryRepository) UpdatePositions(positions []models.CategoryPosition) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, position := range positions {
+			result := tx.Model(&models.TransactionCategory{}).
+				Where("code = ?", position.Code).
+				UpdateColumns(map[string]interface{}{
+					"parent_category_code": position.ParentCategoryCode,
+					"display_order":        position.DisplayOrder,
+					"updated_at":           time.Now(),
+				})
+
+			if result.Error != nil {
+				return fmt.Errorf("failed to update category position: %w", result.Error)
+			}
+
+			if result.RowsAffected == 0 {
+				return ErrCategoryNotFound
+			}
+		}
+
+		return nil
+	})
+}
@@ -3,16 +3,21 @@ package repositories
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"array-assessment/internal/models"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
 var (
-	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrTransactionNotFound   = errors.New("transaction not found")
+	ErrDuplicateReference    = errors.New("transaction reference already exists")
+	ErrTransactionNotPending = errors.New("transaction is no longer pending")
 )
 
 // transactionRepository implements TransactionRepository interface
@@ -103,6 +108,21 @@ func (r *transactionRepository) GetByDateRange(accountID uuid.UUID, startDate, e
 	return transactions, nil
 }
 
+// GetBalanceAsOf returns an account's balance after its last completed transaction
+// before the given time, or ErrTransactionNotFound if it had none
+func (r *transactionRepository) GetBalanceAsOf(accountID uuid.UUID, at time.Time) (decimal.Decimal, error) {
+	var transaction models.Transaction
+	if err := r.db.Where("account_id = ? AND status = ? AND created_at < ?", accountID, models.TransactionStatusCompleted, at).
+		Order("created_at DESC").
+		First(&transaction).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return decimal.Zero, ErrTransactionNotFound
+		}
+		return decimal.Zero, fmt.Errorf("failed to get balance as of %s: %w", at.Format(time.RFC3339), err)
+	}
+	return transaction.BalanceAfter, nil
+}
+
 // CreateBatch creates multiple transactions in a single database transaction
 func (r *transactionRepository) CreateBatch(transactions []models.Transaction) error {
 	if len(transactions) == 0 {
@@ -117,6 +137,87 @@ func (r *transactionRepository) CreateBatch(transactions []models.Transaction) e
 	})
 }
 
+// GetExistingReferences returns which of the given references are already used by
+// a transaction
+func (r *transactionRepository) GetExistingReferences(references []string) ([]string, error) {
+	if len(references) == 0 {
+		return nil, nil
+	}
+
+	var existing []string
+	if err := r.db.Model(&models.Transaction{}).
+		Where("reference IN ?", references).
+		Distinct().
+		Pluck("reference", &existing).Error; err != nil {
+		return nil, fmt.Errorf("failed to get existing references: %w", err)
+	}
+	return existing, nil
+}
+
+// ImportForAccount posts imported transactions to an account in order under a row
+// lock, keeping the account balance and each transaction's running balance in step.
+// A transaction that cannot be posted, because its reference is already taken or
+// the account cannot take it, gets its error in the matching slot of the returned
+// slice. With allOrNothing the first such error rolls back every transaction for
+// the account; otherwise the others are still posted.
+func (r *transactionRepository) ImportForAccount(accountID uuid.UUID, transactions []*models.Transaction, allOrNothing bool) ([]error, error) {
+	rowErrs := make([]error, len(transactions))
+	errRolledBack := errors.New("import rolled back")
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		account, err := lockAccount(tx, accountID)
+		if err != nil {
+			return err
+		}
+
+		references := make([]string, 0, len(transactions))
+		for _, transaction := range transactions {
+			references = append(references, transaction.Reference)
+		}
+		var existing []string
+		if err := tx.Model(&models.Transaction{}).
+			Where("reference IN ?", references).
+			Pluck("reference", &existing).Error; err != nil {
+			return fmt.Errorf("failed to get existing references: %w", err)
+		}
+		taken := make(map[string]bool, len(existing))
+		for _, reference := range existing {
+			taken[reference] = true
+		}
+
+		for i, transaction := range transactions {
+			if taken[transaction.Reference] {
+				rowErrs[i] = ErrDuplicateReference
+			} else if err := account.Post(transaction); err != nil {
+				rowErrs[i] = err
+			}
+
+			if rowErrs[i] != nil {
+				if allOrNothing {
+					return errRolledBack
+				}
+				continue
+			}
+
+			transaction.AccountID = accountID
+			if err := tx.Create(transaction).Error; err != nil {
+				return fmt.Errorf("failed to create imported transaction: %w", err)
+			}
+			taken[transaction.Reference] = true
+		}
+
+		if err := tx.Model(account).Update("balance", account.Balance).Error; err != nil {
+			return fmt.Errorf("failed to update account balance: %w", err)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errRolledBack) {
+		return nil, err
+	}
+
+	return rowErrs, nil
+}
+
 // GetPendingTransactions retrieves all pending transactions
 func (r *transactionRepository) GetPendingTransactions(offset, limit int) ([]models.Transaction, error) {
 	var transactions []models.Transaction
@@ -156,7 +257,7 @@ func (r *transactionRepository) GetTotalsByAccountID(accountID uuid.UUID) (credi
 	}
 	if err := r.db.Model(&models.Transaction{}).
 		Select("COUNT(*) as count, COALESCE(SUM(amount), 0) as amount").
-		Where("account_id = ? AND transaction_type = ? AND status = ?",
+		Where("account_id = ? AND transaction_type = ? AND status = ? AND reversal_of IS NULL",
 			accountID, models.TransactionTypeCredit, models.TransactionStatusCompleted).
 		Scan(&creditResult).Error; err != nil {
 		return 0, 0, "", "", fmt.Errorf("failed to get credit totals: %w", err)
@@ -168,7 +269,7 @@ func (r *transactionRepository) GetTotalsByAccountID(accountID uuid.UUID) (credi
 	}
 	if err := r.db.Model(&models.Transaction{}).
 		Select("COUNT(*) as count, COALESCE(SUM(amount), 0) as amount").
-		Where("account_id = ? AND transaction_type = ? AND status = ?",
+		Where("account_id = ? AND transaction_type = ? AND status = ? AND reversal_of IS NULL",
 			accountID, models.TransactionTypeDebit, models.TransactionStatusCompleted).
 		Scan(&debitResult).Error; err != nil {
 		return 0, 0, "", "", fmt.Errorf("failed to get debit totals: %w", err)
@@ -203,8 +304,60 @@ func (r *transactionRepository) GetWithFilters(filters models.TransactionFilters
 	var transactions []models.Transaction
 	var total int64
 
-	query := r.db.Model(&models.Transaction{})
+	query := applyTransactionFilters(r.db.Model(&models.Transaction{}), filters)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count filtered transactions: %w", err)
+	}
+
+	if err := query.Offset(filters.Offset).Limit(filters.Limit).
+		Order("created_at DESC").
+		Find(&transactions).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get filtered transactions: %w", err)
+	}
+
+	return transactions, total, nil
+}
+
+// StreamWithFilters calls fn with each transaction matching filters, oldest first.
+// Transactions are read in (created_at, id) keyset batches of batchSize, so memory
+// use does not grow with the number of matches. Offset and Limit are ignored; an
+// error returned by fn stops the stream and is returned.
+func (r *transactionRepository) StreamWithFilters(filters models.TransactionFilters, batchSize int, fn func(transaction *models.Transaction) error) error {
+	var cursorCreatedAt time.Time
+	var cursorID uuid.UUID
+
+	for {
+		var batch []models.Transaction
+
+		query := applyTransactionFilters(r.db.Model(&models.Transaction{}), filters)
+		if cursorID != uuid.Nil {
+			query = query.Where("(created_at > ? OR (created_at = ? AND id > ?))", cursorCreatedAt, cursorCreatedAt, cursorID)
+		}
+
+		if err := query.Order("created_at ASC, id ASC").
+			Limit(batchSize).
+			Find(&batch).Error; err != nil {
+			return fmt.Errorf("failed to stream filtered transactions: %w", err)
+		}
+
+		for i := range batch {
+			if err := fn(&batch[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < batchSize {
+			return nil
+		}
+
+		last := &batch[len(batch)-1]
+		cursorCreatedAt, cursorID = last.CreatedAt, last.ID
+	}
+}
 
+// applyTransactionFilters narrows a transaction query to the set filters
+func applyTransactionFilters(query *gorm.DB, filters models.TransactionFilters) *gorm.DB {
 	if filters.AccountID != uuid.Nil {
 		query = query.Where("account_id = ?", filters.AccountID)
 	}
@@ -232,23 +385,15 @@ func (r *transactionRepository) GetWithFilters(filters models.TransactionFilters
 	if filters.MerchantName != "" {
 		query = query.Where("merchant_name ILIKE ?", "%"+filters.MerchantName+"%")
 	}
-
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to count filtered transactions: %w", err)
-	}
-
-	if err := query.Offset(filters.Offset).Limit(filters.Limit).
-		Order("created_at DESC").
-		Find(&transactions).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to get filtered transactions: %w", err)
-	}
-
-	return transactions, total, nil
+	return query
 }
 
 // UpdateWithOptimisticLock updates a transaction with optimistic locking
 func (r *transactionRepository) UpdateWithOptimisticLock(transaction *models.Transaction, expectedVersion int) error {
-	result := r.db.Model(&models.Transaction{ID: transaction.ID}).
+	// The BeforeUpdate hook validates the transaction and stores expectedVersion+1
+	transaction.Version = expectedVersion
+
+	result := r.db.Model(transaction).
 		Where("version = ?", expectedVersion).
 		Updates(transaction)
 
@@ -262,6 +407,132 @@ func (r *transactionRepository) UpdateWithOptimisticLock(transaction *models.Tra
 	return nil
 }
 
+// CompletePending applies a pending transaction to its account and marks it completed
+// in one database transaction. The status change only matches a row that is still
+// pending, so a transaction completed by another worker returns
+// ErrTransactionNotPending and the balance is left untouched.
+func (r *transactionRepository) CompletePending(transaction *models.Transaction) (*models.Account, error) {
+	var account *models.Account
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		locked, err := lockAccount(tx, transaction.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if !locked.IsActive() {
+			return ErrAccountNotActive
+		}
+
+		balanceBefore := locked.Balance
+		var balanceAfter decimal.Decimal
+		switch transaction.TransactionType {
+		case models.TransactionTypeDebit:
+			// Funds reserved by authorization holds cannot be spent
+			if !locked.HasAvailableFunds(transaction.GetTotalAmount()) {
+				return ErrInsufficientFunds
+			}
+			balanceAfter = balanceBefore.Sub(transaction.GetTotalAmount())
+		case models.TransactionTypeCredit:
+			balanceAfter = balanceBefore.Add(transaction.Amount)
+		default:
+			return fmt.Errorf("invalid transaction type: %s", transaction.TransactionType)
+		}
+
+		transaction.BalanceBefore = balanceBefore
+		transaction.BalanceAfter = balanceAfter
+		transaction.Complete()
+		result := tx.Model(transaction).
+			Where("status = ?", models.TransactionStatusPending).
+			Updates(transaction)
+		if result.Error != nil {
+			return fmt.Errorf("failed to complete transaction: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrTransactionNotPending
+		}
+
+		if err := tx.Model(locked).Update("balance", balanceAfter).Error; err != nil {
+			return fmt.Errorf("failed to update account balance: %w", err)
+		}
+
+		account = locked
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// GetRecategorizationBatch retrieves the next page of transactions in a
+// recategorization job's scope, in (created_at, id) order after its checkpoint
+func (r *transactionRepository) GetRecategorizationBatch(job *models.RecategorizationJob) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	query := r.db.Model(&models.Transaction{})
+
+	if job.AccountID != nil {
+		query = query.Where("account_id = ?", *job.AccountID)
+	}
+	if job.StartDate != nil {
+		query = query.Where("created_at >= ?", *job.StartDate)
+	}
+	if job.EndDate != nil {
+		query = query.Where("created_at < ?", *job.EndDate)
+	}
+	if job.CursorCreatedAt != nil && job.CursorTransactionID != nil {
+		query = query.Where("(created_at > ? OR (created_at = ? AND id > ?))",
+			*job.CursorCreatedAt, *job.CursorCreatedAt, *job.CursorTransactionID)
+	}
+
+	if err := query.Order("created_at ASC, id ASC").
+		Limit(job.BatchSize).
+		Find(&transactions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get recategorization batch: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// ApplyCategoryChanges updates the category of several transactions in a single
+// database transaction. A change is skipped when the transaction's version moved
+// on or it was manually overridden since it was read; the IDs of skipped
+// transactions are returned.
+func (r *transactionRepository) ApplyCategoryChanges(changes []models.TransactionCategoryChange) ([]uuid.UUID, error) {
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	var conflicts []uuid.UUID
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for _, change := range changes {
+			// UpdateColumns skips the BeforeUpdate hook; the version is bumped here instead
+			result := tx.Model(&models.Transaction{}).
+				Where("id = ? AND version = ? AND category_overridden_at IS NULL", change.TransactionID, change.ExpectedVersion).
+				UpdateColumns(map[string]interface{}{
+					"category":   change.Category,
+					"version":    gorm.Expr("version + 1"),
+					"updated_at": now,
+				})
+			if result.Error != nil {
+				return fmt.Errorf("failed to update transaction category: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				conflicts = append(conflicts, change.TransactionID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return conflicts, nil
+}
+
 // GetExpiredPendingTransactions retrieves pending transactions that have expired
 func (r *transactionRepository) GetExpiredPendingTransactions(limit int) ([]models.Transaction, error) {
 	var transactions []models.Transaction
@@ -292,6 +563,7 @@ func (r *transactionRepository) GetCategorySummary(accountID uuid.UUID, startDat
 		WHERE account_id = ?
 			AND created_at BETWEEN ? AND ?
 			AND status = ?
+			AND reversal_of IS NULL
 			AND category IS NOT NULL
 		GROUP BY category
 		ORDER BY total_amount DESC
@@ -304,3 +576,133 @@ func (r *transactionRepository) GetCategorySummary(accountID uuid.UUID, startDat
 
 	return summaries, nil
 }
+
+// Reverse offsets a completed transaction with a linked transaction of the opposite
+// type and marks the original as reversed. When the transaction is a leg of a
+// transfer, both legs are reversed together and the transfer is marked reversed.
+// It returns the offsetting transactions.
+func (r *transactionRepository) Reverse(transactionID uuid.UUID, reason string) ([]*models.Transaction, error) {
+	var offsets []*models.Transaction
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		legs, transfer, err := loadReversalLegs(tx, transactionID)
+		if err != nil {
+			return err
+		}
+
+		// Lock accounts in a stable order so that concurrent reversals cannot deadlock
+		accountIDs := make([]uuid.UUID, 0, len(legs))
+		for i := range legs {
+			accountIDs = append(accountIDs, legs[i].AccountID)
+		}
+		slices.SortFunc(accountIDs, func(a, b uuid.UUID) int {
+			return strings.Compare(a.String(), b.String())
+		})
+
+		accounts := make(map[uuid.UUID]*models.Account, len(accountIDs))
+		for _, id := range accountIDs {
+			account, err := lockAccount(tx, id)
+			if err != nil {
+				return err
+			}
+			accounts[id] = account
+		}
+
+		for i := range legs {
+			leg := legs[i]
+			account := accounts[leg.AccountID]
+
+			// Reversals correct the ledger, so they apply to frozen accounts too, but
+			// they may not spend funds that are already gone or reserved by holds
+			if leg.OffsettingType() == models.TransactionTypeDebit && !account.HasAvailableFunds(leg.Amount) {
+				return ErrInsufficientFunds
+			}
+
+			offset := leg.NewReversal(account.Balance, reason)
+			if err := tx.Create(offset).Error; err != nil {
+				return fmt.Errorf("failed to create reversal transaction: %w", err)
+			}
+
+			if err := tx.Model(account).Update("balance", offset.BalanceAfter).Error; err != nil {
+				return fmt.Errorf("failed to update account balance: %w", err)
+			}
+
+			expectedVersion := leg.Version
+			leg.ReverseWithReference(offset.Reference)
+			result := tx.Model(leg).
+				Where("status = ? AND version = ?", models.TransactionStatusCompleted, expectedVersion).
+				Updates(leg)
+			if result.Error != nil {
+				return fmt.Errorf("failed to mark transaction reversed: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return models.ErrOptimisticLockConflict
+			}
+
+			offsets = append(offsets, offset)
+		}
+
+		if transfer != nil {
+			transfer.Reverse()
+			if err := tx.Save(transfer).Error; err != nil {
+				return fmt.Errorf("failed to mark transfer reversed: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offsets, nil
+}
+
+// loadReversalLegs loads the transaction to reverse and, when it belongs to a
+// transfer, the transfer and its other leg. Every leg must be reversible.
+func loadReversalLegs(tx *gorm.DB, transactionID uuid.UUID) ([]*models.Transaction, *models.Transfer, error) {
+	var transaction models.Transaction
+	if err := tx.First(&transaction, "id = ?", transactionID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrTransactionNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	var transfer models.Transfer
+	err := tx.Where("debit_transaction_id = ? OR credit_transaction_id = ?", transactionID, transactionID).
+		First(&transfer).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if err := transaction.CheckReversible(); err != nil {
+			return nil, nil, err
+		}
+		return []*models.Transaction{&transaction}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get transfer: %w", err)
+	}
+
+	if transfer.Status == models.TransferStatusReversed {
+		return nil, nil, models.ErrTransactionAlreadyReversed
+	}
+	if !transfer.IsCompleted() || transfer.DebitTransactionID == nil || transfer.CreditTransactionID == nil {
+		return nil, nil, models.ErrTransactionNotReversible
+	}
+
+	var legs []*models.Transaction
+	if err := tx.Where("id IN ?", []uuid.UUID{*transfer.DebitTransactionID, *transfer.CreditTransactionID}).
+		Find(&legs).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to get transfer legs: %w", err)
+	}
+	if len(legs) != 2 {
+		return nil, nil, fmt.Errorf("transfer %s is missing a leg", transfer.ID)
+	}
+
+	for i := range legs {
+		if err := legs[i].CheckReversible(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return legs, &transfer, nil
+}
@@ -0,0 +1,475 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// TransactionRepositoryTestSuite is the test suite for Transaction repository
+type TransactionRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo TransactionRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *TransactionRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.Account{}, &models.User{}, &models.Transaction{}, &models.Transfer{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewTransactionRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *TransactionRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestTransactionRepositoryTestSuite runs the test suite
+func TestTransactionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactionRepositoryTestSuite))
+}
+
+// Helper function to create a persisted test transaction
+func (s *TransactionRepositoryTestSuite) createTestTransaction() *models.Transaction {
+	transaction := &models.Transaction{
+		AccountID:       uuid.New(),
+		TransactionType: models.TransactionTypeCredit,
+		Amount:          decimal.NewFromInt(25),
+		BalanceBefore:   decimal.NewFromInt(100),
+		BalanceAfter:    decimal.NewFromInt(125),
+		Description:     "Coffee",
+		MerchantName:    "Blue Bottle",
+		Category:        models.CategoryOther,
+	}
+	require.NoError(s.T(), s.repo.Create(transaction))
+	return transaction
+}
+
+// TestUpdateWithOptimisticLock_PersistsChanges tests that the update is saved and the version bumped
+func (s *TransactionRepositoryTestSuite) TestUpdateWithOptimisticLock_PersistsChanges() {
+	transaction := s.createTestTransaction()
+	userID := uuid.New()
+
+	transaction.Category = models.CategoryDining
+	transaction.MarkCategoryOverridden(userID, time.Now())
+
+	err := s.repo.UpdateWithOptimisticLock(transaction, 1)
+	require.NoError(s.T(), err)
+
+	saved, err := s.repo.GetByID(transaction.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.CategoryDining, saved.Category)
+	assert.Equal(s.T(), 2, saved.Version)
+	assert.True(s.T(), saved.IsCategoryOverridden())
+	assert.Equal(s.T(), userID, *saved.CategoryOverriddenBy)
+}
+
+// TestUpdateWithOptimisticLock_StaleVersion tests that a stale version is rejected
+func (s *TransactionRepositoryTestSuite) TestUpdateWithOptimisticLock_StaleVersion() {
+	transaction := s.createTestTransaction()
+
+	transaction.Category = models.CategoryDining
+	require.NoError(s.T(), s.repo.UpdateWithOptimisticLock(transaction, 1))
+
+	transaction.Category = models.CategoryGroceries
+	err := s.repo.UpdateWithOptimisticLock(transaction, 1)
+	assert.ErrorIs(s.T(), err, models.ErrOptimisticLockConflict)
+
+	saved, err := s.repo.GetByID(transaction.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.CategoryDining, saved.Category)
+}
+
+// TestGetRecategorizationBatch_PagesFromCheckpoint tests keyset paging within a job's scope
+func (s *TransactionRepositoryTestSuite) TestGetRecategorizationBatch_PagesFromCheckpoint() {
+	accountID := uuid.New()
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	var created []*models.Transaction
+	for i := 0; i < 5; i++ {
+		transaction := s.createTestTransaction()
+		require.NoError(s.T(), s.db.Model(transaction).UpdateColumns(map[string]interface{}{
+			"account_id": accountID,
+			"created_at": base.Add(time.Duration(i) * time.Minute),
+		}).Error)
+		created = append(created, transaction)
+	}
+	s.createTestTransaction() // another account, out of scope
+
+	job := &models.RecategorizationJob{AccountID: &accountID, BatchSize: 2}
+
+	var seen []uuid.UUID
+	for {
+		batch, err := s.repo.GetRecategorizationBatch(job)
+		require.NoError(s.T(), err)
+		for i := range batch {
+			seen = append(seen, batch[i].ID)
+		}
+		if len(batch) < job.BatchSize {
+			break
+		}
+		job.Checkpoint(&batch[len(batch)-1])
+	}
+
+	require.Len(s.T(), seen, 5)
+	for i, transaction := range created {
+		assert.Equal(s.T(), transaction.ID, seen[i])
+	}
+}
+
+// TestApplyCategoryChanges_SkipsConflictsAndOverrides tests that stale and overridden rows are left alone
+func (s *TransactionRepositoryTestSuite) TestApplyCategoryChanges_SkipsConflictsAndOverrides() {
+	fresh := s.createTestTransaction()
+	stale := s.createTestTransaction()
+	overridden := s.createTestTransaction()
+
+	overridden.Category = models.CategoryShopping
+	overridden.MarkCategoryOverridden(uuid.New(), time.Now())
+	require.NoError(s.T(), s.repo.UpdateWithOptimisticLock(overridden, 1))
+
+	conflicts, err := s.repo.ApplyCategoryChanges([]models.TransactionCategoryChange{
+		{TransactionID: fresh.ID, ExpectedVersion: 1, Category: models.CategoryDining},
+		{TransactionID: stale.ID, ExpectedVersion: 7, Category: models.CategoryDining},
+		{TransactionID: overridden.ID, ExpectedVersion: 2, Category: models.CategoryDining},
+	})
+	require.NoError(s.T(), err)
+	assert.ElementsMatch(s.T(), []uuid.UUID{stale.ID, overridden.ID}, conflicts)
+
+	saved, err := s.repo.GetByID(fresh.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.CategoryDining, saved.Category)
+	assert.Equal(s.T(), 2, saved.Version)
+
+	saved, err = s.repo.GetByID(overridden.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.CategoryShopping, saved.Category)
+}
+
+// TestGetBalanceAsOf_UsesLastCompletedTransactionBefore tests closing balance lookup
+func (s *TransactionRepositoryTestSuite) TestGetBalanceAsOf_UsesLastCompletedTransactionBefore() {
+	accountID := uuid.New()
+	day := time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC)
+
+	for i, status := range []string{models.TransactionStatusCompleted, models.TransactionStatusCompleted, models.TransactionStatusFailed} {
+		transaction := &models.Transaction{
+			AccountID:       accountID,
+			TransactionType: models.TransactionTypeCredit,
+			Amount:          decimal.NewFromInt(10),
+			BalanceBefore:   decimal.NewFromInt(int64(100 + i*10)),
+			BalanceAfter:    decimal.NewFromInt(int64(110 + i*10)),
+			Description:     "Deposit",
+			Status:          status,
+			CreatedAt:       day.Add(time.Duration(i*6) * time.Hour),
+		}
+		require.NoError(s.T(), s.db.Create(transaction).Error)
+	}
+
+	balance, err := s.repo.GetBalanceAsOf(accountID, day.Add(8*time.Hour))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "120", balance.String())
+
+	// The failed transaction is ignored
+	balance, err = s.repo.GetBalanceAsOf(accountID, day.AddDate(0, 0, 1))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "120", balance.String())
+
+	_, err = s.repo.GetBalanceAsOf(accountID, day)
+	assert.ErrorIs(s.T(), err, ErrTransactionNotFound)
+}
+
+// Helper function to create a persisted account with a completed opening credit
+func (s *TransactionRepositoryTestSuite) createFundedAccount(balance decimal.Decimal) (*models.Account, *models.Transaction) {
+	account := &models.Account{
+		AccountNumber: gofakeit.Numerify("##########"),
+		RoutingNumber: gofakeit.Numerify("#########"),
+		UserID:        uuid.New(),
+		AccountType:   models.AccountTypeChecking,
+		Balance:       balance,
+	}
+	require.NoError(s.T(), s.db.Create(account).Error)
+
+	deposit := &models.Transaction{
+		AccountID:       account.ID,
+		TransactionType: models.TransactionTypeCredit,
+		Amount:          balance,
+		BalanceBefore:   decimal.Zero,
+		BalanceAfter:    balance,
+		Description:     "Opening deposit",
+	}
+	require.NoError(s.T(), s.repo.Create(deposit))
+	return account, deposit
+}
+
+// Helper function to read an account's ledger balance
+func (s *TransactionRepositoryTestSuite) balanceOf(accountID uuid.UUID) string {
+	var account models.Account
+	require.NoError(s.T(), s.db.First(&account, "id = ?", accountID).Error)
+	return account.Balance.StringFixed(2)
+}
+
+// TestCompletePending_AppliesOnce tests that a pending transaction moves the balance exactly once
+func (s *TransactionRepositoryTestSuite) TestCompletePending_AppliesOnce() {
+	account, _ := s.createFundedAccount(decimal.NewFromInt(100))
+
+	pending := &models.Transaction{
+		AccountID:       account.ID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromInt(40),
+		BalanceBefore:   decimal.NewFromInt(100),
+		BalanceAfter:    decimal.NewFromInt(60),
+		Description:     "Card purchase",
+		Status:          models.TransactionStatusPending,
+	}
+	require.NoError(s.T(), s.repo.Create(pending))
+	stale, err := s.repo.GetByID(pending.ID)
+	require.NoError(s.T(), err)
+
+	_, err = s.repo.CompletePending(pending)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "60.00", s.balanceOf(account.ID))
+
+	stored, err := s.repo.GetByID(pending.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TransactionStatusCompleted, stored.Status)
+	assert.Equal(s.T(), "60.00", stored.BalanceAfter.StringFixed(2))
+
+	// A second worker holding the same pending copy must not debit again
+	_, err = s.repo.CompletePending(stale)
+	assert.ErrorIs(s.T(), err, ErrTransactionNotPending)
+	assert.Equal(s.T(), "60.00", s.balanceOf(account.ID))
+}
+
+// TestReverse_WritesLinkedOffset tests that a reversal offsets the original exactly once
+func (s *TransactionRepositoryTestSuite) TestReverse_WritesLinkedOffset() {
+	account, deposit := s.createFundedAccount(decimal.NewFromInt(100))
+
+	offsets, err := s.repo.Reverse(deposit.ID, "Deposited to the wrong account")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), offsets, 1)
+
+	offset := offsets[0]
+	assert.Equal(s.T(), models.TransactionTypeDebit, offset.TransactionType)
+	assert.Equal(s.T(), deposit.ID, *offset.ReversalOf)
+	assert.Equal(s.T(), "0.00", offset.BalanceAfter.StringFixed(2))
+	assert.Equal(s.T(), "Deposited to the wrong account", offset.ReversalReason())
+	assert.Equal(s.T(), "0.00", s.balanceOf(account.ID))
+
+	original, err := s.repo.GetByID(deposit.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TransactionStatusReversed, original.Status)
+	assert.Equal(s.T(), offset.Reference, original.ReversalReference)
+	assert.NotNil(s.T(), original.ReversedAt)
+
+	// Neither the original nor its offset count toward the totals
+	credits, debits, _, _, err := s.repo.GetTotalsByAccountID(account.ID)
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), credits)
+	assert.Zero(s.T(), debits)
+
+	_, err = s.repo.Reverse(deposit.ID, "again")
+	assert.ErrorIs(s.T(), err, models.ErrTransactionAlreadyReversed)
+
+	_, err = s.repo.Reverse(offset.ID, "undo the reversal")
+	assert.ErrorIs(s.T(), err, models.ErrTransactionNotReversible)
+}
+
+// TestReverse_RejectsOverdraft tests that a credit cannot be reversed once the funds are spent
+func (s *TransactionRepositoryTestSuite) TestReverse_RejectsOverdraft() {
+	account, deposit := s.createFundedAccount(decimal.NewFromInt(100))
+	require.NoError(s.T(), s.db.Model(&models.Account{}).Where("id = ?", account.ID).
+		UpdateColumn("balance", decimal.NewFromInt(40)).Error)
+
+	_, err := s.repo.Reverse(deposit.ID, "Chargeback")
+	assert.ErrorIs(s.T(), err, ErrInsufficientFunds)
+
+	original, err := s.repo.GetByID(deposit.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TransactionStatusCompleted, original.Status)
+	assert.Equal(s.T(), "40.00", s.balanceOf(account.ID))
+}
+
+// TestReverse_TransferReversesBothLegs tests that reversing one leg reverses the whole transfer
+func (s *TransactionRepositoryTestSuite) TestReverse_TransferReversesBothLegs() {
+	from, _ := s.createFundedAccount(decimal.NewFromInt(100))
+	to, _ := s.createFundedAccount(decimal.NewFromInt(10))
+
+	debitTxID, creditTxID, err := NewAccountRepository(s.db).
+		ExecuteAtomicTransfer(from.ID, to.ID, decimal.NewFromInt(30), "Transfer out", "Transfer in", nil)
+	require.NoError(s.T(), err)
+
+	transfer := &models.Transfer{
+		FromAccountID:  from.ID,
+		ToAccountID:    to.ID,
+		Amount:         decimal.NewFromInt(30),
+		Description:    "Rent share",
+		IdempotencyKey: uuid.NewString(),
+	}
+	transfer.Complete(debitTxID, creditTxID)
+	require.NoError(s.T(), s.db.Create(transfer).Error)
+
+	offsets, err := s.repo.Reverse(creditTxID, "Sent in error")
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), offsets, 2)
+
+	assert.Equal(s.T(), "100.00", s.balanceOf(from.ID))
+	assert.Equal(s.T(), "10.00", s.balanceOf(to.ID))
+
+	var saved models.Transfer
+	require.NoError(s.T(), s.db.First(&saved, "id = ?", transfer.ID).Error)
+	assert.Equal(s.T(), models.TransferStatusReversed, saved.Status)
+	assert.NotNil(s.T(), saved.ReversedAt)
+
+	_, err = s.repo.Reverse(debitTxID, "again")
+	assert.ErrorIs(s.T(), err, models.ErrTransactionAlreadyReversed)
+}
+
+// TestStreamWithFilters_StreamsMatchesInOrder tests that every match is streamed oldest first across batches
+func (s *TransactionRepositoryTestSuite) TestStreamWithFilters_StreamsMatchesInOrder() {
+	accountID := uuid.New()
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	var credits []*models.Transaction
+	for i := 0; i < 6; i++ {
+		transaction := s.createTestTransaction()
+		updates := map[string]interface{}{
+			"account_id": accountID,
+			"created_at": base.Add(time.Duration(i/2) * time.Minute), // pairs share a timestamp
+		}
+		if i == 3 {
+			updates["transaction_type"] = models.TransactionTypeDebit
+		} else {
+			credits = append(credits, transaction)
+		}
+		require.NoError(s.T(), s.db.Model(transaction).UpdateColumns(updates).Error)
+	}
+	s.createTestTransaction() // another account
+
+	filters := models.TransactionFilters{AccountID: accountID, Type: models.TransactionTypeCredit}
+
+	var seen []*models.Transaction
+	err := s.repo.StreamWithFilters(filters, 2, func(transaction *models.Transaction) error {
+		seen = append(seen, transaction)
+		return nil
+	})
+	require.NoError(s.T(), err)
+
+	require.Len(s.T(), seen, len(credits))
+	for i := 1; i < len(seen); i++ {
+		previous, current := seen[i-1], seen[i]
+		assert.True(s.T(), previous.CreatedAt.Before(current.CreatedAt) ||
+			(previous.CreatedAt.Equal(current.CreatedAt) && previous.ID.String() < current.ID.String()))
+	}
+	for _, transaction := range seen {
+		assert.Equal(s.T(), models.TransactionTypeCredit, transaction.TransactionType)
+	}
+}
+
+// TestStreamWithFilters_StopsOnCallbackError tests that a callback error ends the stream
+func (s *TransactionRepositoryTestSuite) TestStreamWithFilters_StopsOnCallbackError() {
+	first := s.createTestTransaction()
+	for i := 0; i < 2; i++ {
+		transaction := s.createTestTransaction()
+		require.NoError(s.T(), s.db.Model(transaction).UpdateColumn("account_id", first.AccountID).Error)
+	}
+
+	stop := errors.New("client went away")
+	calls := 0
+	err := s.repo.StreamWithFilters(models.TransactionFilters{AccountID: first.AccountID}, 10, func(*models.Transaction) error {
+		calls++
+		return stop
+	})
+
+	assert.ErrorIs(s.T(), err, stop)
+	assert.Equal(s.T(), 1, calls)
+}
+
+// Helper function to build an unsaved imported transaction
+func newImportedTransaction(transactionType string, amount int64, reference string) *models.Transaction {
+	return &models.Transaction{
+		TransactionType: transactionType,
+		Amount:          decimal.NewFromInt(amount),
+		Description:     "Imported " + reference,
+		Reference:       reference,
+	}
+}
+
+// TestImportForAccount_PostsInOrder tests that imports keep running balances and the account balance in step
+func (s *TransactionRepositoryTestSuite) TestImportForAccount_PostsInOrder() {
+	account, _ := s.createFundedAccount(decimal.NewFromInt(100))
+
+	transactions := []*models.Transaction{
+		newImportedTransaction(models.TransactionTypeDebit, 150, "IMP-1"), // overdraws, skipped
+		newImportedTransaction(models.TransactionTypeCredit, 50, "IMP-2"),
+		newImportedTransaction(models.TransactionTypeDebit, 120, "IMP-3"),
+	}
+
+	rowErrs, err := s.repo.ImportForAccount(account.ID, transactions, false)
+	require.NoError(s.T(), err)
+
+	assert.ErrorIs(s.T(), rowErrs[0], models.ErrInsufficientFunds)
+	assert.NoError(s.T(), rowErrs[1])
+	assert.NoError(s.T(), rowErrs[2])
+	assert.Equal(s.T(), "30.00", s.balanceOf(account.ID))
+
+	saved, err := s.repo.GetByReference("IMP-3")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), account.ID, saved.AccountID)
+	assert.Equal(s.T(), "150.00", saved.BalanceBefore.StringFixed(2))
+	assert.Equal(s.T(), "30.00", saved.BalanceAfter.StringFixed(2))
+
+	_, err = s.repo.GetByReference("IMP-1")
+	assert.ErrorIs(s.T(), err, ErrTransactionNotFound)
+}
+
+// TestImportForAccount_AllOrNothingRollsBack tests that one failed row undoes the whole account
+func (s *TransactionRepositoryTestSuite) TestImportForAccount_AllOrNothingRollsBack() {
+	account, _ := s.createFundedAccount(decimal.NewFromInt(100))
+	existing := s.createTestTransaction()
+
+	transactions := []*models.Transaction{
+		newImportedTransaction(models.TransactionTypeCredit, 50, "IMP-1"),
+		newImportedTransaction(models.TransactionTypeCredit, 10, existing.Reference),
+	}
+
+	rowErrs, err := s.repo.ImportForAccount(account.ID, transactions, true)
+	require.NoError(s.T(), err)
+
+	assert.NoError(s.T(), rowErrs[0])
+	assert.ErrorIs(s.T(), rowErrs[1], ErrDuplicateReference)
+	assert.Equal(s.T(), "100.00", s.balanceOf(account.ID))
+
+	_, err = s.repo.GetByReference("IMP-1")
+	assert.ErrorIs(s.T(), err, ErrTransactionNotFound)
+}
+
+// TestGetExistingReferences tests that only references already in use are returned
+func (s *TransactionRepositoryTestSuite) TestGetExistingReferences() {
+	existing := s.createTestTransaction()
+
+	references, err := s.repo.GetExistingReferences([]string{existing.Reference, "NEW-REF"})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []string{existing.Reference}, references)
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"array-assessment/internal/models"
 
@@ -83,6 +84,21 @@ func (r *transferRepository) FindByIdempotencyKey(key string) (*models.Transfer,
 	return &transfer, nil
 }
 
+// FindByTransactionID retrieves the transfer that either transaction leg belongs to
+func (r *transferRepository) FindByTransactionID(transactionID uuid.UUID) (*models.Transfer, error) {
+	var transfer models.Transfer
+
+	if err := r.db.Where("debit_transaction_id = ? OR credit_transaction_id = ?", transactionID, transactionID).
+		First(&transfer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, fmt.Errorf("failed to find transfer by transaction ID: %w", err)
+	}
+
+	return &transfer, nil
+}
+
 // FindByUserAccounts retrieves transfers involving any of the user's accounts
 func (r *transferRepository) FindByUserAccounts(accountIDs []uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
 	return r.FindByUserAccountsWithFilters(accountIDs, models.TransferFilters{}, offset, limit)
@@ -112,6 +128,10 @@ func (r *transferRepository) FindByUserAccountsWithFilters(accountIDs []uuid.UUI
 		query = query.Where("to_account_id = ?", *filters.ToAccountID)
 	}
 
+	if filters.ScheduleID != nil {
+		query = query.Where("schedule_id = ?", *filters.ScheduleID)
+	}
+
 	if filters.MinAmount != nil {
 		query = query.Where("amount >= ?", *filters.MinAmount)
 	}
@@ -150,3 +170,32 @@ func (r *transferRepository) CountByUserAccounts(accountIDs []uuid.UUID) (int64,
 
 	return count, nil
 }
+
+// CountCompletedBetween counts the completed transfers from one account to another
+func (r *transferRepository) CountCompletedBetween(fromAccountID, toAccountID uuid.UUID) (int64, error) {
+	var count int64
+
+	if err := r.db.Model(&models.Transfer{}).
+		Where("from_account_id = ? AND to_account_id = ? AND status = ?",
+			fromAccountID, toAccountID, models.TransferStatusCompleted).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count transfers between accounts: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountFromAccountSince counts the transfers out of an account created since the
+// given time, excluding transfers that failed
+func (r *transferRepository) CountFromAccountSince(accountID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+
+	if err := r.db.Model(&models.Transfer{}).
+		Where("from_account_id = ? AND status <> ? AND created_at >= ?",
+			accountID, models.TransferStatusFailed, since).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count recent transfers: %w", err)
+	}
+
+	return count, nil
+}
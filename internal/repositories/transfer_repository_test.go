@@ -283,6 +283,34 @@ func (s *TransferRepositoryTestSuite) TestFindByUserAccounts_WithFilters() {
 	assert.Equal(s.T(), models.TransferStatusCompleted, transfers[0].Status)
 }
 
+// TestFindByUserAccounts_WithScheduleFilter tests finding the occurrences of a transfer schedule
+func (s *TransferRepositoryTestSuite) TestFindByUserAccounts_WithScheduleFilter() {
+	accountID := uuid.New()
+	scheduleID := uuid.New()
+	occurrence := 1
+
+	scheduled := s.createTestTransfer()
+	scheduled.FromAccountID = accountID
+	scheduled.ScheduleID = &scheduleID
+	scheduled.ScheduleOccurrence = &occurrence
+	require.NoError(s.T(), s.repo.Create(scheduled))
+
+	oneOff := s.createTestTransfer()
+	oneOff.FromAccountID = accountID
+	require.NoError(s.T(), s.repo.Create(oneOff))
+
+	filters := models.TransferFilters{
+		ScheduleID: &scheduleID,
+	}
+
+	transfers, total, err := s.repo.FindByUserAccountsWithFilters([]uuid.UUID{accountID}, filters, 0, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), total)
+	require.Len(s.T(), transfers, 1)
+	assert.Equal(s.T(), scheduled.ID, transfers[0].ID)
+	assert.Equal(s.T(), 1, *transfers[0].ScheduleOccurrence)
+}
+
 // TestCountByUserAccounts tests counting transfers
 func (s *TransferRepositoryTestSuite) TestCountByUserAccounts() {
 	accountID1 := uuid.New()
@@ -319,3 +347,58 @@ func (s *TransferRepositoryTestSuite) TestCountByUserAccounts_NoAccounts() {
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(0), count)
 }
+
+// TestCountCompletedBetween tests that only completed transfers in one direction count
+func (s *TransferRepositoryTestSuite) TestCountCompletedBetween() {
+	fromAccountID := uuid.New()
+	toAccountID := uuid.New()
+
+	for _, status := range []string{models.TransferStatusCompleted, models.TransferStatusFailed, models.TransferStatusPending} {
+		transfer := s.createTestTransfer()
+		transfer.FromAccountID = fromAccountID
+		transfer.ToAccountID = toAccountID
+		transfer.Status = status
+		require.NoError(s.T(), s.repo.Create(transfer))
+	}
+
+	reverse := s.createTestTransfer()
+	reverse.FromAccountID = toAccountID
+	reverse.ToAccountID = fromAccountID
+	reverse.Status = models.TransferStatusCompleted
+	require.NoError(s.T(), s.repo.Create(reverse))
+
+	count, err := s.repo.CountCompletedBetween(fromAccountID, toAccountID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), count)
+
+	count, err = s.repo.CountCompletedBetween(fromAccountID, uuid.New())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+// TestCountFromAccountSince tests that failed and older transfers are not counted
+func (s *TransferRepositoryTestSuite) TestCountFromAccountSince() {
+	accountID := uuid.New()
+	now := time.Now()
+
+	for _, status := range []string{models.TransferStatusCompleted, models.TransferStatusPending, models.TransferStatusFailed} {
+		transfer := s.createTestTransfer()
+		transfer.FromAccountID = accountID
+		transfer.Status = status
+		require.NoError(s.T(), s.repo.Create(transfer))
+	}
+
+	older := s.createTestTransfer()
+	older.FromAccountID = accountID
+	older.Status = models.TransferStatusCompleted
+	older.CreatedAt = now.Add(-time.Hour)
+	require.NoError(s.T(), s.repo.Create(older))
+
+	incoming := s.createTestTransfer()
+	incoming.ToAccountID = accountID
+	require.NoError(s.T(), s.repo.Create(incoming))
+
+	count, err := s.repo.CountFromAccountSince(accountID, now.Add(-10*time.Minute))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), count)
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTransferScheduleNotFound = errors.New("transfer schedule not found")
+	ErrTransferScheduleChanged  = errors.New("transfer schedule changed while an occurrence was running")
+)
+
+// transferScheduleRepository implements TransferScheduleRepositoryInterface
+type transferScheduleRepository struct {
+	db *gorm.DB
+}
+
+// NewTransferScheduleRepository creates a new transfer schedule repository
+func NewTransferScheduleRepository(db *gorm.DB) TransferScheduleRepositoryInterface {
+	return &transferScheduleRepository{
+		db: db,
+	}
+}
+
+// Create creates a new transfer schedule
+func (r *transferScheduleRepository) Create(schedule *models.TransferSchedule) error {
+	if err := r.db.Create(schedule).Error; err != nil {
+		return fmt.Errorf("failed to create transfer schedule: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a transfer schedule by ID
+func (r *transferScheduleRepository) GetByID(id uuid.UUID) (*models.TransferSchedule, error) {
+	var schedule models.TransferSchedule
+	if err := r.db.Where("id = ?", id).First(&schedule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransferScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get transfer schedule: %w", err)
+	}
+	return &schedule, nil
+}
+
+// GetByUserID retrieves a user's transfer schedules, newest first
+func (r *transferScheduleRepository) GetByUserID(userID uuid.UUID, offset, limit int) ([]models.TransferSchedule, int64, error) {
+	var schedules []models.TransferSchedule
+	var total int64
+
+	query := r.db.Model(&models.TransferSchedule{}).Where("user_id = ?", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count transfer schedules: %w", err)
+	}
+
+	if err := query.Order("created_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&schedules).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list transfer schedules: %w", err)
+	}
+
+	return schedules, total, nil
+}
+
+// GetDue retrieves active schedules whose next occurrence is due, oldest first
+func (r *transferScheduleRepository) GetDue(now time.Time, limit int) ([]models.TransferSchedule, error) {
+	var schedules []models.TransferSchedule
+	if err := r.db.Where("status = ? AND next_run_at <= ?", models.TransferScheduleStatusActive, now).
+		Order("next_run_at ASC").
+		Limit(limit).
+		Find(&schedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to get due transfer schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// Update saves all fields of a transfer schedule
+func (r *transferScheduleRepository) Update(schedule *models.TransferSchedule) error {
+	if err := r.db.Save(schedule).Error; err != nil {
+		return fmt.Errorf("failed to update transfer schedule: %w", err)
+	}
+	return nil
+}
+
+// SaveRun records that an occurrence has run. The update only applies while the
+// schedule is still active at the expected occurrence; otherwise it returns
+// ErrTransferScheduleChanged so a concurrent pause, cancel or run is not overwritten.
+func (r *transferScheduleRepository) SaveRun(schedule *models.TransferSchedule, expectedOccurrence int) error {
+	result := r.db.Model(schedule).
+		Where("status = ? AND next_occurrence = ?", models.TransferScheduleStatusActive, expectedOccurrence).
+		Select("status", "next_occurrence", "occurrences_run", "next_run_at", "last_run_at", "updated_at").
+		Updates(schedule)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to save transfer schedule run: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrTransferScheduleChanged
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// TransferScheduleRepositoryTestSuite is the test suite for TransferSchedule repository
+type TransferScheduleRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo TransferScheduleRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *TransferScheduleRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.TransferSchedule{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewTransferScheduleRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *TransferScheduleRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestTransferScheduleRepositoryTestSuite runs the test suite
+func TestTransferScheduleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferScheduleRepositoryTestSuite))
+}
+
+// Helper function to create a persisted weekly schedule with the given status and start date
+func (s *TransferScheduleRepositoryTestSuite) createTestSchedule(status string, start time.Time) *models.TransferSchedule {
+	schedule := &models.TransferSchedule{
+		UserID:         uuid.New(),
+		FromAccountID:  uuid.New(),
+		ToAccountID:    uuid.New(),
+		Amount:         decimal.NewFromInt(50),
+		Description:    "Savings",
+		Frequency:      models.TransferFrequencyWeekly,
+		StartDate:      start,
+		Status:         status,
+		NextOccurrence: 1,
+	}
+	schedule.ScheduleNext()
+	require.NoError(s.T(), s.repo.Create(schedule))
+	return schedule
+}
+
+// TestGetDue_ReturnsActiveSchedulesPastTheirRunDate tests that only due active schedules are returned
+func (s *TransferScheduleRepositoryTestSuite) TestGetDue_ReturnsActiveSchedulesPastTheirRunDate() {
+	now := time.Now()
+	due := s.createTestSchedule(models.TransferScheduleStatusActive, now.Add(-time.Hour))
+	s.createTestSchedule(models.TransferScheduleStatusActive, now.Add(time.Hour))
+	s.createTestSchedule(models.TransferScheduleStatusPaused, now.Add(-time.Hour))
+
+	schedules, err := s.repo.GetDue(now, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), schedules, 1)
+	assert.Equal(s.T(), due.ID, schedules[0].ID)
+}
+
+// TestSaveRun_RejectsStaleOccurrence tests that a run is not saved over a concurrent change
+func (s *TransferScheduleRepositoryTestSuite) TestSaveRun_RejectsStaleOccurrence() {
+	schedule := s.createTestSchedule(models.TransferScheduleStatusActive, time.Now().Add(-time.Hour))
+
+	schedule.RecordRun(time.Now())
+	require.NoError(s.T(), s.repo.SaveRun(schedule, 1))
+
+	saved, err := s.repo.GetByID(schedule.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, saved.NextOccurrence)
+	assert.Equal(s.T(), 1, saved.OccurrencesRun)
+
+	// A second worker that ran the same occurrence must not advance it again
+	stale := *saved
+	stale.NextOccurrence = 1
+	stale.RecordRun(time.Now())
+	assert.ErrorIs(s.T(), s.repo.SaveRun(&stale, 1), ErrTransferScheduleChanged)
+}
+
+// TestSaveRun_DoesNotOverwritePause tests that a pause made during a run is kept
+func (s *TransferScheduleRepositoryTestSuite) TestSaveRun_DoesNotOverwritePause() {
+	schedule := s.createTestSchedule(models.TransferScheduleStatusActive, time.Now().Add(-time.Hour))
+
+	paused := *schedule
+	paused.Status = models.TransferScheduleStatusPaused
+	require.NoError(s.T(), s.repo.Update(&paused))
+
+	schedule.RecordRun(time.Now())
+	assert.ErrorIs(s.T(), s.repo.SaveRun(schedule, 1), ErrTransferScheduleChanged)
+
+	saved, err := s.repo.GetByID(schedule.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TransferScheduleStatusPaused, saved.Status)
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"array-assessment/internal/models"
@@ -15,6 +16,10 @@ var (
 	ErrUserNotFound       = errors.New("user not found")
 	ErrUserAlreadyExists  = errors.New("user already exists")
 	ErrEmailAlreadyExists = errors.New("email already exists")
+
+	// ErrTrigramSearchUnavailable is returned by FuzzySearchUsers when the database
+	// has no pg_trgm support, as under SQLite
+	ErrTrigramSearchUnavailable = errors.New("trigram search is not available")
 )
 
 // UserRepository handles database operations for users
@@ -297,3 +302,104 @@ func (r *UserRepository) SearchUsers(criteria UserSearchCriteria, offset, limit
 
 	return users, total, nil
 }
+
+// FuzzySearchUsers ranks users by pg_trgm similarity of their names and email to the
+// criteria. Each term must be at least as similar as pg_trgm.similarity_threshold, and
+// the score is the mean similarity of the terms given.
+func (r *UserRepository) FuzzySearchUsers(criteria FuzzyUserSearchCriteria, offset, limit int) ([]*models.UserSearchMatch, int64, error) {
+	if r.db.Dialector.Name() != "postgres" {
+		return nil, 0, ErrTrigramSearchUnavailable
+	}
+
+	baseQuery := whereAccountNumbersContain(r.db.Model(&models.User{}), criteria.AccountNumbers)
+
+	var scores []string
+	var scoreArgs []interface{}
+	if criteria.Name != "" {
+		name := strings.ToLower(criteria.Name)
+		baseQuery = baseQuery.Where("(LOWER(first_name) % ? OR LOWER(last_name) % ? OR LOWER(first_name || ' ' || last_name) % ?)", name, name, name)
+		scores = append(scores, "GREATEST(similarity(LOWER(first_name), ?), similarity(LOWER(last_name), ?), similarity(LOWER(first_name || ' ' || last_name), ?))")
+		scoreArgs = append(scoreArgs, name, name, name)
+	}
+	if criteria.Email != "" {
+		email := strings.ToLower(criteria.Email)
+		baseQuery = baseQuery.Where("LOWER(email) % ?", email)
+		scores = append(scores, "similarity(LOWER(email), ?)")
+		scoreArgs = append(scoreArgs, email)
+	}
+
+	// A search by account number alone has nothing to rank on
+	score := "1.0"
+	if len(scores) > 0 {
+		score = "(" + strings.Join(scores, " + ") + ") / " + strconv.Itoa(len(scores))
+	}
+
+	var total int64
+	if err := baseQuery.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count search results: %w", err)
+	}
+
+	var ranked []struct {
+		ID    uuid.UUID
+		Score float64
+	}
+	if err := baseQuery.Select("users.id, "+score+" AS score", scoreArgs...).
+		Order("score DESC, last_name ASC, first_name ASC").
+		Offset(offset).
+		Limit(limit).
+		Scan(&ranked).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	if len(ranked) == 0 {
+		return []*models.UserSearchMatch{}, total, nil
+	}
+
+	ids := make([]uuid.UUID, len(ranked))
+	for i, row := range ranked {
+		ids[i] = row.ID
+	}
+
+	var users []*models.User
+	if err := r.db.Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to load matched users: %w", err)
+	}
+
+	usersByID := make(map[uuid.UUID]*models.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+
+	matches := make([]*models.UserSearchMatch, 0, len(ranked))
+	for _, row := range ranked {
+		if user, ok := usersByID[row.ID]; ok {
+			matches = append(matches, &models.UserSearchMatch{User: user, Score: row.Score})
+		}
+	}
+
+	return matches, total, nil
+}
+
+// ListSearchCandidates returns every user holding accounts whose numbers contain all of
+// the given fragments, or every user when there are none, for scoring outside the database
+func (r *UserRepository) ListSearchCandidates(accountNumbers []string) ([]*models.User, error) {
+	var users []*models.User
+
+	if err := whereAccountNumbersContain(r.db.Model(&models.User{}), accountNumbers).
+		Order("last_name ASC, first_name ASC").
+		Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("failed to list search candidates: %w", err)
+	}
+
+	return users, nil
+}
+
+// whereAccountNumbersContain restricts a user query to users with an undeleted account whose
+// number contains each fragment
+func whereAccountNumbersContain(query *gorm.DB, fragments []string) *gorm.DB {
+	for _, fragment := range fragments {
+		query = query.Where("EXISTS (SELECT 1 FROM accounts WHERE accounts.user_id = users.id AND accounts.deleted_at IS NULL AND accounts.account_number LIKE ?)",
+			"%"+fragment+"%")
+	}
+	return query
+}
@@ -233,3 +233,66 @@ func (s *UserRepositorySuite) TestUserRepository_UpdatePasswordHash() {
 	err = s.repo.UpdatePasswordHash(uuid.New(), "new_hash")
 	s.Equal(ErrUserNotFound, err)
 }
+
+func (s *UserRepositorySuite) TestUserRepository_FuzzySearchUsers_NeedsTrigramSupport() {
+	matches, total, err := s.repo.FuzzySearchUsers(FuzzyUserSearchCriteria{Name: "jon smth"}, 0, 10)
+	s.ErrorIs(err, ErrTrigramSearchUnavailable)
+	s.Nil(matches)
+	s.Zero(total)
+}
+
+func (s *UserRepositorySuite) TestUserRepository_ListSearchCandidates() {
+	createUser := func(firstName, lastName string) *models.User {
+		user := &models.User{
+			Email:        fmt.Sprintf("%s.%s@example.com", firstName, lastName),
+			PasswordHash: "hashed_password",
+			FirstName:    firstName,
+			LastName:     lastName,
+			Role:         models.RoleCustomer,
+		}
+		s.Require().NoError(s.repo.Create(user))
+		return user
+	}
+	createAccount := func(user *models.User, accountNumber string) *models.Account {
+		account := &models.Account{
+			UserID:        user.ID,
+			AccountNumber: accountNumber,
+			RoutingNumber: "R" + accountNumber,
+			AccountType:   models.AccountTypeChecking,
+		}
+		s.Require().NoError(s.db.DB.Create(account).Error)
+		return account
+	}
+
+	smith := createUser("John", "Smith")
+	doe := createUser("Jane", "Doe")
+	createUser("Bob", "Adams")
+	createAccount(smith, "1000482100")
+	createAccount(smith, "2000000007")
+	closed := createAccount(doe, "1000482199")
+	s.Require().NoError(s.db.DB.Delete(closed).Error)
+
+	// Every user, ordered by name
+	users, err := s.repo.ListSearchCandidates(nil)
+	s.NoError(err)
+	s.Require().Len(users, 3)
+	s.Equal("Adams", users[0].LastName)
+	s.Equal("Doe", users[1].LastName)
+	s.Equal("Smith", users[2].LastName)
+
+	// Deleted accounts are ignored
+	users, err = s.repo.ListSearchCandidates([]string{"4821"})
+	s.NoError(err)
+	s.Require().Len(users, 1)
+	s.Equal(smith.ID, users[0].ID)
+
+	// Fragments may match different accounts of the same user
+	users, err = s.repo.ListSearchCandidates([]string{"4821", "0007"})
+	s.NoError(err)
+	s.Require().Len(users, 1)
+	s.Equal(smith.ID, users[0].ID)
+
+	users, err = s.repo.ListSearchCandidates([]string{"4821", "9999"})
+	s.NoError(err)
+	s.Empty(users)
+}
@@ -0,0 +1,218 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+)
+
+// webhookRepository implements WebhookRepositoryInterface
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new webhook repository
+func NewWebhookRepository(db *gorm.DB) WebhookRepositoryInterface {
+	return &webhookRepository{
+		db: db,
+	}
+}
+
+// CreateSubscription stores a new webhook subscription
+func (r *webhookRepository) CreateSubscription(subscription *models.WebhookSubscription) error {
+	if err := r.db.Create(subscription).Error; err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// GetSubscription retrieves a webhook subscription by ID
+func (r *webhookRepository) GetSubscription(id uuid.UUID) (*models.WebhookSubscription, error) {
+	var subscription models.WebhookSubscription
+	if err := r.db.First(&subscription, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+	return &subscription, nil
+}
+
+// ListSubscriptions retrieves the subscriptions a user created, newest first
+func (r *webhookRepository) ListSubscriptions(userID uuid.UUID, offset, limit int) ([]models.WebhookSubscription, int64, error) {
+	var subscriptions []models.WebhookSubscription
+	var total int64
+
+	query := r.db.Model(&models.WebhookSubscription{}).Where("user_id = ?", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook subscriptions: %w", err)
+	}
+
+	if err := query.Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&subscriptions).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	return subscriptions, total, nil
+}
+
+// UpdateSubscription saves changes to a webhook subscription
+func (r *webhookRepository) UpdateSubscription(subscription *models.WebhookSubscription) error {
+	result := r.db.Model(subscription).
+		Select("url", "description", "event_types", "active", "updated_at").
+		Updates(subscription)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+// DeleteSubscription removes a webhook subscription along with its delivery log
+func (r *webhookRepository) DeleteSubscription(id uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", id).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+		}
+
+		result := tx.Delete(&models.WebhookSubscription{}, "id = ?", id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete webhook subscription: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrWebhookSubscriptionNotFound
+		}
+		return nil
+	})
+}
+
+// ListActiveSubscriptions retrieves the active subscriptions that receive events
+// about a customer: the customer's own and every subscription made for all customers
+func (r *webhookRepository) ListActiveSubscriptions(userID uuid.UUID) ([]models.WebhookSubscription, error) {
+	var subscriptions []models.WebhookSubscription
+	if err := r.db.Where("active = ? AND (user_id = ? OR all_customers = ?)", true, userID, true).
+		Order("created_at ASC").
+		Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list active webhook subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// CreateDeliveries queues deliveries, all or none of them
+func (r *webhookRepository) CreateDeliveries(deliveries []*models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := r.db.Create(&deliveries).Error; err != nil {
+		return fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// ClaimDueDeliveries atomically claims up to limit pending deliveries that are due.
+// Claimed deliveries have their next attempt pushed back by lease, so a delivery
+// whose worker stops before recording the attempt becomes due again once the lease
+// runs out. Rows locked by another worker's claim are skipped.
+func (r *webhookRepository) ClaimDueDeliveries(limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	var deliveries []*models.WebhookDelivery
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		var ids []uuid.UUID
+		if err := tx.Model(&models.WebhookDelivery{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryStatusPending, now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Pluck("id", &ids).Error; err != nil {
+			return fmt.Errorf("failed to select due webhook deliveries: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&models.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error; err != nil {
+			return fmt.Errorf("failed to claim webhook deliveries: %w", err)
+		}
+
+		if err := tx.Where("id IN ?", ids).
+			Order("created_at ASC").
+			Find(&deliveries).Error; err != nil {
+			return fmt.Errorf("failed to load claimed webhook deliveries: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// SaveAttempt records the outcome of a delivery attempt
+func (r *webhookRepository) SaveAttempt(delivery *models.WebhookDelivery) error {
+	if err := r.db.Model(delivery).
+		Select("status", "attempt_count", "next_attempt_at", "last_attempt_at", "response_status",
+			"last_error", "delivered_at", "attempts", "updated_at").
+		Updates(delivery).Error; err != nil {
+		return fmt.Errorf("failed to save webhook delivery attempt: %w", err)
+	}
+	return nil
+}
+
+// GetDelivery retrieves a delivery made to a subscription
+func (r *webhookRepository) GetDelivery(subscriptionID, id uuid.UUID) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	if err := r.db.First(&delivery, "id = ? AND subscription_id = ?", id, subscriptionID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+// ListDeliveries retrieves the delivery log of a subscription, newest first. An
+// empty status lists deliveries in every status.
+func (r *webhookRepository) ListDeliveries(subscriptionID uuid.UUID, status string, offset, limit int) ([]models.WebhookDelivery, int64, error) {
+	var deliveries []models.WebhookDelivery
+	var total int64
+
+	query := r.db.Model(&models.WebhookDelivery{}).Where("subscription_id = ?", subscriptionID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	if err := query.Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	return deliveries, total, nil
+}
@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// WebhookRepositoryTestSuite is the test suite for the webhook repository
+type WebhookRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo WebhookRepositoryInterface
+}
+
+// SetupTest runs before each test
+func (s *WebhookRepositoryTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(s.T(), err)
+
+	err = db.AutoMigrate(&models.WebhookSubscription{}, &models.WebhookDelivery{})
+	require.NoError(s.T(), err)
+
+	s.db = db
+	s.repo = NewWebhookRepository(db)
+}
+
+// TearDownTest runs after each test
+func (s *WebhookRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// TestWebhookRepositoryTestSuite runs the test suite
+func TestWebhookRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookRepositoryTestSuite))
+}
+
+// Helper function to create a subscription to completed transactions
+func (s *WebhookRepositoryTestSuite) createSubscription(userID uuid.UUID, allCustomers bool) *models.WebhookSubscription {
+	subscription := &models.WebhookSubscription{
+		UserID:       userID,
+		URL:          "https://example.com/hooks",
+		EventTypes:   models.WebhookEventTypes{models.WebhookEventTransactionCompleted},
+		Secret:       "whsec_test",
+		AllCustomers: allCustomers,
+		Active:       true,
+	}
+	s.Require().NoError(s.repo.CreateSubscription(subscription))
+	return subscription
+}
+
+// Helper function to queue a delivery to a subscription
+func (s *WebhookRepositoryTestSuite) createDelivery(subscriptionID uuid.UUID, nextAttemptAt time.Time) *models.WebhookDelivery {
+	delivery := &models.WebhookDelivery{
+		SubscriptionID: subscriptionID,
+		EventID:        uuid.New(),
+		EventType:      models.WebhookEventTransactionCompleted,
+		Payload:        `{"type":"transaction.completed"}`,
+		NextAttemptAt:  nextAttemptAt,
+	}
+	s.Require().NoError(s.repo.CreateDeliveries([]*models.WebhookDelivery{delivery}))
+	return delivery
+}
+
+func (s *WebhookRepositoryTestSuite) TestSubscriptionLifecycle() {
+	userID := uuid.New()
+	subscription := s.createSubscription(userID, false)
+
+	found, err := s.repo.GetSubscription(subscription.ID)
+	s.Require().NoError(err)
+	s.Equal(models.WebhookEventTypes{models.WebhookEventTransactionCompleted}, found.EventTypes)
+	s.Equal("whsec_test", found.Secret)
+
+	found.EventTypes = models.WebhookEventTypes{models.WebhookEventAccountClosed}
+	found.Active = false
+	s.Require().NoError(s.repo.UpdateSubscription(found))
+
+	updated, err := s.repo.GetSubscription(subscription.ID)
+	s.Require().NoError(err)
+	s.False(updated.Active)
+	s.Equal(models.WebhookEventTypes{models.WebhookEventAccountClosed}, updated.EventTypes)
+
+	subscriptions, total, err := s.repo.ListSubscriptions(userID, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Len(subscriptions, 1)
+
+	s.createDelivery(subscription.ID, time.Now())
+	s.Require().NoError(s.repo.DeleteSubscription(subscription.ID))
+
+	_, err = s.repo.GetSubscription(subscription.ID)
+	s.ErrorIs(err, ErrWebhookSubscriptionNotFound)
+	s.ErrorIs(s.repo.DeleteSubscription(subscription.ID), ErrWebhookSubscriptionNotFound)
+
+	var remaining int64
+	s.db.Model(&models.WebhookDelivery{}).Count(&remaining)
+	s.Zero(remaining)
+}
+
+func (s *WebhookRepositoryTestSuite) TestListActiveSubscriptions() {
+	customerID := uuid.New()
+	own := s.createSubscription(customerID, false)
+	admin := s.createSubscription(uuid.New(), true)
+	s.createSubscription(uuid.New(), false)
+
+	inactive := s.createSubscription(customerID, false)
+	inactive.Active = false
+	s.Require().NoError(s.repo.UpdateSubscription(inactive))
+
+	subscriptions, err := s.repo.ListActiveSubscriptions(customerID)
+	s.Require().NoError(err)
+	s.Require().Len(subscriptions, 2)
+	s.ElementsMatch([]uuid.UUID{own.ID, admin.ID}, []uuid.UUID{subscriptions[0].ID, subscriptions[1].ID})
+}
+
+func (s *WebhookRepositoryTestSuite) TestClaimDueDeliveries() {
+	subscription := s.createSubscription(uuid.New(), false)
+	due := s.createDelivery(subscription.ID, time.Now().Add(-time.Minute))
+	s.createDelivery(subscription.ID, time.Now().Add(time.Hour))
+
+	delivered := s.createDelivery(subscription.ID, time.Now().Add(-time.Minute))
+	delivered.RecordAttempt(200, nil, time.Now())
+	s.Require().NoError(s.repo.SaveAttempt(delivered))
+
+	claimed, err := s.repo.ClaimDueDeliveries(10, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(claimed, 1)
+	s.Equal(due.ID, claimed[0].ID)
+	s.True(claimed[0].NextAttemptAt.After(time.Now()))
+
+	// The lease keeps the claimed delivery from being claimed again
+	claimed, err = s.repo.ClaimDueDeliveries(10, time.Minute)
+	s.Require().NoError(err)
+	s.Empty(claimed)
+}
+
+func (s *WebhookRepositoryTestSuite) TestSaveAttemptAndListDeliveries() {
+	subscription := s.createSubscription(uuid.New(), false)
+	delivery := s.createDelivery(subscription.ID, time.Now())
+	s.createDelivery(subscription.ID, time.Now())
+
+	delivery.RecordAttempt(503, nil, time.Now())
+	s.Require().NoError(s.repo.SaveAttempt(delivery))
+
+	found, err := s.repo.GetDelivery(subscription.ID, delivery.ID)
+	s.Require().NoError(err)
+	s.Equal(1, found.AttemptCount)
+	s.Equal(503, *found.ResponseStatus)
+	s.Require().Len(found.Attempts, 1)
+	s.Equal("endpoint responded with status 503", found.Attempts[0].Error)
+
+	_, err = s.repo.GetDelivery(uuid.New(), delivery.ID)
+	s.ErrorIs(err, ErrWebhookDeliveryNotFound)
+
+	deliveries, total, err := s.repo.ListDeliveries(subscription.ID, "", 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+	s.Len(deliveries, 2)
+
+	delivery.RecordAttempt(200, nil, time.Now())
+	s.Require().NoError(s.repo.SaveAttempt(delivery))
+
+	deliveries, total, err = s.repo.ListDeliveries(subscription.ID, models.WebhookDeliveryStatusDelivered, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Equal(delivery.ID, deliveries[0].ID)
+}
@@ -92,7 +92,7 @@ func (s *AccountAssociationService) CreateAccountForCustomer(customerID, perform
 		AccountType:   accountType,
 		Balance:       decimal.Zero,
 		Status:        models.AccountStatusActive,
-		Currency:      "USD",
+		Currency:      models.DefaultCurrency,
 	}
 
 	if err := s.accountRepo.Create(account); err != nil {
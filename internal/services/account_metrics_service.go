@@ -43,21 +43,27 @@ type accountMetricsService struct {
 	accountRepo     repositories.AccountRepositoryInterface
 	transactionRepo repositories.TransactionRepositoryInterface
 	userRepo        repositories.UserRepositoryInterface
+	interestRepo    repositories.InterestRepositoryInterface
+	rateProvider    RateProvider
 }
 
 func NewAccountMetricsService(
 	accountRepo repositories.AccountRepositoryInterface,
 	transactionRepo repositories.TransactionRepositoryInterface,
 	userRepo repositories.UserRepositoryInterface,
+	interestRepo repositories.InterestRepositoryInterface,
+	rateProvider RateProvider,
 ) AccountMetricsServiceInterface {
 	return &accountMetricsService{
 		accountRepo:     accountRepo,
 		transactionRepo: transactionRepo,
 		userRepo:        userRepo,
+		interestRepo:    interestRepo,
+		rateProvider:    rateProvider,
 	}
 }
 
-func (s *accountMetricsService) GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error) {
+func (s *accountMetricsService) GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, canViewAll bool) (*models.AccountMetrics, error) {
 	effectiveStart, effectiveEnd, err := s.validateAndNormalizeDateRange(startDate, endDate)
 	if err != nil {
 		return nil, err
@@ -68,7 +74,7 @@ func (s *accountMetricsService) GetAccountMetrics(requestorID, accountID uuid.UU
 		return nil, err
 	}
 
-	account, err := s.getAndAuthorizeAccount(accountID, requestor, isAdmin)
+	account, err := s.getAndAuthorizeAccount(accountID, requestor, canViewAll)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +88,7 @@ func (s *accountMetricsService) GetAccountMetrics(requestorID, accountID uuid.UU
 	}
 
 	metrics := s.calculateAccountMetrics(accountID, transactions, effectiveStart, effectiveEnd, account)
+	metrics.InterestEarned = s.calculateInterestEarned(account, effectiveStart, effectiveEnd)
 
 	slog.Info("account metrics generated",
 		"account_id", accountID,
@@ -92,18 +99,26 @@ func (s *accountMetricsService) GetAccountMetrics(requestorID, accountID uuid.UU
 	return metrics, nil
 }
 
-func (s *accountMetricsService) GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error) {
+// GetUserAggregateMetrics totals the metrics of all of a user's accounts. Each
+// account's metrics stay in its own currency; the totals are converted into
+// baseCurrency, or the default currency when baseCurrency is empty.
+func (s *accountMetricsService) GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, baseCurrency string, canViewAll bool) (*models.UserAggregateMetrics, error) {
 	effectiveStart, effectiveEnd, err := s.validateAndNormalizeDateRange(startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
 
+	baseCurrency, err = resolveCurrency(baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+
 	requestor, err := s.validateRequestor(requestorID)
 	if err != nil {
 		return nil, err
 	}
 
-	effectiveUserID, err := s.authorizeUserAccess(requestorID, targetUserID, requestor, isAdmin)
+	effectiveUserID, err := s.authorizeUserAccess(requestorID, targetUserID, requestor, canViewAll)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +131,10 @@ func (s *accountMetricsService) GetUserAggregateMetrics(requestorID, targetUserI
 		return nil, fmt.Errorf("failed to fetch accounts: %w", err)
 	}
 
-	aggregateMetrics := s.calculateAggregateMetrics(effectiveUserID, accounts, effectiveStart, effectiveEnd)
+	aggregateMetrics, err := s.calculateAggregateMetrics(effectiveUserID, accounts, effectiveStart, effectiveEnd, baseCurrency)
+	if err != nil {
+		return nil, err
+	}
 
 	slog.Info("user aggregate metrics generated",
 		"user_id", effectiveUserID,
@@ -171,7 +189,7 @@ func (s *accountMetricsService) validateRequestor(requestorID uuid.UUID) (*model
 	return requestor, nil
 }
 
-func (s *accountMetricsService) getAndAuthorizeAccount(accountID uuid.UUID, requestor *models.User, isAdmin bool) (*models.Account, error) {
+func (s *accountMetricsService) getAndAuthorizeAccount(accountID uuid.UUID, requestor *models.User, canViewAll bool) (*models.Account, error) {
 	account, err := s.accountRepo.GetByID(accountID)
 	if err != nil {
 		slog.Error("failed to get account for metrics",
@@ -181,7 +199,7 @@ func (s *accountMetricsService) getAndAuthorizeAccount(accountID uuid.UUID, requ
 	}
 
 	if account.UserID != requestor.ID {
-		if !isAdmin || requestor.Role != models.RoleAdmin {
+		if !canViewAll {
 			slog.Warn("unauthorized access attempt to account metrics",
 				"requestor_id", requestor.ID,
 				"requestor_role", requestor.Role,
@@ -190,9 +208,9 @@ func (s *accountMetricsService) getAndAuthorizeAccount(accountID uuid.UUID, requ
 			return nil, ErrUnauthorized
 		}
 
-		slog.Info("admin accessing account metrics",
-			"admin_id", requestor.ID,
-			"admin_email", requestor.Email,
+		slog.Info("staff accessing account metrics",
+			"staff_id", requestor.ID,
+			"staff_email", requestor.Email,
 			"account_id", accountID,
 			"account_user_id", account.UserID)
 	}
@@ -200,12 +218,12 @@ func (s *accountMetricsService) getAndAuthorizeAccount(accountID uuid.UUID, requ
 	return account, nil
 }
 
-func (s *accountMetricsService) authorizeUserAccess(requestorID, targetUserID uuid.UUID, requestor *models.User, isAdmin bool) (uuid.UUID, error) {
+func (s *accountMetricsService) authorizeUserAccess(requestorID, targetUserID uuid.UUID, requestor *models.User, canViewAll bool) (uuid.UUID, error) {
 	if requestorID == targetUserID {
 		return requestorID, nil
 	}
 
-	if !isAdmin || requestor.Role != models.RoleAdmin {
+	if !canViewAll {
 		slog.Warn("unauthorized access attempt to user aggregate metrics",
 			"requestor_id", requestorID,
 			"requestor_role", requestor.Role,
@@ -216,7 +234,7 @@ func (s *accountMetricsService) authorizeUserAccess(requestorID, targetUserID uu
 	targetUser, err := s.userRepo.GetByID(targetUserID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
-			slog.Warn("target user not found during admin metrics request",
+			slog.Warn("target user not found during staff metrics request",
 				"target_user_id", targetUserID,
 				"error", err)
 			return uuid.Nil, ErrNotFound
@@ -227,9 +245,9 @@ func (s *accountMetricsService) authorizeUserAccess(requestorID, targetUserID uu
 		return uuid.Nil, fmt.Errorf("failed to verify target user: %w", err)
 	}
 
-	slog.Info("admin accessing user aggregate metrics",
-		"admin_id", requestorID,
-		"admin_email", requestor.Email,
+	slog.Info("staff accessing user aggregate metrics",
+		"staff_id", requestorID,
+		"staff_email", requestor.Email,
 		"target_user_id", targetUserID,
 		"target_user_email", targetUser.Email)
 
@@ -239,6 +257,7 @@ func (s *accountMetricsService) authorizeUserAccess(requestorID, targetUserID uu
 func (s *accountMetricsService) calculateAccountMetrics(accountID uuid.UUID, transactions []models.Transaction, startDate, endDate time.Time, account *models.Account) *models.AccountMetrics {
 	metrics := &models.AccountMetrics{
 		AccountID:                accountID,
+		Currency:                 account.CurrencyOrDefault(),
 		StartDate:                startDate,
 		EndDate:                  endDate,
 		TotalDeposits:            decimal.Zero,
@@ -260,7 +279,7 @@ func (s *accountMetricsService) calculateAccountMetrics(accountID uuid.UUID, tra
 	for i := range transactions {
 		txn := &transactions[i]
 
-		if txn.Status != models.TransactionStatusCompleted {
+		if !txn.CountsTowardTotals() {
 			continue
 		}
 
@@ -290,11 +309,6 @@ func (s *accountMetricsService) calculateAccountMetrics(accountID uuid.UUID, tra
 		metrics.AverageTransactionAmount = totalAmount.Div(decimal.NewFromInt(metrics.TransactionCount))
 	}
 
-	daysDifference := int(endDate.Sub(startDate).Hours() / 24)
-	if daysDifference == 0 {
-		daysDifference = 1
-	}
-
 	if len(transactions) > 0 {
 		balanceSum := decimal.Zero
 		for i := range transactions {
@@ -305,17 +319,36 @@ func (s *accountMetricsService) calculateAccountMetrics(accountID uuid.UUID, tra
 		metrics.AverageDailyBalance = account.Balance
 	}
 
-	if !account.InterestRate.IsZero() && daysDifference > 0 {
-		dailyRate := account.InterestRate.Div(decimal.NewFromInt(365)).Div(decimal.NewFromInt(100))
-		metrics.InterestEarned = metrics.AverageDailyBalance.Mul(dailyRate).Mul(decimal.NewFromInt(int64(daysDifference)))
+	return metrics
+}
+
+// calculateInterestEarned sums the interest accrued on each day of the period, rounded
+// to the cent. Accrual failures are logged and reported as no interest.
+func (s *accountMetricsService) calculateInterestEarned(account *models.Account, startDate, endDate time.Time) decimal.Decimal {
+	if !models.IsInterestBearingAccountType(account.AccountType) {
+		return decimal.Zero
+	}
+
+	accruals, err := s.interestRepo.GetAccruals(account.ID, startDate, endDate)
+	if err != nil {
+		slog.Error("failed to fetch interest accruals for metrics",
+			"account_id", account.ID,
+			"error", err)
+		return decimal.Zero
 	}
 
-	return metrics
+	earned := decimal.Zero
+	for i := range accruals {
+		earned = earned.Add(accruals[i].Amount)
+	}
+
+	return earned.Round(2)
 }
 
-func (s *accountMetricsService) calculateAggregateMetrics(userID uuid.UUID, accounts []models.Account, startDate, endDate time.Time) *models.UserAggregateMetrics {
+func (s *accountMetricsService) calculateAggregateMetrics(userID uuid.UUID, accounts []models.Account, startDate, endDate time.Time, baseCurrency string) (*models.UserAggregateMetrics, error) {
 	aggregateMetrics := &models.UserAggregateMetrics{
 		UserID:                userID,
+		Currency:              baseCurrency,
 		StartDate:             startDate,
 		EndDate:               endDate,
 		TotalDeposits:         decimal.Zero,
@@ -339,9 +372,25 @@ func (s *accountMetricsService) calculateAggregateMetrics(userID uuid.UUID, acco
 		}
 
 		accountMetrics := s.calculateAccountMetrics(account.ID, transactions, startDate, endDate, account)
+		accountMetrics.InterestEarned = s.calculateInterestEarned(account, startDate, endDate)
+
+		deposits, withdrawals := accountMetrics.TotalDeposits, accountMetrics.TotalWithdrawals
+		if accountMetrics.Currency != baseCurrency {
+			rate, err := s.rateProvider.GetRate(accountMetrics.Currency, baseCurrency)
+			if err != nil {
+				slog.Error("failed to convert account metrics into base currency",
+					"account_id", account.ID,
+					"currency", accountMetrics.Currency,
+					"base_currency", baseCurrency,
+					"error", err)
+				return nil, err
+			}
+			deposits = rate.Convert(deposits)
+			withdrawals = rate.Convert(withdrawals)
+		}
 
-		aggregateMetrics.TotalDeposits = aggregateMetrics.TotalDeposits.Add(accountMetrics.TotalDeposits)
-		aggregateMetrics.TotalWithdrawals = aggregateMetrics.TotalWithdrawals.Add(accountMetrics.TotalWithdrawals)
+		aggregateMetrics.TotalDeposits = aggregateMetrics.TotalDeposits.Add(deposits)
+		aggregateMetrics.TotalWithdrawals = aggregateMetrics.TotalWithdrawals.Add(withdrawals)
 		aggregateMetrics.TotalTransactionCount += accountMetrics.TransactionCount
 
 		aggregateMetrics.AccountMetrics = append(aggregateMetrics.AccountMetrics, *accountMetrics)
@@ -349,5 +398,5 @@ func (s *accountMetricsService) calculateAggregateMetrics(userID uuid.UUID, acco
 
 	aggregateMetrics.NetChange = aggregateMetrics.TotalDeposits.Sub(aggregateMetrics.TotalWithdrawals)
 
-	return aggregateMetrics
+	return aggregateMetrics, nil
 }
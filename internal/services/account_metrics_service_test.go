@@ -8,6 +8,7 @@ import (
 	"array-assessment/internal/models"
 	"array-assessment/internal/repositories"
 	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/golang/mock/gomock"
@@ -23,6 +24,8 @@ type MetricsServiceTestSuite struct {
 	mockAccountRepo     *repository_mocks.MockAccountRepositoryInterface
 	mockTransactionRepo *repository_mocks.MockTransactionRepositoryInterface
 	mockUserRepo        *repository_mocks.MockUserRepositoryInterface
+	mockInterestRepo    *repository_mocks.MockInterestRepositoryInterface
+	mockRateProvider    *service_mocks.MockRateProvider
 	service             AccountMetricsServiceInterface
 }
 
@@ -32,7 +35,9 @@ func (s *MetricsServiceTestSuite) SetupTest() {
 	s.mockAccountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
 	s.mockTransactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
 	s.mockUserRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
-	s.service = NewAccountMetricsService(s.mockAccountRepo, s.mockTransactionRepo, s.mockUserRepo)
+	s.mockInterestRepo = repository_mocks.NewMockInterestRepositoryInterface(s.ctrl)
+	s.mockRateProvider = service_mocks.NewMockRateProvider(s.ctrl)
+	s.service = NewAccountMetricsService(s.mockAccountRepo, s.mockTransactionRepo, s.mockUserRepo, s.mockInterestRepo, s.mockRateProvider)
 }
 
 // TearDownTest runs after each test
@@ -342,6 +347,44 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_AdvancedMetrics(
 	s.Equal(int64(4), metrics.TransactionCount)
 }
 
+// Test interest earned is the sum of the period's daily accruals
+func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_InterestEarnedFromAccruals() {
+	requestorID := uuid.New()
+	accountID := uuid.New()
+	startDate := time.Now().AddDate(0, 0, -2)
+	endDate := time.Now()
+
+	requestor := &models.User{
+		ID:    requestorID,
+		Email: gofakeit.Email(),
+		Role:  models.RoleCustomer,
+	}
+
+	account := &models.Account{
+		ID:           accountID,
+		UserID:       requestorID,
+		AccountType:  models.AccountTypeSavings,
+		Balance:      decimal.NewFromInt(10000),
+		InterestRate: decimal.RequireFromString("0.0150"),
+	}
+
+	accruals := []models.InterestAccrual{
+		*models.NewInterestAccrual(accountID, startDate, account.Balance, account.InterestRate),
+		*models.NewInterestAccrual(accountID, startDate.AddDate(0, 0, 1), account.Balance, account.InterestRate),
+	}
+
+	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, startDate, endDate).Return([]models.Transaction{}, nil)
+	s.mockInterestRepo.EXPECT().GetAccruals(accountID, startDate, endDate).Return(accruals, nil)
+
+	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, &startDate, &endDate, false)
+
+	s.NoError(err)
+	// 10000 * 0.015 / 365 = 0.4109589041 per day
+	s.Equal("0.82", metrics.InterestEarned.StringFixed(2))
+}
+
 // Test metrics with only pending transactions (should be excluded)
 func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_OnlyCompletedTransactions() {
 	requestorID := uuid.New()
@@ -458,7 +501,7 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Success() {
 	s.mockTransactionRepo.EXPECT().GetByDateRange(account1ID, gomock.Any(), gomock.Any()).Return(transactions1, nil)
 	s.mockTransactionRepo.EXPECT().GetByDateRange(account2ID, gomock.Any(), gomock.Any()).Return(transactions2, nil)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, nil, nil, false)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, nil, nil, "", false)
 
 	s.NoError(err)
 	s.NotNil(aggregateMetrics)
@@ -485,7 +528,7 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Success_NoAccounts
 	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
 	s.mockAccountRepo.EXPECT().GetByUserID(requestorID).Return(accounts, nil)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, nil, nil, false)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, nil, nil, "", false)
 
 	s.NoError(err)
 	s.NotNil(aggregateMetrics)
@@ -510,7 +553,7 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Error_Unauthorized
 
 	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, targetUserID, nil, nil, false)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, targetUserID, nil, nil, "", false)
 
 	s.Error(err)
 	s.Nil(aggregateMetrics)
@@ -540,7 +583,7 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Success_AdminAcces
 	s.mockUserRepo.EXPECT().GetByID(targetUserID).Return(targetUser, nil)
 	s.mockAccountRepo.EXPECT().GetByUserID(targetUserID).Return(accounts, nil)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(adminID, targetUserID, nil, nil, true)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(adminID, targetUserID, nil, nil, "", true)
 
 	s.NoError(err)
 	s.NotNil(aggregateMetrics)
@@ -553,7 +596,7 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Error_InvalidDateR
 	startDate := time.Now()
 	endDate := time.Now().AddDate(0, 0, -7)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, &startDate, &endDate, false)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, &startDate, &endDate, "", false)
 
 	s.Error(err)
 	s.Nil(aggregateMetrics)
@@ -612,3 +655,52 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_LargeTransaction
 	s.True(metrics.TotalWithdrawals.Equal(decimal.NewFromFloat(500000.50)))
 	s.True(metrics.NetChange.Equal(decimal.NewFromFloat(499999.49)))
 }
+
+// Test aggregate metrics converts each account's activity into the base currency
+func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Success_BaseCurrencyConversion() {
+	requestorID := uuid.New()
+	usdAccountID := uuid.New()
+	jpyAccountID := uuid.New()
+
+	requestor := &models.User{ID: requestorID, Role: models.RoleCustomer}
+
+	accounts := []models.Account{
+		{ID: usdAccountID, UserID: requestorID, Currency: "USD"},
+		{ID: jpyAccountID, UserID: requestorID, Currency: "JPY"},
+	}
+
+	now := time.Now()
+
+	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByUserID(requestorID).Return(accounts, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(usdAccountID, gomock.Any(), gomock.Any()).Return([]models.Transaction{
+		{
+			ID:              uuid.New(),
+			AccountID:       usdAccountID,
+			TransactionType: models.TransactionTypeCredit,
+			Amount:          decimal.NewFromFloat(100.00),
+			Status:          models.TransactionStatusCompleted,
+			CreatedAt:       now.AddDate(0, 0, -3),
+		},
+	}, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(jpyAccountID, gomock.Any(), gomock.Any()).Return([]models.Transaction{
+		{
+			ID:              uuid.New(),
+			AccountID:       jpyAccountID,
+			TransactionType: models.TransactionTypeDebit,
+			Amount:          decimal.NewFromInt(1500),
+			Status:          models.TransactionStatusCompleted,
+			CreatedAt:       now.AddDate(0, 0, -2),
+		},
+	}, nil)
+	s.mockRateProvider.EXPECT().GetRate("JPY", "USD").
+		Return(&models.ExchangeRate{BaseCurrency: "JPY", QuoteCurrency: "USD", Rate: decimal.RequireFromString("0.0067")}, nil)
+
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, nil, nil, "", false)
+
+	s.NoError(err)
+	s.Require().NotNil(aggregateMetrics)
+	s.Equal("USD", aggregateMetrics.Currency)
+	s.Equal("100.00", aggregateMetrics.TotalDeposits.StringFixed(2))
+	s.Equal("10.05", aggregateMetrics.TotalWithdrawals.StringFixed(2))
+}
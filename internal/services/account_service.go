@@ -25,6 +25,7 @@ var (
 	ErrAccountClosureNotAllowed = errors.New("account closure not allowed")
 	ErrTransferPending          = errors.New("transfer is still processing with this idempotency key")
 	ErrTransferFailed           = errors.New("previous transfer failed with this idempotency key")
+	ErrTransferNotPending       = errors.New("transfer is not pending")
 )
 
 // accountService implements AccountServiceInterface interface
@@ -34,6 +35,10 @@ type accountService struct {
 	transferRepo    repositories.TransferRepositoryInterface
 	userRepo        repositories.UserRepositoryInterface
 	auditRepo       repositories.AuditLogRepositoryInterface
+	rateProvider    RateProvider
+	limits          TransactionLimitChecker
+	screener        TransactionScreener
+	events          EventPublisher
 	logger          *slog.Logger
 }
 
@@ -44,6 +49,10 @@ func NewAccountService(
 	transferRepo repositories.TransferRepositoryInterface,
 	userRepo repositories.UserRepositoryInterface,
 	auditRepo repositories.AuditLogRepositoryInterface,
+	rateProvider RateProvider,
+	limits TransactionLimitChecker,
+	screener TransactionScreener,
+	events EventPublisher,
 	logger *slog.Logger,
 ) AccountServiceInterface {
 	return &accountService{
@@ -52,12 +61,22 @@ func NewAccountService(
 		transferRepo:    transferRepo,
 		userRepo:        userRepo,
 		auditRepo:       auditRepo,
+		rateProvider:    rateProvider,
+		limits:          limits,
+		screener:        screener,
+		events:          events,
 		logger:          logger,
 	}
 }
 
-// CreateAccount creates a new account for a user
-func (s *accountService) CreateAccount(userID uuid.UUID, accountType, accountNumber, routingNumber string, initialDeposit decimal.Decimal) (*models.Account, error) {
+// CreateAccount creates a new account for a user in an ISO-4217 currency. An empty
+// currency opens the account in the default currency.
+func (s *accountService) CreateAccount(userID uuid.UUID, accountType, accountNumber, routingNumber, currency string, initialDeposit decimal.Decimal) (*models.Account, error) {
+	currency, err := resolveCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.GetByID(userID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
@@ -75,7 +94,7 @@ func (s *accountService) CreateAccount(userID uuid.UUID, accountType, accountNum
 		return nil, ErrAccountAlreadyExists
 	}
 
-	if initialDeposit.LessThan(decimal.Zero) {
+	if initialDeposit.LessThan(decimal.Zero) || !models.HasCurrencyPrecision(initialDeposit, currency) {
 		return nil, ErrInvalidAmount
 	}
 
@@ -90,7 +109,7 @@ func (s *accountService) CreateAccount(userID uuid.UUID, accountType, accountNum
 		Balance:       initialDeposit,
 		Status:        models.AccountStatusActive,
 		RoutingNumber: routingNumber,
-		Currency:      "USD",
+		Currency:      currency,
 	}
 
 	// var transactions []models.Transaction
@@ -112,7 +131,7 @@ func (s *accountService) CreateAccount(userID uuid.UUID, accountType, accountNum
 	// }
 
 	if err := s.accountRepo.Create(account); err != nil {
-		s.logger.Error("EEEEEEEEEEEEEEEEEEEEEEEEEE", err)
+		s.logger.Error("failed to create account", "error", err)
 		return nil, fmt.Errorf("failed to create account: %w", err)
 	}
 
@@ -127,6 +146,7 @@ func (s *accountService) CreateAccount(userID uuid.UUID, accountType, accountNum
 			"account_type":   accountType,
 			"account_number": account.AccountNumber,
 			"routing_number": account.RoutingNumber,
+			"currency":       account.Currency,
 		},
 	}); err != nil {
 		s.logger.Error("failed to create audit log", "error", err, "action", "account.created")
@@ -165,7 +185,7 @@ func (s *accountService) createAccountWithSampleData(userID uuid.UUID, accountTy
 		AccountType:   accountType,
 		Balance:       decimal.Zero,
 		Status:        models.AccountStatusActive,
-		Currency:      "USD",
+		Currency:      models.DefaultCurrency,
 	}
 
 	transactions := s.generateSampleTransactions(targetBalance)
@@ -411,10 +431,14 @@ func (s *accountService) CloseAccount(accountID uuid.UUID, userID uuid.UUID) err
 		s.logger.Error("failed to create audit log", "error", err, "action", "account.closed")
 	}
 
+	s.events.Publish(models.WebhookEventAccountClosed, account.UserID, account)
+
 	return nil
 }
 
-// PerformTransaction creates a transaction on an account
+// PerformTransaction creates a transaction on an account after checking it against
+// the account's transaction limits. Debits flagged by fraud screening are not
+// posted; the returned transaction is the pending hold awaiting review.
 func (s *accountService) PerformTransaction(accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.Transaction, error) {
 	if amount.LessThanOrEqual(decimal.Zero) {
 		return nil, ErrInvalidAmount
@@ -429,35 +453,43 @@ func (s *accountService) PerformTransaction(accountID uuid.UUID, amount decimal.
 		return nil, ErrAccountNotActive
 	}
 
-	balanceBefore := account.Balance
-
-	if err := s.accountRepo.UpdateBalance(accountID, amount, transactionType); err != nil {
-		if errors.Is(err, repositories.ErrInsufficientFunds) {
-			return nil, ErrInsufficientFunds
-		}
-		return nil, fmt.Errorf("failed to update balance: %w", err)
+	activity := models.LimitActivityWithdrawal
+	if transactionType == models.TransactionTypeCredit {
+		activity = models.LimitActivityDeposit
+	}
+	if err := s.limits.CheckLimits(account, amount, activity); err != nil {
+		return nil, err
 	}
 
-	var balanceAfter decimal.Decimal
-	if transactionType == models.TransactionTypeCredit {
-		balanceAfter = balanceBefore.Add(amount)
-	} else {
-		balanceAfter = balanceBefore.Sub(amount)
+	if transactionType == models.TransactionTypeDebit {
+		hold, err := s.screener.ScreenDebit(account, amount, description)
+		if err != nil {
+			return nil, err
+		}
+		if hold != nil {
+			return hold, nil
+		}
 	}
 
 	transaction := &models.Transaction{
 		AccountID:       accountID,
 		TransactionType: transactionType,
 		Amount:          amount,
-		BalanceBefore:   balanceBefore,
-		BalanceAfter:    balanceAfter,
 		Description:     description,
 		Status:          models.TransactionStatusCompleted,
 		Reference:       models.GenerateTransactionReference(),
 	}
 
-	if err := s.transactionRepo.Create(transaction); err != nil {
-		return nil, fmt.Errorf("failed to create transaction record: %w", err)
+	// The limits are checked again under the account's row lock, in the same database
+	// transaction as the balance change, so concurrent debits cannot both fit
+	if err := s.accountRepo.PostTransaction(transaction, s.limitCheck(amount, activity)); err != nil {
+		if errors.Is(err, repositories.ErrInsufficientFunds) {
+			return nil, ErrInsufficientFunds
+		}
+		if errors.Is(err, ErrLimitExceeded) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to post transaction: %w", err)
 	}
 
 	if err := s.auditRepo.Create(&models.AuditLog{
@@ -476,10 +508,14 @@ func (s *accountService) PerformTransaction(accountID uuid.UUID, amount decimal.
 		s.logger.Error("failed to create audit log", "error", err, "action", fmt.Sprintf("transaction.%s", transactionType))
 	}
 
+	s.events.Publish(models.WebhookEventTransactionCompleted, account.UserID, transaction)
+
 	return transaction, nil
 }
 
-// TransferBetweenAccounts performs an atomic transfer with idempotency support
+// TransferBetweenAccounts performs an atomic transfer with idempotency support. The
+// transfer is checked against the source account's transaction limits and screened
+// for fraud; a flagged transfer is returned pending until it is reviewed.
 func (s *accountService) TransferBetweenAccounts(
 	fromAccountID, toAccountID uuid.UUID,
 	amount decimal.Decimal,
@@ -499,14 +535,30 @@ func (s *accountService) TransferBetweenAccounts(
 		return nil, err
 	}
 
-	transfer, debitTxID, creditTxID, err := s.executeTransfer(
-		amount, description, idempotencyKey,
-		fromAccount, toAccount,
-	)
+	if err := s.limits.CheckLimits(fromAccount, amount, models.LimitActivityTransfer); err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.createTransfer(amount, description, idempotencyKey, fromAccount, toAccount)
 	if err != nil {
-		if transfer != nil {
-			s.handleTransferFailure(transfer, err, fromAccount, toAccount, amount, idempotencyKey, userID)
-		}
+		return nil, err
+	}
+
+	held, err := s.screener.ScreenTransfer(transfer, fromAccount, toAccount)
+	if err != nil {
+		s.handleTransferFailure(transfer, err, fromAccount, toAccount, amount, idempotencyKey, userID)
+		return nil, err
+	}
+	if held {
+		return transfer, nil
+	}
+
+	// The limits are checked again under the source account's row lock, so concurrent
+	// transfers cannot both fit under the same limit
+	check := s.limitCheck(amount, models.LimitActivityTransfer)
+	debitTxID, creditTxID, err := s.moveTransferFunds(transfer, fromAccount, toAccount, check)
+	if err != nil {
+		s.handleTransferFailure(transfer, err, fromAccount, toAccount, amount, idempotencyKey, userID)
 		return nil, err
 	}
 
@@ -551,7 +603,7 @@ func (s *accountService) checkExistingTransfer(idempotencyKey string) (*models.T
 	}
 
 	switch existingTransfer.Status {
-	case models.TransferStatusCompleted:
+	case models.TransferStatusCompleted, models.TransferStatusReversed:
 		return existingTransfer, nil
 	case models.TransferStatusPending:
 		return nil, ErrTransferPending
@@ -596,36 +648,122 @@ func (s *accountService) retrieveAndAuthorizeAccounts(
 	return fromAccount, toAccount, nil
 }
 
-func (s *accountService) executeTransfer(
+// CompleteHeldTransfer moves the funds of a pending transfer released by fraud
+// review. The transfer is failed if the funds cannot be moved.
+func (s *accountService) CompleteHeldTransfer(transferID, userID uuid.UUID) (*models.Transfer, error) {
+	transfer, err := s.transferRepo.FindByID(transferID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer: %w", err)
+	}
+
+	if transfer.Status != models.TransferStatusPending {
+		return nil, ErrTransferNotPending
+	}
+
+	fromAccount, toAccount, err := s.retrieveAndAuthorizeAccounts(transfer.FromAccountID, transfer.ToAccountID, userID)
+	if err != nil {
+		transfer.Fail(err.Error())
+		if updateErr := s.transferRepo.Update(transfer); updateErr != nil {
+			s.logger.Error("failed to update transfer status", "error", updateErr, "transfer_id", transfer.ID)
+		}
+		s.events.Publish(models.WebhookEventTransferFailed, userID, transfer)
+		return nil, err
+	}
+
+	// The limits were checked when the transfer was requested
+	debitTxID, creditTxID, err := s.moveTransferFunds(transfer, fromAccount, toAccount, nil)
+	if err != nil {
+		s.handleTransferFailure(transfer, err, fromAccount, toAccount, transfer.Amount, transfer.IdempotencyKey, userID)
+		return nil, err
+	}
+
+	if err := s.handleTransferSuccess(transfer, debitTxID, creditTxID, fromAccount, toAccount, transfer.Amount, transfer.IdempotencyKey, userID); err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// createTransfer records a pending transfer. A transfer between accounts in
+// different currencies locks the current exchange rate before it is recorded; the
+// destination will be credited the converted amount rounded to its currency's
+// minor units.
+func (s *accountService) createTransfer(
 	amount decimal.Decimal,
 	description, idempotencyKey string,
 	fromAccount, toAccount *models.Account,
-) (*models.Transfer, uuid.UUID, uuid.UUID, error) {
+) (*models.Transfer, error) {
 	transfer := &models.Transfer{
 		FromAccountID:  fromAccount.ID,
 		ToAccountID:    toAccount.ID,
 		Amount:         amount,
+		FromCurrency:   fromAccount.CurrencyOrDefault(),
+		ToCurrency:     toAccount.CurrencyOrDefault(),
 		Description:    description,
 		IdempotencyKey: idempotencyKey,
 		Status:         models.TransferStatusPending,
 	}
 
+	if !models.HasCurrencyPrecision(amount, transfer.FromCurrency) {
+		return nil, ErrInvalidAmount
+	}
+
+	if transfer.IsCrossCurrency() {
+		rate, err := s.rateProvider.GetRate(transfer.FromCurrency, transfer.ToCurrency)
+		if err != nil {
+			return nil, err
+		}
+		transfer.LockRate(rate)
+
+		// Amounts too small to be represented in the destination currency
+		if !transfer.CreditAmount().IsPositive() {
+			return nil, ErrInvalidAmount
+		}
+	}
+
 	if err := s.transferRepo.Create(transfer); err != nil {
-		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("failed to create transfer: %w", err)
+		return nil, fmt.Errorf("failed to create transfer: %w", err)
 	}
 
-	fromDescription := fmt.Sprintf("Transfer to %s: %s", toAccount.AccountNumber, description)
-	toDescription := fmt.Sprintf("Transfer from %s: %s", fromAccount.AccountNumber, description)
+	return transfer, nil
+}
 
-	debitTxID, creditTxID, err := s.accountRepo.ExecuteAtomicTransfer(
+// moveTransferFunds debits the source and credits the destination of a recorded
+// transfer in one database transaction, at the transfer's locked rate if any. check,
+// if set, runs against the locked source account.
+func (s *accountService) moveTransferFunds(transfer *models.Transfer, fromAccount, toAccount *models.Account, check repositories.AccountCheck) (uuid.UUID, uuid.UUID, error) {
+	fromDescription := fmt.Sprintf("Transfer to %s: %s", toAccount.AccountNumber, transfer.Description)
+	toDescription := fmt.Sprintf("Transfer from %s: %s", fromAccount.AccountNumber, transfer.Description)
+
+	if transfer.IsCrossCurrency() {
+		return s.accountRepo.ExecuteAtomicFXTransfer(
+			fromAccount.ID,
+			toAccount.ID,
+			transfer.Amount,
+			transfer.CreditAmount(),
+			*transfer.ExchangeRate,
+			fromDescription,
+			toDescription,
+			check,
+		)
+	}
+
+	return s.accountRepo.ExecuteAtomicTransfer(
 		fromAccount.ID,
 		toAccount.ID,
-		amount,
+		transfer.Amount,
 		fromDescription,
 		toDescription,
+		check,
 	)
+}
 
-	return transfer, debitTxID, creditTxID, err
+// limitCheck checks an account's limits from inside the database transaction that
+// moves its funds, once the account row is locked
+func (s *accountService) limitCheck(amount decimal.Decimal, activity string) repositories.AccountCheck {
+	return func(account *models.Account, usage repositories.LimitUsageReader) error {
+		return s.limits.CheckLimitsWithin(usage, account, amount, activity)
+	}
 }
 
 func (s *accountService) handleTransferFailure(
@@ -659,6 +797,8 @@ func (s *accountService) handleTransferFailure(
 	}); err != nil {
 		s.logger.Error("failed to create audit log", "error", err, "action", "transfer.failed")
 	}
+
+	s.events.Publish(models.WebhookEventTransferFailed, fromAccount.UserID, transfer)
 }
 
 func (s *accountService) handleTransferSuccess(
@@ -687,11 +827,15 @@ func (s *accountService) handleTransferSuccess(
 			"amount":          amount.String(),
 			"transfer_id":     transfer.ID.String(),
 			"idempotency_key": idempotencyKey,
+			"from_currency":   transfer.FromCurrency,
+			"to_currency":     transfer.ToCurrency,
 		},
 	}); err != nil {
 		s.logger.Error("failed to create audit log", "error", err, "action", "transfer.completed")
 	}
 
+	s.events.Publish(models.WebhookEventTransferCompleted, fromAccount.UserID, transfer)
+
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"array-assessment/internal/models"
 	"array-assessment/internal/repositories"
 	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -39,11 +40,22 @@ func (s *AccountServiceSuite) SetupTest() {
 	s.transactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
 	s.userRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
 	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	limits := service_mocks.NewMockTransactionLimitChecker(s.ctrl)
+	limits.EXPECT().CheckLimits(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	screener := service_mocks.NewMockTransactionScreener(s.ctrl)
+	screener.EXPECT().ScreenDebit(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	screener.EXPECT().ScreenTransfer(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	events := service_mocks.NewMockEventPublisher(s.ctrl)
+	events.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	s.service = NewAccountService(s.accountRepo,
 		s.transactionRepo,
 		s.transferRepo,
 		s.userRepo,
 		s.auditRepo,
+		nil,
+		limits,
+		screener,
+		events,
 		slog.Default()).(*accountService)
 
 	// Setup common test data
@@ -74,23 +86,16 @@ func (s *AccountServiceSuite) TestCreateAccount_WithInitialDeposit() {
 	// Setup expectations
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(s.testUser, nil)
 	s.accountRepo.EXPECT().ExistsForUser(s.testUserID, "checking").Return(false, nil)
-	s.accountRepo.EXPECT().GenerateUniqueAccountNumber("checking").Return("1012345678", nil)
-	s.accountRepo.EXPECT().CreateWithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
-		func(account *models.Account, transactions []models.Transaction) error {
+	s.accountRepo.EXPECT().Create(gomock.Any()).DoAndReturn(
+		func(account *models.Account) error {
 			account.ID = s.testAccountID
 			account.CreatedAt = s.testTime
 			account.UpdatedAt = s.testTime
-			for i := range transactions {
-				transactions[i].ID = uuid.New()
-				transactions[i].AccountID = s.testAccountID
-				transactions[i].CreatedAt = s.testTime
-				transactions[i].UpdatedAt = s.testTime
-			}
 			return nil
 		})
 	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
 
-	account, err := s.service.CreateAccount(s.testUserID, "checking", decimal.NewFromFloat(100))
+	account, err := s.service.CreateAccount(s.testUserID, "checking", "1012345678", "021000021", "", decimal.NewFromFloat(100))
 	s.NoError(err)
 	s.NotNil(account)
 	s.Equal(s.testUserID, account.UserID)
@@ -103,9 +108,8 @@ func (s *AccountServiceSuite) TestCreateAccount_WithInitialDeposit() {
 func (s *AccountServiceSuite) TestCreateAccount_WithoutInitialDeposit() {
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(s.testUser, nil)
 	s.accountRepo.EXPECT().ExistsForUser(s.testUserID, "savings").Return(false, nil)
-	s.accountRepo.EXPECT().GenerateUniqueAccountNumber("savings").Return("2012345679", nil)
-	s.accountRepo.EXPECT().CreateWithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
-		func(account *models.Account, transactions []models.Transaction) error {
+	s.accountRepo.EXPECT().Create(gomock.Any()).DoAndReturn(
+		func(account *models.Account) error {
 			account.ID = s.testAccountID
 			account.CreatedAt = s.testTime
 			account.UpdatedAt = s.testTime
@@ -113,7 +117,7 @@ func (s *AccountServiceSuite) TestCreateAccount_WithoutInitialDeposit() {
 		})
 	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
 
-	account, err := s.service.CreateAccount(s.testUserID, "savings", decimal.Zero)
+	account, err := s.service.CreateAccount(s.testUserID, "savings", "2012345679", "021000021", "", decimal.Zero)
 	s.NoError(err)
 	s.NotNil(account)
 	s.Equal(decimal.Zero, account.Balance)
@@ -122,7 +126,7 @@ func (s *AccountServiceSuite) TestCreateAccount_WithoutInitialDeposit() {
 func (s *AccountServiceSuite) TestCreateAccount_UserNotFound() {
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(nil, repositories.ErrUserNotFound)
 
-	account, err := s.service.CreateAccount(s.testUserID, "checking", decimal.Zero)
+	account, err := s.service.CreateAccount(s.testUserID, "checking", "1012345678", "021000021", "", decimal.Zero)
 	s.Error(err)
 	s.Nil(account)
 	s.Equal(ErrUserNotFound, err)
@@ -132,7 +136,7 @@ func (s *AccountServiceSuite) TestCreateAccount_NegativeInitialDeposit() {
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(s.testUser, nil)
 	s.accountRepo.EXPECT().ExistsForUser(s.testUserID, "checking").Return(false, nil)
 
-	account, err := s.service.CreateAccount(s.testUserID, "checking", decimal.NewFromFloat(-100))
+	account, err := s.service.CreateAccount(s.testUserID, "checking", "1012345678", "021000021", "", decimal.NewFromFloat(-100))
 	s.Error(err)
 	s.Nil(account)
 	s.Equal(ErrInvalidAmount, err)
@@ -142,7 +146,7 @@ func (s *AccountServiceSuite) TestCreateAccount_AccountAlreadyExists() {
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(s.testUser, nil)
 	s.accountRepo.EXPECT().ExistsForUser(s.testUserID, "checking").Return(true, nil)
 
-	account, err := s.service.CreateAccount(s.testUserID, "checking", decimal.Zero)
+	account, err := s.service.CreateAccount(s.testUserID, "checking", "1012345678", "021000021", "", decimal.Zero)
 	s.Error(err)
 	s.Nil(account)
 	s.Equal(ErrAccountAlreadyExists, err)
@@ -256,9 +260,8 @@ func (s *AccountServiceSuite) TestPerformTransaction_Credit() {
 	}
 
 	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
-	s.accountRepo.EXPECT().UpdateBalance(s.testAccountID, decimal.NewFromFloat(50), "credit").Return(nil)
-	s.transactionRepo.EXPECT().Create(gomock.Any()).DoAndReturn(
-		func(t *models.Transaction) error {
+	s.accountRepo.EXPECT().PostTransaction(gomock.Any(), gomock.Not(gomock.Nil())).DoAndReturn(
+		func(t *models.Transaction, check repositories.AccountCheck) error {
 			t.ID = uuid.New()
 			t.CreatedAt = s.testTime
 			t.UpdatedAt = s.testTime
@@ -284,9 +287,8 @@ func (s *AccountServiceSuite) TestPerformTransaction_Debit() {
 	}
 
 	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
-	s.accountRepo.EXPECT().UpdateBalance(s.testAccountID, decimal.NewFromFloat(100), "debit").Return(nil)
-	s.transactionRepo.EXPECT().Create(gomock.Any()).DoAndReturn(
-		func(t *models.Transaction) error {
+	s.accountRepo.EXPECT().PostTransaction(gomock.Any(), gomock.Not(gomock.Nil())).DoAndReturn(
+		func(t *models.Transaction, check repositories.AccountCheck) error {
 			t.ID = uuid.New()
 			t.CreatedAt = s.testTime
 			t.UpdatedAt = s.testTime
@@ -312,7 +314,7 @@ func (s *AccountServiceSuite) TestPerformTransaction_InsufficientFunds() {
 	}
 
 	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
-	s.accountRepo.EXPECT().UpdateBalance(s.testAccountID, decimal.NewFromFloat(1000), "debit").Return(repositories.ErrInsufficientFunds)
+	s.accountRepo.EXPECT().PostTransaction(gomock.Any(), gomock.Any()).Return(repositories.ErrInsufficientFunds)
 
 	transaction, err := s.service.PerformTransaction(s.testAccountID, decimal.NewFromFloat(1000), "debit", "Large withdrawal", &s.testUserID)
 	s.Error(err)
@@ -398,6 +400,7 @@ func (s *AccountServiceSuite) TestTransferBetweenAccounts_Success() {
 			amount,
 			gomock.Any(), // fromDescription
 			gomock.Any(), // toDescription
+			gomock.Not(gomock.Nil()),
 		).
 		Return(debitTxID, creditTxID, nil)
 
@@ -2,12 +2,14 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"testing"
 
 	"array-assessment/internal/models"
 	"array-assessment/internal/repositories"
 	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -25,6 +27,11 @@ type TransferServiceTestSuite struct {
 	transferRepo    *repository_mocks.MockTransferRepositoryInterface
 	userRepo        *repository_mocks.MockUserRepositoryInterface
 	auditRepo       *repository_mocks.MockAuditLogRepositoryInterface
+	rateProvider    *service_mocks.MockRateProvider
+	limits          *service_mocks.MockTransactionLimitChecker
+	screener        *service_mocks.MockTransactionScreener
+	events          *service_mocks.MockEventPublisher
+	published       []string
 	db              *gorm.DB
 	service         AccountServiceInterface
 }
@@ -37,6 +44,17 @@ func (s *TransferServiceTestSuite) SetupTest() {
 	s.transferRepo = repository_mocks.NewMockTransferRepositoryInterface(s.ctrl)
 	s.userRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
 	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.rateProvider = service_mocks.NewMockRateProvider(s.ctrl)
+	s.limits = service_mocks.NewMockTransactionLimitChecker(s.ctrl)
+	s.limits.EXPECT().CheckLimits(gomock.Any(), gomock.Any(), models.LimitActivityTransfer).Return(nil).AnyTimes()
+	s.screener = service_mocks.NewMockTransactionScreener(s.ctrl)
+	s.screener.EXPECT().ScreenTransfer(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	s.published = nil
+	s.events = service_mocks.NewMockEventPublisher(s.ctrl)
+	s.events.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(eventType string, _ uuid.UUID, _ interface{}) {
+			s.published = append(s.published, eventType)
+		}).AnyTimes()
 
 	// Create service with mocked repositories
 	s.service = NewAccountService(
@@ -45,6 +63,10 @@ func (s *TransferServiceTestSuite) SetupTest() {
 		s.transferRepo,
 		s.userRepo,
 		s.auditRepo,
+		s.rateProvider,
+		s.limits,
+		s.screener,
+		s.events,
 		slog.Default(),
 	)
 }
@@ -123,6 +145,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_Success() {
 			amount,
 			gomock.Any(), // fromDescription
 			gomock.Any(), // toDescription
+			gomock.Not(gomock.Nil()),
 		).
 		Return(debitTxID, creditTxID, nil)
 
@@ -153,6 +176,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_Success() {
 	s.NoError(err)
 	s.NotNil(result)
 	s.Equal(models.TransferStatusCompleted, result.Status)
+	s.Equal([]string{models.WebhookEventTransferCompleted}, s.published)
 }
 
 // TestTransferBetweenAccounts_IdempotencyKeyExists_Completed tests idempotent behavior for completed transfer
@@ -315,6 +339,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InsufficientFunds
 			amount,
 			gomock.Any(), // fromDescription
 			gomock.Any(), // toDescription
+			gomock.Not(gomock.Nil()),
 		).
 		Return(uuid.Nil, uuid.Nil, repositories.ErrInsufficientFunds)
 
@@ -343,6 +368,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InsufficientFunds
 	s.Error(err)
 	s.Nil(result)
 	s.Contains(err.Error(), "insufficient funds")
+	s.Equal([]string{models.WebhookEventTransferFailed}, s.published)
 }
 
 // TestTransferBetweenAccounts_SameAccount tests validation for same account transfer
@@ -571,6 +597,66 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InactiveSourceAcc
 	s.Equal(ErrAccountNotActive, err)
 }
 
+// TestTransferBetweenAccounts_LimitExceeded tests that transfers over the source
+// account's limits are rejected before any funds move
+func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_LimitExceeded() {
+	userID := uuid.New()
+	fromAccountID := uuid.New()
+	toAccountID := uuid.New()
+	amount := decimal.NewFromFloat(5000.00)
+	idempotencyKey := uuid.New().String()
+
+	fromAccount := &models.Account{
+		ID:          fromAccountID,
+		UserID:      userID,
+		AccountType: models.AccountTypeChecking,
+		Balance:     decimal.NewFromFloat(10000.00),
+		Status:      models.AccountStatusActive,
+	}
+
+	toAccount := &models.Account{
+		ID:          toAccountID,
+		UserID:      uuid.New(),
+		AccountType: models.AccountTypeSavings,
+		Status:      models.AccountStatusActive,
+	}
+
+	limits := service_mocks.NewMockTransactionLimitChecker(s.ctrl)
+	service := NewAccountService(
+		s.accountRepo,
+		s.transactionRepo,
+		s.transferRepo,
+		s.userRepo,
+		s.auditRepo,
+		s.rateProvider,
+		limits,
+		s.screener,
+		s.events,
+		slog.Default(),
+	)
+
+	s.transferRepo.EXPECT().
+		FindByIdempotencyKey(idempotencyKey).
+		Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(fromAccountID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(toAccountID).Return(toAccount, nil)
+	limits.EXPECT().
+		CheckLimits(fromAccount, amount, models.LimitActivityTransfer).
+		Return(fmt.Errorf("%w: transfers may not exceed 2500.00", ErrLimitExceeded))
+
+	result, err := service.TransferBetweenAccounts(
+		fromAccountID,
+		toAccountID,
+		amount,
+		"Large transfer",
+		idempotencyKey,
+		userID,
+	)
+
+	s.ErrorIs(err, ErrLimitExceeded)
+	s.Nil(result)
+}
+
 // TestTransferBetweenAccounts_TransactionRollback tests database rollback
 func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_TransactionRollback() {
 	userID := uuid.New()
@@ -623,3 +709,258 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_TransactionRollba
 	s.Error(err)
 	s.Nil(result)
 }
+
+// TestTransferBetweenAccounts_CrossCurrency tests that a cross-currency transfer locks the rate and credits the converted amount
+func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_CrossCurrency() {
+	userID := uuid.New()
+	fromAccountID := uuid.New()
+	toAccountID := uuid.New()
+	amount := decimal.RequireFromString("100.00")
+	idempotencyKey := uuid.New().String()
+	debitTxID := uuid.New()
+	creditTxID := uuid.New()
+	rate := decimal.RequireFromString("0.9123")
+
+	fromAccount := &models.Account{
+		ID:            fromAccountID,
+		UserID:        userID,
+		AccountNumber: "1012345678",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(500.00),
+		Currency:      "USD",
+		Status:        models.AccountStatusActive,
+	}
+
+	toAccount := &models.Account{
+		ID:            toAccountID,
+		UserID:        uuid.New(),
+		AccountNumber: "2023456789",
+		AccountType:   models.AccountTypeSavings,
+		Balance:       decimal.Zero,
+		Currency:      "EUR",
+		Status:        models.AccountStatusActive,
+	}
+
+	s.transferRepo.EXPECT().
+		FindByIdempotencyKey(idempotencyKey).
+		Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(fromAccountID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(toAccountID).Return(toAccount, nil)
+
+	s.rateProvider.EXPECT().
+		GetRate("USD", "EUR").
+		Return(&models.ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: rate}, nil)
+
+	s.transferRepo.EXPECT().
+		Create(gomock.Any()).
+		DoAndReturn(func(transfer *models.Transfer) error {
+			s.Equal("USD", transfer.FromCurrency)
+			s.Equal("EUR", transfer.ToCurrency)
+			s.Require().NotNil(transfer.ExchangeRate)
+			s.True(rate.Equal(*transfer.ExchangeRate))
+			s.NotNil(transfer.RateLockedAt)
+			transfer.ID = uuid.New()
+			return nil
+		})
+
+	s.accountRepo.EXPECT().
+		ExecuteAtomicFXTransfer(
+			fromAccountID,
+			toAccountID,
+			amount,
+			decimal.RequireFromString("91.23"),
+			rate,
+			gomock.Any(),
+			gomock.Any(),
+			gomock.Not(gomock.Nil()),
+		).
+		Return(debitTxID, creditTxID, nil)
+
+	s.transferRepo.EXPECT().Update(gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	result, err := s.service.TransferBetweenAccounts(
+		fromAccountID,
+		toAccountID,
+		amount,
+		"FX transfer",
+		idempotencyKey,
+		userID,
+	)
+
+	s.NoError(err)
+	s.Require().NotNil(result)
+	s.Equal("91.23", result.ConvertedAmount.StringFixed(2))
+}
+
+// TestTransferBetweenAccounts_CrossCurrency_RateUnavailable tests that no transfer is recorded without a rate
+func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_CrossCurrency_RateUnavailable() {
+	userID := uuid.New()
+	fromAccountID := uuid.New()
+	toAccountID := uuid.New()
+	idempotencyKey := uuid.New().String()
+
+	fromAccount := &models.Account{
+		ID:       fromAccountID,
+		UserID:   userID,
+		Balance:  decimal.NewFromFloat(500.00),
+		Currency: "USD",
+		Status:   models.AccountStatusActive,
+	}
+
+	toAccount := &models.Account{
+		ID:       toAccountID,
+		UserID:   uuid.New(),
+		Currency: "CHF",
+		Status:   models.AccountStatusActive,
+	}
+
+	s.transferRepo.EXPECT().
+		FindByIdempotencyKey(idempotencyKey).
+		Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(fromAccountID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(toAccountID).Return(toAccount, nil)
+	s.rateProvider.EXPECT().
+		GetRate("USD", "CHF").
+		Return(nil, ErrExchangeRateUnavailable)
+
+	result, err := s.service.TransferBetweenAccounts(
+		fromAccountID,
+		toAccountID,
+		decimal.NewFromFloat(10.00),
+		"FX transfer",
+		idempotencyKey,
+		userID,
+	)
+
+	s.ErrorIs(err, ErrExchangeRateUnavailable)
+	s.Nil(result)
+}
+
+// TestTransferBetweenAccounts_HeldForReview tests that a flagged transfer stays pending without moving funds
+func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_HeldForReview() {
+	userID := uuid.New()
+	fromAccount := &models.Account{
+		ID:          uuid.New(),
+		UserID:      userID,
+		AccountType: models.AccountTypeChecking,
+		Balance:     decimal.NewFromFloat(10000.00),
+		Status:      models.AccountStatusActive,
+	}
+	toAccount := &models.Account{
+		ID:          uuid.New(),
+		UserID:      uuid.New(),
+		AccountType: models.AccountTypeSavings,
+		Status:      models.AccountStatusActive,
+	}
+	amount := decimal.NewFromFloat(5000.00)
+	idempotencyKey := uuid.New().String()
+
+	screener := service_mocks.NewMockTransactionScreener(s.ctrl)
+	service := NewAccountService(
+		s.accountRepo,
+		s.transactionRepo,
+		s.transferRepo,
+		s.userRepo,
+		s.auditRepo,
+		s.rateProvider,
+		s.limits,
+		screener,
+		s.events,
+		slog.Default(),
+	)
+
+	s.transferRepo.EXPECT().
+		FindByIdempotencyKey(idempotencyKey).
+		Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(fromAccount.ID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(toAccount.ID).Return(toAccount, nil)
+	s.transferRepo.EXPECT().
+		Create(gomock.Any()).
+		DoAndReturn(func(transfer *models.Transfer) error {
+			transfer.ID = uuid.New()
+			return nil
+		})
+	screener.EXPECT().ScreenTransfer(gomock.Any(), fromAccount, toAccount).Return(true, nil)
+
+	result, err := service.TransferBetweenAccounts(
+		fromAccount.ID,
+		toAccount.ID,
+		amount,
+		"Large transfer",
+		idempotencyKey,
+		userID,
+	)
+
+	s.NoError(err)
+	s.Require().NotNil(result)
+	s.Equal(models.TransferStatusPending, result.Status)
+}
+
+// TestCompleteHeldTransfer_Success tests moving the funds of a transfer released by fraud review
+func (s *TransferServiceTestSuite) TestCompleteHeldTransfer_Success() {
+	userID := uuid.New()
+	debitTxID := uuid.New()
+	creditTxID := uuid.New()
+	fromAccount := &models.Account{ID: uuid.New(), UserID: userID, Status: models.AccountStatusActive}
+	toAccount := &models.Account{ID: uuid.New(), UserID: uuid.New(), Status: models.AccountStatusActive}
+	transfer := &models.Transfer{
+		ID:            uuid.New(),
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        decimal.NewFromFloat(5000.00),
+		Status:        models.TransferStatusPending,
+	}
+
+	s.transferRepo.EXPECT().FindByID(transfer.ID).Return(transfer, nil)
+	s.accountRepo.EXPECT().GetByID(fromAccount.ID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(toAccount.ID).Return(toAccount, nil)
+	s.accountRepo.EXPECT().
+		ExecuteAtomicTransfer(fromAccount.ID, toAccount.ID, transfer.Amount, gomock.Any(), gomock.Any(), nil).
+		Return(debitTxID, creditTxID, nil)
+	s.transferRepo.EXPECT().Update(transfer).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	result, err := s.service.CompleteHeldTransfer(transfer.ID, userID)
+
+	s.NoError(err)
+	s.Require().NotNil(result)
+	s.Equal(models.TransferStatusCompleted, result.Status)
+	s.Equal(&debitTxID, result.DebitTransactionID)
+}
+
+// TestCompleteHeldTransfer_NotPending tests that only pending transfers are completed
+func (s *TransferServiceTestSuite) TestCompleteHeldTransfer_NotPending() {
+	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusFailed}
+	s.transferRepo.EXPECT().FindByID(transfer.ID).Return(transfer, nil)
+
+	result, err := s.service.CompleteHeldTransfer(transfer.ID, uuid.New())
+
+	s.ErrorIs(err, ErrTransferNotPending)
+	s.Nil(result)
+}
+
+// TestCompleteHeldTransfer_AccountClosed tests that a transfer is failed when an account closed during review
+func (s *TransferServiceTestSuite) TestCompleteHeldTransfer_AccountClosed() {
+	userID := uuid.New()
+	fromAccount := &models.Account{ID: uuid.New(), UserID: userID, Status: models.AccountStatusActive}
+	toAccount := &models.Account{ID: uuid.New(), UserID: uuid.New(), Status: models.AccountStatusClosed}
+	transfer := &models.Transfer{
+		ID:            uuid.New(),
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        decimal.NewFromFloat(5000.00),
+		Status:        models.TransferStatusPending,
+	}
+
+	s.transferRepo.EXPECT().FindByID(transfer.ID).Return(transfer, nil)
+	s.accountRepo.EXPECT().GetByID(fromAccount.ID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(toAccount.ID).Return(toAccount, nil)
+	s.transferRepo.EXPECT().Update(transfer).Return(nil)
+
+	result, err := s.service.CompleteHeldTransfer(transfer.ID, userID)
+
+	s.ErrorIs(err, ErrAccountNotActive)
+	s.Nil(result)
+	s.Equal(models.TransferStatusFailed, transfer.Status)
+}
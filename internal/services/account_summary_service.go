@@ -17,27 +17,37 @@ var (
 )
 
 type accountSummaryService struct {
-	accountRepo repositories.AccountRepositoryInterface
-	userRepo    repositories.UserRepositoryInterface
+	accountRepo  repositories.AccountRepositoryInterface
+	userRepo     repositories.UserRepositoryInterface
+	rateProvider RateProvider
 }
 
 func NewAccountSummaryService(
 	accountRepo repositories.AccountRepositoryInterface,
 	userRepo repositories.UserRepositoryInterface,
+	rateProvider RateProvider,
 ) AccountSummaryServiceInterface {
 	return &accountSummaryService{
-		accountRepo: accountRepo,
-		userRepo:    userRepo,
+		accountRepo:  accountRepo,
+		userRepo:     userRepo,
+		rateProvider: rateProvider,
 	}
 }
 
-func (s *accountSummaryService) GetAccountSummary(requestorID uuid.UUID, targetUserID *uuid.UUID, isAdmin bool) (*models.UserAccountSummary, error) {
+// GetAccountSummary lists a user's accounts with their total balance converted into
+// baseCurrency, or the default currency when baseCurrency is empty
+func (s *accountSummaryService) GetAccountSummary(requestorID uuid.UUID, targetUserID *uuid.UUID, baseCurrency string, canViewAll bool) (*models.UserAccountSummary, error) {
+	baseCurrency, err := resolveCurrency(baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+
 	requestor, err := s.validateRequestor(requestorID)
 	if err != nil {
 		return nil, err
 	}
 
-	effectiveUserID, err := s.determineTargetUserID(requestorID, targetUserID, requestor, isAdmin)
+	effectiveUserID, err := s.determineTargetUserID(requestorID, targetUserID, requestor, canViewAll)
 	if err != nil {
 		return nil, err
 	}
@@ -47,12 +57,16 @@ func (s *accountSummaryService) GetAccountSummary(requestorID uuid.UUID, targetU
 		return nil, err
 	}
 
-	summary := s.buildAccountSummary(effectiveUserID, accounts)
+	summary, err := s.buildAccountSummary(effectiveUserID, accounts, baseCurrency)
+	if err != nil {
+		return nil, err
+	}
 
 	slog.Info("account summary generated",
 		"user_id", effectiveUserID,
 		"account_count", len(accounts),
-		"total_balance", summary.TotalBalance.String())
+		"total_balance", summary.TotalBalance.String(),
+		"currency", summary.Currency)
 
 	return summary, nil
 }
@@ -78,13 +92,13 @@ func (s *accountSummaryService) determineTargetUserID(
 	requestorID uuid.UUID,
 	targetUserID *uuid.UUID,
 	requestor *models.User,
-	isAdmin bool,
+	canViewAll bool,
 ) (uuid.UUID, error) {
 	if targetUserID == nil || *targetUserID == requestorID {
 		return requestorID, nil
 	}
 
-	if err := s.authorizeAdminAccess(requestorID, *targetUserID, requestor, isAdmin); err != nil {
+	if err := s.authorizeStaffAccess(requestorID, *targetUserID, requestor, canViewAll); err != nil {
 		return uuid.Nil, err
 	}
 
@@ -92,23 +106,23 @@ func (s *accountSummaryService) determineTargetUserID(
 		return uuid.Nil, err
 	}
 
-	s.logAdminAccess(requestorID, requestor.Email, *targetUserID)
+	s.logStaffAccess(requestorID, requestor.Email, *targetUserID)
 
 	return *targetUserID, nil
 }
 
-func (s *accountSummaryService) authorizeAdminAccess(
+func (s *accountSummaryService) authorizeStaffAccess(
 	requestorID uuid.UUID,
 	targetUserID uuid.UUID,
 	requestor *models.User,
-	isAdmin bool,
+	canViewAll bool,
 ) error {
-	if !isAdmin || requestor.Role != models.RoleAdmin {
+	if !canViewAll {
 		slog.Warn("unauthorized access attempt to account summary",
 			"requestor_id", requestorID,
 			"requestor_role", requestor.Role,
 			"target_user_id", targetUserID,
-			"is_admin", isAdmin)
+			"can_view_all", canViewAll)
 		return ErrUnauthorized
 	}
 	return nil
@@ -118,7 +132,7 @@ func (s *accountSummaryService) validateTargetUser(targetUserID uuid.UUID) error
 	_, err := s.userRepo.GetByID(targetUserID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
-			slog.Warn("target user not found during admin account summary request",
+			slog.Warn("target user not found during staff account summary request",
 				"target_user_id", targetUserID,
 				"error", err)
 			return ErrNotFound
@@ -131,10 +145,10 @@ func (s *accountSummaryService) validateTargetUser(targetUserID uuid.UUID) error
 	return nil
 }
 
-func (s *accountSummaryService) logAdminAccess(adminID uuid.UUID, adminEmail string, targetUserID uuid.UUID) {
-	slog.Info("admin accessing user account summary",
-		"admin_id", adminID,
-		"admin_email", adminEmail,
+func (s *accountSummaryService) logStaffAccess(staffID uuid.UUID, staffEmail string, targetUserID uuid.UUID) {
+	slog.Info("staff accessing user account summary",
+		"staff_id", staffID,
+		"staff_email", staffEmail,
 		"target_user_id", targetUserID)
 }
 
@@ -149,24 +163,37 @@ func (s *accountSummaryService) fetchUserAccounts(userID uuid.UUID) ([]models.Ac
 	return accounts, nil
 }
 
-func (s *accountSummaryService) buildAccountSummary(userID uuid.UUID, accounts []models.Account) *models.UserAccountSummary {
+func (s *accountSummaryService) buildAccountSummary(userID uuid.UUID, accounts []models.Account, baseCurrency string) (*models.UserAccountSummary, error) {
 	totalBalance := decimal.Zero
 	accountItems := make([]models.AccountSummaryItem, 0, len(accounts))
 
 	for i := range accounts {
 		account := &accounts[i]
-		totalBalance = totalBalance.Add(account.Balance)
-		accountItems = append(accountItems, s.createAccountSummaryItem(account))
+		item := s.createAccountSummaryItem(account)
+
+		converted, err := convertCurrency(s.rateProvider, account.Balance, account.CurrencyOrDefault(), baseCurrency)
+		if err != nil {
+			slog.Error("failed to convert account balance for summary",
+				"account_id", account.ID,
+				"currency", account.CurrencyOrDefault(),
+				"base_currency", baseCurrency,
+				"error", err)
+			return nil, err
+		}
+		item.ConvertedBalance = converted
+
+		totalBalance = totalBalance.Add(converted)
+		accountItems = append(accountItems, item)
 	}
 
 	return &models.UserAccountSummary{
 		UserID:       userID,
 		TotalBalance: totalBalance,
 		AccountCount: len(accounts),
-		Currency:     "USD",
+		Currency:     baseCurrency,
 		Accounts:     accountItems,
 		GeneratedAt:  fmt.Sprintf("%d", 0),
-	}
+	}, nil
 }
 
 func (s *accountSummaryService) createAccountSummaryItem(account *models.Account) models.AccountSummaryItem {
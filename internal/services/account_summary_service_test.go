@@ -7,6 +7,7 @@ import (
 	"array-assessment/internal/models"
 	"array-assessment/internal/repositories"
 	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -20,6 +21,7 @@ type AccountSummaryServiceSuite struct {
 	ctrl        *gomock.Controller
 	accountRepo *repository_mocks.MockAccountRepositoryInterface
 	userRepo    *repository_mocks.MockUserRepositoryInterface
+	rates       *service_mocks.MockRateProvider
 	service     AccountSummaryServiceInterface
 	testUserID  uuid.UUID
 	testAdminID uuid.UUID
@@ -31,7 +33,8 @@ func (s *AccountSummaryServiceSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.accountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
 	s.userRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
-	s.service = NewAccountSummaryService(s.accountRepo, s.userRepo)
+	s.rates = service_mocks.NewMockRateProvider(s.ctrl)
+	s.service = NewAccountSummaryService(s.accountRepo, s.userRepo, s.rates)
 
 	// Setup common test data
 	s.testUserID = uuid.New()
@@ -87,7 +90,7 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_RegularUser_Success()
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(testUser, nil)
 	s.accountRepo.EXPECT().GetByUserID(s.testUserID).Return(accounts, nil)
 
-	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, false)
+	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, "", false)
 	s.NoError(err)
 	s.NotNil(summary)
 	s.Equal(s.testUserID, summary.UserID)
@@ -140,7 +143,7 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_AdminUser_Success() {
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(targetUser, nil)
 	s.accountRepo.EXPECT().GetByUserID(s.testUserID).Return(accounts, nil)
 
-	summary, err := s.service.GetAccountSummary(s.testAdminID, &s.testUserID, true)
+	summary, err := s.service.GetAccountSummary(s.testAdminID, &s.testUserID, "", true)
 	s.NoError(err)
 	s.NotNil(summary)
 	s.Equal(s.testUserID, summary.UserID)
@@ -148,6 +151,20 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_AdminUser_Success() {
 	s.True(decimal.NewFromFloat(2500.00).Equal(summary.TotalBalance), "expected total balance to be 2500.00")
 }
 
+// Test GetAccountSummary for staff whose role is not admin but who can read customers
+func (s *AccountSummaryServiceSuite) TestGetAccountSummary_SupportUser_Success() {
+	supportUser := &models.User{ID: s.testAdminID, Email: "support@example.com", Role: models.RoleSupport}
+	targetUser := &models.User{ID: s.testUserID, Email: "test@example.com", Role: models.RoleCustomer}
+
+	s.userRepo.EXPECT().GetByID(s.testAdminID).Return(supportUser, nil)
+	s.userRepo.EXPECT().GetByID(s.testUserID).Return(targetUser, nil)
+	s.accountRepo.EXPECT().GetByUserID(s.testUserID).Return([]models.Account{}, nil)
+
+	summary, err := s.service.GetAccountSummary(s.testAdminID, &s.testUserID, "", true)
+	s.NoError(err)
+	s.Equal(s.testUserID, summary.UserID)
+}
+
 // Test GetAccountSummary for regular user attempting to access another user's accounts
 func (s *AccountSummaryServiceSuite) TestGetAccountSummary_UnauthorizedAccess() {
 	testUser := &models.User{
@@ -162,7 +179,7 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_UnauthorizedAccess()
 
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(testUser, nil)
 
-	summary, err := s.service.GetAccountSummary(s.testUserID, &otherUserID, false)
+	summary, err := s.service.GetAccountSummary(s.testUserID, &otherUserID, "", false)
 	s.Error(err)
 	s.Equal(ErrUnauthorized, err)
 	s.Nil(summary)
@@ -181,7 +198,7 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_NoAccounts() {
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(testUser, nil)
 	s.accountRepo.EXPECT().GetByUserID(s.testUserID).Return([]models.Account{}, nil)
 
-	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, false)
+	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, "", false)
 	s.NoError(err)
 	s.NotNil(summary)
 	s.Equal(s.testUserID, summary.UserID)
@@ -194,7 +211,7 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_NoAccounts() {
 func (s *AccountSummaryServiceSuite) TestGetAccountSummary_UserNotFound() {
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(nil, repositories.ErrUserNotFound)
 
-	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, false)
+	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, "", false)
 	s.Error(err)
 	s.Equal(ErrNotFound, err)
 	s.Nil(summary)
@@ -213,7 +230,7 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_TargetUserNotFound()
 	s.userRepo.EXPECT().GetByID(s.testAdminID).Return(adminUser, nil)
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(nil, repositories.ErrUserNotFound)
 
-	summary, err := s.service.GetAccountSummary(s.testAdminID, &s.testUserID, true)
+	summary, err := s.service.GetAccountSummary(s.testAdminID, &s.testUserID, "", true)
 	s.Error(err)
 	s.Equal(ErrNotFound, err)
 	s.Nil(summary)
@@ -268,7 +285,7 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_MultipleAccountTypes(
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(testUser, nil)
 	s.accountRepo.EXPECT().GetByUserID(s.testUserID).Return(accounts, nil)
 
-	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, false)
+	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, "", false)
 	s.NoError(err)
 	s.NotNil(summary)
 	s.Equal(3, summary.AccountCount)
@@ -314,7 +331,7 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_WithInactiveAccounts(
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(testUser, nil)
 	s.accountRepo.EXPECT().GetByUserID(s.testUserID).Return(accounts, nil)
 
-	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, false)
+	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, "", false)
 	s.NoError(err)
 	s.NotNil(summary)
 	s.Equal(2, summary.AccountCount)
@@ -360,7 +377,7 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_AccountNumberMasking(
 	s.userRepo.EXPECT().GetByID(s.testUserID).Return(testUser, nil)
 	s.accountRepo.EXPECT().GetByUserID(s.testUserID).Return(accounts, nil)
 
-	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, false)
+	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, "", false)
 	s.NoError(err)
 	s.NotNil(summary)
 
@@ -368,3 +385,50 @@ func (s *AccountSummaryServiceSuite) TestGetAccountSummary_AccountNumberMasking(
 	s.Equal("****7890", summary.Accounts[0].MaskedAccountNumber)
 	s.Equal("****3210", summary.Accounts[1].MaskedAccountNumber)
 }
+
+// Test GetAccountSummary totals balances in the requested base currency
+func (s *AccountSummaryServiceSuite) TestGetAccountSummary_BaseCurrencyConversion() {
+	testUser := &models.User{ID: s.testUserID, Role: models.RoleCustomer}
+
+	accounts := []models.Account{
+		{
+			ID:            uuid.New(),
+			AccountNumber: "1012345678",
+			UserID:        s.testUserID,
+			AccountType:   models.AccountTypeChecking,
+			Balance:       decimal.NewFromFloat(100.00),
+			Status:        models.AccountStatusActive,
+			Currency:      "USD",
+		},
+		{
+			ID:            uuid.New(),
+			AccountNumber: "2023456789",
+			UserID:        s.testUserID,
+			AccountType:   models.AccountTypeSavings,
+			Balance:       decimal.NewFromFloat(50.00),
+			Status:        models.AccountStatusActive,
+			Currency:      "EUR",
+		},
+	}
+
+	s.userRepo.EXPECT().GetByID(s.testUserID).Return(testUser, nil)
+	s.accountRepo.EXPECT().GetByUserID(s.testUserID).Return(accounts, nil)
+	s.rates.EXPECT().GetRate("USD", "EUR").
+		Return(&models.ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: decimal.RequireFromString("0.9")}, nil)
+
+	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, "eur", false)
+	s.NoError(err)
+	s.Require().NotNil(summary)
+	s.Equal("EUR", summary.Currency)
+	s.Equal("140.00", summary.TotalBalance.StringFixed(2))
+	s.Equal("90.00", summary.Accounts[0].ConvertedBalance.StringFixed(2))
+	s.Equal("100.00", summary.Accounts[0].Balance.StringFixed(2))
+	s.Equal("50.00", summary.Accounts[1].ConvertedBalance.StringFixed(2))
+}
+
+// Test GetAccountSummary rejects an unknown base currency
+func (s *AccountSummaryServiceSuite) TestGetAccountSummary_UnsupportedBaseCurrency() {
+	summary, err := s.service.GetAccountSummary(s.testUserID, &s.testUserID, "ZZZ", false)
+	s.ErrorIs(err, ErrUnsupportedCurrency)
+	s.Nil(summary)
+}
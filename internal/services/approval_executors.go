@@ -0,0 +1,209 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// deleteCustomerExecutor soft-deletes a customer. Payload: customer_id.
+type deleteCustomerExecutor struct {
+	profileService CustomerProfileServiceInterface
+}
+
+// NewDeleteCustomerExecutor carries out approved customer deletions
+func NewDeleteCustomerExecutor(profileService CustomerProfileServiceInterface) ActionExecutor {
+	return &deleteCustomerExecutor{
+		profileService: profileService,
+	}
+}
+
+func (e *deleteCustomerExecutor) ActionType() string {
+	return models.PendingActionDeleteCustomer
+}
+
+func (e *deleteCustomerExecutor) Permission() string {
+	return models.PermissionCustomersWrite
+}
+
+func (e *deleteCustomerExecutor) Execute(action *models.PendingAction, approvedBy uuid.UUID, ipAddress, userAgent string) (map[string]interface{}, error) {
+	customerID, err := payloadUUID(action.Payload, "customer_id")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.profileService.DeleteCustomer(customerID, "Admin deletion approved in request "+action.ID.String()); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// transferOwnershipExecutor moves an account to another customer. Payload:
+// account_id, from_customer_id and to_customer_id.
+type transferOwnershipExecutor struct {
+	associationService AccountAssociationServiceInterface
+}
+
+// NewTransferOwnershipExecutor carries out approved account ownership transfers
+func NewTransferOwnershipExecutor(associationService AccountAssociationServiceInterface) ActionExecutor {
+	return &transferOwnershipExecutor{
+		associationService: associationService,
+	}
+}
+
+func (e *transferOwnershipExecutor) ActionType() string {
+	return models.PendingActionTransferOwnership
+}
+
+func (e *transferOwnershipExecutor) Permission() string {
+	return models.PermissionCustomersWrite
+}
+
+func (e *transferOwnershipExecutor) Execute(action *models.PendingAction, approvedBy uuid.UUID, ipAddress, userAgent string) (map[string]interface{}, error) {
+	accountID, err := payloadUUID(action.Payload, "account_id")
+	if err != nil {
+		return nil, err
+	}
+	fromCustomerID, err := payloadUUID(action.Payload, "from_customer_id")
+	if err != nil {
+		return nil, err
+	}
+	toCustomerID, err := payloadUUID(action.Payload, "to_customer_id")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.associationService.TransferAccountOwnership(accountID, fromCustomerID, toCustomerID, approvedBy, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// resetPasswordExecutor resets a customer's password. Payload: customer_id. The
+// temporary password is returned to the approving admin only.
+type resetPasswordExecutor struct {
+	passwordService PasswordServiceInterface
+}
+
+// NewResetPasswordExecutor carries out approved customer password resets
+func NewResetPasswordExecutor(passwordService PasswordServiceInterface) ActionExecutor {
+	return &resetPasswordExecutor{
+		passwordService: passwordService,
+	}
+}
+
+func (e *resetPasswordExecutor) ActionType() string {
+	return models.PendingActionResetPassword
+}
+
+func (e *resetPasswordExecutor) Permission() string {
+	return models.PermissionCustomersResetPassword
+}
+
+func (e *resetPasswordExecutor) Execute(action *models.PendingAction, approvedBy uuid.UUID, ipAddress, userAgent string) (map[string]interface{}, error) {
+	customerID, err := payloadUUID(action.Payload, "customer_id")
+	if err != nil {
+		return nil, err
+	}
+
+	tempPassword, err := e.passwordService.AdminResetPassword(customerID, approvedBy)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"temporary_password": tempPassword}, nil
+}
+
+// manualTransactionExecutor posts a teller deposit or withdrawal. Payload:
+// account_id, amount, type and description. Limits and fraud screening apply when
+// it is posted.
+type manualTransactionExecutor struct {
+	accountService AccountServiceInterface
+}
+
+// NewManualTransactionExecutor carries out approved manual transactions
+func NewManualTransactionExecutor(accountService AccountServiceInterface) ActionExecutor {
+	return &manualTransactionExecutor{
+		accountService: accountService,
+	}
+}
+
+func (e *manualTransactionExecutor) ActionType() string {
+	return models.PendingActionManualTransaction
+}
+
+func (e *manualTransactionExecutor) Permission() string {
+	return models.PermissionTransactionsPost
+}
+
+func (e *manualTransactionExecutor) Execute(action *models.PendingAction, approvedBy uuid.UUID, ipAddress, userAgent string) (map[string]interface{}, error) {
+	accountID, err := payloadUUID(action.Payload, "account_id")
+	if err != nil {
+		return nil, err
+	}
+	amountStr, _ := action.Payload["amount"].(string)
+	amount, err := decimal.NewFromString(amountStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount in payload: %w", err)
+	}
+	transactionType, _ := action.Payload["type"].(string)
+	description, _ := action.Payload["description"].(string)
+
+	transaction, err := e.accountService.PerformTransaction(accountID, amount, transactionType, description, nil)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"transaction": transaction}, nil
+}
+
+// transactionImportExecutor imports a held transaction import file. Payload:
+// filename, format, mode and content. The rows are validated again when it runs,
+// and are recorded as imported by the admin who uploaded the file.
+type transactionImportExecutor struct {
+	importService TransactionImportServiceInterface
+}
+
+// NewTransactionImportExecutor carries out approved transaction imports
+func NewTransactionImportExecutor(importService TransactionImportServiceInterface) ActionExecutor {
+	return &transactionImportExecutor{
+		importService: importService,
+	}
+}
+
+func (e *transactionImportExecutor) ActionType() string {
+	return models.PendingActionTransactionImport
+}
+
+func (e *transactionImportExecutor) Permission() string {
+	return models.PermissionTransactionsManage
+}
+
+func (e *transactionImportExecutor) Execute(action *models.PendingAction, approvedBy uuid.UUID, ipAddress, userAgent string) (map[string]interface{}, error) {
+	format, _ := action.Payload["format"].(string)
+	mode, _ := action.Payload["mode"].(string)
+	content, _ := action.Payload["content"].(string)
+
+	report, err := e.importService.ImportTransactions(strings.NewReader(content), dto.ImportTransactionsOptions{
+		Format:     format,
+		Mode:       mode,
+		ImportedBy: action.RequestedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"report": report}, nil
+}
+
+// payloadUUID reads a UUID stored as a string in an action's payload
+func payloadUUID(payload models.JSONBMap, key string) (uuid.UUID, error) {
+	value, _ := payload[key].(string)
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid %s in payload: %w", key, err)
+	}
+	return id, nil
+}
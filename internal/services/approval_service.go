@@ -0,0 +1,348 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"array-assessment/internal/config"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrPendingActionNotFound      = errors.New("pending action not found")
+	ErrPendingActionNotPending    = errors.New("pending action has already been decided")
+	ErrPendingActionExpired       = errors.New("pending action expired before it was decided")
+	ErrCannotDecideOwnAction      = errors.New("pending actions must be decided by a different admin")
+	ErrMissingActionPermission    = errors.New("approver lacks the permission the action requires")
+	ErrInvalidPendingActionStatus = errors.New("invalid pending action status")
+)
+
+// ActionExecutor carries out one type of pending action once it is approved
+type ActionExecutor interface {
+	// ActionType is the pending action type the executor carries out
+	ActionType() string
+	// Permission is the permission the approving admin needs; it is the one that
+	// guards the action's own route
+	Permission() string
+	// Execute carries out the action with its stored payload on behalf of the
+	// approving admin, returning any result to show them. Results are not stored.
+	Execute(action *models.PendingAction, approvedBy uuid.UUID, ipAddress, userAgent string) (map[string]interface{}, error)
+}
+
+// ApprovalService holds high-risk staff actions for maker-checker approval. The
+// admin who submits an action cannot decide it; a second admin holding the action's
+// permission approves it, which carries it out, or rejects it. Actions not decided
+// within the configured window expire. Every step is written to the audit log.
+type ApprovalService struct {
+	actionRepo repositories.PendingActionRepositoryInterface
+	auditRepo  repositories.AuditLogRepositoryInterface
+	executors  map[string]ActionExecutor
+	required   map[string]bool
+	cfg        *config.ApprovalConfig
+	logger     *slog.Logger
+}
+
+// NewApprovalService creates a new approval service. Only actions with an executor
+// can be held; any other action named in the policy is ignored with a warning.
+func NewApprovalService(
+	actionRepo repositories.PendingActionRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	executors []ActionExecutor,
+	cfg *config.ApprovalConfig,
+	logger *slog.Logger,
+) ApprovalServiceInterface {
+	s := &ApprovalService{
+		actionRepo: actionRepo,
+		auditRepo:  auditRepo,
+		executors:  make(map[string]ActionExecutor, len(executors)),
+		required:   make(map[string]bool, len(cfg.RequiredActions)),
+		cfg:        cfg,
+		logger:     logger,
+	}
+
+	for _, executor := range executors {
+		s.executors[executor.ActionType()] = executor
+	}
+
+	for _, actionType := range cfg.RequiredActions {
+		if _, ok := s.executors[actionType]; !ok {
+			logger.Warn("ignoring unknown action in approval policy",
+				slog.String("action_type", actionType),
+			)
+			continue
+		}
+		s.required[actionType] = true
+	}
+
+	return s
+}
+
+// RequiresApproval reports whether the policy holds an action for approval. amount
+// is the transaction amount for manual transactions and the largest row's amount
+// for transaction imports, and is ignored otherwise.
+func (s *ApprovalService) RequiresApproval(actionType string, amount decimal.Decimal) bool {
+	if !s.cfg.Enabled || !s.required[actionType] {
+		return false
+	}
+
+	if actionType == models.PendingActionManualTransaction || actionType == models.PendingActionTransactionImport {
+		return amount.GreaterThanOrEqual(s.cfg.TransactionThreshold)
+	}
+
+	return true
+}
+
+// SubmitAction holds an action for approval by a second admin
+func (s *ApprovalService) SubmitAction(actionType, resourceID string, payload map[string]interface{}, requestedBy uuid.UUID, ipAddress, userAgent string) (*models.PendingAction, error) {
+	action := &models.PendingAction{
+		ActionType:  actionType,
+		ResourceID:  resourceID,
+		Payload:     payload,
+		RequestedBy: requestedBy,
+		ExpiresAt:   time.Now().Add(s.cfg.Window),
+	}
+	if err := s.actionRepo.Create(action); err != nil {
+		return nil, err
+	}
+
+	s.createAuditLog(&requestedBy, models.AuditActionApprovalRequested, action, ipAddress, userAgent, map[string]interface{}{
+		"payload":    auditPayload(action.Payload),
+		"expires_at": action.ExpiresAt,
+	})
+
+	return action, nil
+}
+
+// ListActions lists pending actions, oldest first. An empty status lists every action.
+func (s *ApprovalService) ListActions(status string, offset, limit int) ([]models.PendingAction, int64, error) {
+	if status != "" && !models.IsValidPendingActionStatus(status) {
+		return nil, 0, ErrInvalidPendingActionStatus
+	}
+	return s.actionRepo.List(status, offset, limit)
+}
+
+// GetAction retrieves a pending action
+func (s *ApprovalService) GetAction(actionID uuid.UUID) (*models.PendingAction, error) {
+	action, err := s.actionRepo.GetByID(actionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPendingActionNotFound) {
+			return nil, ErrPendingActionNotFound
+		}
+		return nil, err
+	}
+	return action, nil
+}
+
+// ApproveAction approves an action and carries it out. permissions are the
+// approver's; they must include the permission the action itself requires. The
+// approval stands even if the action then fails, for example because the customer
+// still has a balance; the action's failure reason records why.
+func (s *ApprovalService) ApproveAction(actionID, approvedBy uuid.UUID, permissions []string, reason, ipAddress, userAgent string) (*models.PendingAction, map[string]interface{}, error) {
+	action, err := s.decidableAction(actionID, approvedBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executor, ok := s.executors[action.ActionType]
+	if !ok {
+		return nil, nil, fmt.Errorf("no executor for action type %s", action.ActionType)
+	}
+	if !hasPermission(permissions, executor.Permission()) {
+		return nil, nil, ErrMissingActionPermission
+	}
+
+	action.Approve(approvedBy, reason, time.Now())
+	if err := s.decide(action); err != nil {
+		return nil, nil, err
+	}
+
+	s.createAuditLog(&approvedBy, models.AuditActionApprovalApproved, action, ipAddress, userAgent, map[string]interface{}{
+		"requested_by": action.RequestedBy.String(),
+		"reason":       reason,
+	})
+
+	result, execErr := executor.Execute(action, approvedBy, ipAddress, userAgent)
+	if execErr != nil {
+		s.logger.Warn("approved action could not be carried out",
+			slog.String("action_id", action.ID.String()),
+			slog.String("action_type", action.ActionType),
+			slog.String("error", execErr.Error()),
+		)
+
+		action.Fail(execErr.Error())
+		if err := s.actionRepo.RecordFailure(action); err != nil {
+			return nil, nil, err
+		}
+
+		s.createAuditLog(&approvedBy, models.AuditActionApprovalFailed, action, ipAddress, userAgent, map[string]interface{}{
+			"failure_reason": execErr.Error(),
+		})
+		return action, nil, nil
+	}
+
+	return action, result, nil
+}
+
+// RejectAction rejects an action so it is never carried out
+func (s *ApprovalService) RejectAction(actionID, rejectedBy uuid.UUID, reason, ipAddress, userAgent string) (*models.PendingAction, error) {
+	action, err := s.decidableAction(actionID, rejectedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	action.Reject(rejectedBy, reason, time.Now())
+	if err := s.decide(action); err != nil {
+		return nil, err
+	}
+
+	s.createAuditLog(&rejectedBy, models.AuditActionApprovalRejected, action, ipAddress, userAgent, map[string]interface{}{
+		"requested_by": action.RequestedBy.String(),
+		"reason":       reason,
+	})
+
+	return action, nil
+}
+
+// StartWorker expires undecided actions on every poll until the context is cancelled
+func (s *ApprovalService) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	s.logger.Info("starting approval expiry worker",
+		slog.Duration("poll_interval", pollInterval),
+	)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("approval expiry worker stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.ExpireActions(); err != nil {
+				s.logger.Error("failed to expire pending actions",
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
+
+// ExpireActions expires actions whose approval window has passed and reports how
+// many were expired
+func (s *ApprovalService) ExpireActions() (int, error) {
+	now := time.Now()
+	actions, err := s.actionRepo.GetExpired(now, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for i := range actions {
+		if err := s.expire(&actions[i], now); err != nil {
+			if errors.Is(err, ErrPendingActionNotPending) {
+				continue
+			}
+			return expired, err
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+// decidableAction loads an action that is still awaiting a decision and that was
+// not submitted by the deciding admin. An action past its expiry is closed as
+// expired instead.
+func (s *ApprovalService) decidableAction(actionID, decidedBy uuid.UUID) (*models.PendingAction, error) {
+	action, err := s.GetAction(actionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !action.IsPending() {
+		return nil, ErrPendingActionNotPending
+	}
+
+	now := time.Now()
+	if action.IsExpired(now) {
+		if err := s.expire(action, now); err != nil {
+			return nil, err
+		}
+		return nil, ErrPendingActionExpired
+	}
+
+	if action.RequestedBy == decidedBy {
+		return nil, ErrCannotDecideOwnAction
+	}
+
+	return action, nil
+}
+
+// expire closes an undecided action as expired
+func (s *ApprovalService) expire(action *models.PendingAction, at time.Time) error {
+	action.Expire(at)
+	if err := s.decide(action); err != nil {
+		return err
+	}
+
+	s.createAuditLog(nil, models.AuditActionApprovalExpired, action, "", "", map[string]interface{}{
+		"requested_by": action.RequestedBy.String(),
+		"expires_at":   action.ExpiresAt,
+	})
+	return nil
+}
+
+// decide saves an action's decision unless it was decided concurrently
+func (s *ApprovalService) decide(action *models.PendingAction) error {
+	if err := s.actionRepo.Decide(action); err != nil {
+		if errors.Is(err, repositories.ErrPendingActionNotPending) {
+			return ErrPendingActionNotPending
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *ApprovalService) createAuditLog(userID *uuid.UUID, auditAction string, action *models.PendingAction, ipAddress, userAgent string, metadata map[string]interface{}) {
+	metadata["action_type"] = action.ActionType
+	metadata["resource_id"] = action.ResourceID
+
+	recordAuditLog(s.auditRepo, s.logger, &models.AuditLog{
+		UserID:     userID,
+		Action:     auditAction,
+		Resource:   "pending_action",
+		ResourceID: action.ID.String(),
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		Metadata:   metadata,
+	})
+}
+
+// auditPayload copies an action's payload for the audit log, leaving out an import
+// file's content. The file is identified by its digest, the action's resource ID.
+func auditPayload(payload models.JSONBMap) map[string]interface{} {
+	audited := make(map[string]interface{}, len(payload))
+	for key, value := range payload {
+		if key == "content" {
+			continue
+		}
+		audited[key] = value
+	}
+	return audited
+}
+
+func hasPermission(permissions []string, permission string) bool {
+	for _, granted := range permissions {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
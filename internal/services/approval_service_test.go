@@ -0,0 +1,336 @@
+package services
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"array-assessment/internal/config"
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type ApprovalServiceTestSuite struct {
+	suite.Suite
+	ctrl            *gomock.Controller
+	actionRepo      *repository_mocks.MockPendingActionRepositoryInterface
+	auditRepo       *repository_mocks.MockAuditLogRepositoryInterface
+	profileService  *service_mocks.MockCustomerProfileServiceInterface
+	passwordService *service_mocks.MockPasswordServiceInterface
+	accountService  *service_mocks.MockAccountServiceInterface
+	importService   *service_mocks.MockTransactionImportServiceInterface
+	cfg             *config.ApprovalConfig
+	approvalService ApprovalServiceInterface
+	makerID         uuid.UUID
+	checkerID       uuid.UUID
+}
+
+func (s *ApprovalServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.actionRepo = repository_mocks.NewMockPendingActionRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.profileService = service_mocks.NewMockCustomerProfileServiceInterface(s.ctrl)
+	s.passwordService = service_mocks.NewMockPasswordServiceInterface(s.ctrl)
+	s.accountService = service_mocks.NewMockAccountServiceInterface(s.ctrl)
+	s.importService = service_mocks.NewMockTransactionImportServiceInterface(s.ctrl)
+	s.cfg = &config.ApprovalConfig{
+		Enabled: true,
+		RequiredActions: []string{
+			models.PendingActionDeleteCustomer,
+			models.PendingActionResetPassword,
+			models.PendingActionManualTransaction,
+			models.PendingActionTransactionImport,
+		},
+		TransactionThreshold: decimal.NewFromInt(10000),
+		Window:               24 * time.Hour,
+		BatchSize:            100,
+	}
+	s.approvalService = s.newService()
+	s.makerID = uuid.New()
+	s.checkerID = uuid.New()
+}
+
+func (s *ApprovalServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestApprovalServiceSuite(t *testing.T) {
+	suite.Run(t, new(ApprovalServiceTestSuite))
+}
+
+func (s *ApprovalServiceTestSuite) newService() ApprovalServiceInterface {
+	return NewApprovalService(s.actionRepo, s.auditRepo, []ActionExecutor{
+		NewDeleteCustomerExecutor(s.profileService),
+		NewResetPasswordExecutor(s.passwordService),
+		NewManualTransactionExecutor(s.accountService),
+		NewTransactionImportExecutor(s.importService),
+	}, s.cfg, slog.Default())
+}
+
+// pendingDeletion returns an undecided request by the maker to delete a customer
+func (s *ApprovalServiceTestSuite) pendingDeletion(customerID uuid.UUID) *models.PendingAction {
+	return &models.PendingAction{
+		ID:          uuid.New(),
+		ActionType:  models.PendingActionDeleteCustomer,
+		ResourceID:  customerID.String(),
+		Payload:     models.JSONBMap{"customer_id": customerID.String()},
+		Status:      models.PendingActionStatusPending,
+		RequestedBy: s.makerID,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+}
+
+func (s *ApprovalServiceTestSuite) expectAudit(auditAction string) {
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(auditAction, log.Action)
+		s.Equal("pending_action", log.Resource)
+		return nil
+	}).Times(1)
+}
+
+func (s *ApprovalServiceTestSuite) TestRequiresApproval_Policy() {
+	s.True(s.approvalService.RequiresApproval(models.PendingActionDeleteCustomer, decimal.Zero))
+	s.False(s.approvalService.RequiresApproval(models.PendingActionTransferOwnership, decimal.Zero))
+
+	s.False(s.approvalService.RequiresApproval(models.PendingActionManualTransaction, decimal.RequireFromString("9999.99")))
+	s.True(s.approvalService.RequiresApproval(models.PendingActionManualTransaction, decimal.NewFromInt(10000)))
+
+	s.False(s.approvalService.RequiresApproval(models.PendingActionTransactionImport, decimal.RequireFromString("9999.99")))
+	s.True(s.approvalService.RequiresApproval(models.PendingActionTransactionImport, decimal.NewFromInt(25000)))
+
+	s.cfg.Enabled = false
+	s.False(s.approvalService.RequiresApproval(models.PendingActionDeleteCustomer, decimal.Zero))
+}
+
+func (s *ApprovalServiceTestSuite) TestRequiresApproval_IgnoresActionsWithoutExecutor() {
+	s.cfg.RequiredActions = []string{models.PendingActionTransferOwnership}
+	service := s.newService()
+
+	s.False(service.RequiresApproval(models.PendingActionTransferOwnership, decimal.Zero))
+}
+
+func (s *ApprovalServiceTestSuite) TestSubmitAction() {
+	customerID := uuid.New()
+
+	s.actionRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(action *models.PendingAction) error {
+		s.Equal(models.PendingActionDeleteCustomer, action.ActionType)
+		s.Equal(s.makerID, action.RequestedBy)
+		s.WithinDuration(time.Now().Add(24*time.Hour), action.ExpiresAt, time.Minute)
+		return nil
+	}).Times(1)
+	s.expectAudit(models.AuditActionApprovalRequested)
+
+	action, err := s.approvalService.SubmitAction(models.PendingActionDeleteCustomer, customerID.String(),
+		map[string]interface{}{"customer_id": customerID.String()}, s.makerID, "10.0.0.1", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal(customerID.String(), action.ResourceID)
+}
+
+func (s *ApprovalServiceTestSuite) TestSubmitAction_ImportContentNotAudited() {
+	s.actionRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		payload := log.Metadata["payload"].(map[string]interface{})
+		s.NotContains(payload, "content")
+		s.Equal(3, payload["total_rows"])
+		s.Equal("25000", payload["largest_amount"])
+		s.Equal("digest", log.Metadata["resource_id"])
+		return nil
+	}).Times(1)
+
+	_, err := s.approvalService.SubmitAction(models.PendingActionTransactionImport, "digest", map[string]interface{}{
+		"format":         ImportFormatCSV,
+		"total_rows":     3,
+		"largest_amount": "25000",
+		"content":        "account_number,type,amount,description,reference\n",
+	}, s.makerID, "10.0.0.1", "admin-console")
+
+	s.Require().NoError(err)
+}
+
+func (s *ApprovalServiceTestSuite) TestApproveAction_CarriesOutAction() {
+	customerID := uuid.New()
+	action := s.pendingDeletion(customerID)
+
+	s.actionRepo.EXPECT().GetByID(action.ID).Return(action, nil).Times(1)
+	s.actionRepo.EXPECT().Decide(action).Return(nil).Times(1)
+	s.expectAudit(models.AuditActionApprovalApproved)
+	s.profileService.EXPECT().DeleteCustomer(customerID, gomock.Any()).Return(nil).Times(1)
+
+	approved, _, err := s.approvalService.ApproveAction(action.ID, s.checkerID,
+		[]string{models.PermissionCustomersWrite}, "Closure confirmed in writing", "10.0.0.2", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal(models.PendingActionStatusApproved, approved.Status)
+	s.Equal(s.checkerID, *approved.ReviewedBy)
+	s.Nil(approved.FailureReason)
+}
+
+func (s *ApprovalServiceTestSuite) TestApproveAction_ReturnsResultToApprover() {
+	customerID := uuid.New()
+	action := s.pendingDeletion(customerID)
+	action.ActionType = models.PendingActionResetPassword
+
+	s.actionRepo.EXPECT().GetByID(action.ID).Return(action, nil).Times(1)
+	s.actionRepo.EXPECT().Decide(action).Return(nil).Times(1)
+	s.expectAudit(models.AuditActionApprovalApproved)
+	s.passwordService.EXPECT().AdminResetPassword(customerID, s.checkerID).Return("Temp-Passw0rd!", nil).Times(1)
+
+	_, result, err := s.approvalService.ApproveAction(action.ID, s.checkerID,
+		[]string{models.PermissionCustomersResetPassword}, "Identity verified", "10.0.0.2", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal("Temp-Passw0rd!", result["temporary_password"])
+	s.NotContains(action.Payload, "temporary_password")
+}
+
+func (s *ApprovalServiceTestSuite) TestApproveAction_ImportsHeldFile() {
+	const content = "account_number,type,amount,description,reference\n1000000001,credit,25000,Payroll,PAY-1\n"
+	action := s.pendingDeletion(uuid.New())
+	action.ActionType = models.PendingActionTransactionImport
+	action.Payload = models.JSONBMap{"format": ImportFormatCSV, "mode": dto.ImportModeAtomic, "content": content}
+	report := &dto.TransactionImportReport{TotalRows: 1, Accepted: 1}
+
+	s.actionRepo.EXPECT().GetByID(action.ID).Return(action, nil).Times(1)
+	s.actionRepo.EXPECT().Decide(action).Return(nil).Times(1)
+	s.expectAudit(models.AuditActionApprovalApproved)
+	s.importService.EXPECT().ImportTransactions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(file io.Reader, options dto.ImportTransactionsOptions) (*dto.TransactionImportReport, error) {
+			data, err := io.ReadAll(file)
+			s.Require().NoError(err)
+			s.Equal(content, string(data))
+			s.Equal(ImportFormatCSV, options.Format)
+			s.Equal(dto.ImportModeAtomic, options.Mode)
+			s.False(options.DryRun)
+			s.Equal(s.makerID, options.ImportedBy, "rows are recorded as imported by the uploader")
+			return report, nil
+		}).Times(1)
+
+	_, result, err := s.approvalService.ApproveAction(action.ID, s.checkerID,
+		[]string{models.PermissionTransactionsManage}, "Payroll file checked", "10.0.0.2", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal(report, result["report"])
+}
+
+func (s *ApprovalServiceTestSuite) TestApproveAction_ExecutionFailureIsRecorded() {
+	customerID := uuid.New()
+	action := s.pendingDeletion(customerID)
+
+	s.actionRepo.EXPECT().GetByID(action.ID).Return(action, nil).Times(1)
+	s.actionRepo.EXPECT().Decide(action).Return(nil).Times(1)
+	s.expectAudit(models.AuditActionApprovalApproved)
+	s.profileService.EXPECT().DeleteCustomer(customerID, gomock.Any()).Return(ErrCustomerHasBalance).Times(1)
+	s.actionRepo.EXPECT().RecordFailure(action).Return(nil).Times(1)
+	s.expectAudit(models.AuditActionApprovalFailed)
+
+	approved, _, err := s.approvalService.ApproveAction(action.ID, s.checkerID,
+		[]string{models.PermissionCustomersWrite}, "Closure confirmed in writing", "10.0.0.2", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal(models.PendingActionStatusApproved, approved.Status)
+	s.Equal(ErrCustomerHasBalance.Error(), *approved.FailureReason)
+}
+
+func (s *ApprovalServiceTestSuite) TestApproveAction_OwnRequest() {
+	action := s.pendingDeletion(uuid.New())
+
+	s.actionRepo.EXPECT().GetByID(action.ID).Return(action, nil).Times(1)
+
+	_, _, err := s.approvalService.ApproveAction(action.ID, s.makerID,
+		[]string{models.PermissionCustomersWrite}, "Approving my own request", "10.0.0.1", "admin-console")
+
+	s.ErrorIs(err, ErrCannotDecideOwnAction)
+}
+
+func (s *ApprovalServiceTestSuite) TestApproveAction_ApproverNeedsActionPermission() {
+	action := s.pendingDeletion(uuid.New())
+
+	s.actionRepo.EXPECT().GetByID(action.ID).Return(action, nil).Times(1)
+
+	_, _, err := s.approvalService.ApproveAction(action.ID, s.checkerID,
+		[]string{models.PermissionApprovalsReview}, "Looks fine", "10.0.0.2", "admin-console")
+
+	s.ErrorIs(err, ErrMissingActionPermission)
+}
+
+func (s *ApprovalServiceTestSuite) TestApproveAction_Expired() {
+	action := s.pendingDeletion(uuid.New())
+	action.ExpiresAt = time.Now().Add(-time.Minute)
+
+	s.actionRepo.EXPECT().GetByID(action.ID).Return(action, nil).Times(1)
+	s.actionRepo.EXPECT().Decide(action).Return(nil).Times(1)
+	s.expectAudit(models.AuditActionApprovalExpired)
+
+	_, _, err := s.approvalService.ApproveAction(action.ID, s.checkerID,
+		[]string{models.PermissionCustomersWrite}, "Too late", "10.0.0.2", "admin-console")
+
+	s.ErrorIs(err, ErrPendingActionExpired)
+	s.Equal(models.PendingActionStatusExpired, action.Status)
+}
+
+func (s *ApprovalServiceTestSuite) TestApproveAction_DecidedConcurrently() {
+	action := s.pendingDeletion(uuid.New())
+
+	s.actionRepo.EXPECT().GetByID(action.ID).Return(action, nil).Times(1)
+	s.actionRepo.EXPECT().Decide(action).Return(repositories.ErrPendingActionNotPending).Times(1)
+
+	_, _, err := s.approvalService.ApproveAction(action.ID, s.checkerID,
+		[]string{models.PermissionCustomersWrite}, "Closure confirmed in writing", "10.0.0.2", "admin-console")
+
+	s.ErrorIs(err, ErrPendingActionNotPending)
+}
+
+func (s *ApprovalServiceTestSuite) TestRejectAction() {
+	action := s.pendingDeletion(uuid.New())
+
+	s.actionRepo.EXPECT().GetByID(action.ID).Return(action, nil).Times(1)
+	s.actionRepo.EXPECT().Decide(action).Return(nil).Times(1)
+	s.expectAudit(models.AuditActionApprovalRejected)
+
+	rejected, err := s.approvalService.RejectAction(action.ID, s.checkerID, "Customer has an open dispute", "10.0.0.2", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal(models.PendingActionStatusRejected, rejected.Status)
+	s.Equal("Customer has an open dispute", *rejected.DecisionReason)
+}
+
+func (s *ApprovalServiceTestSuite) TestRejectAction_NotFound() {
+	actionID := uuid.New()
+
+	s.actionRepo.EXPECT().GetByID(actionID).Return(nil, repositories.ErrPendingActionNotFound).Times(1)
+
+	_, err := s.approvalService.RejectAction(actionID, s.checkerID, "No such request", "10.0.0.2", "admin-console")
+
+	s.ErrorIs(err, ErrPendingActionNotFound)
+}
+
+func (s *ApprovalServiceTestSuite) TestExpireActions() {
+	first := s.pendingDeletion(uuid.New())
+	second := s.pendingDeletion(uuid.New())
+
+	s.actionRepo.EXPECT().GetExpired(gomock.Any(), 100).Return([]models.PendingAction{*first, *second}, nil).Times(1)
+	s.actionRepo.EXPECT().Decide(gomock.Any()).Return(nil).Times(1)
+	s.actionRepo.EXPECT().Decide(gomock.Any()).Return(repositories.ErrPendingActionNotPending).Times(1)
+	s.expectAudit(models.AuditActionApprovalExpired)
+
+	expired, err := s.approvalService.ExpireActions()
+
+	s.Require().NoError(err)
+	s.Equal(1, expired)
+}
+
+func (s *ApprovalServiceTestSuite) TestListActions_InvalidStatus() {
+	_, _, err := s.approvalService.ListActions("waiting", 0, 20)
+
+	s.ErrorIs(err, ErrInvalidPendingActionStatus)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"array-assessment/internal/models"
@@ -29,6 +30,19 @@ var (
 	ErrAuditDateRange  = errors.New("invalid date range: start date must be before end date")
 )
 
+// recordAuditLog saves an audit entry. Audit logging failure should not block the
+// operation being audited, so it is only logged.
+func recordAuditLog(auditRepo repositories.AuditLogRepositoryInterface, logger *slog.Logger, log *models.AuditLog) {
+	if err := auditRepo.Create(log); err != nil {
+		logger.Error("failed to create audit log",
+			slog.String("action", log.Action),
+			slog.String("resource", log.Resource),
+			slog.String("resource_id", log.ResourceID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
 // ValidateActivityType validates that the activity type is one of the allowed types
 func ValidateActivityType(action string) error {
 	validActions := map[string]bool{
@@ -19,6 +19,7 @@ var (
 	ErrAccountLocked       = errors.New("account is locked due to too many failed attempts")
 	ErrUserAlreadyExists   = errors.New("user with this email already exists")
 	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrInvalidMFAChallenge = errors.New("invalid or expired MFA challenge")
 )
 
 // AuthService handles authentication business logic
@@ -27,9 +28,12 @@ type AuthService struct {
 	refreshTokenRepo     repositories.RefreshTokenRepositoryInterface
 	auditRepo            repositories.AuditLogRepositoryInterface
 	blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface
+	roleRepo             repositories.RoleRepositoryInterface
 	passwordService      PasswordServiceInterface
 	tokenService         TokenServiceInterface
 	accountService       AccountServiceInterface
+	mfaService           MFAServiceInterface
+	sessionService       SessionServiceInterface
 	logger               *slog.Logger
 }
 
@@ -39,9 +43,12 @@ func NewAuthService(
 	refreshTokenRepo repositories.RefreshTokenRepositoryInterface,
 	auditRepo repositories.AuditLogRepositoryInterface,
 	blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface,
+	roleRepo repositories.RoleRepositoryInterface,
 	passwordService PasswordServiceInterface,
 	tokenService TokenServiceInterface,
 	accountService AccountServiceInterface,
+	mfaService MFAServiceInterface,
+	sessionService SessionServiceInterface,
 	logger *slog.Logger,
 ) AuthServiceInterface {
 	return &AuthService{
@@ -49,9 +56,12 @@ func NewAuthService(
 		refreshTokenRepo:     refreshTokenRepo,
 		auditRepo:            auditRepo,
 		blacklistedTokenRepo: blacklistedTokenRepo,
+		roleRepo:             roleRepo,
 		passwordService:      passwordService,
 		tokenService:         tokenService,
 		accountService:       accountService,
+		mfaService:           mfaService,
+		sessionService:       sessionService,
 		logger:               logger,
 	}
 }
@@ -97,8 +107,9 @@ func (s *AuthService) Register(req *dto.RegisterRequest, ipAddress, userAgent st
 	return user, nil
 }
 
-// Login authenticates a user and returns tokens
-func (s *AuthService) Login(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+// Login authenticates a user and returns tokens. Users enrolled in or required to
+// use MFA get a challenge token instead, to complete with CompleteMFALogin.
+func (s *AuthService) Login(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.LoginResponse, error) {
 	user, err := s.userRepo.GetByEmail(req.Email)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
@@ -140,17 +151,125 @@ func (s *AuthService) Login(req *dto.LoginRequest, ipAddress, userAgent string)
 			"email", user.Email)
 	}
 
-	tokens, err := s.generateTokens(user)
+	mfaEnabled, err := s.mfaService.IsEnabled(user.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate tokens: %w", err)
+		return nil, fmt.Errorf("failed to check MFA enrollment: %w", err)
+	}
+
+	if mfaEnabled || user.MustUseMFA() {
+		challengeToken, challengeExpiresAt, err := s.tokenService.GenerateMFAChallengeToken(user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate MFA challenge: %w", err)
+		}
+
+		s.createAuditLog(&user.ID, models.AuditActionMFAChallenged, "user", user.ID.String(), ipAddress, userAgent, map[string]interface{}{
+			"enrollment_required": !mfaEnabled,
+		})
+
+		return &dto.LoginResponse{
+			MFARequired:           true,
+			MFAEnrollmentRequired: !mfaEnabled,
+			ChallengeToken:        challengeToken,
+			ChallengeExpiresAt:    &challengeExpiresAt,
+		}, nil
+	}
+
+	tokens, err := s.startSession(user, req.DeviceName, time.Time{}, ipAddress, userAgent)
+	if err != nil {
+		return nil, err
 	}
 
 	s.auditSuccessfulLogin(user, ipAddress, userAgent)
 
-	return tokens, nil
+	return &dto.LoginResponse{TokenResponse: tokens}, nil
+}
+
+// CompleteMFALogin exchanges a login challenge and a code for tokens. Users who
+// must enroll during login confirm their new authenticator with the code and get
+// their recovery codes in the response. Each challenge can be completed once.
+func (s *AuthService) CompleteMFALogin(req *dto.MFALoginRequest, ipAddress, userAgent string) (*dto.LoginResponse, error) {
+	user, claims, err := s.validateMFAChallenge(req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaEnabled, err := s.mfaService.IsEnabled(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check MFA enrollment: %w", err)
+	}
+
+	response := &dto.LoginResponse{}
+	if mfaEnabled {
+		if err := s.mfaService.Verify(user.ID, req.Code, MFAPurposeLogin, ipAddress, userAgent); err != nil {
+			return nil, err
+		}
+	} else {
+		recoveryCodes, err := s.mfaService.ConfirmEnrollment(user.ID, req.Code, ipAddress, userAgent)
+		if err != nil {
+			return nil, err
+		}
+		response.RecoveryCodes = recoveryCodes.RecoveryCodes
+	}
+
+	if err := s.blacklistToken(claims.ID, user.ID, claims.ExpiresAt.Time); err != nil {
+		return nil, fmt.Errorf("failed to consume MFA challenge: %w", err)
+	}
+
+	tokens, err := s.startSession(user, req.DeviceName, time.Now(), ipAddress, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditSuccessfulLogin(user, ipAddress, userAgent)
+
+	response.TokenResponse = tokens
+	return response, nil
+}
+
+// BeginMFALoginEnrollment starts authenticator enrollment for a user who is
+// required to use MFA but has not enrolled, using their login challenge
+func (s *AuthService) BeginMFALoginEnrollment(challengeToken, ipAddress, userAgent string) (*dto.MFAEnrollmentResponse, error) {
+	user, _, err := s.validateMFAChallenge(challengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.mfaService.BeginEnrollment(user.ID, ipAddress, userAgent)
+}
+
+// StepUp verifies a code for a signed-in user and returns an access token for
+// their session that records the verification, for operations that require a
+// recent MFA
+func (s *AuthService) StepUp(userID, sessionID uuid.UUID, code, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.mfaService.Verify(userID, code, MFAPurposeStepUp, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+
+	permissions, err := s.roleRepo.GetPermissions(user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+
+	accessToken, expiresAt, err := s.tokenService.GenerateSessionAccessToken(user, permissions, sessionID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	return &dto.TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresAt:   expiresAt,
+	}, nil
 }
 
-// RefreshTokens generates new tokens using a refresh token
+// RefreshTokens rotates a refresh token: it is exchanged once for a new token in
+// the same session. A rotated token presented again means the session's tokens
+// have leaked, so the whole session is revoked.
 func (s *AuthService) RefreshTokens(refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error) {
 	claims, err := s.tokenService.ValidateRefreshToken(refreshToken)
 	if err != nil {
@@ -169,6 +288,12 @@ func (s *AuthService) RefreshTokens(refreshToken, ipAddress, userAgent string) (
 		return nil, ErrInvalidRefreshToken
 	}
 
+	if storedToken.IsRotated() {
+		s.auditFailedTokenRefresh(claims.UserID, ipAddress, userAgent, "token_reused")
+		s.sessionService.HandleRefreshTokenReuse(storedToken, ipAddress, userAgent)
+		return nil, ErrInvalidRefreshToken
+	}
+
 	if !storedToken.IsValid() {
 		s.auditFailedTokenRefresh(claims.UserID, ipAddress, userAgent, "token_expired_or_revoked")
 		return nil, ErrInvalidRefreshToken
@@ -179,18 +304,44 @@ func (s *AuthService) RefreshTokens(refreshToken, ipAddress, userAgent string) (
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	storedToken.Revoke()
-	if err := s.refreshTokenRepo.Update(storedToken); err != nil {
-		// Non-critical: Token revocation failure shouldn't block refresh
-		s.logger.Warn("failed to revoke old token",
-			"error", err,
-			"user_id", user.ID,
-			"token_id", storedToken.ID)
+	// Security: Only one of two requests racing with the same token may rotate it
+	rotated, err := s.refreshTokenRepo.MarkRotated(storedToken.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if !rotated {
+		s.auditFailedTokenRefresh(claims.UserID, ipAddress, userAgent, "token_reused")
+		s.sessionService.HandleRefreshTokenReuse(storedToken, ipAddress, userAgent)
+		return nil, ErrInvalidRefreshToken
+	}
+
+	refreshToken, refreshExpiresAt, err := s.tokenService.GenerateRefreshToken(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	var sessionID uuid.UUID
+	if storedToken.SessionID != nil {
+		sessionID = *storedToken.SessionID
+		if err := s.sessionService.RecordUse(sessionID, ipAddress, userAgent, refreshExpiresAt); err != nil {
+			if errors.Is(err, ErrSessionNotFound) {
+				s.auditFailedTokenRefresh(claims.UserID, ipAddress, userAgent, "session_revoked")
+				return nil, ErrInvalidRefreshToken
+			}
+			return nil, err
+		}
+	} else {
+		// Tokens issued before sessions existed join a new session on refresh
+		session, err := s.sessionService.CreateSession(user.ID, "", ipAddress, userAgent, refreshExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		sessionID = session.ID
 	}
 
-	tokens, err := s.generateTokens(user)
+	tokens, err := s.issueTokens(user, sessionID, refreshToken, refreshExpiresAt, time.Time{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate new tokens: %w", err)
+		return nil, err
 	}
 
 	s.auditSuccessfulTokenRefresh(user, ipAddress, userAgent)
@@ -198,7 +349,8 @@ func (s *AuthService) RefreshTokens(refreshToken, ipAddress, userAgent string) (
 	return tokens, nil
 }
 
-// Logout invalidates the user's tokens
+// Logout invalidates the user's tokens. Tokens issued to a session sign out only
+// that session; older tokens sign out all of the user's refresh tokens.
 func (s *AuthService) Logout(accessToken, ipAddress, userAgent string) error {
 	claims, err := s.tokenService.ValidateAccessToken(accessToken)
 	if err != nil {
@@ -224,7 +376,15 @@ func (s *AuthService) Logout(accessToken, ipAddress, userAgent string) error {
 			"user_id", userID)
 	}
 
-	if err := s.refreshTokenRepo.RevokeAllForUser(userID); err != nil {
+	if sessionID, err := uuid.Parse(claims.SessionID); err == nil {
+		if err := s.sessionService.RevokeSession(userID, sessionID, userID, models.SessionRevokedLogout, ipAddress, userAgent); err != nil && !errors.Is(err, ErrSessionNotFound) {
+			// Non-critical: The access token is blacklisted regardless
+			s.logger.Warn("failed to revoke session",
+				"error", err,
+				"user_id", userID,
+				"session_id", sessionID)
+		}
+	} else if err := s.refreshTokenRepo.RevokeAllForUser(userID); err != nil {
 		// Non-critical: Token revocation failure shouldn't block refresh
 		s.logger.Warn("failed to revoke refresh tokens",
 			"error", err,
@@ -236,19 +396,40 @@ func (s *AuthService) Logout(accessToken, ipAddress, userAgent string) error {
 	return nil
 }
 
-func (s *AuthService) generateTokens(user *models.User) (*dto.TokenResponse, error) {
-	accessToken, expiresAt, err := s.tokenService.GenerateAccessToken(user)
+// startSession starts a session for a login and issues its first tokens. A
+// non-zero mfaVerifiedAt is recorded in the access token for step-up checks.
+func (s *AuthService) startSession(user *models.User, deviceName string, mfaVerifiedAt time.Time, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+	refreshToken, refreshExpiresAt, err := s.tokenService.GenerateRefreshToken(user.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate access token: %w", err)
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
-	refreshToken, refreshExpiresAt, err := s.tokenService.GenerateRefreshToken(user.ID)
+	session, err := s.sessionService.CreateSession(user.ID, deviceName, ipAddress, userAgent, refreshExpiresAt)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+		return nil, err
+	}
+
+	return s.issueTokens(user, session.ID, refreshToken, refreshExpiresAt, mfaVerifiedAt)
+}
+
+// issueTokens issues an access token for a session and stores the refresh token
+// in the session's token family
+func (s *AuthService) issueTokens(user *models.User, sessionID uuid.UUID, refreshToken string, refreshExpiresAt, mfaVerifiedAt time.Time) (*dto.TokenResponse, error) {
+	// Permissions are looked up on every issue, so a role change applies from the
+	// user's next login or refresh
+	permissions, err := s.roleRepo.GetPermissions(user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+
+	accessToken, expiresAt, err := s.tokenService.GenerateSessionAccessToken(user, permissions, sessionID, mfaVerifiedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
 	refreshTokenModel := &models.RefreshToken{
 		UserID:    user.ID,
+		SessionID: &sessionID,
 		TokenHash: hashToken(refreshToken),
 		ExpiresAt: refreshExpiresAt,
 	}
@@ -265,6 +446,38 @@ func (s *AuthService) generateTokens(user *models.User) (*dto.TokenResponse, err
 	}, nil
 }
 
+// validateMFAChallenge checks a login challenge token has not expired or been
+// used, and returns the user it was issued to
+func (s *AuthService) validateMFAChallenge(challengeToken string) (*models.User, *models.CustomClaims, error) {
+	claims, err := s.tokenService.ValidateMFAChallengeToken(challengeToken)
+	if err != nil {
+		return nil, nil, ErrInvalidMFAChallenge
+	}
+
+	if blacklisted, err := s.blacklistedTokenRepo.GetByJTI(claims.ID); err == nil && blacklisted != nil {
+		return nil, nil, ErrInvalidMFAChallenge
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return nil, nil, ErrInvalidMFAChallenge
+	}
+
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return nil, nil, ErrInvalidMFAChallenge
+		}
+		return nil, nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if user.IsLocked() {
+		return nil, nil, ErrAccountLocked
+	}
+
+	return user, claims, nil
+}
+
 func (s *AuthService) blacklistToken(jti string, userID uuid.UUID, expiresAt time.Time) error {
 	token := &models.BlacklistedToken{
 		JTI:       jti,
@@ -344,7 +557,7 @@ func (s *AuthService) auditFailedAccountCreation(user *models.User, ipAddress, u
 }
 
 func (s *AuthService) createAuditLog(userID *uuid.UUID, action, resource, resourceID, ipAddress, userAgent string, metadata map[string]interface{}) {
-	log := &models.AuditLog{
+	recordAuditLog(s.auditRepo, s.logger, &models.AuditLog{
 		UserID:     userID,
 		Action:     action,
 		Resource:   resource,
@@ -352,14 +565,5 @@ func (s *AuthService) createAuditLog(userID *uuid.UUID, action, resource, resour
 		IPAddress:  ipAddress,
 		UserAgent:  userAgent,
 		Metadata:   metadata,
-	}
-
-	if err := s.auditRepo.Create(log); err != nil {
-		// Non-critical: Audit logging failure shouldn't block operations
-		s.logger.Error("failed to create audit log",
-			"error", err,
-			"action", action,
-			"resource", resource,
-			"resource_id", resourceID)
-	}
+	})
 }
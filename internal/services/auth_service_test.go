@@ -28,6 +28,9 @@ type AuthServiceTestSuite struct {
 	passwordService      *service_mocks.MockPasswordServiceInterface
 	tokenService         *service_mocks.MockTokenServiceInterface
 	accountService       *service_mocks.MockAccountServiceInterface
+	mfaService           *service_mocks.MockMFAServiceInterface
+	sessionService       *service_mocks.MockSessionServiceInterface
+	roleRepo             *repository_mocks.MockRoleRepositoryInterface
 	authService          AuthServiceInterface
 }
 
@@ -40,7 +43,10 @@ func (s *AuthServiceTestSuite) SetupTest() {
 	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
 	s.blacklistedTokenRepo = repository_mocks.NewMockBlacklistedTokenRepositoryInterface(s.ctrl)
 	s.passwordService = service_mocks.NewMockPasswordServiceInterface(s.ctrl)
-	s.authService = NewAuthService(s.userRepo, s.refreshTokenRepo, s.auditRepo, s.blacklistedTokenRepo, s.passwordService, s.tokenService, s.accountService, slog.Default())
+	s.mfaService = service_mocks.NewMockMFAServiceInterface(s.ctrl)
+	s.sessionService = service_mocks.NewMockSessionServiceInterface(s.ctrl)
+	s.roleRepo = repository_mocks.NewMockRoleRepositoryInterface(s.ctrl)
+	s.authService = NewAuthService(s.userRepo, s.refreshTokenRepo, s.auditRepo, s.blacklistedTokenRepo, s.roleRepo, s.passwordService, s.tokenService, s.accountService, s.mfaService, s.sessionService, slog.Default())
 }
 
 func (s *AuthServiceTestSuite) TearDownTest() {
@@ -138,9 +144,11 @@ func (s *AuthServiceTestSuite) TestLogin_SuccessfulLogin() {
 	}
 
 	req := &dto.LoginRequest{
-		Email:    email,
-		Password: password,
+		Email:      email,
+		Password:   password,
+		DeviceName: "Work laptop",
 	}
+	session := &models.Session{ID: uuid.New(), UserID: userID}
 
 	expiresAt := time.Now().Add(15 * time.Minute)
 
@@ -148,9 +156,15 @@ func (s *AuthServiceTestSuite) TestLogin_SuccessfulLogin() {
 	s.userRepo.EXPECT().GetByEmail(email).Return(user, nil).Times(1)
 	s.passwordService.EXPECT().ComparePassword(password, user.PasswordHash).Return(true).Times(1)
 	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
-	s.tokenService.EXPECT().GenerateAccessToken(user).Return("access_token", expiresAt, nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(userID).Return(false, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("refresh_token", time.Now().Add(7*24*time.Hour), nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.sessionService.EXPECT().CreateSession(userID, "Work laptop", "192.168.1.1", "Mozilla/5.0", gomock.Any()).Return(session, nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(user.Role).Return(nil, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user, nil, session.ID, time.Time{}).Return("access_token", expiresAt, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(token *models.RefreshToken) error {
+		s.Equal(&session.ID, token.SessionID, "the refresh token starts the session's token family")
+		return nil
+	}).Times(1)
 	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // successful login audit log
 
 	tokens, err := s.authService.Login(req, "192.168.1.1", "Mozilla/5.0")
@@ -163,6 +177,62 @@ func (s *AuthServiceTestSuite) TestLogin_SuccessfulLogin() {
 	s.True(tokens.ExpiresAt.After(time.Now()))
 }
 
+func (s *AuthServiceTestSuite) TestLogin_StaffWithoutMFAMustEnroll() {
+	user := &models.User{
+		ID:           uuid.New(),
+		Email:        "teller@example.com",
+		PasswordHash: "hashed_password",
+		Role:         models.RoleTeller,
+	}
+
+	s.userRepo.EXPECT().GetByEmail(user.Email).Return(user, nil).Times(1)
+	s.passwordService.EXPECT().ComparePassword("SecurePass123!@#", user.PasswordHash).Return(true).Times(1)
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(user.ID).Return(false, nil).Times(1)
+	s.tokenService.EXPECT().GenerateMFAChallengeToken(user.ID).Return("challenge_token", time.Now().Add(5*time.Minute), nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	response, err := s.authService.Login(&dto.LoginRequest{Email: user.Email, Password: "SecurePass123!@#"}, "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.Nil(response.TokenResponse, "staff get no tokens without MFA")
+	s.True(response.MFARequired)
+	s.True(response.MFAEnrollmentRequired)
+}
+
+func (s *AuthServiceTestSuite) TestCompleteMFALogin_StaffTokenCarriesRolePermissions() {
+	user := &models.User{
+		ID:           uuid.New(),
+		Email:        "teller@example.com",
+		PasswordHash: "hashed_password",
+		Role:         models.RoleTeller,
+	}
+	claims := &models.CustomClaims{
+		UserID:           user.ID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{ID: "challenge-jti", ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute))},
+	}
+	session := &models.Session{ID: uuid.New(), UserID: user.ID}
+	permissions := []string{models.PermissionCustomersRead, models.PermissionTransactionsPost}
+
+	s.tokenService.EXPECT().ValidateMFAChallengeToken("challenge_token").Return(claims, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().GetByJTI("challenge-jti").Return(nil, repositories.ErrTokenNotFound).Times(1)
+	s.userRepo.EXPECT().GetByID(user.ID).Return(user, nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(user.ID).Return(true, nil).Times(1)
+	s.mfaService.EXPECT().Verify(user.ID, "123456", MFAPurposeLogin, "192.168.1.1", "Mozilla/5.0").Return(nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.tokenService.EXPECT().GenerateRefreshToken(user.ID).Return("refresh_token", time.Now().Add(time.Hour), nil).Times(1)
+	s.sessionService.EXPECT().CreateSession(user.ID, "", "192.168.1.1", "Mozilla/5.0", gomock.Any()).Return(session, nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(models.RoleTeller).Return(permissions, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user, permissions, session.ID, gomock.Not(time.Time{})).Return("access_token", time.Now().Add(time.Hour), nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	response, err := s.authService.CompleteMFALogin(&dto.MFALoginRequest{ChallengeToken: "challenge_token", Code: "123456"}, "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.Equal("access_token", response.AccessToken)
+}
+
 func (s *AuthServiceTestSuite) TestLogin_InvalidPassword() {
 	email := "test2@example.com"
 	userID := uuid.New()
@@ -280,8 +350,11 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_SuccessfulTokenRefresh() {
 		Role:      models.RoleCustomer,
 	}
 
+	sessionID := uuid.New()
 	storedToken := &models.RefreshToken{
+		ID:        uuid.New(),
 		UserID:    userID,
+		SessionID: &sessionID,
 		TokenHash: tokenHash,
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 		RevokedAt: nil,
@@ -297,10 +370,15 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_SuccessfulTokenRefresh() {
 	s.tokenService.EXPECT().ValidateRefreshToken(refreshToken).Return(claims, nil).Times(1)
 	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(storedToken, nil).Times(1)
 	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Update(gomock.Any()).Return(nil).Times(1) // Revoke old token
-	s.tokenService.EXPECT().GenerateAccessToken(user).Return("new_access_token", expiresAt, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().MarkRotated(storedToken.ID).Return(true, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("new_refresh_token", time.Now().Add(7*24*time.Hour), nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.sessionService.EXPECT().RecordUse(sessionID, "192.168.1.1", "Mozilla/5.0", gomock.Any()).Return(nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(user.Role).Return(nil, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user, nil, sessionID, time.Time{}).Return("new_access_token", expiresAt, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(token *models.RefreshToken) error {
+		s.Equal(&sessionID, token.SessionID, "the new token joins the session's token family")
+		return nil
+	}).Times(1)
 	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // successful token refresh audit log
 
 	newTokens, err := s.authService.RefreshTokens(refreshToken, "192.168.1.1", "Mozilla/5.0")
@@ -341,8 +419,11 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_UsingRevokedRefreshToken() {
 	}
 
 	// First refresh - should work
+	sessionID := uuid.New()
 	storedToken := &models.RefreshToken{
+		ID:        uuid.New(),
 		UserID:    userID,
+		SessionID: &sessionID,
 		TokenHash: tokenHash,
 		ExpiresAt: now.Add(7 * 24 * time.Hour),
 		RevokedAt: nil,
@@ -354,9 +435,11 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_UsingRevokedRefreshToken() {
 	s.tokenService.EXPECT().ValidateRefreshToken(refreshToken).Return(claims, nil).Times(1)
 	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(storedToken, nil).Times(1)
 	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Update(gomock.Any()).Return(nil).Times(1) // Revoke old token
-	s.tokenService.EXPECT().GenerateAccessToken(user).Return("new_access_token", expiresAt, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().MarkRotated(storedToken.ID).Return(true, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("new_refresh_token", now.Add(7*24*time.Hour), nil).Times(1)
+	s.sessionService.EXPECT().RecordUse(sessionID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(user.Role).Return(nil, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user, nil, sessionID, time.Time{}).Return("new_access_token", expiresAt, nil).Times(1)
 	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
 	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
 
@@ -368,6 +451,7 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_UsingRevokedRefreshToken() {
 	revokedTime := now
 	revokedToken := &models.RefreshToken{
 		UserID:    userID,
+		SessionID: &sessionID,
 		TokenHash: tokenHash,
 		ExpiresAt: now.Add(7 * 24 * time.Hour),
 		RevokedAt: &revokedTime,
@@ -382,6 +466,133 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_UsingRevokedRefreshToken() {
 	s.Nil(tokens2)
 }
 
+func (s *AuthServiceTestSuite) TestRefreshTokens_ReusedTokenRevokesSession() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	rotatedAt := time.Now().Add(-time.Minute)
+
+	rotatedToken := &models.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		SessionID: &sessionID,
+		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+		RotatedAt: &rotatedAt,
+	}
+	claims := &models.CustomClaims{UserID: userID.String()}
+
+	s.tokenService.EXPECT().ValidateRefreshToken("stolen_refresh_token").Return(claims, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(rotatedToken, nil).Times(1)
+	s.sessionService.EXPECT().HandleRefreshTokenReuse(rotatedToken, "203.0.113.9", "curl/8.0").Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // failed token refresh audit log
+
+	tokens, err := s.authService.RefreshTokens("stolen_refresh_token", "203.0.113.9", "curl/8.0")
+
+	s.ErrorIs(err, ErrInvalidRefreshToken)
+	s.Nil(tokens)
+}
+
+func (s *AuthServiceTestSuite) TestRefreshTokens_ConcurrentRotationIsReuse() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	user := &models.User{ID: userID, Email: "race@example.com", Role: models.RoleCustomer}
+
+	storedToken := &models.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		SessionID: &sessionID,
+		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+	}
+	claims := &models.CustomClaims{UserID: userID.String()}
+
+	// Another request rotated the token between the lookup and the rotation
+	s.tokenService.EXPECT().ValidateRefreshToken("refresh_token").Return(claims, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(storedToken, nil).Times(1)
+	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().MarkRotated(storedToken.ID).Return(false, nil).Times(1)
+	s.sessionService.EXPECT().HandleRefreshTokenReuse(storedToken, gomock.Any(), gomock.Any()).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	tokens, err := s.authService.RefreshTokens("refresh_token", "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrInvalidRefreshToken)
+	s.Nil(tokens)
+}
+
+func (s *AuthServiceTestSuite) TestRefreshTokens_RevokedSession() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	user := &models.User{ID: userID, Email: "revoked@example.com", Role: models.RoleCustomer}
+
+	storedToken := &models.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		SessionID: &sessionID,
+		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+	}
+	claims := &models.CustomClaims{UserID: userID.String()}
+
+	s.tokenService.EXPECT().ValidateRefreshToken("refresh_token").Return(claims, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(storedToken, nil).Times(1)
+	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().MarkRotated(storedToken.ID).Return(true, nil).Times(1)
+	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("new_refresh_token", time.Now().Add(7*24*time.Hour), nil).Times(1)
+	s.sessionService.EXPECT().RecordUse(sessionID, gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrSessionNotFound).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	tokens, err := s.authService.RefreshTokens("refresh_token", "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrInvalidRefreshToken)
+	s.Nil(tokens)
+}
+
+func (s *AuthServiceTestSuite) TestRefreshTokens_TokenWithoutSessionStartsOne() {
+	userID := uuid.New()
+	user := &models.User{ID: userID, Email: "legacy@example.com", Role: models.RoleCustomer}
+	session := &models.Session{ID: uuid.New(), UserID: userID}
+
+	storedToken := &models.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+	}
+	claims := &models.CustomClaims{UserID: userID.String()}
+
+	s.tokenService.EXPECT().ValidateRefreshToken("refresh_token").Return(claims, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(storedToken, nil).Times(1)
+	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().MarkRotated(storedToken.ID).Return(true, nil).Times(1)
+	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("new_refresh_token", time.Now().Add(7*24*time.Hour), nil).Times(1)
+	s.sessionService.EXPECT().CreateSession(userID, "", "192.168.1.1", "Mozilla/5.0", gomock.Any()).Return(session, nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(user.Role).Return(nil, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user, nil, session.ID, time.Time{}).Return("new_access_token", time.Now().Add(15*time.Minute), nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	tokens, err := s.authService.RefreshTokens("refresh_token", "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.Equal("new_access_token", tokens.AccessToken)
+}
+
+func (s *AuthServiceTestSuite) TestLogout_RevokesCurrentSession() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	claims := &models.CustomClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ID: "jti-123"},
+		UserID:           userID.String(),
+		SessionID:        sessionID.String(),
+	}
+
+	s.tokenService.EXPECT().ValidateAccessToken("access_token").Return(claims, nil).Times(1)
+	s.tokenService.EXPECT().GetTokenExpiry("access_token").Return(time.Now().Add(15*time.Minute), nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.sessionService.EXPECT().RevokeSession(userID, sessionID, userID, models.SessionRevokedLogout, "192.168.1.1", "Mozilla/5.0").Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // logout audit log
+
+	s.NoError(s.authService.Logout("access_token", "192.168.1.1", "Mozilla/5.0"))
+}
+
 func (s *AuthServiceTestSuite) TestLogout_SuccessfulLogout() {
 	userID := uuid.New()
 	accessToken := "valid_access_token"
@@ -527,7 +738,10 @@ func (s *AuthServiceTestSuite) TestPasswordHashing_PasswordsAreHashedDifferently
 	s.userRepo.EXPECT().GetByEmail(req1.Email).Return(user1Model, nil).Times(1)
 	s.passwordService.EXPECT().ComparePassword(password, "hashed_password_1").Return(true).Times(1)
 	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
-	s.tokenService.EXPECT().GenerateAccessToken(user1Model).Return("access_token_1", expiresAt, nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(userID1).Return(false, nil).Times(1)
+	s.sessionService.EXPECT().CreateSession(userID1, "", gomock.Any(), gomock.Any(), gomock.Any()).Return(&models.Session{ID: uuid.New()}, nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(user1Model.Role).Return(nil, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user1Model, nil, gomock.Any(), time.Time{}).Return("access_token_1", expiresAt, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID1).Return("refresh_token_1", time.Now().Add(7*24*time.Hour), nil).Times(1)
 	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
 	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
@@ -540,7 +754,10 @@ func (s *AuthServiceTestSuite) TestPasswordHashing_PasswordsAreHashedDifferently
 	s.userRepo.EXPECT().GetByEmail(req2.Email).Return(user2Model, nil).Times(1)
 	s.passwordService.EXPECT().ComparePassword(password, "hashed_password_2").Return(true).Times(1)
 	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
-	s.tokenService.EXPECT().GenerateAccessToken(user2Model).Return("access_token_2", expiresAt, nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(userID2).Return(false, nil).Times(1)
+	s.sessionService.EXPECT().CreateSession(userID2, "", gomock.Any(), gomock.Any(), gomock.Any()).Return(&models.Session{ID: uuid.New()}, nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(user2Model.Role).Return(nil, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user2Model, nil, gomock.Any(), time.Time{}).Return("access_token_2", expiresAt, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID2).Return("refresh_token_2", time.Now().Add(7*24*time.Hour), nil).Times(1)
 	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
 	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
@@ -610,3 +827,162 @@ func (s *AuthServiceTestSuite) TestAuditLogging_FailedLoginCreatesAuditLog() {
 	s.Equal("192.168.1.2", capturedAuditLog.IPAddress)
 	s.Equal("TestAgent2", capturedAuditLog.UserAgent)
 }
+
+func (s *AuthServiceTestSuite) TestLogin_MFAEnabledReturnsChallenge() {
+	userID := uuid.New()
+	user := &models.User{ID: userID, Email: "mfa@example.com", PasswordHash: "hashed_password", Role: models.RoleCustomer}
+	req := &dto.LoginRequest{Email: user.Email, Password: "SecurePass123!"}
+	challengeExpiresAt := time.Now().Add(5 * time.Minute)
+
+	s.userRepo.EXPECT().GetByEmail(user.Email).Return(user, nil).Times(1)
+	s.passwordService.EXPECT().ComparePassword(req.Password, user.PasswordHash).Return(true).Times(1)
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(userID).Return(true, nil).Times(1)
+	s.tokenService.EXPECT().GenerateMFAChallengeToken(userID).Return("challenge_token", challengeExpiresAt, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionMFAChallenged, log.Action)
+		return nil
+	}).Times(1)
+
+	response, err := s.authService.Login(req, "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.Nil(response.TokenResponse, "no tokens are issued before the MFA code")
+	s.True(response.MFARequired)
+	s.False(response.MFAEnrollmentRequired)
+	s.Equal("challenge_token", response.ChallengeToken)
+}
+
+func (s *AuthServiceTestSuite) TestLogin_MFARequiredButNotEnrolled() {
+	userID := uuid.New()
+	user := &models.User{ID: userID, Email: "mfa@example.com", PasswordHash: "hashed_password", Role: models.RoleCustomer, MFARequired: true}
+	req := &dto.LoginRequest{Email: user.Email, Password: "SecurePass123!"}
+
+	s.userRepo.EXPECT().GetByEmail(user.Email).Return(user, nil).Times(1)
+	s.passwordService.EXPECT().ComparePassword(req.Password, user.PasswordHash).Return(true).Times(1)
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(userID).Return(false, nil).Times(1)
+	s.tokenService.EXPECT().GenerateMFAChallengeToken(userID).Return("challenge_token", time.Now().Add(5*time.Minute), nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	response, err := s.authService.Login(req, "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.True(response.MFARequired)
+	s.True(response.MFAEnrollmentRequired)
+}
+
+func (s *AuthServiceTestSuite) TestCompleteMFALogin_Success() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	user := &models.User{ID: userID, Email: "mfa@example.com", Role: models.RoleCustomer}
+	claims := &models.CustomClaims{
+		UserID:           userID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{ID: "challenge-jti", ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute))},
+	}
+
+	s.tokenService.EXPECT().ValidateMFAChallengeToken("challenge_token").Return(claims, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().GetByJTI("challenge-jti").Return(nil, repositories.ErrTokenNotFound).Times(1)
+	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(userID).Return(true, nil).Times(1)
+	s.mfaService.EXPECT().Verify(userID, "123456", MFAPurposeLogin, "192.168.1.1", "Mozilla/5.0").Return(nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(token *models.BlacklistedToken) error {
+		s.Equal("challenge-jti", token.JTI, "the challenge is consumed")
+		return nil
+	}).Times(1)
+	s.sessionService.EXPECT().CreateSession(userID, "", "192.168.1.1", "Mozilla/5.0", gomock.Any()).Return(&models.Session{ID: sessionID}, nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(user.Role).Return(nil, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user, nil, sessionID, gomock.Not(time.Time{})).Return("access_token", time.Now().Add(15*time.Minute), nil).Times(1)
+	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("refresh_token", time.Now().Add(7*24*time.Hour), nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	response, err := s.authService.CompleteMFALogin(&dto.MFALoginRequest{ChallengeToken: "challenge_token", Code: "123456"}, "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.Equal("access_token", response.AccessToken)
+	s.Equal("refresh_token", response.RefreshToken)
+	s.Empty(response.RecoveryCodes)
+}
+
+func (s *AuthServiceTestSuite) TestCompleteMFALogin_ConfirmsEnrollment() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	user := &models.User{ID: userID, Email: "mfa@example.com", Role: models.RoleCustomer, MFARequired: true}
+	claims := &models.CustomClaims{
+		UserID:           userID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{ID: "challenge-jti", ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute))},
+	}
+
+	s.tokenService.EXPECT().ValidateMFAChallengeToken("challenge_token").Return(claims, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().GetByJTI("challenge-jti").Return(nil, repositories.ErrTokenNotFound).Times(1)
+	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(userID).Return(false, nil).Times(1)
+	s.mfaService.EXPECT().ConfirmEnrollment(userID, "123456", "192.168.1.1", "Mozilla/5.0").
+		Return(&dto.MFARecoveryCodesResponse{RecoveryCodes: []string{"aaaaa-bbbbb"}}, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.sessionService.EXPECT().CreateSession(userID, "", "192.168.1.1", "Mozilla/5.0", gomock.Any()).Return(&models.Session{ID: sessionID}, nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(user.Role).Return(nil, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user, nil, sessionID, gomock.Not(time.Time{})).Return("access_token", time.Now().Add(15*time.Minute), nil).Times(1)
+	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("refresh_token", time.Now().Add(7*24*time.Hour), nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	response, err := s.authService.CompleteMFALogin(&dto.MFALoginRequest{ChallengeToken: "challenge_token", Code: "123456"}, "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.Equal([]string{"aaaaa-bbbbb"}, response.RecoveryCodes)
+}
+
+func (s *AuthServiceTestSuite) TestCompleteMFALogin_UsedChallenge() {
+	userID := uuid.New()
+	claims := &models.CustomClaims{
+		UserID:           userID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{ID: "challenge-jti"},
+	}
+
+	s.tokenService.EXPECT().ValidateMFAChallengeToken("challenge_token").Return(claims, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().GetByJTI("challenge-jti").Return(&models.BlacklistedToken{JTI: "challenge-jti"}, nil).Times(1)
+
+	response, err := s.authService.CompleteMFALogin(&dto.MFALoginRequest{ChallengeToken: "challenge_token", Code: "123456"}, "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrInvalidMFAChallenge)
+	s.Nil(response)
+}
+
+func (s *AuthServiceTestSuite) TestCompleteMFALogin_InvalidCode() {
+	userID := uuid.New()
+	user := &models.User{ID: userID, Email: "mfa@example.com", Role: models.RoleCustomer}
+	claims := &models.CustomClaims{
+		UserID:           userID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{ID: "challenge-jti"},
+	}
+
+	s.tokenService.EXPECT().ValidateMFAChallengeToken("challenge_token").Return(claims, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().GetByJTI("challenge-jti").Return(nil, repositories.ErrTokenNotFound).Times(1)
+	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
+	s.mfaService.EXPECT().IsEnabled(userID).Return(true, nil).Times(1)
+	s.mfaService.EXPECT().Verify(userID, "000000", MFAPurposeLogin, gomock.Any(), gomock.Any()).Return(ErrInvalidMFACode).Times(1)
+
+	response, err := s.authService.CompleteMFALogin(&dto.MFALoginRequest{ChallengeToken: "challenge_token", Code: "000000"}, "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrInvalidMFACode)
+	s.Nil(response)
+}
+
+func (s *AuthServiceTestSuite) TestStepUp_IssuesAccessTokenWithMFA() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	user := &models.User{ID: userID, Email: "mfa@example.com", Role: models.RoleCustomer}
+
+	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
+	s.mfaService.EXPECT().Verify(userID, "123456", MFAPurposeStepUp, "192.168.1.1", "Mozilla/5.0").Return(nil).Times(1)
+	s.roleRepo.EXPECT().GetPermissions(user.Role).Return(nil, nil).Times(1)
+	s.tokenService.EXPECT().GenerateSessionAccessToken(user, nil, sessionID, gomock.Not(time.Time{})).Return("access_token", time.Now().Add(15*time.Minute), nil).Times(1)
+
+	tokens, err := s.authService.StepUp(userID, sessionID, "123456", "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.Equal("access_token", tokens.AccessToken)
+	s.Empty(tokens.RefreshToken, "step-up only replaces the access token")
+}
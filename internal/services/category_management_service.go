@@ -0,0 +1,449 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCategoryNotFound        = errors.New("category not found")
+	ErrCategoryAlreadyExists   = errors.New("category already exists")
+	ErrInvalidParentCategory   = errors.New("invalid parent category")
+	ErrCategoryProtected       = errors.New("category cannot be deactivated")
+	ErrMerchantMappingNotFound = errors.New("merchant mapping not found")
+	ErrInvalidMerchantMapping  = errors.New("invalid merchant mapping")
+)
+
+// defaultMappingConfidence is used when a new mapping does not specify a confidence score
+const defaultMappingConfidence = 1.0
+
+// CategoryManagementService manages categories and merchant mapping rules and
+// keeps the categorization rules in sync with every change
+type CategoryManagementService struct {
+	categoryRepo    repositories.TransactionCategoryRepositoryInterface
+	mappingRepo     repositories.MerchantMappingRepositoryInterface
+	categoryService CategoryServiceInterface
+	logger          *slog.Logger
+}
+
+// NewCategoryManagementService creates a new category management service
+func NewCategoryManagementService(
+	categoryRepo repositories.TransactionCategoryRepositoryInterface,
+	mappingRepo repositories.MerchantMappingRepositoryInterface,
+	categoryService CategoryServiceInterface,
+	logger *slog.Logger,
+) CategoryManagementServiceInterface {
+	return &CategoryManagementService{
+		categoryRepo:    categoryRepo,
+		mappingRepo:     mappingRepo,
+		categoryService: categoryService,
+		logger:          logger,
+	}
+}
+
+// ListCategories returns the category hierarchy. Categories whose parent is
+// not part of the result are returned at the top level.
+func (s *CategoryManagementService) ListCategories(includeInactive bool) ([]*models.CategoryNode, error) {
+	var categories []models.TransactionCategory
+	var err error
+
+	if includeInactive {
+		categories, err = s.categoryRepo.GetAll()
+	} else {
+		categories, err = s.categoryRepo.GetActive()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	return buildCategoryTree(categories), nil
+}
+
+// GetCategory retrieves a category by code
+func (s *CategoryManagementService) GetCategory(code string) (*models.TransactionCategory, error) {
+	category, err := s.categoryRepo.GetByCode(normalizeCategoryCode(code))
+	if err != nil {
+		if errors.Is(err, repositories.ErrCategoryNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	return category, nil
+}
+
+// CreateCategory creates a new active category
+func (s *CategoryManagementService) CreateCategory(req *dto.CreateCategoryRequest) (*models.TransactionCategory, error) {
+	code := normalizeCategoryCode(req.Code)
+
+	if _, err := s.GetCategory(code); err == nil {
+		return nil, ErrCategoryAlreadyExists
+	} else if !errors.Is(err, ErrCategoryNotFound) {
+		return nil, err
+	}
+
+	category := &models.TransactionCategory{
+		Code:         code,
+		Name:         strings.TrimSpace(req.Name),
+		Description:  req.Description,
+		Icon:         req.Icon,
+		IsActive:     true,
+		DisplayOrder: req.DisplayOrder,
+	}
+
+	if parent := normalizeParentCode(req.ParentCategoryCode); parent != nil {
+		if _, err := s.GetCategory(*parent); err != nil {
+			if errors.Is(err, ErrCategoryNotFound) {
+				return nil, ErrInvalidParentCategory
+			}
+			return nil, err
+		}
+		category.ParentCategoryCode = parent
+	}
+
+	if err := s.categoryRepo.Create(category); err != nil {
+		if errors.Is(err, repositories.ErrCategoryCodeExists) {
+			return nil, ErrCategoryAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	s.reloadRules()
+
+	return category, nil
+}
+
+// UpdateCategory applies a partial update to a category
+func (s *CategoryManagementService) UpdateCategory(code string, req *dto.UpdateCategoryRequest) (*models.TransactionCategory, error) {
+	category, err := s.GetCategory(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IsActive != nil && !*req.IsActive && category.Code == models.CategoryOther {
+		return nil, ErrCategoryProtected
+	}
+
+	if req.ParentCategoryCode != nil {
+		parent := normalizeParentCode(req.ParentCategoryCode)
+		if parent != nil {
+			categories, err := s.categoryRepo.GetAll()
+			if err != nil {
+				return nil, fmt.Errorf("failed to load categories: %w", err)
+			}
+
+			parents := parentIndex(categories)
+			if _, exists := parents[*parent]; !exists {
+				return nil, ErrInvalidParentCategory
+			}
+
+			parents[category.Code] = parent
+			if hasParentCycle(parents, category.Code) {
+				return nil, ErrInvalidParentCategory
+			}
+		}
+		category.ParentCategoryCode = parent
+	}
+
+	if req.Name != nil {
+		category.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		category.Description = *req.Description
+	}
+	if req.Icon != nil {
+		category.Icon = *req.Icon
+	}
+	if req.IsActive != nil {
+		category.IsActive = *req.IsActive
+	}
+	if req.DisplayOrder != nil {
+		category.DisplayOrder = *req.DisplayOrder
+	}
+
+	if err := s.categoryRepo.Update(category); err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	s.reloadRules()
+
+	return category, nil
+}
+
+// DeactivateCategory deactivates a category so it is no longer used for categorization
+func (s *CategoryManagementService) DeactivateCategory(code string) (*models.TransactionCategory, error) {
+	inactive := false
+	return s.UpdateCategory(code, &dto.UpdateCategoryRequest{IsActive: &inactive})
+}
+
+// ReorderCategories moves categories within the hierarchy and changes their display order
+func (s *CategoryManagementService) ReorderCategories(req *dto.ReorderCategoriesRequest) ([]*models.CategoryNode, error) {
+	categories, err := s.categoryRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load categories: %w", err)
+	}
+
+	parents := parentIndex(categories)
+	positions := make([]models.CategoryPosition, 0, len(req.Categories))
+	seen := make(map[string]bool, len(req.Categories))
+
+	for _, item := range req.Categories {
+		code := normalizeCategoryCode(item.Code)
+		if _, exists := parents[code]; !exists {
+			return nil, ErrCategoryNotFound
+		}
+		if seen[code] {
+			return nil, fmt.Errorf("%w: %s is listed more than once", ErrInvalidParentCategory, code)
+		}
+		seen[code] = true
+
+		parent := normalizeParentCode(item.ParentCategoryCode)
+		if parent != nil {
+			if _, exists := parents[*parent]; !exists {
+				return nil, ErrInvalidParentCategory
+			}
+		}
+
+		parents[code] = parent
+		positions = append(positions, models.CategoryPosition{
+			Code:               code,
+			ParentCategoryCode: parent,
+			DisplayOrder:       item.DisplayOrder,
+		})
+	}
+
+	for _, position := range positions {
+		if hasParentCycle(parents, position.Code) {
+			return nil, ErrInvalidParentCategory
+		}
+	}
+
+	if err := s.categoryRepo.UpdatePositions(positions); err != nil {
+		if errors.Is(err, repositories.ErrCategoryNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to reorder categories: %w", err)
+	}
+
+	s.reloadRules()
+
+	return s.ListCategories(true)
+}
+
+// ListMerchantMappings lists merchant mapping rules with pagination
+func (s *CategoryManagementService) ListMerchantMappings(filters models.MerchantMappingFilters, offset, limit int) ([]models.MerchantMapping, int64, error) {
+	if filters.CategoryCode != "" {
+		filters.CategoryCode = normalizeCategoryCode(filters.CategoryCode)
+	}
+
+	mappings, total, err := s.mappingRepo.List(filters, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list merchant mappings: %w", err)
+	}
+
+	return mappings, total, nil
+}
+
+// GetMerchantMapping retrieves a merchant mapping rule by ID
+func (s *CategoryManagementService) GetMerchantMapping(id uuid.UUID) (*models.MerchantMapping, error) {
+	mapping, err := s.mappingRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrMerchantMappingNotFound) {
+			return nil, ErrMerchantMappingNotFound
+		}
+		return nil, fmt.Errorf("failed to get merchant mapping: %w", err)
+	}
+
+	return mapping, nil
+}
+
+// CreateMerchantMapping creates a new active merchant mapping rule
+func (s *CategoryManagementService) CreateMerchantMapping(req *dto.CreateMerchantMappingRequest) (*models.MerchantMapping, error) {
+	mapping := &models.MerchantMapping{
+		MerchantPattern: strings.TrimSpace(req.MerchantPattern),
+		NormalizedName:  strings.TrimSpace(req.NormalizedName),
+		CategoryCode:    normalizeCategoryCode(req.CategoryCode),
+		MCCCode:         req.MCCCode,
+		MatchType:       req.MatchType,
+		ConfidenceScore: defaultMappingConfidence,
+		IsActive:        true,
+	}
+
+	if req.ConfidenceScore != nil {
+		mapping.ConfidenceScore = *req.ConfidenceScore
+	}
+	if mapping.NormalizedName == "" {
+		mapping.NormalizedName = mapping.MerchantPattern
+	}
+
+	if err := s.validateMerchantMapping(mapping); err != nil {
+		return nil, err
+	}
+
+	if err := s.mappingRepo.Create(mapping); err != nil {
+		return nil, fmt.Errorf("failed to create merchant mapping: %w", err)
+	}
+
+	s.reloadRules()
+
+	return mapping, nil
+}
+
+// UpdateMerchantMapping applies a partial update to a merchant mapping rule
+func (s *CategoryManagementService) UpdateMerchantMapping(id uuid.UUID, req *dto.UpdateMerchantMappingRequest) (*models.MerchantMapping, error) {
+	mapping, err := s.GetMerchantMapping(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MerchantPattern != nil {
+		mapping.MerchantPattern = strings.TrimSpace(*req.MerchantPattern)
+	}
+	if req.NormalizedName != nil {
+		mapping.NormalizedName = strings.TrimSpace(*req.NormalizedName)
+	}
+	if req.CategoryCode != nil {
+		mapping.CategoryCode = normalizeCategoryCode(*req.CategoryCode)
+	}
+	if req.MCCCode != nil {
+		mapping.MCCCode = req.MCCCode
+		if *req.MCCCode == "" {
+			mapping.MCCCode = nil
+		}
+	}
+	if req.MatchType != nil {
+		mapping.MatchType = *req.MatchType
+	}
+	if req.ConfidenceScore != nil {
+		mapping.ConfidenceScore = *req.ConfidenceScore
+	}
+	if req.IsActive != nil {
+		mapping.IsActive = *req.IsActive
+	}
+	if mapping.NormalizedName == "" {
+		mapping.NormalizedName = mapping.MerchantPattern
+	}
+
+	if err := s.validateMerchantMapping(mapping); err != nil {
+		return nil, err
+	}
+
+	if err := s.mappingRepo.Update(mapping); err != nil {
+		return nil, fmt.Errorf("failed to update merchant mapping: %w", err)
+	}
+
+	s.reloadRules()
+
+	return mapping, nil
+}
+
+// DeactivateMerchantMapping deactivates a merchant mapping rule
+func (s *CategoryManagementService) DeactivateMerchantMapping(id uuid.UUID) (*models.MerchantMapping, error) {
+	inactive := false
+	return s.UpdateMerchantMapping(id, &dto.UpdateMerchantMappingRequest{IsActive: &inactive})
+}
+
+// TestCategorization runs a sample transaction through the categorization
+// pipeline without recording rule usage
+func (s *CategoryManagementService) TestCategorization(req *dto.TestCategorizationRequest) *models.CategorizationResult {
+	return s.categoryService.PreviewCategorization(&models.Transaction{
+		Description:  req.Description,
+		MerchantName: req.MerchantName,
+		MCCCode:      req.MCCCode,
+	})
+}
+
+// validateMerchantMapping checks the mapping fields and that it points at an existing category
+func (s *CategoryManagementService) validateMerchantMapping(mapping *models.MerchantMapping) error {
+	if err := mapping.Validate(); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidMerchantMapping, err.Error())
+	}
+
+	if _, err := s.GetCategory(mapping.CategoryCode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// reloadRules refreshes the categorization rules after a change. A failed
+// reload is logged; the periodic reload picks the change up later.
+func (s *CategoryManagementService) reloadRules() {
+	if err := s.categoryService.Reload(); err != nil {
+		s.logger.Error("failed to reload categorization rules after change",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// buildCategoryTree nests categories under their parents, preserving the input order
+func buildCategoryTree(categories []models.TransactionCategory) []*models.CategoryNode {
+	nodes := make(map[string]*models.CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.Code] = &models.CategoryNode{TransactionCategory: category}
+	}
+
+	roots := make([]*models.CategoryNode, 0, len(categories))
+	for _, category := range categories {
+		node := nodes[category.Code]
+		if category.ParentCategoryCode != nil {
+			if parent, exists := nodes[*category.ParentCategoryCode]; exists && parent != node {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+
+	return roots
+}
+
+// parentIndex maps every category code to its parent code
+func parentIndex(categories []models.TransactionCategory) map[string]*string {
+	parents := make(map[string]*string, len(categories))
+	for _, category := range categories {
+		parents[category.Code] = category.ParentCategoryCode
+	}
+	return parents
+}
+
+// hasParentCycle reports whether following the parents of code leads back to code
+func hasParentCycle(parents map[string]*string, code string) bool {
+	visited := map[string]bool{code: true}
+
+	for parent := parents[code]; parent != nil; parent = parents[*parent] {
+		if visited[*parent] {
+			return true
+		}
+		visited[*parent] = true
+	}
+
+	return false
+}
+
+// normalizeCategoryCode converts a category code to its canonical upper-case form
+func normalizeCategoryCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// normalizeParentCode returns the canonical parent code, or nil for top-level categories
+func normalizeParentCode(code *string) *string {
+	if code == nil {
+		return nil
+	}
+
+	normalized := normalizeCategoryCode(*code)
+	if normalized == "" {
+		return nil
+	}
+
+	return &normalized
+}
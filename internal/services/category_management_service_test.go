@@ -0,0 +1,329 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type CategoryManagementServiceTestSuite struct {
+	suite.Suite
+	ctrl                *gomock.Controller
+	mockCategoryRepo    *repository_mocks.MockTransactionCategoryRepositoryInterface
+	mockMappingRepo     *repository_mocks.MockMerchantMappingRepositoryInterface
+	mockCategoryService *service_mocks.MockCategoryServiceInterface
+	service             CategoryManagementServiceInterface
+}
+
+func TestCategoryManagementServiceSuite(t *testing.T) {
+	suite.Run(t, new(CategoryManagementServiceTestSuite))
+}
+
+func (s *CategoryManagementServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockCategoryRepo = repository_mocks.NewMockTransactionCategoryRepositoryInterface(s.ctrl)
+	s.mockMappingRepo = repository_mocks.NewMockMerchantMappingRepositoryInterface(s.ctrl)
+	s.mockCategoryService = service_mocks.NewMockCategoryServiceInterface(s.ctrl)
+
+	s.service = NewCategoryManagementService(s.mockCategoryRepo, s.mockMappingRepo, s.mockCategoryService, slog.Default())
+}
+
+func (s *CategoryManagementServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func newTestCategory(code string, parent *string) models.TransactionCategory {
+	return models.TransactionCategory{
+		ID:                 uuid.New(),
+		Code:               code,
+		Name:               code,
+		ParentCategoryCode: parent,
+		IsActive:           true,
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func (s *CategoryManagementServiceTestSuite) TestListCategories_NestsChildren() {
+	food := newTestCategory("FOOD", nil)
+	dining := newTestCategory(models.CategoryDining, stringPtr("FOOD"))
+	coffee := newTestCategory("COFFEE", stringPtr(models.CategoryDining))
+	orphan := newTestCategory("ORPHAN", stringPtr("INACTIVE_PARENT"))
+
+	s.mockCategoryRepo.EXPECT().GetActive().Return([]models.TransactionCategory{food, dining, coffee, orphan}, nil)
+
+	tree, err := s.service.ListCategories(false)
+
+	s.NoError(err)
+	s.Require().Len(tree, 2)
+	s.Equal("FOOD", tree[0].Code)
+	s.Require().Len(tree[0].Children, 1)
+	s.Equal(models.CategoryDining, tree[0].Children[0].Code)
+	s.Require().Len(tree[0].Children[0].Children, 1)
+	s.Equal("COFFEE", tree[0].Children[0].Children[0].Code)
+	s.Equal("ORPHAN", tree[1].Code, "Categories with a missing parent are listed at the top level")
+}
+
+func (s *CategoryManagementServiceTestSuite) TestListCategories_IncludeInactive() {
+	s.mockCategoryRepo.EXPECT().GetAll().Return([]models.TransactionCategory{newTestCategory("FOOD", nil)}, nil)
+
+	tree, err := s.service.ListCategories(true)
+
+	s.NoError(err)
+	s.Len(tree, 1)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestCreateCategory_Success() {
+	s.mockCategoryRepo.EXPECT().GetByCode("COFFEE").Return(nil, repositories.ErrCategoryNotFound)
+	s.mockCategoryRepo.EXPECT().GetByCode(models.CategoryDining).Return(&models.TransactionCategory{Code: models.CategoryDining}, nil)
+	s.mockCategoryRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	s.mockCategoryService.EXPECT().Reload().Return(nil)
+
+	category, err := s.service.CreateCategory(&dto.CreateCategoryRequest{
+		Code:               " coffee ",
+		Name:               "Coffee Shops",
+		ParentCategoryCode: stringPtr("dining"),
+	})
+
+	s.NoError(err)
+	s.Equal("COFFEE", category.Code)
+	s.True(category.IsActive)
+	s.Require().NotNil(category.ParentCategoryCode)
+	s.Equal(models.CategoryDining, *category.ParentCategoryCode)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestCreateCategory_AlreadyExists() {
+	s.mockCategoryRepo.EXPECT().GetByCode(models.CategoryDining).Return(&models.TransactionCategory{Code: models.CategoryDining}, nil)
+
+	category, err := s.service.CreateCategory(&dto.CreateCategoryRequest{Code: models.CategoryDining, Name: "Dining"})
+
+	s.Nil(category)
+	s.ErrorIs(err, ErrCategoryAlreadyExists)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestCreateCategory_UnknownParent() {
+	s.mockCategoryRepo.EXPECT().GetByCode("COFFEE").Return(nil, repositories.ErrCategoryNotFound)
+	s.mockCategoryRepo.EXPECT().GetByCode("MISSING").Return(nil, repositories.ErrCategoryNotFound)
+
+	category, err := s.service.CreateCategory(&dto.CreateCategoryRequest{
+		Code:               "COFFEE",
+		Name:               "Coffee",
+		ParentCategoryCode: stringPtr("MISSING"),
+	})
+
+	s.Nil(category)
+	s.ErrorIs(err, ErrInvalidParentCategory)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestUpdateCategory_RejectsParentCycle() {
+	food := newTestCategory("FOOD", nil)
+	dining := newTestCategory(models.CategoryDining, stringPtr("FOOD"))
+
+	s.mockCategoryRepo.EXPECT().GetByCode("FOOD").Return(&food, nil)
+	s.mockCategoryRepo.EXPECT().GetAll().Return([]models.TransactionCategory{food, dining}, nil)
+
+	category, err := s.service.UpdateCategory("FOOD", &dto.UpdateCategoryRequest{ParentCategoryCode: stringPtr(models.CategoryDining)})
+
+	s.Nil(category)
+	s.ErrorIs(err, ErrInvalidParentCategory)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestUpdateCategory_EmptyParentMovesToTopLevel() {
+	dining := newTestCategory(models.CategoryDining, stringPtr("FOOD"))
+
+	s.mockCategoryRepo.EXPECT().GetByCode(models.CategoryDining).Return(&dining, nil)
+	s.mockCategoryRepo.EXPECT().Update(gomock.Any()).Return(nil)
+	s.mockCategoryService.EXPECT().Reload().Return(nil)
+
+	category, err := s.service.UpdateCategory(models.CategoryDining, &dto.UpdateCategoryRequest{
+		ParentCategoryCode: stringPtr(""),
+		Name:               stringPtr("Restaurants"),
+	})
+
+	s.NoError(err)
+	s.Nil(category.ParentCategoryCode)
+	s.Equal("Restaurants", category.Name)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestDeactivateCategory() {
+	dining := newTestCategory(models.CategoryDining, nil)
+
+	s.mockCategoryRepo.EXPECT().GetByCode(models.CategoryDining).Return(&dining, nil)
+	s.mockCategoryRepo.EXPECT().Update(gomock.Any()).Return(nil)
+	s.mockCategoryService.EXPECT().Reload().Return(errors.New("database unavailable"))
+
+	category, err := s.service.DeactivateCategory(models.CategoryDining)
+
+	s.NoError(err, "A failed rules reload must not fail the change")
+	s.False(category.IsActive)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestDeactivateCategory_OtherIsProtected() {
+	other := newTestCategory(models.CategoryOther, nil)
+	s.mockCategoryRepo.EXPECT().GetByCode(models.CategoryOther).Return(&other, nil)
+
+	category, err := s.service.DeactivateCategory(models.CategoryOther)
+
+	s.Nil(category)
+	s.ErrorIs(err, ErrCategoryProtected)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestDeactivateCategory_NotFound() {
+	s.mockCategoryRepo.EXPECT().GetByCode("MISSING").Return(nil, repositories.ErrCategoryNotFound)
+
+	category, err := s.service.DeactivateCategory("MISSING")
+
+	s.Nil(category)
+	s.ErrorIs(err, ErrCategoryNotFound)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestReorderCategories_Success() {
+	food := newTestCategory("FOOD", nil)
+	dining := newTestCategory(models.CategoryDining, nil)
+	moved := newTestCategory(models.CategoryDining, stringPtr("FOOD"))
+
+	s.mockCategoryRepo.EXPECT().GetAll().Return([]models.TransactionCategory{food, dining}, nil)
+	s.mockCategoryRepo.EXPECT().UpdatePositions([]models.CategoryPosition{
+		{Code: models.CategoryDining, ParentCategoryCode: stringPtr("FOOD"), DisplayOrder: 1},
+		{Code: "FOOD", ParentCategoryCode: nil, DisplayOrder: 2},
+	}).Return(nil)
+	s.mockCategoryService.EXPECT().Reload().Return(nil)
+	s.mockCategoryRepo.EXPECT().GetAll().Return([]models.TransactionCategory{food, moved}, nil)
+
+	tree, err := s.service.ReorderCategories(&dto.ReorderCategoriesRequest{
+		Categories: []dto.CategoryPositionRequest{
+			{Code: models.CategoryDining, ParentCategoryCode: stringPtr("FOOD"), DisplayOrder: 1},
+			{Code: "FOOD", DisplayOrder: 2},
+		},
+	})
+
+	s.NoError(err)
+	s.Require().Len(tree, 1)
+	s.Require().Len(tree[0].Children, 1)
+	s.Equal(models.CategoryDining, tree[0].Children[0].Code)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestReorderCategories_RejectsCycle() {
+	food := newTestCategory("FOOD", nil)
+	dining := newTestCategory(models.CategoryDining, stringPtr("FOOD"))
+
+	s.mockCategoryRepo.EXPECT().GetAll().Return([]models.TransactionCategory{food, dining}, nil)
+
+	tree, err := s.service.ReorderCategories(&dto.ReorderCategoriesRequest{
+		Categories: []dto.CategoryPositionRequest{
+			{Code: "FOOD", ParentCategoryCode: stringPtr(models.CategoryDining)},
+		},
+	})
+
+	s.Nil(tree)
+	s.ErrorIs(err, ErrInvalidParentCategory)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestReorderCategories_UnknownCategory() {
+	s.mockCategoryRepo.EXPECT().GetAll().Return([]models.TransactionCategory{newTestCategory("FOOD", nil)}, nil)
+
+	tree, err := s.service.ReorderCategories(&dto.ReorderCategoriesRequest{
+		Categories: []dto.CategoryPositionRequest{{Code: "MISSING"}},
+	})
+
+	s.Nil(tree)
+	s.ErrorIs(err, ErrCategoryNotFound)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestCreateMerchantMapping_AppliesDefaults() {
+	s.mockCategoryRepo.EXPECT().GetByCode(models.CategoryGroceries).Return(&models.TransactionCategory{Code: models.CategoryGroceries}, nil)
+	s.mockMappingRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	s.mockCategoryService.EXPECT().Reload().Return(nil)
+
+	mapping, err := s.service.CreateMerchantMapping(&dto.CreateMerchantMappingRequest{
+		MerchantPattern: "Trader Joe",
+		CategoryCode:    "groceries",
+		MatchType:       models.MatchTypePartial,
+	})
+
+	s.NoError(err)
+	s.Equal("Trader Joe", mapping.NormalizedName)
+	s.Equal(models.CategoryGroceries, mapping.CategoryCode)
+	s.Equal(1.0, mapping.ConfidenceScore)
+	s.True(mapping.IsActive)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestCreateMerchantMapping_InvalidRegex() {
+	mapping, err := s.service.CreateMerchantMapping(&dto.CreateMerchantMappingRequest{
+		MerchantPattern: "([",
+		CategoryCode:    models.CategoryGroceries,
+		MatchType:       models.MatchTypeRegex,
+	})
+
+	s.Nil(mapping)
+	s.ErrorIs(err, ErrInvalidMerchantMapping)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestCreateMerchantMapping_UnknownCategory() {
+	s.mockCategoryRepo.EXPECT().GetByCode("MISSING").Return(nil, repositories.ErrCategoryNotFound)
+
+	mapping, err := s.service.CreateMerchantMapping(&dto.CreateMerchantMappingRequest{
+		MerchantPattern: "Trader Joe",
+		CategoryCode:    "MISSING",
+		MatchType:       models.MatchTypePartial,
+	})
+
+	s.Nil(mapping)
+	s.ErrorIs(err, ErrCategoryNotFound)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestUpdateMerchantMapping() {
+	existing := newTestMapping("Kroger", models.CategoryGroceries, models.MatchTypePartial, 0.9)
+
+	s.mockMappingRepo.EXPECT().GetByID(existing.ID).Return(&existing, nil)
+	s.mockCategoryRepo.EXPECT().GetByCode(models.CategoryGroceries).Return(&models.TransactionCategory{Code: models.CategoryGroceries}, nil)
+	s.mockMappingRepo.EXPECT().Update(gomock.Any()).Return(nil)
+	s.mockCategoryService.EXPECT().Reload().Return(nil)
+
+	confidence := 0.75
+	mapping, err := s.service.UpdateMerchantMapping(existing.ID, &dto.UpdateMerchantMappingRequest{
+		MatchType:       stringPtr(models.MatchTypeRegex),
+		MerchantPattern: stringPtr(`(?i)^kroger #\d+`),
+		ConfidenceScore: &confidence,
+	})
+
+	s.NoError(err)
+	s.Equal(models.MatchTypeRegex, mapping.MatchType)
+	s.Equal(0.75, mapping.ConfidenceScore)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestDeactivateMerchantMapping_NotFound() {
+	id := uuid.New()
+	s.mockMappingRepo.EXPECT().GetByID(id).Return(nil, repositories.ErrMerchantMappingNotFound)
+
+	mapping, err := s.service.DeactivateMerchantMapping(id)
+
+	s.Nil(mapping)
+	s.ErrorIs(err, ErrMerchantMappingNotFound)
+}
+
+func (s *CategoryManagementServiceTestSuite) TestTestCategorization_UsesPreview() {
+	expected := &models.CategorizationResult{Category: models.CategoryDining, Method: models.CategorizationMethodMCC}
+
+	s.mockCategoryService.EXPECT().PreviewCategorization(gomock.Any()).DoAndReturn(func(txn *models.Transaction) *models.CategorizationResult {
+		s.Equal("5812", txn.MCCCode)
+		s.Equal("Blue Bottle", txn.MerchantName)
+		return expected
+	})
+
+	result := s.service.TestCategorization(&dto.TestCategorizationRequest{MerchantName: "Blue Bottle", MCCCode: "5812"})
+
+	s.Equal(expected, result)
+}
@@ -1,12 +1,17 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"log/slog"
 	"testing"
 	"time"
 
 	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
@@ -14,7 +19,12 @@ import (
 
 type CategoryServiceTestSuite struct {
 	suite.Suite
-	service *categoryService
+	ctrl             *gomock.Controller
+	mockCategoryRepo *repository_mocks.MockTransactionCategoryRepositoryInterface
+	mockMappingRepo  *repository_mocks.MockMerchantMappingRepositoryInterface
+	categories       []models.TransactionCategory
+	mappings         []models.MerchantMapping
+	service          *categoryService
 }
 
 func TestCategoryServiceSuite(t *testing.T) {
@@ -22,7 +32,100 @@ func TestCategoryServiceSuite(t *testing.T) {
 }
 
 func (s *CategoryServiceTestSuite) SetupTest() {
-	s.service = NewCategoryService().(*categoryService)
+	s.ctrl = gomock.NewController(s.T())
+	s.mockCategoryRepo = repository_mocks.NewMockTransactionCategoryRepositoryInterface(s.ctrl)
+	s.mockMappingRepo = repository_mocks.NewMockMerchantMappingRepositoryInterface(s.ctrl)
+	s.categories, s.mappings = categoryRuleFixtures()
+
+	s.mockCategoryRepo.EXPECT().GetActive().Return(s.categories, nil)
+	s.mockMappingRepo.EXPECT().GetActive().Return(s.mappings, nil)
+	s.mockMappingRepo.EXPECT().RecordUsage(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	s.service = NewCategoryService(s.mockCategoryRepo, s.mockMappingRepo, slog.Default()).(*categoryService)
+}
+
+func (s *CategoryServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// categoryRuleFixtures mirrors the categories and rules seeded by the migrations
+func categoryRuleFixtures() ([]models.TransactionCategory, []models.MerchantMapping) {
+	categories := make([]models.TransactionCategory, 0, len(models.AllCategories()))
+	for i, code := range models.AllCategories() {
+		categories = append(categories, models.TransactionCategory{
+			ID:           uuid.New(),
+			Code:         code,
+			Name:         code,
+			IsActive:     true,
+			DisplayOrder: i + 1,
+		})
+	}
+
+	var mappings []models.MerchantMapping
+	add := func(pattern, category, matchType string, confidence float64) {
+		mappings = append(mappings, models.MerchantMapping{
+			ID:              uuid.New(),
+			MerchantPattern: pattern,
+			NormalizedName:  pattern,
+			CategoryCode:    category,
+			MatchType:       matchType,
+			ConfidenceScore: confidence,
+			IsActive:        true,
+		})
+	}
+
+	mccCodes := map[string][]string{
+		models.CategoryGroceries:      {"5411", "5541"},
+		models.CategoryDining:         {"5812", "5814"},
+		models.CategoryTransportation: {"4111", "4121", "5542"},
+		models.CategoryShopping:       {"5732", "5945"},
+		models.CategoryEntertainment:  {"7832", "7922"},
+		models.CategoryBillsUtilities: {"4814", "4900"},
+		models.CategoryHealthcare:     {"8011", "8062"},
+		models.CategoryEducation:      {"8211", "8220"},
+		models.CategoryTravel:         {"3000", "7011"},
+		models.CategoryATMCash:        {"6010", "6011"},
+	}
+	for category, codes := range mccCodes {
+		for _, code := range codes {
+			add(code, category, models.MatchTypeMCC, 0.95)
+		}
+	}
+
+	merchants := map[string][]string{
+		models.CategoryGroceries:      {"Walmart", "Kroger", "Safeway", "Whole Foods", "Trader Joe", "Costco", "Aldi"},
+		models.CategoryDining:         {"Starbucks", "McDonald", "Chipotle", "Subway", "Taco Bell", "Panera", "Dunkin", "Pizza Hut"},
+		models.CategoryTransportation: {"Uber", "Lyft", "Shell", "Chevron", "Exxon", "BP", "Mobil"},
+		models.CategoryEntertainment:  {"Netflix", "Spotify", "AMC", "Hulu", "HBO"},
+		models.CategoryShopping:       {"Amazon", "Best Buy", "Home Depot", "Lowes", "Ikea"},
+		models.CategoryBillsUtilities: {"AT&T", "Verizon", "T-Mobile", "Comcast", "PG&E"},
+		models.CategoryHealthcare:     {"CVS", "Walgreens", "Rite Aid"},
+		models.CategoryTravel:         {"Delta", "United", "American Airlines", "Southwest", "Marriott", "Hilton", "Hyatt"},
+	}
+	for category, patterns := range merchants {
+		for _, pattern := range patterns {
+			add(pattern, category, models.MatchTypePartial, 0.95)
+		}
+	}
+	add("Target", models.CategoryShopping, models.MatchTypePartial, 0.90)
+	add("Disney", models.CategoryEntertainment, models.MatchTypePartial, 0.90)
+	add("Apple", models.CategoryShopping, models.MatchTypePartial, 0.90)
+	add("Edison", models.CategoryBillsUtilities, models.MatchTypePartial, 0.90)
+
+	for _, keyword := range []string{"Direct Deposit", "Salary", "Payroll", "Paycheck", "Wage", "Income", "Employer"} {
+		add(keyword, models.CategoryIncome, models.MatchTypeKeyword, 0.95)
+	}
+	for _, keyword := range []string{"ATM Withdrawal", "Cash Withdrawal", "ATM", "Cash Out", "Cash Advance"} {
+		add(keyword, models.CategoryATMCash, models.MatchTypeKeyword, 0.90)
+	}
+	for _, keyword := range []string{"Monthly Service Fee", "Overdraft Fee", "Late Fee", "Service Charge", "Bank Fee", "Transaction Fee", "International Fee"} {
+		add(keyword, models.CategoryFees, models.MatchTypeKeyword, 0.90)
+	}
+	for _, keyword := range []string{"Refund", "Reimbursement", "Credit Adjustment", "Return"} {
+		add(keyword, models.CategoryOther, models.MatchTypeKeyword, 0.70)
+	}
+
+	return categories, mappings
 }
 
 // MCC Mapping Tests
@@ -430,3 +533,163 @@ func (s *CategoryServiceTestSuite) TestBatchCategorize_EmptyInput() {
 	results := s.service.BatchCategorize([]*models.Transaction{})
 	s.Empty(results)
 }
+
+// Database-driven Rule Tests
+
+func (s *CategoryServiceTestSuite) newServiceWithRules(categories []models.TransactionCategory, mappings []models.MerchantMapping) (*categoryService, *repository_mocks.MockTransactionCategoryRepositoryInterface, *repository_mocks.MockMerchantMappingRepositoryInterface) {
+	categoryRepo := repository_mocks.NewMockTransactionCategoryRepositoryInterface(s.ctrl)
+	mappingRepo := repository_mocks.NewMockMerchantMappingRepositoryInterface(s.ctrl)
+
+	categoryRepo.EXPECT().GetActive().Return(categories, nil)
+	mappingRepo.EXPECT().GetActive().Return(mappings, nil)
+
+	service := NewCategoryService(categoryRepo, mappingRepo, slog.Default()).(*categoryService)
+	return service, categoryRepo, mappingRepo
+}
+
+func newTestMapping(pattern, category, matchType string, confidence float64) models.MerchantMapping {
+	return models.MerchantMapping{
+		ID:              uuid.New(),
+		MerchantPattern: pattern,
+		NormalizedName:  pattern,
+		CategoryCode:    category,
+		MatchType:       matchType,
+		ConfidenceScore: confidence,
+		IsActive:        true,
+	}
+}
+
+func (s *CategoryServiceTestSuite) TestCategorizeByMerchant_RecordsUsageOfMatchedMapping() {
+	walmart := newTestMapping("Walmart", models.CategoryGroceries, models.MatchTypePartial, 0.95)
+	service, _, mappingRepo := s.newServiceWithRules(s.categories, []models.MerchantMapping{walmart})
+
+	mappingRepo.EXPECT().RecordUsage(walmart.ID, gomock.Any()).Return(nil).Times(1)
+
+	category, _ := service.CategorizeByMerchant("WALMART SUPERCENTER #12")
+	s.Equal(models.CategoryGroceries, category)
+}
+
+func (s *CategoryServiceTestSuite) TestCategorizeByMerchant_UsageFailureDoesNotFailMatch() {
+	walmart := newTestMapping("Walmart", models.CategoryGroceries, models.MatchTypePartial, 0.95)
+	service, _, mappingRepo := s.newServiceWithRules(s.categories, []models.MerchantMapping{walmart})
+
+	mappingRepo.EXPECT().RecordUsage(walmart.ID, gomock.Any()).Return(errors.New("database unavailable"))
+
+	category, confidence := service.CategorizeByMerchant("Walmart")
+	s.Equal(models.CategoryGroceries, category)
+	s.Equal(0.95, confidence)
+}
+
+func (s *CategoryServiceTestSuite) TestCategorizeByMerchant_ExactAndRegexMatchTypes() {
+	exact := newTestMapping("Amazon Prime", models.CategoryEntertainment, models.MatchTypeExact, 0.99)
+	regex := newTestMapping(`(?i)^sq \*`, models.CategoryDining, models.MatchTypeRegex, 0.80)
+	service, _, mappingRepo := s.newServiceWithRules(s.categories, []models.MerchantMapping{exact, regex})
+	mappingRepo.EXPECT().RecordUsage(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	category, confidence := service.CategorizeByMerchant("AMAZON-PRIME")
+	s.Equal(models.CategoryEntertainment, category)
+	s.Equal(0.99, confidence)
+
+	category, _ = service.CategorizeByMerchant("Amazon Prime Video Rental Store")
+	s.Equal(models.CategoryOther, category, "Exact rules must not match on substrings")
+
+	category, confidence = service.CategorizeByMerchant("SQ *BLUE BOTTLE COFFEE")
+	s.Equal(models.CategoryDining, category)
+	s.Equal(0.80, confidence)
+}
+
+func (s *CategoryServiceTestSuite) TestBuildCategoryRules_SkipsInactiveCategoriesAndInvalidRegex() {
+	categories := []models.TransactionCategory{
+		{ID: uuid.New(), Code: models.CategoryGroceries, Name: "Groceries", IsActive: true},
+	}
+	mappings := []models.MerchantMapping{
+		newTestMapping("Walmart", models.CategoryGroceries, models.MatchTypePartial, 0.95),
+		newTestMapping("Starbucks", models.CategoryDining, models.MatchTypePartial, 0.95),
+		newTestMapping("([", models.CategoryGroceries, models.MatchTypeRegex, 0.95),
+	}
+
+	rules := buildCategoryRules(categories, mappings, slog.Default())
+
+	s.Len(rules.merchantRules, 1)
+	s.Equal("Walmart", rules.merchantRules[0].pattern)
+}
+
+func (s *CategoryServiceTestSuite) TestOverrideCategory_InactiveCategory() {
+	categories := []models.TransactionCategory{
+		{ID: uuid.New(), Code: models.CategoryOther, Name: "Other", IsActive: true},
+	}
+	service, _, _ := s.newServiceWithRules(categories, nil)
+
+	transaction := &models.Transaction{
+		ID:       uuid.New(),
+		Category: models.CategoryOther,
+		Version:  1,
+	}
+
+	err := service.OverrideCategory(transaction, models.CategoryDining, "User correction")
+
+	s.ErrorIs(err, ErrInvalidCategory)
+	s.Equal(models.CategoryOther, transaction.Category)
+}
+
+func (s *CategoryServiceTestSuite) TestReload_PicksUpNewRules() {
+	service, categoryRepo, mappingRepo := s.newServiceWithRules(s.categories, nil)
+	mappingRepo.EXPECT().RecordUsage(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	category, _ := service.CategorizeByMerchant("Blue Bottle Coffee")
+	s.Equal(models.CategoryOther, category)
+
+	categoryRepo.EXPECT().GetActive().Return(s.categories, nil)
+	mappingRepo.EXPECT().GetActive().Return([]models.MerchantMapping{
+		newTestMapping("Blue Bottle", models.CategoryDining, models.MatchTypePartial, 0.90),
+	}, nil)
+
+	s.NoError(service.Reload())
+
+	category, confidence := service.CategorizeByMerchant("Blue Bottle Coffee")
+	s.Equal(models.CategoryDining, category)
+	s.Equal(0.90, confidence)
+}
+
+func (s *CategoryServiceTestSuite) TestReload_KeepsPreviousRulesOnError() {
+	s.mockCategoryRepo.EXPECT().GetActive().Return(s.categories, nil)
+	s.mockMappingRepo.EXPECT().GetActive().Return(nil, errors.New("connection refused"))
+
+	err := s.service.Reload()
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to load merchant mappings")
+
+	category, _ := s.service.CategorizeByMerchant("Starbucks")
+	s.Equal(models.CategoryDining, category)
+}
+
+func (s *CategoryServiceTestSuite) TestStartAutoReload_StopsWhenContextCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.service.StartAutoReload(ctx, time.Hour)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.Fail("StartAutoReload did not return after context cancellation")
+	}
+}
+
+func (s *CategoryServiceTestSuite) TestPreviewCategorization_DoesNotRecordUsage() {
+	walmart := newTestMapping("Walmart", models.CategoryGroceries, models.MatchTypePartial, 0.95)
+	service, _, mappingRepo := s.newServiceWithRules(s.categories, []models.MerchantMapping{walmart})
+
+	mappingRepo.EXPECT().RecordUsage(gomock.Any(), gomock.Any()).Times(0)
+
+	result := service.PreviewCategorization(&models.Transaction{MerchantName: "Walmart Supercenter"})
+
+	s.Equal(models.CategoryGroceries, result.Category)
+	s.Equal(models.CategorizationMethodMerchant, result.Method)
+	s.Equal(0.95, result.Confidence)
+}
@@ -18,14 +18,16 @@ type CustomerProfileService struct {
 	userRepo     repositories.UserRepositoryInterface
 	accountRepo  repositories.AccountRepositoryInterface
 	auditService AuditServiceInterface
+	events       EventPublisher
 }
 
 // NewCustomerProfileService creates a new customer profile service
-func NewCustomerProfileService(userRepo repositories.UserRepositoryInterface, accountRepo repositories.AccountRepositoryInterface, auditService AuditServiceInterface) CustomerProfileServiceInterface {
+func NewCustomerProfileService(userRepo repositories.UserRepositoryInterface, accountRepo repositories.AccountRepositoryInterface, auditService AuditServiceInterface, events EventPublisher) CustomerProfileServiceInterface {
 	return &CustomerProfileService{
 		userRepo:     userRepo,
 		accountRepo:  accountRepo,
 		auditService: auditService,
+		events:       events,
 	}
 }
 
@@ -154,7 +156,7 @@ func (s *CustomerProfileService) UpdateCustomerEmail(customerID uuid.UUID, newEm
 		return ErrEmailAlreadyExists
 	}
 
-	_, err = s.userRepo.GetByIDActive(customerID)
+	customer, err := s.userRepo.GetByIDActive(customerID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return ErrCustomerNotFound
@@ -172,6 +174,12 @@ func (s *CustomerProfileService) UpdateCustomerEmail(customerID uuid.UUID, newEm
 		return fmt.Errorf("failed to update email: %w", err)
 	}
 
+	s.events.Publish(models.WebhookEventCustomerEmailUpdated, customerID, map[string]interface{}{
+		"customer_id":    customerID,
+		"previous_email": customer.Email,
+		"email":          newEmail,
+	})
+
 	return nil
 }
 
@@ -21,6 +21,7 @@ type CustomerProfileServiceTestSuite struct {
 	userRepo     *repository_mocks.MockUserRepositoryInterface
 	accountRepo  *repository_mocks.MockAccountRepositoryInterface
 	auditService *service_mocks.MockAuditServiceInterface
+	events       *service_mocks.MockEventPublisher
 	service      CustomerProfileServiceInterface
 }
 
@@ -29,7 +30,8 @@ func (s *CustomerProfileServiceTestSuite) SetupTest() {
 	s.userRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
 	s.accountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
 	s.auditService = service_mocks.NewMockAuditServiceInterface(s.ctrl)
-	s.service = NewCustomerProfileService(s.userRepo, s.accountRepo, s.auditService)
+	s.events = service_mocks.NewMockEventPublisher(s.ctrl)
+	s.service = NewCustomerProfileService(s.userRepo, s.accountRepo, s.auditService, s.events)
 }
 
 func (s *CustomerProfileServiceTestSuite) TearDownTest() {
@@ -303,7 +305,8 @@ func (s *CustomerProfileServiceTestSuite) TestUpdateCustomerProfile() {
 			s.userRepo = repository_mocks.NewMockUserRepositoryInterface(ctrl)
 			s.accountRepo = repository_mocks.NewMockAccountRepositoryInterface(ctrl)
 			s.auditService = service_mocks.NewMockAuditServiceInterface(ctrl)
-			s.service = NewCustomerProfileService(s.userRepo, s.accountRepo, s.auditService)
+			s.events = service_mocks.NewMockEventPublisher(ctrl)
+			s.service = NewCustomerProfileService(s.userRepo, s.accountRepo, s.auditService, s.events)
 
 			tt.setupMocks()
 
@@ -338,6 +341,11 @@ func (s *CustomerProfileServiceTestSuite) TestUpdateCustomerEmail_ValidEmailUpda
 	s.userRepo.EXPECT().GetByEmailExcluding("newemail@example.com", user.ID).Return(nil, repositories.ErrUserNotFound).Times(1)
 	s.userRepo.EXPECT().GetByIDActive(user.ID).Return(user, nil).Times(1)
 	s.userRepo.EXPECT().UpdateEmail(user.ID, "newemail@example.com").Return(nil).Times(1)
+	s.events.EXPECT().Publish(models.WebhookEventCustomerEmailUpdated, user.ID, gomock.Any()).
+		Do(func(_ string, _ uuid.UUID, data interface{}) {
+			s.Equal("user1@example.com", data.(map[string]interface{})["previous_email"])
+			s.Equal("newemail@example.com", data.(map[string]interface{})["email"])
+		}).Times(1)
 
 	err := s.service.UpdateCustomerEmail(user.ID, "newemail@example.com")
 	s.Require().NoError(err)
@@ -471,7 +479,8 @@ func (s *CustomerProfileServiceTestSuite) TestDeleteCustomer() {
 			s.userRepo = repository_mocks.NewMockUserRepositoryInterface(ctrl)
 			s.accountRepo = repository_mocks.NewMockAccountRepositoryInterface(ctrl)
 			s.auditService = service_mocks.NewMockAuditServiceInterface(ctrl)
-			s.service = NewCustomerProfileService(s.userRepo, s.accountRepo, s.auditService)
+			s.events = service_mocks.NewMockEventPublisher(ctrl)
+			s.service = NewCustomerProfileService(s.userRepo, s.accountRepo, s.auditService, s.events)
 
 			tt.setupMocks()
 
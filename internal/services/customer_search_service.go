@@ -3,6 +3,7 @@ package services
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"array-assessment/internal/models"
@@ -13,6 +14,10 @@ import (
 const (
 	DefaultSearchLimit = 10
 	MaxSearchLimit     = 1000
+
+	// MinFuzzySearchScore is the similarity each term of a fuzzy search must reach when
+	// scoring falls back to Levenshtein distance. It matches pg_trgm's default threshold.
+	MinFuzzySearchScore = 0.3
 )
 
 var (
@@ -40,6 +45,7 @@ func ValidateSearchType(searchType models.SearchType) error {
 		models.SearchTypeName:          true,
 		models.SearchTypeEmail:         true,
 		models.SearchTypeAccountNumber: true,
+		models.SearchTypeFuzzy:         true,
 	}
 
 	if !validTypes[searchType] {
@@ -49,7 +55,7 @@ func ValidateSearchType(searchType models.SearchType) error {
 }
 
 // SearchCustomers searches for customers based on the query and search type
-// Performs case-insensitive exact match searches
+// Performs case-insensitive exact match searches, or a ranked search for SearchTypeFuzzy
 func (s *CustomerSearchService) SearchCustomers(query string, searchType models.SearchType, offset, limit int) ([]*models.CustomerSearchResult, int64, error) {
 	if strings.TrimSpace(query) == "" {
 		return nil, 0, ErrInvalidSearchQuery
@@ -70,18 +76,21 @@ func (s *CustomerSearchService) SearchCustomers(query string, searchType models.
 		offset = 0
 	}
 
-	criteria := repositories.UserSearchCriteria{
-		Query:      query,
-		SearchType: string(searchType),
+	var matches []*models.UserSearchMatch
+	var total int64
+	var err error
+	if searchType == models.SearchTypeFuzzy {
+		matches, total, err = s.fuzzySearch(query, offset, limit)
+	} else {
+		matches, total, err = s.exactSearch(query, searchType, offset, limit)
 	}
-
-	users, total, err := s.userRepo.SearchUsers(criteria, offset, limit)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to search customers: %w", err)
 	}
 
-	results := make([]*models.CustomerSearchResult, 0, len(users))
-	for _, user := range users {
+	results := make([]*models.CustomerSearchResult, 0, len(matches))
+	for _, match := range matches {
+		user := match.User
 		accountCount, err := s.userRepo.CountAccountsByUserID(user.ID)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to count accounts for user %s: %w", user.ID, err)
@@ -95,6 +104,7 @@ func (s *CustomerSearchService) SearchCustomers(query string, searchType models.
 			Role:         user.Role,
 			AccountCount: accountCount,
 			CreatedAt:    user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			Score:        match.Score,
 		}
 
 		if user.LastLoginAt != nil {
@@ -107,3 +117,127 @@ func (s *CustomerSearchService) SearchCustomers(query string, searchType models.
 
 	return results, total, nil
 }
+
+// exactSearch finds users matching the query exactly; every match scores 1
+func (s *CustomerSearchService) exactSearch(query string, searchType models.SearchType, offset, limit int) ([]*models.UserSearchMatch, int64, error) {
+	criteria := repositories.UserSearchCriteria{
+		Query:      query,
+		SearchType: string(searchType),
+	}
+
+	users, total, err := s.userRepo.SearchUsers(criteria, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	matches := make([]*models.UserSearchMatch, len(users))
+	for i, user := range users {
+		matches[i] = &models.UserSearchMatch{User: user, Score: 1}
+	}
+	return matches, total, nil
+}
+
+// fuzzySearch ranks users by trigram similarity in the database, falling back to
+// Levenshtein scoring when the database has no trigram support
+func (s *CustomerSearchService) fuzzySearch(query string, offset, limit int) ([]*models.UserSearchMatch, int64, error) {
+	criteria := parseFuzzyQuery(query)
+
+	matches, total, err := s.userRepo.FuzzySearchUsers(criteria, offset, limit)
+	if !errors.Is(err, repositories.ErrTrigramSearchUnavailable) {
+		return matches, total, err
+	}
+
+	candidates, err := s.userRepo.ListSearchCandidates(criteria.AccountNumbers)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	matches = make([]*models.UserSearchMatch, 0, len(candidates))
+	for _, user := range candidates {
+		if score, ok := scoreFuzzyMatch(user, criteria); ok {
+			matches = append(matches, &models.UserSearchMatch{User: user, Score: score})
+		}
+	}
+
+	// Candidates arrive ordered by name, so a stable sort keeps that order among equal scores
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	total = int64(len(matches))
+	if offset >= len(matches) {
+		return []*models.UserSearchMatch{}, total, nil
+	}
+	end := offset + limit
+	if end > len(matches) {
+		end = len(matches)
+	}
+	return matches[offset:end], total, nil
+}
+
+// parseFuzzyQuery splits a fuzzy query into its terms. Digit-only words are account
+// number fragments, words containing @ are the email and the rest make up the name.
+func parseFuzzyQuery(query string) repositories.FuzzyUserSearchCriteria {
+	var criteria repositories.FuzzyUserSearchCriteria
+	var nameWords, emailWords []string
+
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		switch {
+		case isDigits(word):
+			criteria.AccountNumbers = append(criteria.AccountNumbers, word)
+		case strings.Contains(word, "@"):
+			emailWords = append(emailWords, word)
+		default:
+			nameWords = append(nameWords, word)
+		}
+	}
+
+	criteria.Name = strings.Join(nameWords, " ")
+	criteria.Email = strings.Join(emailWords, " ")
+	return criteria
+}
+
+// scoreFuzzyMatch scores a user against the name and email terms with the same rules as
+// the trigram search: each term must reach MinFuzzySearchScore and the score is their mean
+func scoreFuzzyMatch(user *models.User, criteria repositories.FuzzyUserSearchCriteria) (float64, bool) {
+	var scores []float64
+
+	if criteria.Name != "" {
+		firstName := strings.ToLower(user.FirstName)
+		lastName := strings.ToLower(user.LastName)
+		score := calculateSimilarity(criteria.Name, firstName)
+		for _, candidate := range []string{lastName, firstName + " " + lastName} {
+			if similarity := calculateSimilarity(criteria.Name, candidate); similarity > score {
+				score = similarity
+			}
+		}
+		scores = append(scores, score)
+	}
+	if criteria.Email != "" {
+		scores = append(scores, calculateSimilarity(criteria.Email, strings.ToLower(user.Email)))
+	}
+
+	// A search by account number alone has nothing to rank on
+	if len(scores) == 0 {
+		return 1, true
+	}
+
+	var sum float64
+	for _, score := range scores {
+		if score < MinFuzzySearchScore {
+			return 0, false
+		}
+		sum += score
+	}
+	return sum / float64(len(scores)), true
+}
+
+// isDigits reports whether s is made up only of decimal digits
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -99,6 +99,11 @@ func (s *CustomerSearchServiceTestSuite) TestValidateSearchType_ValidAccountNumb
 	s.NoError(err)
 }
 
+func (s *CustomerSearchServiceTestSuite) TestValidateSearchType_ValidFuzzy() {
+	err := ValidateSearchType(models.SearchTypeFuzzy)
+	s.NoError(err)
+}
+
 func (s *CustomerSearchServiceTestSuite) TestValidateSearchType_Invalid() {
 	err := ValidateSearchType(models.SearchType("invalid"))
 	s.Error(err)
@@ -522,3 +527,122 @@ func (s *CustomerSearchServiceTestSuite) TestExcludesDeletedAccounts() {
 	s.Equal(int64(1), total)
 	s.Len(results, 1)
 }
+
+func (s *CustomerSearchServiceTestSuite) TestParseFuzzyQuery() {
+	tests := []struct {
+		query    string
+		expected repositories.FuzzyUserSearchCriteria
+	}{
+		{query: "Jon Smth", expected: repositories.FuzzyUserSearchCriteria{Name: "jon smth"}},
+		{query: "  jon   smth 4821 ", expected: repositories.FuzzyUserSearchCriteria{Name: "jon smth", AccountNumbers: []string{"4821"}}},
+		{query: "John.Doe@Example.com", expected: repositories.FuzzyUserSearchCriteria{Email: "john.doe@example.com"}},
+		{query: "1000 0001", expected: repositories.FuzzyUserSearchCriteria{AccountNumbers: []string{"1000", "0001"}}},
+		{query: "doe 10a", expected: repositories.FuzzyUserSearchCriteria{Name: "doe 10a"}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.query, func() {
+			s.Equal(tt.expected, parseFuzzyQuery(tt.query))
+		})
+	}
+}
+
+func (s *CustomerSearchServiceTestSuite) TestSearchCustomers_FuzzyUsesTrigramScores() {
+	john := s.createTestCustomer("John", "Smith", "john.smith@example.com", nil)
+	criteria := repositories.FuzzyUserSearchCriteria{Name: "jon smth", AccountNumbers: []string{"4821"}}
+
+	s.userRepo.EXPECT().FuzzySearchUsers(criteria, 0, 10).
+		Return([]*models.UserSearchMatch{{User: john, Score: 0.42}}, int64(1), nil)
+	s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(1), nil)
+
+	results, total, err := s.service.SearchCustomers("Jon Smth 4821", models.SearchTypeFuzzy, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Require().Len(results, 1)
+	s.Equal(john.ID, results[0].ID)
+	s.Equal(0.42, results[0].Score)
+}
+
+func (s *CustomerSearchServiceTestSuite) TestSearchCustomers_FuzzyFallsBackToLevenshtein() {
+	jane := s.createTestCustomer("Jane", "Smith", "jane.smith@example.com", nil)
+	john := s.createTestCustomer("John", "Smith", "john.smith@example.com", nil)
+	bob := s.createTestCustomer("Bob", "Johnson", "bob.johnson@example.com", nil)
+	criteria := repositories.FuzzyUserSearchCriteria{Name: "jon smth"}
+
+	s.userRepo.EXPECT().FuzzySearchUsers(criteria, 0, 10).Return(nil, int64(0), repositories.ErrTrigramSearchUnavailable)
+	s.userRepo.EXPECT().ListSearchCandidates([]string(nil)).Return([]*models.User{bob, jane, john}, nil)
+	s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any()).Return(int64(0), nil).Times(3)
+
+	results, total, err := s.service.SearchCustomers("jon smth", models.SearchTypeFuzzy, 0, 10)
+	s.Require().NoError(err)
+
+	// "jon smth" is two edits from "john smith" and three from "jane smith"
+	s.Equal(int64(3), total)
+	s.Require().Len(results, 3)
+	s.Equal(john.ID, results[0].ID)
+	s.InDelta(0.8, results[0].Score, 0.001)
+	s.Equal(jane.ID, results[1].ID)
+	s.InDelta(0.7, results[1].Score, 0.001)
+	s.Equal(bob.ID, results[2].ID)
+	s.Less(results[2].Score, results[1].Score)
+}
+
+func (s *CustomerSearchServiceTestSuite) TestSearchCustomers_FuzzyFallbackDropsDistantNames() {
+	john := s.createTestCustomer("John", "Smith", "john.smith@example.com", nil)
+	criteria := repositories.FuzzyUserSearchCriteria{Name: "xavier"}
+
+	s.userRepo.EXPECT().FuzzySearchUsers(criteria, 0, 10).Return(nil, int64(0), repositories.ErrTrigramSearchUnavailable)
+	s.userRepo.EXPECT().ListSearchCandidates([]string(nil)).Return([]*models.User{john}, nil)
+
+	results, total, err := s.service.SearchCustomers("Xavier", models.SearchTypeFuzzy, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(0), total)
+	s.Empty(results)
+}
+
+func (s *CustomerSearchServiceTestSuite) TestSearchCustomers_FuzzyFallbackCombinesTermsAndPaginates() {
+	johnDoe := s.createTestCustomer("John", "Doe", "john.doe@example.com", nil)
+	janeDoe := s.createTestCustomer("Jane", "Doe", "jane.doe@example.com", nil)
+	criteria := repositories.FuzzyUserSearchCriteria{Name: "doe", Email: "jon.doe@example.com", AccountNumbers: []string{"0001"}}
+
+	s.userRepo.EXPECT().FuzzySearchUsers(criteria, 1, 1).Return(nil, int64(0), repositories.ErrTrigramSearchUnavailable)
+	s.userRepo.EXPECT().ListSearchCandidates([]string{"0001"}).Return([]*models.User{janeDoe, johnDoe}, nil)
+	s.userRepo.EXPECT().CountAccountsByUserID(janeDoe.ID).Return(int64(1), nil)
+
+	results, total, err := s.service.SearchCustomers("doe jon.doe@example.com 0001", models.SearchTypeFuzzy, 1, 1)
+	s.Require().NoError(err)
+
+	// Both names match exactly, so the email decides: John ranks first and Jane second
+	s.Equal(int64(2), total)
+	s.Require().Len(results, 1)
+	s.Equal(janeDoe.ID, results[0].ID)
+	s.Less(results[0].Score, 1.0)
+}
+
+func (s *CustomerSearchServiceTestSuite) TestSearchCustomers_FuzzyAccountNumberOnly() {
+	john := s.createTestCustomer("John", "Doe", "john.doe@example.com", nil)
+
+	s.userRepo.EXPECT().FuzzySearchUsers(repositories.FuzzyUserSearchCriteria{AccountNumbers: []string{"4821"}}, 0, 10).
+		Return(nil, int64(0), repositories.ErrTrigramSearchUnavailable)
+	s.userRepo.EXPECT().ListSearchCandidates([]string{"4821"}).Return([]*models.User{john}, nil)
+	s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(1), nil)
+
+	results, total, err := s.service.SearchCustomers("4821", models.SearchTypeFuzzy, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Require().Len(results, 1)
+	s.Equal(1.0, results[0].Score)
+}
+
+func (s *CustomerSearchServiceTestSuite) TestSearchCustomers_ExactMatchesScoreOne() {
+	john := s.createTestCustomer("John", "Doe", "john.doe@example.com", nil)
+	criteria := repositories.UserSearchCriteria{Query: "john.doe@example.com", SearchType: string(models.SearchTypeEmail)}
+
+	s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{john}, int64(1), nil)
+	s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(0), nil)
+
+	results, _, err := s.service.SearchCustomers("john.doe@example.com", models.SearchTypeEmail, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Equal(1.0, results[0].Score)
+}
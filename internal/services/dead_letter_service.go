@@ -0,0 +1,140 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrQueueItemNotFound  = errors.New("queue item not found")
+	ErrInvalidQueueItemID = errors.New("invalid queue item ID")
+)
+
+// DeadLetterService manages processing queue items that failed after exhausting
+// their retries. Failed items can be replayed, which queues them again with a fresh
+// retry budget, or purged with a recorded reason.
+type DeadLetterService struct {
+	queueRepo repositories.ProcessingQueueRepositoryInterface
+	logger    *slog.Logger
+}
+
+// NewDeadLetterService creates a new dead-letter service
+func NewDeadLetterService(queueRepo repositories.ProcessingQueueRepositoryInterface, logger *slog.Logger) DeadLetterServiceInterface {
+	return &DeadLetterService{
+		queueRepo: queueRepo,
+		logger:    logger,
+	}
+}
+
+// ListFailed lists failed queue items, most recently failed first
+func (s *DeadLetterService) ListFailed(filters models.QueueItemFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error) {
+	items, total, err := s.queueRepo.ListFailed(filters, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list failed queue items: %w", err)
+	}
+	return items, total, nil
+}
+
+// GetItem retrieves a queue item with its retry history
+func (s *DeadLetterService) GetItem(queueItemID uuid.UUID) (*models.ProcessingQueueItem, error) {
+	item, err := s.queueRepo.GetByID(queueItemID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrQueueItemNotFound) {
+			return nil, ErrQueueItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get queue item: %w", err)
+	}
+	return item, nil
+}
+
+// Replay queues failed items again for immediate processing with their retry count
+// reset. Items that are not failed are skipped.
+func (s *DeadLetterService) Replay(req *dto.ReplayQueueItemsRequest) (*dto.QueueItemsActionResponse, error) {
+	ids, err := parseQueueItemIDs(req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	replayed, err := s.queueRepo.Replay(ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay queue items: %w", err)
+	}
+
+	s.logger.Info("failed queue items replayed",
+		slog.Int("requested", len(ids)),
+		slog.Int("replayed", len(replayed)),
+	)
+
+	return newQueueItemsActionResponse(ids, replayed), nil
+}
+
+// Purge marks failed items as purged with the given reason. Purged items are kept
+// for the record but are no longer listed or replayable. Items that are not failed
+// are skipped.
+func (s *DeadLetterService) Purge(adminID uuid.UUID, req *dto.PurgeQueueItemsRequest) (*dto.QueueItemsActionResponse, error) {
+	ids, err := parseQueueItemIDs(req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	purged, err := s.queueRepo.Purge(ids, req.Reason, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge queue items: %w", err)
+	}
+
+	s.logger.Info("failed queue items purged",
+		slog.String("admin_id", adminID.String()),
+		slog.Int("requested", len(ids)),
+		slog.Int("purged", len(purged)),
+	)
+
+	return newQueueItemsActionResponse(ids, purged), nil
+}
+
+// parseQueueItemIDs parses and de-duplicates queue item IDs
+func parseQueueItemIDs(rawIDs []string) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]bool, len(rawIDs))
+	ids := make([]uuid.UUID, 0, len(rawIDs))
+
+	for _, raw := range rawIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidQueueItemID, raw)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// newQueueItemsActionResponse splits the requested IDs into those affected and those skipped
+func newQueueItemsActionResponse(requested, affected []uuid.UUID) *dto.QueueItemsActionResponse {
+	done := make(map[uuid.UUID]bool, len(affected))
+	for _, id := range affected {
+		done[id] = true
+	}
+
+	response := &dto.QueueItemsActionResponse{
+		ItemIDs:    []string{},
+		SkippedIDs: []string{},
+	}
+	for _, id := range requested {
+		if done[id] {
+			response.ItemIDs = append(response.ItemIDs, id.String())
+		} else {
+			response.SkippedIDs = append(response.SkippedIDs, id.String())
+		}
+	}
+
+	return response
+}
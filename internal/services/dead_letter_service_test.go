@@ -0,0 +1,77 @@
+package services
+
+import (
+	"log/slog"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeadLetterServiceTestSuite struct {
+	suite.Suite
+	ctrl          *gomock.Controller
+	mockQueueRepo *repository_mocks.MockProcessingQueueRepositoryInterface
+	service       *DeadLetterService
+}
+
+func TestDeadLetterServiceSuite(t *testing.T) {
+	suite.Run(t, new(DeadLetterServiceTestSuite))
+}
+
+func (s *DeadLetterServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockQueueRepo = repository_mocks.NewMockProcessingQueueRepositoryInterface(s.ctrl)
+	s.service = NewDeadLetterService(s.mockQueueRepo, slog.Default()).(*DeadLetterService)
+}
+
+func (s *DeadLetterServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *DeadLetterServiceTestSuite) TestReplay_ReportsSkippedItems() {
+	failed := uuid.New()
+	notFailed := uuid.New()
+
+	s.mockQueueRepo.EXPECT().Replay([]uuid.UUID{failed, notFailed}).Return([]uuid.UUID{failed}, nil)
+
+	result, err := s.service.Replay(&dto.ReplayQueueItemsRequest{
+		IDs: []string{failed.String(), notFailed.String(), failed.String()},
+	})
+	s.Require().NoError(err)
+	s.Equal([]string{failed.String()}, result.ItemIDs)
+	s.Equal([]string{notFailed.String()}, result.SkippedIDs)
+}
+
+func (s *DeadLetterServiceTestSuite) TestReplay_InvalidID() {
+	_, err := s.service.Replay(&dto.ReplayQueueItemsRequest{IDs: []string{"not-a-uuid"}})
+	s.ErrorIs(err, ErrInvalidQueueItemID)
+}
+
+func (s *DeadLetterServiceTestSuite) TestPurge_RecordsReasonAndAdmin() {
+	adminID := uuid.New()
+	itemID := uuid.New()
+
+	s.mockQueueRepo.EXPECT().Purge([]uuid.UUID{itemID}, "Account closed", adminID).Return([]uuid.UUID{itemID}, nil)
+
+	result, err := s.service.Purge(adminID, &dto.PurgeQueueItemsRequest{
+		IDs:    []string{itemID.String()},
+		Reason: "Account closed",
+	})
+	s.Require().NoError(err)
+	s.Equal([]string{itemID.String()}, result.ItemIDs)
+	s.Empty(result.SkippedIDs)
+}
+
+func (s *DeadLetterServiceTestSuite) TestGetItem_NotFound() {
+	id := uuid.New()
+	s.mockQueueRepo.EXPECT().GetByID(id).Return(nil, repositories.ErrQueueItemNotFound)
+
+	_, err := s.service.GetItem(id)
+	s.ErrorIs(err, ErrQueueItemNotFound)
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrUnsupportedCurrency     = errors.New("unsupported currency")
+	ErrExchangeRateUnavailable = errors.New("no exchange rate available for currency pair")
+)
+
+// exchangeRateSourceIdentity is the source of the 1:1 rate between a currency and itself
+const exchangeRateSourceIdentity = "identity"
+
+// exchangeRateSourceFile is the source of rates loaded without one from a rates file
+const exchangeRateSourceFile = "file"
+
+// ExchangeRateService is the database-backed RateProvider. Rates are stored once per
+// currency pair and may be seeded from a JSON rates file; a pair is used in either
+// direction, so a USD/EUR rate also converts EUR into USD.
+type ExchangeRateService struct {
+	rateRepo repositories.ExchangeRateRepositoryInterface
+	logger   *slog.Logger
+}
+
+// NewExchangeRateService creates a new exchange rate service
+func NewExchangeRateService(rateRepo repositories.ExchangeRateRepositoryInterface, logger *slog.Logger) ExchangeRateServiceInterface {
+	return &ExchangeRateService{
+		rateRepo: rateRepo,
+		logger:   logger,
+	}
+}
+
+// GetRate returns the rate converting one unit of fromCurrency into toCurrency.
+// When only the opposite direction is stored its inverse is returned.
+func (s *ExchangeRateService) GetRate(fromCurrency, toCurrency string) (*models.ExchangeRate, error) {
+	if !models.IsValidCurrency(fromCurrency) || !models.IsValidCurrency(toCurrency) {
+		return nil, ErrUnsupportedCurrency
+	}
+
+	if fromCurrency == toCurrency {
+		return &models.ExchangeRate{
+			BaseCurrency:  fromCurrency,
+			QuoteCurrency: toCurrency,
+			Rate:          decimal.NewFromInt(1),
+			Source:        exchangeRateSourceIdentity,
+		}, nil
+	}
+
+	rate, err := s.rateRepo.GetRate(fromCurrency, toCurrency)
+	if err == nil {
+		return rate, nil
+	}
+	if !errors.Is(err, repositories.ErrExchangeRateNotFound) {
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+
+	inverse, err := s.rateRepo.GetRate(toCurrency, fromCurrency)
+	if err != nil {
+		if errors.Is(err, repositories.ErrExchangeRateNotFound) {
+			return nil, ErrExchangeRateUnavailable
+		}
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+
+	return inverse.Inverse(), nil
+}
+
+// ListRates returns all stored rates
+func (s *ExchangeRateService) ListRates() ([]models.ExchangeRate, error) {
+	rates, err := s.rateRepo.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exchange rates: %w", err)
+	}
+	return rates, nil
+}
+
+// LoadRatesFile stores the rates in a JSON file, replacing the stored rate of each
+// pair it contains. The file holds an array of objects with base_currency,
+// quote_currency, rate and optional source and effective_at fields. It returns
+// the number of rates stored.
+func (s *ExchangeRateService) LoadRatesFile(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read exchange rates file: %w", err)
+	}
+
+	var rates []models.ExchangeRate
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return 0, fmt.Errorf("failed to parse exchange rates file: %w", err)
+	}
+
+	for i := range rates {
+		rate := &rates[i]
+		rate.BaseCurrency = models.NormalizeCurrency(rate.BaseCurrency)
+		rate.QuoteCurrency = models.NormalizeCurrency(rate.QuoteCurrency)
+		if rate.Source == "" {
+			rate.Source = exchangeRateSourceFile
+		}
+
+		if err := rate.Validate(); err != nil {
+			return i, fmt.Errorf("invalid exchange rate %s/%s: %w", rate.BaseCurrency, rate.QuoteCurrency, err)
+		}
+
+		if err := s.rateRepo.Upsert(rate); err != nil {
+			return i, err
+		}
+	}
+
+	s.logger.Info("exchange rates loaded",
+		slog.String("path", path),
+		slog.Int("count", len(rates)),
+	)
+
+	return len(rates), nil
+}
+
+// resolveCurrency normalizes a requested account or reporting currency, defaulting
+// to models.DefaultCurrency when none is given
+func resolveCurrency(code string) (string, error) {
+	if code == "" {
+		return models.DefaultCurrency, nil
+	}
+
+	code = models.NormalizeCurrency(code)
+	if !models.IsValidCurrency(code) {
+		return "", ErrUnsupportedCurrency
+	}
+	return code, nil
+}
+
+// convertCurrency converts amount from one currency into another at the provider's
+// current rate, rounded to the minor units of the target currency
+func convertCurrency(rates RateProvider, amount decimal.Decimal, fromCurrency, toCurrency string) (decimal.Decimal, error) {
+	if fromCurrency == toCurrency {
+		return amount, nil
+	}
+
+	rate, err := rates.GetRate(fromCurrency, toCurrency)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return rate.Convert(amount), nil
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+// ExchangeRateServiceTestSuite defines tests for exchange rate lookups and loading
+type ExchangeRateServiceTestSuite struct {
+	suite.Suite
+	ctrl     *gomock.Controller
+	rateRepo *repository_mocks.MockExchangeRateRepositoryInterface
+	service  ExchangeRateServiceInterface
+}
+
+// SetupTest runs before each test
+func (s *ExchangeRateServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.rateRepo = repository_mocks.NewMockExchangeRateRepositoryInterface(s.ctrl)
+	s.service = NewExchangeRateService(s.rateRepo, slog.Default())
+}
+
+// TearDownTest runs after each test
+func (s *ExchangeRateServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// TestExchangeRateServiceTestSuite runs the test suite
+func TestExchangeRateServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeRateServiceTestSuite))
+}
+
+// TestGetRate_SameCurrency tests that a currency converts into itself 1:1 without a lookup
+func (s *ExchangeRateServiceTestSuite) TestGetRate_SameCurrency() {
+	rate, err := s.service.GetRate("EUR", "EUR")
+
+	s.NoError(err)
+	s.True(rate.Rate.Equal(decimal.NewFromInt(1)))
+}
+
+// TestGetRate_Direct tests that a stored rate is returned as is
+func (s *ExchangeRateServiceTestSuite) TestGetRate_Direct() {
+	stored := &models.ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: decimal.RequireFromString("0.92")}
+	s.rateRepo.EXPECT().GetRate("USD", "EUR").Return(stored, nil)
+
+	rate, err := s.service.GetRate("USD", "EUR")
+
+	s.NoError(err)
+	s.Equal(stored, rate)
+}
+
+// TestGetRate_Inverse tests that the opposite direction of a stored pair is used
+func (s *ExchangeRateServiceTestSuite) TestGetRate_Inverse() {
+	s.rateRepo.EXPECT().GetRate("EUR", "USD").Return(nil, repositories.ErrExchangeRateNotFound)
+	s.rateRepo.EXPECT().GetRate("USD", "EUR").
+		Return(&models.ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: decimal.RequireFromString("0.8")}, nil)
+
+	rate, err := s.service.GetRate("EUR", "USD")
+
+	s.NoError(err)
+	s.Equal("EUR", rate.BaseCurrency)
+	s.Equal("USD", rate.QuoteCurrency)
+	s.Equal("1.25", rate.Rate.String())
+}
+
+// TestGetRate_Unavailable tests lookups for a pair with no rate in either direction
+func (s *ExchangeRateServiceTestSuite) TestGetRate_Unavailable() {
+	s.rateRepo.EXPECT().GetRate("USD", "CHF").Return(nil, repositories.ErrExchangeRateNotFound)
+	s.rateRepo.EXPECT().GetRate("CHF", "USD").Return(nil, repositories.ErrExchangeRateNotFound)
+
+	_, err := s.service.GetRate("USD", "CHF")
+
+	s.ErrorIs(err, ErrExchangeRateUnavailable)
+}
+
+// TestGetRate_UnsupportedCurrency tests that unknown currency codes are rejected
+func (s *ExchangeRateServiceTestSuite) TestGetRate_UnsupportedCurrency() {
+	_, err := s.service.GetRate("USD", "ZZZ")
+
+	s.ErrorIs(err, ErrUnsupportedCurrency)
+}
+
+// TestGetRate_RepositoryError tests that storage failures are not reported as missing rates
+func (s *ExchangeRateServiceTestSuite) TestGetRate_RepositoryError() {
+	s.rateRepo.EXPECT().GetRate("USD", "EUR").Return(nil, errors.New("connection refused"))
+
+	_, err := s.service.GetRate("USD", "EUR")
+
+	s.Error(err)
+	s.NotErrorIs(err, ErrExchangeRateUnavailable)
+}
+
+// TestLoadRatesFile_Success tests that each rate in the file is normalized and stored
+func (s *ExchangeRateServiceTestSuite) TestLoadRatesFile_Success() {
+	path := filepath.Join(s.T().TempDir(), "rates.json")
+	s.Require().NoError(os.WriteFile(path, []byte(`[
+		{"base_currency": "usd", "quote_currency": "EUR", "rate": "0.92"},
+		{"base_currency": "GBP", "quote_currency": "USD", "rate": "1.27", "source": "ecb"}
+	]`), 0o600))
+
+	var stored []models.ExchangeRate
+	s.rateRepo.EXPECT().Upsert(gomock.Any()).Times(2).DoAndReturn(func(rate *models.ExchangeRate) error {
+		stored = append(stored, *rate)
+		return nil
+	})
+
+	count, err := s.service.LoadRatesFile(path)
+
+	s.NoError(err)
+	s.Equal(2, count)
+	s.Equal("USD", stored[0].BaseCurrency)
+	s.Equal("file", stored[0].Source)
+	s.Equal("ecb", stored[1].Source)
+}
+
+// TestLoadRatesFile_InvalidRate tests that loading stops at the first invalid rate
+func (s *ExchangeRateServiceTestSuite) TestLoadRatesFile_InvalidRate() {
+	path := filepath.Join(s.T().TempDir(), "rates.json")
+	s.Require().NoError(os.WriteFile(path, []byte(`[
+		{"base_currency": "USD", "quote_currency": "EUR", "rate": "0.92"},
+		{"base_currency": "USD", "quote_currency": "JPY", "rate": "0"}
+	]`), 0o600))
+
+	s.rateRepo.EXPECT().Upsert(gomock.Any()).Return(nil)
+
+	count, err := s.service.LoadRatesFile(path)
+
+	s.ErrorIs(err, models.ErrInvalidExchangeRate)
+	s.Equal(1, count)
+}
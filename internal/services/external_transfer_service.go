@@ -0,0 +1,576 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrExternalTransferNotFound   = errors.New("external transfer not found")
+	ErrExternalTransferState      = errors.New("external transfer cannot be changed in its current state")
+	ErrExternalAccountNotVerified = errors.New("external account could not be verified")
+	ErrNorthWindUnavailable       = errors.New("northwind is unavailable")
+)
+
+const (
+	// DefaultExternalTransferBatchSize is the number of transfers submitted or settled per poll
+	DefaultExternalTransferBatchSize = 100
+
+	// DefaultSettlementDelay is how long a submitted transfer waits for a return
+	// before it may settle
+	DefaultSettlementDelay = 48 * time.Hour
+
+	// submissionRetryDelay is how old an initiated transfer must be before the
+	// worker retries its submission, so it does not race the initiating request
+	submissionRetryDelay = time.Minute
+)
+
+// NorthWind transfer statuses that end a transfer
+var (
+	northWindSettledStatuses  = []string{"completed", "settled"}
+	northWindReturnedStatuses = []string{"returned", "failed", "rejected", "cancelled"}
+)
+
+// ExternalTransferService moves funds between our accounts and accounts at other
+// institutions verified through NorthWind. A transfer is initiated with its funds
+// held, submitted to NorthWind, and settles once the settlement delay has passed
+// and NorthWind reports it complete, unless it is returned first. Transfers are
+// checked against the account's limits, and outbound transfers flagged by fraud
+// screening wait for admin review before they are submitted. A background worker
+// retries failed submissions and settles due transfers.
+type ExternalTransferService struct {
+	transferRepo    repositories.ExternalTransferRepositoryInterface
+	accountRepo     repositories.AccountRepositoryInterface
+	userRepo        repositories.UserRepositoryInterface
+	client          NorthWindTransferClient
+	limits          TransactionLimitChecker
+	screener        TransactionScreener
+	settlementDelay time.Duration
+	batchSize       int
+	events          EventPublisher
+	logger          *slog.Logger
+}
+
+// NewExternalTransferService creates a new external transfer service
+func NewExternalTransferService(
+	transferRepo repositories.ExternalTransferRepositoryInterface,
+	accountRepo repositories.AccountRepositoryInterface,
+	userRepo repositories.UserRepositoryInterface,
+	client NorthWindTransferClient,
+	limits TransactionLimitChecker,
+	screener TransactionScreener,
+	settlementDelay time.Duration,
+	batchSize int,
+	events EventPublisher,
+	logger *slog.Logger,
+) ExternalTransferServiceInterface {
+	if settlementDelay <= 0 {
+		settlementDelay = DefaultSettlementDelay
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultExternalTransferBatchSize
+	}
+
+	return &ExternalTransferService{
+		transferRepo:    transferRepo,
+		accountRepo:     accountRepo,
+		userRepo:        userRepo,
+		client:          client,
+		limits:          limits,
+		screener:        screener,
+		settlementDelay: settlementDelay,
+		batchSize:       batchSize,
+		events:          events,
+		logger:          logger,
+	}
+}
+
+// InitiateTransfer checks the transfer against the account's limits, verifies the
+// counterparty with NorthWind, records the transfer with its funds held and submits
+// it. An outbound transfer flagged by fraud screening is instead returned pending
+// review, and is submitted by the worker once an admin approves it. A transfer that
+// could not be submitted stays initiated and is retried by the worker; one NorthWind
+// refuses is returned failed. Repeating a request with the same idempotency key
+// returns the original transfer.
+func (s *ExternalTransferService) InitiateTransfer(
+	ctx context.Context,
+	userID, accountID uuid.UUID,
+	req *dto.CreateExternalTransferRequest,
+	idempotencyKey string,
+) (*models.ExternalTransfer, error) {
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil || amount.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidAmount
+	}
+
+	existing, err := s.transferRepo.GetByIdempotencyKey(idempotencyKey)
+	if err == nil {
+		if existing.UserID != userID {
+			return nil, ErrUnauthorized
+		}
+		return existing, nil
+	}
+	if !errors.Is(err, repositories.ErrExternalTransferNotFound) {
+		return nil, fmt.Errorf("failed to check idempotency key: %w", err)
+	}
+
+	account, err := s.accountRepo.GetByID(accountID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAccountNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if account.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	if !account.IsActive() {
+		return nil, ErrAccountNotActive
+	}
+
+	if !models.HasCurrencyPrecision(amount, account.CurrencyOrDefault()) {
+		return nil, ErrInvalidAmount
+	}
+
+	activity := models.LimitActivityDeposit
+	if req.Direction == models.ExternalTransferOutbound {
+		activity = models.LimitActivityTransfer
+	}
+	if err := s.limits.CheckLimits(account, amount, activity); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyCounterparty(ctx, &req.Counterparty); err != nil {
+		return nil, err
+	}
+
+	var flagged models.JSONBMap
+	if req.Direction == models.ExternalTransferOutbound {
+		flagged, err = s.screener.ScreenExternalTransfer(account, amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	transfer := &models.ExternalTransfer{
+		UserID:                    userID,
+		AccountID:                 accountID,
+		Direction:                 req.Direction,
+		Amount:                    amount,
+		Currency:                  account.CurrencyOrDefault(),
+		Description:               req.Description,
+		IdempotencyKey:            idempotencyKey,
+		ReferenceNumber:           models.GenerateExternalTransferReference(),
+		CounterpartyName:          req.Counterparty.AccountHolderName,
+		CounterpartyAccountNumber: req.Counterparty.AccountNumber,
+		CounterpartyRoutingNumber: req.Counterparty.RoutingNumber,
+		CounterpartyInstitution:   req.Counterparty.InstitutionName,
+		Status:                    models.ExternalTransferStatusInitiated,
+	}
+	if len(flagged) > 0 {
+		transfer.Status = models.ExternalTransferStatusPendingReview
+	}
+
+	if err := s.transferRepo.Create(transfer); err != nil {
+		return nil, mapExternalTransferErr(err)
+	}
+
+	s.logger.Info("external transfer initiated",
+		slog.String("transfer_id", transfer.ID.String()),
+		slog.String("account_id", accountID.String()),
+		slog.String("direction", transfer.Direction),
+		slog.String("amount", amount.StringFixed(2)),
+	)
+
+	if len(flagged) > 0 {
+		return s.holdForReview(transfer, flagged)
+	}
+
+	return s.submit(ctx, transfer, account)
+}
+
+// GetTransfer retrieves one of the user's external transfers
+func (s *ExternalTransferService) GetTransfer(id, userID uuid.UUID) (*models.ExternalTransfer, error) {
+	transfer, err := s.getTransfer(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Another user's transfer is reported as missing so IDs cannot be probed
+	if transfer.UserID != userID {
+		return nil, ErrExternalTransferNotFound
+	}
+
+	return transfer, nil
+}
+
+// ListTransfers retrieves the user's external transfers, newest first
+func (s *ExternalTransferService) ListTransfers(userID uuid.UUID, offset, limit int) ([]models.ExternalTransfer, int64, error) {
+	transfers, total, err := s.transferRepo.GetByUserID(userID, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list external transfers: %w", err)
+	}
+	return transfers, total, nil
+}
+
+// ReturnTransfer records an ACH return reported outside NorthWind's status API.
+// Only transfers that have not settled can be returned.
+func (s *ExternalTransferService) ReturnTransfer(id uuid.UUID, req *dto.ReturnExternalTransferRequest) (*models.ExternalTransfer, error) {
+	code := strings.ToUpper(req.ReturnCode)
+	if !models.IsValidACHReturnCode(code) {
+		return nil, fmt.Errorf("%w: %s", models.ErrInvalidACHReturnCode, req.ReturnCode)
+	}
+
+	if _, err := s.getTransfer(id); err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.transferRepo.Return(id, code, req.Reason)
+	if err != nil {
+		return nil, mapExternalTransferErr(err)
+	}
+
+	s.logReturn(transfer)
+	s.events.Publish(models.WebhookEventTransferFailed, transfer.UserID, transfer)
+	return transfer, nil
+}
+
+// StartWorker retries unsubmitted transfers and settles due transfers on every
+// poll until the context is cancelled
+func (s *ExternalTransferService) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	s.logger.Info("starting external transfer worker",
+		slog.Duration("poll_interval", pollInterval),
+		slog.Duration("settlement_delay", s.settlementDelay),
+	)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("external transfer worker stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.SubmitPending(ctx); err != nil {
+				s.logger.Error("failed to submit pending external transfers",
+					slog.String("error", err.Error()),
+				)
+			}
+			if _, err := s.SettleDue(ctx); err != nil {
+				s.logger.Error("failed to settle external transfers",
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
+
+// SubmitPending retries the submission of transfers NorthWind has not accepted
+// and reports how many were submitted
+func (s *ExternalTransferService) SubmitPending(ctx context.Context) (int, error) {
+	transfers, err := s.transferRepo.GetUnsubmitted(time.Now().Add(-submissionRetryDelay), s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	submitted := 0
+	for i := range transfers {
+		if ctx.Err() != nil {
+			return submitted, ctx.Err()
+		}
+
+		account, err := s.accountRepo.GetByID(transfers[i].AccountID)
+		if err != nil {
+			s.logger.Error("failed to get external transfer account",
+				slog.String("transfer_id", transfers[i].ID.String()),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		transfer, err := s.submit(ctx, &transfers[i], account)
+		if err != nil {
+			s.logger.Error("failed to submit external transfer",
+				slog.String("transfer_id", transfers[i].ID.String()),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		if transfer.Status == models.ExternalTransferStatusSubmitted {
+			submitted++
+		}
+	}
+
+	return submitted, nil
+}
+
+// SettleDue checks each transfer past its settlement delay with NorthWind, settling
+// completed transfers and returning those NorthWind returned. Transfers NorthWind
+// is still processing are checked again on the next poll. It reports how many
+// transfers settled.
+func (s *ExternalTransferService) SettleDue(ctx context.Context) (int, error) {
+	transfers, err := s.transferRepo.GetDueForSettlement(time.Now(), s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	settled := 0
+	for i := range transfers {
+		if ctx.Err() != nil {
+			return settled, ctx.Err()
+		}
+
+		ok, err := s.settle(ctx, &transfers[i])
+		if err != nil {
+			s.logger.Error("failed to settle external transfer",
+				slog.String("transfer_id", transfers[i].ID.String()),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		if ok {
+			settled++
+		}
+	}
+
+	return settled, nil
+}
+
+// settle applies NorthWind's status to a due transfer and reports whether it settled
+func (s *ExternalTransferService) settle(ctx context.Context, transfer *models.ExternalTransfer) (bool, error) {
+	status, err := s.client.GetTransfer(ctx, transfer.ProviderTransferID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get northwind transfer status: %w", err)
+	}
+
+	providerStatus := strings.ToLower(status.Status)
+	switch {
+	case slices.Contains(northWindSettledStatuses, providerStatus):
+		settled, err := s.transferRepo.Settle(transfer.ID)
+		if err != nil {
+			return false, err
+		}
+
+		s.logger.Info("external transfer settled",
+			slog.String("transfer_id", settled.ID.String()),
+			slog.String("account_id", settled.AccountID.String()),
+			slog.String("direction", settled.Direction),
+		)
+		s.events.Publish(models.WebhookEventTransferCompleted, settled.UserID, settled)
+		return true, nil
+
+	case slices.Contains(northWindReturnedStatuses, providerStatus):
+		code := strings.ToUpper(status.ReturnCode)
+		if !models.IsValidACHReturnCode(code) {
+			code = ""
+		}
+
+		reason := status.ReturnReason
+		if reason == "" && code == "" {
+			reason = "Transfer " + providerStatus + " by NorthWind"
+		}
+
+		returned, err := s.transferRepo.Return(transfer.ID, code, reason)
+		if err != nil {
+			return false, err
+		}
+
+		s.logReturn(returned)
+		s.events.Publish(models.WebhookEventTransferFailed, returned.UserID, returned)
+		return false, nil
+
+	default:
+		return false, nil
+	}
+}
+
+// holdForReview opens a fraud review of a flagged transfer, which is not submitted
+// until an admin approves it. The transfer is failed, releasing its hold, if the
+// review cannot be opened.
+func (s *ExternalTransferService) holdForReview(transfer *models.ExternalTransfer, rules models.JSONBMap) (*models.ExternalTransfer, error) {
+	if err := s.screener.HoldExternalTransfer(transfer, rules); err != nil {
+		if _, failErr := s.transferRepo.Fail(transfer.ID, "fraud review could not be opened"); failErr != nil {
+			s.logger.Error("failed to fail external transfer after fraud review failed",
+				slog.String("transfer_id", transfer.ID.String()),
+				slog.String("error", failErr.Error()),
+			)
+		}
+		return nil, fmt.Errorf("failed to hold external transfer for review: %w", err)
+	}
+
+	s.logger.Info("external transfer held for fraud review",
+		slog.String("transfer_id", transfer.ID.String()),
+		slog.String("account_id", transfer.AccountID.String()),
+	)
+
+	return transfer, nil
+}
+
+// verifyCounterparty checks with NorthWind that the counterparty account exists and is valid
+func (s *ExternalTransferService) verifyCounterparty(ctx context.Context, counterparty *dto.ExternalTransferCounterparty) error {
+	result, err := s.client.AuthAccount(ctx, dto.NorthWindAccountRequestDto{
+		AccountHolderName: counterparty.AccountHolderName,
+		AccountNumber:     counterparty.AccountNumber,
+		RoutingNumber:     counterparty.RoutingNumber,
+	})
+	if err != nil {
+		if errors.Is(err, ErrNorthWindRejected) {
+			return fmt.Errorf("%w: %s", ErrExternalAccountNotVerified, err.Error())
+		}
+		return fmt.Errorf("%w: %s", ErrNorthWindUnavailable, err.Error())
+	}
+
+	if !result.AccountExists || !result.AccountValid {
+		return ErrExternalAccountNotVerified
+	}
+
+	return nil
+}
+
+// submit sends an initiated transfer to NorthWind. Transport and server errors
+// leave it initiated for the worker to retry; a refusal fails the transfer and
+// releases any hold. The transfer is returned in its resulting state. Every
+// attempt carries the transfer's reference number as its idempotency key, so a
+// retry after a lost response returns the transfer NorthWind already created
+// instead of moving the funds twice.
+func (s *ExternalTransferService) submit(ctx context.Context, transfer *models.ExternalTransfer, account *models.Account) (*models.ExternalTransfer, error) {
+	ours := dto.NorthWindTransferAccount{
+		AccountHolderName: s.accountHolderName(account),
+		AccountNumber:     account.AccountNumber,
+		RoutingNumber:     account.RoutingNumber,
+	}
+	counterparty := dto.NorthWindTransferAccount{
+		AccountHolderName: transfer.CounterpartyName,
+		AccountNumber:     transfer.CounterpartyAccountNumber,
+		RoutingNumber:     transfer.CounterpartyRoutingNumber,
+	}
+	if transfer.CounterpartyInstitution != "" {
+		counterparty.InstitutionName = &transfer.CounterpartyInstitution
+	}
+
+	source, destination := ours, counterparty
+	if !transfer.IsOutbound() {
+		source, destination = counterparty, ours
+	}
+
+	units, err := models.CurrencyMinorUnits(transfer.Currency)
+	if err != nil {
+		units = 2
+	}
+
+	now := time.Now()
+	resp, err := s.client.InitiateTransfer(ctx, dto.NorthWindInitiateTransferRequest{
+		Amount:             transfer.Amount.StringFixed(units),
+		Currency:           transfer.Currency,
+		Direction:          transfer.Direction,
+		SourceAccount:      source,
+		DestinationAccount: destination,
+		Description:        transfer.Description,
+		ReferenceNumber:    transfer.ReferenceNumber,
+		TransferType:       models.ExternalTransferTypeACH,
+		ScheduledDate:      now.Format("2006-01-02"),
+	})
+	if err != nil {
+		if !errors.Is(err, ErrNorthWindRejected) {
+			s.logger.Warn("external transfer submission failed, will retry",
+				slog.String("transfer_id", transfer.ID.String()),
+				slog.String("error", err.Error()),
+			)
+			return transfer, nil
+		}
+
+		failed, failErr := s.transferRepo.Fail(transfer.ID, err.Error())
+		if failErr != nil {
+			return transfer, fmt.Errorf("failed to record refused external transfer: %w", failErr)
+		}
+
+		s.logger.Warn("external transfer refused by northwind",
+			slog.String("transfer_id", transfer.ID.String()),
+			slog.String("error", err.Error()),
+		)
+		s.events.Publish(models.WebhookEventTransferFailed, failed.UserID, failed)
+		return failed, nil
+	}
+
+	settleAfter := now.Add(s.settlementDelay)
+	if resp.ExpectedCompletionDate.After(settleAfter) {
+		settleAfter = resp.ExpectedCompletionDate
+	}
+	transfer.MarkSubmitted(resp.TransferID, now, settleAfter)
+
+	if err := s.transferRepo.MarkSubmitted(transfer); err != nil {
+		return transfer, fmt.Errorf("failed to record external transfer submission: %w", err)
+	}
+
+	s.logger.Info("external transfer submitted",
+		slog.String("transfer_id", transfer.ID.String()),
+		slog.String("provider_transfer_id", resp.TransferID),
+		slog.Time("settle_after", settleAfter),
+	)
+
+	return transfer, nil
+}
+
+// accountHolderName returns the name of the account's owner, falling back to the
+// account number when the owner cannot be loaded
+func (s *ExternalTransferService) accountHolderName(account *models.Account) string {
+	user, err := s.userRepo.GetByID(account.UserID)
+	if err != nil {
+		return account.AccountNumber
+	}
+	return strings.TrimSpace(user.FirstName + " " + user.LastName)
+}
+
+func (s *ExternalTransferService) getTransfer(id uuid.UUID) (*models.ExternalTransfer, error) {
+	transfer, err := s.transferRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrExternalTransferNotFound) {
+			return nil, ErrExternalTransferNotFound
+		}
+		return nil, fmt.Errorf("failed to get external transfer: %w", err)
+	}
+	return transfer, nil
+}
+
+func (s *ExternalTransferService) logReturn(transfer *models.ExternalTransfer) {
+	s.logger.Info("external transfer returned",
+		slog.String("transfer_id", transfer.ID.String()),
+		slog.String("account_id", transfer.AccountID.String()),
+		slog.String("return_code", transfer.ReturnCode),
+		slog.String("return_reason", transfer.ReturnReason),
+	)
+}
+
+// mapExternalTransferErr translates external transfer repository errors into service errors
+func mapExternalTransferErr(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrExternalTransferNotFound):
+		return ErrExternalTransferNotFound
+	case errors.Is(err, repositories.ErrExternalTransferStateChanged),
+		errors.Is(err, repositories.ErrHoldNotActive):
+		return ErrExternalTransferState
+	case errors.Is(err, repositories.ErrAccountNotFound):
+		return ErrAccountNotFound
+	case errors.Is(err, repositories.ErrAccountNotActive):
+		return ErrAccountNotActive
+	case errors.Is(err, repositories.ErrInsufficientFunds):
+		return ErrInsufficientFunds
+	default:
+		return fmt.Errorf("external transfer operation failed: %w", err)
+	}
+}
@@ -0,0 +1,452 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"array-assessment/internal/config"
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExternalTransferServiceTestSuite struct {
+	suite.Suite
+	ctrl             *gomock.Controller
+	mockTransferRepo *repository_mocks.MockExternalTransferRepositoryInterface
+	mockAccountRepo  *repository_mocks.MockAccountRepositoryInterface
+	mockUserRepo     *repository_mocks.MockUserRepositoryInterface
+	mockClient       *service_mocks.MockNorthWindTransferClient
+	mockLimits       *service_mocks.MockTransactionLimitChecker
+	mockScreener     *service_mocks.MockTransactionScreener
+	service          *ExternalTransferService
+	ctx              context.Context
+	userID           uuid.UUID
+	account          *models.Account
+}
+
+func TestExternalTransferServiceSuite(t *testing.T) {
+	suite.Run(t, new(ExternalTransferServiceTestSuite))
+}
+
+func (s *ExternalTransferServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockTransferRepo = repository_mocks.NewMockExternalTransferRepositoryInterface(s.ctrl)
+	s.mockAccountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
+	s.mockUserRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
+	s.mockClient = service_mocks.NewMockNorthWindTransferClient(s.ctrl)
+	s.mockLimits = service_mocks.NewMockTransactionLimitChecker(s.ctrl)
+	s.mockScreener = service_mocks.NewMockTransactionScreener(s.ctrl)
+	events := service_mocks.NewMockEventPublisher(s.ctrl)
+	events.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	s.service = NewExternalTransferService(
+		s.mockTransferRepo,
+		s.mockAccountRepo,
+		s.mockUserRepo,
+		s.mockClient,
+		s.mockLimits,
+		s.mockScreener,
+		48*time.Hour,
+		10,
+		events,
+		slog.Default(),
+	).(*ExternalTransferService)
+
+	s.ctx = context.Background()
+	s.userID = uuid.New()
+	s.account = &models.Account{
+		ID:            uuid.New(),
+		UserID:        s.userID,
+		AccountNumber: "1012345678",
+		RoutingNumber: "021000021",
+		Status:        models.AccountStatusActive,
+		Balance:       decimal.NewFromInt(500),
+		Currency:      "USD",
+	}
+}
+
+func (s *ExternalTransferServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *ExternalTransferServiceTestSuite) newRequest(direction string) *dto.CreateExternalTransferRequest {
+	return &dto.CreateExternalTransferRequest{
+		Direction:   direction,
+		Amount:      "125.00",
+		Description: "Rent",
+		Counterparty: dto.ExternalTransferCounterparty{
+			AccountHolderName: "Jane Doe",
+			AccountNumber:     "987654321",
+			RoutingNumber:     "011000015",
+			InstitutionName:   "Other Bank",
+		},
+	}
+}
+
+func (s *ExternalTransferServiceTestSuite) newTransfer(direction, status string) *models.ExternalTransfer {
+	return &models.ExternalTransfer{
+		ID:                        uuid.New(),
+		UserID:                    s.userID,
+		AccountID:                 s.account.ID,
+		Direction:                 direction,
+		Amount:                    decimal.RequireFromString("125.00"),
+		Currency:                  "USD",
+		Status:                    status,
+		ProviderTransferID:        "nw-123",
+		CounterpartyName:          "Jane Doe",
+		CounterpartyAccountNumber: "987654321",
+		CounterpartyRoutingNumber: "011000015",
+	}
+}
+
+func (s *ExternalTransferServiceTestSuite) expectVerified() {
+	s.mockClient.EXPECT().AuthAccount(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthWindAccountValidationResult{AccountExists: true, AccountValid: true}, nil)
+	s.mockScreener.EXPECT().ScreenExternalTransfer(s.account, gomock.Any()).Return(models.JSONBMap{}, nil).AnyTimes()
+}
+
+func (s *ExternalTransferServiceTestSuite) expectNewTransfer() {
+	s.mockTransferRepo.EXPECT().GetByIdempotencyKey("key-1").Return(nil, repositories.ErrExternalTransferNotFound)
+	s.mockAccountRepo.EXPECT().GetByID(s.account.ID).Return(s.account, nil)
+	s.mockUserRepo.EXPECT().GetByID(s.userID).Return(&models.User{FirstName: "John", LastName: "Smith"}, nil).AnyTimes()
+	s.mockLimits.EXPECT().CheckLimits(s.account, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_OutboundSubmitted() {
+	s.expectNewTransfer()
+	s.expectVerified()
+
+	s.mockTransferRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(transfer *models.ExternalTransfer) error {
+		s.Equal(models.ExternalTransferOutbound, transfer.Direction)
+		s.Equal("125", transfer.Amount.String())
+		s.Equal("Jane Doe", transfer.CounterpartyName)
+		transfer.ID = uuid.New()
+		return nil
+	})
+
+	expectedCompletion := time.Now().Add(72 * time.Hour)
+	s.mockClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req dto.NorthWindInitiateTransferRequest) (*dto.NorthWindTransferStatusResponse, error) {
+			s.Equal("John Smith", req.SourceAccount.AccountHolderName)
+			s.Equal(s.account.AccountNumber, req.SourceAccount.AccountNumber)
+			s.Equal("987654321", req.DestinationAccount.AccountNumber)
+			s.Equal(models.ExternalTransferTypeACH, req.TransferType)
+			s.Equal("125.00", req.Amount)
+			return &dto.NorthWindTransferStatusResponse{TransferID: "nw-123", ExpectedCompletionDate: expectedCompletion}, nil
+		})
+	s.mockTransferRepo.EXPECT().MarkSubmitted(gomock.Any()).Return(nil)
+
+	transfer, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.Require().NoError(err)
+	s.Equal(models.ExternalTransferStatusSubmitted, transfer.Status)
+	s.Equal("nw-123", transfer.ProviderTransferID)
+	s.Require().NotNil(transfer.SettleAfter)
+	s.WithinDuration(expectedCompletion, *transfer.SettleAfter, time.Second)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_InboundSwapsAccounts() {
+	s.expectNewTransfer()
+	s.expectVerified()
+	s.mockTransferRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	s.mockClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req dto.NorthWindInitiateTransferRequest) (*dto.NorthWindTransferStatusResponse, error) {
+			s.Equal("987654321", req.SourceAccount.AccountNumber)
+			s.Equal(s.account.AccountNumber, req.DestinationAccount.AccountNumber)
+			return &dto.NorthWindTransferStatusResponse{TransferID: "nw-456"}, nil
+		})
+	s.mockTransferRepo.EXPECT().MarkSubmitted(gomock.Any()).Return(nil)
+
+	transfer, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferInbound), "key-1")
+	s.Require().NoError(err)
+	s.Require().NotNil(transfer.SettleAfter)
+	s.WithinDuration(time.Now().Add(48*time.Hour), *transfer.SettleAfter, time.Minute)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_UnverifiedCounterparty() {
+	s.expectNewTransfer()
+	s.mockClient.EXPECT().AuthAccount(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthWindAccountValidationResult{AccountExists: false}, nil)
+
+	_, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.ErrorIs(err, ErrExternalAccountNotVerified)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_NorthWindDownOnVerification() {
+	s.expectNewTransfer()
+	s.mockClient.EXPECT().AuthAccount(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("connection refused"))
+
+	_, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.ErrorIs(err, ErrNorthWindUnavailable)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_SubmissionErrorLeavesInitiated() {
+	s.expectNewTransfer()
+	s.expectVerified()
+	s.mockTransferRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	s.mockClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("northwind error (503): unavailable"))
+
+	transfer, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.Require().NoError(err)
+	s.Equal(models.ExternalTransferStatusInitiated, transfer.Status)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_RefusedSubmissionFails() {
+	s.expectNewTransfer()
+	s.expectVerified()
+	s.mockTransferRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	s.mockClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("%w: invalid routing number", ErrNorthWindRejected))
+
+	failed := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusFailed)
+	s.mockTransferRepo.EXPECT().Fail(gomock.Any(), gomock.Any()).Return(failed, nil)
+
+	transfer, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.Require().NoError(err)
+	s.Equal(models.ExternalTransferStatusFailed, transfer.Status)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_InsufficientFunds() {
+	s.expectNewTransfer()
+	s.expectVerified()
+	s.mockTransferRepo.EXPECT().Create(gomock.Any()).Return(repositories.ErrInsufficientFunds)
+
+	_, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.ErrorIs(err, ErrInsufficientFunds)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_LimitExceeded() {
+	s.mockTransferRepo.EXPECT().GetByIdempotencyKey("key-1").Return(nil, repositories.ErrExternalTransferNotFound)
+	s.mockAccountRepo.EXPECT().GetByID(s.account.ID).Return(s.account, nil)
+	s.mockLimits.EXPECT().CheckLimits(s.account, decimal.RequireFromString("125.00"), models.LimitActivityTransfer).
+		Return(fmt.Errorf("%w: transfers may not exceed 100.00", ErrLimitExceeded))
+
+	_, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.ErrorIs(err, ErrLimitExceeded)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_FlaggedHeldForReview() {
+	s.expectNewTransfer()
+	s.mockClient.EXPECT().AuthAccount(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthWindAccountValidationResult{AccountExists: true, AccountValid: true}, nil)
+
+	rules := models.JSONBMap{models.FraudRuleLargeAmount: "amount is 10x the account's average"}
+	s.mockScreener.EXPECT().ScreenExternalTransfer(s.account, decimal.RequireFromString("125.00")).Return(rules, nil)
+	s.mockTransferRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(transfer *models.ExternalTransfer) error {
+		s.Equal(models.ExternalTransferStatusPendingReview, transfer.Status)
+		holdID := uuid.New()
+		transfer.ID = uuid.New()
+		transfer.HoldTransactionID = &holdID
+		return nil
+	})
+	s.mockScreener.EXPECT().HoldExternalTransfer(gomock.Any(), rules).Return(nil)
+
+	transfer, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.Require().NoError(err)
+	s.Equal(models.ExternalTransferStatusPendingReview, transfer.Status)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_ReviewNotOpenedFails() {
+	s.expectNewTransfer()
+	s.mockClient.EXPECT().AuthAccount(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthWindAccountValidationResult{AccountExists: true, AccountValid: true}, nil)
+
+	rules := models.JSONBMap{models.FraudRuleLargeAmount: "amount is 10x the account's average"}
+	s.mockScreener.EXPECT().ScreenExternalTransfer(s.account, gomock.Any()).Return(rules, nil)
+	s.mockTransferRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(transfer *models.ExternalTransfer) error {
+		transfer.ID = uuid.New()
+		return nil
+	})
+	s.mockScreener.EXPECT().HoldExternalTransfer(gomock.Any(), rules).Return(fmt.Errorf("database unavailable"))
+	s.mockTransferRepo.EXPECT().Fail(gomock.Any(), gomock.Any()).Return(s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusFailed), nil)
+
+	_, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.Error(err)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_IdempotentReplay() {
+	existing := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusSubmitted)
+	s.mockTransferRepo.EXPECT().GetByIdempotencyKey("key-1").Return(existing, nil)
+
+	transfer, err := s.service.InitiateTransfer(s.ctx, s.userID, s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.Require().NoError(err)
+	s.Equal(existing.ID, transfer.ID)
+
+	s.mockTransferRepo.EXPECT().GetByIdempotencyKey("key-1").Return(existing, nil)
+	_, err = s.service.InitiateTransfer(s.ctx, uuid.New(), s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.ErrorIs(err, ErrUnauthorized)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestInitiateTransfer_OtherUsersAccount() {
+	s.mockTransferRepo.EXPECT().GetByIdempotencyKey("key-1").Return(nil, repositories.ErrExternalTransferNotFound)
+	s.mockAccountRepo.EXPECT().GetByID(s.account.ID).Return(s.account, nil)
+
+	_, err := s.service.InitiateTransfer(s.ctx, uuid.New(), s.account.ID, s.newRequest(models.ExternalTransferOutbound), "key-1")
+	s.ErrorIs(err, ErrUnauthorized)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestSettleDue_SettlesCompletedAndReturns() {
+	completed := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusSubmitted)
+	returned := s.newTransfer(models.ExternalTransferInbound, models.ExternalTransferStatusSubmitted)
+	returned.ProviderTransferID = "nw-456"
+	pending := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusSubmitted)
+	pending.ProviderTransferID = "nw-789"
+
+	s.mockTransferRepo.EXPECT().GetDueForSettlement(gomock.Any(), 10).
+		Return([]models.ExternalTransfer{*completed, *returned, *pending}, nil)
+
+	s.mockClient.EXPECT().GetTransfer(gomock.Any(), "nw-123").Return(&dto.NorthWindTransferStatusResponse{Status: "COMPLETED"}, nil)
+	s.mockClient.EXPECT().GetTransfer(gomock.Any(), "nw-456").
+		Return(&dto.NorthWindTransferStatusResponse{Status: "returned", ReturnCode: "r01"}, nil)
+	s.mockClient.EXPECT().GetTransfer(gomock.Any(), "nw-789").Return(&dto.NorthWindTransferStatusResponse{Status: "processing"}, nil)
+
+	settled := *completed
+	settled.Status = models.ExternalTransferStatusSettled
+	s.mockTransferRepo.EXPECT().Settle(completed.ID).Return(&settled, nil)
+
+	closed := *returned
+	closed.Return("R01", "", time.Now())
+	s.mockTransferRepo.EXPECT().Return(returned.ID, "R01", "").Return(&closed, nil)
+
+	count, err := s.service.SettleDue(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestSettleDue_ContinuesAfterNorthWindError() {
+	first := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusSubmitted)
+	second := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusSubmitted)
+	second.ProviderTransferID = "nw-456"
+
+	s.mockTransferRepo.EXPECT().GetDueForSettlement(gomock.Any(), 10).Return([]models.ExternalTransfer{*first, *second}, nil)
+	s.mockClient.EXPECT().GetTransfer(gomock.Any(), "nw-123").Return(nil, fmt.Errorf("timeout"))
+	s.mockClient.EXPECT().GetTransfer(gomock.Any(), "nw-456").Return(&dto.NorthWindTransferStatusResponse{Status: "settled"}, nil)
+	s.mockTransferRepo.EXPECT().Settle(second.ID).Return(second, nil)
+
+	count, err := s.service.SettleDue(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestSubmitPending_RetriesInitiated() {
+	transfer := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusInitiated)
+	transfer.ProviderTransferID = ""
+
+	s.mockTransferRepo.EXPECT().GetUnsubmitted(gomock.Any(), 10).
+		DoAndReturn(func(before time.Time, limit int) ([]models.ExternalTransfer, error) {
+			s.True(before.Before(time.Now().Add(-30 * time.Second)))
+			return []models.ExternalTransfer{*transfer}, nil
+		})
+	s.mockAccountRepo.EXPECT().GetByID(s.account.ID).Return(s.account, nil)
+	s.mockUserRepo.EXPECT().GetByID(s.userID).Return(nil, repositories.ErrUserNotFound)
+	s.mockClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthWindTransferStatusResponse{TransferID: "nw-999"}, nil)
+	s.mockTransferRepo.EXPECT().MarkSubmitted(gomock.Any()).Return(nil)
+
+	count, err := s.service.SubmitPending(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestReturnTransfer() {
+	_, err := s.service.ReturnTransfer(uuid.New(), &dto.ReturnExternalTransferRequest{ReturnCode: "R99"})
+	s.ErrorIs(err, models.ErrInvalidACHReturnCode)
+
+	transfer := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusSubmitted)
+	s.mockTransferRepo.EXPECT().GetByID(transfer.ID).Return(transfer, nil)
+
+	returned := *transfer
+	returned.Return("R02", "", time.Now())
+	s.mockTransferRepo.EXPECT().Return(transfer.ID, "R02", "").Return(&returned, nil)
+
+	result, err := s.service.ReturnTransfer(transfer.ID, &dto.ReturnExternalTransferRequest{ReturnCode: "r02"})
+	s.Require().NoError(err)
+	s.Equal("Account closed", result.ReturnReason)
+
+	settled := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusSettled)
+	s.mockTransferRepo.EXPECT().GetByID(settled.ID).Return(settled, nil)
+	s.mockTransferRepo.EXPECT().Return(settled.ID, "R02", "").Return(nil, repositories.ErrExternalTransferStateChanged)
+
+	_, err = s.service.ReturnTransfer(settled.ID, &dto.ReturnExternalTransferRequest{ReturnCode: "R02"})
+	s.ErrorIs(err, ErrExternalTransferState)
+}
+
+func (s *ExternalTransferServiceTestSuite) TestGetTransfer_HidesOtherUsersTransfers() {
+	transfer := s.newTransfer(models.ExternalTransferOutbound, models.ExternalTransferStatusSubmitted)
+	s.mockTransferRepo.EXPECT().GetByID(transfer.ID).Return(transfer, nil)
+
+	_, err := s.service.GetTransfer(transfer.ID, uuid.New())
+	s.ErrorIs(err, ErrExternalTransferNotFound)
+}
+
+func TestNorthWindService_TransferRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/external/transfers/initiate":
+			var req dto.NorthWindInitiateTransferRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, req.ReferenceNumber, r.Header.Get("Idempotency-Key"))
+			if req.ReferenceNumber == "EXT-BUSY" {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error":{"code":"IDEMPOTENCY_IN_PROGRESS","message":"request in progress"}}`))
+				return
+			}
+			if req.DestinationAccount.RoutingNumber == "000000000" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"error":{"code":"INVALID_ROUTING","message":"invalid routing number"}}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"transfer_id":"nw-1","status":"pending","reference_number":"` + req.ReferenceNumber + `"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/external/transfers/nw-1":
+			_, _ = w.Write([]byte(`{"transfer_id":"nw-1","status":"returned","return_code":"R03"}`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	client := NewNorthWindService(&config.NorthWindConfig{BaseUrl: server.URL, ApiKey: "test"}, slog.Default())
+	ctx := context.Background()
+
+	resp, err := client.InitiateTransfer(ctx, dto.NorthWindInitiateTransferRequest{ReferenceNumber: "EXT-1", Amount: "125.00"})
+	require.NoError(t, err)
+	assert.Equal(t, "nw-1", resp.TransferID)
+	assert.Equal(t, "EXT-1", resp.ReferenceNumber)
+
+	_, err = client.InitiateTransfer(ctx, dto.NorthWindInitiateTransferRequest{
+		DestinationAccount: dto.NorthWindTransferAccount{RoutingNumber: "000000000"},
+	})
+	assert.ErrorIs(t, err, ErrNorthWindRejected)
+	assert.Contains(t, err.Error(), "invalid routing number")
+
+	// A conflicting idempotency key is still in progress and may be retried
+	_, err = client.InitiateTransfer(ctx, dto.NorthWindInitiateTransferRequest{ReferenceNumber: "EXT-BUSY"})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNorthWindRejected)
+
+	status, err := client.GetTransfer(ctx, "nw-1")
+	require.NoError(t, err)
+	assert.Equal(t, "returned", status.Status)
+	assert.Equal(t, "R03", status.ReturnCode)
+
+	_, err = client.GetTransfer(ctx, "nw-2")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNorthWindRejected)
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrFraudReviewNotFound      = errors.New("fraud review not found")
+	ErrFraudReviewNotPending    = errors.New("fraud review has already been decided")
+	ErrFraudReviewExpired       = errors.New("fraud review expired before it was decided")
+	ErrInvalidFraudReviewStatus = errors.New("invalid fraud review status")
+)
+
+// DefaultFraudReviewExpiryBatchSize is the number of expired reviews closed per poll
+const DefaultFraudReviewExpiryBatchSize = 100
+
+// FraudReviewService lets admins decide the debits and transfers held by fraud
+// screening. Approving a debit captures its hold; approving a transfer releases the
+// hold and moves the funds; approving an external transfer releases it to be
+// submitted with its hold kept. Rejecting releases the hold and fails any transfer.
+// A background worker closes reviews whose hold has expired.
+type FraudReviewService struct {
+	reviewRepo           repositories.FraudReviewRepositoryInterface
+	holdRepo             repositories.HoldRepositoryInterface
+	transferRepo         repositories.TransferRepositoryInterface
+	externalTransferRepo repositories.ExternalTransferRepositoryInterface
+	accountService       AccountServiceInterface
+	events               EventPublisher
+	batchSize            int
+	logger               *slog.Logger
+}
+
+// NewFraudReviewService creates a new fraud review service
+func NewFraudReviewService(
+	reviewRepo repositories.FraudReviewRepositoryInterface,
+	holdRepo repositories.HoldRepositoryInterface,
+	transferRepo repositories.TransferRepositoryInterface,
+	externalTransferRepo repositories.ExternalTransferRepositoryInterface,
+	accountService AccountServiceInterface,
+	events EventPublisher,
+	batchSize int,
+	logger *slog.Logger,
+) FraudReviewServiceInterface {
+	if batchSize <= 0 {
+		batchSize = DefaultFraudReviewExpiryBatchSize
+	}
+
+	return &FraudReviewService{
+		reviewRepo:           reviewRepo,
+		holdRepo:             holdRepo,
+		transferRepo:         transferRepo,
+		externalTransferRepo: externalTransferRepo,
+		accountService:       accountService,
+		events:               events,
+		batchSize:            batchSize,
+		logger:               logger,
+	}
+}
+
+// ListReviews lists fraud reviews, oldest first. An empty status lists every review.
+func (s *FraudReviewService) ListReviews(status string, offset, limit int) ([]models.FraudReview, int64, error) {
+	if status != "" && !models.IsValidFraudReviewStatus(status) {
+		return nil, 0, ErrInvalidFraudReviewStatus
+	}
+	return s.reviewRepo.List(status, offset, limit)
+}
+
+// GetReview retrieves a fraud review
+func (s *FraudReviewService) GetReview(reviewID uuid.UUID) (*models.FraudReview, error) {
+	review, err := s.reviewRepo.GetByID(reviewID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrFraudReviewNotFound) {
+			return nil, ErrFraudReviewNotFound
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+// ApproveReview lets a held item through. The decision stands even if the item can
+// no longer be completed, for example because the account was closed meanwhile;
+// the review's failure reason then records why.
+func (s *FraudReviewService) ApproveReview(reviewID, adminID uuid.UUID, reason string) (*models.FraudReview, error) {
+	review, err := s.pendingReview(reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Approve(adminID, reason, time.Now())
+	if err := s.decide(review); err != nil {
+		return nil, err
+	}
+
+	var completeErr error
+	switch review.SubjectType {
+	case models.FraudSubjectTransfer:
+		completeErr = s.completeTransfer(review)
+	case models.FraudSubjectExternalTransfer:
+		completeErr = s.externalTransferRepo.ReleaseFromReview(*review.ExternalTransferID)
+	default:
+		_, completeErr = s.holdRepo.CaptureHold(review.HoldID, review.Amount)
+	}
+
+	if completeErr != nil {
+		s.logger.Warn("approved fraud review could not be completed",
+			slog.String("review_id", review.ID.String()),
+			slog.String("error", completeErr.Error()),
+		)
+
+		review.Fail(completeErr.Error())
+		if err := s.reviewRepo.RecordFailure(review); err != nil {
+			return nil, err
+		}
+	}
+
+	return review, nil
+}
+
+// RejectReview blocks a held item, releasing its hold and failing any transfer
+func (s *FraudReviewService) RejectReview(reviewID, adminID uuid.UUID, reason string) (*models.FraudReview, error) {
+	review, err := s.pendingReview(reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Reject(adminID, reason, time.Now())
+	if err := s.decide(review); err != nil {
+		return nil, err
+	}
+
+	if review.SubjectType == models.FraudSubjectExternalTransfer {
+		if err := s.failExternalTransfer(review, "rejected by fraud review"); err != nil {
+			return nil, err
+		}
+		return review, nil
+	}
+
+	if _, err := s.holdRepo.ReleaseHold(review.HoldID, models.HoldOutcomeReleased); err != nil && !errors.Is(err, repositories.ErrHoldNotActive) {
+		return nil, fmt.Errorf("failed to release held funds: %w", err)
+	}
+
+	if review.SubjectType == models.FraudSubjectTransfer {
+		s.failTransfer(review, "rejected by fraud review")
+	}
+
+	return review, nil
+}
+
+// StartWorker expires undecided reviews on every poll until the context is cancelled
+func (s *FraudReviewService) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	s.logger.Info("starting fraud review expiry worker",
+		slog.Duration("poll_interval", pollInterval),
+	)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("fraud review expiry worker stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.ExpireReviews(); err != nil {
+				s.logger.Error("failed to expire fraud reviews",
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
+
+// ExpireReviews expires reviews whose hold has expired and reports how many were
+// expired
+func (s *FraudReviewService) ExpireReviews() (int, error) {
+	now := time.Now()
+	reviews, err := s.reviewRepo.GetExpired(now, s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for i := range reviews {
+		if err := s.expire(&reviews[i], now); err != nil {
+			if errors.Is(err, ErrFraudReviewNotPending) {
+				continue
+			}
+			return expired, err
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+// pendingReview loads a review that is still awaiting a decision. A review whose
+// hold has expired is closed as expired instead.
+func (s *FraudReviewService) pendingReview(reviewID uuid.UUID) (*models.FraudReview, error) {
+	review, err := s.GetReview(reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !review.IsPending() {
+		return nil, ErrFraudReviewNotPending
+	}
+
+	now := time.Now()
+	if review.IsExpired(now) {
+		if err := s.expire(review, now); err != nil {
+			return nil, err
+		}
+		return nil, ErrFraudReviewExpired
+	}
+
+	return review, nil
+}
+
+// expire closes a review whose hold has expired and fails any transfer it held. A
+// debit's hold is left to the hold expiry worker; an external transfer's is
+// released as the transfer is failed.
+func (s *FraudReviewService) expire(review *models.FraudReview, now time.Time) error {
+	review.Expire(now)
+	if err := s.decide(review); err != nil {
+		return err
+	}
+
+	switch review.SubjectType {
+	case models.FraudSubjectTransfer:
+		s.failTransfer(review, "fraud review expired")
+	case models.FraudSubjectExternalTransfer:
+		return s.failExternalTransfer(review, "fraud review expired")
+	}
+	return nil
+}
+
+// decide saves a review's decision unless it was decided concurrently
+func (s *FraudReviewService) decide(review *models.FraudReview) error {
+	if err := s.reviewRepo.Decide(review); err != nil {
+		if errors.Is(err, repositories.ErrFraudReviewNotPending) {
+			return ErrFraudReviewNotPending
+		}
+		return err
+	}
+	return nil
+}
+
+// completeTransfer releases an approved transfer's hold so the transfer can move
+// the funds it reserved
+func (s *FraudReviewService) completeTransfer(review *models.FraudReview) error {
+	if _, err := s.holdRepo.ReleaseHold(review.HoldID, models.HoldOutcomeReleased); err != nil {
+		s.failTransfer(review, err.Error())
+		return err
+	}
+
+	_, err := s.accountService.CompleteHeldTransfer(*review.TransferID, review.UserID)
+	return err
+}
+
+// failTransfer fails a held transfer that will not be completed
+func (s *FraudReviewService) failTransfer(review *models.FraudReview, reason string) {
+	transfer, err := s.transferRepo.FindByID(*review.TransferID)
+	if err != nil {
+		s.logger.Error("failed to get held transfer",
+			slog.String("transfer_id", review.TransferID.String()),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if transfer.Status != models.TransferStatusPending {
+		return
+	}
+
+	transfer.Fail(reason)
+	if err := s.transferRepo.Update(transfer); err != nil {
+		s.logger.Error("failed to fail held transfer",
+			slog.String("transfer_id", transfer.ID.String()),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	s.events.Publish(models.WebhookEventTransferFailed, review.UserID, transfer)
+}
+
+// failExternalTransfer fails a held external transfer that will not be submitted,
+// releasing its hold
+func (s *FraudReviewService) failExternalTransfer(review *models.FraudReview, reason string) error {
+	transfer, err := s.externalTransferRepo.Fail(*review.ExternalTransferID, reason)
+	if err != nil {
+		if errors.Is(err, repositories.ErrExternalTransferStateChanged) {
+			return nil
+		}
+		return fmt.Errorf("failed to fail held external transfer: %w", err)
+	}
+
+	s.events.Publish(models.WebhookEventTransferFailed, review.UserID, transfer)
+	return nil
+}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type FraudReviewServiceTestSuite struct {
+	suite.Suite
+	ctrl               *gomock.Controller
+	mockReviewRepo     *repository_mocks.MockFraudReviewRepositoryInterface
+	mockHoldRepo       *repository_mocks.MockHoldRepositoryInterface
+	mockTransferRepo   *repository_mocks.MockTransferRepositoryInterface
+	mockExternalRepo   *repository_mocks.MockExternalTransferRepositoryInterface
+	mockAccountService *service_mocks.MockAccountServiceInterface
+	service            FraudReviewServiceInterface
+	adminID            uuid.UUID
+}
+
+func TestFraudReviewServiceSuite(t *testing.T) {
+	suite.Run(t, new(FraudReviewServiceTestSuite))
+}
+
+func (s *FraudReviewServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockReviewRepo = repository_mocks.NewMockFraudReviewRepositoryInterface(s.ctrl)
+	s.mockHoldRepo = repository_mocks.NewMockHoldRepositoryInterface(s.ctrl)
+	s.mockTransferRepo = repository_mocks.NewMockTransferRepositoryInterface(s.ctrl)
+	s.mockExternalRepo = repository_mocks.NewMockExternalTransferRepositoryInterface(s.ctrl)
+	s.mockAccountService = service_mocks.NewMockAccountServiceInterface(s.ctrl)
+	events := service_mocks.NewMockEventPublisher(s.ctrl)
+	events.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.service = NewFraudReviewService(s.mockReviewRepo, s.mockHoldRepo, s.mockTransferRepo, s.mockExternalRepo, s.mockAccountService, events, 100, slog.Default())
+	s.adminID = uuid.New()
+}
+
+func (s *FraudReviewServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *FraudReviewServiceTestSuite) newReview(subjectType string) *models.FraudReview {
+	review := &models.FraudReview{
+		ID:          uuid.New(),
+		AccountID:   uuid.New(),
+		UserID:      uuid.New(),
+		SubjectType: subjectType,
+		HoldID:      uuid.New(),
+		Amount:      decimal.NewFromInt(5000),
+		Description: "Jewellery",
+		Rules:       models.JSONBMap{models.FraudRuleLargeAmount: "too large"},
+		Status:      models.FraudReviewStatusPending,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+	transferID := uuid.New()
+	switch subjectType {
+	case models.FraudSubjectTransfer:
+		review.TransferID = &transferID
+	case models.FraudSubjectExternalTransfer:
+		review.ExternalTransferID = &transferID
+	}
+	s.mockReviewRepo.EXPECT().GetByID(review.ID).Return(review, nil)
+	return review
+}
+
+func (s *FraudReviewServiceTestSuite) expectDecision(status string) {
+	s.mockReviewRepo.EXPECT().Decide(gomock.Any()).DoAndReturn(func(review *models.FraudReview) error {
+		s.Equal(status, review.Status)
+		return nil
+	})
+}
+
+func (s *FraudReviewServiceTestSuite) TestListReviews_InvalidStatus() {
+	_, _, err := s.service.ListReviews("bogus", 0, 20)
+	s.ErrorIs(err, ErrInvalidFraudReviewStatus)
+}
+
+func (s *FraudReviewServiceTestSuite) TestGetReview_NotFound() {
+	reviewID := uuid.New()
+	s.mockReviewRepo.EXPECT().GetByID(reviewID).Return(nil, repositories.ErrFraudReviewNotFound)
+
+	_, err := s.service.GetReview(reviewID)
+	s.ErrorIs(err, ErrFraudReviewNotFound)
+}
+
+func (s *FraudReviewServiceTestSuite) TestApproveReview_DebitCapturesHold() {
+	review := s.newReview(models.FraudSubjectTransaction)
+	s.expectDecision(models.FraudReviewStatusApproved)
+	s.mockHoldRepo.EXPECT().CaptureHold(review.HoldID, review.Amount).Return(&models.Transaction{}, nil)
+
+	result, err := s.service.ApproveReview(review.ID, s.adminID, "Customer confirmed by phone")
+	s.Require().NoError(err)
+	s.Equal(models.FraudReviewStatusApproved, result.Status)
+	s.Equal(s.adminID, *result.ReviewedBy)
+	s.Equal("Customer confirmed by phone", *result.DecisionReason)
+	s.Nil(result.FailureReason)
+}
+
+func (s *FraudReviewServiceTestSuite) TestApproveReview_TransferReleasesHoldAndCompletes() {
+	review := s.newReview(models.FraudSubjectTransfer)
+	s.expectDecision(models.FraudReviewStatusApproved)
+
+	gomock.InOrder(
+		s.mockHoldRepo.EXPECT().ReleaseHold(review.HoldID, models.HoldOutcomeReleased).Return(&models.Transaction{}, nil),
+		s.mockAccountService.EXPECT().CompleteHeldTransfer(*review.TransferID, review.UserID).Return(&models.Transfer{}, nil),
+	)
+
+	result, err := s.service.ApproveReview(review.ID, s.adminID, "Known landlord")
+	s.Require().NoError(err)
+	s.Nil(result.FailureReason)
+}
+
+func (s *FraudReviewServiceTestSuite) TestApproveReview_ExternalTransferReleasedForSubmission() {
+	review := s.newReview(models.FraudSubjectExternalTransfer)
+	s.expectDecision(models.FraudReviewStatusApproved)
+	s.mockExternalRepo.EXPECT().ReleaseFromReview(*review.ExternalTransferID).Return(nil)
+
+	result, err := s.service.ApproveReview(review.ID, s.adminID, "Known payee")
+	s.Require().NoError(err)
+	s.Nil(result.FailureReason)
+}
+
+func (s *FraudReviewServiceTestSuite) TestApproveReview_CompletionFailureIsRecorded() {
+	review := s.newReview(models.FraudSubjectTransfer)
+	s.expectDecision(models.FraudReviewStatusApproved)
+
+	s.mockHoldRepo.EXPECT().ReleaseHold(review.HoldID, models.HoldOutcomeReleased).Return(&models.Transaction{}, nil)
+	s.mockAccountService.EXPECT().CompleteHeldTransfer(*review.TransferID, review.UserID).Return(nil, ErrAccountNotActive)
+	s.mockReviewRepo.EXPECT().RecordFailure(review).Return(nil)
+
+	result, err := s.service.ApproveReview(review.ID, s.adminID, "Known landlord")
+	s.Require().NoError(err)
+	s.Equal(models.FraudReviewStatusApproved, result.Status)
+	s.Require().NotNil(result.FailureReason)
+	s.Equal(ErrAccountNotActive.Error(), *result.FailureReason)
+}
+
+func (s *FraudReviewServiceTestSuite) TestApproveReview_DecidedConcurrently() {
+	review := s.newReview(models.FraudSubjectTransaction)
+	s.mockReviewRepo.EXPECT().Decide(review).Return(repositories.ErrFraudReviewNotPending)
+
+	_, err := s.service.ApproveReview(review.ID, s.adminID, "Looks fine")
+	s.ErrorIs(err, ErrFraudReviewNotPending)
+}
+
+func (s *FraudReviewServiceTestSuite) TestApproveReview_AlreadyDecided() {
+	review := s.newReview(models.FraudSubjectTransaction)
+	review.Status = models.FraudReviewStatusRejected
+
+	_, err := s.service.ApproveReview(review.ID, s.adminID, "Looks fine")
+	s.ErrorIs(err, ErrFraudReviewNotPending)
+}
+
+func (s *FraudReviewServiceTestSuite) TestApproveReview_ExpiredFailsTransfer() {
+	review := s.newReview(models.FraudSubjectTransfer)
+	review.ExpiresAt = time.Now().Add(-time.Minute)
+	s.expectDecision(models.FraudReviewStatusExpired)
+
+	transfer := &models.Transfer{ID: *review.TransferID, Status: models.TransferStatusPending}
+	s.mockTransferRepo.EXPECT().FindByID(*review.TransferID).Return(transfer, nil)
+	s.mockTransferRepo.EXPECT().Update(transfer).Return(nil)
+
+	_, err := s.service.ApproveReview(review.ID, s.adminID, "Looks fine")
+	s.ErrorIs(err, ErrFraudReviewExpired)
+	s.Equal(models.TransferStatusFailed, transfer.Status)
+}
+
+func (s *FraudReviewServiceTestSuite) TestRejectReview_TransferReleasesHoldAndFailsTransfer() {
+	review := s.newReview(models.FraudSubjectTransfer)
+	s.expectDecision(models.FraudReviewStatusRejected)
+	s.mockHoldRepo.EXPECT().ReleaseHold(review.HoldID, models.HoldOutcomeReleased).Return(&models.Transaction{}, nil)
+
+	transfer := &models.Transfer{ID: *review.TransferID, Status: models.TransferStatusPending}
+	s.mockTransferRepo.EXPECT().FindByID(*review.TransferID).Return(transfer, nil)
+	s.mockTransferRepo.EXPECT().Update(transfer).Return(nil)
+
+	result, err := s.service.RejectReview(review.ID, s.adminID, "Account takeover suspected")
+	s.Require().NoError(err)
+	s.Equal(models.FraudReviewStatusRejected, result.Status)
+	s.Equal(models.TransferStatusFailed, transfer.Status)
+}
+
+func (s *FraudReviewServiceTestSuite) TestRejectReview_ExternalTransferFailed() {
+	review := s.newReview(models.FraudSubjectExternalTransfer)
+	s.expectDecision(models.FraudReviewStatusRejected)
+	s.mockExternalRepo.EXPECT().Fail(*review.ExternalTransferID, "rejected by fraud review").
+		Return(&models.ExternalTransfer{ID: *review.ExternalTransferID, Status: models.ExternalTransferStatusFailed}, nil)
+
+	result, err := s.service.RejectReview(review.ID, s.adminID, "Account takeover suspected")
+	s.Require().NoError(err)
+	s.Equal(models.FraudReviewStatusRejected, result.Status)
+}
+
+func (s *FraudReviewServiceTestSuite) TestApproveReview_ExpiredFailsExternalTransfer() {
+	review := s.newReview(models.FraudSubjectExternalTransfer)
+	review.ExpiresAt = time.Now().Add(-time.Minute)
+	s.expectDecision(models.FraudReviewStatusExpired)
+	s.mockExternalRepo.EXPECT().Fail(*review.ExternalTransferID, "fraud review expired").
+		Return(&models.ExternalTransfer{ID: *review.ExternalTransferID, Status: models.ExternalTransferStatusFailed}, nil)
+
+	_, err := s.service.ApproveReview(review.ID, s.adminID, "Looks fine")
+	s.ErrorIs(err, ErrFraudReviewExpired)
+}
+
+func (s *FraudReviewServiceTestSuite) TestRejectReview_HoldAlreadyExpired() {
+	review := s.newReview(models.FraudSubjectTransaction)
+	s.expectDecision(models.FraudReviewStatusRejected)
+	s.mockHoldRepo.EXPECT().ReleaseHold(review.HoldID, models.HoldOutcomeReleased).Return(nil, repositories.ErrHoldNotActive)
+
+	_, err := s.service.RejectReview(review.ID, s.adminID, "Account takeover suspected")
+	s.NoError(err)
+}
+
+func (s *FraudReviewServiceTestSuite) TestRejectReview_ReleaseError() {
+	review := s.newReview(models.FraudSubjectTransaction)
+	s.expectDecision(models.FraudReviewStatusRejected)
+	s.mockHoldRepo.EXPECT().ReleaseHold(review.HoldID, models.HoldOutcomeReleased).Return(nil, errors.New("connection refused"))
+
+	_, err := s.service.RejectReview(review.ID, s.adminID, "Account takeover suspected")
+	s.Error(err)
+}
+
+func (s *FraudReviewServiceTestSuite) TestExpireReviews() {
+	transferID := uuid.New()
+	transferReview := models.FraudReview{
+		ID:          uuid.New(),
+		UserID:      uuid.New(),
+		SubjectType: models.FraudSubjectTransfer,
+		TransferID:  &transferID,
+		Status:      models.FraudReviewStatusPending,
+		ExpiresAt:   time.Now().Add(-time.Minute),
+	}
+	decidedReview := models.FraudReview{
+		ID:          uuid.New(),
+		SubjectType: models.FraudSubjectTransaction,
+		Status:      models.FraudReviewStatusPending,
+		ExpiresAt:   time.Now().Add(-time.Minute),
+	}
+
+	s.mockReviewRepo.EXPECT().GetExpired(gomock.Any(), 100).Return([]models.FraudReview{transferReview, decidedReview}, nil)
+	s.mockReviewRepo.EXPECT().Decide(gomock.Any()).DoAndReturn(func(review *models.FraudReview) error {
+		s.Equal(models.FraudReviewStatusExpired, review.Status)
+		if review.ID == decidedReview.ID {
+			return repositories.ErrFraudReviewNotPending
+		}
+		return nil
+	}).Times(2)
+
+	transfer := &models.Transfer{ID: transferID, Status: models.TransferStatusPending}
+	s.mockTransferRepo.EXPECT().FindByID(transferID).Return(transfer, nil)
+	s.mockTransferRepo.EXPECT().Update(transfer).Return(nil)
+
+	expired, err := s.service.ExpireReviews()
+	s.Require().NoError(err)
+	s.Equal(1, expired)
+	s.Equal(models.TransferStatusFailed, transfer.Status)
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/shopspring/decimal"
+)
+
+// largeAmountRule flags amounts far above the account's average transaction over
+// the default metrics period. Accounts with too little history are not judged.
+type largeAmountRule struct {
+	metrics    AccountMetricsServiceInterface
+	multiplier decimal.Decimal
+	minHistory int64
+}
+
+// NewLargeAmountRule flags amounts more than multiplier times the account's
+// AverageTransactionAmount, once the account has at least minHistory transactions
+func NewLargeAmountRule(metrics AccountMetricsServiceInterface, multiplier, minHistory int) ScreeningRule {
+	return &largeAmountRule{
+		metrics:    metrics,
+		multiplier: decimal.NewFromInt(int64(multiplier)),
+		minHistory: int64(minHistory),
+	}
+}
+
+func (r *largeAmountRule) Name() string {
+	return models.FraudRuleLargeAmount
+}
+
+func (r *largeAmountRule) Evaluate(subject *ScreeningSubject) (string, error) {
+	metrics, err := r.metrics.GetAccountMetrics(subject.Account.UserID, subject.Account.ID, nil, nil, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to get account metrics: %w", err)
+	}
+
+	if metrics.TransactionCount < r.minHistory || !metrics.AverageTransactionAmount.IsPositive() {
+		return "", nil
+	}
+
+	if subject.Amount.LessThanOrEqual(metrics.AverageTransactionAmount.Mul(r.multiplier)) {
+		return "", nil
+	}
+
+	return fmt.Sprintf("amount %s is more than %s times the average transaction of %s",
+		subject.Amount.StringFixed(2), r.multiplier, metrics.AverageTransactionAmount.StringFixed(2)), nil
+}
+
+// newDestinationRule flags the first transfer to another customer's account.
+// Transfers between a customer's own accounts are not flagged.
+type newDestinationRule struct {
+	transferRepo repositories.TransferRepositoryInterface
+}
+
+// NewNewDestinationRule flags transfers to an account the source account has never
+// completed a transfer to
+func NewNewDestinationRule(transferRepo repositories.TransferRepositoryInterface) ScreeningRule {
+	return &newDestinationRule{
+		transferRepo: transferRepo,
+	}
+}
+
+func (r *newDestinationRule) Name() string {
+	return models.FraudRuleNewDestination
+}
+
+func (r *newDestinationRule) Evaluate(subject *ScreeningSubject) (string, error) {
+	if subject.Destination == nil || subject.Destination.UserID == subject.Account.UserID {
+		return "", nil
+	}
+
+	count, err := r.transferRepo.CountCompletedBetween(subject.Account.ID, subject.Destination.ID)
+	if err != nil {
+		return "", err
+	}
+
+	if count > 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("first transfer to account %s", subject.Destination.AccountNumber), nil
+}
+
+// newLoginIPRule flags activity after a login from an IP address the customer has
+// not logged in from recently, as recorded in the audit log
+type newLoginIPRule struct {
+	auditRepo repositories.AuditLogRepositoryInterface
+	history   int
+}
+
+// NewNewLoginIPRule flags activity when the customer's latest login came from an
+// IP address not seen in their previous history logins. Customers with no earlier
+// logins are not flagged.
+func NewNewLoginIPRule(auditRepo repositories.AuditLogRepositoryInterface, history int) ScreeningRule {
+	return &newLoginIPRule{
+		auditRepo: auditRepo,
+		history:   history,
+	}
+}
+
+func (r *newLoginIPRule) Name() string {
+	return models.FraudRuleNewLoginIP
+}
+
+func (r *newLoginIPRule) Evaluate(subject *ScreeningSubject) (string, error) {
+	logins, err := r.auditRepo.GetRecentLogins(subject.Account.UserID, r.history+1)
+	if err != nil {
+		return "", err
+	}
+
+	if len(logins) < 2 {
+		return "", nil
+	}
+
+	latest := logins[0]
+	for _, login := range logins[1:] {
+		if login.IPAddress == latest.IPAddress {
+			return "", nil
+		}
+	}
+
+	return fmt.Sprintf("latest login came from new IP address %s", latest.IPAddress), nil
+}
+
+// rapidTransfersRule flags bursts of transfers out of an account
+type rapidTransfersRule struct {
+	transferRepo repositories.TransferRepositoryInterface
+	window       time.Duration
+	maxCount     int64
+}
+
+// NewRapidTransfersRule flags a transfer when more than maxCount transfers, including
+// it, have left the account within window
+func NewRapidTransfersRule(transferRepo repositories.TransferRepositoryInterface, window time.Duration, maxCount int) ScreeningRule {
+	return &rapidTransfersRule{
+		transferRepo: transferRepo,
+		window:       window,
+		maxCount:     int64(maxCount),
+	}
+}
+
+func (r *rapidTransfersRule) Name() string {
+	return models.FraudRuleRapidTransfers
+}
+
+func (r *rapidTransfersRule) Evaluate(subject *ScreeningSubject) (string, error) {
+	if subject.Destination == nil {
+		return "", nil
+	}
+
+	count, err := r.transferRepo.CountFromAccountSince(subject.Account.ID, time.Now().Add(-r.window))
+	if err != nil {
+		return "", err
+	}
+
+	if count <= r.maxCount {
+		return "", nil
+	}
+
+	return fmt.Sprintf("%d transfers from this account within %s", count, r.window), nil
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type FraudRulesTestSuite struct {
+	suite.Suite
+	ctrl             *gomock.Controller
+	mockMetrics      *service_mocks.MockAccountMetricsServiceInterface
+	mockTransferRepo *repository_mocks.MockTransferRepositoryInterface
+	mockAuditRepo    *repository_mocks.MockAuditLogRepositoryInterface
+	account          *models.Account
+	destination      *models.Account
+}
+
+func TestFraudRulesSuite(t *testing.T) {
+	suite.Run(t, new(FraudRulesTestSuite))
+}
+
+func (s *FraudRulesTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockMetrics = service_mocks.NewMockAccountMetricsServiceInterface(s.ctrl)
+	s.mockTransferRepo = repository_mocks.NewMockTransferRepositoryInterface(s.ctrl)
+	s.mockAuditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+
+	s.account = &models.Account{ID: uuid.New(), UserID: uuid.New(), AccountNumber: "1000000001"}
+	s.destination = &models.Account{ID: uuid.New(), UserID: uuid.New(), AccountNumber: "2000000002"}
+}
+
+func (s *FraudRulesTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *FraudRulesTestSuite) TestLargeAmountRule() {
+	rule := NewLargeAmountRule(s.mockMetrics, 5, 3)
+	s.Equal(models.FraudRuleLargeAmount, rule.Name())
+
+	s.mockMetrics.EXPECT().
+		GetAccountMetrics(s.account.UserID, s.account.ID, nil, nil, false).
+		Return(&models.AccountMetrics{TransactionCount: 10, AverageTransactionAmount: decimal.NewFromInt(100)}, nil).
+		Times(2)
+
+	reason, err := rule.Evaluate(&ScreeningSubject{Account: s.account, Amount: decimal.NewFromInt(500)})
+	s.NoError(err)
+	s.Empty(reason)
+
+	reason, err = rule.Evaluate(&ScreeningSubject{Account: s.account, Amount: decimal.RequireFromString("500.01")})
+	s.NoError(err)
+	s.Equal("amount 500.01 is more than 5 times the average transaction of 100.00", reason)
+}
+
+func (s *FraudRulesTestSuite) TestLargeAmountRule_TooLittleHistory() {
+	rule := NewLargeAmountRule(s.mockMetrics, 5, 3)
+
+	s.mockMetrics.EXPECT().
+		GetAccountMetrics(s.account.UserID, s.account.ID, nil, nil, false).
+		Return(&models.AccountMetrics{TransactionCount: 2, AverageTransactionAmount: decimal.NewFromInt(10)}, nil)
+
+	reason, err := rule.Evaluate(&ScreeningSubject{Account: s.account, Amount: decimal.NewFromInt(10000)})
+	s.NoError(err)
+	s.Empty(reason)
+}
+
+func (s *FraudRulesTestSuite) TestNewDestinationRule() {
+	rule := NewNewDestinationRule(s.mockTransferRepo)
+	s.Equal(models.FraudRuleNewDestination, rule.Name())
+
+	s.mockTransferRepo.EXPECT().CountCompletedBetween(s.account.ID, s.destination.ID).Return(int64(0), nil)
+	reason, err := rule.Evaluate(&ScreeningSubject{Account: s.account, Destination: s.destination})
+	s.NoError(err)
+	s.Equal("first transfer to account 2000000002", reason)
+
+	s.mockTransferRepo.EXPECT().CountCompletedBetween(s.account.ID, s.destination.ID).Return(int64(1), nil)
+	reason, err = rule.Evaluate(&ScreeningSubject{Account: s.account, Destination: s.destination})
+	s.NoError(err)
+	s.Empty(reason)
+}
+
+func (s *FraudRulesTestSuite) TestNewDestinationRule_SkipsDebitsAndOwnAccounts() {
+	rule := NewNewDestinationRule(s.mockTransferRepo)
+
+	reason, err := rule.Evaluate(&ScreeningSubject{Account: s.account})
+	s.NoError(err)
+	s.Empty(reason)
+
+	s.destination.UserID = s.account.UserID
+	reason, err = rule.Evaluate(&ScreeningSubject{Account: s.account, Destination: s.destination})
+	s.NoError(err)
+	s.Empty(reason)
+}
+
+func (s *FraudRulesTestSuite) TestNewLoginIPRule() {
+	rule := NewNewLoginIPRule(s.mockAuditRepo, 3)
+	s.Equal(models.FraudRuleNewLoginIP, rule.Name())
+
+	logins := func(ips ...string) []*models.AuditLog {
+		result := make([]*models.AuditLog, len(ips))
+		for i, ip := range ips {
+			result[i] = &models.AuditLog{IPAddress: ip}
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name   string
+		logins []*models.AuditLog
+		fires  bool
+	}{
+		{"no logins", nil, false},
+		{"first login", logins("203.0.113.7"), false},
+		{"known IP", logins("192.168.1.1", "10.0.0.1", "192.168.1.1"), false},
+		{"new IP", logins("203.0.113.7", "192.168.1.1", "192.168.1.1"), true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockAuditRepo.EXPECT().GetRecentLogins(s.account.UserID, 4).Return(tc.logins, nil)
+
+			reason, err := rule.Evaluate(&ScreeningSubject{Account: s.account})
+			s.NoError(err)
+			if tc.fires {
+				s.Equal("latest login came from new IP address 203.0.113.7", reason)
+			} else {
+				s.Empty(reason)
+			}
+		})
+	}
+}
+
+func (s *FraudRulesTestSuite) TestRapidTransfersRule() {
+	rule := NewRapidTransfersRule(s.mockTransferRepo, 10*time.Minute, 3)
+	s.Equal(models.FraudRuleRapidTransfers, rule.Name())
+
+	s.mockTransferRepo.EXPECT().CountFromAccountSince(s.account.ID, gomock.Any()).Return(int64(3), nil)
+	reason, err := rule.Evaluate(&ScreeningSubject{Account: s.account, Destination: s.destination})
+	s.NoError(err)
+	s.Empty(reason)
+
+	s.mockTransferRepo.EXPECT().CountFromAccountSince(s.account.ID, gomock.Any()).Return(int64(4), nil)
+	reason, err = rule.Evaluate(&ScreeningSubject{Account: s.account, Destination: s.destination})
+	s.NoError(err)
+	s.Equal("4 transfers from this account within 10m0s", reason)
+
+	// Debits are not transfers
+	reason, err = rule.Evaluate(&ScreeningSubject{Account: s.account})
+	s.NoError(err)
+	s.Empty(reason)
+}
+
+func (s *FraudRulesTestSuite) TestRuleErrorsArePropagated() {
+	dbErr := errors.New("connection refused")
+	s.mockTransferRepo.EXPECT().CountFromAccountSince(s.account.ID, gomock.Any()).Return(int64(0), dbErr)
+
+	_, err := NewRapidTransfersRule(s.mockTransferRepo, time.Minute, 1).
+		Evaluate(&ScreeningSubject{Account: s.account, Destination: s.destination})
+	s.ErrorIs(err, dbErr)
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/shopspring/decimal"
+)
+
+// ScreeningSubject is a debit or outgoing transfer being screened. Destination is
+// nil for debits.
+type ScreeningSubject struct {
+	Account     *models.Account
+	Destination *models.Account
+	Amount      decimal.Decimal
+}
+
+// ScreeningRule is one check in the fraud screening pipeline
+type ScreeningRule interface {
+	// Name identifies the rule in fraud reviews and the audit trail
+	Name() string
+	// Evaluate returns why the subject looks suspicious, or an empty string if the
+	// rule does not fire
+	Evaluate(subject *ScreeningSubject) (string, error)
+}
+
+// FraudScreeningService runs debits and outgoing transfers through a pipeline of
+// screening rules. When any rule fires the amount is reserved with a hold and a
+// fraud review is opened; the item stays pending until an admin decides or the
+// review window elapses and the hold expires.
+type FraudScreeningService struct {
+	rules        []ScreeningRule
+	holdRepo     repositories.HoldRepositoryInterface
+	reviewRepo   repositories.FraudReviewRepositoryInterface
+	auditRepo    repositories.AuditLogRepositoryInterface
+	reviewWindow time.Duration
+	logger       *slog.Logger
+}
+
+// NewFraudScreeningService creates a fraud screening service. With no rules
+// nothing is ever flagged.
+func NewFraudScreeningService(
+	rules []ScreeningRule,
+	holdRepo repositories.HoldRepositoryInterface,
+	reviewRepo repositories.FraudReviewRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	reviewWindow time.Duration,
+	logger *slog.Logger,
+) TransactionScreener {
+	return &FraudScreeningService{
+		rules:        rules,
+		holdRepo:     holdRepo,
+		reviewRepo:   reviewRepo,
+		auditRepo:    auditRepo,
+		reviewWindow: reviewWindow,
+		logger:       logger,
+	}
+}
+
+// ScreenDebit screens a debit and holds it for review if any rule fires
+func (s *FraudScreeningService) ScreenDebit(account *models.Account, amount decimal.Decimal, description string) (*models.Transaction, error) {
+	rules, err := s.screen(&ScreeningSubject{Account: account, Amount: amount})
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+
+	review := &models.FraudReview{
+		AccountID:   account.ID,
+		UserID:      account.UserID,
+		SubjectType: models.FraudSubjectTransaction,
+		Amount:      amount,
+		Description: description,
+		Rules:       rules,
+	}
+
+	return s.holdForReview(review, description)
+}
+
+// ScreenTransfer screens a pending transfer and holds it for review if any rule fires
+func (s *FraudScreeningService) ScreenTransfer(transfer *models.Transfer, fromAccount, toAccount *models.Account) (bool, error) {
+	rules, err := s.screen(&ScreeningSubject{Account: fromAccount, Destination: toAccount, Amount: transfer.Amount})
+	if err != nil || len(rules) == 0 {
+		return false, err
+	}
+
+	review := &models.FraudReview{
+		AccountID:   fromAccount.ID,
+		UserID:      fromAccount.UserID,
+		SubjectType: models.FraudSubjectTransfer,
+		TransferID:  &transfer.ID,
+		Amount:      transfer.Amount,
+		Description: transfer.Description,
+		Rules:       rules,
+	}
+
+	holdDescription := fmt.Sprintf("Transfer to %s held for review: %s", toAccount.AccountNumber, transfer.Description)
+	if _, err := s.holdForReview(review, holdDescription); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ScreenExternalTransfer screens an outbound external transfer before it is recorded
+func (s *FraudScreeningService) ScreenExternalTransfer(account *models.Account, amount decimal.Decimal) (models.JSONBMap, error) {
+	return s.screen(&ScreeningSubject{Account: account, Amount: amount})
+}
+
+// HoldExternalTransfer opens a review of a flagged external transfer. The review
+// takes over the transfer's hold rather than placing a second one.
+func (s *FraudScreeningService) HoldExternalTransfer(transfer *models.ExternalTransfer, rules models.JSONBMap) error {
+	if transfer.HoldTransactionID == nil {
+		return fmt.Errorf("external transfer %s has no hold to review", transfer.ID)
+	}
+
+	review := &models.FraudReview{
+		AccountID:          transfer.AccountID,
+		UserID:             transfer.UserID,
+		SubjectType:        models.FraudSubjectExternalTransfer,
+		HoldID:             *transfer.HoldTransactionID,
+		ExternalTransferID: &transfer.ID,
+		Amount:             transfer.Amount,
+		Description:        transfer.Description,
+		Rules:              rules,
+		ExpiresAt:          time.Now().Add(s.reviewWindow),
+	}
+
+	return s.openReview(review)
+}
+
+// screen runs every rule and returns the reasons given by those that fired. A rule
+// that cannot be evaluated stops the item rather than letting it through unscreened.
+func (s *FraudScreeningService) screen(subject *ScreeningSubject) (models.JSONBMap, error) {
+	fired := models.JSONBMap{}
+	for _, rule := range s.rules {
+		reason, err := rule.Evaluate(subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate fraud rule %s: %w", rule.Name(), err)
+		}
+		if reason != "" {
+			fired[rule.Name()] = reason
+		}
+	}
+	return fired, nil
+}
+
+// holdForReview reserves the review's amount and opens the review. The hold is
+// released again if the review cannot be stored.
+func (s *FraudScreeningService) holdForReview(review *models.FraudReview, holdDescription string) (*models.Transaction, error) {
+	review.ExpiresAt = time.Now().Add(s.reviewWindow)
+
+	hold := models.NewHold(review.AccountID, review.Amount, holdDescription, review.ExpiresAt)
+	if err := s.holdRepo.PlaceHold(hold); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrInsufficientFunds):
+			return nil, ErrInsufficientFunds
+		case errors.Is(err, repositories.ErrAccountNotActive):
+			return nil, ErrAccountNotActive
+		}
+		return nil, fmt.Errorf("failed to place hold for fraud review: %w", err)
+	}
+
+	review.HoldID = hold.ID
+	if err := s.openReview(review); err != nil {
+		if _, releaseErr := s.holdRepo.ReleaseHold(hold.ID, models.HoldOutcomeReleased); releaseErr != nil {
+			s.logger.Error("failed to release hold after fraud review failed",
+				slog.String("hold_id", hold.ID.String()),
+				slog.String("error", releaseErr.Error()),
+			)
+		}
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// openReview stores a review of an item whose amount is already held, and records
+// it in the audit trail
+func (s *FraudScreeningService) openReview(review *models.FraudReview) error {
+	if err := s.reviewRepo.Create(review); err != nil {
+		return err
+	}
+
+	s.logger.Info("held for fraud review",
+		slog.String("review_id", review.ID.String()),
+		slog.String("account_id", review.AccountID.String()),
+		slog.Any("rules", review.RuleNames()),
+	)
+
+	recordAuditLog(s.auditRepo, s.logger, &models.AuditLog{
+		UserID:     &review.UserID,
+		Action:     models.AuditActionFraudFlagged,
+		Resource:   "fraud_review",
+		ResourceID: review.ID.String(),
+		IPAddress:  "system",
+		UserAgent:  "internal",
+		Metadata: models.JSONBMap{
+			"subject_type": review.SubjectType,
+			"account_id":   review.AccountID.String(),
+			"amount":       review.Amount.String(),
+			"hold_id":      review.HoldID.String(),
+			"rules":        review.Rules,
+		},
+	})
+
+	return nil
+}
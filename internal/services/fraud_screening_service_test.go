@@ -0,0 +1,212 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+// stubRule is a screening rule with a fixed outcome
+type stubRule struct {
+	name   string
+	reason string
+	err    error
+}
+
+func (r *stubRule) Name() string {
+	return r.name
+}
+
+func (r *stubRule) Evaluate(subject *ScreeningSubject) (string, error) {
+	return r.reason, r.err
+}
+
+type FraudScreeningServiceTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	mockHoldRepo   *repository_mocks.MockHoldRepositoryInterface
+	mockReviewRepo *repository_mocks.MockFraudReviewRepositoryInterface
+	mockAuditRepo  *repository_mocks.MockAuditLogRepositoryInterface
+	account        *models.Account
+	destination    *models.Account
+}
+
+func TestFraudScreeningServiceSuite(t *testing.T) {
+	suite.Run(t, new(FraudScreeningServiceTestSuite))
+}
+
+func (s *FraudScreeningServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockHoldRepo = repository_mocks.NewMockHoldRepositoryInterface(s.ctrl)
+	s.mockReviewRepo = repository_mocks.NewMockFraudReviewRepositoryInterface(s.ctrl)
+	s.mockAuditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+
+	s.account = &models.Account{ID: uuid.New(), UserID: uuid.New(), AccountNumber: "1000000001"}
+	s.destination = &models.Account{ID: uuid.New(), UserID: uuid.New(), AccountNumber: "2000000002"}
+}
+
+func (s *FraudScreeningServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *FraudScreeningServiceTestSuite) newService(rules ...ScreeningRule) TransactionScreener {
+	return NewFraudScreeningService(rules, s.mockHoldRepo, s.mockReviewRepo, s.mockAuditRepo, 72*time.Hour, slog.Default())
+}
+
+// expectHold expects a hold to be placed and assigns it an ID
+func (s *FraudScreeningServiceTestSuite) expectHold(amount decimal.Decimal) *uuid.UUID {
+	holdID := uuid.New()
+	s.mockHoldRepo.EXPECT().PlaceHold(gomock.Any()).DoAndReturn(func(hold *models.Transaction) error {
+		s.Equal(s.account.ID, hold.AccountID)
+		s.True(hold.Amount.Equal(amount))
+		s.True(hold.IsActiveHold())
+		hold.ID = holdID
+		return nil
+	})
+	return &holdID
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreenDebit_NoRuleFires() {
+	service := s.newService(&stubRule{name: models.FraudRuleLargeAmount})
+
+	hold, err := service.ScreenDebit(s.account, decimal.NewFromInt(100), "Groceries")
+	s.NoError(err)
+	s.Nil(hold)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreenDebit_Held() {
+	service := s.newService(
+		&stubRule{name: models.FraudRuleLargeAmount, reason: "too large"},
+		&stubRule{name: models.FraudRuleNewLoginIP},
+	)
+	amount := decimal.NewFromInt(5000)
+	holdID := s.expectHold(amount)
+
+	s.mockReviewRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(review *models.FraudReview) error {
+		s.Equal(models.FraudSubjectTransaction, review.SubjectType)
+		s.Equal(s.account.ID, review.AccountID)
+		s.Equal(s.account.UserID, review.UserID)
+		s.Equal(*holdID, review.HoldID)
+		s.Nil(review.TransferID)
+		s.Equal(models.JSONBMap{models.FraudRuleLargeAmount: "too large"}, review.Rules)
+		s.WithinDuration(time.Now().Add(72*time.Hour), review.ExpiresAt, time.Minute)
+		review.ID = uuid.New()
+		return nil
+	})
+	s.mockAuditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionFraudFlagged, log.Action)
+		s.Equal(s.account.UserID, *log.UserID)
+		return nil
+	})
+
+	hold, err := service.ScreenDebit(s.account, amount, "Jewellery")
+	s.Require().NoError(err)
+	s.Equal(*holdID, hold.ID)
+	s.True(hold.IsPending())
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreenDebit_InsufficientFunds() {
+	service := s.newService(&stubRule{name: models.FraudRuleLargeAmount, reason: "too large"})
+
+	s.mockHoldRepo.EXPECT().PlaceHold(gomock.Any()).Return(repositories.ErrInsufficientFunds)
+
+	hold, err := service.ScreenDebit(s.account, decimal.NewFromInt(5000), "Jewellery")
+	s.ErrorIs(err, ErrInsufficientFunds)
+	s.Nil(hold)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreenDebit_ReviewFailureReleasesHold() {
+	service := s.newService(&stubRule{name: models.FraudRuleLargeAmount, reason: "too large"})
+	holdID := s.expectHold(decimal.NewFromInt(5000))
+
+	dbErr := errors.New("connection refused")
+	s.mockReviewRepo.EXPECT().Create(gomock.Any()).Return(dbErr)
+	s.mockHoldRepo.EXPECT().ReleaseHold(*holdID, models.HoldOutcomeReleased).Return(&models.Transaction{}, nil)
+
+	hold, err := service.ScreenDebit(s.account, decimal.NewFromInt(5000), "Jewellery")
+	s.ErrorIs(err, dbErr)
+	s.Nil(hold)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreenDebit_RuleErrorStopsItem() {
+	dbErr := errors.New("connection refused")
+	service := s.newService(&stubRule{name: models.FraudRuleNewLoginIP, err: dbErr})
+
+	hold, err := service.ScreenDebit(s.account, decimal.NewFromInt(100), "Groceries")
+	s.ErrorIs(err, dbErr)
+	s.Nil(hold)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreenTransfer_Held() {
+	service := s.newService(&stubRule{name: models.FraudRuleNewDestination, reason: "first transfer"})
+	transfer := &models.Transfer{
+		ID:            uuid.New(),
+		FromAccountID: s.account.ID,
+		ToAccountID:   s.destination.ID,
+		Amount:        decimal.NewFromInt(250),
+		Description:   "Rent",
+		Status:        models.TransferStatusPending,
+	}
+	s.expectHold(transfer.Amount)
+
+	s.mockReviewRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(review *models.FraudReview) error {
+		s.Equal(models.FraudSubjectTransfer, review.SubjectType)
+		s.Equal(transfer.ID, *review.TransferID)
+		review.ID = uuid.New()
+		return nil
+	})
+	s.mockAuditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	held, err := service.ScreenTransfer(transfer, s.account, s.destination)
+	s.NoError(err)
+	s.True(held)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreenTransfer_NoRules() {
+	transfer := &models.Transfer{ID: uuid.New(), Amount: decimal.NewFromInt(250)}
+
+	held, err := s.newService().ScreenTransfer(transfer, s.account, s.destination)
+	s.NoError(err)
+	s.False(held)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreenExternalTransfer_ReviewUsesTransferHold() {
+	service := s.newService(&stubRule{name: models.FraudRuleLargeAmount, reason: "unusually large"})
+
+	rules, err := service.ScreenExternalTransfer(s.account, decimal.NewFromInt(250))
+	s.Require().NoError(err)
+	s.Equal(models.JSONBMap{models.FraudRuleLargeAmount: "unusually large"}, rules)
+
+	holdID := uuid.New()
+	transfer := &models.ExternalTransfer{
+		ID:                uuid.New(),
+		UserID:            s.account.UserID,
+		AccountID:         s.account.ID,
+		Amount:            decimal.NewFromInt(250),
+		Description:       "Rent",
+		Status:            models.ExternalTransferStatusPendingReview,
+		HoldTransactionID: &holdID,
+	}
+
+	s.mockReviewRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(review *models.FraudReview) error {
+		s.Equal(models.FraudSubjectExternalTransfer, review.SubjectType)
+		s.Equal(transfer.ID, *review.ExternalTransferID)
+		s.Equal(holdID, review.HoldID)
+		s.Nil(review.TransferID)
+		review.ID = uuid.New()
+		return nil
+	})
+	s.mockAuditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	s.NoError(service.HoldExternalTransfer(transfer, rules))
+}
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrHoldNotFound      = errors.New("hold not found")
+	ErrHoldNotActive     = errors.New("hold has already been captured, released or expired")
+	ErrInvalidHoldExpiry = errors.New("invalid hold expiry")
+)
+
+// DefaultHoldExpiryBatchSize is the number of expired holds queued per poll
+const DefaultHoldExpiryBatchSize = 100
+
+// HoldService places, captures and releases authorization holds. A hold is a
+// pending debit that reduces the account's available balance without moving funds
+// until it is captured. A background worker queues holds past their expiry for the
+// processing queue, which releases them.
+type HoldService struct {
+	holdRepo        repositories.HoldRepositoryInterface
+	accountRepo     repositories.AccountRepositoryInterface
+	transactionRepo repositories.TransactionRepositoryInterface
+	queueRepo       repositories.ProcessingQueueRepositoryInterface
+	batchSize       int
+	logger          *slog.Logger
+}
+
+// NewHoldService creates a new hold service
+func NewHoldService(
+	holdRepo repositories.HoldRepositoryInterface,
+	accountRepo repositories.AccountRepositoryInterface,
+	transactionRepo repositories.TransactionRepositoryInterface,
+	queueRepo repositories.ProcessingQueueRepositoryInterface,
+	batchSize int,
+	logger *slog.Logger,
+) HoldServiceInterface {
+	if batchSize <= 0 {
+		batchSize = DefaultHoldExpiryBatchSize
+	}
+
+	return &HoldService{
+		holdRepo:        holdRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		queueRepo:       queueRepo,
+		batchSize:       batchSize,
+		logger:          logger,
+	}
+}
+
+// PlaceHold reserves funds on an account until the hold is captured, released or expires
+func (s *HoldService) PlaceHold(accountID uuid.UUID, req *dto.PlaceHoldRequest) (*models.Transaction, error) {
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil || amount.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidAmount
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(models.DefaultHoldDuration)
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+	if !expiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expiry must be in the future", ErrInvalidHoldExpiry)
+	}
+	if expiresAt.After(now.Add(models.MaxHoldDuration)) {
+		return nil, fmt.Errorf("%w: holds may last at most %s", ErrInvalidHoldExpiry, models.MaxHoldDuration)
+	}
+
+	hold := models.NewHold(accountID, amount, req.Description, expiresAt)
+	if err := s.holdRepo.PlaceHold(hold); err != nil {
+		return nil, mapHoldErr(err)
+	}
+
+	s.logger.Info("hold placed",
+		slog.String("hold_id", hold.ID.String()),
+		slog.String("account_id", accountID.String()),
+		slog.String("amount", amount.StringFixed(2)),
+		slog.Time("expires_at", expiresAt),
+	)
+
+	return hold, nil
+}
+
+// GetAccountHolds retrieves the holds that currently reserve funds on an account
+func (s *HoldService) GetAccountHolds(accountID uuid.UUID) ([]models.Transaction, error) {
+	if _, err := s.accountRepo.GetByID(accountID); err != nil {
+		if errors.Is(err, repositories.ErrAccountNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	holds, err := s.holdRepo.GetActiveHolds(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holds: %w", err)
+	}
+	return holds, nil
+}
+
+// CaptureHold debits the account for the held amount, or for a smaller amount when
+// one is given, and returns any remainder to the available balance
+func (s *HoldService) CaptureHold(holdID uuid.UUID, req *dto.CaptureHoldRequest) (*models.Transaction, error) {
+	hold, err := s.getHold(holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := hold.Amount
+	if req.Amount != "" {
+		amount, err = decimal.NewFromString(req.Amount)
+		if err != nil || amount.LessThanOrEqual(decimal.Zero) || amount.GreaterThan(hold.Amount) {
+			return nil, ErrInvalidAmount
+		}
+	}
+
+	captured, err := s.holdRepo.CaptureHold(holdID, amount)
+	if err != nil {
+		return nil, mapHoldErr(err)
+	}
+
+	s.logger.Info("hold captured",
+		slog.String("hold_id", holdID.String()),
+		slog.String("account_id", captured.AccountID.String()),
+		slog.String("amount", amount.StringFixed(2)),
+	)
+
+	return captured, nil
+}
+
+// ReleaseHold cancels a hold and returns its amount to the available balance
+func (s *HoldService) ReleaseHold(holdID uuid.UUID) (*models.Transaction, error) {
+	if _, err := s.getHold(holdID); err != nil {
+		return nil, err
+	}
+
+	released, err := s.holdRepo.ReleaseHold(holdID, models.HoldOutcomeReleased)
+	if err != nil {
+		return nil, mapHoldErr(err)
+	}
+
+	s.logger.Info("hold released",
+		slog.String("hold_id", holdID.String()),
+		slog.String("account_id", released.AccountID.String()),
+	)
+
+	return released, nil
+}
+
+// StartWorker queues expired holds for release on every poll until the context is cancelled
+func (s *HoldService) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	s.logger.Info("starting hold expiry worker",
+		slog.Duration("poll_interval", pollInterval),
+	)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("hold expiry worker stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.EnqueueExpiredHolds(); err != nil {
+				s.logger.Error("failed to queue expired holds",
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
+
+// EnqueueExpiredHolds queues an expire operation for each hold past its expiry that
+// is not already queued, and reports how many were queued
+func (s *HoldService) EnqueueExpiredHolds() (int, error) {
+	expired, err := s.transactionRepo.GetExpiredPendingTransactions(s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for i := range expired {
+		hold := &expired[i]
+		if !hold.IsHold() {
+			continue
+		}
+
+		alreadyQueued, err := s.queueRepo.IsQueued(hold.ID, models.QueueOperationExpire)
+		if err != nil {
+			return queued, err
+		}
+		if alreadyQueued {
+			continue
+		}
+
+		if err := s.queueRepo.Enqueue(hold.ID, models.QueueOperationExpire, models.QueuePriorityHigh); err != nil {
+			return queued, err
+		}
+		queued++
+	}
+
+	if queued > 0 {
+		s.logger.Info("queued expired holds", slog.Int("count", queued))
+	}
+
+	return queued, nil
+}
+
+func (s *HoldService) getHold(holdID uuid.UUID) (*models.Transaction, error) {
+	hold, err := s.holdRepo.GetHold(holdID)
+	if err != nil {
+		return nil, mapHoldErr(err)
+	}
+
+	if !hold.IsActiveHold() {
+		return nil, ErrHoldNotActive
+	}
+
+	return hold, nil
+}
+
+// mapHoldErr translates hold repository errors into service errors
+func mapHoldErr(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrHoldNotFound):
+		return ErrHoldNotFound
+	case errors.Is(err, repositories.ErrHoldNotActive), errors.Is(err, repositories.ErrHoldExpired):
+		return ErrHoldNotActive
+	case errors.Is(err, repositories.ErrHoldAmountExceeded):
+		return ErrInvalidAmount
+	case errors.Is(err, repositories.ErrAccountNotFound):
+		return ErrAccountNotFound
+	case errors.Is(err, repositories.ErrAccountNotActive):
+		return ErrAccountNotActive
+	case errors.Is(err, repositories.ErrInsufficientFunds):
+		return ErrInsufficientFunds
+	default:
+		return fmt.Errorf("hold operation failed: %w", err)
+	}
+}
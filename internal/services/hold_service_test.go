@@ -0,0 +1,160 @@
+package services
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type HoldServiceTestSuite struct {
+	suite.Suite
+	ctrl                *gomock.Controller
+	mockHoldRepo        *repository_mocks.MockHoldRepositoryInterface
+	mockAccountRepo     *repository_mocks.MockAccountRepositoryInterface
+	mockTransactionRepo *repository_mocks.MockTransactionRepositoryInterface
+	mockQueueRepo       *repository_mocks.MockProcessingQueueRepositoryInterface
+	service             *HoldService
+}
+
+func TestHoldServiceSuite(t *testing.T) {
+	suite.Run(t, new(HoldServiceTestSuite))
+}
+
+func (s *HoldServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockHoldRepo = repository_mocks.NewMockHoldRepositoryInterface(s.ctrl)
+	s.mockAccountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
+	s.mockTransactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
+	s.mockQueueRepo = repository_mocks.NewMockProcessingQueueRepositoryInterface(s.ctrl)
+
+	s.service = NewHoldService(
+		s.mockHoldRepo,
+		s.mockAccountRepo,
+		s.mockTransactionRepo,
+		s.mockQueueRepo,
+		10,
+		slog.Default(),
+	).(*HoldService)
+}
+
+func (s *HoldServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *HoldServiceTestSuite) newHold(amount string, expiresAt time.Time) *models.Transaction {
+	hold := models.NewHold(uuid.New(), decimal.RequireFromString(amount), "Card authorization", expiresAt)
+	hold.ID = uuid.New()
+	return hold
+}
+
+func (s *HoldServiceTestSuite) TestPlaceHold_DefaultsExpiry() {
+	accountID := uuid.New()
+
+	s.mockHoldRepo.EXPECT().PlaceHold(gomock.Any()).DoAndReturn(func(hold *models.Transaction) error {
+		s.Equal(accountID, hold.AccountID)
+		s.True(hold.IsActiveHold())
+		s.WithinDuration(time.Now().Add(models.DefaultHoldDuration), *hold.PendingUntil, time.Minute)
+		return nil
+	})
+
+	hold, err := s.service.PlaceHold(accountID, &dto.PlaceHoldRequest{Amount: "25.00", Description: "Card authorization"})
+	s.Require().NoError(err)
+	s.Equal("25.00", hold.Amount.StringFixed(2))
+}
+
+func (s *HoldServiceTestSuite) TestPlaceHold_RejectsInvalidExpiry() {
+	past := time.Now().Add(-time.Minute)
+	_, err := s.service.PlaceHold(uuid.New(), &dto.PlaceHoldRequest{Amount: "25.00", Description: "x", ExpiresAt: &past})
+	s.ErrorIs(err, ErrInvalidHoldExpiry)
+
+	tooLate := time.Now().Add(models.MaxHoldDuration + time.Hour)
+	_, err = s.service.PlaceHold(uuid.New(), &dto.PlaceHoldRequest{Amount: "25.00", Description: "x", ExpiresAt: &tooLate})
+	s.ErrorIs(err, ErrInvalidHoldExpiry)
+
+	_, err = s.service.PlaceHold(uuid.New(), &dto.PlaceHoldRequest{Amount: "-1", Description: "x"})
+	s.ErrorIs(err, ErrInvalidAmount)
+}
+
+func (s *HoldServiceTestSuite) TestPlaceHold_InsufficientAvailableBalance() {
+	s.mockHoldRepo.EXPECT().PlaceHold(gomock.Any()).Return(repositories.ErrInsufficientFunds)
+
+	_, err := s.service.PlaceHold(uuid.New(), &dto.PlaceHoldRequest{Amount: "500.00", Description: "Card authorization"})
+	s.ErrorIs(err, ErrInsufficientFunds)
+}
+
+func (s *HoldServiceTestSuite) TestCaptureHold_DefaultsToHeldAmount() {
+	hold := s.newHold("50.00", time.Now().Add(time.Hour))
+
+	s.mockHoldRepo.EXPECT().GetHold(hold.ID).Return(hold, nil)
+	s.mockHoldRepo.EXPECT().CaptureHold(hold.ID, hold.Amount).Return(hold, nil)
+
+	_, err := s.service.CaptureHold(hold.ID, &dto.CaptureHoldRequest{})
+	s.Require().NoError(err)
+}
+
+func (s *HoldServiceTestSuite) TestCaptureHold_RejectsMoreThanHeld() {
+	hold := s.newHold("50.00", time.Now().Add(time.Hour))
+
+	s.mockHoldRepo.EXPECT().GetHold(hold.ID).Return(hold, nil)
+
+	_, err := s.service.CaptureHold(hold.ID, &dto.CaptureHoldRequest{Amount: "50.01"})
+	s.ErrorIs(err, ErrInvalidAmount)
+}
+
+func (s *HoldServiceTestSuite) TestCaptureHold_ExpiredHoldIsNotActive() {
+	hold := s.newHold("50.00", time.Now().Add(-time.Minute))
+
+	s.mockHoldRepo.EXPECT().GetHold(hold.ID).Return(hold, nil)
+	s.mockHoldRepo.EXPECT().CaptureHold(hold.ID, hold.Amount).Return(nil, repositories.ErrHoldExpired)
+
+	_, err := s.service.CaptureHold(hold.ID, &dto.CaptureHoldRequest{})
+	s.ErrorIs(err, ErrHoldNotActive)
+}
+
+func (s *HoldServiceTestSuite) TestReleaseHold_AlreadySettled() {
+	hold := s.newHold("50.00", time.Now().Add(time.Hour))
+	hold.ReleaseHold(models.HoldOutcomeReleased)
+
+	s.mockHoldRepo.EXPECT().GetHold(hold.ID).Return(hold, nil)
+
+	_, err := s.service.ReleaseHold(hold.ID)
+	s.ErrorIs(err, ErrHoldNotActive)
+}
+
+func (s *HoldServiceTestSuite) TestReleaseHold_NotFound() {
+	id := uuid.New()
+	s.mockHoldRepo.EXPECT().GetHold(id).Return(nil, repositories.ErrHoldNotFound)
+
+	_, err := s.service.ReleaseHold(id)
+	s.ErrorIs(err, ErrHoldNotFound)
+}
+
+func (s *HoldServiceTestSuite) TestEnqueueExpiredHolds_SkipsQueuedAndNonHolds() {
+	expired := []models.Transaction{
+		*s.newHold("10.00", time.Now().Add(-time.Hour)),
+		*s.newHold("20.00", time.Now().Add(-time.Minute)),
+		*s.newHold("30.00", time.Now().Add(-time.Minute)),
+	}
+	queued, fresh, notHold := &expired[0], &expired[1], &expired[2]
+	notHold.TransactionType = models.TransactionTypeCredit
+
+	s.mockTransactionRepo.EXPECT().GetExpiredPendingTransactions(10).
+		Return(expired, nil)
+	s.mockQueueRepo.EXPECT().IsQueued(queued.ID, models.QueueOperationExpire).Return(true, nil)
+	s.mockQueueRepo.EXPECT().IsQueued(fresh.ID, models.QueueOperationExpire).Return(false, nil)
+	s.mockQueueRepo.EXPECT().Enqueue(fresh.ID, models.QueueOperationExpire, models.QueuePriorityHigh).Return(nil)
+
+	count, err := s.service.EnqueueExpiredHolds()
+	s.Require().NoError(err)
+	s.Equal(1, count)
+}
@@ -0,0 +1,382 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidInterestBackfill = errors.New("invalid interest backfill")
+)
+
+const (
+	// DefaultInterestAccountBatchSize is the number of accounts the worker loads per page
+	DefaultInterestAccountBatchSize = 100
+
+	// MaxInterestBackfillDays is the longest range a single backfill may cover
+	MaxInterestBackfillDays = 366
+
+	// interestCatchUpDays bounds how many days the worker accrues for one account per
+	// run, so a long outage or a newly deployed engine catches up over several runs
+	interestCatchUpDays = 31
+)
+
+// InterestService accrues daily interest on savings and money market accounts and
+// pays it out monthly. Each day's accrual is keyed by account and date and each
+// month's posting by account and month, so the worker and backfills can be re-run
+// safely.
+type InterestService struct {
+	interestRepo     repositories.InterestRepositoryInterface
+	accountRepo      repositories.AccountRepositoryInterface
+	transactionRepo  repositories.TransactionRepositoryInterface
+	accountBatchSize int
+	logger           *slog.Logger
+}
+
+// NewInterestService creates a new interest service
+func NewInterestService(
+	interestRepo repositories.InterestRepositoryInterface,
+	accountRepo repositories.AccountRepositoryInterface,
+	transactionRepo repositories.TransactionRepositoryInterface,
+	accountBatchSize int,
+	logger *slog.Logger,
+) InterestServiceInterface {
+	if accountBatchSize <= 0 {
+		accountBatchSize = DefaultInterestAccountBatchSize
+	}
+
+	return &InterestService{
+		interestRepo:     interestRepo,
+		accountRepo:      accountRepo,
+		transactionRepo:  transactionRepo,
+		accountBatchSize: accountBatchSize,
+		logger:           logger,
+	}
+}
+
+// Backfill accrues interest for every day in the requested range that has no accrual
+// yet, then posts any month that is now fully accrued. Days already accrued are left
+// unchanged. Accruals added to a month that was already posted are paid with the
+// account's next posting.
+func (s *InterestService) Backfill(req *dto.InterestBackfillRequest) (*models.InterestBackfillResult, error) {
+	startDate, endDate, err := s.parseBackfillRange(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	result := newInterestResult(startDate, endDate)
+	if req.AccountID != nil {
+		account, err := s.getBackfillAccount(*req.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		s.processAccount(account, startDate, endDate, time.Now(), result)
+	} else {
+		accounts, err := s.getAllInterestBearingAccounts()
+		if err != nil {
+			return nil, err
+		}
+		for i := range accounts {
+			s.processAccount(&accounts[i], startDate, endDate, time.Now(), result)
+		}
+	}
+
+	s.logger.Info("interest backfill completed",
+		slog.String("start_date", startDate.Format("2006-01-02")),
+		slog.String("end_date", endDate.Format("2006-01-02")),
+		slog.Int("accounts_processed", result.AccountsProcessed),
+		slog.Int("accruals_created", result.AccrualsCreated),
+		slog.Int("postings_created", result.PostingsCreated),
+		slog.String("interest_posted", result.InterestPosted.StringFixed(2)),
+		slog.Int("failed_accounts", len(result.FailedAccounts)),
+	)
+
+	return result, nil
+}
+
+// StartWorker accrues and posts interest on every poll until the context is cancelled
+func (s *InterestService) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	s.logger.Info("starting interest accrual worker",
+		slog.Duration("poll_interval", pollInterval),
+	)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("interest accrual worker stopped")
+			return
+		case <-ticker.C:
+			s.runDue(ctx, time.Now())
+		}
+	}
+}
+
+// runDue accrues each interest-bearing account from the day after its last accrual
+// through yesterday and posts every month that has ended
+func (s *InterestService) runDue(ctx context.Context, now time.Time) *models.InterestBackfillResult {
+	through := models.InterestDate(now).AddDate(0, 0, -1)
+	result := newInterestResult(time.Time{}, through)
+
+	for offset := 0; ; offset += s.accountBatchSize {
+		accounts, err := s.interestRepo.GetInterestBearingAccounts(offset, s.accountBatchSize)
+		if err != nil {
+			s.logger.Error("failed to fetch interest bearing accounts",
+				slog.String("error", err.Error()),
+			)
+			return result
+		}
+
+		for i := range accounts {
+			if ctx.Err() != nil {
+				return result
+			}
+
+			account := &accounts[i]
+			from, err := s.nextAccrualDate(account)
+			if err != nil {
+				s.recordFailure(result, account.ID, err)
+				continue
+			}
+
+			to := from.AddDate(0, 0, interestCatchUpDays-1)
+			if to.After(through) {
+				to = through
+			}
+
+			s.processAccount(account, from, to, now, result)
+		}
+
+		if len(accounts) < s.accountBatchSize {
+			break
+		}
+	}
+
+	if result.AccrualsCreated > 0 || result.PostingsCreated > 0 || len(result.FailedAccounts) > 0 {
+		s.logger.Info("interest accrual run completed",
+			slog.Int("accounts_processed", result.AccountsProcessed),
+			slog.Int("accruals_created", result.AccrualsCreated),
+			slog.Int("postings_created", result.PostingsCreated),
+			slog.String("interest_posted", result.InterestPosted.StringFixed(2)),
+			slog.Int("failed_accounts", len(result.FailedAccounts)),
+		)
+	}
+
+	return result
+}
+
+// processAccount accrues one account over a date range and posts its completed months,
+// adding the outcome to result
+func (s *InterestService) processAccount(account *models.Account, from, through, now time.Time, result *models.InterestBackfillResult) {
+	created, err := s.accrueAccount(account, from, through)
+	result.AccrualsCreated += created
+	if err != nil {
+		s.recordFailure(result, account.ID, err)
+		return
+	}
+
+	postings, err := s.postAccount(account, now)
+	for _, posting := range postings {
+		result.PostingsCreated++
+		result.InterestPosted = result.InterestPosted.Add(posting.Amount)
+	}
+	if err != nil {
+		s.recordFailure(result, account.ID, err)
+		return
+	}
+
+	result.AccountsProcessed++
+}
+
+// accrueAccount stores an accrual for each day in the range that does not have one.
+// Days before the account was opened are skipped. It returns the number of accruals stored.
+func (s *InterestService) accrueAccount(account *models.Account, from, through time.Time) (int, error) {
+	start := models.InterestDate(from)
+	if opened := models.InterestDate(account.CreatedAt); start.Before(opened) {
+		start = opened
+	}
+	end := models.InterestDate(through)
+
+	created := 0
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		balance, err := s.closingBalance(account.ID, day)
+		if err != nil {
+			return created, err
+		}
+
+		stored, err := s.interestRepo.CreateAccrual(models.NewInterestAccrual(account.ID, day, balance, account.InterestRate))
+		if err != nil {
+			return created, err
+		}
+		if stored {
+			created++
+		}
+	}
+
+	return created, nil
+}
+
+// postAccount posts each month that has ended and been accrued through its last day,
+// starting from the month of the account's earliest unpaid accrual
+func (s *InterestService) postAccount(account *models.Account, now time.Time) ([]*models.InterestPosting, error) {
+	earliest, err := s.interestRepo.GetEarliestUnpostedAccrualDate(account.ID)
+	if err != nil || earliest == nil {
+		return nil, err
+	}
+
+	lastAccrued, err := s.interestRepo.GetLastAccrualDate(account.ID)
+	if err != nil || lastAccrued == nil {
+		return nil, err
+	}
+
+	period := models.InterestPeriodStart(*earliest)
+	lastPosting, err := s.interestRepo.GetLastPosting(account.ID)
+	if err != nil && !errors.Is(err, repositories.ErrInterestPostingNotFound) {
+		return nil, err
+	}
+	if lastPosting != nil && !period.After(lastPosting.PeriodStart) {
+		period = lastPosting.PeriodStart.AddDate(0, 1, 0)
+	}
+
+	currentPeriod := models.InterestPeriodStart(now)
+	var postings []*models.InterestPosting
+	for ; period.Before(currentPeriod); period = period.AddDate(0, 1, 0) {
+		lastDay := period.AddDate(0, 1, -1)
+		if lastAccrued.Before(lastDay) {
+			break
+		}
+
+		posting, err := s.interestRepo.PostInterest(account.ID, period)
+		if err != nil {
+			if errors.Is(err, repositories.ErrInterestAlreadyPosted) {
+				continue
+			}
+			return postings, fmt.Errorf("failed to post interest for %s: %w", period.Format("2006-01"), err)
+		}
+
+		s.logger.Info("interest posted",
+			slog.String("account_id", account.ID.String()),
+			slog.String("period", period.Format("2006-01")),
+			slog.String("amount", posting.Amount.StringFixed(2)),
+			slog.String("carry", posting.Carry.String()),
+		)
+		postings = append(postings, posting)
+	}
+
+	return postings, nil
+}
+
+// closingBalance returns an account's balance at the end of a day. An account with no
+// completed transactions by then had nothing on deposit.
+func (s *InterestService) closingBalance(accountID uuid.UUID, day time.Time) (decimal.Decimal, error) {
+	balance, err := s.transactionRepo.GetBalanceAsOf(accountID, day.AddDate(0, 0, 1))
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransactionNotFound) {
+			return decimal.Zero, nil
+		}
+		return decimal.Zero, err
+	}
+	return balance, nil
+}
+
+// nextAccrualDate returns the day after the account's last accrual, or the day it was opened
+func (s *InterestService) nextAccrualDate(account *models.Account) (time.Time, error) {
+	last, err := s.interestRepo.GetLastAccrualDate(account.ID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if last == nil {
+		return models.InterestDate(account.CreatedAt), nil
+	}
+	return models.InterestDate(*last).AddDate(0, 0, 1), nil
+}
+
+// parseBackfillRange validates a backfill's dates. The range must end before today
+// and cover at most MaxInterestBackfillDays days.
+func (s *InterestService) parseBackfillRange(req *dto.InterestBackfillRequest, now time.Time) (time.Time, time.Time, error) {
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid start date", ErrInvalidInterestBackfill)
+	}
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid end date", ErrInvalidInterestBackfill)
+	}
+
+	if endDate.Before(startDate) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: end date must not be before start date", ErrInvalidInterestBackfill)
+	}
+	if !endDate.Before(models.InterestDate(now)) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: end date must be before today", ErrInvalidInterestBackfill)
+	}
+	if days := int(endDate.Sub(startDate).Hours()/24) + 1; days > MaxInterestBackfillDays {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: range must not exceed %d days", ErrInvalidInterestBackfill, MaxInterestBackfillDays)
+	}
+
+	return startDate, endDate, nil
+}
+
+// getBackfillAccount loads a single account to backfill and checks that it earns interest
+func (s *InterestService) getBackfillAccount(accountID uuid.UUID) (*models.Account, error) {
+	account, err := s.accountRepo.GetByID(accountID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAccountNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if !models.IsInterestBearingAccountType(account.AccountType) || !account.InterestRate.IsPositive() {
+		return nil, fmt.Errorf("%w: account does not earn interest", ErrInvalidInterestBackfill)
+	}
+	if !account.IsActive() {
+		return nil, ErrAccountNotActive
+	}
+
+	return account, nil
+}
+
+// getAllInterestBearingAccounts loads every interest-bearing account page by page
+func (s *InterestService) getAllInterestBearingAccounts() ([]models.Account, error) {
+	var all []models.Account
+	for offset := 0; ; offset += s.accountBatchSize {
+		accounts, err := s.interestRepo.GetInterestBearingAccounts(offset, s.accountBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get interest bearing accounts: %w", err)
+		}
+		all = append(all, accounts...)
+		if len(accounts) < s.accountBatchSize {
+			return all, nil
+		}
+	}
+}
+
+// recordFailure logs an account that could not be accrued or posted and adds it to result
+func (s *InterestService) recordFailure(result *models.InterestBackfillResult, accountID uuid.UUID, err error) {
+	s.logger.Error("interest accrual failed",
+		slog.String("account_id", accountID.String()),
+		slog.String("error", err.Error()),
+	)
+	result.FailedAccounts = append(result.FailedAccounts, accountID)
+}
+
+// newInterestResult creates an empty result for a run over the given range
+func newInterestResult(startDate, endDate time.Time) *models.InterestBackfillResult {
+	return &models.InterestBackfillResult{
+		StartDate:      startDate,
+		EndDate:        endDate,
+		InterestPosted: decimal.Zero,
+	}
+}
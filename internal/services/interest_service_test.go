@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type InterestServiceTestSuite struct {
+	suite.Suite
+	ctrl                *gomock.Controller
+	mockInterestRepo    *repository_mocks.MockInterestRepositoryInterface
+	mockAccountRepo     *repository_mocks.MockAccountRepositoryInterface
+	mockTransactionRepo *repository_mocks.MockTransactionRepositoryInterface
+	service             *InterestService
+}
+
+func TestInterestServiceSuite(t *testing.T) {
+	suite.Run(t, new(InterestServiceTestSuite))
+}
+
+func (s *InterestServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockInterestRepo = repository_mocks.NewMockInterestRepositoryInterface(s.ctrl)
+	s.mockAccountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
+	s.mockTransactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
+
+	s.service = NewInterestService(
+		s.mockInterestRepo,
+		s.mockAccountRepo,
+		s.mockTransactionRepo,
+		10,
+		slog.Default(),
+	).(*InterestService)
+}
+
+func (s *InterestServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// newSavingsAccount builds an active savings account opened on the given day
+func (s *InterestServiceTestSuite) newSavingsAccount(opened time.Time) *models.Account {
+	account := savingsAccount(opened)
+	return &account
+}
+
+func savingsAccount(opened time.Time) models.Account {
+	return models.Account{
+		ID:           uuid.New(),
+		UserID:       uuid.New(),
+		AccountType:  models.AccountTypeSavings,
+		Status:       models.AccountStatusActive,
+		Balance:      decimal.NewFromInt(1000),
+		InterestRate: decimal.RequireFromString("0.0150"),
+		CreatedAt:    opened,
+	}
+}
+
+func utcDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *InterestServiceTestSuite) TestBackfill_RejectsRangeEndingToday() {
+	today := time.Now().UTC().Format("2006-01-02")
+
+	_, err := s.service.Backfill(&dto.InterestBackfillRequest{StartDate: today, EndDate: today})
+	s.ErrorIs(err, ErrInvalidInterestBackfill)
+}
+
+func (s *InterestServiceTestSuite) TestBackfill_RejectsRangeOverLimit() {
+	_, err := s.service.Backfill(&dto.InterestBackfillRequest{StartDate: "2024-01-01", EndDate: "2025-12-31"})
+	s.ErrorIs(err, ErrInvalidInterestBackfill)
+}
+
+func (s *InterestServiceTestSuite) TestBackfill_RejectsCheckingAccount() {
+	account := s.newSavingsAccount(utcDate(2026, 1, 1))
+	account.AccountType = models.AccountTypeChecking
+	account.InterestRate = decimal.Zero
+	s.mockAccountRepo.EXPECT().GetByID(account.ID).Return(account, nil)
+
+	_, err := s.service.Backfill(&dto.InterestBackfillRequest{AccountID: &account.ID, StartDate: "2026-09-01", EndDate: "2026-09-02"})
+	s.ErrorIs(err, ErrInvalidInterestBackfill)
+}
+
+func (s *InterestServiceTestSuite) TestBackfill_UnknownAccount() {
+	id := uuid.New()
+	s.mockAccountRepo.EXPECT().GetByID(id).Return(nil, repositories.ErrAccountNotFound)
+
+	_, err := s.service.Backfill(&dto.InterestBackfillRequest{AccountID: &id, StartDate: "2026-09-01", EndDate: "2026-09-02"})
+	s.ErrorIs(err, ErrAccountNotFound)
+}
+
+func (s *InterestServiceTestSuite) TestAccrueAccount_UsesClosingBalanceAndSkipsExistingDays() {
+	account := s.newSavingsAccount(utcDate(2026, 9, 2).Add(10 * time.Hour))
+
+	// September 1 is before the account was opened
+	s.mockTransactionRepo.EXPECT().GetBalanceAsOf(account.ID, utcDate(2026, 9, 3)).
+		Return(decimal.Zero, repositories.ErrTransactionNotFound)
+	s.mockTransactionRepo.EXPECT().GetBalanceAsOf(account.ID, utcDate(2026, 9, 4)).
+		Return(decimal.NewFromInt(730), nil)
+
+	s.mockInterestRepo.EXPECT().CreateAccrual(gomock.Any()).DoAndReturn(func(a *models.InterestAccrual) (bool, error) {
+		s.True(utcDate(2026, 9, 2).Equal(a.AccrualDate))
+		s.True(a.Amount.IsZero())
+		return false, nil
+	})
+	s.mockInterestRepo.EXPECT().CreateAccrual(gomock.Any()).DoAndReturn(func(a *models.InterestAccrual) (bool, error) {
+		s.True(utcDate(2026, 9, 3).Equal(a.AccrualDate))
+		// 730 * 0.015 / 365 = 0.03
+		s.Equal("0.03", a.Amount.String())
+		return true, nil
+	})
+
+	created, err := s.service.accrueAccount(account, utcDate(2026, 9, 1), utcDate(2026, 9, 3))
+	s.Require().NoError(err)
+	s.Equal(1, created)
+}
+
+func (s *InterestServiceTestSuite) TestPostAccount_PostsEndedMonthsThatAreFullyAccrued() {
+	account := s.newSavingsAccount(utcDate(2026, 1, 1))
+	now := utcDate(2026, 10, 16)
+	august := utcDate(2026, 8, 10)
+	septemberEnd := utcDate(2026, 9, 30)
+
+	s.mockInterestRepo.EXPECT().GetEarliestUnpostedAccrualDate(account.ID).Return(&august, nil)
+	s.mockInterestRepo.EXPECT().GetLastAccrualDate(account.ID).Return(&septemberEnd, nil)
+	s.mockInterestRepo.EXPECT().GetLastPosting(account.ID).Return(nil, repositories.ErrInterestPostingNotFound)
+	s.mockInterestRepo.EXPECT().PostInterest(account.ID, utcDate(2026, 8, 1)).
+		Return(&models.InterestPosting{Amount: decimal.RequireFromString("1.27")}, nil)
+	s.mockInterestRepo.EXPECT().PostInterest(account.ID, utcDate(2026, 9, 1)).
+		Return(nil, repositories.ErrInterestAlreadyPosted)
+
+	postings, err := s.service.postAccount(account, now)
+	s.Require().NoError(err)
+	s.Len(postings, 1)
+}
+
+func (s *InterestServiceTestSuite) TestPostAccount_WaitsForMonthToBeFullyAccrued() {
+	account := s.newSavingsAccount(utcDate(2026, 1, 1))
+	earliest := utcDate(2026, 9, 1)
+	lastAccrued := utcDate(2026, 9, 29)
+
+	s.mockInterestRepo.EXPECT().GetEarliestUnpostedAccrualDate(account.ID).Return(&earliest, nil)
+	s.mockInterestRepo.EXPECT().GetLastAccrualDate(account.ID).Return(&lastAccrued, nil)
+	s.mockInterestRepo.EXPECT().GetLastPosting(account.ID).Return(nil, repositories.ErrInterestPostingNotFound)
+
+	postings, err := s.service.postAccount(account, utcDate(2026, 10, 16))
+	s.Require().NoError(err)
+	s.Empty(postings)
+}
+
+func (s *InterestServiceTestSuite) TestPostAccount_LateAccrualsGoToNextUnpostedMonth() {
+	account := s.newSavingsAccount(utcDate(2026, 1, 1))
+	lateJuly := utcDate(2026, 7, 20)
+	septemberEnd := utcDate(2026, 9, 30)
+
+	s.mockInterestRepo.EXPECT().GetEarliestUnpostedAccrualDate(account.ID).Return(&lateJuly, nil)
+	s.mockInterestRepo.EXPECT().GetLastAccrualDate(account.ID).Return(&septemberEnd, nil)
+	s.mockInterestRepo.EXPECT().GetLastPosting(account.ID).
+		Return(&models.InterestPosting{PeriodStart: utcDate(2026, 8, 1)}, nil)
+	s.mockInterestRepo.EXPECT().PostInterest(account.ID, utcDate(2026, 9, 1)).
+		Return(&models.InterestPosting{Amount: decimal.RequireFromString("2.10")}, nil)
+
+	postings, err := s.service.postAccount(account, utcDate(2026, 10, 1))
+	s.Require().NoError(err)
+	s.Len(postings, 1)
+}
+
+func (s *InterestServiceTestSuite) TestRunDue_CatchesUpAtMostOneBatchOfDays() {
+	accounts := []models.Account{savingsAccount(utcDate(2026, 1, 1))}
+	account := &accounts[0]
+	lastAccrued := utcDate(2026, 8, 31)
+	now := utcDate(2026, 10, 16).Add(3 * time.Hour)
+
+	s.mockInterestRepo.EXPECT().GetInterestBearingAccounts(0, 10).Return(accounts, nil)
+	s.mockInterestRepo.EXPECT().GetLastAccrualDate(account.ID).Return(&lastAccrued, nil)
+	s.mockTransactionRepo.EXPECT().GetBalanceAsOf(account.ID, gomock.Any()).
+		Return(decimal.NewFromInt(1000), nil).Times(interestCatchUpDays)
+	s.mockInterestRepo.EXPECT().CreateAccrual(gomock.Any()).Return(true, nil).Times(interestCatchUpDays)
+
+	// Posting sees September fully accrued
+	september := utcDate(2026, 9, 1)
+	octoberFirst := utcDate(2026, 10, 1)
+	s.mockInterestRepo.EXPECT().GetEarliestUnpostedAccrualDate(account.ID).Return(&september, nil)
+	s.mockInterestRepo.EXPECT().GetLastAccrualDate(account.ID).Return(&octoberFirst, nil)
+	s.mockInterestRepo.EXPECT().GetLastPosting(account.ID).Return(nil, repositories.ErrInterestPostingNotFound)
+	s.mockInterestRepo.EXPECT().PostInterest(account.ID, september).
+		Return(&models.InterestPosting{Amount: decimal.RequireFromString("1.23"), PeriodStart: september}, nil)
+
+	result := s.service.runDue(context.Background(), now)
+	s.Equal(interestCatchUpDays, result.AccrualsCreated)
+	s.Equal(1, result.PostingsCreated)
+	s.Equal("1.23", result.InterestPosted.StringFixed(2))
+	s.Empty(result.FailedAccounts)
+}
@@ -2,10 +2,12 @@ package services
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"array-assessment/internal/dto"
 	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -20,7 +22,7 @@ type AccountAssociationServiceInterface interface {
 
 // AccountServiceInterface defines account-related business operations
 type AccountServiceInterface interface {
-	CreateAccount(userID uuid.UUID, accountType, accountNumber, routingNumber string, initialDeposit decimal.Decimal) (*models.Account, error)
+	CreateAccount(userID uuid.UUID, accountType, accountNumber, routingNumber, currency string, initialDeposit decimal.Decimal) (*models.Account, error)
 	CreateAccountsForNewUser(userID uuid.UUID) error
 	GetAccountByID(accountID uuid.UUID, userID *uuid.UUID) (*models.Account, error)
 	GetAccountByNumber(accountNumber string) (*models.Account, error)
@@ -30,13 +32,157 @@ type AccountServiceInterface interface {
 	CloseAccount(accountID uuid.UUID, userID uuid.UUID) error
 	PerformTransaction(accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.Transaction, error)
 	TransferBetweenAccounts(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description, idempotencyKey string, userID uuid.UUID) (*models.Transfer, error)
+	CompleteHeldTransfer(transferID, userID uuid.UUID) (*models.Transfer, error)
 	GetAccountTransactions(accountID uuid.UUID, userID *uuid.UUID, offset, limit int) ([]models.Transaction, int64, error)
 	GetRecentTransactions(accountID uuid.UUID, userID *uuid.UUID, limit int) ([]models.Transaction, error)
 	GetUserTransfers(userID uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error)
 }
 
+// TransferScheduleServiceInterface defines the contract for recurring transfers
+type TransferScheduleServiceInterface interface {
+	CreateSchedule(userID uuid.UUID, req *dto.CreateTransferScheduleRequest) (*models.TransferSchedule, error)
+	ListSchedules(userID uuid.UUID, offset, limit int) ([]models.TransferSchedule, int64, error)
+	GetSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error)
+	PauseSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error)
+	ResumeSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error)
+	CancelSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error)
+	StartWorker(ctx context.Context, pollInterval time.Duration)
+}
+
+// InterestServiceInterface defines the contract for interest accrual and posting
+type InterestServiceInterface interface {
+	Backfill(req *dto.InterestBackfillRequest) (*models.InterestBackfillResult, error)
+	StartWorker(ctx context.Context, pollInterval time.Duration)
+}
+
+// HoldServiceInterface defines the contract for authorization holds
+type HoldServiceInterface interface {
+	PlaceHold(accountID uuid.UUID, req *dto.PlaceHoldRequest) (*models.Transaction, error)
+	GetAccountHolds(accountID uuid.UUID) ([]models.Transaction, error)
+	CaptureHold(holdID uuid.UUID, req *dto.CaptureHoldRequest) (*models.Transaction, error)
+	ReleaseHold(holdID uuid.UUID) (*models.Transaction, error)
+	EnqueueExpiredHolds() (int, error)
+	StartWorker(ctx context.Context, pollInterval time.Duration)
+}
+
+// RateProvider supplies exchange rates for converting between currencies
+type RateProvider interface {
+	// GetRate returns the rate converting one unit of fromCurrency into toCurrency
+	GetRate(fromCurrency, toCurrency string) (*models.ExchangeRate, error)
+}
+
+// ExchangeRateServiceInterface defines the contract for managing stored exchange rates
+type ExchangeRateServiceInterface interface {
+	RateProvider
+	ListRates() ([]models.ExchangeRate, error)
+	LoadRatesFile(path string) (int, error)
+}
+
+// ExternalTransferServiceInterface defines the contract for external transfers to
+// accounts verified through NorthWind
+type ExternalTransferServiceInterface interface {
+	InitiateTransfer(ctx context.Context, userID, accountID uuid.UUID, req *dto.CreateExternalTransferRequest, idempotencyKey string) (*models.ExternalTransfer, error)
+	GetTransfer(id, userID uuid.UUID) (*models.ExternalTransfer, error)
+	ListTransfers(userID uuid.UUID, offset, limit int) ([]models.ExternalTransfer, int64, error)
+	ReturnTransfer(id uuid.UUID, req *dto.ReturnExternalTransferRequest) (*models.ExternalTransfer, error)
+	SubmitPending(ctx context.Context) (int, error)
+	SettleDue(ctx context.Context) (int, error)
+	StartWorker(ctx context.Context, pollInterval time.Duration)
+}
+
+// EventPublisher publishes events about a customer to their webhook subscriptions.
+// Publish never fails the caller; events that cannot be queued are logged.
+type EventPublisher interface {
+	Publish(eventType string, userID uuid.UUID, data interface{})
+}
+
+// WebhookServiceInterface defines the contract for webhook subscriptions and the
+// delivery of published events to them
+type WebhookServiceInterface interface {
+	EventPublisher
+	CreateSubscription(userID uuid.UUID, allCustomers bool, req *dto.CreateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionCreatedResponse, error)
+	ListSubscriptions(userID uuid.UUID, offset, limit int) ([]models.WebhookSubscription, int64, error)
+	GetSubscription(id, userID uuid.UUID) (*models.WebhookSubscription, error)
+	UpdateSubscription(id, userID uuid.UUID, req *dto.UpdateWebhookSubscriptionRequest) (*models.WebhookSubscription, error)
+	DeleteSubscription(id, userID uuid.UUID) error
+	ListDeliveries(subscriptionID, userID uuid.UUID, status string, offset, limit int) ([]models.WebhookDelivery, int64, error)
+	Redeliver(subscriptionID, deliveryID, userID uuid.UUID) (*models.WebhookDelivery, error)
+	DeliverDue(ctx context.Context) (int, error)
+	StartWorker(ctx context.Context, pollInterval time.Duration)
+}
+
+// TransactionLimitChecker checks an account's limits before funds move
+type TransactionLimitChecker interface {
+	// CheckLimits returns an error wrapping ErrLimitExceeded if the activity would
+	// breach one of the account's limits
+	CheckLimits(account *models.Account, amount decimal.Decimal, activity string) error
+	// CheckLimitsWithin is CheckLimits with usage read from the database transaction
+	// that moves the funds
+	CheckLimitsWithin(usage repositories.LimitUsageReader, account *models.Account, amount decimal.Decimal, activity string) error
+}
+
+// LimitServiceInterface defines the contract for configuring transaction limits,
+// granting temporary overrides and reporting usage
+type LimitServiceInterface interface {
+	TransactionLimitChecker
+	GetAccountLimits(requestorID, accountID uuid.UUID, canViewAll bool) (*models.AccountLimits, error)
+	ListAccountTypeLimits() ([]models.TransactionLimit, error)
+	SetAccountTypeLimits(accountType string, req *dto.SetTransactionLimitsRequest, adminID uuid.UUID) (*models.TransactionLimit, error)
+	GetUserLimits(userID uuid.UUID) (*models.TransactionLimit, error)
+	SetUserLimits(userID uuid.UUID, req *dto.SetTransactionLimitsRequest, adminID uuid.UUID) (*models.TransactionLimit, error)
+	DeleteUserLimits(userID uuid.UUID) error
+	GrantOverride(accountID uuid.UUID, req *dto.GrantLimitOverrideRequest, adminID uuid.UUID) (*models.LimitOverride, error)
+	RevokeOverride(overrideID, adminID uuid.UUID) (*models.LimitOverride, error)
+}
+
+// TransactionScreener screens debits and outgoing transfers before funds move
+type TransactionScreener interface {
+	// ScreenDebit returns nil if no rule fires. Otherwise it places the amount on
+	// hold for admin review and returns the hold.
+	ScreenDebit(account *models.Account, amount decimal.Decimal, description string) (*models.Transaction, error)
+	// ScreenTransfer returns false if no rule fires. Otherwise it holds the pending
+	// transfer's amount on the source account for admin review.
+	ScreenTransfer(transfer *models.Transfer, fromAccount, toAccount *models.Account) (bool, error)
+	// ScreenExternalTransfer returns the rules an outbound external transfer fires,
+	// or an empty map if none do. A flagged transfer is recorded pending review and
+	// then passed to HoldExternalTransfer.
+	ScreenExternalTransfer(account *models.Account, amount decimal.Decimal) (models.JSONBMap, error)
+	// HoldExternalTransfer opens an admin review of an external transfer recorded
+	// pending review. The transfer's own hold already reserves its amount.
+	HoldExternalTransfer(transfer *models.ExternalTransfer, rules models.JSONBMap) error
+}
+
+// FraudReviewServiceInterface defines the contract for admin review of the debits
+// and transfers held by fraud screening
+type FraudReviewServiceInterface interface {
+	ListReviews(status string, offset, limit int) ([]models.FraudReview, int64, error)
+	GetReview(reviewID uuid.UUID) (*models.FraudReview, error)
+	ApproveReview(reviewID, adminID uuid.UUID, reason string) (*models.FraudReview, error)
+	RejectReview(reviewID, adminID uuid.UUID, reason string) (*models.FraudReview, error)
+	ExpireReviews() (int, error)
+	StartWorker(ctx context.Context, pollInterval time.Duration)
+}
+
+// ApprovalServiceInterface defines the contract for maker-checker approval of
+// high-risk staff actions
+type ApprovalServiceInterface interface {
+	RequiresApproval(actionType string, amount decimal.Decimal) bool
+	SubmitAction(actionType, resourceID string, payload map[string]interface{}, requestedBy uuid.UUID, ipAddress, userAgent string) (*models.PendingAction, error)
+	ListActions(status string, offset, limit int) ([]models.PendingAction, int64, error)
+	GetAction(actionID uuid.UUID) (*models.PendingAction, error)
+	ApproveAction(actionID, approvedBy uuid.UUID, permissions []string, reason, ipAddress, userAgent string) (*models.PendingAction, map[string]interface{}, error)
+	RejectAction(actionID, rejectedBy uuid.UUID, reason, ipAddress, userAgent string) (*models.PendingAction, error)
+	ExpireActions() (int, error)
+	StartWorker(ctx context.Context, pollInterval time.Duration)
+}
+
+// ReversalServiceInterface defines the contract for admin transaction reversals
+type ReversalServiceInterface interface {
+	RequestReversal(transactionID, adminID uuid.UUID, req *dto.ReverseTransactionRequest) (*dto.ReverseTransactionResponse, error)
+}
+
 type AccountSummaryServiceInterface interface {
-	GetAccountSummary(requestorID uuid.UUID, targetUserID *uuid.UUID, isAdmin bool) (*models.UserAccountSummary, error)
+	GetAccountSummary(requestorID uuid.UUID, targetUserID *uuid.UUID, baseCurrency string, canViewAll bool) (*models.UserAccountSummary, error)
 }
 
 // AuditServiceInterface defines the contract for audit logging operations
@@ -72,11 +218,51 @@ type CategoryServiceInterface interface {
 	// CategorizeTransaction performs complete categorization using all available data
 	CategorizeTransaction(transaction *models.Transaction) *models.CategorizationResult
 
+	// PreviewCategorization categorizes a transaction without recording rule usage
+	PreviewCategorization(transaction *models.Transaction) *models.CategorizationResult
+
 	// BatchCategorize categorizes multiple transactions
 	BatchCategorize(transactions []*models.Transaction) []*models.CategorizationResult
 
 	// OverrideCategory manually overrides the category with audit trail
 	OverrideCategory(transaction *models.Transaction, newCategory, reason string) error
+
+	// Reload refreshes the categorization rules from the database
+	Reload() error
+
+	// StartAutoReload periodically reloads the categorization rules until the context is cancelled
+	StartAutoReload(ctx context.Context, interval time.Duration)
+}
+
+// CategoryManagementServiceInterface defines the contract for administering categories and merchant mapping rules
+type CategoryManagementServiceInterface interface {
+	ListCategories(includeInactive bool) ([]*models.CategoryNode, error)
+	GetCategory(code string) (*models.TransactionCategory, error)
+	CreateCategory(req *dto.CreateCategoryRequest) (*models.TransactionCategory, error)
+	UpdateCategory(code string, req *dto.UpdateCategoryRequest) (*models.TransactionCategory, error)
+	DeactivateCategory(code string) (*models.TransactionCategory, error)
+	ReorderCategories(req *dto.ReorderCategoriesRequest) ([]*models.CategoryNode, error)
+	ListMerchantMappings(filters models.MerchantMappingFilters, offset, limit int) ([]models.MerchantMapping, int64, error)
+	GetMerchantMapping(id uuid.UUID) (*models.MerchantMapping, error)
+	CreateMerchantMapping(req *dto.CreateMerchantMappingRequest) (*models.MerchantMapping, error)
+	UpdateMerchantMapping(id uuid.UUID, req *dto.UpdateMerchantMappingRequest) (*models.MerchantMapping, error)
+	DeactivateMerchantMapping(id uuid.UUID) (*models.MerchantMapping, error)
+	TestCategorization(req *dto.TestCategorizationRequest) *models.CategorizationResult
+}
+
+// RecategorizationServiceInterface defines the contract for re-running categorization over historical transactions
+type RecategorizationServiceInterface interface {
+	CreateJob(req *dto.StartRecategorizationRequest, requestedBy uuid.UUID) (*models.RecategorizationJob, error)
+	GetJob(id uuid.UUID) (*models.RecategorizationJob, error)
+	ListJobs(offset, limit int) ([]models.RecategorizationJob, int64, error)
+	CancelJob(id uuid.UUID) (*models.RecategorizationJob, error)
+	ResumeJob(id uuid.UUID) (*models.RecategorizationJob, error)
+	StartWorker(ctx context.Context, pollInterval time.Duration)
+}
+
+// TransactionCategoryServiceInterface defines the contract for persisting manual category overrides
+type TransactionCategoryServiceInterface interface {
+	OverrideTransactionCategory(transactionID, userID uuid.UUID, canManageTransactions, canManageRules bool, req *dto.OverrideTransactionCategoryRequest, ipAddress, userAgent string) (*dto.OverrideTransactionCategoryResponse, error)
 }
 
 // CustomerProfileServiceInterface defines the contract for customer profile operations
@@ -96,10 +282,10 @@ type CustomerSearchServiceInterface interface {
 // AccountMetricsServiceInterface provides performance metrics and analytics for accounts
 type AccountMetricsServiceInterface interface {
 	// GetAccountMetrics calculates performance metrics for a single account over a date range
-	GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error)
+	GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, canViewAll bool) (*models.AccountMetrics, error)
 
 	// GetUserAggregateMetrics calculates aggregate metrics across all accounts for a user
-	GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error)
+	GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, baseCurrency string, canViewAll bool) (*models.UserAggregateMetrics, error)
 }
 
 type MetricsRecorderInterface interface {
@@ -111,7 +297,10 @@ type MetricsRecorderInterface interface {
 // StatementServiceInterface provides account statement generation
 type StatementServiceInterface interface {
 	// GenerateStatement generates a monthly or quarterly account statement
-	GenerateStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, isAdmin bool) (*models.AccountStatement, error)
+	GenerateStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, canViewAll bool) (*models.AccountStatement, error)
+	// RenderStatement returns the archived PDF or CSV document for a statement
+	// period, rendering and archiving it on first request
+	RenderStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, format string, canViewAll bool) (*models.ArchivedStatement, error)
 }
 
 // TransactionGeneratorInterface generates realistic transaction data for testing
@@ -130,19 +319,26 @@ type TransactionGeneratorInterface interface {
 
 type AuthServiceInterface interface {
 	Register(req *dto.RegisterRequest, ipAddress, userAgent string) (*models.User, error)
-	Login(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error)
+	Login(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.LoginResponse, error)
+	CompleteMFALogin(req *dto.MFALoginRequest, ipAddress, userAgent string) (*dto.LoginResponse, error)
+	BeginMFALoginEnrollment(challengeToken, ipAddress, userAgent string) (*dto.MFAEnrollmentResponse, error)
+	StepUp(userID, sessionID uuid.UUID, code, ipAddress, userAgent string) (*dto.TokenResponse, error)
 	RefreshTokens(refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error)
 	Logout(accessToken, ipAddress, userAgent string) error
 }
 
 type TokenServiceInterface interface {
 	GenerateAccessToken(user *models.User) (string, time.Time, error)
+	GenerateSessionAccessToken(user *models.User, permissions []string, sessionID uuid.UUID, mfaVerifiedAt time.Time) (string, time.Time, error)
 	GenerateRefreshToken(userID uuid.UUID) (string, time.Time, error)
+	GenerateMFAChallengeToken(userID uuid.UUID) (string, time.Time, error)
 	ValidateAccessToken(tokenString string) (*models.CustomClaims, error)
 	ValidateRefreshToken(tokenString string) (*models.CustomClaims, error)
+	ValidateMFAChallengeToken(tokenString string) (*models.CustomClaims, error)
 	ExtractTokenFromHeader(authHeader string) (string, error)
 	GetJTI(tokenString string) (string, error)
 	GetTokenExpiry(tokenString string) (time.Time, error)
+	JWKS() dto.JWKSResponse
 }
 
 type PasswordServiceInterface interface {
@@ -199,13 +395,79 @@ type CustomerLoggerInterface interface {
 	LogAuthorizationFailure(ctx context.Context, operation string, userID uuid.UUID, requiredRole string)
 }
 
+// DeadLetterServiceInterface defines the contract for inspecting, replaying and
+// purging failed processing queue items
+type DeadLetterServiceInterface interface {
+	ListFailed(filters models.QueueItemFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error)
+	GetItem(queueItemID uuid.UUID) (*models.ProcessingQueueItem, error)
+	Replay(req *dto.ReplayQueueItemsRequest) (*dto.QueueItemsActionResponse, error)
+	Purge(adminID uuid.UUID, req *dto.PurgeQueueItemsRequest) (*dto.QueueItemsActionResponse, error)
+}
+
 type TransactionProcessingServiceInterface interface {
 	EnqueueTransaction(transactionID uuid.UUID, operation string, priority int) error
 	StartProcessing(ctx context.Context)
+	StartLeaseReaper(ctx context.Context, pollInterval time.Duration)
+	ReleaseExpiredLeases() (int64, error)
 	ProcessQueueItem(ctx context.Context, queueItem *models.ProcessingQueueItem) error
 	GetQueueMetrics() (*dto.QueueMetrics, error)
 }
 
 type NorthWindServiceInterface interface {
+	NorthWindTransferClient
+}
+
+// NorthWindTransferClient is the NorthWind API used to verify external accounts and
+// move funds to and from them. NorthWindService implements it over HTTP.
+type NorthWindTransferClient interface {
 	AuthAccount(ctx context.Context, requestDto dto.NorthWindAccountRequestDto) (*dto.NorthWindAccountValidationResult, error)
+	InitiateTransfer(ctx context.Context, requestDto dto.NorthWindInitiateTransferRequest) (*dto.NorthWindTransferStatusResponse, error)
+	GetTransfer(ctx context.Context, transferID string) (*dto.NorthWindTransferStatusResponse, error)
+}
+
+// TransactionExportServiceInterface streams transactions to personal finance tools
+type TransactionExportServiceInterface interface {
+	// ExportTransactions writes the account's transactions matching filters to w
+	// as CSV, OFX or QIF
+	ExportTransactions(account *models.Account, filters models.TransactionFilters, format string, w io.Writer) error
+}
+
+// TransactionImportServiceInterface loads back-office files of credits and debits
+type TransactionImportServiceInterface interface {
+	// ImportTransactions validates and applies a CSV or JSON-lines file, returning
+	// a row-by-row report. A dry run writes nothing.
+	ImportTransactions(file io.Reader, options dto.ImportTransactionsOptions) (*dto.TransactionImportReport, error)
+}
+
+// MFAServiceInterface manages TOTP enrollment and verifies codes for login and
+// step-up of sensitive operations
+type MFAServiceInterface interface {
+	GetStatus(userID uuid.UUID) (*dto.MFAStatusResponse, error)
+	BeginEnrollment(userID uuid.UUID, ipAddress, userAgent string) (*dto.MFAEnrollmentResponse, error)
+	ConfirmEnrollment(userID uuid.UUID, code, ipAddress, userAgent string) (*dto.MFARecoveryCodesResponse, error)
+	// Verify accepts a current authenticator code or an unused recovery code
+	Verify(userID uuid.UUID, code, purpose, ipAddress, userAgent string) error
+	Disable(userID uuid.UUID, code, ipAddress, userAgent string) error
+	RegenerateRecoveryCodes(userID uuid.UUID, code, ipAddress, userAgent string) (*dto.MFARecoveryCodesResponse, error)
+	IsEnabled(userID uuid.UUID) (bool, error)
+	StepUpRequired(userID uuid.UUID) (bool, error)
+	SetRequired(userID, adminID uuid.UUID, required bool, ipAddress, userAgent string) error
+	Reset(userID, adminID uuid.UUID, ipAddress, userAgent string) error
+}
+
+// SessionServiceInterface manages signed-in sessions and their refresh token families
+type SessionServiceInterface interface {
+	CreateSession(userID uuid.UUID, deviceName, ipAddress, userAgent string, expiresAt time.Time) (*models.Session, error)
+	RecordUse(sessionID uuid.UUID, ipAddress, userAgent string, expiresAt time.Time) error
+	ListSessions(userID, currentSessionID uuid.UUID) ([]dto.SessionResponse, error)
+	RevokeSession(userID, sessionID, performedBy uuid.UUID, reason, ipAddress, userAgent string) error
+	RevokeAllSessions(userID, performedBy uuid.UUID, reason, ipAddress, userAgent string) (int, error)
+	// HandleRefreshTokenReuse revokes the token family of a rotated refresh token that was presented again
+	HandleRefreshTokenReuse(token *models.RefreshToken, ipAddress, userAgent string)
+}
+
+// RoleServiceInterface lists roles and assigns them to users
+type RoleServiceInterface interface {
+	ListRoles() ([]dto.RoleResponse, error)
+	AssignRole(userID uuid.UUID, role string, performedBy uuid.UUID, ipAddress, userAgent string) (*models.User, error)
 }
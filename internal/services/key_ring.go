@@ -0,0 +1,147 @@
+package services
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"array-assessment/internal/config"
+	"array-assessment/internal/dto"
+)
+
+// signingKey is an RSA key in the token key ring
+type signingKey struct {
+	id         string
+	privateKey *rsa.PrivateKey // nil for a replaced key kept only for verification
+	publicKey  *rsa.PublicKey
+	// activatesAt is when the key starts signing; zero for the current key
+	activatesAt time.Time
+	// retiresAt is when tokens the key signed stop being accepted; zero while the
+	// key has not been replaced
+	retiresAt time.Time
+}
+
+func (k *signingKey) retired(now time.Time) bool {
+	return !k.retiresAt.IsZero() && !now.Before(k.retiresAt)
+}
+
+// keyRing holds the keys tokens are signed and verified with. Rotation is
+// zero-downtime: the next key is published before it signs anything, and a
+// replaced key keeps verifying until every token it signed has expired.
+type keyRing struct {
+	current  *signingKey
+	next     *signingKey
+	previous *signingKey
+}
+
+func newKeyRing(cfg *config.JWTConfig) *keyRing {
+	maxLifetime := cfg.MaxTokenLifetime()
+
+	publicKey := cfg.PublicKey
+	if publicKey == nil && cfg.PrivateKey != nil {
+		publicKey = &cfg.PrivateKey.PublicKey
+	}
+
+	ring := &keyRing{
+		current: &signingKey{
+			privateKey: cfg.PrivateKey,
+			publicKey:  publicKey,
+		},
+	}
+	if publicKey != nil {
+		ring.current.id = keyID(publicKey)
+	}
+
+	if cfg.NextPrivateKey != nil {
+		ring.next = &signingKey{
+			id:          keyID(&cfg.NextPrivateKey.PublicKey),
+			privateKey:  cfg.NextPrivateKey,
+			publicKey:   &cfg.NextPrivateKey.PublicKey,
+			activatesAt: cfg.NextKeyActivatesAt,
+		}
+		ring.current.retiresAt = cfg.NextKeyActivatesAt.Add(maxLifetime)
+	}
+
+	if cfg.PreviousPublicKey != nil {
+		ring.previous = &signingKey{
+			id:        keyID(cfg.PreviousPublicKey),
+			publicKey: cfg.PreviousPublicKey,
+			retiresAt: cfg.KeyRotatedAt.Add(maxLifetime),
+		}
+	}
+
+	return ring
+}
+
+// signingKey returns the key new tokens are signed with at now
+func (r *keyRing) signingKey(now time.Time) *signingKey {
+	if r.next != nil && !now.Before(r.next.activatesAt) {
+		return r.next
+	}
+	return r.current
+}
+
+// verificationKeys returns the keys tokens are accepted from at now, signing key
+// first
+func (r *keyRing) verificationKeys(now time.Time) []*signingKey {
+	signing := r.signingKey(now)
+	keys := []*signingKey{signing}
+	for _, key := range []*signingKey{r.current, r.next, r.previous} {
+		if key == nil || key == signing || key.publicKey == nil || key.retired(now) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// verificationKey returns the public key with the given kid, if it is accepted
+// at now
+func (r *keyRing) verificationKey(kid string, now time.Time) (*rsa.PublicKey, bool) {
+	for _, key := range r.verificationKeys(now) {
+		if key.id == kid {
+			return key.publicKey, true
+		}
+	}
+	return nil, false
+}
+
+// jwks returns the keys accepted at now as a JSON Web Key Set
+func (r *keyRing) jwks(now time.Time) dto.JWKSResponse {
+	keys := r.verificationKeys(now)
+	response := dto.JWKSResponse{Keys: make([]dto.JWK, 0, len(keys))}
+	for _, key := range keys {
+		if key.publicKey == nil {
+			continue
+		}
+		response.Keys = append(response.Keys, dto.JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			Kid: key.id,
+			N:   base64.RawURLEncoding.EncodeToString(key.publicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.publicKey.E)).Bytes()),
+		})
+	}
+	return response
+}
+
+// keyID is the RFC 7638 JWK thumbprint of an RSA public key, so the same key
+// gets the same kid on every replica without any coordination
+func keyID(publicKey *rsa.PublicKey) string {
+	// Members in lexicographic order, as the thumbprint requires
+	thumbprintInput, _ := json.Marshal(struct {
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		Kty: "RSA",
+		N:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+	})
+	sum := sha256.Sum256(thumbprintInput)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,466 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrLimitExceeded          = errors.New("transaction limit exceeded")
+	ErrInvalidLimit           = errors.New("invalid transaction limit")
+	ErrTransactionLimitNotSet = errors.New("no transaction limits configured")
+	ErrLimitOverrideNotFound  = errors.New("limit override not found")
+	ErrLimitOverrideNotActive = errors.New("limit override has expired or been revoked")
+	ErrInvalidLimitOverride   = errors.New("invalid limit override")
+)
+
+// effectiveLimit is the limit that applies to an account for one limit type
+type effectiveLimit struct {
+	value    *decimal.Decimal
+	source   string
+	override *models.LimitOverride
+}
+
+// LimitService enforces per-account transaction limits. Each limit comes from the
+// newest active override on the account, else the customer's limits, else the
+// account type's limits; a limit set at none of those levels is unlimited.
+type LimitService struct {
+	limitRepo   repositories.LimitRepositoryInterface
+	accountRepo repositories.AccountRepositoryInterface
+	logger      *slog.Logger
+}
+
+// NewLimitService creates a new limit service
+func NewLimitService(
+	limitRepo repositories.LimitRepositoryInterface,
+	accountRepo repositories.AccountRepositoryInterface,
+	logger *slog.Logger,
+) LimitServiceInterface {
+	return &LimitService{
+		limitRepo:   limitRepo,
+		accountRepo: accountRepo,
+		logger:      logger,
+	}
+}
+
+// CheckLimits checks a deposit, withdrawal or outgoing transfer against the
+// account's limits. Deposits count only towards the hourly transaction limit.
+func (s *LimitService) CheckLimits(account *models.Account, amount decimal.Decimal, activity string) error {
+	return s.CheckLimitsWithin(s.limitRepo, account, amount, activity)
+}
+
+// CheckLimitsWithin checks limits against the activity read through usage. Run from an
+// AccountCheck, the usage is read under the account's row lock, so concurrent
+// debits cannot both fit under the same limit.
+func (s *LimitService) CheckLimitsWithin(usage repositories.LimitUsageReader, account *models.Account, amount decimal.Decimal, activity string) error {
+	now := time.Now()
+	limits, err := s.effectiveLimits(account, now)
+	if err != nil {
+		return err
+	}
+
+	if activity == models.LimitActivityTransfer {
+		if limit := limits[models.LimitTypeSingleTransfer].value; limit != nil && amount.GreaterThan(*limit) {
+			return s.limitExceeded(account, models.LimitTypeSingleTransfer,
+				fmt.Sprintf("transfers may not exceed %s", limit.StringFixed(2)))
+		}
+	}
+
+	if activity == models.LimitActivityWithdrawal || activity == models.LimitActivityTransfer {
+		windows := []struct {
+			limitType string
+			since     time.Time
+			name      string
+		}{
+			{models.LimitTypeDailyWithdrawal, models.StartOfDay(now), "daily"},
+			{models.LimitTypeMonthlyWithdrawal, models.StartOfMonth(now), "monthly"},
+		}
+
+		for _, window := range windows {
+			limit := limits[window.limitType].value
+			if limit == nil {
+				continue
+			}
+
+			used, err := usage.GetWithdrawalTotal(account.ID, window.since)
+			if err != nil {
+				return fmt.Errorf("failed to get withdrawal usage: %w", err)
+			}
+
+			if used.Add(amount).GreaterThan(*limit) {
+				return s.limitExceeded(account, window.limitType,
+					fmt.Sprintf("%s withdrawal limit of %s has %s remaining",
+						window.name, limit.StringFixed(2), decimal.Max(limit.Sub(used), decimal.Zero).StringFixed(2)))
+			}
+		}
+	}
+
+	if limit := limits[models.LimitTypeHourlyTransactions].value; limit != nil {
+		count, err := usage.CountTransactions(account.ID, now.Add(-time.Hour))
+		if err != nil {
+			return fmt.Errorf("failed to get transaction count: %w", err)
+		}
+
+		if decimal.NewFromInt(count + 1).GreaterThan(*limit) {
+			return s.limitExceeded(account, models.LimitTypeHourlyTransactions,
+				fmt.Sprintf("at most %s transactions are allowed per hour", limit.String()))
+		}
+	}
+
+	return nil
+}
+
+// GetAccountLimits reports an account's effective limits and how much of each has
+// been used. Customers may view their own accounts; staff who can read customers may view any account.
+func (s *LimitService) GetAccountLimits(requestorID, accountID uuid.UUID, canViewAll bool) (*models.AccountLimits, error) {
+	account, err := s.getAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canViewAll && account.UserID != requestorID {
+		return nil, ErrUnauthorized
+	}
+
+	now := time.Now()
+	limits, err := s.effectiveLimits(account, now)
+	if err != nil {
+		return nil, err
+	}
+
+	dayStart := models.StartOfDay(now)
+	monthStart := models.StartOfMonth(now)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+
+	dailyUsed, err := s.limitRepo.GetWithdrawalTotal(account.ID, dayStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily withdrawal usage: %w", err)
+	}
+	monthlyUsed, err := s.limitRepo.GetWithdrawalTotal(account.ID, monthStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monthly withdrawal usage: %w", err)
+	}
+	hourlyCount, err := s.limitRepo.CountTransactions(account.ID, now.Add(-time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction count: %w", err)
+	}
+
+	used := map[string]decimal.Decimal{
+		models.LimitTypeDailyWithdrawal:    dailyUsed,
+		models.LimitTypeMonthlyWithdrawal:  monthlyUsed,
+		models.LimitTypeSingleTransfer:     decimal.Zero,
+		models.LimitTypeHourlyTransactions: decimal.NewFromInt(hourlyCount),
+	}
+	resetsAt := map[string]*time.Time{
+		models.LimitTypeDailyWithdrawal:   &dayEnd,
+		models.LimitTypeMonthlyWithdrawal: &monthEnd,
+	}
+
+	result := &models.AccountLimits{
+		AccountID:   account.ID,
+		AccountType: account.AccountType,
+		Currency:    account.CurrencyOrDefault(),
+		GeneratedAt: now,
+	}
+
+	for _, limitType := range models.LimitTypes {
+		limit := limits[limitType]
+		usage := models.LimitUsage{
+			LimitType: limitType,
+			Limit:     limit.value,
+			Used:      used[limitType],
+			Source:    limit.source,
+			ResetsAt:  resetsAt[limitType],
+		}
+
+		if limit.value != nil {
+			remaining := decimal.Max(limit.value.Sub(usage.Used), decimal.Zero)
+			usage.Remaining = &remaining
+		}
+
+		if limit.override != nil {
+			usage.OverrideID = &limit.override.ID
+			usage.OverrideExpiresAt = &limit.override.ExpiresAt
+		}
+
+		result.Limits = append(result.Limits, usage)
+	}
+
+	return result, nil
+}
+
+// ListAccountTypeLimits retrieves the limits configured for each account type
+func (s *LimitService) ListAccountTypeLimits() ([]models.TransactionLimit, error) {
+	limits, err := s.limitRepo.GetAccountTypeLimits()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account type limits: %w", err)
+	}
+	return limits, nil
+}
+
+// SetAccountTypeLimits replaces the limits for every account of an account type
+func (s *LimitService) SetAccountTypeLimits(accountType string, req *dto.SetTransactionLimitsRequest, adminID uuid.UUID) (*models.TransactionLimit, error) {
+	if !models.IsValidAccountType(accountType) {
+		return nil, fmt.Errorf("%w: unknown account type %s", ErrInvalidLimit, accountType)
+	}
+
+	limit, err := buildTransactionLimit(req, adminID)
+	if err != nil {
+		return nil, err
+	}
+	limit.AccountType = &accountType
+
+	if err := s.saveLimit(limit); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("account type limits updated",
+		slog.String("account_type", accountType),
+		slog.String("admin_id", adminID.String()),
+	)
+
+	return limit, nil
+}
+
+// GetUserLimits retrieves the limits configured for a customer
+func (s *LimitService) GetUserLimits(userID uuid.UUID) (*models.TransactionLimit, error) {
+	limit, err := s.limitRepo.GetForUser(userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransactionLimitNotFound) {
+			return nil, ErrTransactionLimitNotSet
+		}
+		return nil, fmt.Errorf("failed to get customer limits: %w", err)
+	}
+	return limit, nil
+}
+
+// SetUserLimits replaces a customer's limits, which apply to all of their accounts
+func (s *LimitService) SetUserLimits(userID uuid.UUID, req *dto.SetTransactionLimitsRequest, adminID uuid.UUID) (*models.TransactionLimit, error) {
+	limit, err := buildTransactionLimit(req, adminID)
+	if err != nil {
+		return nil, err
+	}
+	limit.UserID = &userID
+
+	if err := s.saveLimit(limit); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("customer limits updated",
+		slog.String("user_id", userID.String()),
+		slog.String("admin_id", adminID.String()),
+	)
+
+	return limit, nil
+}
+
+// DeleteUserLimits removes a customer's limits so their accounts fall back to the
+// account type limits
+func (s *LimitService) DeleteUserLimits(userID uuid.UUID) error {
+	if err := s.limitRepo.DeleteForUser(userID); err != nil {
+		if errors.Is(err, repositories.ErrTransactionLimitNotFound) {
+			return ErrTransactionLimitNotSet
+		}
+		return fmt.Errorf("failed to delete customer limits: %w", err)
+	}
+	return nil
+}
+
+// GrantOverride temporarily replaces one of an account's limits until the given
+// expiry, at most models.MaxLimitOverrideDuration away
+func (s *LimitService) GrantOverride(accountID uuid.UUID, req *dto.GrantLimitOverrideRequest, adminID uuid.UUID) (*models.LimitOverride, error) {
+	value, err := decimal.NewFromString(req.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: value must be a number", ErrInvalidLimitOverride)
+	}
+
+	now := time.Now()
+	if !req.ExpiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expiry must be in the future", ErrInvalidLimitOverride)
+	}
+	if req.ExpiresAt.After(now.Add(models.MaxLimitOverrideDuration)) {
+		return nil, fmt.Errorf("%w: overrides may last at most %s", ErrInvalidLimitOverride, models.MaxLimitOverrideDuration)
+	}
+
+	if _, err := s.getAccount(accountID); err != nil {
+		return nil, err
+	}
+
+	override := &models.LimitOverride{
+		AccountID: accountID,
+		LimitType: req.LimitType,
+		Value:     value,
+		Reason:    req.Reason,
+		GrantedBy: adminID,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	if err := override.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLimitOverride, err.Error())
+	}
+
+	if err := s.limitRepo.CreateOverride(override); err != nil {
+		return nil, fmt.Errorf("failed to grant limit override: %w", err)
+	}
+
+	s.logger.Info("limit override granted",
+		slog.String("override_id", override.ID.String()),
+		slog.String("account_id", accountID.String()),
+		slog.String("limit_type", override.LimitType),
+		slog.String("value", override.Value.String()),
+		slog.Time("expires_at", override.ExpiresAt),
+		slog.String("admin_id", adminID.String()),
+	)
+
+	return override, nil
+}
+
+// RevokeOverride ends an active override before it expires
+func (s *LimitService) RevokeOverride(overrideID, adminID uuid.UUID) (*models.LimitOverride, error) {
+	override, err := s.limitRepo.GetOverrideByID(overrideID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrLimitOverrideNotFound) {
+			return nil, ErrLimitOverrideNotFound
+		}
+		return nil, fmt.Errorf("failed to get limit override: %w", err)
+	}
+
+	now := time.Now()
+	if !override.IsActive(now) {
+		return nil, ErrLimitOverrideNotActive
+	}
+
+	override.Revoke(adminID, now)
+	if err := s.limitRepo.RevokeOverride(override); err != nil {
+		if errors.Is(err, repositories.ErrLimitOverrideNotActive) {
+			return nil, ErrLimitOverrideNotActive
+		}
+		return nil, fmt.Errorf("failed to revoke limit override: %w", err)
+	}
+
+	s.logger.Info("limit override revoked",
+		slog.String("override_id", override.ID.String()),
+		slog.String("account_id", override.AccountID.String()),
+		slog.String("admin_id", adminID.String()),
+	)
+
+	return override, nil
+}
+
+// effectiveLimits resolves each limit type for the account
+func (s *LimitService) effectiveLimits(account *models.Account, now time.Time) (map[string]effectiveLimit, error) {
+	typeLimit, err := s.limitRepo.GetForAccountType(account.AccountType)
+	if err != nil && !errors.Is(err, repositories.ErrTransactionLimitNotFound) {
+		return nil, fmt.Errorf("failed to get account type limits: %w", err)
+	}
+
+	userLimit, err := s.limitRepo.GetForUser(account.UserID)
+	if err != nil && !errors.Is(err, repositories.ErrTransactionLimitNotFound) {
+		return nil, fmt.Errorf("failed to get customer limits: %w", err)
+	}
+
+	overrides, err := s.limitRepo.GetActiveOverrides(account.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get limit overrides: %w", err)
+	}
+
+	limits := make(map[string]effectiveLimit, len(models.LimitTypes))
+	for _, limitType := range models.LimitTypes {
+		limit := effectiveLimit{source: models.LimitSourceNone}
+
+		if typeLimit != nil {
+			if value := typeLimit.Value(limitType); value != nil {
+				limit = effectiveLimit{value: value, source: models.LimitSourceAccountType}
+			}
+		}
+		if userLimit != nil {
+			if value := userLimit.Value(limitType); value != nil {
+				limit = effectiveLimit{value: value, source: models.LimitSourceCustomer}
+			}
+		}
+
+		// Overrides are ordered newest first, so the latest grant wins
+		for i := range overrides {
+			if overrides[i].LimitType == limitType {
+				limit = effectiveLimit{value: &overrides[i].Value, source: models.LimitSourceOverride, override: &overrides[i]}
+				break
+			}
+		}
+
+		limits[limitType] = limit
+	}
+
+	return limits, nil
+}
+
+func (s *LimitService) limitExceeded(account *models.Account, limitType, detail string) error {
+	s.logger.Warn("transaction limit exceeded",
+		slog.String("account_id", account.ID.String()),
+		slog.String("limit_type", limitType),
+		slog.String("detail", detail),
+	)
+	return fmt.Errorf("%w: %s", ErrLimitExceeded, detail)
+}
+
+func (s *LimitService) saveLimit(limit *models.TransactionLimit) error {
+	if err := limit.Validate(); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidLimit, err.Error())
+	}
+
+	if err := s.limitRepo.Upsert(limit); err != nil {
+		return fmt.Errorf("failed to save transaction limits: %w", err)
+	}
+	return nil
+}
+
+func (s *LimitService) getAccount(accountID uuid.UUID) (*models.Account, error) {
+	account, err := s.accountRepo.GetByID(accountID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAccountNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	return account, nil
+}
+
+// buildTransactionLimit parses the limits in a request
+func buildTransactionLimit(req *dto.SetTransactionLimitsRequest, adminID uuid.UUID) (*models.TransactionLimit, error) {
+	limit := &models.TransactionLimit{
+		HourlyTransactionLimit: req.HourlyTransactionLimit,
+		UpdatedBy:              &adminID,
+	}
+
+	amounts := []struct {
+		name  string
+		value *string
+		dest  **decimal.Decimal
+	}{
+		{"dailyWithdrawalLimit", req.DailyWithdrawalLimit, &limit.DailyWithdrawalLimit},
+		{"monthlyWithdrawalLimit", req.MonthlyWithdrawalLimit, &limit.MonthlyWithdrawalLimit},
+		{"maxSingleTransfer", req.MaxSingleTransfer, &limit.MaxSingleTransfer},
+	}
+
+	for _, amount := range amounts {
+		if amount.value == nil {
+			continue
+		}
+		value, err := decimal.NewFromString(*amount.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s must be a number", ErrInvalidLimit, amount.name)
+		}
+		*amount.dest = &value
+	}
+
+	return limit, nil
+}
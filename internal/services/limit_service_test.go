@@ -0,0 +1,294 @@
+package services
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type LimitServiceTestSuite struct {
+	suite.Suite
+	ctrl            *gomock.Controller
+	mockLimitRepo   *repository_mocks.MockLimitRepositoryInterface
+	mockAccountRepo *repository_mocks.MockAccountRepositoryInterface
+	service         *LimitService
+	account         *models.Account
+}
+
+func TestLimitServiceSuite(t *testing.T) {
+	suite.Run(t, new(LimitServiceTestSuite))
+}
+
+func (s *LimitServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockLimitRepo = repository_mocks.NewMockLimitRepositoryInterface(s.ctrl)
+	s.mockAccountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
+	s.service = NewLimitService(s.mockLimitRepo, s.mockAccountRepo, slog.Default()).(*LimitService)
+
+	s.account = &models.Account{
+		ID:          uuid.New(),
+		UserID:      uuid.New(),
+		AccountType: models.AccountTypeChecking,
+		Status:      models.AccountStatusActive,
+		Balance:     decimal.NewFromInt(10000),
+		Currency:    "USD",
+	}
+}
+
+func (s *LimitServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func amountPtr(value string) *decimal.Decimal {
+	amount := decimal.RequireFromString(value)
+	return &amount
+}
+
+// expectLimits sets up the account type limits, customer limits and overrides
+// that apply to the test account; nil limits are not configured
+func (s *LimitServiceTestSuite) expectLimits(typeLimit, userLimit *models.TransactionLimit, overrides ...models.LimitOverride) {
+	if typeLimit != nil {
+		s.mockLimitRepo.EXPECT().GetForAccountType(s.account.AccountType).Return(typeLimit, nil)
+	} else {
+		s.mockLimitRepo.EXPECT().GetForAccountType(s.account.AccountType).Return(nil, repositories.ErrTransactionLimitNotFound)
+	}
+	if userLimit != nil {
+		s.mockLimitRepo.EXPECT().GetForUser(s.account.UserID).Return(userLimit, nil)
+	} else {
+		s.mockLimitRepo.EXPECT().GetForUser(s.account.UserID).Return(nil, repositories.ErrTransactionLimitNotFound)
+	}
+	s.mockLimitRepo.EXPECT().GetActiveOverrides(s.account.ID, gomock.Any()).Return(overrides, nil)
+}
+
+func (s *LimitServiceTestSuite) TestCheckLimits_NoLimitsConfigured() {
+	s.expectLimits(nil, nil)
+
+	s.NoError(s.service.CheckLimits(s.account, decimal.NewFromInt(1000000), models.LimitActivityTransfer))
+}
+
+func (s *LimitServiceTestSuite) TestCheckLimits_SingleTransfer() {
+	typeLimit := &models.TransactionLimit{MaxSingleTransfer: amountPtr("2500")}
+
+	s.expectLimits(typeLimit, nil)
+	err := s.service.CheckLimits(s.account, decimal.NewFromInt(3000), models.LimitActivityTransfer)
+	s.ErrorIs(err, ErrLimitExceeded)
+	s.Contains(err.Error(), "2500.00")
+
+	// The single transfer limit does not apply to withdrawals
+	s.expectLimits(typeLimit, nil)
+	s.NoError(s.service.CheckLimits(s.account, decimal.NewFromInt(3000), models.LimitActivityWithdrawal))
+}
+
+func (s *LimitServiceTestSuite) TestCheckLimits_CustomerLimitReplacesAccountType() {
+	typeLimit := &models.TransactionLimit{DailyWithdrawalLimit: amountPtr("500")}
+	userLimit := &models.TransactionLimit{DailyWithdrawalLimit: amountPtr("1000")}
+
+	s.expectLimits(typeLimit, userLimit)
+	s.mockLimitRepo.EXPECT().GetWithdrawalTotal(s.account.ID, gomock.Any()).Return(decimal.NewFromInt(600), nil)
+	s.NoError(s.service.CheckLimits(s.account, decimal.NewFromInt(400), models.LimitActivityWithdrawal))
+
+	s.expectLimits(typeLimit, userLimit)
+	s.mockLimitRepo.EXPECT().GetWithdrawalTotal(s.account.ID, gomock.Any()).Return(decimal.NewFromInt(600), nil)
+	err := s.service.CheckLimits(s.account, decimal.NewFromInt(401), models.LimitActivityWithdrawal)
+	s.ErrorIs(err, ErrLimitExceeded)
+	s.Contains(err.Error(), "400.00 remaining")
+}
+
+func (s *LimitServiceTestSuite) TestCheckLimits_MonthlyWithdrawal() {
+	typeLimit := &models.TransactionLimit{MonthlyWithdrawalLimit: amountPtr("5000")}
+
+	s.expectLimits(typeLimit, nil)
+	s.mockLimitRepo.EXPECT().GetWithdrawalTotal(s.account.ID, models.StartOfMonth(time.Now())).Return(decimal.NewFromInt(4900), nil)
+
+	err := s.service.CheckLimits(s.account, decimal.NewFromInt(200), models.LimitActivityTransfer)
+	s.ErrorIs(err, ErrLimitExceeded)
+	s.Contains(err.Error(), "monthly")
+}
+
+func (s *LimitServiceTestSuite) TestCheckLimits_OverrideWins() {
+	typeLimit := &models.TransactionLimit{MaxSingleTransfer: amountPtr("2500")}
+	override := models.LimitOverride{
+		ID:        uuid.New(),
+		AccountID: s.account.ID,
+		LimitType: models.LimitTypeSingleTransfer,
+		Value:     decimal.NewFromInt(10000),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	s.expectLimits(typeLimit, nil, override)
+	s.NoError(s.service.CheckLimits(s.account, decimal.NewFromInt(8000), models.LimitActivityTransfer))
+}
+
+func (s *LimitServiceTestSuite) TestCheckLimits_HourlyTransactions() {
+	hourly := 3
+	typeLimit := &models.TransactionLimit{HourlyTransactionLimit: &hourly}
+
+	// Deposits count only towards the hourly limit
+	s.expectLimits(typeLimit, nil)
+	s.mockLimitRepo.EXPECT().CountTransactions(s.account.ID, gomock.Any()).Return(int64(2), nil)
+	s.NoError(s.service.CheckLimits(s.account, decimal.NewFromInt(10), models.LimitActivityDeposit))
+
+	s.expectLimits(typeLimit, nil)
+	s.mockLimitRepo.EXPECT().CountTransactions(s.account.ID, gomock.Any()).Return(int64(3), nil)
+	err := s.service.CheckLimits(s.account, decimal.NewFromInt(10), models.LimitActivityDeposit)
+	s.ErrorIs(err, ErrLimitExceeded)
+}
+
+func (s *LimitServiceTestSuite) TestGetAccountLimits() {
+	overrideID := uuid.New()
+	typeLimit := &models.TransactionLimit{
+		DailyWithdrawalLimit: amountPtr("1000"),
+		MaxSingleTransfer:    amountPtr("2500"),
+	}
+	userLimit := &models.TransactionLimit{DailyWithdrawalLimit: amountPtr("1500")}
+	override := models.LimitOverride{
+		ID:        overrideID,
+		AccountID: s.account.ID,
+		LimitType: models.LimitTypeSingleTransfer,
+		Value:     decimal.NewFromInt(5000),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	s.mockAccountRepo.EXPECT().GetByID(s.account.ID).Return(s.account, nil)
+	s.expectLimits(typeLimit, userLimit, override)
+	s.mockLimitRepo.EXPECT().GetWithdrawalTotal(s.account.ID, gomock.Any()).Return(decimal.NewFromInt(1600), nil)
+	s.mockLimitRepo.EXPECT().GetWithdrawalTotal(s.account.ID, gomock.Any()).Return(decimal.NewFromInt(2000), nil)
+	s.mockLimitRepo.EXPECT().CountTransactions(s.account.ID, gomock.Any()).Return(int64(4), nil)
+
+	result, err := s.service.GetAccountLimits(s.account.UserID, s.account.ID, false)
+	s.Require().NoError(err)
+	s.Len(result.Limits, len(models.LimitTypes))
+
+	daily := result.Usage(models.LimitTypeDailyWithdrawal)
+	s.Equal(models.LimitSourceCustomer, daily.Source)
+	s.True(daily.Used.Equal(decimal.NewFromInt(1600)))
+	s.True(daily.Remaining.IsZero())
+	s.NotNil(daily.ResetsAt)
+
+	monthly := result.Usage(models.LimitTypeMonthlyWithdrawal)
+	s.Equal(models.LimitSourceNone, monthly.Source)
+	s.Nil(monthly.Limit)
+	s.Nil(monthly.Remaining)
+
+	single := result.Usage(models.LimitTypeSingleTransfer)
+	s.Equal(models.LimitSourceOverride, single.Source)
+	s.Equal(overrideID, *single.OverrideID)
+	s.True(single.Limit.Equal(decimal.NewFromInt(5000)))
+
+	hourly := result.Usage(models.LimitTypeHourlyTransactions)
+	s.True(hourly.Used.Equal(decimal.NewFromInt(4)))
+}
+
+func (s *LimitServiceTestSuite) TestGetAccountLimits_OtherCustomersAccount() {
+	s.mockAccountRepo.EXPECT().GetByID(s.account.ID).Return(s.account, nil)
+
+	_, err := s.service.GetAccountLimits(uuid.New(), s.account.ID, false)
+	s.ErrorIs(err, ErrUnauthorized)
+}
+
+func (s *LimitServiceTestSuite) TestSetAccountTypeLimits() {
+	adminID := uuid.New()
+	hourly := 20
+	req := &dto.SetTransactionLimitsRequest{
+		DailyWithdrawalLimit:   stringPtr("1000.00"),
+		HourlyTransactionLimit: &hourly,
+	}
+
+	s.mockLimitRepo.EXPECT().Upsert(gomock.Any()).DoAndReturn(func(limit *models.TransactionLimit) error {
+		s.Equal(models.AccountTypeSavings, *limit.AccountType)
+		s.Nil(limit.UserID)
+		s.True(limit.DailyWithdrawalLimit.Equal(decimal.NewFromInt(1000)))
+		s.Nil(limit.MonthlyWithdrawalLimit)
+		s.Equal(adminID, *limit.UpdatedBy)
+		return nil
+	})
+
+	_, err := s.service.SetAccountTypeLimits(models.AccountTypeSavings, req, adminID)
+	s.NoError(err)
+
+	_, err = s.service.SetAccountTypeLimits("BROKERAGE", req, adminID)
+	s.ErrorIs(err, ErrInvalidLimit)
+
+	_, err = s.service.SetAccountTypeLimits(models.AccountTypeSavings, &dto.SetTransactionLimitsRequest{
+		MaxSingleTransfer: stringPtr("-5"),
+	}, adminID)
+	s.ErrorIs(err, ErrInvalidLimit)
+}
+
+func (s *LimitServiceTestSuite) TestGetUserLimits_NotSet() {
+	userID := uuid.New()
+	s.mockLimitRepo.EXPECT().GetForUser(userID).Return(nil, repositories.ErrTransactionLimitNotFound)
+
+	_, err := s.service.GetUserLimits(userID)
+	s.ErrorIs(err, ErrTransactionLimitNotSet)
+}
+
+func (s *LimitServiceTestSuite) TestGrantOverride() {
+	adminID := uuid.New()
+	req := &dto.GrantLimitOverrideRequest{
+		LimitType: models.LimitTypeDailyWithdrawal,
+		Value:     "7500.00",
+		Reason:    "House deposit",
+		ExpiresAt: time.Now().Add(48 * time.Hour),
+	}
+
+	s.mockAccountRepo.EXPECT().GetByID(s.account.ID).Return(s.account, nil)
+	s.mockLimitRepo.EXPECT().CreateOverride(gomock.Any()).DoAndReturn(func(override *models.LimitOverride) error {
+		s.Equal(s.account.ID, override.AccountID)
+		s.Equal(adminID, override.GrantedBy)
+		s.True(override.Value.Equal(decimal.NewFromInt(7500)))
+		return nil
+	})
+
+	override, err := s.service.GrantOverride(s.account.ID, req, adminID)
+	s.Require().NoError(err)
+	s.Equal("House deposit", override.Reason)
+}
+
+func (s *LimitServiceTestSuite) TestGrantOverride_InvalidExpiry() {
+	req := &dto.GrantLimitOverrideRequest{
+		LimitType: models.LimitTypeDailyWithdrawal,
+		Value:     "7500.00",
+		Reason:    "House deposit",
+		ExpiresAt: time.Now().Add(models.MaxLimitOverrideDuration + time.Hour),
+	}
+
+	_, err := s.service.GrantOverride(s.account.ID, req, uuid.New())
+	s.ErrorIs(err, ErrInvalidLimitOverride)
+
+	req.ExpiresAt = time.Now().Add(-time.Minute)
+	_, err = s.service.GrantOverride(s.account.ID, req, uuid.New())
+	s.ErrorIs(err, ErrInvalidLimitOverride)
+}
+
+func (s *LimitServiceTestSuite) TestRevokeOverride() {
+	adminID := uuid.New()
+	override := &models.LimitOverride{
+		ID:        uuid.New(),
+		AccountID: s.account.ID,
+		LimitType: models.LimitTypeDailyWithdrawal,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	s.mockLimitRepo.EXPECT().GetOverrideByID(override.ID).Return(override, nil)
+	s.mockLimitRepo.EXPECT().RevokeOverride(override).Return(nil)
+
+	revoked, err := s.service.RevokeOverride(override.ID, adminID)
+	s.Require().NoError(err)
+	s.Equal(adminID, *revoked.RevokedBy)
+
+	// A second revocation finds the override already ended
+	s.mockLimitRepo.EXPECT().GetOverrideByID(override.ID).Return(override, nil)
+	_, err = s.service.RevokeOverride(override.ID, adminID)
+	s.ErrorIs(err, ErrLimitOverrideNotActive)
+}
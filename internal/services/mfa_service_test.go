@@ -0,0 +1,298 @@
+package services
+
+import (
+	"log/slog"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type MFAServiceTestSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	mfaRepo    *repository_mocks.MockMFARepositoryInterface
+	userRepo   *repository_mocks.MockUserRepositoryInterface
+	auditRepo  *repository_mocks.MockAuditLogRepositoryInterface
+	mfaService MFAServiceInterface
+	userID     uuid.UUID
+	user       *models.User
+}
+
+func (s *MFAServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mfaRepo = repository_mocks.NewMockMFARepositoryInterface(s.ctrl)
+	s.userRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.mfaService = NewMFAService(s.mfaRepo, s.userRepo, s.auditRepo, "Array Bank", []byte(testMFASecretKey), slog.Default())
+
+	s.userID = uuid.New()
+	s.user = &models.User{ID: s.userID, Email: "mfa@example.com", Role: models.RoleCustomer}
+}
+
+func (s *MFAServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestMFAServiceSuite(t *testing.T) {
+	suite.Run(t, new(MFAServiceTestSuite))
+}
+
+const (
+	testTOTPSecret   = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" // "12345678901234567890"
+	testMFASecretKey = "0123456789abcdef0123456789abcdef"
+)
+
+func (s *MFAServiceTestSuite) confirmedEnrollment() *models.MFAEnrollment {
+	sealed, err := s.mfaService.(*MFAService).sealSecret(testTOTPSecret)
+	s.Require().NoError(err)
+	enrollment := &models.MFAEnrollment{ID: uuid.New(), UserID: s.userID, Secret: sealed}
+	enrollment.Confirm([]string{hashToken("aaaaabbbbb")}, time.Now())
+	return enrollment
+}
+
+func (s *MFAServiceTestSuite) currentCode() string {
+	code, err := GenerateTOTPCode(testTOTPSecret, time.Now())
+	s.Require().NoError(err)
+	return code
+}
+
+func (s *MFAServiceTestSuite) TestGenerateTOTPCode_RFC6238Vectors() {
+	// RFC 6238 appendix B SHA1 vectors, truncated to 6 digits
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, expected := range vectors {
+		code, err := GenerateTOTPCode(testTOTPSecret, time.Unix(unix, 0))
+		s.Require().NoError(err)
+		s.Equal(expected, code, "time %d", unix)
+	}
+}
+
+func (s *MFAServiceTestSuite) TestBeginEnrollment_ReturnsProvisioningURI() {
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+	s.mfaRepo.EXPECT().GetByUserID(s.userID).Return(nil, repositories.ErrMFAEnrollmentNotFound).Times(1)
+	var stored *models.MFAEnrollment
+	s.mfaRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(enrollment *models.MFAEnrollment) error {
+		stored = enrollment
+		return nil
+	}).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionMFAEnrollment, log.Action)
+		return nil
+	}).Times(1)
+
+	enrollment, err := s.mfaService.BeginEnrollment(s.userID, "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.Len(enrollment.Secret, 32)
+	s.NotContains(stored.Secret, enrollment.Secret, "the secret is encrypted at rest")
+	opened, err := s.mfaService.(*MFAService).openSecret(stored.Secret)
+	s.Require().NoError(err)
+	s.Equal(enrollment.Secret, opened)
+	s.Equal(6, enrollment.Digits)
+	s.Equal(30, enrollment.Period)
+
+	uri, err := url.Parse(enrollment.ProvisioningURI)
+	s.Require().NoError(err)
+	s.Equal("otpauth", uri.Scheme)
+	s.Equal("totp", uri.Host)
+	s.Equal("/Array Bank:mfa@example.com", uri.Path)
+	s.Equal(enrollment.Secret, uri.Query().Get("secret"))
+	s.Equal("Array Bank", uri.Query().Get("issuer"))
+	s.NotContains(enrollment.ProvisioningURI, "+")
+}
+
+func (s *MFAServiceTestSuite) TestBeginEnrollment_AlreadyEnabled() {
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+	s.mfaRepo.EXPECT().GetByUserID(s.userID).Return(s.confirmedEnrollment(), nil).Times(1)
+
+	_, err := s.mfaService.BeginEnrollment(s.userID, "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrMFAAlreadyEnabled)
+}
+
+func (s *MFAServiceTestSuite) TestConfirmEnrollment_IssuesRecoveryCodes() {
+	// Secrets stored before encryption was introduced are still accepted
+	pending := &models.MFAEnrollment{ID: uuid.New(), UserID: s.userID, Secret: testTOTPSecret}
+
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+	s.mfaRepo.EXPECT().GetByUserID(s.userID).Return(pending, nil).Times(1)
+	s.mfaRepo.EXPECT().AdvanceLastUsedStep(pending.ID, gomock.Any()).Return(true, nil).Times(1)
+	s.mfaRepo.EXPECT().Update(pending).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionMFAEnabled, log.Action)
+		return nil
+	}).Times(1)
+
+	codes, err := s.mfaService.ConfirmEnrollment(s.userID, s.currentCode(), "192.168.1.1", "Mozilla/5.0")
+
+	s.Require().NoError(err)
+	s.Len(codes.RecoveryCodes, models.MFARecoveryCodeCount)
+	s.True(pending.IsConfirmed())
+	s.Len(pending.RecoveryCodes, models.MFARecoveryCodeCount)
+	for i, code := range codes.RecoveryCodes {
+		s.Len(code, 11)
+		s.Equal(hashToken(normalizeRecoveryCode(code)), pending.RecoveryCodes[i], "only hashes are stored")
+	}
+}
+
+func (s *MFAServiceTestSuite) TestVerify_AcceptsCurrentCode() {
+	enrollment := s.confirmedEnrollment()
+
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+	s.mfaRepo.EXPECT().GetByUserID(s.userID).Return(enrollment, nil).Times(1)
+	s.mfaRepo.EXPECT().AdvanceLastUsedStep(enrollment.ID, gomock.Any()).Return(true, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionMFAVerified, log.Action)
+		s.Equal(MFAPurposeStepUp, log.Metadata["purpose"])
+		s.Equal("totp", log.Metadata["method"])
+		return nil
+	}).Times(1)
+
+	err := s.mfaService.Verify(s.userID, s.currentCode(), MFAPurposeStepUp, "192.168.1.1", "Mozilla/5.0")
+
+	s.NoError(err)
+}
+
+func (s *MFAServiceTestSuite) TestVerify_RejectsReplayedCode() {
+	enrollment := s.confirmedEnrollment()
+	enrollment.LastUsedStep = time.Now().Unix()/30 + 1
+
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+	s.mfaRepo.EXPECT().GetByUserID(s.userID).Return(enrollment, nil).Times(1)
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(s.user).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionMFAFailed, log.Action)
+		return nil
+	}).Times(1)
+
+	err := s.mfaService.Verify(s.userID, s.currentCode(), MFAPurposeLogin, "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrInvalidMFACode)
+	s.Equal(1, s.user.FailedLoginAttempts)
+}
+
+func (s *MFAServiceTestSuite) TestVerify_AcceptsRecoveryCode() {
+	enrollment := s.confirmedEnrollment()
+
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+	s.mfaRepo.EXPECT().GetByUserID(s.userID).Return(enrollment, nil).Times(1)
+	s.mfaRepo.EXPECT().ConsumeRecoveryCode(enrollment.ID, hashToken("aaaaabbbbb")).Return(0, true, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(2) // recovery code used + verified
+
+	err := s.mfaService.Verify(s.userID, "AAAAA-BBBBB", MFAPurposeLogin, "192.168.1.1", "Mozilla/5.0")
+
+	s.NoError(err)
+}
+
+func (s *MFAServiceTestSuite) TestVerify_LocksAccountAfterRepeatedFailures() {
+	enrollment := s.confirmedEnrollment()
+	s.user.FailedLoginAttempts = 2
+
+	var actions []string
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+	s.mfaRepo.EXPECT().GetByUserID(s.userID).Return(enrollment, nil).Times(1)
+	s.mfaRepo.EXPECT().ConsumeRecoveryCode(enrollment.ID, gomock.Any()).Return(1, false, nil).Times(1)
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(s.user).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		actions = append(actions, log.Action)
+		return nil
+	}).Times(2)
+
+	err := s.mfaService.Verify(s.userID, "wrong-code", MFAPurposeLogin, "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrInvalidMFACode)
+	s.True(s.user.IsLocked())
+	s.Equal([]string{models.AuditActionMFAFailed, models.AuditActionAccountLocked}, actions)
+}
+
+func (s *MFAServiceTestSuite) TestVerify_LockedAccount() {
+	lockedAt := time.Now()
+	s.user.LockedAt = &lockedAt
+
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+
+	err := s.mfaService.Verify(s.userID, "123456", MFAPurposeLogin, "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrAccountLocked)
+}
+
+func (s *MFAServiceTestSuite) TestDisable_EnforcedByAdmin() {
+	s.user.MFARequired = true
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+
+	err := s.mfaService.Disable(s.userID, "123456", "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrMFAEnforced)
+}
+
+func (s *MFAServiceTestSuite) TestStepUpRequired() {
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+	s.mfaRepo.EXPECT().GetByUserID(s.userID).Return(nil, repositories.ErrMFAEnrollmentNotFound).Times(1)
+
+	required, err := s.mfaService.StepUpRequired(s.userID)
+	s.Require().NoError(err)
+	s.False(required)
+
+	s.user.MFARequired = true
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+
+	required, err = s.mfaService.StepUpRequired(s.userID)
+	s.Require().NoError(err)
+	s.True(required)
+}
+
+func (s *MFAServiceTestSuite) TestStepUpRequired_StaffWithoutEnrollment() {
+	s.user.Role = models.RoleSupport
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+
+	required, err := s.mfaService.StepUpRequired(s.userID)
+
+	s.Require().NoError(err)
+	s.True(required, "unenrolled staff cannot pass step-up")
+}
+
+func (s *MFAServiceTestSuite) TestDisable_Staff() {
+	s.user.Role = models.RoleAdmin
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+
+	err := s.mfaService.Disable(s.userID, "123456", "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrMFAEnforced)
+}
+
+func (s *MFAServiceTestSuite) TestSetRequired_Audited() {
+	adminID := uuid.New()
+
+	s.userRepo.EXPECT().GetByID(s.userID).Return(s.user, nil).Times(1)
+	s.userRepo.EXPECT().UpdateFields(s.userID, map[string]interface{}{"mfa_required": true}).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionMFARequirement, log.Action)
+		s.Equal(adminID, *log.UserID)
+		s.Equal(s.userID.String(), log.ResourceID)
+		return nil
+	}).Times(1)
+
+	err := s.mfaService.SetRequired(s.userID, adminID, true, "192.168.1.1", "Mozilla/5.0")
+
+	s.NoError(err)
+}
+
+func (s *MFAServiceTestSuite) TestNormalizeRecoveryCode() {
+	s.Equal("aaaaabbbbb", normalizeRecoveryCode(" AAAAA-bbbbb "))
+	s.False(isTOTPCode(strings.Repeat("1", 7)))
+	s.True(isTOTPCode("012345"))
+}
@@ -11,9 +11,14 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"time"
 )
 
+// ErrNorthWindRejected is returned when NorthWind refuses a request as invalid.
+// Other failures, such as timeouts or server errors, may succeed when retried.
+var ErrNorthWindRejected = errors.New("northwind rejected the request")
+
 type AuthTransport struct {
 	apiKey string
 	base   http.RoundTripper
@@ -116,8 +121,6 @@ func (s *NorthWindService) AuthAccount(ctx context.Context, requestDto dto.North
 		requestDto,
 	)
 
-	s.logger.Info("AAAAAAAAAAAAAAA", requestDto.AccountHolderName)
-
 	if err != nil {
 		return nil, err
 	}
@@ -173,6 +176,9 @@ func (s *NorthWindService) AuthAccount(ctx context.Context, requestDto dto.North
 			"request_id", errResp.Error.RequestID,
 		)
 
+		if resp.StatusCode == http.StatusBadRequest {
+			return nil, fmt.Errorf("%w: %s", ErrNorthWindRejected, errResp.Error.Message)
+		}
 		return nil, errors.New(errResp.Error.Message)
 
 	default:
@@ -183,3 +189,66 @@ func (s *NorthWindService) AuthAccount(ctx context.Context, requestDto dto.North
 		)
 	}
 }
+
+// InitiateTransfer submits an external transfer to NorthWind. The reference number
+// is sent as the idempotency key, so resubmitting the same transfer returns the
+// original rather than initiating a second one.
+func (s *NorthWindService) InitiateTransfer(ctx context.Context, requestDto dto.NorthWindInitiateTransferRequest) (*dto.NorthWindTransferStatusResponse, error) {
+	req, err := s.buildRequest(ctx, http.MethodPost, "/external/transfers/initiate", requestDto)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Idempotency-Key", requestDto.ReferenceNumber)
+
+	return s.doTransferRequest(req)
+}
+
+// GetTransfer retrieves the current status of a transfer submitted to NorthWind
+func (s *NorthWindService) GetTransfer(ctx context.Context, transferID string) (*dto.NorthWindTransferStatusResponse, error) {
+	req, err := s.buildRequest(ctx, http.MethodGet, "/external/transfers/"+url.PathEscape(transferID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.doTransferRequest(req)
+}
+
+// doTransferRequest sends a transfer request and decodes the transfer status.
+// Client errors wrap ErrNorthWindRejected, except a conflict, which means a
+// request with the same idempotency key is still in progress and may be retried.
+func (s *NorthWindService) doTransferRequest(req *http.Request) (*dto.NorthWindTransferStatusResponse, error) {
+	resp, body, err := s.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		var transfer dto.NorthWindTransferStatusResponse
+		if err := json.Unmarshal(body, &transfer); err != nil {
+			return nil, fmt.Errorf("decode transfer response: %w", err)
+		}
+		return &transfer, nil
+	}
+
+	var errResp dto.NorthwindValidateAccountErrorResponse
+	message := string(body)
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error.Message != "" {
+		message = errResp.Error.Message
+	}
+
+	s.logger.Error(
+		"northwind transfer error",
+		"method", req.Method,
+		"status", resp.StatusCode,
+		"code", errResp.Error.Code,
+		"message", message,
+		"request_id", errResp.Error.RequestID,
+	)
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity:
+		return nil, fmt.Errorf("%w: %s", ErrNorthWindRejected, message)
+	default:
+		return nil, fmt.Errorf("northwind error (%d): %s", resp.StatusCode, message)
+	}
+}
@@ -0,0 +1,353 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRecategorizationJobNotFound = errors.New("recategorization job not found")
+	ErrRecategorizationJobState    = errors.New("recategorization job cannot be changed in its current state")
+	ErrInvalidRecategorizationJob  = errors.New("invalid recategorization job")
+)
+
+// DefaultRecategorizationBatchSize is used when a job does not specify a batch size
+const DefaultRecategorizationBatchSize = 500
+
+// recategorizationStaleAfter is how long a running job can go without a checkpoint
+// before another worker takes it over
+const recategorizationStaleAfter = 5 * time.Minute
+
+// RecategorizationService re-runs the categorization rules over historical
+// transactions. Jobs are persisted and run one at a time by a background worker,
+// which checkpoints after every batch so a restarted server resumes them.
+type RecategorizationService struct {
+	jobRepo          repositories.RecategorizationJobRepositoryInterface
+	transactionRepo  repositories.TransactionRepositoryInterface
+	categoryService  CategoryServiceInterface
+	defaultBatchSize int
+	logger           *slog.Logger
+}
+
+// NewRecategorizationService creates a new recategorization service
+func NewRecategorizationService(
+	jobRepo repositories.RecategorizationJobRepositoryInterface,
+	transactionRepo repositories.TransactionRepositoryInterface,
+	categoryService CategoryServiceInterface,
+	defaultBatchSize int,
+	logger *slog.Logger,
+) RecategorizationServiceInterface {
+	if defaultBatchSize <= 0 {
+		defaultBatchSize = DefaultRecategorizationBatchSize
+	}
+
+	return &RecategorizationService{
+		jobRepo:          jobRepo,
+		transactionRepo:  transactionRepo,
+		categoryService:  categoryService,
+		defaultBatchSize: defaultBatchSize,
+		logger:           logger,
+	}
+}
+
+// CreateJob queues a recategorization job for the background worker
+func (s *RecategorizationService) CreateJob(req *dto.StartRecategorizationRequest, requestedBy uuid.UUID) (*models.RecategorizationJob, error) {
+	job := &models.RecategorizationJob{
+		Status:      models.RecategorizationStatusPending,
+		DryRun:      req.DryRun,
+		AccountID:   req.AccountID,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		BatchSize:   req.BatchSize,
+		RequestedBy: &requestedBy,
+	}
+	if job.BatchSize == 0 {
+		job.BatchSize = s.defaultBatchSize
+	}
+
+	if err := job.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRecategorizationJob, err.Error())
+	}
+
+	if err := s.jobRepo.Create(job); err != nil {
+		return nil, fmt.Errorf("failed to create recategorization job: %w", err)
+	}
+
+	s.logger.Info("recategorization job queued",
+		slog.String("job_id", job.ID.String()),
+		slog.Bool("dry_run", job.DryRun),
+		slog.Int("batch_size", job.BatchSize),
+	)
+
+	return job, nil
+}
+
+// GetJob retrieves a recategorization job with its progress and transition counts
+func (s *RecategorizationService) GetJob(id uuid.UUID) (*models.RecategorizationJob, error) {
+	job, err := s.jobRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecategorizationJobNotFound) {
+			return nil, ErrRecategorizationJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get recategorization job: %w", err)
+	}
+	return job, nil
+}
+
+// ListJobs retrieves recategorization jobs, newest first
+func (s *RecategorizationService) ListJobs(offset, limit int) ([]models.RecategorizationJob, int64, error) {
+	jobs, total, err := s.jobRepo.List(offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list recategorization jobs: %w", err)
+	}
+	return jobs, total, nil
+}
+
+// CancelJob stops a pending or running job. A running job stops after its current batch.
+func (s *RecategorizationService) CancelJob(id uuid.UUID) (*models.RecategorizationJob, error) {
+	job, err := s.GetJob(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.IsFinished() {
+		return nil, ErrRecategorizationJobState
+	}
+
+	now := time.Now()
+	job.Status = models.RecategorizationStatusCancelled
+	job.CompletedAt = &now
+
+	if err := s.jobRepo.UpdateStatus(job, models.RecategorizationStatusPending, models.RecategorizationStatusRunning); err != nil {
+		if errors.Is(err, repositories.ErrRecategorizationJobStatusChanged) {
+			return nil, ErrRecategorizationJobState
+		}
+		return nil, fmt.Errorf("failed to cancel recategorization job: %w", err)
+	}
+
+	return job, nil
+}
+
+// ResumeJob queues a failed or cancelled job again. It continues from its last checkpoint.
+func (s *RecategorizationService) ResumeJob(id uuid.UUID) (*models.RecategorizationJob, error) {
+	job, err := s.GetJob(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !job.CanResume() {
+		return nil, ErrRecategorizationJobState
+	}
+
+	job.Status = models.RecategorizationStatusPending
+	job.ErrorMessage = ""
+	job.CompletedAt = nil
+
+	if err := s.jobRepo.UpdateStatus(job, models.RecategorizationStatusFailed, models.RecategorizationStatusCancelled); err != nil {
+		if errors.Is(err, repositories.ErrRecategorizationJobStatusChanged) {
+			return nil, ErrRecategorizationJobState
+		}
+		return nil, fmt.Errorf("failed to resume recategorization job: %w", err)
+	}
+
+	return job, nil
+}
+
+// StartWorker polls for queued jobs and runs them one at a time until the context is cancelled.
+// A job interrupted by shutdown stays running and is picked up again from its checkpoint
+// once it has gone recategorizationStaleAfter without one.
+func (s *RecategorizationService) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	s.logger.Info("starting recategorization worker",
+		slog.Duration("poll_interval", pollInterval),
+	)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("recategorization worker stopped")
+			return
+		case <-ticker.C:
+			s.runNextJob(ctx)
+		}
+	}
+}
+
+// runNextJob runs the next queued job, if any, to completion
+func (s *RecategorizationService) runNextJob(ctx context.Context) {
+	job, err := s.jobRepo.ClaimNextRunnable(time.Now().Add(-recategorizationStaleAfter))
+	if err != nil {
+		if !errors.Is(err, repositories.ErrRecategorizationJobNotFound) {
+			s.logger.Error("failed to fetch recategorization job",
+				slog.String("error", err.Error()),
+			)
+		}
+		return
+	}
+
+	if err := s.runJob(ctx, job); err != nil {
+		s.logger.Error("recategorization job failed",
+			slog.String("job_id", job.ID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// runJob processes a claimed job batch by batch from its checkpoint
+func (s *RecategorizationService) runJob(ctx context.Context, job *models.RecategorizationJob) error {
+	s.logger.Info("running recategorization job",
+		slog.String("job_id", job.ID.String()),
+		slog.Bool("dry_run", job.DryRun),
+		slog.Int64("processed", job.ProcessedCount),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Left running so the next worker resumes from the checkpoint
+			return nil
+		default:
+		}
+
+		transactions, err := s.transactionRepo.GetRecategorizationBatch(job)
+		if err != nil {
+			return s.failJob(job, err)
+		}
+
+		if len(transactions) > 0 {
+			if err := s.processBatch(job, transactions); err != nil {
+				return s.failJob(job, err)
+			}
+		}
+
+		if len(transactions) < job.BatchSize {
+			now := time.Now()
+			job.Status = models.RecategorizationStatusCompleted
+			job.CompletedAt = &now
+		}
+
+		if err := s.jobRepo.SaveProgress(job); err != nil {
+			if errors.Is(err, repositories.ErrRecategorizationJobNotRunning) {
+				s.logger.Info("recategorization job cancelled",
+					slog.String("job_id", job.ID.String()),
+				)
+				return nil
+			}
+			return fmt.Errorf("failed to checkpoint recategorization job: %w", err)
+		}
+
+		if job.Status == models.RecategorizationStatusCompleted {
+			s.logger.Info("recategorization job completed",
+				slog.String("job_id", job.ID.String()),
+				slog.Int64("processed", job.ProcessedCount),
+				slog.Int64("changed", job.ChangedCount),
+				slog.Int64("overridden", job.OverriddenCount),
+				slog.Int64("conflicts", job.ConflictCount),
+			)
+			return nil
+		}
+	}
+}
+
+// processBatch recategorizes one page of transactions and advances the checkpoint.
+// Manually overridden transactions are counted but never recategorized. The job's
+// counters only change once the batch's updates are committed, so a batch retried
+// after a failure is not counted twice.
+func (s *RecategorizationService) processBatch(job *models.RecategorizationJob, transactions []models.Transaction) error {
+	var overridden, unchanged int64
+
+	candidates := make([]*models.Transaction, 0, len(transactions))
+	for i := range transactions {
+		if transactions[i].IsCategoryOverridden() {
+			overridden++
+			continue
+		}
+		candidates = append(candidates, &transactions[i])
+	}
+
+	results := s.categorize(job, candidates)
+
+	changes := make([]models.TransactionCategoryChange, 0, len(candidates))
+	previous := make(map[uuid.UUID]string, len(candidates))
+	for i, txn := range candidates {
+		newCategory := results[i].Category
+		if newCategory == txn.Category {
+			unchanged++
+			continue
+		}
+		changes = append(changes, models.TransactionCategoryChange{
+			TransactionID:   txn.ID,
+			ExpectedVersion: txn.Version,
+			Category:        newCategory,
+		})
+		previous[txn.ID] = txn.Category
+	}
+
+	var conflicts []uuid.UUID
+	if !job.DryRun {
+		var err error
+		conflicts, err = s.transactionRepo.ApplyCategoryChanges(changes)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range conflicts {
+		delete(previous, id)
+	}
+	for _, change := range changes {
+		if from, ok := previous[change.TransactionID]; ok {
+			job.RecordTransition(from, change.Category)
+		}
+	}
+
+	job.ProcessedCount += int64(len(transactions))
+	job.OverriddenCount += overridden
+	job.UnchangedCount += unchanged
+	job.ConflictCount += int64(len(conflicts))
+	job.Checkpoint(&transactions[len(transactions)-1])
+
+	return nil
+}
+
+// categorize runs the rules over the candidates. Dry runs use the preview so
+// they leave the merchant mapping usage statistics untouched.
+func (s *RecategorizationService) categorize(job *models.RecategorizationJob, transactions []*models.Transaction) []*models.CategorizationResult {
+	if !job.DryRun {
+		return s.categoryService.BatchCategorize(transactions)
+	}
+
+	results := make([]*models.CategorizationResult, 0, len(transactions))
+	for _, txn := range transactions {
+		results = append(results, s.categoryService.PreviewCategorization(txn))
+	}
+	return results
+}
+
+// failJob records the error on the job and stops it
+func (s *RecategorizationService) failJob(job *models.RecategorizationJob, cause error) error {
+	now := time.Now()
+	job.Status = models.RecategorizationStatusFailed
+	job.ErrorMessage = cause.Error()
+	job.CompletedAt = &now
+
+	if err := s.jobRepo.SaveProgress(job); err != nil && !errors.Is(err, repositories.ErrRecategorizationJobNotRunning) {
+		s.logger.Error("failed to record recategorization job failure",
+			slog.String("job_id", job.ID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return cause
+}
@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type RecategorizationServiceTestSuite struct {
+	suite.Suite
+	ctrl                *gomock.Controller
+	mockJobRepo         *repository_mocks.MockRecategorizationJobRepositoryInterface
+	mockTransactionRepo *repository_mocks.MockTransactionRepositoryInterface
+	mockCategoryService *service_mocks.MockCategoryServiceInterface
+	service             *RecategorizationService
+}
+
+func TestRecategorizationServiceSuite(t *testing.T) {
+	suite.Run(t, new(RecategorizationServiceTestSuite))
+}
+
+func (s *RecategorizationServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockJobRepo = repository_mocks.NewMockRecategorizationJobRepositoryInterface(s.ctrl)
+	s.mockTransactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
+	s.mockCategoryService = service_mocks.NewMockCategoryServiceInterface(s.ctrl)
+
+	s.service = NewRecategorizationService(
+		s.mockJobRepo,
+		s.mockTransactionRepo,
+		s.mockCategoryService,
+		100,
+		slog.Default(),
+	).(*RecategorizationService)
+}
+
+func (s *RecategorizationServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// newTestTransaction builds a transaction created offset minutes after a fixed time
+func newTestTransaction(category string, offset int) models.Transaction {
+	return models.Transaction{
+		ID:           uuid.New(),
+		Category:     category,
+		MerchantName: "Merchant",
+		Version:      1,
+		CreatedAt:    time.Date(2025, 1, 1, 0, offset, 0, 0, time.UTC),
+	}
+}
+
+// categorizeAs returns a BatchCategorize stub that assigns the given categories in order
+func categorizeAs(categories ...string) func([]*models.Transaction) []*models.CategorizationResult {
+	return func(transactions []*models.Transaction) []*models.CategorizationResult {
+		results := make([]*models.CategorizationResult, len(transactions))
+		for i := range transactions {
+			results[i] = &models.CategorizationResult{Category: categories[i]}
+		}
+		return results
+	}
+}
+
+func (s *RecategorizationServiceTestSuite) TestCreateJob_DefaultsBatchSize() {
+	requestedBy := uuid.New()
+	s.mockJobRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	job, err := s.service.CreateJob(&dto.StartRecategorizationRequest{DryRun: true}, requestedBy)
+
+	s.Require().NoError(err)
+	s.Equal(100, job.BatchSize)
+	s.Equal(models.RecategorizationStatusPending, job.Status)
+	s.True(job.DryRun)
+	s.Equal(requestedBy, *job.RequestedBy)
+}
+
+func (s *RecategorizationServiceTestSuite) TestCreateJob_InvalidDateRange() {
+	start := time.Now()
+	end := start.Add(-time.Hour)
+
+	_, err := s.service.CreateJob(&dto.StartRecategorizationRequest{StartDate: &start, EndDate: &end}, uuid.New())
+
+	s.ErrorIs(err, ErrInvalidRecategorizationJob)
+}
+
+func (s *RecategorizationServiceTestSuite) TestRunJob_AppliesChangesAndCountsTransitions() {
+	startedAt := time.Now()
+	job := &models.RecategorizationJob{ID: uuid.New(), Status: models.RecategorizationStatusRunning, BatchSize: 4, StartedAt: &startedAt}
+
+	batch := []models.Transaction{
+		newTestTransaction(models.CategoryShopping, 0),
+		newTestTransaction(models.CategoryDining, 1),
+		newTestTransaction(models.CategoryOther, 2),
+		newTestTransaction(models.CategoryOther, 3),
+	}
+	overridden, changed, conflicted := &batch[0], &batch[2], &batch[3]
+	overridden.MarkCategoryOverridden(uuid.New(), time.Now())
+
+	gomock.InOrder(
+		s.mockTransactionRepo.EXPECT().GetRecategorizationBatch(job).
+			Return(batch, nil),
+		s.mockCategoryService.EXPECT().BatchCategorize(gomock.Len(3)).
+			DoAndReturn(categorizeAs(models.CategoryDining, models.CategoryDining, models.CategoryDining)),
+		s.mockTransactionRepo.EXPECT().ApplyCategoryChanges([]models.TransactionCategoryChange{
+			{TransactionID: changed.ID, ExpectedVersion: 1, Category: models.CategoryDining},
+			{TransactionID: conflicted.ID, ExpectedVersion: 1, Category: models.CategoryDining},
+		}).Return([]uuid.UUID{conflicted.ID}, nil),
+		s.mockJobRepo.EXPECT().SaveProgress(job).DoAndReturn(func(j *models.RecategorizationJob) error {
+			s.Equal(conflicted.ID, *j.CursorTransactionID, "Checkpoint is the last transaction of the batch")
+			s.Equal(models.RecategorizationStatusRunning, j.Status)
+			return nil
+		}),
+		s.mockTransactionRepo.EXPECT().GetRecategorizationBatch(job).Return([]models.Transaction{newTestTransaction("", 4)}, nil),
+		s.mockCategoryService.EXPECT().BatchCategorize(gomock.Len(1)).
+			DoAndReturn(categorizeAs(models.CategoryGroceries)),
+		s.mockTransactionRepo.EXPECT().ApplyCategoryChanges(gomock.Len(1)).Return(nil, nil),
+		s.mockJobRepo.EXPECT().SaveProgress(job).Return(nil),
+	)
+
+	err := s.service.runJob(context.Background(), job)
+
+	s.Require().NoError(err)
+	s.Equal(models.RecategorizationStatusCompleted, job.Status)
+	s.NotNil(job.StartedAt)
+	s.NotNil(job.CompletedAt)
+	s.Equal(int64(5), job.ProcessedCount)
+	s.Equal(int64(2), job.ChangedCount)
+	s.Equal(int64(1), job.UnchangedCount)
+	s.Equal(int64(1), job.OverriddenCount)
+	s.Equal(int64(1), job.ConflictCount)
+	s.Equal(models.CategoryTransitionCounts{
+		"OTHER->DINING":   1,
+		"NONE->GROCERIES": 1,
+	}, job.Transitions)
+}
+
+func (s *RecategorizationServiceTestSuite) TestRunJob_DryRunDoesNotWrite() {
+	job := &models.RecategorizationJob{ID: uuid.New(), Status: models.RecategorizationStatusRunning, DryRun: true, BatchSize: 10}
+
+	s.mockTransactionRepo.EXPECT().GetRecategorizationBatch(job).Return([]models.Transaction{newTestTransaction(models.CategoryOther, 0)}, nil)
+	s.mockCategoryService.EXPECT().PreviewCategorization(gomock.Any()).
+		Return(&models.CategorizationResult{Category: models.CategoryDining})
+	s.mockJobRepo.EXPECT().SaveProgress(job).Return(nil)
+
+	err := s.service.runJob(context.Background(), job)
+
+	s.Require().NoError(err)
+	s.Equal(models.RecategorizationStatusCompleted, job.Status)
+	s.Equal(int64(1), job.Transitions["OTHER->DINING"])
+}
+
+func (s *RecategorizationServiceTestSuite) TestRunJob_ResumesFromCheckpoint() {
+	cursorAt := time.Now().Add(-time.Hour)
+	cursorID := uuid.New()
+	job := &models.RecategorizationJob{
+		ID:                  uuid.New(),
+		Status:              models.RecategorizationStatusRunning,
+		BatchSize:           10,
+		ProcessedCount:      20,
+		CursorCreatedAt:     &cursorAt,
+		CursorTransactionID: &cursorID,
+	}
+
+	s.mockTransactionRepo.EXPECT().GetRecategorizationBatch(job).
+		DoAndReturn(func(j *models.RecategorizationJob) ([]models.Transaction, error) {
+			s.Equal(cursorID, *j.CursorTransactionID)
+			return nil, nil
+		})
+	s.mockJobRepo.EXPECT().SaveProgress(job).Return(nil)
+
+	s.Require().NoError(s.service.runJob(context.Background(), job))
+	s.Equal(int64(20), job.ProcessedCount)
+	s.Equal(models.RecategorizationStatusCompleted, job.Status)
+}
+
+func (s *RecategorizationServiceTestSuite) TestRunJob_StopsWhenCancelled() {
+	job := &models.RecategorizationJob{ID: uuid.New(), Status: models.RecategorizationStatusRunning, BatchSize: 1}
+
+	s.mockTransactionRepo.EXPECT().GetRecategorizationBatch(job).Return([]models.Transaction{newTestTransaction(models.CategoryOther, 0)}, nil)
+	s.mockCategoryService.EXPECT().BatchCategorize(gomock.Any()).DoAndReturn(categorizeAs(models.CategoryOther))
+	s.mockTransactionRepo.EXPECT().ApplyCategoryChanges(gomock.Len(0)).Return(nil, nil)
+	s.mockJobRepo.EXPECT().SaveProgress(job).Return(repositories.ErrRecategorizationJobNotRunning)
+
+	s.NoError(s.service.runJob(context.Background(), job))
+}
+
+func (s *RecategorizationServiceTestSuite) TestRunJob_FailureKeepsCountersOfCommittedBatches() {
+	job := &models.RecategorizationJob{ID: uuid.New(), Status: models.RecategorizationStatusRunning, BatchSize: 1}
+	dbErr := errors.New("connection reset")
+
+	s.mockTransactionRepo.EXPECT().GetRecategorizationBatch(job).Return([]models.Transaction{newTestTransaction(models.CategoryOther, 0)}, nil)
+	s.mockCategoryService.EXPECT().BatchCategorize(gomock.Any()).DoAndReturn(categorizeAs(models.CategoryDining))
+	s.mockTransactionRepo.EXPECT().ApplyCategoryChanges(gomock.Any()).Return(nil, dbErr)
+	s.mockJobRepo.EXPECT().SaveProgress(job).Return(nil)
+
+	err := s.service.runJob(context.Background(), job)
+
+	s.ErrorIs(err, dbErr)
+	s.Equal(models.RecategorizationStatusFailed, job.Status)
+	s.Equal("connection reset", job.ErrorMessage)
+	s.Equal(int64(0), job.ProcessedCount)
+	s.Nil(job.CursorTransactionID)
+}
+
+func (s *RecategorizationServiceTestSuite) TestCancelJob() {
+	tests := []struct {
+		name        string
+		status      string
+		expectSave  bool
+		expectedErr error
+	}{
+		{name: "pending job", status: models.RecategorizationStatusPending, expectSave: true},
+		{name: "running job", status: models.RecategorizationStatusRunning, expectSave: true},
+		{name: "completed job", status: models.RecategorizationStatusCompleted, expectedErr: ErrRecategorizationJobState},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			job := &models.RecategorizationJob{ID: uuid.New(), Status: tt.status, BatchSize: 10}
+			s.mockJobRepo.EXPECT().GetByID(job.ID).Return(job, nil)
+			if tt.expectSave {
+				s.mockJobRepo.EXPECT().UpdateStatus(job, models.RecategorizationStatusPending, models.RecategorizationStatusRunning).Return(nil)
+			}
+
+			result, err := s.service.CancelJob(job.ID)
+
+			if tt.expectedErr != nil {
+				s.ErrorIs(err, tt.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(models.RecategorizationStatusCancelled, result.Status)
+		})
+	}
+}
+
+func (s *RecategorizationServiceTestSuite) TestResumeJob() {
+	job := &models.RecategorizationJob{
+		ID:           uuid.New(),
+		Status:       models.RecategorizationStatusFailed,
+		BatchSize:    10,
+		ErrorMessage: "connection reset",
+	}
+	s.mockJobRepo.EXPECT().GetByID(job.ID).Return(job, nil)
+	s.mockJobRepo.EXPECT().UpdateStatus(job, models.RecategorizationStatusFailed, models.RecategorizationStatusCancelled).Return(nil)
+
+	result, err := s.service.ResumeJob(job.ID)
+
+	s.Require().NoError(err)
+	s.Equal(models.RecategorizationStatusPending, result.Status)
+	s.Empty(result.ErrorMessage)
+}
+
+func (s *RecategorizationServiceTestSuite) TestCancelJob_FinishedConcurrently() {
+	job := &models.RecategorizationJob{ID: uuid.New(), Status: models.RecategorizationStatusRunning, BatchSize: 10}
+	s.mockJobRepo.EXPECT().GetByID(job.ID).Return(job, nil)
+	s.mockJobRepo.EXPECT().UpdateStatus(job, models.RecategorizationStatusPending, models.RecategorizationStatusRunning).
+		Return(repositories.ErrRecategorizationJobStatusChanged)
+
+	_, err := s.service.CancelJob(job.ID)
+
+	s.ErrorIs(err, ErrRecategorizationJobState)
+}
+
+func (s *RecategorizationServiceTestSuite) TestResumeJob_NotFound() {
+	id := uuid.New()
+	s.mockJobRepo.EXPECT().GetByID(id).Return(nil, repositories.ErrRecategorizationJobNotFound)
+
+	_, err := s.service.ResumeJob(id)
+
+	s.ErrorIs(err, ErrRecategorizationJobNotFound)
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransactionAlreadyReversed = errors.New("transaction has already been reversed or has a reversal in progress")
+	ErrTransactionNotReversible   = errors.New("only completed transactions can be reversed")
+)
+
+// ReversalStatusQueued is the status of a reversal accepted for processing
+const ReversalStatusQueued = "queued"
+
+// ReversalService accepts admin reversal requests. It checks that the transaction
+// can be reversed and queues a high-priority reverse operation; the processing queue
+// writes the offsetting transactions. A transfer leg reverses the whole transfer.
+type ReversalService struct {
+	transactionRepo repositories.TransactionRepositoryInterface
+	transferRepo    repositories.TransferRepositoryInterface
+	accountRepo     repositories.AccountRepositoryInterface
+	queueRepo       repositories.ProcessingQueueRepositoryInterface
+	logger          *slog.Logger
+}
+
+// NewReversalService creates a new reversal service
+func NewReversalService(
+	transactionRepo repositories.TransactionRepositoryInterface,
+	transferRepo repositories.TransferRepositoryInterface,
+	accountRepo repositories.AccountRepositoryInterface,
+	queueRepo repositories.ProcessingQueueRepositoryInterface,
+	logger *slog.Logger,
+) ReversalServiceInterface {
+	return &ReversalService{
+		transactionRepo: transactionRepo,
+		transferRepo:    transferRepo,
+		accountRepo:     accountRepo,
+		queueRepo:       queueRepo,
+		logger:          logger,
+	}
+}
+
+// RequestReversal queues the reversal of a completed transaction. It rejects
+// transactions that are already reversed or queued for reversal, and reversals that
+// would take an account below its available balance. The balance is checked again
+// when the reversal is processed.
+func (s *ReversalService) RequestReversal(transactionID, adminID uuid.UUID, req *dto.ReverseTransactionRequest) (*dto.ReverseTransactionResponse, error) {
+	transaction, err := s.transactionRepo.GetByID(transactionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransactionNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	legs, transfer, err := s.reversalLegs(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, leg := range legs {
+		if err := leg.CheckReversible(); err != nil {
+			return nil, mapReversalErr(err)
+		}
+
+		queued, err := s.queueRepo.IsQueued(leg.ID, models.QueueOperationReverse)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check reversal queue: %w", err)
+		}
+		if queued {
+			return nil, ErrTransactionAlreadyReversed
+		}
+
+		if err := s.checkFunds(leg); err != nil {
+			return nil, err
+		}
+	}
+
+	metadata, err := json.Marshal(models.ReversalRequest{Reason: req.Reason, RequestedBy: adminID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode reversal request: %w", err)
+	}
+
+	if err := s.queueRepo.EnqueueWithMetadata(transaction.ID, models.QueueOperationReverse, models.QueuePriorityHigh, string(metadata)); err != nil {
+		return nil, fmt.Errorf("failed to queue reversal: %w", err)
+	}
+
+	response := &dto.ReverseTransactionResponse{
+		TransactionID: transaction.ID.String(),
+		Reason:        req.Reason,
+		Status:        ReversalStatusQueued,
+	}
+	for _, leg := range legs {
+		response.TransactionIDs = append(response.TransactionIDs, leg.ID.String())
+	}
+	if transfer != nil {
+		transferID := transfer.ID.String()
+		response.TransferID = &transferID
+	}
+
+	s.logger.Info("transaction reversal queued",
+		slog.String("transaction_id", transaction.ID.String()),
+		slog.String("admin_id", adminID.String()),
+		slog.Int("legs", len(legs)),
+	)
+
+	return response, nil
+}
+
+// reversalLegs returns the transactions a reversal offsets: the transaction itself,
+// or both legs when it belongs to a transfer
+func (s *ReversalService) reversalLegs(transaction *models.Transaction) ([]*models.Transaction, *models.Transfer, error) {
+	transfer, err := s.transferRepo.FindByTransactionID(transaction.ID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransferNotFound) {
+			return []*models.Transaction{transaction}, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get transfer: %w", err)
+	}
+
+	if transfer.Status == models.TransferStatusReversed {
+		return nil, nil, ErrTransactionAlreadyReversed
+	}
+	if !transfer.IsCompleted() || transfer.DebitTransactionID == nil || transfer.CreditTransactionID == nil {
+		return nil, nil, ErrTransactionNotReversible
+	}
+
+	otherID := *transfer.DebitTransactionID
+	if otherID == transaction.ID {
+		otherID = *transfer.CreditTransactionID
+	}
+
+	other, err := s.transactionRepo.GetByID(otherID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get transfer leg: %w", err)
+	}
+
+	return []*models.Transaction{transaction, other}, transfer, nil
+}
+
+// checkFunds rejects reversing a credit that the account no longer has available
+func (s *ReversalService) checkFunds(leg *models.Transaction) error {
+	if leg.OffsettingType() != models.TransactionTypeDebit {
+		return nil
+	}
+
+	account, err := s.accountRepo.GetByID(leg.AccountID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAccountNotFound) {
+			return ErrAccountNotFound
+		}
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if !account.HasAvailableFunds(leg.Amount) {
+		return ErrInsufficientFunds
+	}
+
+	return nil
+}
+
+// mapReversalErr translates model reversal errors into service errors
+func mapReversalErr(err error) error {
+	switch {
+	case errors.Is(err, models.ErrTransactionAlreadyReversed):
+		return ErrTransactionAlreadyReversed
+	case errors.Is(err, models.ErrTransactionNotReversible):
+		return ErrTransactionNotReversible
+	default:
+		return err
+	}
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReversalServiceTestSuite struct {
+	suite.Suite
+	ctrl                *gomock.Controller
+	mockTransactionRepo *repository_mocks.MockTransactionRepositoryInterface
+	mockTransferRepo    *repository_mocks.MockTransferRepositoryInterface
+	mockAccountRepo     *repository_mocks.MockAccountRepositoryInterface
+	mockQueueRepo       *repository_mocks.MockProcessingQueueRepositoryInterface
+	service             ReversalServiceInterface
+	adminID             uuid.UUID
+}
+
+func TestReversalServiceSuite(t *testing.T) {
+	suite.Run(t, new(ReversalServiceTestSuite))
+}
+
+func (s *ReversalServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockTransactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
+	s.mockTransferRepo = repository_mocks.NewMockTransferRepositoryInterface(s.ctrl)
+	s.mockAccountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
+	s.mockQueueRepo = repository_mocks.NewMockProcessingQueueRepositoryInterface(s.ctrl)
+	s.adminID = uuid.New()
+
+	s.service = NewReversalService(
+		s.mockTransactionRepo,
+		s.mockTransferRepo,
+		s.mockAccountRepo,
+		s.mockQueueRepo,
+		slog.Default(),
+	)
+}
+
+func (s *ReversalServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *ReversalServiceTestSuite) newTransaction(transactionType string, amount string) *models.Transaction {
+	return &models.Transaction{
+		ID:              uuid.New(),
+		AccountID:       uuid.New(),
+		TransactionType: transactionType,
+		Amount:          decimal.RequireFromString(amount),
+		Description:     "Card purchase",
+		Status:          models.TransactionStatusCompleted,
+		Version:         1,
+	}
+}
+
+func (s *ReversalServiceTestSuite) TestRequestReversal_QueuesHighPriorityReverse() {
+	purchase := s.newTransaction(models.TransactionTypeDebit, "40.00")
+
+	s.mockTransactionRepo.EXPECT().GetByID(purchase.ID).Return(purchase, nil)
+	s.mockTransferRepo.EXPECT().FindByTransactionID(purchase.ID).Return(nil, repositories.ErrTransferNotFound)
+	s.mockQueueRepo.EXPECT().IsQueued(purchase.ID, models.QueueOperationReverse).Return(false, nil)
+	s.mockQueueRepo.EXPECT().
+		EnqueueWithMetadata(purchase.ID, models.QueueOperationReverse, models.QueuePriorityHigh, gomock.Any()).
+		DoAndReturn(func(_ uuid.UUID, _ string, _ int, metadata string) error {
+			var request models.ReversalRequest
+			s.Require().NoError(json.Unmarshal([]byte(metadata), &request))
+			s.Equal("Duplicate charge", request.Reason)
+			s.Equal(s.adminID, request.RequestedBy)
+			return nil
+		})
+
+	resp, err := s.service.RequestReversal(purchase.ID, s.adminID, &dto.ReverseTransactionRequest{Reason: "Duplicate charge"})
+	s.Require().NoError(err)
+	s.Equal(ReversalStatusQueued, resp.Status)
+	s.Equal([]string{purchase.ID.String()}, resp.TransactionIDs)
+	s.Nil(resp.TransferID)
+}
+
+func (s *ReversalServiceTestSuite) TestRequestReversal_RejectsDoubleReversal() {
+	reversed := s.newTransaction(models.TransactionTypeDebit, "40.00")
+	reversed.ReverseWithReference("TXN-offset")
+
+	s.mockTransactionRepo.EXPECT().GetByID(reversed.ID).Return(reversed, nil)
+	s.mockTransferRepo.EXPECT().FindByTransactionID(reversed.ID).Return(nil, repositories.ErrTransferNotFound)
+
+	_, err := s.service.RequestReversal(reversed.ID, s.adminID, &dto.ReverseTransactionRequest{Reason: "again"})
+	s.ErrorIs(err, ErrTransactionAlreadyReversed)
+
+	queued := s.newTransaction(models.TransactionTypeDebit, "40.00")
+
+	s.mockTransactionRepo.EXPECT().GetByID(queued.ID).Return(queued, nil)
+	s.mockTransferRepo.EXPECT().FindByTransactionID(queued.ID).Return(nil, repositories.ErrTransferNotFound)
+	s.mockQueueRepo.EXPECT().IsQueued(queued.ID, models.QueueOperationReverse).Return(true, nil)
+
+	_, err = s.service.RequestReversal(queued.ID, s.adminID, &dto.ReverseTransactionRequest{Reason: "again"})
+	s.ErrorIs(err, ErrTransactionAlreadyReversed)
+}
+
+func (s *ReversalServiceTestSuite) TestRequestReversal_RejectsPendingTransaction() {
+	pending := s.newTransaction(models.TransactionTypeDebit, "40.00")
+	pending.Status = models.TransactionStatusPending
+
+	s.mockTransactionRepo.EXPECT().GetByID(pending.ID).Return(pending, nil)
+	s.mockTransferRepo.EXPECT().FindByTransactionID(pending.ID).Return(nil, repositories.ErrTransferNotFound)
+
+	_, err := s.service.RequestReversal(pending.ID, s.adminID, &dto.ReverseTransactionRequest{Reason: "x"})
+	s.ErrorIs(err, ErrTransactionNotReversible)
+}
+
+func (s *ReversalServiceTestSuite) TestRequestReversal_RejectsOverdraft() {
+	deposit := s.newTransaction(models.TransactionTypeCredit, "500.00")
+	account := &models.Account{ID: deposit.AccountID, Balance: decimal.RequireFromString("120.00")}
+
+	s.mockTransactionRepo.EXPECT().GetByID(deposit.ID).Return(deposit, nil)
+	s.mockTransferRepo.EXPECT().FindByTransactionID(deposit.ID).Return(nil, repositories.ErrTransferNotFound)
+	s.mockQueueRepo.EXPECT().IsQueued(deposit.ID, models.QueueOperationReverse).Return(false, nil)
+	s.mockAccountRepo.EXPECT().GetByID(deposit.AccountID).Return(account, nil)
+
+	_, err := s.service.RequestReversal(deposit.ID, s.adminID, &dto.ReverseTransactionRequest{Reason: "Chargeback"})
+	s.ErrorIs(err, ErrInsufficientFunds)
+}
+
+func (s *ReversalServiceTestSuite) TestRequestReversal_TransferChecksBothLegs() {
+	debit := s.newTransaction(models.TransactionTypeDebit, "30.00")
+	credit := s.newTransaction(models.TransactionTypeCredit, "30.00")
+	transfer := &models.Transfer{ID: uuid.New(), FromAccountID: debit.AccountID, ToAccountID: credit.AccountID}
+	transfer.Complete(debit.ID, credit.ID)
+	recipient := &models.Account{ID: credit.AccountID, Balance: decimal.RequireFromString("30.00")}
+
+	s.mockTransactionRepo.EXPECT().GetByID(debit.ID).Return(debit, nil)
+	s.mockTransferRepo.EXPECT().FindByTransactionID(debit.ID).Return(transfer, nil)
+	s.mockTransactionRepo.EXPECT().GetByID(credit.ID).Return(credit, nil)
+	s.mockQueueRepo.EXPECT().IsQueued(debit.ID, models.QueueOperationReverse).Return(false, nil)
+	s.mockQueueRepo.EXPECT().IsQueued(credit.ID, models.QueueOperationReverse).Return(false, nil)
+	s.mockAccountRepo.EXPECT().GetByID(credit.AccountID).Return(recipient, nil)
+	s.mockQueueRepo.EXPECT().
+		EnqueueWithMetadata(debit.ID, models.QueueOperationReverse, models.QueuePriorityHigh, gomock.Any()).
+		Return(nil)
+
+	resp, err := s.service.RequestReversal(debit.ID, s.adminID, &dto.ReverseTransactionRequest{Reason: "Sent in error"})
+	s.Require().NoError(err)
+	s.Equal([]string{debit.ID.String(), credit.ID.String()}, resp.TransactionIDs)
+	s.Require().NotNil(resp.TransferID)
+	s.Equal(transfer.ID.String(), *resp.TransferID)
+}
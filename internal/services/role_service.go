@@ -0,0 +1,130 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRoleNotFound        = errors.New("role not found")
+	ErrCannotChangeOwnRole = errors.New("cannot change your own role")
+)
+
+// RoleService lists roles and assigns them to users. Access tokens carry the
+// permissions of the role they were issued for, so assigning a role signs the
+// user out everywhere and the new permissions apply from their next login.
+type RoleService struct {
+	roleRepo       repositories.RoleRepositoryInterface
+	userRepo       repositories.UserRepositoryInterface
+	auditRepo      repositories.AuditLogRepositoryInterface
+	sessionService SessionServiceInterface
+	logger         *slog.Logger
+}
+
+// NewRoleService creates a new role service
+func NewRoleService(
+	roleRepo repositories.RoleRepositoryInterface,
+	userRepo repositories.UserRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	sessionService SessionServiceInterface,
+	logger *slog.Logger,
+) RoleServiceInterface {
+	return &RoleService{
+		roleRepo:       roleRepo,
+		userRepo:       userRepo,
+		auditRepo:      auditRepo,
+		sessionService: sessionService,
+		logger:         logger,
+	}
+}
+
+// ListRoles lists every role with the permissions it grants
+func (s *RoleService) ListRoles() ([]dto.RoleResponse, error) {
+	roles, err := s.roleRepo.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	responses := make([]dto.RoleResponse, len(roles))
+	for i, role := range roles {
+		permissions := make([]string, len(role.Permissions))
+		for j, permission := range role.Permissions {
+			permissions[j] = permission.Name
+		}
+		responses[i] = dto.RoleResponse{
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: permissions,
+		}
+	}
+
+	return responses, nil
+}
+
+// AssignRole changes a user's role. Admins cannot change their own role, so the
+// last super-admin cannot demote themselves by mistake.
+func (s *RoleService) AssignRole(userID uuid.UUID, role string, performedBy uuid.UUID, ipAddress, userAgent string) (*models.User, error) {
+	if userID == performedBy {
+		return nil, ErrCannotChangeOwnRole
+	}
+
+	if _, err := s.roleRepo.GetByName(role); err != nil {
+		if errors.Is(err, repositories.ErrRoleNotFound) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if user.Role == role {
+		return user, nil
+	}
+
+	previousRole := user.Role
+	if err := s.userRepo.UpdateFields(userID, map[string]interface{}{"role": role}); err != nil {
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+	user.Role = role
+
+	// Security: Tokens issued for the previous role still carry its permissions
+	if _, err := s.sessionService.RevokeAllSessions(userID, performedBy, models.SessionRevokedRoleChanged, ipAddress, userAgent); err != nil {
+		s.logger.Error("failed to revoke sessions after role change",
+			"error", err,
+			"user_id", userID,
+			"previous_role", previousRole,
+			"role", role)
+	}
+
+	s.createAuditLog(&performedBy, models.AuditActionRoleAssigned, userID.String(), ipAddress, userAgent, map[string]interface{}{
+		"previous_role": previousRole,
+		"role":          role,
+	})
+
+	return user, nil
+}
+
+func (s *RoleService) createAuditLog(userID *uuid.UUID, action, resourceID, ipAddress, userAgent string, metadata map[string]interface{}) {
+	recordAuditLog(s.auditRepo, s.logger, &models.AuditLog{
+		UserID:     userID,
+		Action:     action,
+		Resource:   "user",
+		ResourceID: resourceID,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		Metadata:   metadata,
+	})
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"log/slog"
+	"testing"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoleServiceTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	roleRepo       *repository_mocks.MockRoleRepositoryInterface
+	userRepo       *repository_mocks.MockUserRepositoryInterface
+	auditRepo      *repository_mocks.MockAuditLogRepositoryInterface
+	sessionService *service_mocks.MockSessionServiceInterface
+	roleService    RoleServiceInterface
+	adminID        uuid.UUID
+}
+
+func (s *RoleServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.roleRepo = repository_mocks.NewMockRoleRepositoryInterface(s.ctrl)
+	s.userRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.sessionService = service_mocks.NewMockSessionServiceInterface(s.ctrl)
+	s.roleService = NewRoleService(s.roleRepo, s.userRepo, s.auditRepo, s.sessionService, slog.Default())
+	s.adminID = uuid.New()
+}
+
+func (s *RoleServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestRoleServiceSuite(t *testing.T) {
+	suite.Run(t, new(RoleServiceTestSuite))
+}
+
+func (s *RoleServiceTestSuite) TestListRoles() {
+	s.roleRepo.EXPECT().List().Return([]*models.Role{
+		{Name: models.RoleCustomer},
+		{
+			Name:        models.RoleSupport,
+			Description: "Support agent",
+			Permissions: []models.Permission{{Name: models.PermissionCustomersRead}, {Name: models.PermissionCustomersResetPassword}},
+		},
+	}, nil).Times(1)
+
+	roles, err := s.roleService.ListRoles()
+
+	s.Require().NoError(err)
+	s.Require().Len(roles, 2)
+	s.Empty(roles[0].Permissions)
+	s.Equal([]string{models.PermissionCustomersRead, models.PermissionCustomersResetPassword}, roles[1].Permissions)
+}
+
+func (s *RoleServiceTestSuite) TestAssignRole_SignsUserOutEverywhere() {
+	user := &models.User{ID: uuid.New(), Role: models.RoleCustomer}
+
+	s.roleRepo.EXPECT().GetByName(models.RoleTeller).Return(&models.Role{Name: models.RoleTeller}, nil).Times(1)
+	s.userRepo.EXPECT().GetByID(user.ID).Return(user, nil).Times(1)
+	s.userRepo.EXPECT().UpdateFields(user.ID, map[string]interface{}{"role": models.RoleTeller}).Return(nil).Times(1)
+	s.sessionService.EXPECT().
+		RevokeAllSessions(user.ID, s.adminID, models.SessionRevokedRoleChanged, "10.0.0.1", "admin-console").
+		Return(2, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionRoleAssigned, log.Action)
+		s.Equal(models.RoleCustomer, log.Metadata["previous_role"])
+		s.Equal(models.RoleTeller, log.Metadata["role"])
+		return nil
+	}).Times(1)
+
+	updated, err := s.roleService.AssignRole(user.ID, models.RoleTeller, s.adminID, "10.0.0.1", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal(models.RoleTeller, updated.Role)
+}
+
+func (s *RoleServiceTestSuite) TestAssignRole_Unchanged() {
+	user := &models.User{ID: uuid.New(), Role: models.RoleSupport}
+
+	s.roleRepo.EXPECT().GetByName(models.RoleSupport).Return(&models.Role{Name: models.RoleSupport}, nil).Times(1)
+	s.userRepo.EXPECT().GetByID(user.ID).Return(user, nil).Times(1)
+
+	updated, err := s.roleService.AssignRole(user.ID, models.RoleSupport, s.adminID, "10.0.0.1", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal(models.RoleSupport, updated.Role)
+}
+
+func (s *RoleServiceTestSuite) TestAssignRole_OwnRole() {
+	_, err := s.roleService.AssignRole(s.adminID, models.RoleCustomer, s.adminID, "10.0.0.1", "admin-console")
+
+	s.ErrorIs(err, ErrCannotChangeOwnRole)
+}
+
+func (s *RoleServiceTestSuite) TestAssignRole_UnknownRole() {
+	s.roleRepo.EXPECT().GetByName("auditor").Return(nil, repositories.ErrRoleNotFound).Times(1)
+
+	_, err := s.roleService.AssignRole(uuid.New(), "auditor", s.adminID, "10.0.0.1", "admin-console")
+
+	s.ErrorIs(err, ErrRoleNotFound)
+}
+
+func (s *RoleServiceTestSuite) TestAssignRole_RoleAddedToTable() {
+	user := &models.User{ID: uuid.New(), Role: models.RoleCustomer}
+
+	s.roleRepo.EXPECT().GetByName("auditor").Return(&models.Role{Name: "auditor"}, nil).Times(1)
+	s.userRepo.EXPECT().GetByID(user.ID).Return(user, nil).Times(1)
+	s.userRepo.EXPECT().UpdateFields(user.ID, map[string]interface{}{"role": "auditor"}).Return(nil).Times(1)
+	s.sessionService.EXPECT().RevokeAllSessions(user.ID, s.adminID, models.SessionRevokedRoleChanged, "10.0.0.1", "admin-console").Return(0, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	updated, err := s.roleService.AssignRole(user.ID, "auditor", s.adminID, "10.0.0.1", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal("auditor", updated.Role)
+}
+
+func (s *RoleServiceTestSuite) TestAssignRole_UserNotFound() {
+	userID := uuid.New()
+
+	s.roleRepo.EXPECT().GetByName(models.RoleCompliance).Return(&models.Role{Name: models.RoleCompliance}, nil).Times(1)
+	s.userRepo.EXPECT().GetByID(userID).Return(nil, repositories.ErrUserNotFound).Times(1)
+
+	_, err := s.roleService.AssignRole(userID, models.RoleCompliance, s.adminID, "10.0.0.1", "admin-console")
+
+	s.ErrorIs(err, ErrUserNotFound)
+}
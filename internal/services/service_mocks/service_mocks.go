@@ -7,7 +7,9 @@ package service_mocks
 import (
 	dto "array-assessment/internal/dto"
 	models "array-assessment/internal/models"
+	repositories "array-assessment/internal/repositories"
 	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -120,19 +122,34 @@ func (mr *MockAccountServiceInterfaceMockRecorder) CloseAccount(accountID, userI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockAccountServiceInterface)(nil).CloseAccount), accountID, userID)
 }
 
+// CompleteHeldTransfer mocks base method.
+func (m *MockAccountServiceInterface) CompleteHeldTransfer(transferID, userID uuid.UUID) (*models.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteHeldTransfer", transferID, userID)
+	ret0, _ := ret[0].(*models.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteHeldTransfer indicates an expected call of CompleteHeldTransfer.
+func (mr *MockAccountServiceInterfaceMockRecorder) CompleteHeldTransfer(transferID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteHeldTransfer", reflect.TypeOf((*MockAccountServiceInterface)(nil).CompleteHeldTransfer), transferID, userID)
+}
+
 // CreateAccount mocks base method.
-func (m *MockAccountServiceInterface) CreateAccount(userID uuid.UUID, accountType, accountNumber, routingNumber string, initialDeposit decimal.Decimal) (*models.Account, error) {
+func (m *MockAccountServiceInterface) CreateAccount(userID uuid.UUID, accountType, accountNumber, routingNumber, currency string, initialDeposit decimal.Decimal) (*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", userID, accountType, accountNumber, routingNumber, initialDeposit)
+	ret := m.ctrl.Call(m, "CreateAccount", userID, accountType, accountNumber, routingNumber, currency, initialDeposit)
 	ret0, _ := ret[0].(*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockAccountServiceInterfaceMockRecorder) CreateAccount(userID, accountType, accountNumber, routingNumber, initialDeposit interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) CreateAccount(userID, accountType, accountNumber, routingNumber, currency, initialDeposit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountServiceInterface)(nil).CreateAccount), userID, accountType, accountNumber, routingNumber, initialDeposit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountServiceInterface)(nil).CreateAccount), userID, accountType, accountNumber, routingNumber, currency, initialDeposit)
 }
 
 // CreateAccountsForNewUser mocks base method.
@@ -302,1759 +319,4200 @@ func (mr *MockAccountServiceInterfaceMockRecorder) UpdateAccountStatus(accountID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountStatus", reflect.TypeOf((*MockAccountServiceInterface)(nil).UpdateAccountStatus), accountID, userID, status)
 }
 
-// MockAccountSummaryServiceInterface is a mock of AccountSummaryServiceInterface interface.
-type MockAccountSummaryServiceInterface struct {
+// MockTransferScheduleServiceInterface is a mock of TransferScheduleServiceInterface interface.
+type MockTransferScheduleServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockAccountSummaryServiceInterfaceMockRecorder
+	recorder *MockTransferScheduleServiceInterfaceMockRecorder
 }
 
-// MockAccountSummaryServiceInterfaceMockRecorder is the mock recorder for MockAccountSummaryServiceInterface.
-type MockAccountSummaryServiceInterfaceMockRecorder struct {
-	mock *MockAccountSummaryServiceInterface
+// MockTransferScheduleServiceInterfaceMockRecorder is the mock recorder for MockTransferScheduleServiceInterface.
+type MockTransferScheduleServiceInterfaceMockRecorder struct {
+	mock *MockTransferScheduleServiceInterface
 }
 
-// NewMockAccountSummaryServiceInterface creates a new mock instance.
-func NewMockAccountSummaryServiceInterface(ctrl *gomock.Controller) *MockAccountSummaryServiceInterface {
-	mock := &MockAccountSummaryServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockAccountSummaryServiceInterfaceMockRecorder{mock}
+// NewMockTransferScheduleServiceInterface creates a new mock instance.
+func NewMockTransferScheduleServiceInterface(ctrl *gomock.Controller) *MockTransferScheduleServiceInterface {
+	mock := &MockTransferScheduleServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferScheduleServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAccountSummaryServiceInterface) EXPECT() *MockAccountSummaryServiceInterfaceMockRecorder {
+func (m *MockTransferScheduleServiceInterface) EXPECT() *MockTransferScheduleServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// GetAccountSummary mocks base method.
-func (m *MockAccountSummaryServiceInterface) GetAccountSummary(requestorID uuid.UUID, targetUserID *uuid.UUID, isAdmin bool) (*models.UserAccountSummary, error) {
+// CancelSchedule mocks base method.
+func (m *MockTransferScheduleServiceInterface) CancelSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountSummary", requestorID, targetUserID, isAdmin)
-	ret0, _ := ret[0].(*models.UserAccountSummary)
+	ret := m.ctrl.Call(m, "CancelSchedule", id, userID)
+	ret0, _ := ret[0].(*models.TransferSchedule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountSummary indicates an expected call of GetAccountSummary.
-func (mr *MockAccountSummaryServiceInterfaceMockRecorder) GetAccountSummary(requestorID, targetUserID, isAdmin interface{}) *gomock.Call {
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *MockTransferScheduleServiceInterfaceMockRecorder) CancelSchedule(id, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSummary", reflect.TypeOf((*MockAccountSummaryServiceInterface)(nil).GetAccountSummary), requestorID, targetUserID, isAdmin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*MockTransferScheduleServiceInterface)(nil).CancelSchedule), id, userID)
 }
 
-// MockAuditServiceInterface is a mock of AuditServiceInterface interface.
-type MockAuditServiceInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockAuditServiceInterfaceMockRecorder
+// CreateSchedule mocks base method.
+func (m *MockTransferScheduleServiceInterface) CreateSchedule(userID uuid.UUID, req *dto.CreateTransferScheduleRequest) (*models.TransferSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", userID, req)
+	ret0, _ := ret[0].(*models.TransferSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockAuditServiceInterfaceMockRecorder is the mock recorder for MockAuditServiceInterface.
-type MockAuditServiceInterfaceMockRecorder struct {
-	mock *MockAuditServiceInterface
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockTransferScheduleServiceInterfaceMockRecorder) CreateSchedule(userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockTransferScheduleServiceInterface)(nil).CreateSchedule), userID, req)
 }
 
-// NewMockAuditServiceInterface creates a new mock instance.
-func NewMockAuditServiceInterface(ctrl *gomock.Controller) *MockAuditServiceInterface {
-	mock := &MockAuditServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockAuditServiceInterfaceMockRecorder{mock}
-	return mock
+// GetSchedule mocks base method.
+func (m *MockTransferScheduleServiceInterface) GetSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", id, userID)
+	ret0, _ := ret[0].(*models.TransferSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAuditServiceInterface) EXPECT() *MockAuditServiceInterfaceMockRecorder {
-	return m.recorder
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *MockTransferScheduleServiceInterfaceMockRecorder) GetSchedule(id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockTransferScheduleServiceInterface)(nil).GetSchedule), id, userID)
 }
 
-// CreateAuditLog mocks base method.
-func (m *MockAuditServiceInterface) CreateAuditLog(log *models.AuditLog) error {
+// ListSchedules mocks base method.
+func (m *MockTransferScheduleServiceInterface) ListSchedules(userID uuid.UUID, offset, limit int) ([]models.TransferSchedule, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAuditLog", log)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListSchedules", userID, offset, limit)
+	ret0, _ := ret[0].([]models.TransferSchedule)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// CreateAuditLog indicates an expected call of CreateAuditLog.
-func (mr *MockAuditServiceInterfaceMockRecorder) CreateAuditLog(log interface{}) *gomock.Call {
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockTransferScheduleServiceInterfaceMockRecorder) ListSchedules(userID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockAuditServiceInterface)(nil).CreateAuditLog), log)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockTransferScheduleServiceInterface)(nil).ListSchedules), userID, offset, limit)
 }
 
-// GetCustomerActivity mocks base method.
-func (m *MockAuditServiceInterface) GetCustomerActivity(userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
+// PauseSchedule mocks base method.
+func (m *MockTransferScheduleServiceInterface) PauseSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomerActivity", userID, startDate, endDate, offset, limit)
-	ret0, _ := ret[0].([]*models.AuditLog)
-	ret1, _ := ret[1].(int64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "PauseSchedule", id, userID)
+	ret0, _ := ret[0].(*models.TransferSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetCustomerActivity indicates an expected call of GetCustomerActivity.
-func (mr *MockAuditServiceInterfaceMockRecorder) GetCustomerActivity(userID, startDate, endDate, offset, limit interface{}) *gomock.Call {
+// PauseSchedule indicates an expected call of PauseSchedule.
+func (mr *MockTransferScheduleServiceInterfaceMockRecorder) PauseSchedule(id, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerActivity", reflect.TypeOf((*MockAuditServiceInterface)(nil).GetCustomerActivity), userID, startDate, endDate, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockTransferScheduleServiceInterface)(nil).PauseSchedule), id, userID)
 }
 
-// LogAccountCreated mocks base method.
-func (m *MockAuditServiceInterface) LogAccountCreated(userID, performedBy, accountID uuid.UUID, accountType, ipAddress, userAgent string) error {
+// ResumeSchedule mocks base method.
+func (m *MockTransferScheduleServiceInterface) ResumeSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogAccountCreated", userID, performedBy, accountID, accountType, ipAddress, userAgent)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ResumeSchedule", id, userID)
+	ret0, _ := ret[0].(*models.TransferSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogAccountCreated indicates an expected call of LogAccountCreated.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogAccountCreated(userID, performedBy, accountID, accountType, ipAddress, userAgent interface{}) *gomock.Call {
+// ResumeSchedule indicates an expected call of ResumeSchedule.
+func (mr *MockTransferScheduleServiceInterfaceMockRecorder) ResumeSchedule(id, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountCreated", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogAccountCreated), userID, performedBy, accountID, accountType, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSchedule", reflect.TypeOf((*MockTransferScheduleServiceInterface)(nil).ResumeSchedule), id, userID)
 }
 
-// LogAccountTransferred mocks base method.
-func (m *MockAuditServiceInterface) LogAccountTransferred(fromUserID, toUserID, performedBy, accountID uuid.UUID, ipAddress, userAgent string) error {
+// StartWorker mocks base method.
+func (m *MockTransferScheduleServiceInterface) StartWorker(ctx context.Context, pollInterval time.Duration) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogAccountTransferred", fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent)
-	ret0, _ := ret[0].(error)
-	return ret0
+	m.ctrl.Call(m, "StartWorker", ctx, pollInterval)
 }
 
-// LogAccountTransferred indicates an expected call of LogAccountTransferred.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogAccountTransferred(fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent interface{}) *gomock.Call {
+// StartWorker indicates an expected call of StartWorker.
+func (mr *MockTransferScheduleServiceInterfaceMockRecorder) StartWorker(ctx, pollInterval interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountTransferred", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogAccountTransferred), fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorker", reflect.TypeOf((*MockTransferScheduleServiceInterface)(nil).StartWorker), ctx, pollInterval)
 }
 
-// LogCustomerCreated mocks base method.
-func (m *MockAuditServiceInterface) LogCustomerCreated(userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+// MockInterestServiceInterface is a mock of InterestServiceInterface interface.
+type MockInterestServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterestServiceInterfaceMockRecorder
+}
+
+// MockInterestServiceInterfaceMockRecorder is the mock recorder for MockInterestServiceInterface.
+type MockInterestServiceInterfaceMockRecorder struct {
+	mock *MockInterestServiceInterface
+}
+
+// NewMockInterestServiceInterface creates a new mock instance.
+func NewMockInterestServiceInterface(ctrl *gomock.Controller) *MockInterestServiceInterface {
+	mock := &MockInterestServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockInterestServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterestServiceInterface) EXPECT() *MockInterestServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Backfill mocks base method.
+func (m *MockInterestServiceInterface) Backfill(req *dto.InterestBackfillRequest) (*models.InterestBackfillResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogCustomerCreated", userID, performedBy, ipAddress, userAgent)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Backfill", req)
+	ret0, _ := ret[0].(*models.InterestBackfillResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogCustomerCreated indicates an expected call of LogCustomerCreated.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogCustomerCreated(userID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
+// Backfill indicates an expected call of Backfill.
+func (mr *MockInterestServiceInterfaceMockRecorder) Backfill(req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerCreated", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogCustomerCreated), userID, performedBy, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backfill", reflect.TypeOf((*MockInterestServiceInterface)(nil).Backfill), req)
 }
 
-// LogCustomerDeleted mocks base method.
-func (m *MockAuditServiceInterface) LogCustomerDeleted(userID, performedBy uuid.UUID, ipAddress, userAgent, reason string) error {
+// StartWorker mocks base method.
+func (m *MockInterestServiceInterface) StartWorker(ctx context.Context, pollInterval time.Duration) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogCustomerDeleted", userID, performedBy, ipAddress, userAgent, reason)
-	ret0, _ := ret[0].(error)
-	return ret0
+	m.ctrl.Call(m, "StartWorker", ctx, pollInterval)
 }
 
-// LogCustomerDeleted indicates an expected call of LogCustomerDeleted.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogCustomerDeleted(userID, performedBy, ipAddress, userAgent, reason interface{}) *gomock.Call {
+// StartWorker indicates an expected call of StartWorker.
+func (mr *MockInterestServiceInterfaceMockRecorder) StartWorker(ctx, pollInterval interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerDeleted", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogCustomerDeleted), userID, performedBy, ipAddress, userAgent, reason)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorker", reflect.TypeOf((*MockInterestServiceInterface)(nil).StartWorker), ctx, pollInterval)
 }
 
-// LogEmailUpdate mocks base method.
-func (m *MockAuditServiceInterface) LogEmailUpdate(userID, performedBy uuid.UUID, oldEmail, newEmail, ipAddress, userAgent string) error {
+// MockHoldServiceInterface is a mock of HoldServiceInterface interface.
+type MockHoldServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockHoldServiceInterfaceMockRecorder
+}
+
+// MockHoldServiceInterfaceMockRecorder is the mock recorder for MockHoldServiceInterface.
+type MockHoldServiceInterfaceMockRecorder struct {
+	mock *MockHoldServiceInterface
+}
+
+// NewMockHoldServiceInterface creates a new mock instance.
+func NewMockHoldServiceInterface(ctrl *gomock.Controller) *MockHoldServiceInterface {
+	mock := &MockHoldServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockHoldServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHoldServiceInterface) EXPECT() *MockHoldServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CaptureHold mocks base method.
+func (m *MockHoldServiceInterface) CaptureHold(holdID uuid.UUID, req *dto.CaptureHoldRequest) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogEmailUpdate", userID, performedBy, oldEmail, newEmail, ipAddress, userAgent)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CaptureHold", holdID, req)
+	ret0, _ := ret[0].(*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogEmailUpdate indicates an expected call of LogEmailUpdate.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogEmailUpdate(userID, performedBy, oldEmail, newEmail, ipAddress, userAgent interface{}) *gomock.Call {
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockHoldServiceInterfaceMockRecorder) CaptureHold(holdID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEmailUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogEmailUpdate), userID, performedBy, oldEmail, newEmail, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockHoldServiceInterface)(nil).CaptureHold), holdID, req)
 }
 
-// LogLogin mocks base method.
-func (m *MockAuditServiceInterface) LogLogin(userID uuid.UUID, ipAddress, userAgent string) error {
+// EnqueueExpiredHolds mocks base method.
+func (m *MockHoldServiceInterface) EnqueueExpiredHolds() (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogLogin", userID, ipAddress, userAgent)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "EnqueueExpiredHolds")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogLogin indicates an expected call of LogLogin.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogLogin(userID, ipAddress, userAgent interface{}) *gomock.Call {
+// EnqueueExpiredHolds indicates an expected call of EnqueueExpiredHolds.
+func (mr *MockHoldServiceInterfaceMockRecorder) EnqueueExpiredHolds() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogLogin", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogLogin), userID, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueExpiredHolds", reflect.TypeOf((*MockHoldServiceInterface)(nil).EnqueueExpiredHolds))
 }
 
-// LogLogout mocks base method.
-func (m *MockAuditServiceInterface) LogLogout(userID uuid.UUID, ipAddress, userAgent string) error {
+// GetAccountHolds mocks base method.
+func (m *MockHoldServiceInterface) GetAccountHolds(accountID uuid.UUID) ([]models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogLogout", userID, ipAddress, userAgent)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetAccountHolds", accountID)
+	ret0, _ := ret[0].([]models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogLogout indicates an expected call of LogLogout.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogLogout(userID, ipAddress, userAgent interface{}) *gomock.Call {
+// GetAccountHolds indicates an expected call of GetAccountHolds.
+func (mr *MockHoldServiceInterfaceMockRecorder) GetAccountHolds(accountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogLogout", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogLogout), userID, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountHolds", reflect.TypeOf((*MockHoldServiceInterface)(nil).GetAccountHolds), accountID)
 }
 
-// LogPasswordReset mocks base method.
-func (m *MockAuditServiceInterface) LogPasswordReset(userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+// PlaceHold mocks base method.
+func (m *MockHoldServiceInterface) PlaceHold(accountID uuid.UUID, req *dto.PlaceHoldRequest) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogPasswordReset", userID, performedBy, ipAddress, userAgent)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "PlaceHold", accountID, req)
+	ret0, _ := ret[0].(*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogPasswordReset indicates an expected call of LogPasswordReset.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogPasswordReset(userID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
+// PlaceHold indicates an expected call of PlaceHold.
+func (mr *MockHoldServiceInterfaceMockRecorder) PlaceHold(accountID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordReset", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogPasswordReset), userID, performedBy, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceHold", reflect.TypeOf((*MockHoldServiceInterface)(nil).PlaceHold), accountID, req)
 }
 
-// LogPasswordUpdate mocks base method.
-func (m *MockAuditServiceInterface) LogPasswordUpdate(userID uuid.UUID, ipAddress, userAgent string) error {
+// ReleaseHold mocks base method.
+func (m *MockHoldServiceInterface) ReleaseHold(holdID uuid.UUID) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogPasswordUpdate", userID, ipAddress, userAgent)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReleaseHold", holdID)
+	ret0, _ := ret[0].(*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogPasswordUpdate indicates an expected call of LogPasswordUpdate.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogPasswordUpdate(userID, ipAddress, userAgent interface{}) *gomock.Call {
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockHoldServiceInterfaceMockRecorder) ReleaseHold(holdID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogPasswordUpdate), userID, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockHoldServiceInterface)(nil).ReleaseHold), holdID)
 }
 
-// LogProfileUpdate mocks base method.
-func (m *MockAuditServiceInterface) LogProfileUpdate(userID, performedBy uuid.UUID, ipAddress, userAgent string, changes map[string]interface{}) error {
+// StartWorker mocks base method.
+func (m *MockHoldServiceInterface) StartWorker(ctx context.Context, pollInterval time.Duration) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogProfileUpdate", userID, performedBy, ipAddress, userAgent, changes)
-	ret0, _ := ret[0].(error)
-	return ret0
+	m.ctrl.Call(m, "StartWorker", ctx, pollInterval)
 }
 
-// LogProfileUpdate indicates an expected call of LogProfileUpdate.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogProfileUpdate(userID, performedBy, ipAddress, userAgent, changes interface{}) *gomock.Call {
+// StartWorker indicates an expected call of StartWorker.
+func (mr *MockHoldServiceInterfaceMockRecorder) StartWorker(ctx, pollInterval interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogProfileUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogProfileUpdate), userID, performedBy, ipAddress, userAgent, changes)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorker", reflect.TypeOf((*MockHoldServiceInterface)(nil).StartWorker), ctx, pollInterval)
 }
 
-// MockCategoryServiceInterface is a mock of CategoryServiceInterface interface.
-type MockCategoryServiceInterface struct {
+// MockRateProvider is a mock of RateProvider interface.
+type MockRateProvider struct {
 	ctrl     *gomock.Controller
-	recorder *MockCategoryServiceInterfaceMockRecorder
+	recorder *MockRateProviderMockRecorder
 }
 
-// MockCategoryServiceInterfaceMockRecorder is the mock recorder for MockCategoryServiceInterface.
-type MockCategoryServiceInterfaceMockRecorder struct {
-	mock *MockCategoryServiceInterface
+// MockRateProviderMockRecorder is the mock recorder for MockRateProvider.
+type MockRateProviderMockRecorder struct {
+	mock *MockRateProvider
 }
 
-// NewMockCategoryServiceInterface creates a new mock instance.
-func NewMockCategoryServiceInterface(ctrl *gomock.Controller) *MockCategoryServiceInterface {
-	mock := &MockCategoryServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockCategoryServiceInterfaceMockRecorder{mock}
+// NewMockRateProvider creates a new mock instance.
+func NewMockRateProvider(ctrl *gomock.Controller) *MockRateProvider {
+	mock := &MockRateProvider{ctrl: ctrl}
+	mock.recorder = &MockRateProviderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCategoryServiceInterface) EXPECT() *MockCategoryServiceInterfaceMockRecorder {
+func (m *MockRateProvider) EXPECT() *MockRateProviderMockRecorder {
 	return m.recorder
 }
 
-// BatchCategorize mocks base method.
-func (m *MockCategoryServiceInterface) BatchCategorize(transactions []*models.Transaction) []*models.CategorizationResult {
+// GetRate mocks base method.
+func (m *MockRateProvider) GetRate(fromCurrency, toCurrency string) (*models.ExchangeRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BatchCategorize", transactions)
-	ret0, _ := ret[0].([]*models.CategorizationResult)
-	return ret0
+	ret := m.ctrl.Call(m, "GetRate", fromCurrency, toCurrency)
+	ret0, _ := ret[0].(*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// BatchCategorize indicates an expected call of BatchCategorize.
-func (mr *MockCategoryServiceInterfaceMockRecorder) BatchCategorize(transactions interface{}) *gomock.Call {
+// GetRate indicates an expected call of GetRate.
+func (mr *MockRateProviderMockRecorder) GetRate(fromCurrency, toCurrency interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCategorize", reflect.TypeOf((*MockCategoryServiceInterface)(nil).BatchCategorize), transactions)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockRateProvider)(nil).GetRate), fromCurrency, toCurrency)
 }
 
-// CategorizeByDescription mocks base method.
-func (m *MockCategoryServiceInterface) CategorizeByDescription(description string) (string, float64) {
+// MockExchangeRateServiceInterface is a mock of ExchangeRateServiceInterface interface.
+type MockExchangeRateServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateServiceInterfaceMockRecorder
+}
+
+// MockExchangeRateServiceInterfaceMockRecorder is the mock recorder for MockExchangeRateServiceInterface.
+type MockExchangeRateServiceInterfaceMockRecorder struct {
+	mock *MockExchangeRateServiceInterface
+}
+
+// NewMockExchangeRateServiceInterface creates a new mock instance.
+func NewMockExchangeRateServiceInterface(ctrl *gomock.Controller) *MockExchangeRateServiceInterface {
+	mock := &MockExchangeRateServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateServiceInterface) EXPECT() *MockExchangeRateServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetRate mocks base method.
+func (m *MockExchangeRateServiceInterface) GetRate(fromCurrency, toCurrency string) (*models.ExchangeRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CategorizeByDescription", description)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(float64)
+	ret := m.ctrl.Call(m, "GetRate", fromCurrency, toCurrency)
+	ret0, _ := ret[0].(*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CategorizeByDescription indicates an expected call of CategorizeByDescription.
-func (mr *MockCategoryServiceInterfaceMockRecorder) CategorizeByDescription(description interface{}) *gomock.Call {
+// GetRate indicates an expected call of GetRate.
+func (mr *MockExchangeRateServiceInterfaceMockRecorder) GetRate(fromCurrency, toCurrency interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategorizeByDescription", reflect.TypeOf((*MockCategoryServiceInterface)(nil).CategorizeByDescription), description)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockExchangeRateServiceInterface)(nil).GetRate), fromCurrency, toCurrency)
 }
 
-// CategorizeByMerchant mocks base method.
-func (m *MockCategoryServiceInterface) CategorizeByMerchant(merchantName string) (string, float64) {
+// ListRates mocks base method.
+func (m *MockExchangeRateServiceInterface) ListRates() ([]models.ExchangeRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CategorizeByMerchant", merchantName)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(float64)
+	ret := m.ctrl.Call(m, "ListRates")
+	ret0, _ := ret[0].([]models.ExchangeRate)
+	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CategorizeByMerchant indicates an expected call of CategorizeByMerchant.
-func (mr *MockCategoryServiceInterfaceMockRecorder) CategorizeByMerchant(merchantName interface{}) *gomock.Call {
+// ListRates indicates an expected call of ListRates.
+func (mr *MockExchangeRateServiceInterfaceMockRecorder) ListRates() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategorizeByMerchant", reflect.TypeOf((*MockCategoryServiceInterface)(nil).CategorizeByMerchant), merchantName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRates", reflect.TypeOf((*MockExchangeRateServiceInterface)(nil).ListRates))
 }
 
-// CategorizeTransaction mocks base method.
-func (m *MockCategoryServiceInterface) CategorizeTransaction(transaction *models.Transaction) *models.CategorizationResult {
+// LoadRatesFile mocks base method.
+func (m *MockExchangeRateServiceInterface) LoadRatesFile(path string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CategorizeTransaction", transaction)
-	ret0, _ := ret[0].(*models.CategorizationResult)
-	return ret0
+	ret := m.ctrl.Call(m, "LoadRatesFile", path)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CategorizeTransaction indicates an expected call of CategorizeTransaction.
-func (mr *MockCategoryServiceInterfaceMockRecorder) CategorizeTransaction(transaction interface{}) *gomock.Call {
+// LoadRatesFile indicates an expected call of LoadRatesFile.
+func (mr *MockExchangeRateServiceInterfaceMockRecorder) LoadRatesFile(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategorizeTransaction", reflect.TypeOf((*MockCategoryServiceInterface)(nil).CategorizeTransaction), transaction)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadRatesFile", reflect.TypeOf((*MockExchangeRateServiceInterface)(nil).LoadRatesFile), path)
 }
 
-// CategoryFromMCC mocks base method.
-func (m *MockCategoryServiceInterface) CategoryFromMCC(mccCode string) string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CategoryFromMCC", mccCode)
-	ret0, _ := ret[0].(string)
-	return ret0
+// MockExternalTransferServiceInterface is a mock of ExternalTransferServiceInterface interface.
+type MockExternalTransferServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalTransferServiceInterfaceMockRecorder
 }
 
-// CategoryFromMCC indicates an expected call of CategoryFromMCC.
-func (mr *MockCategoryServiceInterfaceMockRecorder) CategoryFromMCC(mccCode interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryFromMCC", reflect.TypeOf((*MockCategoryServiceInterface)(nil).CategoryFromMCC), mccCode)
+// MockExternalTransferServiceInterfaceMockRecorder is the mock recorder for MockExternalTransferServiceInterface.
+type MockExternalTransferServiceInterfaceMockRecorder struct {
+	mock *MockExternalTransferServiceInterface
 }
 
-// FuzzyMatchMerchant mocks base method.
-func (m *MockCategoryServiceInterface) FuzzyMatchMerchant(input string) (string, float64) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FuzzyMatchMerchant", input)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(float64)
-	return ret0, ret1
+// NewMockExternalTransferServiceInterface creates a new mock instance.
+func NewMockExternalTransferServiceInterface(ctrl *gomock.Controller) *MockExternalTransferServiceInterface {
+	mock := &MockExternalTransferServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockExternalTransferServiceInterfaceMockRecorder{mock}
+	return mock
 }
 
-// FuzzyMatchMerchant indicates an expected call of FuzzyMatchMerchant.
-func (mr *MockCategoryServiceInterfaceMockRecorder) FuzzyMatchMerchant(input interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzyMatchMerchant", reflect.TypeOf((*MockCategoryServiceInterface)(nil).FuzzyMatchMerchant), input)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalTransferServiceInterface) EXPECT() *MockExternalTransferServiceInterfaceMockRecorder {
+	return m.recorder
 }
 
-// OverrideCategory mocks base method.
-func (m *MockCategoryServiceInterface) OverrideCategory(transaction *models.Transaction, newCategory, reason string) error {
+// GetTransfer mocks base method.
+func (m *MockExternalTransferServiceInterface) GetTransfer(id, userID uuid.UUID) (*models.ExternalTransfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OverrideCategory", transaction, newCategory, reason)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetTransfer", id, userID)
+	ret0, _ := ret[0].(*models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// OverrideCategory indicates an expected call of OverrideCategory.
-func (mr *MockCategoryServiceInterfaceMockRecorder) OverrideCategory(transaction, newCategory, reason interface{}) *gomock.Call {
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockExternalTransferServiceInterfaceMockRecorder) GetTransfer(id, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverrideCategory", reflect.TypeOf((*MockCategoryServiceInterface)(nil).OverrideCategory), transaction, newCategory, reason)
-}
-
-// MockCustomerProfileServiceInterface is a mock of CustomerProfileServiceInterface interface.
-type MockCustomerProfileServiceInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockCustomerProfileServiceInterfaceMockRecorder
-}
-
-// MockCustomerProfileServiceInterfaceMockRecorder is the mock recorder for MockCustomerProfileServiceInterface.
-type MockCustomerProfileServiceInterfaceMockRecorder struct {
-	mock *MockCustomerProfileServiceInterface
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockExternalTransferServiceInterface)(nil).GetTransfer), id, userID)
 }
 
-// NewMockCustomerProfileServiceInterface creates a new mock instance.
-func NewMockCustomerProfileServiceInterface(ctrl *gomock.Controller) *MockCustomerProfileServiceInterface {
-	mock := &MockCustomerProfileServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockCustomerProfileServiceInterfaceMockRecorder{mock}
-	return mock
+// InitiateTransfer mocks base method.
+func (m *MockExternalTransferServiceInterface) InitiateTransfer(ctx context.Context, userID, accountID uuid.UUID, req *dto.CreateExternalTransferRequest, idempotencyKey string) (*models.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitiateTransfer", ctx, userID, accountID, req, idempotencyKey)
+	ret0, _ := ret[0].(*models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCustomerProfileServiceInterface) EXPECT() *MockCustomerProfileServiceInterfaceMockRecorder {
-	return m.recorder
+// InitiateTransfer indicates an expected call of InitiateTransfer.
+func (mr *MockExternalTransferServiceInterfaceMockRecorder) InitiateTransfer(ctx, userID, accountID, req, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateTransfer", reflect.TypeOf((*MockExternalTransferServiceInterface)(nil).InitiateTransfer), ctx, userID, accountID, req, idempotencyKey)
 }
 
-// CreateCustomer mocks base method.
-func (m *MockCustomerProfileServiceInterface) CreateCustomer(email, firstName, lastName, role string) (*models.User, string, error) {
+// ListTransfers mocks base method.
+func (m *MockExternalTransferServiceInterface) ListTransfers(userID uuid.UUID, offset, limit int) ([]models.ExternalTransfer, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCustomer", email, firstName, lastName, role)
-	ret0, _ := ret[0].(*models.User)
-	ret1, _ := ret[1].(string)
+	ret := m.ctrl.Call(m, "ListTransfers", userID, offset, limit)
+	ret0, _ := ret[0].([]models.ExternalTransfer)
+	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// CreateCustomer indicates an expected call of CreateCustomer.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) CreateCustomer(email, firstName, lastName, role interface{}) *gomock.Call {
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockExternalTransferServiceInterfaceMockRecorder) ListTransfers(userID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).CreateCustomer), email, firstName, lastName, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockExternalTransferServiceInterface)(nil).ListTransfers), userID, offset, limit)
 }
 
-// DeleteCustomer mocks base method.
-func (m *MockCustomerProfileServiceInterface) DeleteCustomer(customerID uuid.UUID, reason string) error {
+// ReturnTransfer mocks base method.
+func (m *MockExternalTransferServiceInterface) ReturnTransfer(id uuid.UUID, req *dto.ReturnExternalTransferRequest) (*models.ExternalTransfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCustomer", customerID, reason)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReturnTransfer", id, req)
+	ret0, _ := ret[0].(*models.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteCustomer indicates an expected call of DeleteCustomer.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) DeleteCustomer(customerID, reason interface{}) *gomock.Call {
+// ReturnTransfer indicates an expected call of ReturnTransfer.
+func (mr *MockExternalTransferServiceInterfaceMockRecorder) ReturnTransfer(id, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomer", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).DeleteCustomer), customerID, reason)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnTransfer", reflect.TypeOf((*MockExternalTransferServiceInterface)(nil).ReturnTransfer), id, req)
 }
 
-// GetCustomerProfile mocks base method.
-func (m *MockCustomerProfileServiceInterface) GetCustomerProfile(customerID uuid.UUID) (*models.User, error) {
+// SettleDue mocks base method.
+func (m *MockExternalTransferServiceInterface) SettleDue(ctx context.Context) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomerProfile", customerID)
-	ret0, _ := ret[0].(*models.User)
+	ret := m.ctrl.Call(m, "SettleDue", ctx)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCustomerProfile indicates an expected call of GetCustomerProfile.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) GetCustomerProfile(customerID interface{}) *gomock.Call {
+// SettleDue indicates an expected call of SettleDue.
+func (mr *MockExternalTransferServiceInterfaceMockRecorder) SettleDue(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerProfile", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).GetCustomerProfile), customerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleDue", reflect.TypeOf((*MockExternalTransferServiceInterface)(nil).SettleDue), ctx)
 }
 
-// UpdateCustomerEmail mocks base method.
-func (m *MockCustomerProfileServiceInterface) UpdateCustomerEmail(customerID uuid.UUID, newEmail string) error {
+// StartWorker mocks base method.
+func (m *MockExternalTransferServiceInterface) StartWorker(ctx context.Context, pollInterval time.Duration) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCustomerEmail", customerID, newEmail)
-	ret0, _ := ret[0].(error)
-	return ret0
+	m.ctrl.Call(m, "StartWorker", ctx, pollInterval)
 }
 
-// UpdateCustomerEmail indicates an expected call of UpdateCustomerEmail.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) UpdateCustomerEmail(customerID, newEmail interface{}) *gomock.Call {
+// StartWorker indicates an expected call of StartWorker.
+func (mr *MockExternalTransferServiceInterfaceMockRecorder) StartWorker(ctx, pollInterval interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerEmail", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).UpdateCustomerEmail), customerID, newEmail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorker", reflect.TypeOf((*MockExternalTransferServiceInterface)(nil).StartWorker), ctx, pollInterval)
 }
 
-// UpdateCustomerProfile mocks base method.
-func (m *MockCustomerProfileServiceInterface) UpdateCustomerProfile(customerID uuid.UUID, updates map[string]interface{}) error {
+// SubmitPending mocks base method.
+func (m *MockExternalTransferServiceInterface) SubmitPending(ctx context.Context) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCustomerProfile", customerID, updates)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SubmitPending", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateCustomerProfile indicates an expected call of UpdateCustomerProfile.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) UpdateCustomerProfile(customerID, updates interface{}) *gomock.Call {
+// SubmitPending indicates an expected call of SubmitPending.
+func (mr *MockExternalTransferServiceInterfaceMockRecorder) SubmitPending(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerProfile", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).UpdateCustomerProfile), customerID, updates)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitPending", reflect.TypeOf((*MockExternalTransferServiceInterface)(nil).SubmitPending), ctx)
 }
 
-// MockCustomerSearchServiceInterface is a mock of CustomerSearchServiceInterface interface.
-type MockCustomerSearchServiceInterface struct {
+// MockEventPublisher is a mock of EventPublisher interface.
+type MockEventPublisher struct {
 	ctrl     *gomock.Controller
-	recorder *MockCustomerSearchServiceInterfaceMockRecorder
+	recorder *MockEventPublisherMockRecorder
 }
 
-// MockCustomerSearchServiceInterfaceMockRecorder is the mock recorder for MockCustomerSearchServiceInterface.
-type MockCustomerSearchServiceInterfaceMockRecorder struct {
-	mock *MockCustomerSearchServiceInterface
+// MockEventPublisherMockRecorder is the mock recorder for MockEventPublisher.
+type MockEventPublisherMockRecorder struct {
+	mock *MockEventPublisher
 }
 
-// NewMockCustomerSearchServiceInterface creates a new mock instance.
-func NewMockCustomerSearchServiceInterface(ctrl *gomock.Controller) *MockCustomerSearchServiceInterface {
-	mock := &MockCustomerSearchServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockCustomerSearchServiceInterfaceMockRecorder{mock}
+// NewMockEventPublisher creates a new mock instance.
+func NewMockEventPublisher(ctrl *gomock.Controller) *MockEventPublisher {
+	mock := &MockEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockEventPublisherMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCustomerSearchServiceInterface) EXPECT() *MockCustomerSearchServiceInterfaceMockRecorder {
+func (m *MockEventPublisher) EXPECT() *MockEventPublisherMockRecorder {
 	return m.recorder
 }
 
-// SearchCustomers mocks base method.
-func (m *MockCustomerSearchServiceInterface) SearchCustomers(query string, searchType models.SearchType, offset, limit int) ([]*models.CustomerSearchResult, int64, error) {
+// Publish mocks base method.
+func (m *MockEventPublisher) Publish(eventType string, userID uuid.UUID, data interface{}) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchCustomers", query, searchType, offset, limit)
-	ret0, _ := ret[0].([]*models.CustomerSearchResult)
-	ret1, _ := ret[1].(int64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	m.ctrl.Call(m, "Publish", eventType, userID, data)
 }
 
-// SearchCustomers indicates an expected call of SearchCustomers.
-func (mr *MockCustomerSearchServiceInterfaceMockRecorder) SearchCustomers(query, searchType, offset, limit interface{}) *gomock.Call {
+// Publish indicates an expected call of Publish.
+func (mr *MockEventPublisherMockRecorder) Publish(eventType, userID, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomers", reflect.TypeOf((*MockCustomerSearchServiceInterface)(nil).SearchCustomers), query, searchType, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventPublisher)(nil).Publish), eventType, userID, data)
 }
 
-// MockAccountMetricsServiceInterface is a mock of AccountMetricsServiceInterface interface.
-type MockAccountMetricsServiceInterface struct {
+// MockWebhookServiceInterface is a mock of WebhookServiceInterface interface.
+type MockWebhookServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockAccountMetricsServiceInterfaceMockRecorder
+	recorder *MockWebhookServiceInterfaceMockRecorder
 }
 
-// MockAccountMetricsServiceInterfaceMockRecorder is the mock recorder for MockAccountMetricsServiceInterface.
-type MockAccountMetricsServiceInterfaceMockRecorder struct {
-	mock *MockAccountMetricsServiceInterface
+// MockWebhookServiceInterfaceMockRecorder is the mock recorder for MockWebhookServiceInterface.
+type MockWebhookServiceInterfaceMockRecorder struct {
+	mock *MockWebhookServiceInterface
 }
 
-// NewMockAccountMetricsServiceInterface creates a new mock instance.
-func NewMockAccountMetricsServiceInterface(ctrl *gomock.Controller) *MockAccountMetricsServiceInterface {
-	mock := &MockAccountMetricsServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockAccountMetricsServiceInterfaceMockRecorder{mock}
+// NewMockWebhookServiceInterface creates a new mock instance.
+func NewMockWebhookServiceInterface(ctrl *gomock.Controller) *MockWebhookServiceInterface {
+	mock := &MockWebhookServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAccountMetricsServiceInterface) EXPECT() *MockAccountMetricsServiceInterfaceMockRecorder {
+func (m *MockWebhookServiceInterface) EXPECT() *MockWebhookServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// GetAccountMetrics mocks base method.
-func (m *MockAccountMetricsServiceInterface) GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error) {
+// CreateSubscription mocks base method.
+func (m *MockWebhookServiceInterface) CreateSubscription(userID uuid.UUID, allCustomers bool, req *dto.CreateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionCreatedResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountMetrics", requestorID, accountID, startDate, endDate, isAdmin)
-	ret0, _ := ret[0].(*models.AccountMetrics)
+	ret := m.ctrl.Call(m, "CreateSubscription", userID, allCustomers, req)
+	ret0, _ := ret[0].(*dto.WebhookSubscriptionCreatedResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountMetrics indicates an expected call of GetAccountMetrics.
-func (mr *MockAccountMetricsServiceInterfaceMockRecorder) GetAccountMetrics(requestorID, accountID, startDate, endDate, isAdmin interface{}) *gomock.Call {
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockWebhookServiceInterfaceMockRecorder) CreateSubscription(userID, allCustomers, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountMetrics", reflect.TypeOf((*MockAccountMetricsServiceInterface)(nil).GetAccountMetrics), requestorID, accountID, startDate, endDate, isAdmin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookServiceInterface)(nil).CreateSubscription), userID, allCustomers, req)
 }
 
-// GetUserAggregateMetrics mocks base method.
-func (m *MockAccountMetricsServiceInterface) GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error) {
+// DeleteSubscription mocks base method.
+func (m *MockWebhookServiceInterface) DeleteSubscription(id, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserAggregateMetrics", requestorID, targetUserID, startDate, endDate, isAdmin)
-	ret0, _ := ret[0].(*models.UserAggregateMetrics)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteSubscription", id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetUserAggregateMetrics indicates an expected call of GetUserAggregateMetrics.
-func (mr *MockAccountMetricsServiceInterfaceMockRecorder) GetUserAggregateMetrics(requestorID, targetUserID, startDate, endDate, isAdmin interface{}) *gomock.Call {
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookServiceInterfaceMockRecorder) DeleteSubscription(id, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAggregateMetrics", reflect.TypeOf((*MockAccountMetricsServiceInterface)(nil).GetUserAggregateMetrics), requestorID, targetUserID, startDate, endDate, isAdmin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookServiceInterface)(nil).DeleteSubscription), id, userID)
 }
 
-// MockMetricsRecorderInterface is a mock of MetricsRecorderInterface interface.
-type MockMetricsRecorderInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockMetricsRecorderInterfaceMockRecorder
+// DeliverDue mocks base method.
+func (m *MockWebhookServiceInterface) DeliverDue(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverDue", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockMetricsRecorderInterfaceMockRecorder is the mock recorder for MockMetricsRecorderInterface.
-type MockMetricsRecorderInterfaceMockRecorder struct {
-	mock *MockMetricsRecorderInterface
+// DeliverDue indicates an expected call of DeliverDue.
+func (mr *MockWebhookServiceInterfaceMockRecorder) DeliverDue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverDue", reflect.TypeOf((*MockWebhookServiceInterface)(nil).DeliverDue), ctx)
 }
 
-// NewMockMetricsRecorderInterface creates a new mock instance.
-func NewMockMetricsRecorderInterface(ctrl *gomock.Controller) *MockMetricsRecorderInterface {
-	mock := &MockMetricsRecorderInterface{ctrl: ctrl}
-	mock.recorder = &MockMetricsRecorderInterfaceMockRecorder{mock}
-	return mock
+// GetSubscription mocks base method.
+func (m *MockWebhookServiceInterface) GetSubscription(id, userID uuid.UUID) (*models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", id, userID)
+	ret0, _ := ret[0].(*models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockMetricsRecorderInterface) EXPECT() *MockMetricsRecorderInterfaceMockRecorder {
-	return m.recorder
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockWebhookServiceInterfaceMockRecorder) GetSubscription(id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockWebhookServiceInterface)(nil).GetSubscription), id, userID)
 }
 
-// IncrementCounter mocks base method.
-func (m *MockMetricsRecorderInterface) IncrementCounter(name string, tags map[string]string) {
+// ListDeliveries mocks base method.
+func (m *MockWebhookServiceInterface) ListDeliveries(subscriptionID, userID uuid.UUID, status string, offset, limit int) ([]models.WebhookDelivery, int64, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "IncrementCounter", name, tags)
+	ret := m.ctrl.Call(m, "ListDeliveries", subscriptionID, userID, status, offset, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// IncrementCounter indicates an expected call of IncrementCounter.
-func (mr *MockMetricsRecorderInterfaceMockRecorder) IncrementCounter(name, tags interface{}) *gomock.Call {
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookServiceInterfaceMockRecorder) ListDeliveries(subscriptionID, userID, status, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCounter", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).IncrementCounter), name, tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookServiceInterface)(nil).ListDeliveries), subscriptionID, userID, status, offset, limit)
 }
 
-// RecordGauge mocks base method.
-func (m *MockMetricsRecorderInterface) RecordGauge(name string, value float64, tags map[string]string) {
+// ListSubscriptions mocks base method.
+func (m *MockWebhookServiceInterface) ListSubscriptions(userID uuid.UUID, offset, limit int) ([]models.WebhookSubscription, int64, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RecordGauge", name, value, tags)
+	ret := m.ctrl.Call(m, "ListSubscriptions", userID, offset, limit)
+	ret0, _ := ret[0].([]models.WebhookSubscription)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// RecordGauge indicates an expected call of RecordGauge.
-func (mr *MockMetricsRecorderInterfaceMockRecorder) RecordGauge(name, value, tags interface{}) *gomock.Call {
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockWebhookServiceInterfaceMockRecorder) ListSubscriptions(userID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGauge", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).RecordGauge), name, value, tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockWebhookServiceInterface)(nil).ListSubscriptions), userID, offset, limit)
 }
 
-// RecordProcessingTime mocks base method.
-func (m *MockMetricsRecorderInterface) RecordProcessingTime(name string, duration time.Duration) {
+// Publish mocks base method.
+func (m *MockWebhookServiceInterface) Publish(eventType string, userID uuid.UUID, data interface{}) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RecordProcessingTime", name, duration)
+	m.ctrl.Call(m, "Publish", eventType, userID, data)
 }
 
-// RecordProcessingTime indicates an expected call of RecordProcessingTime.
-func (mr *MockMetricsRecorderInterfaceMockRecorder) RecordProcessingTime(name, duration interface{}) *gomock.Call {
+// Publish indicates an expected call of Publish.
+func (mr *MockWebhookServiceInterfaceMockRecorder) Publish(eventType, userID, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordProcessingTime", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).RecordProcessingTime), name, duration)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockWebhookServiceInterface)(nil).Publish), eventType, userID, data)
 }
 
-// MockStatementServiceInterface is a mock of StatementServiceInterface interface.
-type MockStatementServiceInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockStatementServiceInterfaceMockRecorder
+// Redeliver mocks base method.
+func (m *MockWebhookServiceInterface) Redeliver(subscriptionID, deliveryID, userID uuid.UUID) (*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", subscriptionID, deliveryID, userID)
+	ret0, _ := ret[0].(*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockStatementServiceInterfaceMockRecorder is the mock recorder for MockStatementServiceInterface.
-type MockStatementServiceInterfaceMockRecorder struct {
-	mock *MockStatementServiceInterface
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockWebhookServiceInterfaceMockRecorder) Redeliver(subscriptionID, deliveryID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockWebhookServiceInterface)(nil).Redeliver), subscriptionID, deliveryID, userID)
 }
 
-// NewMockStatementServiceInterface creates a new mock instance.
-func NewMockStatementServiceInterface(ctrl *gomock.Controller) *MockStatementServiceInterface {
-	mock := &MockStatementServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockStatementServiceInterfaceMockRecorder{mock}
-	return mock
+// StartWorker mocks base method.
+func (m *MockWebhookServiceInterface) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartWorker", ctx, pollInterval)
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockStatementServiceInterface) EXPECT() *MockStatementServiceInterfaceMockRecorder {
-	return m.recorder
+// StartWorker indicates an expected call of StartWorker.
+func (mr *MockWebhookServiceInterfaceMockRecorder) StartWorker(ctx, pollInterval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorker", reflect.TypeOf((*MockWebhookServiceInterface)(nil).StartWorker), ctx, pollInterval)
 }
 
-// GenerateStatement mocks base method.
-func (m *MockStatementServiceInterface) GenerateStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, isAdmin bool) (*models.AccountStatement, error) {
+// UpdateSubscription mocks base method.
+func (m *MockWebhookServiceInterface) UpdateSubscription(id, userID uuid.UUID, req *dto.UpdateWebhookSubscriptionRequest) (*models.WebhookSubscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateStatement", requestorID, accountID, periodType, year, period, isAdmin)
-	ret0, _ := ret[0].(*models.AccountStatement)
+	ret := m.ctrl.Call(m, "UpdateSubscription", id, userID, req)
+	ret0, _ := ret[0].(*models.WebhookSubscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenerateStatement indicates an expected call of GenerateStatement.
-func (mr *MockStatementServiceInterfaceMockRecorder) GenerateStatement(requestorID, accountID, periodType, year, period, isAdmin interface{}) *gomock.Call {
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookServiceInterfaceMockRecorder) UpdateSubscription(id, userID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStatement", reflect.TypeOf((*MockStatementServiceInterface)(nil).GenerateStatement), requestorID, accountID, periodType, year, period, isAdmin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookServiceInterface)(nil).UpdateSubscription), id, userID, req)
 }
 
-// MockTransactionGeneratorInterface is a mock of TransactionGeneratorInterface interface.
-type MockTransactionGeneratorInterface struct {
+// MockTransactionLimitChecker is a mock of TransactionLimitChecker interface.
+type MockTransactionLimitChecker struct {
 	ctrl     *gomock.Controller
-	recorder *MockTransactionGeneratorInterfaceMockRecorder
+	recorder *MockTransactionLimitCheckerMockRecorder
 }
 
-// MockTransactionGeneratorInterfaceMockRecorder is the mock recorder for MockTransactionGeneratorInterface.
-type MockTransactionGeneratorInterfaceMockRecorder struct {
-	mock *MockTransactionGeneratorInterface
+// MockTransactionLimitCheckerMockRecorder is the mock recorder for MockTransactionLimitChecker.
+type MockTransactionLimitCheckerMockRecorder struct {
+	mock *MockTransactionLimitChecker
 }
 
-// NewMockTransactionGeneratorInterface creates a new mock instance.
-func NewMockTransactionGeneratorInterface(ctrl *gomock.Controller) *MockTransactionGeneratorInterface {
-	mock := &MockTransactionGeneratorInterface{ctrl: ctrl}
-	mock.recorder = &MockTransactionGeneratorInterfaceMockRecorder{mock}
+// NewMockTransactionLimitChecker creates a new mock instance.
+func NewMockTransactionLimitChecker(ctrl *gomock.Controller) *MockTransactionLimitChecker {
+	mock := &MockTransactionLimitChecker{ctrl: ctrl}
+	mock.recorder = &MockTransactionLimitCheckerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTransactionGeneratorInterface) EXPECT() *MockTransactionGeneratorInterfaceMockRecorder {
+func (m *MockTransactionLimitChecker) EXPECT() *MockTransactionLimitCheckerMockRecorder {
 	return m.recorder
 }
 
-// GenerateAmount mocks base method.
-func (m *MockTransactionGeneratorInterface) GenerateAmount(category string) decimal.Decimal {
+// CheckLimits mocks base method.
+func (m *MockTransactionLimitChecker) CheckLimits(account *models.Account, amount decimal.Decimal, activity string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAmount", category)
-	ret0, _ := ret[0].(decimal.Decimal)
+	ret := m.ctrl.Call(m, "CheckLimits", account, amount, activity)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GenerateAmount indicates an expected call of GenerateAmount.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateAmount(category interface{}) *gomock.Call {
+// CheckLimits indicates an expected call of CheckLimits.
+func (mr *MockTransactionLimitCheckerMockRecorder) CheckLimits(account, amount, activity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAmount", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateAmount), category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLimits", reflect.TypeOf((*MockTransactionLimitChecker)(nil).CheckLimits), account, amount, activity)
 }
 
-// GenerateBillTransactions mocks base method.
-func (m *MockTransactionGeneratorInterface) GenerateBillTransactions(accountID uuid.UUID, startDate, endDate time.Time, startingBalance decimal.Decimal) []*models.Transaction {
+// CheckLimitsWithin mocks base method.
+func (m *MockTransactionLimitChecker) CheckLimitsWithin(usage repositories.LimitUsageReader, account *models.Account, amount decimal.Decimal, activity string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateBillTransactions", accountID, startDate, endDate, startingBalance)
-	ret0, _ := ret[0].([]*models.Transaction)
+	ret := m.ctrl.Call(m, "CheckLimitsWithin", usage, account, amount, activity)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GenerateBillTransactions indicates an expected call of GenerateBillTransactions.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateBillTransactions(accountID, startDate, endDate, startingBalance interface{}) *gomock.Call {
+// CheckLimitsWithin indicates an expected call of CheckLimitsWithin.
+func (mr *MockTransactionLimitCheckerMockRecorder) CheckLimitsWithin(usage, account, amount, activity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateBillTransactions", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateBillTransactions), accountID, startDate, endDate, startingBalance)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLimitsWithin", reflect.TypeOf((*MockTransactionLimitChecker)(nil).CheckLimitsWithin), usage, account, amount, activity)
 }
 
-// GenerateDailyPurchases mocks base method.
-func (m *MockTransactionGeneratorInterface) GenerateDailyPurchases(accountID uuid.UUID, startDate, endDate time.Time, startingBalance decimal.Decimal) []*models.Transaction {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateDailyPurchases", accountID, startDate, endDate, startingBalance)
-	ret0, _ := ret[0].([]*models.Transaction)
-	return ret0
+// MockLimitServiceInterface is a mock of LimitServiceInterface interface.
+type MockLimitServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockLimitServiceInterfaceMockRecorder
 }
 
-// GenerateDailyPurchases indicates an expected call of GenerateDailyPurchases.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateDailyPurchases(accountID, startDate, endDate, startingBalance interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDailyPurchases", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateDailyPurchases), accountID, startDate, endDate, startingBalance)
+// MockLimitServiceInterfaceMockRecorder is the mock recorder for MockLimitServiceInterface.
+type MockLimitServiceInterfaceMockRecorder struct {
+	mock *MockLimitServiceInterface
 }
 
-// GenerateFeeAmount mocks base method.
-func (m *MockTransactionGeneratorInterface) GenerateFeeAmount() decimal.Decimal {
+// NewMockLimitServiceInterface creates a new mock instance.
+func NewMockLimitServiceInterface(ctrl *gomock.Controller) *MockLimitServiceInterface {
+	mock := &MockLimitServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockLimitServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLimitServiceInterface) EXPECT() *MockLimitServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CheckLimits mocks base method.
+func (m *MockLimitServiceInterface) CheckLimits(account *models.Account, amount decimal.Decimal, activity string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateFeeAmount")
-	ret0, _ := ret[0].(decimal.Decimal)
+	ret := m.ctrl.Call(m, "CheckLimits", account, amount, activity)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GenerateFeeAmount indicates an expected call of GenerateFeeAmount.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateFeeAmount() *gomock.Call {
+// CheckLimits indicates an expected call of CheckLimits.
+func (mr *MockLimitServiceInterfaceMockRecorder) CheckLimits(account, amount, activity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateFeeAmount", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateFeeAmount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLimits", reflect.TypeOf((*MockLimitServiceInterface)(nil).CheckLimits), account, amount, activity)
 }
 
-// GenerateHistoricalTransactions mocks base method.
-func (m *MockTransactionGeneratorInterface) GenerateHistoricalTransactions(accountID uuid.UUID, startDate, endDate time.Time, startingBalance decimal.Decimal, count int) []*models.Transaction {
+// CheckLimitsWithin mocks base method.
+func (m *MockLimitServiceInterface) CheckLimitsWithin(usage repositories.LimitUsageReader, account *models.Account, amount decimal.Decimal, activity string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateHistoricalTransactions", accountID, startDate, endDate, startingBalance, count)
-	ret0, _ := ret[0].([]*models.Transaction)
+	ret := m.ctrl.Call(m, "CheckLimitsWithin", usage, account, amount, activity)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GenerateHistoricalTransactions indicates an expected call of GenerateHistoricalTransactions.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateHistoricalTransactions(accountID, startDate, endDate, startingBalance, count interface{}) *gomock.Call {
+// CheckLimitsWithin indicates an expected call of CheckLimitsWithin.
+func (mr *MockLimitServiceInterfaceMockRecorder) CheckLimitsWithin(usage, account, amount, activity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHistoricalTransactions", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateHistoricalTransactions), accountID, startDate, endDate, startingBalance, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLimitsWithin", reflect.TypeOf((*MockLimitServiceInterface)(nil).CheckLimitsWithin), usage, account, amount, activity)
 }
 
-// GenerateSalaryTransactions mocks base method.
-func (m *MockTransactionGeneratorInterface) GenerateSalaryTransactions(accountID uuid.UUID, startDate, endDate time.Time, startingBalance decimal.Decimal) []*models.Transaction {
+// DeleteUserLimits mocks base method.
+func (m *MockLimitServiceInterface) DeleteUserLimits(userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateSalaryTransactions", accountID, startDate, endDate, startingBalance)
-	ret0, _ := ret[0].([]*models.Transaction)
+	ret := m.ctrl.Call(m, "DeleteUserLimits", userID)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GenerateSalaryTransactions indicates an expected call of GenerateSalaryTransactions.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateSalaryTransactions(accountID, startDate, endDate, startingBalance interface{}) *gomock.Call {
+// DeleteUserLimits indicates an expected call of DeleteUserLimits.
+func (mr *MockLimitServiceInterfaceMockRecorder) DeleteUserLimits(userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSalaryTransactions", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateSalaryTransactions), accountID, startDate, endDate, startingBalance)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserLimits", reflect.TypeOf((*MockLimitServiceInterface)(nil).DeleteUserLimits), userID)
 }
 
-// GenerateTimestamp mocks base method.
-func (m *MockTransactionGeneratorInterface) GenerateTimestamp(startDate, endDate time.Time) time.Time {
+// GetAccountLimits mocks base method.
+func (m *MockLimitServiceInterface) GetAccountLimits(requestorID, accountID uuid.UUID, canViewAll bool) (*models.AccountLimits, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateTimestamp", startDate, endDate)
-	ret0, _ := ret[0].(time.Time)
-	return ret0
+	ret := m.ctrl.Call(m, "GetAccountLimits", requestorID, accountID, canViewAll)
+	ret0, _ := ret[0].(*models.AccountLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GenerateTimestamp indicates an expected call of GenerateTimestamp.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateTimestamp(startDate, endDate interface{}) *gomock.Call {
+// GetAccountLimits indicates an expected call of GetAccountLimits.
+func (mr *MockLimitServiceInterfaceMockRecorder) GetAccountLimits(requestorID, accountID, canViewAll interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTimestamp", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateTimestamp), startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountLimits", reflect.TypeOf((*MockLimitServiceInterface)(nil).GetAccountLimits), requestorID, accountID, canViewAll)
 }
 
-// GenerateTransactionType mocks base method.
-func (m *MockTransactionGeneratorInterface) GenerateTransactionType() (string, bool) {
+// GetUserLimits mocks base method.
+func (m *MockLimitServiceInterface) GetUserLimits(userID uuid.UUID) (*models.TransactionLimit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateTransactionType")
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(bool)
+	ret := m.ctrl.Call(m, "GetUserLimits", userID)
+	ret0, _ := ret[0].(*models.TransactionLimit)
+	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenerateTransactionType indicates an expected call of GenerateTransactionType.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateTransactionType() *gomock.Call {
+// GetUserLimits indicates an expected call of GetUserLimits.
+func (mr *MockLimitServiceInterfaceMockRecorder) GetUserLimits(userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTransactionType", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateTransactionType))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserLimits", reflect.TypeOf((*MockLimitServiceInterface)(nil).GetUserLimits), userID)
 }
 
-// GetMerchantPool mocks base method.
-func (m *MockTransactionGeneratorInterface) GetMerchantPool() []models.MerchantInfo {
+// GrantOverride mocks base method.
+func (m *MockLimitServiceInterface) GrantOverride(accountID uuid.UUID, req *dto.GrantLimitOverrideRequest, adminID uuid.UUID) (*models.LimitOverride, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMerchantPool")
-	ret0, _ := ret[0].([]models.MerchantInfo)
-	return ret0
+	ret := m.ctrl.Call(m, "GrantOverride", accountID, req, adminID)
+	ret0, _ := ret[0].(*models.LimitOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetMerchantPool indicates an expected call of GetMerchantPool.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) GetMerchantPool() *gomock.Call {
+// GrantOverride indicates an expected call of GrantOverride.
+func (mr *MockLimitServiceInterfaceMockRecorder) GrantOverride(accountID, req, adminID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMerchantPool", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GetMerchantPool))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantOverride", reflect.TypeOf((*MockLimitServiceInterface)(nil).GrantOverride), accountID, req, adminID)
 }
 
-// SelectRandomMerchant mocks base method.
-func (m *MockTransactionGeneratorInterface) SelectRandomMerchant() models.MerchantInfo {
+// ListAccountTypeLimits mocks base method.
+func (m *MockLimitServiceInterface) ListAccountTypeLimits() ([]models.TransactionLimit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SelectRandomMerchant")
-	ret0, _ := ret[0].(models.MerchantInfo)
-	return ret0
+	ret := m.ctrl.Call(m, "ListAccountTypeLimits")
+	ret0, _ := ret[0].([]models.TransactionLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SelectRandomMerchant indicates an expected call of SelectRandomMerchant.
-func (mr *MockTransactionGeneratorInterfaceMockRecorder) SelectRandomMerchant() *gomock.Call {
+// ListAccountTypeLimits indicates an expected call of ListAccountTypeLimits.
+func (mr *MockLimitServiceInterfaceMockRecorder) ListAccountTypeLimits() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectRandomMerchant", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).SelectRandomMerchant))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountTypeLimits", reflect.TypeOf((*MockLimitServiceInterface)(nil).ListAccountTypeLimits))
 }
 
-// MockAuthServiceInterface is a mock of AuthServiceInterface interface.
-type MockAuthServiceInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockAuthServiceInterfaceMockRecorder
+// RevokeOverride mocks base method.
+func (m *MockLimitServiceInterface) RevokeOverride(overrideID, adminID uuid.UUID) (*models.LimitOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOverride", overrideID, adminID)
+	ret0, _ := ret[0].(*models.LimitOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockAuthServiceInterfaceMockRecorder is the mock recorder for MockAuthServiceInterface.
-type MockAuthServiceInterfaceMockRecorder struct {
-	mock *MockAuthServiceInterface
+// RevokeOverride indicates an expected call of RevokeOverride.
+func (mr *MockLimitServiceInterfaceMockRecorder) RevokeOverride(overrideID, adminID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOverride", reflect.TypeOf((*MockLimitServiceInterface)(nil).RevokeOverride), overrideID, adminID)
 }
 
-// NewMockAuthServiceInterface creates a new mock instance.
-func NewMockAuthServiceInterface(ctrl *gomock.Controller) *MockAuthServiceInterface {
-	mock := &MockAuthServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockAuthServiceInterfaceMockRecorder{mock}
-	return mock
+// SetAccountTypeLimits mocks base method.
+func (m *MockLimitServiceInterface) SetAccountTypeLimits(accountType string, req *dto.SetTransactionLimitsRequest, adminID uuid.UUID) (*models.TransactionLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountTypeLimits", accountType, req, adminID)
+	ret0, _ := ret[0].(*models.TransactionLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAuthServiceInterface) EXPECT() *MockAuthServiceInterfaceMockRecorder {
-	return m.recorder
+// SetAccountTypeLimits indicates an expected call of SetAccountTypeLimits.
+func (mr *MockLimitServiceInterfaceMockRecorder) SetAccountTypeLimits(accountType, req, adminID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountTypeLimits", reflect.TypeOf((*MockLimitServiceInterface)(nil).SetAccountTypeLimits), accountType, req, adminID)
 }
 
-// Login mocks base method.
-func (m *MockAuthServiceInterface) Login(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+// SetUserLimits mocks base method.
+func (m *MockLimitServiceInterface) SetUserLimits(userID uuid.UUID, req *dto.SetTransactionLimitsRequest, adminID uuid.UUID) (*models.TransactionLimit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", req, ipAddress, userAgent)
-	ret0, _ := ret[0].(*dto.TokenResponse)
+	ret := m.ctrl.Call(m, "SetUserLimits", userID, req, adminID)
+	ret0, _ := ret[0].(*models.TransactionLimit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Login indicates an expected call of Login.
-func (mr *MockAuthServiceInterfaceMockRecorder) Login(req, ipAddress, userAgent interface{}) *gomock.Call {
+// SetUserLimits indicates an expected call of SetUserLimits.
+func (mr *MockLimitServiceInterfaceMockRecorder) SetUserLimits(userID, req, adminID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceInterface)(nil).Login), req, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserLimits", reflect.TypeOf((*MockLimitServiceInterface)(nil).SetUserLimits), userID, req, adminID)
 }
 
-// Logout mocks base method.
-func (m *MockAuthServiceInterface) Logout(accessToken, ipAddress, userAgent string) error {
+// MockTransactionScreener is a mock of TransactionScreener interface.
+type MockTransactionScreener struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionScreenerMockRecorder
+}
+
+// MockTransactionScreenerMockRecorder is the mock recorder for MockTransactionScreener.
+type MockTransactionScreenerMockRecorder struct {
+	mock *MockTransactionScreener
+}
+
+// NewMockTransactionScreener creates a new mock instance.
+func NewMockTransactionScreener(ctrl *gomock.Controller) *MockTransactionScreener {
+	mock := &MockTransactionScreener{ctrl: ctrl}
+	mock.recorder = &MockTransactionScreenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionScreener) EXPECT() *MockTransactionScreenerMockRecorder {
+	return m.recorder
+}
+
+// HoldExternalTransfer mocks base method.
+func (m *MockTransactionScreener) HoldExternalTransfer(transfer *models.ExternalTransfer, rules models.JSONBMap) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Logout", accessToken, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "HoldExternalTransfer", transfer, rules)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Logout indicates an expected call of Logout.
-func (mr *MockAuthServiceInterfaceMockRecorder) Logout(accessToken, ipAddress, userAgent interface{}) *gomock.Call {
+// HoldExternalTransfer indicates an expected call of HoldExternalTransfer.
+func (mr *MockTransactionScreenerMockRecorder) HoldExternalTransfer(transfer, rules interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceInterface)(nil).Logout), accessToken, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldExternalTransfer", reflect.TypeOf((*MockTransactionScreener)(nil).HoldExternalTransfer), transfer, rules)
 }
 
-// RefreshTokens mocks base method.
-func (m *MockAuthServiceInterface) RefreshTokens(refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+// ScreenDebit mocks base method.
+func (m *MockTransactionScreener) ScreenDebit(account *models.Account, amount decimal.Decimal, description string) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshTokens", refreshToken, ipAddress, userAgent)
-	ret0, _ := ret[0].(*dto.TokenResponse)
+	ret := m.ctrl.Call(m, "ScreenDebit", account, amount, description)
+	ret0, _ := ret[0].(*models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RefreshTokens indicates an expected call of RefreshTokens.
-func (mr *MockAuthServiceInterfaceMockRecorder) RefreshTokens(refreshToken, ipAddress, userAgent interface{}) *gomock.Call {
+// ScreenDebit indicates an expected call of ScreenDebit.
+func (mr *MockTransactionScreenerMockRecorder) ScreenDebit(account, amount, description interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokens", reflect.TypeOf((*MockAuthServiceInterface)(nil).RefreshTokens), refreshToken, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScreenDebit", reflect.TypeOf((*MockTransactionScreener)(nil).ScreenDebit), account, amount, description)
 }
 
-// Register mocks base method.
-func (m *MockAuthServiceInterface) Register(req *dto.RegisterRequest, ipAddress, userAgent string) (*models.User, error) {
+// ScreenExternalTransfer mocks base method.
+func (m *MockTransactionScreener) ScreenExternalTransfer(account *models.Account, amount decimal.Decimal) (models.JSONBMap, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", req, ipAddress, userAgent)
-	ret0, _ := ret[0].(*models.User)
+	ret := m.ctrl.Call(m, "ScreenExternalTransfer", account, amount)
+	ret0, _ := ret[0].(models.JSONBMap)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Register indicates an expected call of Register.
-func (mr *MockAuthServiceInterfaceMockRecorder) Register(req, ipAddress, userAgent interface{}) *gomock.Call {
+// ScreenExternalTransfer indicates an expected call of ScreenExternalTransfer.
+func (mr *MockTransactionScreenerMockRecorder) ScreenExternalTransfer(account, amount interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthServiceInterface)(nil).Register), req, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScreenExternalTransfer", reflect.TypeOf((*MockTransactionScreener)(nil).ScreenExternalTransfer), account, amount)
 }
 
-// MockTokenServiceInterface is a mock of TokenServiceInterface interface.
-type MockTokenServiceInterface struct {
+// ScreenTransfer mocks base method.
+func (m *MockTransactionScreener) ScreenTransfer(transfer *models.Transfer, fromAccount, toAccount *models.Account) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScreenTransfer", transfer, fromAccount, toAccount)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScreenTransfer indicates an expected call of ScreenTransfer.
+func (mr *MockTransactionScreenerMockRecorder) ScreenTransfer(transfer, fromAccount, toAccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScreenTransfer", reflect.TypeOf((*MockTransactionScreener)(nil).ScreenTransfer), transfer, fromAccount, toAccount)
+}
+
+// MockFraudReviewServiceInterface is a mock of FraudReviewServiceInterface interface.
+type MockFraudReviewServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockTokenServiceInterfaceMockRecorder
+	recorder *MockFraudReviewServiceInterfaceMockRecorder
 }
 
-// MockTokenServiceInterfaceMockRecorder is the mock recorder for MockTokenServiceInterface.
-type MockTokenServiceInterfaceMockRecorder struct {
-	mock *MockTokenServiceInterface
+// MockFraudReviewServiceInterfaceMockRecorder is the mock recorder for MockFraudReviewServiceInterface.
+type MockFraudReviewServiceInterfaceMockRecorder struct {
+	mock *MockFraudReviewServiceInterface
 }
 
-// NewMockTokenServiceInterface creates a new mock instance.
-func NewMockTokenServiceInterface(ctrl *gomock.Controller) *MockTokenServiceInterface {
-	mock := &MockTokenServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockTokenServiceInterfaceMockRecorder{mock}
+// NewMockFraudReviewServiceInterface creates a new mock instance.
+func NewMockFraudReviewServiceInterface(ctrl *gomock.Controller) *MockFraudReviewServiceInterface {
+	mock := &MockFraudReviewServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockFraudReviewServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTokenServiceInterface) EXPECT() *MockTokenServiceInterfaceMockRecorder {
+func (m *MockFraudReviewServiceInterface) EXPECT() *MockFraudReviewServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// ExtractTokenFromHeader mocks base method.
-func (m *MockTokenServiceInterface) ExtractTokenFromHeader(authHeader string) (string, error) {
+// ApproveReview mocks base method.
+func (m *MockFraudReviewServiceInterface) ApproveReview(reviewID, adminID uuid.UUID, reason string) (*models.FraudReview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExtractTokenFromHeader", authHeader)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ApproveReview", reviewID, adminID, reason)
+	ret0, _ := ret[0].(*models.FraudReview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ExtractTokenFromHeader indicates an expected call of ExtractTokenFromHeader.
-func (mr *MockTokenServiceInterfaceMockRecorder) ExtractTokenFromHeader(authHeader interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTokenFromHeader", reflect.TypeOf((*MockTokenServiceInterface)(nil).ExtractTokenFromHeader), authHeader)
-}
-
-// GenerateAccessToken mocks base method.
-func (m *MockTokenServiceInterface) GenerateAccessToken(user *models.User) (string, time.Time, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAccessToken", user)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(time.Time)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
-}
-
-// GenerateAccessToken indicates an expected call of GenerateAccessToken.
-func (mr *MockTokenServiceInterfaceMockRecorder) GenerateAccessToken(user interface{}) *gomock.Call {
+// ApproveReview indicates an expected call of ApproveReview.
+func (mr *MockFraudReviewServiceInterfaceMockRecorder) ApproveReview(reviewID, adminID, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).GenerateAccessToken), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveReview", reflect.TypeOf((*MockFraudReviewServiceInterface)(nil).ApproveReview), reviewID, adminID, reason)
 }
 
-// GenerateRefreshToken mocks base method.
-func (m *MockTokenServiceInterface) GenerateRefreshToken(userID uuid.UUID) (string, time.Time, error) {
+// ExpireReviews mocks base method.
+func (m *MockFraudReviewServiceInterface) ExpireReviews() (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateRefreshToken", userID)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(time.Time)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ExpireReviews")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GenerateRefreshToken indicates an expected call of GenerateRefreshToken.
-func (mr *MockTokenServiceInterfaceMockRecorder) GenerateRefreshToken(userID interface{}) *gomock.Call {
+// ExpireReviews indicates an expected call of ExpireReviews.
+func (mr *MockFraudReviewServiceInterfaceMockRecorder) ExpireReviews() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).GenerateRefreshToken), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireReviews", reflect.TypeOf((*MockFraudReviewServiceInterface)(nil).ExpireReviews))
 }
 
-// GetJTI mocks base method.
-func (m *MockTokenServiceInterface) GetJTI(tokenString string) (string, error) {
+// GetReview mocks base method.
+func (m *MockFraudReviewServiceInterface) GetReview(reviewID uuid.UUID) (*models.FraudReview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetJTI", tokenString)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetReview", reviewID)
+	ret0, _ := ret[0].(*models.FraudReview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetJTI indicates an expected call of GetJTI.
-func (mr *MockTokenServiceInterfaceMockRecorder) GetJTI(tokenString interface{}) *gomock.Call {
+// GetReview indicates an expected call of GetReview.
+func (mr *MockFraudReviewServiceInterfaceMockRecorder) GetReview(reviewID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJTI", reflect.TypeOf((*MockTokenServiceInterface)(nil).GetJTI), tokenString)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockFraudReviewServiceInterface)(nil).GetReview), reviewID)
 }
 
-// GetTokenExpiry mocks base method.
-func (m *MockTokenServiceInterface) GetTokenExpiry(tokenString string) (time.Time, error) {
+// ListReviews mocks base method.
+func (m *MockFraudReviewServiceInterface) ListReviews(status string, offset, limit int) ([]models.FraudReview, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTokenExpiry", tokenString)
-	ret0, _ := ret[0].(time.Time)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ListReviews", status, offset, limit)
+	ret0, _ := ret[0].([]models.FraudReview)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetTokenExpiry indicates an expected call of GetTokenExpiry.
-func (mr *MockTokenServiceInterfaceMockRecorder) GetTokenExpiry(tokenString interface{}) *gomock.Call {
+// ListReviews indicates an expected call of ListReviews.
+func (mr *MockFraudReviewServiceInterfaceMockRecorder) ListReviews(status, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenExpiry", reflect.TypeOf((*MockTokenServiceInterface)(nil).GetTokenExpiry), tokenString)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviews", reflect.TypeOf((*MockFraudReviewServiceInterface)(nil).ListReviews), status, offset, limit)
 }
 
-// ValidateAccessToken mocks base method.
-func (m *MockTokenServiceInterface) ValidateAccessToken(tokenString string) (*models.CustomClaims, error) {
+// RejectReview mocks base method.
+func (m *MockFraudReviewServiceInterface) RejectReview(reviewID, adminID uuid.UUID, reason string) (*models.FraudReview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateAccessToken", tokenString)
-	ret0, _ := ret[0].(*models.CustomClaims)
+	ret := m.ctrl.Call(m, "RejectReview", reviewID, adminID, reason)
+	ret0, _ := ret[0].(*models.FraudReview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ValidateAccessToken indicates an expected call of ValidateAccessToken.
-func (mr *MockTokenServiceInterfaceMockRecorder) ValidateAccessToken(tokenString interface{}) *gomock.Call {
+// RejectReview indicates an expected call of RejectReview.
+func (mr *MockFraudReviewServiceInterfaceMockRecorder) RejectReview(reviewID, adminID, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAccessToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).ValidateAccessToken), tokenString)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectReview", reflect.TypeOf((*MockFraudReviewServiceInterface)(nil).RejectReview), reviewID, adminID, reason)
 }
 
-// ValidateRefreshToken mocks base method.
-func (m *MockTokenServiceInterface) ValidateRefreshToken(tokenString string) (*models.CustomClaims, error) {
+// StartWorker mocks base method.
+func (m *MockFraudReviewServiceInterface) StartWorker(ctx context.Context, pollInterval time.Duration) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateRefreshToken", tokenString)
-	ret0, _ := ret[0].(*models.CustomClaims)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	m.ctrl.Call(m, "StartWorker", ctx, pollInterval)
 }
 
-// ValidateRefreshToken indicates an expected call of ValidateRefreshToken.
-func (mr *MockTokenServiceInterfaceMockRecorder) ValidateRefreshToken(tokenString interface{}) *gomock.Call {
+// StartWorker indicates an expected call of StartWorker.
+func (mr *MockFraudReviewServiceInterfaceMockRecorder) StartWorker(ctx, pollInterval interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRefreshToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).ValidateRefreshToken), tokenString)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorker", reflect.TypeOf((*MockFraudReviewServiceInterface)(nil).StartWorker), ctx, pollInterval)
 }
 
-// MockPasswordServiceInterface is a mock of PasswordServiceInterface interface.
-type MockPasswordServiceInterface struct {
+// MockApprovalServiceInterface is a mock of ApprovalServiceInterface interface.
+type MockApprovalServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockPasswordServiceInterfaceMockRecorder
+	recorder *MockApprovalServiceInterfaceMockRecorder
 }
 
-// MockPasswordServiceInterfaceMockRecorder is the mock recorder for MockPasswordServiceInterface.
-type MockPasswordServiceInterfaceMockRecorder struct {
-	mock *MockPasswordServiceInterface
+// MockApprovalServiceInterfaceMockRecorder is the mock recorder for MockApprovalServiceInterface.
+type MockApprovalServiceInterfaceMockRecorder struct {
+	mock *MockApprovalServiceInterface
 }
 
-// NewMockPasswordServiceInterface creates a new mock instance.
-func NewMockPasswordServiceInterface(ctrl *gomock.Controller) *MockPasswordServiceInterface {
-	mock := &MockPasswordServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockPasswordServiceInterfaceMockRecorder{mock}
+// NewMockApprovalServiceInterface creates a new mock instance.
+func NewMockApprovalServiceInterface(ctrl *gomock.Controller) *MockApprovalServiceInterface {
+	mock := &MockApprovalServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockApprovalServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockPasswordServiceInterface) EXPECT() *MockPasswordServiceInterfaceMockRecorder {
+func (m *MockApprovalServiceInterface) EXPECT() *MockApprovalServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// AdminResetPassword mocks base method.
-func (m *MockPasswordServiceInterface) AdminResetPassword(customerID, adminID uuid.UUID) (string, error) {
+// ApproveAction mocks base method.
+func (m *MockApprovalServiceInterface) ApproveAction(actionID, approvedBy uuid.UUID, permissions []string, reason, ipAddress, userAgent string) (*models.PendingAction, map[string]interface{}, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AdminResetPassword", customerID, adminID)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ApproveAction", actionID, approvedBy, permissions, reason, ipAddress, userAgent)
+	ret0, _ := ret[0].(*models.PendingAction)
+	ret1, _ := ret[1].(map[string]interface{})
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// AdminResetPassword indicates an expected call of AdminResetPassword.
-func (mr *MockPasswordServiceInterfaceMockRecorder) AdminResetPassword(customerID, adminID interface{}) *gomock.Call {
+// ApproveAction indicates an expected call of ApproveAction.
+func (mr *MockApprovalServiceInterfaceMockRecorder) ApproveAction(actionID, approvedBy, permissions, reason, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminResetPassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).AdminResetPassword), customerID, adminID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveAction", reflect.TypeOf((*MockApprovalServiceInterface)(nil).ApproveAction), actionID, approvedBy, permissions, reason, ipAddress, userAgent)
 }
 
-// ComparePassword mocks base method.
-func (m *MockPasswordServiceInterface) ComparePassword(password, hash string) bool {
+// ExpireActions mocks base method.
+func (m *MockApprovalServiceInterface) ExpireActions() (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ComparePassword", password, hash)
-	ret0, _ := ret[0].(bool)
-	return ret0
+	ret := m.ctrl.Call(m, "ExpireActions")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ComparePassword indicates an expected call of ComparePassword.
-func (mr *MockPasswordServiceInterfaceMockRecorder) ComparePassword(password, hash interface{}) *gomock.Call {
+// ExpireActions indicates an expected call of ExpireActions.
+func (mr *MockApprovalServiceInterfaceMockRecorder) ExpireActions() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComparePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).ComparePassword), password, hash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireActions", reflect.TypeOf((*MockApprovalServiceInterface)(nil).ExpireActions))
 }
 
-// CustomerUpdatePassword mocks base method.
-func (m *MockPasswordServiceInterface) CustomerUpdatePassword(customerID uuid.UUID, currentPassword, newPassword string) error {
+// GetAction mocks base method.
+func (m *MockApprovalServiceInterface) GetAction(actionID uuid.UUID) (*models.PendingAction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CustomerUpdatePassword", customerID, currentPassword, newPassword)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetAction", actionID)
+	ret0, _ := ret[0].(*models.PendingAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CustomerUpdatePassword indicates an expected call of CustomerUpdatePassword.
-func (mr *MockPasswordServiceInterfaceMockRecorder) CustomerUpdatePassword(customerID, currentPassword, newPassword interface{}) *gomock.Call {
+// GetAction indicates an expected call of GetAction.
+func (mr *MockApprovalServiceInterfaceMockRecorder) GetAction(actionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomerUpdatePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).CustomerUpdatePassword), customerID, currentPassword, newPassword)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAction", reflect.TypeOf((*MockApprovalServiceInterface)(nil).GetAction), actionID)
 }
 
-// GenerateSecurePassword mocks base method.
-func (m *MockPasswordServiceInterface) GenerateSecurePassword() (string, error) {
+// ListActions mocks base method.
+func (m *MockApprovalServiceInterface) ListActions(status string, offset, limit int) ([]models.PendingAction, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateSecurePassword")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListActions", status, offset, limit)
+	ret0, _ := ret[0].([]models.PendingAction)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListActions indicates an expected call of ListActions.
+func (mr *MockApprovalServiceInterfaceMockRecorder) ListActions(status, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActions", reflect.TypeOf((*MockApprovalServiceInterface)(nil).ListActions), status, offset, limit)
+}
+
+// RejectAction mocks base method.
+func (m *MockApprovalServiceInterface) RejectAction(actionID, rejectedBy uuid.UUID, reason, ipAddress, userAgent string) (*models.PendingAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectAction", actionID, rejectedBy, reason, ipAddress, userAgent)
+	ret0, _ := ret[0].(*models.PendingAction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenerateSecurePassword indicates an expected call of GenerateSecurePassword.
-func (mr *MockPasswordServiceInterfaceMockRecorder) GenerateSecurePassword() *gomock.Call {
+// RejectAction indicates an expected call of RejectAction.
+func (mr *MockApprovalServiceInterfaceMockRecorder) RejectAction(actionID, rejectedBy, reason, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecurePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).GenerateSecurePassword))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectAction", reflect.TypeOf((*MockApprovalServiceInterface)(nil).RejectAction), actionID, rejectedBy, reason, ipAddress, userAgent)
 }
 
-// GenerateSecurePasswordWithLength mocks base method.
-func (m *MockPasswordServiceInterface) GenerateSecurePasswordWithLength(length int) (string, error) {
+// RequiresApproval mocks base method.
+func (m *MockApprovalServiceInterface) RequiresApproval(actionType string, amount decimal.Decimal) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateSecurePasswordWithLength", length)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "RequiresApproval", actionType, amount)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// RequiresApproval indicates an expected call of RequiresApproval.
+func (mr *MockApprovalServiceInterfaceMockRecorder) RequiresApproval(actionType, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequiresApproval", reflect.TypeOf((*MockApprovalServiceInterface)(nil).RequiresApproval), actionType, amount)
+}
+
+// StartWorker mocks base method.
+func (m *MockApprovalServiceInterface) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartWorker", ctx, pollInterval)
+}
+
+// StartWorker indicates an expected call of StartWorker.
+func (mr *MockApprovalServiceInterfaceMockRecorder) StartWorker(ctx, pollInterval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorker", reflect.TypeOf((*MockApprovalServiceInterface)(nil).StartWorker), ctx, pollInterval)
+}
+
+// SubmitAction mocks base method.
+func (m *MockApprovalServiceInterface) SubmitAction(actionType, resourceID string, payload map[string]interface{}, requestedBy uuid.UUID, ipAddress, userAgent string) (*models.PendingAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitAction", actionType, resourceID, payload, requestedBy, ipAddress, userAgent)
+	ret0, _ := ret[0].(*models.PendingAction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenerateSecurePasswordWithLength indicates an expected call of GenerateSecurePasswordWithLength.
-func (mr *MockPasswordServiceInterfaceMockRecorder) GenerateSecurePasswordWithLength(length interface{}) *gomock.Call {
+// SubmitAction indicates an expected call of SubmitAction.
+func (mr *MockApprovalServiceInterfaceMockRecorder) SubmitAction(actionType, resourceID, payload, requestedBy, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecurePasswordWithLength", reflect.TypeOf((*MockPasswordServiceInterface)(nil).GenerateSecurePasswordWithLength), length)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAction", reflect.TypeOf((*MockApprovalServiceInterface)(nil).SubmitAction), actionType, resourceID, payload, requestedBy, ipAddress, userAgent)
 }
 
-// HashPassword mocks base method.
-func (m *MockPasswordServiceInterface) HashPassword(password string) (string, error) {
+// MockReversalServiceInterface is a mock of ReversalServiceInterface interface.
+type MockReversalServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReversalServiceInterfaceMockRecorder
+}
+
+// MockReversalServiceInterfaceMockRecorder is the mock recorder for MockReversalServiceInterface.
+type MockReversalServiceInterfaceMockRecorder struct {
+	mock *MockReversalServiceInterface
+}
+
+// NewMockReversalServiceInterface creates a new mock instance.
+func NewMockReversalServiceInterface(ctrl *gomock.Controller) *MockReversalServiceInterface {
+	mock := &MockReversalServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockReversalServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReversalServiceInterface) EXPECT() *MockReversalServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// RequestReversal mocks base method.
+func (m *MockReversalServiceInterface) RequestReversal(transactionID, adminID uuid.UUID, req *dto.ReverseTransactionRequest) (*dto.ReverseTransactionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HashPassword", password)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "RequestReversal", transactionID, adminID, req)
+	ret0, _ := ret[0].(*dto.ReverseTransactionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HashPassword indicates an expected call of HashPassword.
-func (mr *MockPasswordServiceInterfaceMockRecorder) HashPassword(password interface{}) *gomock.Call {
+// RequestReversal indicates an expected call of RequestReversal.
+func (mr *MockReversalServiceInterfaceMockRecorder) RequestReversal(transactionID, adminID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).HashPassword), password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReversal", reflect.TypeOf((*MockReversalServiceInterface)(nil).RequestReversal), transactionID, adminID, req)
 }
 
-// HashPasswordWithoutValidation mocks base method.
-func (m *MockPasswordServiceInterface) HashPasswordWithoutValidation(password string) (string, error) {
+// MockAccountSummaryServiceInterface is a mock of AccountSummaryServiceInterface interface.
+type MockAccountSummaryServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountSummaryServiceInterfaceMockRecorder
+}
+
+// MockAccountSummaryServiceInterfaceMockRecorder is the mock recorder for MockAccountSummaryServiceInterface.
+type MockAccountSummaryServiceInterfaceMockRecorder struct {
+	mock *MockAccountSummaryServiceInterface
+}
+
+// NewMockAccountSummaryServiceInterface creates a new mock instance.
+func NewMockAccountSummaryServiceInterface(ctrl *gomock.Controller) *MockAccountSummaryServiceInterface {
+	mock := &MockAccountSummaryServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAccountSummaryServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountSummaryServiceInterface) EXPECT() *MockAccountSummaryServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAccountSummary mocks base method.
+func (m *MockAccountSummaryServiceInterface) GetAccountSummary(requestorID uuid.UUID, targetUserID *uuid.UUID, baseCurrency string, canViewAll bool) (*models.UserAccountSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HashPasswordWithoutValidation", password)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetAccountSummary", requestorID, targetUserID, baseCurrency, canViewAll)
+	ret0, _ := ret[0].(*models.UserAccountSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HashPasswordWithoutValidation indicates an expected call of HashPasswordWithoutValidation.
-func (mr *MockPasswordServiceInterfaceMockRecorder) HashPasswordWithoutValidation(password interface{}) *gomock.Call {
+// GetAccountSummary indicates an expected call of GetAccountSummary.
+func (mr *MockAccountSummaryServiceInterfaceMockRecorder) GetAccountSummary(requestorID, targetUserID, baseCurrency, canViewAll interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSummary", reflect.TypeOf((*MockAccountSummaryServiceInterface)(nil).GetAccountSummary), requestorID, targetUserID, baseCurrency, canViewAll)
+}
+
+// MockAuditServiceInterface is a mock of AuditServiceInterface interface.
+type MockAuditServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceInterfaceMockRecorder
+}
+
+// MockAuditServiceInterfaceMockRecorder is the mock recorder for MockAuditServiceInterface.
+type MockAuditServiceInterfaceMockRecorder struct {
+	mock *MockAuditServiceInterface
+}
+
+// NewMockAuditServiceInterface creates a new mock instance.
+func NewMockAuditServiceInterface(ctrl *gomock.Controller) *MockAuditServiceInterface {
+	mock := &MockAuditServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditServiceInterface) EXPECT() *MockAuditServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockAuditServiceInterface) CreateAuditLog(log *models.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockAuditServiceInterfaceMockRecorder) CreateAuditLog(log interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockAuditServiceInterface)(nil).CreateAuditLog), log)
+}
+
+// GetCustomerActivity mocks base method.
+func (m *MockAuditServiceInterface) GetCustomerActivity(userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerActivity", userID, startDate, endDate, offset, limit)
+	ret0, _ := ret[0].([]*models.AuditLog)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCustomerActivity indicates an expected call of GetCustomerActivity.
+func (mr *MockAuditServiceInterfaceMockRecorder) GetCustomerActivity(userID, startDate, endDate, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerActivity", reflect.TypeOf((*MockAuditServiceInterface)(nil).GetCustomerActivity), userID, startDate, endDate, offset, limit)
+}
+
+// LogAccountCreated mocks base method.
+func (m *MockAuditServiceInterface) LogAccountCreated(userID, performedBy, accountID uuid.UUID, accountType, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogAccountCreated", userID, performedBy, accountID, accountType, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogAccountCreated indicates an expected call of LogAccountCreated.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogAccountCreated(userID, performedBy, accountID, accountType, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountCreated", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogAccountCreated), userID, performedBy, accountID, accountType, ipAddress, userAgent)
+}
+
+// LogAccountTransferred mocks base method.
+func (m *MockAuditServiceInterface) LogAccountTransferred(fromUserID, toUserID, performedBy, accountID uuid.UUID, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogAccountTransferred", fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogAccountTransferred indicates an expected call of LogAccountTransferred.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogAccountTransferred(fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountTransferred", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogAccountTransferred), fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent)
+}
+
+// LogCustomerCreated mocks base method.
+func (m *MockAuditServiceInterface) LogCustomerCreated(userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogCustomerCreated", userID, performedBy, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogCustomerCreated indicates an expected call of LogCustomerCreated.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogCustomerCreated(userID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerCreated", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogCustomerCreated), userID, performedBy, ipAddress, userAgent)
+}
+
+// LogCustomerDeleted mocks base method.
+func (m *MockAuditServiceInterface) LogCustomerDeleted(userID, performedBy uuid.UUID, ipAddress, userAgent, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogCustomerDeleted", userID, performedBy, ipAddress, userAgent, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogCustomerDeleted indicates an expected call of LogCustomerDeleted.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogCustomerDeleted(userID, performedBy, ipAddress, userAgent, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerDeleted", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogCustomerDeleted), userID, performedBy, ipAddress, userAgent, reason)
+}
+
+// LogEmailUpdate mocks base method.
+func (m *MockAuditServiceInterface) LogEmailUpdate(userID, performedBy uuid.UUID, oldEmail, newEmail, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEmailUpdate", userID, performedBy, oldEmail, newEmail, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogEmailUpdate indicates an expected call of LogEmailUpdate.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogEmailUpdate(userID, performedBy, oldEmail, newEmail, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEmailUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogEmailUpdate), userID, performedBy, oldEmail, newEmail, ipAddress, userAgent)
+}
+
+// LogLogin mocks base method.
+func (m *MockAuditServiceInterface) LogLogin(userID uuid.UUID, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogLogin", userID, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogLogin indicates an expected call of LogLogin.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogLogin(userID, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogLogin", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogLogin), userID, ipAddress, userAgent)
+}
+
+// LogLogout mocks base method.
+func (m *MockAuditServiceInterface) LogLogout(userID uuid.UUID, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogLogout", userID, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogLogout indicates an expected call of LogLogout.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogLogout(userID, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogLogout", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogLogout), userID, ipAddress, userAgent)
+}
+
+// LogPasswordReset mocks base method.
+func (m *MockAuditServiceInterface) LogPasswordReset(userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogPasswordReset", userID, performedBy, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogPasswordReset indicates an expected call of LogPasswordReset.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogPasswordReset(userID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordReset", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogPasswordReset), userID, performedBy, ipAddress, userAgent)
+}
+
+// LogPasswordUpdate mocks base method.
+func (m *MockAuditServiceInterface) LogPasswordUpdate(userID uuid.UUID, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogPasswordUpdate", userID, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogPasswordUpdate indicates an expected call of LogPasswordUpdate.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogPasswordUpdate(userID, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogPasswordUpdate), userID, ipAddress, userAgent)
+}
+
+// LogProfileUpdate mocks base method.
+func (m *MockAuditServiceInterface) LogProfileUpdate(userID, performedBy uuid.UUID, ipAddress, userAgent string, changes map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogProfileUpdate", userID, performedBy, ipAddress, userAgent, changes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogProfileUpdate indicates an expected call of LogProfileUpdate.
+func (mr *MockAuditServiceInterfaceMockRecorder) LogProfileUpdate(userID, performedBy, ipAddress, userAgent, changes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogProfileUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogProfileUpdate), userID, performedBy, ipAddress, userAgent, changes)
+}
+
+// MockCategoryServiceInterface is a mock of CategoryServiceInterface interface.
+type MockCategoryServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryServiceInterfaceMockRecorder
+}
+
+// MockCategoryServiceInterfaceMockRecorder is the mock recorder for MockCategoryServiceInterface.
+type MockCategoryServiceInterfaceMockRecorder struct {
+	mock *MockCategoryServiceInterface
+}
+
+// NewMockCategoryServiceInterface creates a new mock instance.
+func NewMockCategoryServiceInterface(ctrl *gomock.Controller) *MockCategoryServiceInterface {
+	mock := &MockCategoryServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCategoryServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryServiceInterface) EXPECT() *MockCategoryServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// BatchCategorize mocks base method.
+func (m *MockCategoryServiceInterface) BatchCategorize(transactions []*models.Transaction) []*models.CategorizationResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCategorize", transactions)
+	ret0, _ := ret[0].([]*models.CategorizationResult)
+	return ret0
+}
+
+// BatchCategorize indicates an expected call of BatchCategorize.
+func (mr *MockCategoryServiceInterfaceMockRecorder) BatchCategorize(transactions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCategorize", reflect.TypeOf((*MockCategoryServiceInterface)(nil).BatchCategorize), transactions)
+}
+
+// CategorizeByDescription mocks base method.
+func (m *MockCategoryServiceInterface) CategorizeByDescription(description string) (string, float64) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategorizeByDescription", description)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(float64)
+	return ret0, ret1
+}
+
+// CategorizeByDescription indicates an expected call of CategorizeByDescription.
+func (mr *MockCategoryServiceInterfaceMockRecorder) CategorizeByDescription(description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategorizeByDescription", reflect.TypeOf((*MockCategoryServiceInterface)(nil).CategorizeByDescription), description)
+}
+
+// CategorizeByMerchant mocks base method.
+func (m *MockCategoryServiceInterface) CategorizeByMerchant(merchantName string) (string, float64) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategorizeByMerchant", merchantName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(float64)
+	return ret0, ret1
+}
+
+// CategorizeByMerchant indicates an expected call of CategorizeByMerchant.
+func (mr *MockCategoryServiceInterfaceMockRecorder) CategorizeByMerchant(merchantName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategorizeByMerchant", reflect.TypeOf((*MockCategoryServiceInterface)(nil).CategorizeByMerchant), merchantName)
+}
+
+// CategorizeTransaction mocks base method.
+func (m *MockCategoryServiceInterface) CategorizeTransaction(transaction *models.Transaction) *models.CategorizationResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategorizeTransaction", transaction)
+	ret0, _ := ret[0].(*models.CategorizationResult)
+	return ret0
+}
+
+// CategorizeTransaction indicates an expected call of CategorizeTransaction.
+func (mr *MockCategoryServiceInterfaceMockRecorder) CategorizeTransaction(transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategorizeTransaction", reflect.TypeOf((*MockCategoryServiceInterface)(nil).CategorizeTransaction), transaction)
+}
+
+// CategoryFromMCC mocks base method.
+func (m *MockCategoryServiceInterface) CategoryFromMCC(mccCode string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategoryFromMCC", mccCode)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CategoryFromMCC indicates an expected call of CategoryFromMCC.
+func (mr *MockCategoryServiceInterfaceMockRecorder) CategoryFromMCC(mccCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryFromMCC", reflect.TypeOf((*MockCategoryServiceInterface)(nil).CategoryFromMCC), mccCode)
+}
+
+// FuzzyMatchMerchant mocks base method.
+func (m *MockCategoryServiceInterface) FuzzyMatchMerchant(input string) (string, float64) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzyMatchMerchant", input)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(float64)
+	return ret0, ret1
+}
+
+// FuzzyMatchMerchant indicates an expected call of FuzzyMatchMerchant.
+func (mr *MockCategoryServiceInterfaceMockRecorder) FuzzyMatchMerchant(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzyMatchMerchant", reflect.TypeOf((*MockCategoryServiceInterface)(nil).FuzzyMatchMerchant), input)
+}
+
+// OverrideCategory mocks base method.
+func (m *MockCategoryServiceInterface) OverrideCategory(transaction *models.Transaction, newCategory, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverrideCategory", transaction, newCategory, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OverrideCategory indicates an expected call of OverrideCategory.
+func (mr *MockCategoryServiceInterfaceMockRecorder) OverrideCategory(transaction, newCategory, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverrideCategory", reflect.TypeOf((*MockCategoryServiceInterface)(nil).OverrideCategory), transaction, newCategory, reason)
+}
+
+// PreviewCategorization mocks base method.
+func (m *MockCategoryServiceInterface) PreviewCategorization(transaction *models.Transaction) *models.CategorizationResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewCategorization", transaction)
+	ret0, _ := ret[0].(*models.CategorizationResult)
+	return ret0
+}
+
+// PreviewCategorization indicates an expected call of PreviewCategorization.
+func (mr *MockCategoryServiceInterfaceMockRecorder) PreviewCategorization(transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewCategorization", reflect.TypeOf((*MockCategoryServiceInterface)(nil).PreviewCategorization), transaction)
+}
+
+// Reload mocks base method.
+func (m *MockCategoryServiceInterface) Reload() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reload")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reload indicates an expected call of Reload.
+func (mr *MockCategoryServiceInterfaceMockRecorder) Reload() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockCategoryServiceInterface)(nil).Reload))
+}
+
+// StartAutoReload mocks base method.
+func (m *MockCategoryServiceInterface) StartAutoReload(ctx context.Context, interval time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartAutoReload", ctx, interval)
+}
+
+// StartAutoReload indicates an expected call of StartAutoReload.
+func (mr *MockCategoryServiceInterfaceMockRecorder) StartAutoReload(ctx, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAutoReload", reflect.TypeOf((*MockCategoryServiceInterface)(nil).StartAutoReload), ctx, interval)
+}
+
+// MockCategoryManagementServiceInterface is a mock of CategoryManagementServiceInterface interface.
+type MockCategoryManagementServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryManagementServiceInterfaceMockRecorder
+}
+
+// MockCategoryManagementServiceInterfaceMockRecorder is the mock recorder for MockCategoryManagementServiceInterface.
+type MockCategoryManagementServiceInterfaceMockRecorder struct {
+	mock *MockCategoryManagementServiceInterface
+}
+
+// NewMockCategoryManagementServiceInterface creates a new mock instance.
+func NewMockCategoryManagementServiceInterface(ctrl *gomock.Controller) *MockCategoryManagementServiceInterface {
+	mock := &MockCategoryManagementServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCategoryManagementServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryManagementServiceInterface) EXPECT() *MockCategoryManagementServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockCategoryManagementServiceInterface) CreateCategory(req *dto.CreateCategoryRequest) (*models.TransactionCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", req)
+	ret0, _ := ret[0].(*models.TransactionCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) CreateCategory(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).CreateCategory), req)
+}
+
+// CreateMerchantMapping mocks base method.
+func (m *MockCategoryManagementServiceInterface) CreateMerchantMapping(req *dto.CreateMerchantMappingRequest) (*models.MerchantMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMerchantMapping", req)
+	ret0, _ := ret[0].(*models.MerchantMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMerchantMapping indicates an expected call of CreateMerchantMapping.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) CreateMerchantMapping(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMerchantMapping", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).CreateMerchantMapping), req)
+}
+
+// DeactivateCategory mocks base method.
+func (m *MockCategoryManagementServiceInterface) DeactivateCategory(code string) (*models.TransactionCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateCategory", code)
+	ret0, _ := ret[0].(*models.TransactionCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateCategory indicates an expected call of DeactivateCategory.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) DeactivateCategory(code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateCategory", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).DeactivateCategory), code)
+}
+
+// DeactivateMerchantMapping mocks base method.
+func (m *MockCategoryManagementServiceInterface) DeactivateMerchantMapping(id uuid.UUID) (*models.MerchantMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateMerchantMapping", id)
+	ret0, _ := ret[0].(*models.MerchantMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateMerchantMapping indicates an expected call of DeactivateMerchantMapping.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) DeactivateMerchantMapping(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateMerchantMapping", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).DeactivateMerchantMapping), id)
+}
+
+// GetCategory mocks base method.
+func (m *MockCategoryManagementServiceInterface) GetCategory(code string) (*models.TransactionCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", code)
+	ret0, _ := ret[0].(*models.TransactionCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) GetCategory(code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).GetCategory), code)
+}
+
+// GetMerchantMapping mocks base method.
+func (m *MockCategoryManagementServiceInterface) GetMerchantMapping(id uuid.UUID) (*models.MerchantMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMerchantMapping", id)
+	ret0, _ := ret[0].(*models.MerchantMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMerchantMapping indicates an expected call of GetMerchantMapping.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) GetMerchantMapping(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMerchantMapping", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).GetMerchantMapping), id)
+}
+
+// ListCategories mocks base method.
+func (m *MockCategoryManagementServiceInterface) ListCategories(includeInactive bool) ([]*models.CategoryNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", includeInactive)
+	ret0, _ := ret[0].([]*models.CategoryNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) ListCategories(includeInactive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).ListCategories), includeInactive)
+}
+
+// ListMerchantMappings mocks base method.
+func (m *MockCategoryManagementServiceInterface) ListMerchantMappings(filters models.MerchantMappingFilters, offset, limit int) ([]models.MerchantMapping, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMerchantMappings", filters, offset, limit)
+	ret0, _ := ret[0].([]models.MerchantMapping)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMerchantMappings indicates an expected call of ListMerchantMappings.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) ListMerchantMappings(filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMerchantMappings", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).ListMerchantMappings), filters, offset, limit)
+}
+
+// ReorderCategories mocks base method.
+func (m *MockCategoryManagementServiceInterface) ReorderCategories(req *dto.ReorderCategoriesRequest) ([]*models.CategoryNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCategories", req)
+	ret0, _ := ret[0].([]*models.CategoryNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderCategories indicates an expected call of ReorderCategories.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) ReorderCategories(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCategories", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).ReorderCategories), req)
+}
+
+// TestCategorization mocks base method.
+func (m *MockCategoryManagementServiceInterface) TestCategorization(req *dto.TestCategorizationRequest) *models.CategorizationResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestCategorization", req)
+	ret0, _ := ret[0].(*models.CategorizationResult)
+	return ret0
+}
+
+// TestCategorization indicates an expected call of TestCategorization.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) TestCategorization(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestCategorization", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).TestCategorization), req)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockCategoryManagementServiceInterface) UpdateCategory(code string, req *dto.UpdateCategoryRequest) (*models.TransactionCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", code, req)
+	ret0, _ := ret[0].(*models.TransactionCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) UpdateCategory(code, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).UpdateCategory), code, req)
+}
+
+// UpdateMerchantMapping mocks base method.
+func (m *MockCategoryManagementServiceInterface) UpdateMerchantMapping(id uuid.UUID, req *dto.UpdateMerchantMappingRequest) (*models.MerchantMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMerchantMapping", id, req)
+	ret0, _ := ret[0].(*models.MerchantMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMerchantMapping indicates an expected call of UpdateMerchantMapping.
+func (mr *MockCategoryManagementServiceInterfaceMockRecorder) UpdateMerchantMapping(id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMerchantMapping", reflect.TypeOf((*MockCategoryManagementServiceInterface)(nil).UpdateMerchantMapping), id, req)
+}
+
+// MockRecategorizationServiceInterface is a mock of RecategorizationServiceInterface interface.
+type MockRecategorizationServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecategorizationServiceInterfaceMockRecorder
+}
+
+// MockRecategorizationServiceInterfaceMockRecorder is the mock recorder for MockRecategorizationServiceInterface.
+type MockRecategorizationServiceInterfaceMockRecorder struct {
+	mock *MockRecategorizationServiceInterface
+}
+
+// NewMockRecategorizationServiceInterface creates a new mock instance.
+func NewMockRecategorizationServiceInterface(ctrl *gomock.Controller) *MockRecategorizationServiceInterface {
+	mock := &MockRecategorizationServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockRecategorizationServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecategorizationServiceInterface) EXPECT() *MockRecategorizationServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CancelJob mocks base method.
+func (m *MockRecategorizationServiceInterface) CancelJob(id uuid.UUID) (*models.RecategorizationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", id)
+	ret0, _ := ret[0].(*models.RecategorizationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockRecategorizationServiceInterfaceMockRecorder) CancelJob(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockRecategorizationServiceInterface)(nil).CancelJob), id)
+}
+
+// CreateJob mocks base method.
+func (m *MockRecategorizationServiceInterface) CreateJob(req *dto.StartRecategorizationRequest, requestedBy uuid.UUID) (*models.RecategorizationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", req, requestedBy)
+	ret0, _ := ret[0].(*models.RecategorizationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockRecategorizationServiceInterfaceMockRecorder) CreateJob(req, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockRecategorizationServiceInterface)(nil).CreateJob), req, requestedBy)
+}
+
+// GetJob mocks base method.
+func (m *MockRecategorizationServiceInterface) GetJob(id uuid.UUID) (*models.RecategorizationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", id)
+	ret0, _ := ret[0].(*models.RecategorizationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockRecategorizationServiceInterfaceMockRecorder) GetJob(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockRecategorizationServiceInterface)(nil).GetJob), id)
+}
+
+// ListJobs mocks base method.
+func (m *MockRecategorizationServiceInterface) ListJobs(offset, limit int) ([]models.RecategorizationJob, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", offset, limit)
+	ret0, _ := ret[0].([]models.RecategorizationJob)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockRecategorizationServiceInterfaceMockRecorder) ListJobs(offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockRecategorizationServiceInterface)(nil).ListJobs), offset, limit)
+}
+
+// ResumeJob mocks base method.
+func (m *MockRecategorizationServiceInterface) ResumeJob(id uuid.UUID) (*models.RecategorizationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeJob", id)
+	ret0, _ := ret[0].(*models.RecategorizationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeJob indicates an expected call of ResumeJob.
+func (mr *MockRecategorizationServiceInterfaceMockRecorder) ResumeJob(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeJob", reflect.TypeOf((*MockRecategorizationServiceInterface)(nil).ResumeJob), id)
+}
+
+// StartWorker mocks base method.
+func (m *MockRecategorizationServiceInterface) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartWorker", ctx, pollInterval)
+}
+
+// StartWorker indicates an expected call of StartWorker.
+func (mr *MockRecategorizationServiceInterfaceMockRecorder) StartWorker(ctx, pollInterval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorker", reflect.TypeOf((*MockRecategorizationServiceInterface)(nil).StartWorker), ctx, pollInterval)
+}
+
+// MockTransactionCategoryServiceInterface is a mock of TransactionCategoryServiceInterface interface.
+type MockTransactionCategoryServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionCategoryServiceInterfaceMockRecorder
+}
+
+// MockTransactionCategoryServiceInterfaceMockRecorder is the mock recorder for MockTransactionCategoryServiceInterface.
+type MockTransactionCategoryServiceInterfaceMockRecorder struct {
+	mock *MockTransactionCategoryServiceInterface
+}
+
+// NewMockTransactionCategoryServiceInterface creates a new mock instance.
+func NewMockTransactionCategoryServiceInterface(ctrl *gomock.Controller) *MockTransactionCategoryServiceInterface {
+	mock := &MockTransactionCategoryServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTransactionCategoryServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionCategoryServiceInterface) EXPECT() *MockTransactionCategoryServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// OverrideTransactionCategory mocks base method.
+func (m *MockTransactionCategoryServiceInterface) OverrideTransactionCategory(transactionID, userID uuid.UUID, canManageTransactions, canManageRules bool, req *dto.OverrideTransactionCategoryRequest, ipAddress, userAgent string) (*dto.OverrideTransactionCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverrideTransactionCategory", transactionID, userID, canManageTransactions, canManageRules, req, ipAddress, userAgent)
+	ret0, _ := ret[0].(*dto.OverrideTransactionCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverrideTransactionCategory indicates an expected call of OverrideTransactionCategory.
+func (mr *MockTransactionCategoryServiceInterfaceMockRecorder) OverrideTransactionCategory(transactionID, userID, canManageTransactions, canManageRules, req, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverrideTransactionCategory", reflect.TypeOf((*MockTransactionCategoryServiceInterface)(nil).OverrideTransactionCategory), transactionID, userID, canManageTransactions, canManageRules, req, ipAddress, userAgent)
+}
+
+// MockCustomerProfileServiceInterface is a mock of CustomerProfileServiceInterface interface.
+type MockCustomerProfileServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerProfileServiceInterfaceMockRecorder
+}
+
+// MockCustomerProfileServiceInterfaceMockRecorder is the mock recorder for MockCustomerProfileServiceInterface.
+type MockCustomerProfileServiceInterfaceMockRecorder struct {
+	mock *MockCustomerProfileServiceInterface
+}
+
+// NewMockCustomerProfileServiceInterface creates a new mock instance.
+func NewMockCustomerProfileServiceInterface(ctrl *gomock.Controller) *MockCustomerProfileServiceInterface {
+	mock := &MockCustomerProfileServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCustomerProfileServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerProfileServiceInterface) EXPECT() *MockCustomerProfileServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateCustomer mocks base method.
+func (m *MockCustomerProfileServiceInterface) CreateCustomer(email, firstName, lastName, role string) (*models.User, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", email, firstName, lastName, role)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) CreateCustomer(email, firstName, lastName, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).CreateCustomer), email, firstName, lastName, role)
+}
+
+// DeleteCustomer mocks base method.
+func (m *MockCustomerProfileServiceInterface) DeleteCustomer(customerID uuid.UUID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomer", customerID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomer indicates an expected call of DeleteCustomer.
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) DeleteCustomer(customerID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomer", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).DeleteCustomer), customerID, reason)
+}
+
+// GetCustomerProfile mocks base method.
+func (m *MockCustomerProfileServiceInterface) GetCustomerProfile(customerID uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerProfile", customerID)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerProfile indicates an expected call of GetCustomerProfile.
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) GetCustomerProfile(customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerProfile", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).GetCustomerProfile), customerID)
+}
+
+// UpdateCustomerEmail mocks base method.
+func (m *MockCustomerProfileServiceInterface) UpdateCustomerEmail(customerID uuid.UUID, newEmail string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomerEmail", customerID, newEmail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomerEmail indicates an expected call of UpdateCustomerEmail.
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) UpdateCustomerEmail(customerID, newEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerEmail", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).UpdateCustomerEmail), customerID, newEmail)
+}
+
+// UpdateCustomerProfile mocks base method.
+func (m *MockCustomerProfileServiceInterface) UpdateCustomerProfile(customerID uuid.UUID, updates map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomerProfile", customerID, updates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomerProfile indicates an expected call of UpdateCustomerProfile.
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) UpdateCustomerProfile(customerID, updates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerProfile", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).UpdateCustomerProfile), customerID, updates)
+}
+
+// MockCustomerSearchServiceInterface is a mock of CustomerSearchServiceInterface interface.
+type MockCustomerSearchServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerSearchServiceInterfaceMockRecorder
+}
+
+// MockCustomerSearchServiceInterfaceMockRecorder is the mock recorder for MockCustomerSearchServiceInterface.
+type MockCustomerSearchServiceInterfaceMockRecorder struct {
+	mock *MockCustomerSearchServiceInterface
+}
+
+// NewMockCustomerSearchServiceInterface creates a new mock instance.
+func NewMockCustomerSearchServiceInterface(ctrl *gomock.Controller) *MockCustomerSearchServiceInterface {
+	mock := &MockCustomerSearchServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCustomerSearchServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerSearchServiceInterface) EXPECT() *MockCustomerSearchServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// SearchCustomers mocks base method.
+func (m *MockCustomerSearchServiceInterface) SearchCustomers(query string, searchType models.SearchType, offset, limit int) ([]*models.CustomerSearchResult, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCustomers", query, searchType, offset, limit)
+	ret0, _ := ret[0].([]*models.CustomerSearchResult)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchCustomers indicates an expected call of SearchCustomers.
+func (mr *MockCustomerSearchServiceInterfaceMockRecorder) SearchCustomers(query, searchType, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomers", reflect.TypeOf((*MockCustomerSearchServiceInterface)(nil).SearchCustomers), query, searchType, offset, limit)
+}
+
+// MockAccountMetricsServiceInterface is a mock of AccountMetricsServiceInterface interface.
+type MockAccountMetricsServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountMetricsServiceInterfaceMockRecorder
+}
+
+// MockAccountMetricsServiceInterfaceMockRecorder is the mock recorder for MockAccountMetricsServiceInterface.
+type MockAccountMetricsServiceInterfaceMockRecorder struct {
+	mock *MockAccountMetricsServiceInterface
+}
+
+// NewMockAccountMetricsServiceInterface creates a new mock instance.
+func NewMockAccountMetricsServiceInterface(ctrl *gomock.Controller) *MockAccountMetricsServiceInterface {
+	mock := &MockAccountMetricsServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAccountMetricsServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountMetricsServiceInterface) EXPECT() *MockAccountMetricsServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAccountMetrics mocks base method.
+func (m *MockAccountMetricsServiceInterface) GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, canViewAll bool) (*models.AccountMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountMetrics", requestorID, accountID, startDate, endDate, canViewAll)
+	ret0, _ := ret[0].(*models.AccountMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountMetrics indicates an expected call of GetAccountMetrics.
+func (mr *MockAccountMetricsServiceInterfaceMockRecorder) GetAccountMetrics(requestorID, accountID, startDate, endDate, canViewAll interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountMetrics", reflect.TypeOf((*MockAccountMetricsServiceInterface)(nil).GetAccountMetrics), requestorID, accountID, startDate, endDate, canViewAll)
+}
+
+// GetUserAggregateMetrics mocks base method.
+func (m *MockAccountMetricsServiceInterface) GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, baseCurrency string, canViewAll bool) (*models.UserAggregateMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAggregateMetrics", requestorID, targetUserID, startDate, endDate, baseCurrency, canViewAll)
+	ret0, _ := ret[0].(*models.UserAggregateMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAggregateMetrics indicates an expected call of GetUserAggregateMetrics.
+func (mr *MockAccountMetricsServiceInterfaceMockRecorder) GetUserAggregateMetrics(requestorID, targetUserID, startDate, endDate, baseCurrency, canViewAll interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAggregateMetrics", reflect.TypeOf((*MockAccountMetricsServiceInterface)(nil).GetUserAggregateMetrics), requestorID, targetUserID, startDate, endDate, baseCurrency, canViewAll)
+}
+
+// MockMetricsRecorderInterface is a mock of MetricsRecorderInterface interface.
+type MockMetricsRecorderInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsRecorderInterfaceMockRecorder
+}
+
+// MockMetricsRecorderInterfaceMockRecorder is the mock recorder for MockMetricsRecorderInterface.
+type MockMetricsRecorderInterfaceMockRecorder struct {
+	mock *MockMetricsRecorderInterface
+}
+
+// NewMockMetricsRecorderInterface creates a new mock instance.
+func NewMockMetricsRecorderInterface(ctrl *gomock.Controller) *MockMetricsRecorderInterface {
+	mock := &MockMetricsRecorderInterface{ctrl: ctrl}
+	mock.recorder = &MockMetricsRecorderInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetricsRecorderInterface) EXPECT() *MockMetricsRecorderInterfaceMockRecorder {
+	return m.recorder
+}
+
+// IncrementCounter mocks base method.
+func (m *MockMetricsRecorderInterface) IncrementCounter(name string, tags map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementCounter", name, tags)
+}
+
+// IncrementCounter indicates an expected call of IncrementCounter.
+func (mr *MockMetricsRecorderInterfaceMockRecorder) IncrementCounter(name, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCounter", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).IncrementCounter), name, tags)
+}
+
+// RecordGauge mocks base method.
+func (m *MockMetricsRecorderInterface) RecordGauge(name string, value float64, tags map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordGauge", name, value, tags)
+}
+
+// RecordGauge indicates an expected call of RecordGauge.
+func (mr *MockMetricsRecorderInterfaceMockRecorder) RecordGauge(name, value, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGauge", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).RecordGauge), name, value, tags)
+}
+
+// RecordProcessingTime mocks base method.
+func (m *MockMetricsRecorderInterface) RecordProcessingTime(name string, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordProcessingTime", name, duration)
+}
+
+// RecordProcessingTime indicates an expected call of RecordProcessingTime.
+func (mr *MockMetricsRecorderInterfaceMockRecorder) RecordProcessingTime(name, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordProcessingTime", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).RecordProcessingTime), name, duration)
+}
+
+// MockStatementServiceInterface is a mock of StatementServiceInterface interface.
+type MockStatementServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatementServiceInterfaceMockRecorder
+}
+
+// MockStatementServiceInterfaceMockRecorder is the mock recorder for MockStatementServiceInterface.
+type MockStatementServiceInterfaceMockRecorder struct {
+	mock *MockStatementServiceInterface
+}
+
+// NewMockStatementServiceInterface creates a new mock instance.
+func NewMockStatementServiceInterface(ctrl *gomock.Controller) *MockStatementServiceInterface {
+	mock := &MockStatementServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockStatementServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatementServiceInterface) EXPECT() *MockStatementServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GenerateStatement mocks base method.
+func (m *MockStatementServiceInterface) GenerateStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, canViewAll bool) (*models.AccountStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateStatement", requestorID, accountID, periodType, year, period, canViewAll)
+	ret0, _ := ret[0].(*models.AccountStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateStatement indicates an expected call of GenerateStatement.
+func (mr *MockStatementServiceInterfaceMockRecorder) GenerateStatement(requestorID, accountID, periodType, year, period, canViewAll interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStatement", reflect.TypeOf((*MockStatementServiceInterface)(nil).GenerateStatement), requestorID, accountID, periodType, year, period, canViewAll)
+}
+
+// RenderStatement mocks base method.
+func (m *MockStatementServiceInterface) RenderStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, format string, canViewAll bool) (*models.ArchivedStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderStatement", requestorID, accountID, periodType, year, period, format, canViewAll)
+	ret0, _ := ret[0].(*models.ArchivedStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderStatement indicates an expected call of RenderStatement.
+func (mr *MockStatementServiceInterfaceMockRecorder) RenderStatement(requestorID, accountID, periodType, year, period, format, canViewAll interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderStatement", reflect.TypeOf((*MockStatementServiceInterface)(nil).RenderStatement), requestorID, accountID, periodType, year, period, format, canViewAll)
+}
+
+// MockTransactionGeneratorInterface is a mock of TransactionGeneratorInterface interface.
+type MockTransactionGeneratorInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionGeneratorInterfaceMockRecorder
+}
+
+// MockTransactionGeneratorInterfaceMockRecorder is the mock recorder for MockTransactionGeneratorInterface.
+type MockTransactionGeneratorInterfaceMockRecorder struct {
+	mock *MockTransactionGeneratorInterface
+}
+
+// NewMockTransactionGeneratorInterface creates a new mock instance.
+func NewMockTransactionGeneratorInterface(ctrl *gomock.Controller) *MockTransactionGeneratorInterface {
+	mock := &MockTransactionGeneratorInterface{ctrl: ctrl}
+	mock.recorder = &MockTransactionGeneratorInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionGeneratorInterface) EXPECT() *MockTransactionGeneratorInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GenerateAmount mocks base method.
+func (m *MockTransactionGeneratorInterface) GenerateAmount(category string) decimal.Decimal {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAmount", category)
+	ret0, _ := ret[0].(decimal.Decimal)
+	return ret0
+}
+
+// GenerateAmount indicates an expected call of GenerateAmount.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateAmount(category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAmount", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateAmount), category)
+}
+
+// GenerateBillTransactions mocks base method.
+func (m *MockTransactionGeneratorInterface) GenerateBillTransactions(accountID uuid.UUID, startDate, endDate time.Time, startingBalance decimal.Decimal) []*models.Transaction {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateBillTransactions", accountID, startDate, endDate, startingBalance)
+	ret0, _ := ret[0].([]*models.Transaction)
+	return ret0
+}
+
+// GenerateBillTransactions indicates an expected call of GenerateBillTransactions.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateBillTransactions(accountID, startDate, endDate, startingBalance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateBillTransactions", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateBillTransactions), accountID, startDate, endDate, startingBalance)
+}
+
+// GenerateDailyPurchases mocks base method.
+func (m *MockTransactionGeneratorInterface) GenerateDailyPurchases(accountID uuid.UUID, startDate, endDate time.Time, startingBalance decimal.Decimal) []*models.Transaction {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateDailyPurchases", accountID, startDate, endDate, startingBalance)
+	ret0, _ := ret[0].([]*models.Transaction)
+	return ret0
+}
+
+// GenerateDailyPurchases indicates an expected call of GenerateDailyPurchases.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateDailyPurchases(accountID, startDate, endDate, startingBalance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDailyPurchases", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateDailyPurchases), accountID, startDate, endDate, startingBalance)
+}
+
+// GenerateFeeAmount mocks base method.
+func (m *MockTransactionGeneratorInterface) GenerateFeeAmount() decimal.Decimal {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateFeeAmount")
+	ret0, _ := ret[0].(decimal.Decimal)
+	return ret0
+}
+
+// GenerateFeeAmount indicates an expected call of GenerateFeeAmount.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateFeeAmount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateFeeAmount", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateFeeAmount))
+}
+
+// GenerateHistoricalTransactions mocks base method.
+func (m *MockTransactionGeneratorInterface) GenerateHistoricalTransactions(accountID uuid.UUID, startDate, endDate time.Time, startingBalance decimal.Decimal, count int) []*models.Transaction {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHistoricalTransactions", accountID, startDate, endDate, startingBalance, count)
+	ret0, _ := ret[0].([]*models.Transaction)
+	return ret0
+}
+
+// GenerateHistoricalTransactions indicates an expected call of GenerateHistoricalTransactions.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateHistoricalTransactions(accountID, startDate, endDate, startingBalance, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHistoricalTransactions", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateHistoricalTransactions), accountID, startDate, endDate, startingBalance, count)
+}
+
+// GenerateSalaryTransactions mocks base method.
+func (m *MockTransactionGeneratorInterface) GenerateSalaryTransactions(accountID uuid.UUID, startDate, endDate time.Time, startingBalance decimal.Decimal) []*models.Transaction {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSalaryTransactions", accountID, startDate, endDate, startingBalance)
+	ret0, _ := ret[0].([]*models.Transaction)
+	return ret0
+}
+
+// GenerateSalaryTransactions indicates an expected call of GenerateSalaryTransactions.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateSalaryTransactions(accountID, startDate, endDate, startingBalance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSalaryTransactions", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateSalaryTransactions), accountID, startDate, endDate, startingBalance)
+}
+
+// GenerateTimestamp mocks base method.
+func (m *MockTransactionGeneratorInterface) GenerateTimestamp(startDate, endDate time.Time) time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateTimestamp", startDate, endDate)
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GenerateTimestamp indicates an expected call of GenerateTimestamp.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateTimestamp(startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTimestamp", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateTimestamp), startDate, endDate)
+}
+
+// GenerateTransactionType mocks base method.
+func (m *MockTransactionGeneratorInterface) GenerateTransactionType() (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateTransactionType")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GenerateTransactionType indicates an expected call of GenerateTransactionType.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) GenerateTransactionType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTransactionType", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GenerateTransactionType))
+}
+
+// GetMerchantPool mocks base method.
+func (m *MockTransactionGeneratorInterface) GetMerchantPool() []models.MerchantInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMerchantPool")
+	ret0, _ := ret[0].([]models.MerchantInfo)
+	return ret0
+}
+
+// GetMerchantPool indicates an expected call of GetMerchantPool.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) GetMerchantPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMerchantPool", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).GetMerchantPool))
+}
+
+// SelectRandomMerchant mocks base method.
+func (m *MockTransactionGeneratorInterface) SelectRandomMerchant() models.MerchantInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectRandomMerchant")
+	ret0, _ := ret[0].(models.MerchantInfo)
+	return ret0
+}
+
+// SelectRandomMerchant indicates an expected call of SelectRandomMerchant.
+func (mr *MockTransactionGeneratorInterfaceMockRecorder) SelectRandomMerchant() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectRandomMerchant", reflect.TypeOf((*MockTransactionGeneratorInterface)(nil).SelectRandomMerchant))
+}
+
+// MockAuthServiceInterface is a mock of AuthServiceInterface interface.
+type MockAuthServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthServiceInterfaceMockRecorder
+}
+
+// MockAuthServiceInterfaceMockRecorder is the mock recorder for MockAuthServiceInterface.
+type MockAuthServiceInterfaceMockRecorder struct {
+	mock *MockAuthServiceInterface
+}
+
+// NewMockAuthServiceInterface creates a new mock instance.
+func NewMockAuthServiceInterface(ctrl *gomock.Controller) *MockAuthServiceInterface {
+	mock := &MockAuthServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAuthServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthServiceInterface) EXPECT() *MockAuthServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// BeginMFALoginEnrollment mocks base method.
+func (m *MockAuthServiceInterface) BeginMFALoginEnrollment(challengeToken, ipAddress, userAgent string) (*dto.MFAEnrollmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginMFALoginEnrollment", challengeToken, ipAddress, userAgent)
+	ret0, _ := ret[0].(*dto.MFAEnrollmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginMFALoginEnrollment indicates an expected call of BeginMFALoginEnrollment.
+func (mr *MockAuthServiceInterfaceMockRecorder) BeginMFALoginEnrollment(challengeToken, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginMFALoginEnrollment", reflect.TypeOf((*MockAuthServiceInterface)(nil).BeginMFALoginEnrollment), challengeToken, ipAddress, userAgent)
+}
+
+// CompleteMFALogin mocks base method.
+func (m *MockAuthServiceInterface) CompleteMFALogin(req *dto.MFALoginRequest, ipAddress, userAgent string) (*dto.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMFALogin", req, ipAddress, userAgent)
+	ret0, _ := ret[0].(*dto.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteMFALogin indicates an expected call of CompleteMFALogin.
+func (mr *MockAuthServiceInterfaceMockRecorder) CompleteMFALogin(req, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMFALogin", reflect.TypeOf((*MockAuthServiceInterface)(nil).CompleteMFALogin), req, ipAddress, userAgent)
+}
+
+// Login mocks base method.
+func (m *MockAuthServiceInterface) Login(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", req, ipAddress, userAgent)
+	ret0, _ := ret[0].(*dto.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAuthServiceInterfaceMockRecorder) Login(req, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceInterface)(nil).Login), req, ipAddress, userAgent)
+}
+
+// Logout mocks base method.
+func (m *MockAuthServiceInterface) Logout(accessToken, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", accessToken, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceInterfaceMockRecorder) Logout(accessToken, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceInterface)(nil).Logout), accessToken, ipAddress, userAgent)
+}
+
+// RefreshTokens mocks base method.
+func (m *MockAuthServiceInterface) RefreshTokens(refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokens", refreshToken, ipAddress, userAgent)
+	ret0, _ := ret[0].(*dto.TokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokens indicates an expected call of RefreshTokens.
+func (mr *MockAuthServiceInterfaceMockRecorder) RefreshTokens(refreshToken, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokens", reflect.TypeOf((*MockAuthServiceInterface)(nil).RefreshTokens), refreshToken, ipAddress, userAgent)
+}
+
+// Register mocks base method.
+func (m *MockAuthServiceInterface) Register(req *dto.RegisterRequest, ipAddress, userAgent string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", req, ipAddress, userAgent)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockAuthServiceInterfaceMockRecorder) Register(req, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthServiceInterface)(nil).Register), req, ipAddress, userAgent)
+}
+
+// StepUp mocks base method.
+func (m *MockAuthServiceInterface) StepUp(userID, sessionID uuid.UUID, code, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StepUp", userID, sessionID, code, ipAddress, userAgent)
+	ret0, _ := ret[0].(*dto.TokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StepUp indicates an expected call of StepUp.
+func (mr *MockAuthServiceInterfaceMockRecorder) StepUp(userID, sessionID, code, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StepUp", reflect.TypeOf((*MockAuthServiceInterface)(nil).StepUp), userID, sessionID, code, ipAddress, userAgent)
+}
+
+// MockTokenServiceInterface is a mock of TokenServiceInterface interface.
+type MockTokenServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenServiceInterfaceMockRecorder
+}
+
+// MockTokenServiceInterfaceMockRecorder is the mock recorder for MockTokenServiceInterface.
+type MockTokenServiceInterfaceMockRecorder struct {
+	mock *MockTokenServiceInterface
+}
+
+// NewMockTokenServiceInterface creates a new mock instance.
+func NewMockTokenServiceInterface(ctrl *gomock.Controller) *MockTokenServiceInterface {
+	mock := &MockTokenServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTokenServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenServiceInterface) EXPECT() *MockTokenServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ExtractTokenFromHeader mocks base method.
+func (m *MockTokenServiceInterface) ExtractTokenFromHeader(authHeader string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractTokenFromHeader", authHeader)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractTokenFromHeader indicates an expected call of ExtractTokenFromHeader.
+func (mr *MockTokenServiceInterfaceMockRecorder) ExtractTokenFromHeader(authHeader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTokenFromHeader", reflect.TypeOf((*MockTokenServiceInterface)(nil).ExtractTokenFromHeader), authHeader)
+}
+
+// GenerateAccessToken mocks base method.
+func (m *MockTokenServiceInterface) GenerateAccessToken(user *models.User) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAccessToken", user)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateAccessToken indicates an expected call of GenerateAccessToken.
+func (mr *MockTokenServiceInterfaceMockRecorder) GenerateAccessToken(user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).GenerateAccessToken), user)
+}
+
+// GenerateMFAChallengeToken mocks base method.
+func (m *MockTokenServiceInterface) GenerateMFAChallengeToken(userID uuid.UUID) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateMFAChallengeToken", userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateMFAChallengeToken indicates an expected call of GenerateMFAChallengeToken.
+func (mr *MockTokenServiceInterfaceMockRecorder) GenerateMFAChallengeToken(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateMFAChallengeToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).GenerateMFAChallengeToken), userID)
+}
+
+// GenerateRefreshToken mocks base method.
+func (m *MockTokenServiceInterface) GenerateRefreshToken(userID uuid.UUID) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRefreshToken", userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateRefreshToken indicates an expected call of GenerateRefreshToken.
+func (mr *MockTokenServiceInterfaceMockRecorder) GenerateRefreshToken(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).GenerateRefreshToken), userID)
+}
+
+// GenerateSessionAccessToken mocks base method.
+func (m *MockTokenServiceInterface) GenerateSessionAccessToken(user *models.User, permissions []string, sessionID uuid.UUID, mfaVerifiedAt time.Time) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSessionAccessToken", user, permissions, sessionID, mfaVerifiedAt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateSessionAccessToken indicates an expected call of GenerateSessionAccessToken.
+func (mr *MockTokenServiceInterfaceMockRecorder) GenerateSessionAccessToken(user, permissions, sessionID, mfaVerifiedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSessionAccessToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).GenerateSessionAccessToken), user, permissions, sessionID, mfaVerifiedAt)
+}
+
+// GetJTI mocks base method.
+func (m *MockTokenServiceInterface) GetJTI(tokenString string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJTI", tokenString)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJTI indicates an expected call of GetJTI.
+func (mr *MockTokenServiceInterfaceMockRecorder) GetJTI(tokenString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJTI", reflect.TypeOf((*MockTokenServiceInterface)(nil).GetJTI), tokenString)
+}
+
+// GetTokenExpiry mocks base method.
+func (m *MockTokenServiceInterface) GetTokenExpiry(tokenString string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenExpiry", tokenString)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenExpiry indicates an expected call of GetTokenExpiry.
+func (mr *MockTokenServiceInterfaceMockRecorder) GetTokenExpiry(tokenString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenExpiry", reflect.TypeOf((*MockTokenServiceInterface)(nil).GetTokenExpiry), tokenString)
+}
+
+// JWKS mocks base method.
+func (m *MockTokenServiceInterface) JWKS() dto.JWKSResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(dto.JWKSResponse)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockTokenServiceInterfaceMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockTokenServiceInterface)(nil).JWKS))
+}
+
+// ValidateAccessToken mocks base method.
+func (m *MockTokenServiceInterface) ValidateAccessToken(tokenString string) (*models.CustomClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAccessToken", tokenString)
+	ret0, _ := ret[0].(*models.CustomClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAccessToken indicates an expected call of ValidateAccessToken.
+func (mr *MockTokenServiceInterfaceMockRecorder) ValidateAccessToken(tokenString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAccessToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).ValidateAccessToken), tokenString)
+}
+
+// ValidateMFAChallengeToken mocks base method.
+func (m *MockTokenServiceInterface) ValidateMFAChallengeToken(tokenString string) (*models.CustomClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateMFAChallengeToken", tokenString)
+	ret0, _ := ret[0].(*models.CustomClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateMFAChallengeToken indicates an expected call of ValidateMFAChallengeToken.
+func (mr *MockTokenServiceInterfaceMockRecorder) ValidateMFAChallengeToken(tokenString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateMFAChallengeToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).ValidateMFAChallengeToken), tokenString)
+}
+
+// ValidateRefreshToken mocks base method.
+func (m *MockTokenServiceInterface) ValidateRefreshToken(tokenString string) (*models.CustomClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRefreshToken", tokenString)
+	ret0, _ := ret[0].(*models.CustomClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateRefreshToken indicates an expected call of ValidateRefreshToken.
+func (mr *MockTokenServiceInterfaceMockRecorder) ValidateRefreshToken(tokenString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRefreshToken", reflect.TypeOf((*MockTokenServiceInterface)(nil).ValidateRefreshToken), tokenString)
+}
+
+// MockPasswordServiceInterface is a mock of PasswordServiceInterface interface.
+type MockPasswordServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordServiceInterfaceMockRecorder
+}
+
+// MockPasswordServiceInterfaceMockRecorder is the mock recorder for MockPasswordServiceInterface.
+type MockPasswordServiceInterfaceMockRecorder struct {
+	mock *MockPasswordServiceInterface
+}
+
+// NewMockPasswordServiceInterface creates a new mock instance.
+func NewMockPasswordServiceInterface(ctrl *gomock.Controller) *MockPasswordServiceInterface {
+	mock := &MockPasswordServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockPasswordServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordServiceInterface) EXPECT() *MockPasswordServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AdminResetPassword mocks base method.
+func (m *MockPasswordServiceInterface) AdminResetPassword(customerID, adminID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminResetPassword", customerID, adminID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminResetPassword indicates an expected call of AdminResetPassword.
+func (mr *MockPasswordServiceInterfaceMockRecorder) AdminResetPassword(customerID, adminID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminResetPassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).AdminResetPassword), customerID, adminID)
+}
+
+// ComparePassword mocks base method.
+func (m *MockPasswordServiceInterface) ComparePassword(password, hash string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComparePassword", password, hash)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ComparePassword indicates an expected call of ComparePassword.
+func (mr *MockPasswordServiceInterfaceMockRecorder) ComparePassword(password, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComparePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).ComparePassword), password, hash)
+}
+
+// CustomerUpdatePassword mocks base method.
+func (m *MockPasswordServiceInterface) CustomerUpdatePassword(customerID uuid.UUID, currentPassword, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomerUpdatePassword", customerID, currentPassword, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomerUpdatePassword indicates an expected call of CustomerUpdatePassword.
+func (mr *MockPasswordServiceInterfaceMockRecorder) CustomerUpdatePassword(customerID, currentPassword, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomerUpdatePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).CustomerUpdatePassword), customerID, currentPassword, newPassword)
+}
+
+// GenerateSecurePassword mocks base method.
+func (m *MockPasswordServiceInterface) GenerateSecurePassword() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecurePassword")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecurePassword indicates an expected call of GenerateSecurePassword.
+func (mr *MockPasswordServiceInterfaceMockRecorder) GenerateSecurePassword() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecurePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).GenerateSecurePassword))
+}
+
+// GenerateSecurePasswordWithLength mocks base method.
+func (m *MockPasswordServiceInterface) GenerateSecurePasswordWithLength(length int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecurePasswordWithLength", length)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecurePasswordWithLength indicates an expected call of GenerateSecurePasswordWithLength.
+func (mr *MockPasswordServiceInterfaceMockRecorder) GenerateSecurePasswordWithLength(length interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecurePasswordWithLength", reflect.TypeOf((*MockPasswordServiceInterface)(nil).GenerateSecurePasswordWithLength), length)
+}
+
+// HashPassword mocks base method.
+func (m *MockPasswordServiceInterface) HashPassword(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashPassword", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HashPassword indicates an expected call of HashPassword.
+func (mr *MockPasswordServiceInterfaceMockRecorder) HashPassword(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).HashPassword), password)
+}
+
+// HashPasswordWithoutValidation mocks base method.
+func (m *MockPasswordServiceInterface) HashPasswordWithoutValidation(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashPasswordWithoutValidation", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HashPasswordWithoutValidation indicates an expected call of HashPasswordWithoutValidation.
+func (mr *MockPasswordServiceInterfaceMockRecorder) HashPasswordWithoutValidation(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPasswordWithoutValidation", reflect.TypeOf((*MockPasswordServiceInterface)(nil).HashPasswordWithoutValidation), password)
+}
+
+// PasswordStrength mocks base method.
+func (m *MockPasswordServiceInterface) PasswordStrength(password string) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordStrength", password)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PasswordStrength indicates an expected call of PasswordStrength.
+func (mr *MockPasswordServiceInterfaceMockRecorder) PasswordStrength(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordStrength", reflect.TypeOf((*MockPasswordServiceInterface)(nil).PasswordStrength), password)
+}
+
+// ValidatePassword mocks base method.
+func (m *MockPasswordServiceInterface) ValidatePassword(password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePassword", password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidatePassword indicates an expected call of ValidatePassword.
+func (mr *MockPasswordServiceInterfaceMockRecorder) ValidatePassword(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).ValidatePassword), password)
+}
+
+// MockAuditLoggerInterface is a mock of AuditLoggerInterface interface.
+type MockAuditLoggerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLoggerInterfaceMockRecorder
+}
+
+// MockAuditLoggerInterfaceMockRecorder is the mock recorder for MockAuditLoggerInterface.
+type MockAuditLoggerInterfaceMockRecorder struct {
+	mock *MockAuditLoggerInterface
+}
+
+// NewMockAuditLoggerInterface creates a new mock instance.
+func NewMockAuditLoggerInterface(ctrl *gomock.Controller) *MockAuditLoggerInterface {
+	mock := &MockAuditLoggerInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditLoggerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLoggerInterface) EXPECT() *MockAuditLoggerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// LogBalanceUpdate mocks base method.
+func (m *MockAuditLoggerInterface) LogBalanceUpdate(ctx context.Context, accountID uuid.UUID, oldBalance, newBalance string, transactionID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogBalanceUpdate", ctx, accountID, oldBalance, newBalance, transactionID)
+}
+
+// LogBalanceUpdate indicates an expected call of LogBalanceUpdate.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogBalanceUpdate(ctx, accountID, oldBalance, newBalance, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogBalanceUpdate", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogBalanceUpdate), ctx, accountID, oldBalance, newBalance, transactionID)
+}
+
+// LogCircuitBreakerStateChange mocks base method.
+func (m *MockAuditLoggerInterface) LogCircuitBreakerStateChange(ctx context.Context, service, oldState, newState string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogCircuitBreakerStateChange", ctx, service, oldState, newState)
+}
+
+// LogCircuitBreakerStateChange indicates an expected call of LogCircuitBreakerStateChange.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogCircuitBreakerStateChange(ctx, service, oldState, newState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCircuitBreakerStateChange", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogCircuitBreakerStateChange), ctx, service, oldState, newState)
+}
+
+// LogOptimisticLockConflict mocks base method.
+func (m *MockAuditLoggerInterface) LogOptimisticLockConflict(ctx context.Context, entityType string, entityID uuid.UUID, expectedVersion, actualVersion int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogOptimisticLockConflict", ctx, entityType, entityID, expectedVersion, actualVersion)
+}
+
+// LogOptimisticLockConflict indicates an expected call of LogOptimisticLockConflict.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogOptimisticLockConflict(ctx, entityType, entityID, expectedVersion, actualVersion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogOptimisticLockConflict", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogOptimisticLockConflict), ctx, entityType, entityID, expectedVersion, actualVersion)
+}
+
+// LogQueueItemEnqueued mocks base method.
+func (m *MockAuditLoggerInterface) LogQueueItemEnqueued(ctx context.Context, queueItemID, transactionID uuid.UUID, operation string, priority int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogQueueItemEnqueued", ctx, queueItemID, transactionID, operation, priority)
+}
+
+// LogQueueItemEnqueued indicates an expected call of LogQueueItemEnqueued.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogQueueItemEnqueued(ctx, queueItemID, transactionID, operation, priority interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogQueueItemEnqueued", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogQueueItemEnqueued), ctx, queueItemID, transactionID, operation, priority)
+}
+
+// LogQueueItemProcessed mocks base method.
+func (m *MockAuditLoggerInterface) LogQueueItemProcessed(ctx context.Context, queueItemID, transactionID uuid.UUID, operation string, retryCount int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogQueueItemProcessed", ctx, queueItemID, transactionID, operation, retryCount)
+}
+
+// LogQueueItemProcessed indicates an expected call of LogQueueItemProcessed.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogQueueItemProcessed(ctx, queueItemID, transactionID, operation, retryCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogQueueItemProcessed", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogQueueItemProcessed), ctx, queueItemID, transactionID, operation, retryCount)
+}
+
+// LogRetryAttempt mocks base method.
+func (m *MockAuditLoggerInterface) LogRetryAttempt(ctx context.Context, queueItemID, transactionID uuid.UUID, retryCount, maxRetries int, backoffMs int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogRetryAttempt", ctx, queueItemID, transactionID, retryCount, maxRetries, backoffMs)
+}
+
+// LogRetryAttempt indicates an expected call of LogRetryAttempt.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogRetryAttempt(ctx, queueItemID, transactionID, retryCount, maxRetries, backoffMs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogRetryAttempt", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogRetryAttempt), ctx, queueItemID, transactionID, retryCount, maxRetries, backoffMs)
+}
+
+// LogTransactionProcessingCompleted mocks base method.
+func (m *MockAuditLoggerInterface) LogTransactionProcessingCompleted(ctx context.Context, transactionID uuid.UUID, operation string, durationMs int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogTransactionProcessingCompleted", ctx, transactionID, operation, durationMs)
+}
+
+// LogTransactionProcessingCompleted indicates an expected call of LogTransactionProcessingCompleted.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransactionProcessingCompleted(ctx, transactionID, operation, durationMs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransactionProcessingCompleted", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransactionProcessingCompleted), ctx, transactionID, operation, durationMs)
+}
+
+// LogTransactionProcessingFailed mocks base method.
+func (m *MockAuditLoggerInterface) LogTransactionProcessingFailed(ctx context.Context, transactionID uuid.UUID, operation, errorMsg string, retryCount int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogTransactionProcessingFailed", ctx, transactionID, operation, errorMsg, retryCount)
+}
+
+// LogTransactionProcessingFailed indicates an expected call of LogTransactionProcessingFailed.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransactionProcessingFailed(ctx, transactionID, operation, errorMsg, retryCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransactionProcessingFailed", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransactionProcessingFailed), ctx, transactionID, operation, errorMsg, retryCount)
+}
+
+// LogTransactionProcessingStarted mocks base method.
+func (m *MockAuditLoggerInterface) LogTransactionProcessingStarted(ctx context.Context, transactionID uuid.UUID, operation string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogTransactionProcessingStarted", ctx, transactionID, operation)
+}
+
+// LogTransactionProcessingStarted indicates an expected call of LogTransactionProcessingStarted.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransactionProcessingStarted(ctx, transactionID, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransactionProcessingStarted", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransactionProcessingStarted), ctx, transactionID, operation)
+}
+
+// LogTransactionStateChange mocks base method.
+func (m *MockAuditLoggerInterface) LogTransactionStateChange(ctx context.Context, transactionID uuid.UUID, oldStatus, newStatus string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogTransactionStateChange", ctx, transactionID, oldStatus, newStatus)
+}
+
+// LogTransactionStateChange indicates an expected call of LogTransactionStateChange.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransactionStateChange(ctx, transactionID, oldStatus, newStatus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransactionStateChange", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransactionStateChange), ctx, transactionID, oldStatus, newStatus)
+}
+
+// LogTransferCompleted mocks base method.
+func (m *MockAuditLoggerInterface) LogTransferCompleted(ctx context.Context, transferID uuid.UUID, durationMs int64, debitTxID, creditTxID *uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogTransferCompleted", ctx, transferID, durationMs, debitTxID, creditTxID)
+}
+
+// LogTransferCompleted indicates an expected call of LogTransferCompleted.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransferCompleted(ctx, transferID, durationMs, debitTxID, creditTxID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransferCompleted", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransferCompleted), ctx, transferID, durationMs, debitTxID, creditTxID)
+}
+
+// LogTransferFailed mocks base method.
+func (m *MockAuditLoggerInterface) LogTransferFailed(ctx context.Context, transferID uuid.UUID, errorMsg string, durationMs int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogTransferFailed", ctx, transferID, errorMsg, durationMs)
+}
+
+// LogTransferFailed indicates an expected call of LogTransferFailed.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransferFailed(ctx, transferID, errorMsg, durationMs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransferFailed", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransferFailed), ctx, transferID, errorMsg, durationMs)
+}
+
+// LogTransferIdempotencyCheck mocks base method.
+func (m *MockAuditLoggerInterface) LogTransferIdempotencyCheck(ctx context.Context, idempotencyKey string, existingTransferID uuid.UUID, status string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogTransferIdempotencyCheck", ctx, idempotencyKey, existingTransferID, status)
+}
+
+// LogTransferIdempotencyCheck indicates an expected call of LogTransferIdempotencyCheck.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransferIdempotencyCheck(ctx, idempotencyKey, existingTransferID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransferIdempotencyCheck", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransferIdempotencyCheck), ctx, idempotencyKey, existingTransferID, status)
+}
+
+// LogTransferInitiated mocks base method.
+func (m *MockAuditLoggerInterface) LogTransferInitiated(ctx context.Context, transferID, fromAccountID, toAccountID uuid.UUID, amount, idempotencyKey string, userID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogTransferInitiated", ctx, transferID, fromAccountID, toAccountID, amount, idempotencyKey, userID)
+}
+
+// LogTransferInitiated indicates an expected call of LogTransferInitiated.
+func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransferInitiated(ctx, transferID, fromAccountID, toAccountID, amount, idempotencyKey, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransferInitiated", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransferInitiated), ctx, transferID, fromAccountID, toAccountID, amount, idempotencyKey, userID)
+}
+
+// MockCircuitBreakerInterface is a mock of CircuitBreakerInterface interface.
+type MockCircuitBreakerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCircuitBreakerInterfaceMockRecorder
+}
+
+// MockCircuitBreakerInterfaceMockRecorder is the mock recorder for MockCircuitBreakerInterface.
+type MockCircuitBreakerInterfaceMockRecorder struct {
+	mock *MockCircuitBreakerInterface
+}
+
+// NewMockCircuitBreakerInterface creates a new mock instance.
+func NewMockCircuitBreakerInterface(ctrl *gomock.Controller) *MockCircuitBreakerInterface {
+	mock := &MockCircuitBreakerInterface{ctrl: ctrl}
+	mock.recorder = &MockCircuitBreakerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCircuitBreakerInterface) EXPECT() *MockCircuitBreakerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetFailureCount mocks base method.
+func (m *MockCircuitBreakerInterface) GetFailureCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailureCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetFailureCount indicates an expected call of GetFailureCount.
+func (mr *MockCircuitBreakerInterfaceMockRecorder) GetFailureCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailureCount", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).GetFailureCount))
+}
+
+// GetState mocks base method.
+func (m *MockCircuitBreakerInterface) GetState() models.CircuitBreakerState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetState")
+	ret0, _ := ret[0].(models.CircuitBreakerState)
+	return ret0
+}
+
+// GetState indicates an expected call of GetState.
+func (mr *MockCircuitBreakerInterfaceMockRecorder) GetState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).GetState))
+}
+
+// IsOpen mocks base method.
+func (m *MockCircuitBreakerInterface) IsOpen() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOpen")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsOpen indicates an expected call of IsOpen.
+func (mr *MockCircuitBreakerInterfaceMockRecorder) IsOpen() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOpen", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).IsOpen))
+}
+
+// RecordFailure mocks base method.
+func (m *MockCircuitBreakerInterface) RecordFailure() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordFailure")
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockCircuitBreakerInterfaceMockRecorder) RecordFailure() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).RecordFailure))
+}
+
+// RecordSuccess mocks base method.
+func (m *MockCircuitBreakerInterface) RecordSuccess() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordSuccess")
+}
+
+// RecordSuccess indicates an expected call of RecordSuccess.
+func (mr *MockCircuitBreakerInterfaceMockRecorder) RecordSuccess() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSuccess", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).RecordSuccess))
+}
+
+// Reset mocks base method.
+func (m *MockCircuitBreakerInterface) Reset() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reset")
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockCircuitBreakerInterfaceMockRecorder) Reset() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).Reset))
+}
+
+// MockCustomerLoggerInterface is a mock of CustomerLoggerInterface interface.
+type MockCustomerLoggerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerLoggerInterfaceMockRecorder
+}
+
+// MockCustomerLoggerInterfaceMockRecorder is the mock recorder for MockCustomerLoggerInterface.
+type MockCustomerLoggerInterfaceMockRecorder struct {
+	mock *MockCustomerLoggerInterface
+}
+
+// NewMockCustomerLoggerInterface creates a new mock instance.
+func NewMockCustomerLoggerInterface(ctrl *gomock.Controller) *MockCustomerLoggerInterface {
+	mock := &MockCustomerLoggerInterface{ctrl: ctrl}
+	mock.recorder = &MockCustomerLoggerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerLoggerInterface) EXPECT() *MockCustomerLoggerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// LogAccountCreatedForCustomer mocks base method.
+func (m *MockCustomerLoggerInterface) LogAccountCreatedForCustomer(ctx context.Context, accountID, customerID, adminUserID uuid.UUID, accountNumber string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogAccountCreatedForCustomer", ctx, accountID, customerID, adminUserID, accountNumber)
+}
+
+// LogAccountCreatedForCustomer indicates an expected call of LogAccountCreatedForCustomer.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogAccountCreatedForCustomer(ctx, accountID, customerID, adminUserID, accountNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountCreatedForCustomer", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogAccountCreatedForCustomer), ctx, accountID, customerID, adminUserID, accountNumber)
+}
+
+// LogAccountOwnershipTransferred mocks base method.
+func (m *MockCustomerLoggerInterface) LogAccountOwnershipTransferred(ctx context.Context, accountID, fromCustomerID, toCustomerID, adminUserID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogAccountOwnershipTransferred", ctx, accountID, fromCustomerID, toCustomerID, adminUserID)
+}
+
+// LogAccountOwnershipTransferred indicates an expected call of LogAccountOwnershipTransferred.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogAccountOwnershipTransferred(ctx, accountID, fromCustomerID, toCustomerID, adminUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountOwnershipTransferred", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogAccountOwnershipTransferred), ctx, accountID, fromCustomerID, toCustomerID, adminUserID)
+}
+
+// LogAuthorizationFailure mocks base method.
+func (m *MockCustomerLoggerInterface) LogAuthorizationFailure(ctx context.Context, operation string, userID uuid.UUID, requiredRole string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogAuthorizationFailure", ctx, operation, userID, requiredRole)
+}
+
+// LogAuthorizationFailure indicates an expected call of LogAuthorizationFailure.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogAuthorizationFailure(ctx, operation, userID, requiredRole interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAuthorizationFailure", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogAuthorizationFailure), ctx, operation, userID, requiredRole)
+}
+
+// LogCustomerCreated mocks base method.
+func (m *MockCustomerLoggerInterface) LogCustomerCreated(ctx context.Context, customerID uuid.UUID, email string, adminUserID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogCustomerCreated", ctx, customerID, email, adminUserID)
+}
+
+// LogCustomerCreated indicates an expected call of LogCustomerCreated.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerCreated(ctx, customerID, email, adminUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerCreated", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerCreated), ctx, customerID, email, adminUserID)
+}
+
+// LogCustomerDeleted mocks base method.
+func (m *MockCustomerLoggerInterface) LogCustomerDeleted(ctx context.Context, customerID uuid.UUID, accountsDeactivated int, adminUserID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogCustomerDeleted", ctx, customerID, accountsDeactivated, adminUserID)
+}
+
+// LogCustomerDeleted indicates an expected call of LogCustomerDeleted.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerDeleted(ctx, customerID, accountsDeactivated, adminUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerDeleted", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerDeleted), ctx, customerID, accountsDeactivated, adminUserID)
+}
+
+// LogCustomerEmailUpdated mocks base method.
+func (m *MockCustomerLoggerInterface) LogCustomerEmailUpdated(ctx context.Context, customerID uuid.UUID, oldEmail, newEmail string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogCustomerEmailUpdated", ctx, customerID, oldEmail, newEmail)
+}
+
+// LogCustomerEmailUpdated indicates an expected call of LogCustomerEmailUpdated.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerEmailUpdated(ctx, customerID, oldEmail, newEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerEmailUpdated", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerEmailUpdated), ctx, customerID, oldEmail, newEmail)
+}
+
+// LogCustomerProfileUpdated mocks base method.
+func (m *MockCustomerLoggerInterface) LogCustomerProfileUpdated(ctx context.Context, customerID uuid.UUID, updatedFields []string, adminUserID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogCustomerProfileUpdated", ctx, customerID, updatedFields, adminUserID)
+}
+
+// LogCustomerProfileUpdated indicates an expected call of LogCustomerProfileUpdated.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerProfileUpdated(ctx, customerID, updatedFields, adminUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerProfileUpdated", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerProfileUpdated), ctx, customerID, updatedFields, adminUserID)
+}
+
+// LogCustomerSearchCompleted mocks base method.
+func (m *MockCustomerLoggerInterface) LogCustomerSearchCompleted(ctx context.Context, resultsCount int, durationMs int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogCustomerSearchCompleted", ctx, resultsCount, durationMs)
+}
+
+// LogCustomerSearchCompleted indicates an expected call of LogCustomerSearchCompleted.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerSearchCompleted(ctx, resultsCount, durationMs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerSearchCompleted", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerSearchCompleted), ctx, resultsCount, durationMs)
+}
+
+// LogCustomerSearchFailed mocks base method.
+func (m *MockCustomerLoggerInterface) LogCustomerSearchFailed(ctx context.Context, errorMsg string, durationMs int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogCustomerSearchFailed", ctx, errorMsg, durationMs)
+}
+
+// LogCustomerSearchFailed indicates an expected call of LogCustomerSearchFailed.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerSearchFailed(ctx, errorMsg, durationMs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerSearchFailed", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerSearchFailed), ctx, errorMsg, durationMs)
+}
+
+// LogCustomerSearchStarted mocks base method.
+func (m *MockCustomerLoggerInterface) LogCustomerSearchStarted(ctx context.Context, query, searchType string, adminUserID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogCustomerSearchStarted", ctx, query, searchType, adminUserID)
+}
+
+// LogCustomerSearchStarted indicates an expected call of LogCustomerSearchStarted.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerSearchStarted(ctx, query, searchType, adminUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerSearchStarted", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerSearchStarted), ctx, query, searchType, adminUserID)
+}
+
+// LogPasswordChanged mocks base method.
+func (m *MockCustomerLoggerInterface) LogPasswordChanged(ctx context.Context, customerID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogPasswordChanged", ctx, customerID)
+}
+
+// LogPasswordChanged indicates an expected call of LogPasswordChanged.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogPasswordChanged(ctx, customerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPasswordWithoutValidation", reflect.TypeOf((*MockPasswordServiceInterface)(nil).HashPasswordWithoutValidation), password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordChanged", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogPasswordChanged), ctx, customerID)
 }
 
-// PasswordStrength mocks base method.
-func (m *MockPasswordServiceInterface) PasswordStrength(password string) int {
+// LogPasswordReset mocks base method.
+func (m *MockCustomerLoggerInterface) LogPasswordReset(ctx context.Context, customerID, adminUserID uuid.UUID) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PasswordStrength", password)
-	ret0, _ := ret[0].(int)
-	return ret0
+	m.ctrl.Call(m, "LogPasswordReset", ctx, customerID, adminUserID)
 }
 
-// PasswordStrength indicates an expected call of PasswordStrength.
-func (mr *MockPasswordServiceInterfaceMockRecorder) PasswordStrength(password interface{}) *gomock.Call {
+// LogPasswordReset indicates an expected call of LogPasswordReset.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogPasswordReset(ctx, customerID, adminUserID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordStrength", reflect.TypeOf((*MockPasswordServiceInterface)(nil).PasswordStrength), password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordReset", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogPasswordReset), ctx, customerID, adminUserID)
 }
 
-// ValidatePassword mocks base method.
-func (m *MockPasswordServiceInterface) ValidatePassword(password string) error {
+// LogValidationFailure mocks base method.
+func (m *MockCustomerLoggerInterface) LogValidationFailure(ctx context.Context, operation, errorMsg string) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidatePassword", password)
-	ret0, _ := ret[0].(error)
-	return ret0
+	m.ctrl.Call(m, "LogValidationFailure", ctx, operation, errorMsg)
 }
 
-// ValidatePassword indicates an expected call of ValidatePassword.
-func (mr *MockPasswordServiceInterfaceMockRecorder) ValidatePassword(password interface{}) *gomock.Call {
+// LogValidationFailure indicates an expected call of LogValidationFailure.
+func (mr *MockCustomerLoggerInterfaceMockRecorder) LogValidationFailure(ctx, operation, errorMsg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).ValidatePassword), password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogValidationFailure", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogValidationFailure), ctx, operation, errorMsg)
 }
 
-// MockAuditLoggerInterface is a mock of AuditLoggerInterface interface.
-type MockAuditLoggerInterface struct {
+// MockDeadLetterServiceInterface is a mock of DeadLetterServiceInterface interface.
+type MockDeadLetterServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockAuditLoggerInterfaceMockRecorder
+	recorder *MockDeadLetterServiceInterfaceMockRecorder
 }
 
-// MockAuditLoggerInterfaceMockRecorder is the mock recorder for MockAuditLoggerInterface.
-type MockAuditLoggerInterfaceMockRecorder struct {
-	mock *MockAuditLoggerInterface
+// MockDeadLetterServiceInterfaceMockRecorder is the mock recorder for MockDeadLetterServiceInterface.
+type MockDeadLetterServiceInterfaceMockRecorder struct {
+	mock *MockDeadLetterServiceInterface
 }
 
-// NewMockAuditLoggerInterface creates a new mock instance.
-func NewMockAuditLoggerInterface(ctrl *gomock.Controller) *MockAuditLoggerInterface {
-	mock := &MockAuditLoggerInterface{ctrl: ctrl}
-	mock.recorder = &MockAuditLoggerInterfaceMockRecorder{mock}
+// NewMockDeadLetterServiceInterface creates a new mock instance.
+func NewMockDeadLetterServiceInterface(ctrl *gomock.Controller) *MockDeadLetterServiceInterface {
+	mock := &MockDeadLetterServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAuditLoggerInterface) EXPECT() *MockAuditLoggerInterfaceMockRecorder {
+func (m *MockDeadLetterServiceInterface) EXPECT() *MockDeadLetterServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// LogBalanceUpdate mocks base method.
-func (m *MockAuditLoggerInterface) LogBalanceUpdate(ctx context.Context, accountID uuid.UUID, oldBalance, newBalance string, transactionID uuid.UUID) {
+// GetItem mocks base method.
+func (m *MockDeadLetterServiceInterface) GetItem(queueItemID uuid.UUID) (*models.ProcessingQueueItem, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogBalanceUpdate", ctx, accountID, oldBalance, newBalance, transactionID)
+	ret := m.ctrl.Call(m, "GetItem", queueItemID)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogBalanceUpdate indicates an expected call of LogBalanceUpdate.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogBalanceUpdate(ctx, accountID, oldBalance, newBalance, transactionID interface{}) *gomock.Call {
+// GetItem indicates an expected call of GetItem.
+func (mr *MockDeadLetterServiceInterfaceMockRecorder) GetItem(queueItemID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogBalanceUpdate", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogBalanceUpdate), ctx, accountID, oldBalance, newBalance, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockDeadLetterServiceInterface)(nil).GetItem), queueItemID)
 }
 
-// LogCircuitBreakerStateChange mocks base method.
-func (m *MockAuditLoggerInterface) LogCircuitBreakerStateChange(ctx context.Context, service, oldState, newState string) {
+// ListFailed mocks base method.
+func (m *MockDeadLetterServiceInterface) ListFailed(filters models.QueueItemFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogCircuitBreakerStateChange", ctx, service, oldState, newState)
+	ret := m.ctrl.Call(m, "ListFailed", filters, offset, limit)
+	ret0, _ := ret[0].([]models.ProcessingQueueItem)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// LogCircuitBreakerStateChange indicates an expected call of LogCircuitBreakerStateChange.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogCircuitBreakerStateChange(ctx, service, oldState, newState interface{}) *gomock.Call {
+// ListFailed indicates an expected call of ListFailed.
+func (mr *MockDeadLetterServiceInterfaceMockRecorder) ListFailed(filters, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCircuitBreakerStateChange", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogCircuitBreakerStateChange), ctx, service, oldState, newState)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFailed", reflect.TypeOf((*MockDeadLetterServiceInterface)(nil).ListFailed), filters, offset, limit)
 }
 
-// LogOptimisticLockConflict mocks base method.
-func (m *MockAuditLoggerInterface) LogOptimisticLockConflict(ctx context.Context, entityType string, entityID uuid.UUID, expectedVersion, actualVersion int) {
+// Purge mocks base method.
+func (m *MockDeadLetterServiceInterface) Purge(adminID uuid.UUID, req *dto.PurgeQueueItemsRequest) (*dto.QueueItemsActionResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogOptimisticLockConflict", ctx, entityType, entityID, expectedVersion, actualVersion)
+	ret := m.ctrl.Call(m, "Purge", adminID, req)
+	ret0, _ := ret[0].(*dto.QueueItemsActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogOptimisticLockConflict indicates an expected call of LogOptimisticLockConflict.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogOptimisticLockConflict(ctx, entityType, entityID, expectedVersion, actualVersion interface{}) *gomock.Call {
+// Purge indicates an expected call of Purge.
+func (mr *MockDeadLetterServiceInterfaceMockRecorder) Purge(adminID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogOptimisticLockConflict", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogOptimisticLockConflict), ctx, entityType, entityID, expectedVersion, actualVersion)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDeadLetterServiceInterface)(nil).Purge), adminID, req)
 }
 
-// LogQueueItemEnqueued mocks base method.
-func (m *MockAuditLoggerInterface) LogQueueItemEnqueued(ctx context.Context, queueItemID, transactionID uuid.UUID, operation string, priority int) {
+// Replay mocks base method.
+func (m *MockDeadLetterServiceInterface) Replay(req *dto.ReplayQueueItemsRequest) (*dto.QueueItemsActionResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogQueueItemEnqueued", ctx, queueItemID, transactionID, operation, priority)
+	ret := m.ctrl.Call(m, "Replay", req)
+	ret0, _ := ret[0].(*dto.QueueItemsActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogQueueItemEnqueued indicates an expected call of LogQueueItemEnqueued.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogQueueItemEnqueued(ctx, queueItemID, transactionID, operation, priority interface{}) *gomock.Call {
+// Replay indicates an expected call of Replay.
+func (mr *MockDeadLetterServiceInterfaceMockRecorder) Replay(req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogQueueItemEnqueued", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogQueueItemEnqueued), ctx, queueItemID, transactionID, operation, priority)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockDeadLetterServiceInterface)(nil).Replay), req)
 }
 
-// LogQueueItemProcessed mocks base method.
-func (m *MockAuditLoggerInterface) LogQueueItemProcessed(ctx context.Context, queueItemID, transactionID uuid.UUID, operation string, retryCount int) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogQueueItemProcessed", ctx, queueItemID, transactionID, operation, retryCount)
+// MockTransactionProcessingServiceInterface is a mock of TransactionProcessingServiceInterface interface.
+type MockTransactionProcessingServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionProcessingServiceInterfaceMockRecorder
 }
 
-// LogQueueItemProcessed indicates an expected call of LogQueueItemProcessed.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogQueueItemProcessed(ctx, queueItemID, transactionID, operation, retryCount interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogQueueItemProcessed", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogQueueItemProcessed), ctx, queueItemID, transactionID, operation, retryCount)
+// MockTransactionProcessingServiceInterfaceMockRecorder is the mock recorder for MockTransactionProcessingServiceInterface.
+type MockTransactionProcessingServiceInterfaceMockRecorder struct {
+	mock *MockTransactionProcessingServiceInterface
 }
 
-// LogRetryAttempt mocks base method.
-func (m *MockAuditLoggerInterface) LogRetryAttempt(ctx context.Context, queueItemID, transactionID uuid.UUID, retryCount, maxRetries int, backoffMs int64) {
+// NewMockTransactionProcessingServiceInterface creates a new mock instance.
+func NewMockTransactionProcessingServiceInterface(ctrl *gomock.Controller) *MockTransactionProcessingServiceInterface {
+	mock := &MockTransactionProcessingServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTransactionProcessingServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionProcessingServiceInterface) EXPECT() *MockTransactionProcessingServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// EnqueueTransaction mocks base method.
+func (m *MockTransactionProcessingServiceInterface) EnqueueTransaction(transactionID uuid.UUID, operation string, priority int) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogRetryAttempt", ctx, queueItemID, transactionID, retryCount, maxRetries, backoffMs)
+	ret := m.ctrl.Call(m, "EnqueueTransaction", transactionID, operation, priority)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// LogRetryAttempt indicates an expected call of LogRetryAttempt.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogRetryAttempt(ctx, queueItemID, transactionID, retryCount, maxRetries, backoffMs interface{}) *gomock.Call {
+// EnqueueTransaction indicates an expected call of EnqueueTransaction.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) EnqueueTransaction(transactionID, operation, priority interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogRetryAttempt", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogRetryAttempt), ctx, queueItemID, transactionID, retryCount, maxRetries, backoffMs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueTransaction", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).EnqueueTransaction), transactionID, operation, priority)
 }
 
-// LogTransactionProcessingCompleted mocks base method.
-func (m *MockAuditLoggerInterface) LogTransactionProcessingCompleted(ctx context.Context, transactionID uuid.UUID, operation string, durationMs int64) {
+// GetQueueMetrics mocks base method.
+func (m *MockTransactionProcessingServiceInterface) GetQueueMetrics() (*dto.QueueMetrics, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogTransactionProcessingCompleted", ctx, transactionID, operation, durationMs)
+	ret := m.ctrl.Call(m, "GetQueueMetrics")
+	ret0, _ := ret[0].(*dto.QueueMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogTransactionProcessingCompleted indicates an expected call of LogTransactionProcessingCompleted.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransactionProcessingCompleted(ctx, transactionID, operation, durationMs interface{}) *gomock.Call {
+// GetQueueMetrics indicates an expected call of GetQueueMetrics.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) GetQueueMetrics() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransactionProcessingCompleted", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransactionProcessingCompleted), ctx, transactionID, operation, durationMs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueMetrics", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).GetQueueMetrics))
 }
 
-// LogTransactionProcessingFailed mocks base method.
-func (m *MockAuditLoggerInterface) LogTransactionProcessingFailed(ctx context.Context, transactionID uuid.UUID, operation, errorMsg string, retryCount int) {
+// ProcessQueueItem mocks base method.
+func (m *MockTransactionProcessingServiceInterface) ProcessQueueItem(ctx context.Context, queueItem *models.ProcessingQueueItem) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogTransactionProcessingFailed", ctx, transactionID, operation, errorMsg, retryCount)
+	ret := m.ctrl.Call(m, "ProcessQueueItem", ctx, queueItem)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// LogTransactionProcessingFailed indicates an expected call of LogTransactionProcessingFailed.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransactionProcessingFailed(ctx, transactionID, operation, errorMsg, retryCount interface{}) *gomock.Call {
+// ProcessQueueItem indicates an expected call of ProcessQueueItem.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) ProcessQueueItem(ctx, queueItem interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransactionProcessingFailed", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransactionProcessingFailed), ctx, transactionID, operation, errorMsg, retryCount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessQueueItem", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).ProcessQueueItem), ctx, queueItem)
 }
 
-// LogTransactionProcessingStarted mocks base method.
-func (m *MockAuditLoggerInterface) LogTransactionProcessingStarted(ctx context.Context, transactionID uuid.UUID, operation string) {
+// ReleaseExpiredLeases mocks base method.
+func (m *MockTransactionProcessingServiceInterface) ReleaseExpiredLeases() (int64, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogTransactionProcessingStarted", ctx, transactionID, operation)
+	ret := m.ctrl.Call(m, "ReleaseExpiredLeases")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogTransactionProcessingStarted indicates an expected call of LogTransactionProcessingStarted.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransactionProcessingStarted(ctx, transactionID, operation interface{}) *gomock.Call {
+// ReleaseExpiredLeases indicates an expected call of ReleaseExpiredLeases.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) ReleaseExpiredLeases() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransactionProcessingStarted", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransactionProcessingStarted), ctx, transactionID, operation)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpiredLeases", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).ReleaseExpiredLeases))
 }
 
-// LogTransactionStateChange mocks base method.
-func (m *MockAuditLoggerInterface) LogTransactionStateChange(ctx context.Context, transactionID uuid.UUID, oldStatus, newStatus string) {
+// StartLeaseReaper mocks base method.
+func (m *MockTransactionProcessingServiceInterface) StartLeaseReaper(ctx context.Context, pollInterval time.Duration) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogTransactionStateChange", ctx, transactionID, oldStatus, newStatus)
+	m.ctrl.Call(m, "StartLeaseReaper", ctx, pollInterval)
 }
 
-// LogTransactionStateChange indicates an expected call of LogTransactionStateChange.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransactionStateChange(ctx, transactionID, oldStatus, newStatus interface{}) *gomock.Call {
+// StartLeaseReaper indicates an expected call of StartLeaseReaper.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) StartLeaseReaper(ctx, pollInterval interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransactionStateChange", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransactionStateChange), ctx, transactionID, oldStatus, newStatus)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLeaseReaper", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).StartLeaseReaper), ctx, pollInterval)
 }
 
-// LogTransferCompleted mocks base method.
-func (m *MockAuditLoggerInterface) LogTransferCompleted(ctx context.Context, transferID uuid.UUID, durationMs int64, debitTxID, creditTxID *uuid.UUID) {
+// StartProcessing mocks base method.
+func (m *MockTransactionProcessingServiceInterface) StartProcessing(ctx context.Context) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogTransferCompleted", ctx, transferID, durationMs, debitTxID, creditTxID)
+	m.ctrl.Call(m, "StartProcessing", ctx)
 }
 
-// LogTransferCompleted indicates an expected call of LogTransferCompleted.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransferCompleted(ctx, transferID, durationMs, debitTxID, creditTxID interface{}) *gomock.Call {
+// StartProcessing indicates an expected call of StartProcessing.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) StartProcessing(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransferCompleted", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransferCompleted), ctx, transferID, durationMs, debitTxID, creditTxID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartProcessing", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).StartProcessing), ctx)
 }
 
-// LogTransferFailed mocks base method.
-func (m *MockAuditLoggerInterface) LogTransferFailed(ctx context.Context, transferID uuid.UUID, errorMsg string, durationMs int64) {
+// MockNorthWindServiceInterface is a mock of NorthWindServiceInterface interface.
+type MockNorthWindServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNorthWindServiceInterfaceMockRecorder
+}
+
+// MockNorthWindServiceInterfaceMockRecorder is the mock recorder for MockNorthWindServiceInterface.
+type MockNorthWindServiceInterfaceMockRecorder struct {
+	mock *MockNorthWindServiceInterface
+}
+
+// NewMockNorthWindServiceInterface creates a new mock instance.
+func NewMockNorthWindServiceInterface(ctrl *gomock.Controller) *MockNorthWindServiceInterface {
+	mock := &MockNorthWindServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockNorthWindServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNorthWindServiceInterface) EXPECT() *MockNorthWindServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AuthAccount mocks base method.
+func (m *MockNorthWindServiceInterface) AuthAccount(ctx context.Context, requestDto dto.NorthWindAccountRequestDto) (*dto.NorthWindAccountValidationResult, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogTransferFailed", ctx, transferID, errorMsg, durationMs)
+	ret := m.ctrl.Call(m, "AuthAccount", ctx, requestDto)
+	ret0, _ := ret[0].(*dto.NorthWindAccountValidationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogTransferFailed indicates an expected call of LogTransferFailed.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransferFailed(ctx, transferID, errorMsg, durationMs interface{}) *gomock.Call {
+// AuthAccount indicates an expected call of AuthAccount.
+func (mr *MockNorthWindServiceInterfaceMockRecorder) AuthAccount(ctx, requestDto interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransferFailed", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransferFailed), ctx, transferID, errorMsg, durationMs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthAccount", reflect.TypeOf((*MockNorthWindServiceInterface)(nil).AuthAccount), ctx, requestDto)
 }
 
-// LogTransferIdempotencyCheck mocks base method.
-func (m *MockAuditLoggerInterface) LogTransferIdempotencyCheck(ctx context.Context, idempotencyKey string, existingTransferID uuid.UUID, status string) {
+// GetTransfer mocks base method.
+func (m *MockNorthWindServiceInterface) GetTransfer(ctx context.Context, transferID string) (*dto.NorthWindTransferStatusResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogTransferIdempotencyCheck", ctx, idempotencyKey, existingTransferID, status)
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, transferID)
+	ret0, _ := ret[0].(*dto.NorthWindTransferStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogTransferIdempotencyCheck indicates an expected call of LogTransferIdempotencyCheck.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransferIdempotencyCheck(ctx, idempotencyKey, existingTransferID, status interface{}) *gomock.Call {
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockNorthWindServiceInterfaceMockRecorder) GetTransfer(ctx, transferID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransferIdempotencyCheck", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransferIdempotencyCheck), ctx, idempotencyKey, existingTransferID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockNorthWindServiceInterface)(nil).GetTransfer), ctx, transferID)
 }
 
-// LogTransferInitiated mocks base method.
-func (m *MockAuditLoggerInterface) LogTransferInitiated(ctx context.Context, transferID, fromAccountID, toAccountID uuid.UUID, amount, idempotencyKey string, userID uuid.UUID) {
+// InitiateTransfer mocks base method.
+func (m *MockNorthWindServiceInterface) InitiateTransfer(ctx context.Context, requestDto dto.NorthWindInitiateTransferRequest) (*dto.NorthWindTransferStatusResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogTransferInitiated", ctx, transferID, fromAccountID, toAccountID, amount, idempotencyKey, userID)
+	ret := m.ctrl.Call(m, "InitiateTransfer", ctx, requestDto)
+	ret0, _ := ret[0].(*dto.NorthWindTransferStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogTransferInitiated indicates an expected call of LogTransferInitiated.
-func (mr *MockAuditLoggerInterfaceMockRecorder) LogTransferInitiated(ctx, transferID, fromAccountID, toAccountID, amount, idempotencyKey, userID interface{}) *gomock.Call {
+// InitiateTransfer indicates an expected call of InitiateTransfer.
+func (mr *MockNorthWindServiceInterfaceMockRecorder) InitiateTransfer(ctx, requestDto interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTransferInitiated", reflect.TypeOf((*MockAuditLoggerInterface)(nil).LogTransferInitiated), ctx, transferID, fromAccountID, toAccountID, amount, idempotencyKey, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateTransfer", reflect.TypeOf((*MockNorthWindServiceInterface)(nil).InitiateTransfer), ctx, requestDto)
 }
 
-// MockCircuitBreakerInterface is a mock of CircuitBreakerInterface interface.
-type MockCircuitBreakerInterface struct {
+// MockNorthWindTransferClient is a mock of NorthWindTransferClient interface.
+type MockNorthWindTransferClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockCircuitBreakerInterfaceMockRecorder
+	recorder *MockNorthWindTransferClientMockRecorder
 }
 
-// MockCircuitBreakerInterfaceMockRecorder is the mock recorder for MockCircuitBreakerInterface.
-type MockCircuitBreakerInterfaceMockRecorder struct {
-	mock *MockCircuitBreakerInterface
+// MockNorthWindTransferClientMockRecorder is the mock recorder for MockNorthWindTransferClient.
+type MockNorthWindTransferClientMockRecorder struct {
+	mock *MockNorthWindTransferClient
 }
 
-// NewMockCircuitBreakerInterface creates a new mock instance.
-func NewMockCircuitBreakerInterface(ctrl *gomock.Controller) *MockCircuitBreakerInterface {
-	mock := &MockCircuitBreakerInterface{ctrl: ctrl}
-	mock.recorder = &MockCircuitBreakerInterfaceMockRecorder{mock}
+// NewMockNorthWindTransferClient creates a new mock instance.
+func NewMockNorthWindTransferClient(ctrl *gomock.Controller) *MockNorthWindTransferClient {
+	mock := &MockNorthWindTransferClient{ctrl: ctrl}
+	mock.recorder = &MockNorthWindTransferClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCircuitBreakerInterface) EXPECT() *MockCircuitBreakerInterfaceMockRecorder {
+func (m *MockNorthWindTransferClient) EXPECT() *MockNorthWindTransferClientMockRecorder {
 	return m.recorder
 }
 
-// GetFailureCount mocks base method.
-func (m *MockCircuitBreakerInterface) GetFailureCount() int {
+// AuthAccount mocks base method.
+func (m *MockNorthWindTransferClient) AuthAccount(ctx context.Context, requestDto dto.NorthWindAccountRequestDto) (*dto.NorthWindAccountValidationResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFailureCount")
-	ret0, _ := ret[0].(int)
-	return ret0
+	ret := m.ctrl.Call(m, "AuthAccount", ctx, requestDto)
+	ret0, _ := ret[0].(*dto.NorthWindAccountValidationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetFailureCount indicates an expected call of GetFailureCount.
-func (mr *MockCircuitBreakerInterfaceMockRecorder) GetFailureCount() *gomock.Call {
+// AuthAccount indicates an expected call of AuthAccount.
+func (mr *MockNorthWindTransferClientMockRecorder) AuthAccount(ctx, requestDto interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailureCount", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).GetFailureCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthAccount", reflect.TypeOf((*MockNorthWindTransferClient)(nil).AuthAccount), ctx, requestDto)
 }
 
-// GetState mocks base method.
-func (m *MockCircuitBreakerInterface) GetState() models.CircuitBreakerState {
+// GetTransfer mocks base method.
+func (m *MockNorthWindTransferClient) GetTransfer(ctx context.Context, transferID string) (*dto.NorthWindTransferStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetState")
-	ret0, _ := ret[0].(models.CircuitBreakerState)
-	return ret0
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, transferID)
+	ret0, _ := ret[0].(*dto.NorthWindTransferStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetState indicates an expected call of GetState.
-func (mr *MockCircuitBreakerInterfaceMockRecorder) GetState() *gomock.Call {
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockNorthWindTransferClientMockRecorder) GetTransfer(ctx, transferID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).GetState))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockNorthWindTransferClient)(nil).GetTransfer), ctx, transferID)
 }
 
-// IsOpen mocks base method.
-func (m *MockCircuitBreakerInterface) IsOpen() bool {
+// InitiateTransfer mocks base method.
+func (m *MockNorthWindTransferClient) InitiateTransfer(ctx context.Context, requestDto dto.NorthWindInitiateTransferRequest) (*dto.NorthWindTransferStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsOpen")
-	ret0, _ := ret[0].(bool)
-	return ret0
+	ret := m.ctrl.Call(m, "InitiateTransfer", ctx, requestDto)
+	ret0, _ := ret[0].(*dto.NorthWindTransferStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// IsOpen indicates an expected call of IsOpen.
-func (mr *MockCircuitBreakerInterfaceMockRecorder) IsOpen() *gomock.Call {
+// InitiateTransfer indicates an expected call of InitiateTransfer.
+func (mr *MockNorthWindTransferClientMockRecorder) InitiateTransfer(ctx, requestDto interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOpen", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).IsOpen))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateTransfer", reflect.TypeOf((*MockNorthWindTransferClient)(nil).InitiateTransfer), ctx, requestDto)
 }
 
-// RecordFailure mocks base method.
-func (m *MockCircuitBreakerInterface) RecordFailure() {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RecordFailure")
+// MockTransactionExportServiceInterface is a mock of TransactionExportServiceInterface interface.
+type MockTransactionExportServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionExportServiceInterfaceMockRecorder
 }
 
-// RecordFailure indicates an expected call of RecordFailure.
-func (mr *MockCircuitBreakerInterfaceMockRecorder) RecordFailure() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).RecordFailure))
+// MockTransactionExportServiceInterfaceMockRecorder is the mock recorder for MockTransactionExportServiceInterface.
+type MockTransactionExportServiceInterfaceMockRecorder struct {
+	mock *MockTransactionExportServiceInterface
 }
 
-// RecordSuccess mocks base method.
-func (m *MockCircuitBreakerInterface) RecordSuccess() {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RecordSuccess")
+// NewMockTransactionExportServiceInterface creates a new mock instance.
+func NewMockTransactionExportServiceInterface(ctrl *gomock.Controller) *MockTransactionExportServiceInterface {
+	mock := &MockTransactionExportServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTransactionExportServiceInterfaceMockRecorder{mock}
+	return mock
 }
 
-// RecordSuccess indicates an expected call of RecordSuccess.
-func (mr *MockCircuitBreakerInterfaceMockRecorder) RecordSuccess() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSuccess", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).RecordSuccess))
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionExportServiceInterface) EXPECT() *MockTransactionExportServiceInterfaceMockRecorder {
+	return m.recorder
 }
 
-// Reset mocks base method.
-func (m *MockCircuitBreakerInterface) Reset() {
+// ExportTransactions mocks base method.
+func (m *MockTransactionExportServiceInterface) ExportTransactions(account *models.Account, filters models.TransactionFilters, format string, w io.Writer) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Reset")
+	ret := m.ctrl.Call(m, "ExportTransactions", account, filters, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Reset indicates an expected call of Reset.
-func (mr *MockCircuitBreakerInterfaceMockRecorder) Reset() *gomock.Call {
+// ExportTransactions indicates an expected call of ExportTransactions.
+func (mr *MockTransactionExportServiceInterfaceMockRecorder) ExportTransactions(account, filters, format, w interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockCircuitBreakerInterface)(nil).Reset))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTransactions", reflect.TypeOf((*MockTransactionExportServiceInterface)(nil).ExportTransactions), account, filters, format, w)
 }
 
-// MockCustomerLoggerInterface is a mock of CustomerLoggerInterface interface.
-type MockCustomerLoggerInterface struct {
+// MockTransactionImportServiceInterface is a mock of TransactionImportServiceInterface interface.
+type MockTransactionImportServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockCustomerLoggerInterfaceMockRecorder
+	recorder *MockTransactionImportServiceInterfaceMockRecorder
 }
 
-// MockCustomerLoggerInterfaceMockRecorder is the mock recorder for MockCustomerLoggerInterface.
-type MockCustomerLoggerInterfaceMockRecorder struct {
-	mock *MockCustomerLoggerInterface
+// MockTransactionImportServiceInterfaceMockRecorder is the mock recorder for MockTransactionImportServiceInterface.
+type MockTransactionImportServiceInterfaceMockRecorder struct {
+	mock *MockTransactionImportServiceInterface
 }
 
-// NewMockCustomerLoggerInterface creates a new mock instance.
-func NewMockCustomerLoggerInterface(ctrl *gomock.Controller) *MockCustomerLoggerInterface {
-	mock := &MockCustomerLoggerInterface{ctrl: ctrl}
-	mock.recorder = &MockCustomerLoggerInterfaceMockRecorder{mock}
+// NewMockTransactionImportServiceInterface creates a new mock instance.
+func NewMockTransactionImportServiceInterface(ctrl *gomock.Controller) *MockTransactionImportServiceInterface {
+	mock := &MockTransactionImportServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTransactionImportServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCustomerLoggerInterface) EXPECT() *MockCustomerLoggerInterfaceMockRecorder {
+func (m *MockTransactionImportServiceInterface) EXPECT() *MockTransactionImportServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// LogAccountCreatedForCustomer mocks base method.
-func (m *MockCustomerLoggerInterface) LogAccountCreatedForCustomer(ctx context.Context, accountID, customerID, adminUserID uuid.UUID, accountNumber string) {
+// ImportTransactions mocks base method.
+func (m *MockTransactionImportServiceInterface) ImportTransactions(file io.Reader, options dto.ImportTransactionsOptions) (*dto.TransactionImportReport, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogAccountCreatedForCustomer", ctx, accountID, customerID, adminUserID, accountNumber)
+	ret := m.ctrl.Call(m, "ImportTransactions", file, options)
+	ret0, _ := ret[0].(*dto.TransactionImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogAccountCreatedForCustomer indicates an expected call of LogAccountCreatedForCustomer.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogAccountCreatedForCustomer(ctx, accountID, customerID, adminUserID, accountNumber interface{}) *gomock.Call {
+// ImportTransactions indicates an expected call of ImportTransactions.
+func (mr *MockTransactionImportServiceInterfaceMockRecorder) ImportTransactions(file, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountCreatedForCustomer", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogAccountCreatedForCustomer), ctx, accountID, customerID, adminUserID, accountNumber)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTransactions", reflect.TypeOf((*MockTransactionImportServiceInterface)(nil).ImportTransactions), file, options)
 }
 
-// LogAccountOwnershipTransferred mocks base method.
-func (m *MockCustomerLoggerInterface) LogAccountOwnershipTransferred(ctx context.Context, accountID, fromCustomerID, toCustomerID, adminUserID uuid.UUID) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogAccountOwnershipTransferred", ctx, accountID, fromCustomerID, toCustomerID, adminUserID)
+// MockMFAServiceInterface is a mock of MFAServiceInterface interface.
+type MockMFAServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFAServiceInterfaceMockRecorder
 }
 
-// LogAccountOwnershipTransferred indicates an expected call of LogAccountOwnershipTransferred.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogAccountOwnershipTransferred(ctx, accountID, fromCustomerID, toCustomerID, adminUserID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountOwnershipTransferred", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogAccountOwnershipTransferred), ctx, accountID, fromCustomerID, toCustomerID, adminUserID)
+// MockMFAServiceInterfaceMockRecorder is the mock recorder for MockMFAServiceInterface.
+type MockMFAServiceInterfaceMockRecorder struct {
+	mock *MockMFAServiceInterface
 }
 
-// LogAuthorizationFailure mocks base method.
-func (m *MockCustomerLoggerInterface) LogAuthorizationFailure(ctx context.Context, operation string, userID uuid.UUID, requiredRole string) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogAuthorizationFailure", ctx, operation, userID, requiredRole)
+// NewMockMFAServiceInterface creates a new mock instance.
+func NewMockMFAServiceInterface(ctrl *gomock.Controller) *MockMFAServiceInterface {
+	mock := &MockMFAServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockMFAServiceInterfaceMockRecorder{mock}
+	return mock
 }
 
-// LogAuthorizationFailure indicates an expected call of LogAuthorizationFailure.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogAuthorizationFailure(ctx, operation, userID, requiredRole interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAuthorizationFailure", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogAuthorizationFailure), ctx, operation, userID, requiredRole)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFAServiceInterface) EXPECT() *MockMFAServiceInterfaceMockRecorder {
+	return m.recorder
 }
 
-// LogCustomerCreated mocks base method.
-func (m *MockCustomerLoggerInterface) LogCustomerCreated(ctx context.Context, customerID uuid.UUID, email string, adminUserID uuid.UUID) {
+// BeginEnrollment mocks base method.
+func (m *MockMFAServiceInterface) BeginEnrollment(userID uuid.UUID, ipAddress, userAgent string) (*dto.MFAEnrollmentResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogCustomerCreated", ctx, customerID, email, adminUserID)
+	ret := m.ctrl.Call(m, "BeginEnrollment", userID, ipAddress, userAgent)
+	ret0, _ := ret[0].(*dto.MFAEnrollmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogCustomerCreated indicates an expected call of LogCustomerCreated.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerCreated(ctx, customerID, email, adminUserID interface{}) *gomock.Call {
+// BeginEnrollment indicates an expected call of BeginEnrollment.
+func (mr *MockMFAServiceInterfaceMockRecorder) BeginEnrollment(userID, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerCreated", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerCreated), ctx, customerID, email, adminUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginEnrollment", reflect.TypeOf((*MockMFAServiceInterface)(nil).BeginEnrollment), userID, ipAddress, userAgent)
 }
 
-// LogCustomerDeleted mocks base method.
-func (m *MockCustomerLoggerInterface) LogCustomerDeleted(ctx context.Context, customerID uuid.UUID, accountsDeactivated int, adminUserID uuid.UUID) {
+// ConfirmEnrollment mocks base method.
+func (m *MockMFAServiceInterface) ConfirmEnrollment(userID uuid.UUID, code, ipAddress, userAgent string) (*dto.MFARecoveryCodesResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogCustomerDeleted", ctx, customerID, accountsDeactivated, adminUserID)
+	ret := m.ctrl.Call(m, "ConfirmEnrollment", userID, code, ipAddress, userAgent)
+	ret0, _ := ret[0].(*dto.MFARecoveryCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogCustomerDeleted indicates an expected call of LogCustomerDeleted.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerDeleted(ctx, customerID, accountsDeactivated, adminUserID interface{}) *gomock.Call {
+// ConfirmEnrollment indicates an expected call of ConfirmEnrollment.
+func (mr *MockMFAServiceInterfaceMockRecorder) ConfirmEnrollment(userID, code, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerDeleted", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerDeleted), ctx, customerID, accountsDeactivated, adminUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEnrollment", reflect.TypeOf((*MockMFAServiceInterface)(nil).ConfirmEnrollment), userID, code, ipAddress, userAgent)
 }
 
-// LogCustomerEmailUpdated mocks base method.
-func (m *MockCustomerLoggerInterface) LogCustomerEmailUpdated(ctx context.Context, customerID uuid.UUID, oldEmail, newEmail string) {
+// Disable mocks base method.
+func (m *MockMFAServiceInterface) Disable(userID uuid.UUID, code, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogCustomerEmailUpdated", ctx, customerID, oldEmail, newEmail)
+	ret := m.ctrl.Call(m, "Disable", userID, code, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// LogCustomerEmailUpdated indicates an expected call of LogCustomerEmailUpdated.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerEmailUpdated(ctx, customerID, oldEmail, newEmail interface{}) *gomock.Call {
+// Disable indicates an expected call of Disable.
+func (mr *MockMFAServiceInterfaceMockRecorder) Disable(userID, code, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerEmailUpdated", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerEmailUpdated), ctx, customerID, oldEmail, newEmail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockMFAServiceInterface)(nil).Disable), userID, code, ipAddress, userAgent)
 }
 
-// LogCustomerProfileUpdated mocks base method.
-func (m *MockCustomerLoggerInterface) LogCustomerProfileUpdated(ctx context.Context, customerID uuid.UUID, updatedFields []string, adminUserID uuid.UUID) {
+// GetStatus mocks base method.
+func (m *MockMFAServiceInterface) GetStatus(userID uuid.UUID) (*dto.MFAStatusResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogCustomerProfileUpdated", ctx, customerID, updatedFields, adminUserID)
+	ret := m.ctrl.Call(m, "GetStatus", userID)
+	ret0, _ := ret[0].(*dto.MFAStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogCustomerProfileUpdated indicates an expected call of LogCustomerProfileUpdated.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerProfileUpdated(ctx, customerID, updatedFields, adminUserID interface{}) *gomock.Call {
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockMFAServiceInterfaceMockRecorder) GetStatus(userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerProfileUpdated", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerProfileUpdated), ctx, customerID, updatedFields, adminUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockMFAServiceInterface)(nil).GetStatus), userID)
 }
 
-// LogCustomerSearchCompleted mocks base method.
-func (m *MockCustomerLoggerInterface) LogCustomerSearchCompleted(ctx context.Context, resultsCount int, durationMs int64) {
+// IsEnabled mocks base method.
+func (m *MockMFAServiceInterface) IsEnabled(userID uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogCustomerSearchCompleted", ctx, resultsCount, durationMs)
+	ret := m.ctrl.Call(m, "IsEnabled", userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogCustomerSearchCompleted indicates an expected call of LogCustomerSearchCompleted.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerSearchCompleted(ctx, resultsCount, durationMs interface{}) *gomock.Call {
+// IsEnabled indicates an expected call of IsEnabled.
+func (mr *MockMFAServiceInterfaceMockRecorder) IsEnabled(userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerSearchCompleted", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerSearchCompleted), ctx, resultsCount, durationMs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEnabled", reflect.TypeOf((*MockMFAServiceInterface)(nil).IsEnabled), userID)
 }
 
-// LogCustomerSearchFailed mocks base method.
-func (m *MockCustomerLoggerInterface) LogCustomerSearchFailed(ctx context.Context, errorMsg string, durationMs int64) {
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockMFAServiceInterface) RegenerateRecoveryCodes(userID uuid.UUID, code, ipAddress, userAgent string) (*dto.MFARecoveryCodesResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogCustomerSearchFailed", ctx, errorMsg, durationMs)
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", userID, code, ipAddress, userAgent)
+	ret0, _ := ret[0].(*dto.MFARecoveryCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogCustomerSearchFailed indicates an expected call of LogCustomerSearchFailed.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerSearchFailed(ctx, errorMsg, durationMs interface{}) *gomock.Call {
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockMFAServiceInterfaceMockRecorder) RegenerateRecoveryCodes(userID, code, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerSearchFailed", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerSearchFailed), ctx, errorMsg, durationMs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockMFAServiceInterface)(nil).RegenerateRecoveryCodes), userID, code, ipAddress, userAgent)
 }
 
-// LogCustomerSearchStarted mocks base method.
-func (m *MockCustomerLoggerInterface) LogCustomerSearchStarted(ctx context.Context, query, searchType string, adminUserID uuid.UUID) {
+// Reset mocks base method.
+func (m *MockMFAServiceInterface) Reset(userID, adminID uuid.UUID, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogCustomerSearchStarted", ctx, query, searchType, adminUserID)
+	ret := m.ctrl.Call(m, "Reset", userID, adminID, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// LogCustomerSearchStarted indicates an expected call of LogCustomerSearchStarted.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogCustomerSearchStarted(ctx, query, searchType, adminUserID interface{}) *gomock.Call {
+// Reset indicates an expected call of Reset.
+func (mr *MockMFAServiceInterfaceMockRecorder) Reset(userID, adminID, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerSearchStarted", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogCustomerSearchStarted), ctx, query, searchType, adminUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockMFAServiceInterface)(nil).Reset), userID, adminID, ipAddress, userAgent)
 }
 
-// LogPasswordChanged mocks base method.
-func (m *MockCustomerLoggerInterface) LogPasswordChanged(ctx context.Context, customerID uuid.UUID) {
+// SetRequired mocks base method.
+func (m *MockMFAServiceInterface) SetRequired(userID, adminID uuid.UUID, required bool, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogPasswordChanged", ctx, customerID)
+	ret := m.ctrl.Call(m, "SetRequired", userID, adminID, required, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// LogPasswordChanged indicates an expected call of LogPasswordChanged.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogPasswordChanged(ctx, customerID interface{}) *gomock.Call {
+// SetRequired indicates an expected call of SetRequired.
+func (mr *MockMFAServiceInterfaceMockRecorder) SetRequired(userID, adminID, required, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordChanged", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogPasswordChanged), ctx, customerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRequired", reflect.TypeOf((*MockMFAServiceInterface)(nil).SetRequired), userID, adminID, required, ipAddress, userAgent)
 }
 
-// LogPasswordReset mocks base method.
-func (m *MockCustomerLoggerInterface) LogPasswordReset(ctx context.Context, customerID, adminUserID uuid.UUID) {
+// StepUpRequired mocks base method.
+func (m *MockMFAServiceInterface) StepUpRequired(userID uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogPasswordReset", ctx, customerID, adminUserID)
+	ret := m.ctrl.Call(m, "StepUpRequired", userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LogPasswordReset indicates an expected call of LogPasswordReset.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogPasswordReset(ctx, customerID, adminUserID interface{}) *gomock.Call {
+// StepUpRequired indicates an expected call of StepUpRequired.
+func (mr *MockMFAServiceInterfaceMockRecorder) StepUpRequired(userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordReset", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogPasswordReset), ctx, customerID, adminUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StepUpRequired", reflect.TypeOf((*MockMFAServiceInterface)(nil).StepUpRequired), userID)
 }
 
-// LogValidationFailure mocks base method.
-func (m *MockCustomerLoggerInterface) LogValidationFailure(ctx context.Context, operation, errorMsg string) {
+// Verify mocks base method.
+func (m *MockMFAServiceInterface) Verify(userID uuid.UUID, code, purpose, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "LogValidationFailure", ctx, operation, errorMsg)
+	ret := m.ctrl.Call(m, "Verify", userID, code, purpose, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// LogValidationFailure indicates an expected call of LogValidationFailure.
-func (mr *MockCustomerLoggerInterfaceMockRecorder) LogValidationFailure(ctx, operation, errorMsg interface{}) *gomock.Call {
+// Verify indicates an expected call of Verify.
+func (mr *MockMFAServiceInterfaceMockRecorder) Verify(userID, code, purpose, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogValidationFailure", reflect.TypeOf((*MockCustomerLoggerInterface)(nil).LogValidationFailure), ctx, operation, errorMsg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockMFAServiceInterface)(nil).Verify), userID, code, purpose, ipAddress, userAgent)
 }
 
-// MockTransactionProcessingServiceInterface is a mock of TransactionProcessingServiceInterface interface.
-type MockTransactionProcessingServiceInterface struct {
+// MockSessionServiceInterface is a mock of SessionServiceInterface interface.
+type MockSessionServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockTransactionProcessingServiceInterfaceMockRecorder
+	recorder *MockSessionServiceInterfaceMockRecorder
 }
 
-// MockTransactionProcessingServiceInterfaceMockRecorder is the mock recorder for MockTransactionProcessingServiceInterface.
-type MockTransactionProcessingServiceInterfaceMockRecorder struct {
-	mock *MockTransactionProcessingServiceInterface
+// MockSessionServiceInterfaceMockRecorder is the mock recorder for MockSessionServiceInterface.
+type MockSessionServiceInterfaceMockRecorder struct {
+	mock *MockSessionServiceInterface
 }
 
-// NewMockTransactionProcessingServiceInterface creates a new mock instance.
-func NewMockTransactionProcessingServiceInterface(ctrl *gomock.Controller) *MockTransactionProcessingServiceInterface {
-	mock := &MockTransactionProcessingServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockTransactionProcessingServiceInterfaceMockRecorder{mock}
+// NewMockSessionServiceInterface creates a new mock instance.
+func NewMockSessionServiceInterface(ctrl *gomock.Controller) *MockSessionServiceInterface {
+	mock := &MockSessionServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockSessionServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTransactionProcessingServiceInterface) EXPECT() *MockTransactionProcessingServiceInterfaceMockRecorder {
+func (m *MockSessionServiceInterface) EXPECT() *MockSessionServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// EnqueueTransaction mocks base method.
-func (m *MockTransactionProcessingServiceInterface) EnqueueTransaction(transactionID uuid.UUID, operation string, priority int) error {
+// CreateSession mocks base method.
+func (m *MockSessionServiceInterface) CreateSession(userID uuid.UUID, deviceName, ipAddress, userAgent string, expiresAt time.Time) (*models.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnqueueTransaction", transactionID, operation, priority)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateSession", userID, deviceName, ipAddress, userAgent, expiresAt)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EnqueueTransaction indicates an expected call of EnqueueTransaction.
-func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) EnqueueTransaction(transactionID, operation, priority interface{}) *gomock.Call {
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockSessionServiceInterfaceMockRecorder) CreateSession(userID, deviceName, ipAddress, userAgent, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueTransaction", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).EnqueueTransaction), transactionID, operation, priority)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionServiceInterface)(nil).CreateSession), userID, deviceName, ipAddress, userAgent, expiresAt)
 }
 
-// GetQueueMetrics mocks base method.
-func (m *MockTransactionProcessingServiceInterface) GetQueueMetrics() (*dto.QueueMetrics, error) {
+// HandleRefreshTokenReuse mocks base method.
+func (m *MockSessionServiceInterface) HandleRefreshTokenReuse(token *models.RefreshToken, ipAddress, userAgent string) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetQueueMetrics")
-	ret0, _ := ret[0].(*dto.QueueMetrics)
+	m.ctrl.Call(m, "HandleRefreshTokenReuse", token, ipAddress, userAgent)
+}
+
+// HandleRefreshTokenReuse indicates an expected call of HandleRefreshTokenReuse.
+func (mr *MockSessionServiceInterfaceMockRecorder) HandleRefreshTokenReuse(token, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRefreshTokenReuse", reflect.TypeOf((*MockSessionServiceInterface)(nil).HandleRefreshTokenReuse), token, ipAddress, userAgent)
+}
+
+// ListSessions mocks base method.
+func (m *MockSessionServiceInterface) ListSessions(userID, currentSessionID uuid.UUID) ([]dto.SessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", userID, currentSessionID)
+	ret0, _ := ret[0].([]dto.SessionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetQueueMetrics indicates an expected call of GetQueueMetrics.
-func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) GetQueueMetrics() *gomock.Call {
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockSessionServiceInterfaceMockRecorder) ListSessions(userID, currentSessionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueMetrics", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).GetQueueMetrics))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockSessionServiceInterface)(nil).ListSessions), userID, currentSessionID)
 }
 
-// ProcessQueueItem mocks base method.
-func (m *MockTransactionProcessingServiceInterface) ProcessQueueItem(ctx context.Context, queueItem *models.ProcessingQueueItem) error {
+// RecordUse mocks base method.
+func (m *MockSessionServiceInterface) RecordUse(sessionID uuid.UUID, ipAddress, userAgent string, expiresAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProcessQueueItem", ctx, queueItem)
+	ret := m.ctrl.Call(m, "RecordUse", sessionID, ipAddress, userAgent, expiresAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ProcessQueueItem indicates an expected call of ProcessQueueItem.
-func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) ProcessQueueItem(ctx, queueItem interface{}) *gomock.Call {
+// RecordUse indicates an expected call of RecordUse.
+func (mr *MockSessionServiceInterfaceMockRecorder) RecordUse(sessionID, ipAddress, userAgent, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessQueueItem", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).ProcessQueueItem), ctx, queueItem)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUse", reflect.TypeOf((*MockSessionServiceInterface)(nil).RecordUse), sessionID, ipAddress, userAgent, expiresAt)
 }
 
-// StartProcessing mocks base method.
-func (m *MockTransactionProcessingServiceInterface) StartProcessing(ctx context.Context) {
+// RevokeAllSessions mocks base method.
+func (m *MockSessionServiceInterface) RevokeAllSessions(userID, performedBy uuid.UUID, reason, ipAddress, userAgent string) (int, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "StartProcessing", ctx)
+	ret := m.ctrl.Call(m, "RevokeAllSessions", userID, performedBy, reason, ipAddress, userAgent)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// StartProcessing indicates an expected call of StartProcessing.
-func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) StartProcessing(ctx interface{}) *gomock.Call {
+// RevokeAllSessions indicates an expected call of RevokeAllSessions.
+func (mr *MockSessionServiceInterfaceMockRecorder) RevokeAllSessions(userID, performedBy, reason, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartProcessing", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).StartProcessing), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllSessions", reflect.TypeOf((*MockSessionServiceInterface)(nil).RevokeAllSessions), userID, performedBy, reason, ipAddress, userAgent)
 }
 
-// MockNorthWindServiceInterface is a mock of NorthWindServiceInterface interface.
-type MockNorthWindServiceInterface struct {
+// RevokeSession mocks base method.
+func (m *MockSessionServiceInterface) RevokeSession(userID, sessionID, performedBy uuid.UUID, reason, ipAddress, userAgent string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", userID, sessionID, performedBy, reason, ipAddress, userAgent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockSessionServiceInterfaceMockRecorder) RevokeSession(userID, sessionID, performedBy, reason, ipAddress, userAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockSessionServiceInterface)(nil).RevokeSession), userID, sessionID, performedBy, reason, ipAddress, userAgent)
+}
+
+// MockRoleServiceInterface is a mock of RoleServiceInterface interface.
+type MockRoleServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockNorthWindServiceInterfaceMockRecorder
+	recorder *MockRoleServiceInterfaceMockRecorder
 }
 
-// MockNorthWindServiceInterfaceMockRecorder is the mock recorder for MockNorthWindServiceInterface.
-type MockNorthWindServiceInterfaceMockRecorder struct {
-	mock *MockNorthWindServiceInterface
+// MockRoleServiceInterfaceMockRecorder is the mock recorder for MockRoleServiceInterface.
+type MockRoleServiceInterfaceMockRecorder struct {
+	mock *MockRoleServiceInterface
 }
 
-// NewMockNorthWindServiceInterface creates a new mock instance.
-func NewMockNorthWindServiceInterface(ctrl *gomock.Controller) *MockNorthWindServiceInterface {
-	mock := &MockNorthWindServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockNorthWindServiceInterfaceMockRecorder{mock}
+// NewMockRoleServiceInterface creates a new mock instance.
+func NewMockRoleServiceInterface(ctrl *gomock.Controller) *MockRoleServiceInterface {
+	mock := &MockRoleServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockRoleServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockNorthWindServiceInterface) EXPECT() *MockNorthWindServiceInterfaceMockRecorder {
+func (m *MockRoleServiceInterface) EXPECT() *MockRoleServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// AuthAccount mocks base method.
-func (m *MockNorthWindServiceInterface) AuthAccount(ctx context.Context, requestDto dto.NorthWindAccountRequestDto) (*dto.NorthWindAccountValidationResult, error) {
+// AssignRole mocks base method.
+func (m *MockRoleServiceInterface) AssignRole(userID uuid.UUID, role string, performedBy uuid.UUID, ipAddress, userAgent string) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthAccount", ctx, requestDto)
-	ret0, _ := ret[0].(*dto.NorthWindAccountValidationResult)
+	ret := m.ctrl.Call(m, "AssignRole", userID, role, performedBy, ipAddress, userAgent)
+	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AuthAccount indicates an expected call of AuthAccount.
-func (mr *MockNorthWindServiceInterfaceMockRecorder) AuthAccount(ctx, requestDto interface{}) *gomock.Call {
+// AssignRole indicates an expected call of AssignRole.
+func (mr *MockRoleServiceInterfaceMockRecorder) AssignRole(userID, role, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthAccount", reflect.TypeOf((*MockNorthWindServiceInterface)(nil).AuthAccount), ctx, requestDto)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockRoleServiceInterface)(nil).AssignRole), userID, role, performedBy, ipAddress, userAgent)
+}
+
+// ListRoles mocks base method.
+func (m *MockRoleServiceInterface) ListRoles() ([]dto.RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles")
+	ret0, _ := ret[0].([]dto.RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockRoleServiceInterfaceMockRecorder) ListRoles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRoleServiceInterface)(nil).ListRoles))
 }
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+// maxDeviceNameLength matches the sessions.device_name column
+const maxDeviceNameLength = 100
+
+// SessionService manages signed-in sessions. Revoking a session revokes its
+// refresh token family and blacklists its ID, which RequireAuth checks, so the
+// session's access tokens stop working at once rather than when they expire.
+type SessionService struct {
+	sessionRepo          repositories.SessionRepositoryInterface
+	blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface
+	auditRepo            repositories.AuditLogRepositoryInterface
+	logger               *slog.Logger
+}
+
+// NewSessionService creates a new session service
+func NewSessionService(
+	sessionRepo repositories.SessionRepositoryInterface,
+	blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	logger *slog.Logger,
+) SessionServiceInterface {
+	return &SessionService{
+		sessionRepo:          sessionRepo,
+		blacklistedTokenRepo: blacklistedTokenRepo,
+		auditRepo:            auditRepo,
+		logger:               logger,
+	}
+}
+
+// CreateSession starts a session for a login. Without a device name, one is
+// derived from the user agent.
+func (s *SessionService) CreateSession(userID uuid.UUID, deviceName, ipAddress, userAgent string, expiresAt time.Time) (*models.Session, error) {
+	deviceName = strings.TrimSpace(deviceName)
+	if deviceName == "" {
+		deviceName = describeDevice(userAgent)
+	}
+	if len(deviceName) > maxDeviceNameLength {
+		deviceName = deviceName[:maxDeviceNameLength]
+	}
+
+	session := &models.Session{
+		UserID:     userID,
+		DeviceName: deviceName,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		ExpiresAt:  expiresAt,
+	}
+
+	if err := s.sessionRepo.Create(session); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return session, nil
+}
+
+// RecordUse records a token refresh on the session. It returns
+// ErrSessionNotFound if the session has been revoked.
+func (s *SessionService) RecordUse(sessionID uuid.UUID, ipAddress, userAgent string, expiresAt time.Time) error {
+	if err := s.sessionRepo.RecordUse(sessionID, ipAddress, userAgent, expiresAt); err != nil {
+		if errors.Is(err, repositories.ErrSessionNotFound) {
+			return ErrSessionNotFound
+		}
+		return fmt.Errorf("failed to record session use: %w", err)
+	}
+	return nil
+}
+
+// ListSessions lists the user's active sessions, marking currentSessionID as the
+// current one
+func (s *SessionService) ListSessions(userID, currentSessionID uuid.UUID) ([]dto.SessionResponse, error) {
+	sessions, err := s.sessionRepo.ListActiveByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	responses := make([]dto.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = dto.SessionResponse{
+			ID:         session.ID,
+			DeviceName: session.DeviceName,
+			IPAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.ID == currentSessionID,
+		}
+	}
+
+	return responses, nil
+}
+
+// RevokeSession signs out one of the user's sessions. performedBy is the user
+// themselves or the admin acting on their behalf.
+func (s *SessionService) RevokeSession(userID, sessionID, performedBy uuid.UUID, reason, ipAddress, userAgent string) error {
+	session, err := s.sessionRepo.GetByID(sessionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSessionNotFound) {
+			return ErrSessionNotFound
+		}
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	// Security: Other users' sessions are reported as not found
+	if session.UserID != userID || !session.IsActive() {
+		return ErrSessionNotFound
+	}
+
+	revoked, err := s.sessionRepo.Revoke(session.ID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	if !revoked {
+		return ErrSessionNotFound
+	}
+
+	s.blacklistSession(session)
+
+	s.createAuditLog(&performedBy, models.AuditActionSessionRevoked, session.ID.String(), ipAddress, userAgent, map[string]interface{}{
+		"user_id": userID,
+		"reason":  reason,
+	})
+
+	return nil
+}
+
+// RevokeAllSessions signs the user out everywhere and returns how many sessions
+// were revoked
+func (s *SessionService) RevokeAllSessions(userID, performedBy uuid.UUID, reason, ipAddress, userAgent string) (int, error) {
+	sessions, err := s.sessionRepo.RevokeAllForUser(userID, reason)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	for _, session := range sessions {
+		s.blacklistSession(session)
+	}
+
+	s.createAuditLog(&performedBy, models.AuditActionSessionsRevoked, userID.String(), ipAddress, userAgent, map[string]interface{}{
+		"user_id":       userID,
+		"reason":        reason,
+		"revoked_count": len(sessions),
+	})
+
+	return len(sessions), nil
+}
+
+// HandleRefreshTokenReuse responds to a rotated refresh token being presented
+// again, which means the token family has leaked: the legitimate client and an
+// attacker both hold a token from it. The whole session is revoked, or for a
+// token issued before sessions existed, every session of the user.
+func (s *SessionService) HandleRefreshTokenReuse(token *models.RefreshToken, ipAddress, userAgent string) {
+	metadata := map[string]interface{}{
+		"token_id": token.ID,
+	}
+
+	if token.SessionID != nil {
+		metadata["session_id"] = *token.SessionID
+
+		session, err := s.sessionRepo.GetByID(*token.SessionID)
+		if err == nil {
+			revoked, err := s.sessionRepo.Revoke(session.ID, models.SessionRevokedRefreshTokenReuse)
+			if err != nil {
+				s.logger.Error("failed to revoke session after refresh token reuse",
+					"error", err,
+					"user_id", token.UserID,
+					"session_id", session.ID)
+			} else if revoked {
+				s.blacklistSession(session)
+			}
+		} else {
+			s.logger.Error("failed to get session after refresh token reuse",
+				"error", err,
+				"user_id", token.UserID,
+				"session_id", *token.SessionID)
+		}
+	} else {
+		sessions, err := s.sessionRepo.RevokeAllForUser(token.UserID, models.SessionRevokedRefreshTokenReuse)
+		if err != nil {
+			s.logger.Error("failed to revoke sessions after refresh token reuse",
+				"error", err,
+				"user_id", token.UserID)
+		}
+		for _, session := range sessions {
+			s.blacklistSession(session)
+		}
+		metadata["revoked_count"] = len(sessions)
+	}
+
+	s.logger.Warn("refresh token reuse detected",
+		"user_id", token.UserID,
+		"token_id", token.ID,
+		"ip_address", ipAddress)
+
+	s.createAuditLog(&token.UserID, models.AuditActionRefreshTokenReuse, token.UserID.String(), ipAddress, userAgent, metadata)
+}
+
+// blacklistSession blacklists a revoked session's ID until the session would have
+// expired, which outlasts every access token issued to it
+func (s *SessionService) blacklistSession(session *models.Session) {
+	token := &models.BlacklistedToken{
+		JTI:       session.ID.String(),
+		UserID:    session.UserID,
+		ExpiresAt: session.ExpiresAt,
+	}
+
+	if err := s.blacklistedTokenRepo.Create(token); err != nil {
+		// Non-critical: The session's access tokens still expire on their own
+		s.logger.Error("failed to blacklist revoked session",
+			"error", err,
+			"user_id", session.UserID,
+			"session_id", session.ID)
+	}
+}
+
+func (s *SessionService) createAuditLog(userID *uuid.UUID, action, resourceID, ipAddress, userAgent string, metadata map[string]interface{}) {
+	recordAuditLog(s.auditRepo, s.logger, &models.AuditLog{
+		UserID:     userID,
+		Action:     action,
+		Resource:   "session",
+		ResourceID: resourceID,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		Metadata:   metadata,
+	})
+}
+
+// describeDevice names a device from its user agent, e.g. "Firefox on Windows"
+func describeDevice(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+
+	var browser string
+	switch {
+	case strings.Contains(ua, "edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "firefox/"):
+		browser = "Firefox"
+	case strings.Contains(ua, "chrome/") || strings.Contains(ua, "crios/"):
+		browser = "Chrome"
+	case strings.Contains(ua, "safari/"):
+		browser = "Safari"
+	}
+
+	var platform string
+	switch {
+	case strings.Contains(ua, "iphone") || strings.Contains(ua, "ipad"):
+		platform = "iOS"
+	case strings.Contains(ua, "android"):
+		platform = "Android"
+	case strings.Contains(ua, "windows"):
+		platform = "Windows"
+	case strings.Contains(ua, "mac os"):
+		platform = "macOS"
+	case strings.Contains(ua, "linux"):
+		platform = "Linux"
+	}
+
+	switch {
+	case browser != "" && platform != "":
+		return browser + " on " + platform
+	case browser != "":
+		return browser
+	case platform != "":
+		return platform + " device"
+	default:
+		return "Unknown device"
+	}
+}
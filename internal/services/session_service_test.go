@@ -0,0 +1,197 @@
+package services
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionServiceTestSuite struct {
+	suite.Suite
+	ctrl                 *gomock.Controller
+	sessionRepo          *repository_mocks.MockSessionRepositoryInterface
+	blacklistedTokenRepo *repository_mocks.MockBlacklistedTokenRepositoryInterface
+	auditRepo            *repository_mocks.MockAuditLogRepositoryInterface
+	sessionService       SessionServiceInterface
+	userID               uuid.UUID
+}
+
+func (s *SessionServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.sessionRepo = repository_mocks.NewMockSessionRepositoryInterface(s.ctrl)
+	s.blacklistedTokenRepo = repository_mocks.NewMockBlacklistedTokenRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.sessionService = NewSessionService(s.sessionRepo, s.blacklistedTokenRepo, s.auditRepo, slog.Default())
+	s.userID = uuid.New()
+}
+
+func (s *SessionServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestSessionServiceSuite(t *testing.T) {
+	suite.Run(t, new(SessionServiceTestSuite))
+}
+
+func (s *SessionServiceTestSuite) activeSession() *models.Session {
+	return &models.Session{
+		ID:        uuid.New(),
+		UserID:    s.userID,
+		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+	}
+}
+
+func (s *SessionServiceTestSuite) TestCreateSession_NamesDeviceFromUserAgent() {
+	userAgent := "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_0) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15"
+
+	s.sessionRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	session, err := s.sessionService.CreateSession(s.userID, "  ", "192.168.1.1", userAgent, time.Now().Add(time.Hour))
+
+	s.Require().NoError(err)
+	s.Equal("Safari on macOS", session.DeviceName)
+	s.Equal("192.168.1.1", session.IPAddress)
+}
+
+func (s *SessionServiceTestSuite) TestCreateSession_KeepsGivenDeviceName() {
+	s.sessionRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	session, err := s.sessionService.CreateSession(s.userID, "Work laptop", "192.168.1.1", "curl/8.0", time.Now().Add(time.Hour))
+
+	s.Require().NoError(err)
+	s.Equal("Work laptop", session.DeviceName)
+}
+
+func (s *SessionServiceTestSuite) TestDescribeDevice() {
+	testCases := map[string]string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36 Edg/120.0": "Edge on Windows",
+		"Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0":                                                "Firefox on Linux",
+		"Mozilla/5.0 (Linux; Android 14) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Mobile Safari/537.36":              "Chrome on Android",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148":         "iOS device",
+		"curl/8.0": "Unknown device",
+	}
+
+	for userAgent, expected := range testCases {
+		s.Equal(expected, describeDevice(userAgent), userAgent)
+	}
+}
+
+func (s *SessionServiceTestSuite) TestListSessions_MarksCurrent() {
+	current := s.activeSession()
+	other := s.activeSession()
+
+	s.sessionRepo.EXPECT().ListActiveByUserID(s.userID).Return([]*models.Session{other, current}, nil).Times(1)
+
+	sessions, err := s.sessionService.ListSessions(s.userID, current.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(sessions, 2)
+	s.False(sessions[0].Current)
+	s.True(sessions[1].Current)
+}
+
+func (s *SessionServiceTestSuite) TestRevokeSession_BlacklistsSession() {
+	session := s.activeSession()
+
+	s.sessionRepo.EXPECT().GetByID(session.ID).Return(session, nil).Times(1)
+	s.sessionRepo.EXPECT().Revoke(session.ID, models.SessionRevokedByUser).Return(true, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(token *models.BlacklistedToken) error {
+		s.Equal(session.ID.String(), token.JTI, "RequireAuth rejects the session's access tokens")
+		s.Equal(session.ExpiresAt, token.ExpiresAt)
+		return nil
+	}).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionSessionRevoked, log.Action)
+		return nil
+	}).Times(1)
+
+	err := s.sessionService.RevokeSession(s.userID, session.ID, s.userID, models.SessionRevokedByUser, "192.168.1.1", "Mozilla/5.0")
+
+	s.NoError(err)
+}
+
+func (s *SessionServiceTestSuite) TestRevokeSession_OtherUsersSession() {
+	session := s.activeSession()
+	session.UserID = uuid.New()
+
+	s.sessionRepo.EXPECT().GetByID(session.ID).Return(session, nil).Times(1)
+
+	err := s.sessionService.RevokeSession(s.userID, session.ID, s.userID, models.SessionRevokedByUser, "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrSessionNotFound)
+}
+
+func (s *SessionServiceTestSuite) TestRevokeSession_AlreadyRevoked() {
+	session := s.activeSession()
+	revokedAt := time.Now()
+	session.RevokedAt = &revokedAt
+
+	s.sessionRepo.EXPECT().GetByID(session.ID).Return(session, nil).Times(1)
+
+	err := s.sessionService.RevokeSession(s.userID, session.ID, s.userID, models.SessionRevokedByUser, "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrSessionNotFound)
+}
+
+func (s *SessionServiceTestSuite) TestRevokeSession_NotFound() {
+	sessionID := uuid.New()
+
+	s.sessionRepo.EXPECT().GetByID(sessionID).Return(nil, repositories.ErrSessionNotFound).Times(1)
+
+	err := s.sessionService.RevokeSession(s.userID, sessionID, s.userID, models.SessionRevokedByUser, "192.168.1.1", "Mozilla/5.0")
+
+	s.ErrorIs(err, ErrSessionNotFound)
+}
+
+func (s *SessionServiceTestSuite) TestRevokeAllSessions() {
+	adminID := uuid.New()
+	sessions := []*models.Session{s.activeSession(), s.activeSession()}
+
+	s.sessionRepo.EXPECT().RevokeAllForUser(s.userID, models.SessionRevokedByAdmin).Return(sessions, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(2)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionSessionsRevoked, log.Action)
+		s.Equal(&adminID, log.UserID, "the admin is recorded as performing the sign-out")
+		return nil
+	}).Times(1)
+
+	count, err := s.sessionService.RevokeAllSessions(s.userID, adminID, models.SessionRevokedByAdmin, "10.0.0.1", "admin-console")
+
+	s.Require().NoError(err)
+	s.Equal(2, count)
+}
+
+func (s *SessionServiceTestSuite) TestHandleRefreshTokenReuse_RevokesFamily() {
+	session := s.activeSession()
+	token := &models.RefreshToken{ID: uuid.New(), UserID: s.userID, SessionID: &session.ID}
+
+	s.sessionRepo.EXPECT().GetByID(session.ID).Return(session, nil).Times(1)
+	s.sessionRepo.EXPECT().Revoke(session.ID, models.SessionRevokedRefreshTokenReuse).Return(true, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionRefreshTokenReuse, log.Action)
+		s.Equal(session.ID, log.Metadata["session_id"])
+		return nil
+	}).Times(1)
+
+	s.sessionService.HandleRefreshTokenReuse(token, "203.0.113.9", "curl/8.0")
+}
+
+func (s *SessionServiceTestSuite) TestHandleRefreshTokenReuse_TokenWithoutSession() {
+	token := &models.RefreshToken{ID: uuid.New(), UserID: s.userID}
+	sessions := []*models.Session{s.activeSession()}
+
+	s.sessionRepo.EXPECT().RevokeAllForUser(s.userID, models.SessionRevokedRefreshTokenReuse).Return(sessions, nil).Times(1)
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+	s.sessionService.HandleRefreshTokenReuse(token, "203.0.113.9", "curl/8.0")
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PDF page geometry in points. Text is set in 8pt Courier so statement columns
+// line up without font metrics: each character is 4.8pt wide, giving 106
+// characters per line between the margins.
+const (
+	pdfPageWidth    = 612
+	pdfPageHeight   = 792
+	pdfMargin       = 50
+	pdfFontSize     = 8
+	pdfLeading      = 11
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLeading
+	pdfLineWidth    = 106
+)
+
+// pdfDocument is a minimal PDF 1.4 writer for pages of plain fixed-pitch text. It
+// uses the standard Courier font, which every PDF reader provides, so nothing is
+// embedded and the output depends only on the text, title and creation time.
+type pdfDocument struct {
+	title   string
+	created time.Time
+	pages   [][]string
+}
+
+// addPage appends a page holding lines, top to bottom. Lines beyond
+// pdfLinesPerPage or pdfLineWidth are cut off by the page edge.
+func (d *pdfDocument) addPage(lines []string) {
+	d.pages = append(d.pages, lines)
+}
+
+// bytes serializes the document. Objects 1-4 are the catalog, page tree, font and
+// document info; each page then takes a page object and a content stream.
+func (d *pdfDocument) bytes() []byte {
+	var buf bytes.Buffer
+	var offsets []int
+
+	writeObject := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// The binary comment marks the file as binary for transfer tools
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	writeObject(fmt.Sprintf("<< /Title (%s) /CreationDate (D:%sZ) >>",
+		pdfEscape(d.title), d.created.UTC().Format("20060102150405")))
+
+	for i, lines := range d.pages {
+		writeObject(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 6+2*i))
+
+		content := pdfPageContent(lines)
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 4 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}
+
+// pdfPageContent builds the content stream that draws lines from the top margin down
+func pdfPageContent(lines []string) string {
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLeading, pdfMargin, pdfPageHeight-pdfMargin)
+	for i, line := range lines {
+		if i > 0 {
+			content.WriteString("T*\n")
+		}
+		fmt.Fprintf(&content, "(%s) Tj\n", pdfEscape(line))
+	}
+	content.WriteString("ET")
+	return content.String()
+}
+
+// pdfEscape makes text safe inside a PDF string literal. Characters outside
+// printable ASCII are replaced with '?' since Courier is not embedded.
+func pdfEscape(text string) string {
+	var escaped strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			escaped.WriteByte('\\')
+			escaped.WriteRune(r)
+		case r < ' ' || r > '~':
+			escaped.WriteByte('?')
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+	return escaped.String()
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+// statementRenderers render a statement into a downloadable document, by format
+var statementRenderers = map[string]func(statement *models.AccountStatement) ([]byte, error){
+	models.StatementFormatPDF: renderStatementPDF,
+	models.StatementFormatCSV: renderStatementCSV,
+}
+
+// renderStatementCSV renders one row per transaction with its running balance
+func renderStatementCSV(statement *models.AccountStatement) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	rows := [][]string{{"date", "transaction_id", "reference", "description", "type", "amount", "running_balance", "status"}}
+	for _, txn := range statement.Transactions {
+		rows = append(rows, []string{
+			txn.Date.UTC().Format(time.RFC3339),
+			txn.ID.String(),
+			csvSafe(txn.Reference),
+			csvSafe(txn.Description),
+			txn.TransactionType,
+			txn.Amount.StringFixed(2),
+			txn.RunningBalance.StringFixed(2),
+			txn.Status,
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("failed to write statement CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// csvSafe stops spreadsheets from evaluating free text as a formula
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// Statement PDF table columns; amounts are right-aligned
+const (
+	pdfDateWidth        = 10
+	pdfReferenceWidth   = 27
+	pdfDescriptionWidth = 22
+	pdfAmountWidth      = 11
+	pdfBalanceWidth     = 12
+)
+
+// renderStatementPDF renders the statement summary followed by its transactions,
+// repeating the table heading on each page and numbering the pages
+func renderStatementPDF(statement *models.AccountStatement) ([]byte, error) {
+	heading := []string{
+		pdfRow("Date", "Reference", "Description", "Debit", "Credit", "Balance"),
+		strings.Repeat("-", pdfDateWidth+pdfReferenceWidth+pdfDescriptionWidth+2*pdfAmountWidth+pdfBalanceWidth+10),
+	}
+
+	summary := statementSummaryLines(statement)
+
+	var rows []string
+	for _, txn := range statement.Transactions {
+		debit, credit := "", ""
+		if txn.TransactionType == models.TransactionTypeDebit {
+			debit = txn.Amount.StringFixed(2)
+		} else {
+			credit = txn.Amount.StringFixed(2)
+		}
+
+		description := txn.Description
+		if txn.Status != models.TransactionStatusCompleted {
+			marker := " (" + txn.Status + ")"
+			description = truncate(description, pdfDescriptionWidth-len(marker)) + marker
+		}
+
+		rows = append(rows, pdfRow(txn.Date.UTC().Format("2006-01-02"), txn.Reference, description, debit, credit, txn.RunningBalance.StringFixed(2)))
+	}
+	if len(rows) == 0 {
+		rows = append(rows, "No transactions in this period.")
+	}
+
+	// Each page keeps its last two lines for a blank line and the page number
+	var pages [][]string
+	page := append(summary, heading...)
+	for _, row := range rows {
+		if len(page) == pdfLinesPerPage-2 {
+			pages = append(pages, page)
+			page = append([]string{}, heading...)
+		}
+		page = append(page, row)
+	}
+	pages = append(pages, page)
+
+	document := &pdfDocument{
+		title:   fmt.Sprintf("Account statement %s %s", statement.AccountNumber, statementPeriodLabel(statement)),
+		created: statement.GeneratedAt,
+	}
+	for i, lines := range pages {
+		footer := fmt.Sprintf("Page %d of %d", i+1, len(pages))
+		for len(lines) < pdfLinesPerPage-1 {
+			lines = append(lines, "")
+		}
+		document.addPage(append(lines, fmt.Sprintf("%*s", pdfLineWidth, footer)))
+	}
+
+	return document.bytes(), nil
+}
+
+// statementSummaryLines heads the first page with the account, period and totals
+func statementSummaryLines(statement *models.AccountStatement) []string {
+	summary := statement.Summary
+	amount := func(value decimal.Decimal) string {
+		return fmt.Sprintf("%15s", value.StringFixed(2))
+	}
+
+	return []string{
+		"ACCOUNT STATEMENT",
+		"",
+		fmt.Sprintf("Account            %s (%s, %s)", statement.AccountNumber, statement.AccountType, statement.Currency),
+		fmt.Sprintf("Statement period   %s to %s (%s)",
+			statement.StartDate.Format("2006-01-02"), statement.EndDate.Format("2006-01-02"), statementPeriodLabel(statement)),
+		fmt.Sprintf("Generated          %s", statement.GeneratedAt.UTC().Format("2006-01-02 15:04 MST")),
+		"",
+		fmt.Sprintf("Opening balance    %s", amount(statement.OpeningBalance)),
+		fmt.Sprintf("Total deposits     %s  (%d)", amount(summary.TotalDeposits), summary.DepositCount),
+		fmt.Sprintf("Total withdrawals  %s  (%d)", amount(summary.TotalWithdrawals), summary.WithdrawalCount),
+		fmt.Sprintf("Net change         %s", amount(summary.NetChange)),
+		fmt.Sprintf("Closing balance    %s", amount(statement.ClosingBalance)),
+		"",
+	}
+}
+
+// statementPeriodLabel names a statement period, such as 2025-09 or 2025-Q3
+func statementPeriodLabel(statement *models.AccountStatement) string {
+	if statement.PeriodType == PeriodTypeQuarterly {
+		return fmt.Sprintf("%d-Q%d", statement.Year, statement.Period)
+	}
+	return fmt.Sprintf("%d-%02d", statement.Year, statement.Period)
+}
+
+// pdfRow lays out one line of the statement table
+func pdfRow(date, reference, description, debit, credit, balance string) string {
+	return fmt.Sprintf("%-*s  %-*s  %-*s  %*s  %*s  %*s",
+		pdfDateWidth, date,
+		pdfReferenceWidth, truncate(reference, pdfReferenceWidth),
+		pdfDescriptionWidth, truncate(description, pdfDescriptionWidth),
+		pdfAmountWidth, debit,
+		pdfAmountWidth, credit,
+		pdfBalanceWidth, balance)
+}
+
+// truncate shortens text to at most width characters
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	if width <= 3 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-3]) + "..."
+}
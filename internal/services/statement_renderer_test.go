@@ -0,0 +1,131 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRenderTestStatement(transactionCount int) *models.AccountStatement {
+	startDate := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	statement := &models.AccountStatement{
+		AccountID:      uuid.New(),
+		AccountNumber:  "1234567890",
+		AccountType:    models.AccountTypeChecking,
+		Currency:       "USD",
+		PeriodType:     PeriodTypeMonthly,
+		Year:           2025,
+		Period:         9,
+		StartDate:      startDate,
+		EndDate:        startDate.AddDate(0, 1, 0).Add(-time.Second),
+		OpeningBalance: decimal.NewFromInt(1000),
+		ClosingBalance: decimal.NewFromInt(1000 + 10*int64(transactionCount)),
+		GeneratedAt:    time.Date(2025, 10, 1, 8, 30, 0, 0, time.UTC),
+	}
+
+	for i := 0; i < transactionCount; i++ {
+		statement.Transactions = append(statement.Transactions, models.StatementTransaction{
+			ID:              uuid.New(),
+			Date:            startDate.Add(time.Duration(i) * time.Hour),
+			Description:     fmt.Sprintf("Deposit %d", i+1),
+			TransactionType: models.TransactionTypeCredit,
+			Amount:          decimal.NewFromInt(10),
+			RunningBalance:  decimal.NewFromInt(1010 + 10*int64(i)),
+			Reference:       fmt.Sprintf("TXN-%03d", i+1),
+			Status:          models.TransactionStatusCompleted,
+		})
+	}
+	return statement
+}
+
+func TestRenderStatementCSV(t *testing.T) {
+	statement := newRenderTestStatement(2)
+	statement.Transactions[1].Description = "=HYPERLINK(\"http://example.com\")"
+	statement.Transactions[1].TransactionType = models.TransactionTypeDebit
+
+	content, err := renderStatementCSV(statement)
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, []string{"date", "transaction_id", "reference", "description", "type", "amount", "running_balance", "status"}, rows[0])
+	assert.Equal(t, []string{
+		"2025-09-01T00:00:00Z",
+		statement.Transactions[0].ID.String(),
+		"TXN-001",
+		"Deposit 1",
+		models.TransactionTypeCredit,
+		"10.00",
+		"1010.00",
+		models.TransactionStatusCompleted,
+	}, rows[1])
+	assert.Equal(t, "'=HYPERLINK(\"http://example.com\")", rows[2][3], "formulas must not be evaluated")
+	assert.Equal(t, models.TransactionTypeDebit, rows[2][4])
+}
+
+func TestRenderStatementPDF(t *testing.T) {
+	statement := newRenderTestStatement(3)
+	statement.Transactions[2].Status = models.TransactionStatusReversed
+
+	content, err := renderStatementPDF(statement)
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(content, []byte("%%EOF\n")))
+	assert.Contains(t, string(content), "/Count 1")
+	assert.Contains(t, string(content), "(Account            1234567890 \\("+models.AccountTypeChecking+", USD\\)) Tj")
+	assert.Contains(t, string(content), "Deposit 3 \\(reversed\\)")
+	assert.Contains(t, string(content), "Page 1 of 1")
+	assertValidXref(t, content)
+
+	again, err := renderStatementPDF(statement)
+	require.NoError(t, err)
+	assert.Equal(t, content, again, "rendering must be deterministic")
+}
+
+func TestRenderStatementPDF_Paginates(t *testing.T) {
+	content, err := renderStatementPDF(newRenderTestStatement(120))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), "/Count 3")
+	assert.Contains(t, string(content), "Page 3 of 3")
+	assert.Contains(t, string(content), "Deposit 120")
+	assertValidXref(t, content)
+}
+
+func TestRenderStatementPDF_NoTransactions(t *testing.T) {
+	content, err := renderStatementPDF(newRenderTestStatement(0))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), "No transactions in this period.")
+}
+
+func TestPDFEscape(t *testing.T) {
+	assert.Equal(t, `Caf? \(Paris\) \\ 100%`, pdfEscape(`Café (Paris) \ 100%`))
+}
+
+// assertValidXref checks each cross-reference entry points at its object
+func assertValidXref(t *testing.T, content []byte) {
+	t.Helper()
+
+	matches := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(content, -1)
+	require.NotEmpty(t, matches)
+	for i, match := range matches {
+		offset, err := strconv.Atoi(string(match[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(content[offset:], []byte(fmt.Sprintf("%d 0 obj", i+1))), "object %d offset", i+1)
+	}
+}
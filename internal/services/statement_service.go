@@ -23,6 +23,8 @@ var (
 	ErrInvalidMonth      = errors.New("month must be between 1 and 12")
 	ErrInvalidQuarter    = errors.New("quarter must be between 1 and 4")
 	ErrFuturePeriod      = errors.New("cannot generate statement for future period")
+
+	ErrUnsupportedStatementFormat = errors.New("unsupported statement format")
 )
 
 type statementService struct {
@@ -30,6 +32,7 @@ type statementService struct {
 	transactionRepo repositories.TransactionRepositoryInterface
 	userRepo        repositories.UserRepositoryInterface
 	metricsService  AccountMetricsServiceInterface
+	archiveRepo     repositories.StatementArchiveRepositoryInterface
 }
 
 func NewStatementService(
@@ -37,43 +40,125 @@ func NewStatementService(
 	transactionRepo repositories.TransactionRepositoryInterface,
 	userRepo repositories.UserRepositoryInterface,
 	metricsService AccountMetricsServiceInterface,
+	archiveRepo repositories.StatementArchiveRepositoryInterface,
 ) StatementServiceInterface {
 	return &statementService{
 		accountRepo:     accountRepo,
 		transactionRepo: transactionRepo,
 		userRepo:        userRepo,
 		metricsService:  metricsService,
+		archiveRepo:     archiveRepo,
 	}
 }
 
-func (s *statementService) GenerateStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, isAdmin bool) (*models.AccountStatement, error) {
-	if err := s.validatePeriodType(periodType); err != nil {
+func (s *statementService) GenerateStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, canViewAll bool) (*models.AccountStatement, error) {
+	account, startDate, endDate, err := s.authorizeStatement(requestorID, accountID, periodType, year, period, canViewAll)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := s.validatePeriod(periodType, period); err != nil {
-		return nil, err
+	return s.buildStatement(requestorID, account, periodType, year, period, startDate, endDate, canViewAll)
+}
+
+// RenderStatement returns a statement document in the requested format. Every
+// statement period has closed, so the first rendering is archived and returned
+// unchanged by later requests.
+func (s *statementService) RenderStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, format string, canViewAll bool) (*models.ArchivedStatement, error) {
+	render, ok := statementRenderers[format]
+	if !ok {
+		return nil, ErrUnsupportedStatementFormat
 	}
 
-	startDate, endDate, err := s.calculateDateRange(periodType, year, period)
+	account, startDate, endDate, err := s.authorizeStatement(requestorID, accountID, periodType, year, period, canViewAll)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.validateNotFuture(endDate); err != nil {
+	archived, err := s.archiveRepo.Get(account.ID, periodType, year, period, format)
+	if err == nil {
+		return archived, nil
+	}
+	if !errors.Is(err, repositories.ErrArchivedStatementNotFound) {
 		return nil, err
 	}
 
-	requestor, err := s.validateRequestor(requestorID)
+	statement, err := s.buildStatement(requestorID, account, periodType, year, period, startDate, endDate, canViewAll)
 	if err != nil {
 		return nil, err
 	}
 
-	account, err := s.getAndAuthorizeAccount(accountID, requestor, isAdmin)
+	content, err := render(statement)
 	if err != nil {
 		return nil, err
 	}
 
+	archived = models.NewArchivedStatement(statement, format, content)
+	stored, err := s.archiveRepo.Create(archived)
+	if err != nil {
+		return nil, err
+	}
+
+	// Another request archived the period first; return its document so every
+	// download is identical
+	if !stored {
+		return s.archiveRepo.Get(account.ID, periodType, year, period, format)
+	}
+
+	slog.Info("statement archived",
+		"account_id", account.ID,
+		"period_type", periodType,
+		"year", year,
+		"period", period,
+		"format", format,
+		"content_hash", archived.ContentHash)
+
+	return archived, nil
+}
+
+// authorizeStatement validates the statement period and checks the requestor may
+// see the account, returning the account and the period's date range
+func (s *statementService) authorizeStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, canViewAll bool) (*models.Account, time.Time, time.Time, error) {
+	if err := s.validatePeriodType(periodType); err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	if err := s.validatePeriod(periodType, period); err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	startDate, endDate, err := s.calculateDateRange(periodType, year, period)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	if err := s.validateNotFuture(endDate); err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	requestor, err := s.validateRequestor(requestorID)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	account, err := s.getAndAuthorizeAccount(accountID, requestor, canViewAll)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	return account, startDate, endDate, nil
+}
+
+// buildStatement assembles the statement for an authorized account and period
+func (s *statementService) buildStatement(
+	requestorID uuid.UUID,
+	account *models.Account,
+	periodType string,
+	year, period int,
+	startDate, endDate time.Time,
+	canViewAll bool,
+) (*models.AccountStatement, error) {
+	accountID := account.ID
+
 	transactions, err := s.transactionRepo.GetByDateRange(accountID, startDate, endDate)
 	if err != nil {
 		slog.Error("failed to fetch transactions for statement",
@@ -88,7 +173,7 @@ func (s *statementService) GenerateStatement(requestorID, accountID uuid.UUID, p
 
 	summary := s.calculateSummary(transactions)
 
-	metrics, err := s.metricsService.GetAccountMetrics(requestorID, accountID, &startDate, &endDate, isAdmin)
+	metrics, err := s.metricsService.GetAccountMetrics(requestorID, accountID, &startDate, &endDate, canViewAll)
 	if err != nil {
 		slog.Warn("failed to fetch metrics for statement",
 			"account_id", accountID,
@@ -100,6 +185,7 @@ func (s *statementService) GenerateStatement(requestorID, accountID uuid.UUID, p
 		AccountID:          accountID,
 		AccountNumber:      account.AccountNumber,
 		AccountType:        account.AccountType,
+		Currency:           account.CurrencyOrDefault(),
 		PeriodType:         periodType,
 		Year:               year,
 		Period:             period,
@@ -183,7 +269,7 @@ func (s *statementService) validateRequestor(requestorID uuid.UUID) (*models.Use
 	return requestor, nil
 }
 
-func (s *statementService) getAndAuthorizeAccount(accountID uuid.UUID, requestor *models.User, isAdmin bool) (*models.Account, error) {
+func (s *statementService) getAndAuthorizeAccount(accountID uuid.UUID, requestor *models.User, canViewAll bool) (*models.Account, error) {
 	account, err := s.accountRepo.GetByID(accountID)
 	if err != nil {
 		slog.Error("failed to get account for statement",
@@ -193,7 +279,7 @@ func (s *statementService) getAndAuthorizeAccount(accountID uuid.UUID, requestor
 	}
 
 	if account.UserID != requestor.ID {
-		if !isAdmin || requestor.Role != models.RoleAdmin {
+		if !canViewAll {
 			slog.Warn("unauthorized access attempt to statement",
 				"requestor_id", requestor.ID,
 				"requestor_role", requestor.Role,
@@ -202,9 +288,9 @@ func (s *statementService) getAndAuthorizeAccount(accountID uuid.UUID, requestor
 			return nil, ErrUnauthorized
 		}
 
-		slog.Info("admin accessing account statement",
-			"admin_id", requestor.ID,
-			"admin_email", requestor.Email,
+		slog.Info("staff accessing account statement",
+			"staff_id", requestor.ID,
+			"staff_email", requestor.Email,
 			"account_id", accountID,
 			"account_user_id", account.UserID)
 	}
@@ -256,7 +342,7 @@ func (s *statementService) calculateSummary(transactions []models.Transaction) m
 	for i := range transactions {
 		txn := &transactions[i]
 
-		if txn.Status != models.TransactionStatusCompleted {
+		if !txn.CountsTowardTotals() {
 			continue
 		}
 
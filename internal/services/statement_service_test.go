@@ -19,7 +19,7 @@ import (
 // MockAccountMetricsService is an inline mock for AccountMetricsServiceInterface to avoid import cycles
 type MockAccountMetricsService struct {
 	GetAccountMetricsFunc       func(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error)
-	GetUserAggregateMetricsFunc func(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error)
+	GetUserAggregateMetricsFunc func(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, baseCurrency string, isAdmin bool) (*models.UserAggregateMetrics, error)
 }
 
 func (m *MockAccountMetricsService) GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error) {
@@ -29,9 +29,9 @@ func (m *MockAccountMetricsService) GetAccountMetrics(requestorID, accountID uui
 	return nil, nil
 }
 
-func (m *MockAccountMetricsService) GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error) {
+func (m *MockAccountMetricsService) GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, baseCurrency string, isAdmin bool) (*models.UserAggregateMetrics, error) {
 	if m.GetUserAggregateMetricsFunc != nil {
-		return m.GetUserAggregateMetricsFunc(requestorID, targetUserID, startDate, endDate, isAdmin)
+		return m.GetUserAggregateMetricsFunc(requestorID, targetUserID, startDate, endDate, baseCurrency, isAdmin)
 	}
 	return nil, nil
 }
@@ -44,6 +44,7 @@ type StatementServiceTestSuite struct {
 	mockTransactionRepo *repository_mocks.MockTransactionRepositoryInterface
 	mockUserRepo        *repository_mocks.MockUserRepositoryInterface
 	mockMetricsService  *MockAccountMetricsService
+	mockArchiveRepo     *repository_mocks.MockStatementArchiveRepositoryInterface
 	service             StatementServiceInterface
 }
 
@@ -54,7 +55,8 @@ func (s *StatementServiceTestSuite) SetupTest() {
 	s.mockTransactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
 	s.mockUserRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
 	s.mockMetricsService = &MockAccountMetricsService{}
-	s.service = NewStatementService(s.mockAccountRepo, s.mockTransactionRepo, s.mockUserRepo, s.mockMetricsService)
+	s.mockArchiveRepo = repository_mocks.NewMockStatementArchiveRepositoryInterface(s.ctrl)
+	s.service = NewStatementService(s.mockAccountRepo, s.mockTransactionRepo, s.mockUserRepo, s.mockMetricsService, s.mockArchiveRepo)
 }
 
 // TearDownTest runs after each test
@@ -535,3 +537,95 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Success_Q1Dates() {
 	s.True(statement.StartDate.Equal(expectedStart))
 	s.True(statement.EndDate.Equal(expectedEnd))
 }
+
+// expectAuthorizedOwner sets up a customer requesting a statement for their own account
+func (s *StatementServiceTestSuite) expectAuthorizedOwner() (*models.User, *models.Account) {
+	requestor := &models.User{ID: uuid.New(), Email: gofakeit.Email(), Role: models.RoleCustomer}
+	account := &models.Account{
+		ID:            uuid.New(),
+		UserID:        requestor.ID,
+		AccountNumber: "1234567890",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(5000.00),
+		Status:        models.AccountStatusActive,
+	}
+
+	s.mockUserRepo.EXPECT().GetByID(requestor.ID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(account.ID).Return(account, nil)
+	return requestor, account
+}
+
+// Test an archived statement is returned without rendering it again
+func (s *StatementServiceTestSuite) TestRenderStatement_ReturnsArchivedDocument() {
+	requestor, account := s.expectAuthorizedOwner()
+	archived := &models.ArchivedStatement{ID: uuid.New(), AccountID: account.ID, Format: models.StatementFormatPDF, ContentHash: "abc"}
+
+	s.mockArchiveRepo.EXPECT().Get(account.ID, PeriodTypeMonthly, 2025, 9, models.StatementFormatPDF).Return(archived, nil)
+
+	document, err := s.service.RenderStatement(requestor.ID, account.ID, PeriodTypeMonthly, 2025, 9, models.StatementFormatPDF, false)
+
+	s.NoError(err)
+	s.Equal(archived, document)
+}
+
+// Test the first rendering of a period is archived
+func (s *StatementServiceTestSuite) TestRenderStatement_ArchivesFirstRendering() {
+	requestor, account := s.expectAuthorizedOwner()
+	startDate := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 1, 0).Add(-time.Second)
+
+	s.mockArchiveRepo.EXPECT().Get(account.ID, PeriodTypeMonthly, 2025, 9, models.StatementFormatCSV).
+		Return(nil, repositories.ErrArchivedStatementNotFound)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(account.ID, startDate, endDate).Return([]models.Transaction{
+		{
+			ID:              uuid.New(),
+			TransactionType: models.TransactionTypeCredit,
+			Amount:          decimal.NewFromFloat(1000.00),
+			BalanceBefore:   decimal.NewFromFloat(4000.00),
+			BalanceAfter:    decimal.NewFromFloat(5000.00),
+			Description:     "Salary deposit",
+			Status:          models.TransactionStatusCompleted,
+			Reference:       "TXN-001",
+			CreatedAt:       startDate.AddDate(0, 0, 5),
+		},
+	}, nil)
+	s.mockArchiveRepo.EXPECT().Create(gomock.Any()).Return(true, nil)
+
+	document, err := s.service.RenderStatement(requestor.ID, account.ID, PeriodTypeMonthly, 2025, 9, models.StatementFormatCSV, false)
+
+	s.Require().NoError(err)
+	s.Equal(account.ID, document.AccountID)
+	s.Equal(PeriodTypeMonthly, document.PeriodType)
+	s.Equal(models.StatementFormatCSV, document.Format)
+	s.Equal("text/csv; charset=utf-8", document.ContentType)
+	s.Contains(string(document.Content), "Salary deposit")
+	s.Len(document.ContentHash, 64)
+	s.Equal(len(document.Content), document.SizeBytes)
+}
+
+// Test a period archived concurrently returns the stored document
+func (s *StatementServiceTestSuite) TestRenderStatement_ArchivedConcurrently() {
+	requestor, account := s.expectAuthorizedOwner()
+	stored := &models.ArchivedStatement{ID: uuid.New(), AccountID: account.ID, Format: models.StatementFormatPDF, ContentHash: "first"}
+
+	gomock.InOrder(
+		s.mockArchiveRepo.EXPECT().Get(account.ID, PeriodTypeQuarterly, 2025, 2, models.StatementFormatPDF).
+			Return(nil, repositories.ErrArchivedStatementNotFound),
+		s.mockArchiveRepo.EXPECT().Create(gomock.Any()).Return(false, nil),
+		s.mockArchiveRepo.EXPECT().Get(account.ID, PeriodTypeQuarterly, 2025, 2, models.StatementFormatPDF).Return(stored, nil),
+	)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(account.ID, gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	document, err := s.service.RenderStatement(requestor.ID, account.ID, PeriodTypeQuarterly, 2025, 2, models.StatementFormatPDF, false)
+
+	s.NoError(err)
+	s.Equal(stored, document)
+}
+
+// Test unsupported document formats are rejected
+func (s *StatementServiceTestSuite) TestRenderStatement_Error_UnsupportedFormat() {
+	document, err := s.service.RenderStatement(uuid.New(), uuid.New(), PeriodTypeMonthly, 2025, 9, "xlsx", false)
+
+	s.ErrorIs(err, ErrUnsupportedStatementFormat)
+	s.Nil(document)
+}
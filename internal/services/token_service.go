@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"array-assessment/internal/config"
+	"array-assessment/internal/dto"
 	"array-assessment/internal/models"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -14,8 +15,13 @@ import (
 )
 
 const (
-	TokenTypeAccess  = "access"
-	TokenTypeRefresh = "refresh"
+	TokenTypeAccess       = "access"
+	TokenTypeRefresh      = "refresh"
+	TokenTypeMFAChallenge = "mfa_challenge"
+
+	// DefaultMFAChallengeDuration is how long a user has to enter an MFA code after
+	// their password was accepted
+	DefaultMFAChallengeDuration = 5 * time.Minute
 )
 
 var (
@@ -27,16 +33,23 @@ var (
 	ErrInvalidAuthHeader = errors.New("invalid authorization header format")
 )
 
-// TokenService handles JWT token generation and validation
+// TokenService handles JWT token generation and validation. Tokens carry the
+// kid of the key that signed them, which is looked up in the key ring.
 type TokenService struct {
 	config.JWTConfig
+	keys *keyRing
 }
 
 // NewTokenService creates a new token service from JWT configuration
 func NewTokenService(jwtConfig *config.JWTConfig) TokenServiceInterface {
-	return &TokenService{
+	ts := &TokenService{
 		JWTConfig: *jwtConfig,
 	}
+	if ts.MFAChallengeDuration <= 0 {
+		ts.MFAChallengeDuration = DefaultMFAChallengeDuration
+	}
+	ts.keys = newKeyRing(&ts.JWTConfig)
+	return ts
 }
 
 // GenerateAccessToken generates a new JWT access token for a user
@@ -49,9 +62,35 @@ func (ts *TokenService) GenerateAccessToken(user *models.User) (string, time.Tim
 	expiresAt := now.Add(ts.AccessTokenDuration)
 
 	claims := ts.buildAccessTokenClaims(user, now, expiresAt)
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tokenString, err := ts.sign(claims)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	return tokenString, expiresAt, nil
+}
+
+// GenerateSessionAccessToken generates an access token for a signed-in session,
+// so revoking the session revokes the token. permissions are those of the user's
+// role, which admin routes check. A non-zero mfaVerifiedAt records when the user
+// last entered an MFA code, which sensitive operations check for.
+func (ts *TokenService) GenerateSessionAccessToken(user *models.User, permissions []string, sessionID uuid.UUID, mfaVerifiedAt time.Time) (string, time.Time, error) {
+	if user == nil {
+		return "", time.Time{}, errors.New("user cannot be nil")
+	}
 
-	tokenString, err := token.SignedString(ts.PrivateKey)
+	now := time.Now()
+	expiresAt := now.Add(ts.AccessTokenDuration)
+
+	claims := ts.buildAccessTokenClaims(user, now, expiresAt)
+	claims.Permissions = permissions
+	if sessionID != uuid.Nil {
+		claims.SessionID = sessionID.String()
+	}
+	if !mfaVerifiedAt.IsZero() {
+		claims.MFAVerifiedAt = mfaVerifiedAt.Unix()
+	}
+	tokenString, err := ts.sign(claims)
 	if err != nil {
 		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
 	}
@@ -59,6 +98,26 @@ func (ts *TokenService) GenerateAccessToken(user *models.User) (string, time.Tim
 	return tokenString, expiresAt, nil
 }
 
+// GenerateMFAChallengeToken generates a short-lived token proving the user's
+// password was accepted. It can only be exchanged for tokens with an MFA code.
+func (ts *TokenService) GenerateMFAChallengeToken(userID uuid.UUID) (string, time.Time, error) {
+	if userID == uuid.Nil {
+		return "", time.Time{}, errors.New("user ID cannot be nil")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ts.MFAChallengeDuration)
+
+	claims := ts.buildRefreshTokenClaims(userID, now, expiresAt)
+	claims.TokenType = TokenTypeMFAChallenge
+	tokenString, err := ts.sign(claims)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign MFA challenge token: %w", err)
+	}
+
+	return tokenString, expiresAt, nil
+}
+
 // GenerateRefreshToken generates a new JWT refresh token
 func (ts *TokenService) GenerateRefreshToken(userID uuid.UUID) (string, time.Time, error) {
 	if userID == uuid.Nil {
@@ -69,9 +128,7 @@ func (ts *TokenService) GenerateRefreshToken(userID uuid.UUID) (string, time.Tim
 	expiresAt := now.Add(ts.RefreshTokenDuration)
 
 	claims := ts.buildRefreshTokenClaims(userID, now, expiresAt)
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-
-	tokenString, err := token.SignedString(ts.PrivateKey)
+	tokenString, err := ts.sign(claims)
 	if err != nil {
 		return "", time.Time{}, fmt.Errorf("failed to sign refresh token: %w", err)
 	}
@@ -89,6 +146,11 @@ func (ts *TokenService) ValidateRefreshToken(tokenString string) (*models.Custom
 	return ts.validateToken(tokenString, TokenTypeRefresh)
 }
 
+// ValidateMFAChallengeToken validates and parses an MFA challenge token
+func (ts *TokenService) ValidateMFAChallengeToken(tokenString string) (*models.CustomClaims, error) {
+	return ts.validateToken(tokenString, TokenTypeMFAChallenge)
+}
+
 // ExtractTokenFromHeader extracts the JWT token from the Authorization header
 func (ts *TokenService) ExtractTokenFromHeader(authHeader string) (string, error) {
 	if authHeader == "" {
@@ -131,6 +193,22 @@ func (ts *TokenService) GetTokenExpiry(tokenString string) (time.Time, error) {
 	return claims.ExpiresAt.Time, nil
 }
 
+// JWKS returns the public keys tokens are currently verified with, for other
+// services to verify our access tokens themselves
+func (ts *TokenService) JWKS() dto.JWKSResponse {
+	return ts.keys.jwks(time.Now())
+}
+
+// sign signs claims with the current signing key, naming it in the kid header
+func (ts *TokenService) sign(claims models.CustomClaims) (string, error) {
+	key := ts.keys.signingKey(time.Now())
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = key.id
+
+	return token.SignedString(key.privateKey)
+}
+
 func (ts *TokenService) buildAccessTokenClaims(user *models.User, issuedAt, expiresAt time.Time) models.CustomClaims {
 	return models.CustomClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
@@ -190,7 +268,23 @@ func (ts *TokenService) keyFunc(token *jwt.Token) (interface{}, error) {
 	if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
 		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 	}
-	return ts.PublicKey, nil
+
+	now := time.Now()
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		// Tokens issued before key IDs were added: try every accepted key
+		keySet := jwt.VerificationKeySet{}
+		for _, key := range ts.keys.verificationKeys(now) {
+			keySet.Keys = append(keySet.Keys, key.publicKey)
+		}
+		return keySet, nil
+	}
+
+	publicKey, ok := ts.keys.verificationKey(kid, now)
+	if !ok {
+		return nil, fmt.Errorf("unknown or retired signing key: %s", kid)
+	}
+	return publicKey, nil
 }
 
 func (ts *TokenService) mapTokenError(err error) error {
@@ -2,12 +2,15 @@ package services
 
 import (
 	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
 	"testing"
 	"time"
 
 	"array-assessment/internal/config"
 	"array-assessment/internal/models"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 )
@@ -142,6 +145,60 @@ func (s *TokenServiceTestSuite) TestValidateRefreshToken_Success() {
 	s.Equal(TokenTypeRefresh, claims.TokenType)
 }
 
+// Test MFA challenge tokens cannot be used as access or refresh tokens
+func (s *TokenServiceTestSuite) TestMFAChallengeToken() {
+	userID := uuid.New()
+
+	token, expiresAt, err := s.service.GenerateMFAChallengeToken(userID)
+	s.Require().NoError(err)
+	s.WithinDuration(time.Now().Add(DefaultMFAChallengeDuration), expiresAt, 5*time.Second)
+
+	claims, err := s.service.ValidateMFAChallengeToken(token)
+	s.Require().NoError(err)
+	s.Equal(userID.String(), claims.UserID)
+	s.Equal(TokenTypeMFAChallenge, claims.TokenType)
+
+	_, err = s.service.ValidateAccessToken(token)
+	s.Error(err)
+	_, err = s.service.ValidateRefreshToken(token)
+	s.Error(err)
+}
+
+// Test session access tokens record their session and when MFA was verified
+func (s *TokenServiceTestSuite) TestGenerateSessionAccessToken() {
+	user := &models.User{ID: uuid.New(), Email: "mfa@example.com", Role: models.RoleCustomer}
+	sessionID := uuid.New()
+	verifiedAt := time.Now()
+
+	token, _, err := s.service.GenerateSessionAccessToken(user, nil, sessionID, verifiedAt)
+	s.Require().NoError(err)
+
+	claims, err := s.service.ValidateAccessToken(token)
+	s.Require().NoError(err)
+	s.Equal(sessionID.String(), claims.SessionID)
+	s.Equal(verifiedAt.Unix(), claims.MFAVerifiedAt)
+
+	token, _, err = s.service.GenerateSessionAccessToken(user, nil, sessionID, time.Time{})
+	s.Require().NoError(err)
+
+	claims, err = s.service.ValidateAccessToken(token)
+	s.Require().NoError(err)
+	s.Zero(claims.MFAVerifiedAt, "no MFA claim without a verification")
+}
+
+// Test access tokens carry the permissions of the user's role
+func (s *TokenServiceTestSuite) TestGenerateSessionAccessToken_Permissions() {
+	user := &models.User{ID: uuid.New(), Email: "support@example.com", Role: models.RoleSupport}
+	permissions := []string{models.PermissionCustomersRead, models.PermissionCustomersResetPassword}
+
+	token, _, err := s.service.GenerateSessionAccessToken(user, permissions, uuid.New(), time.Time{})
+	s.Require().NoError(err)
+
+	claims, err := s.service.ValidateAccessToken(token)
+	s.Require().NoError(err)
+	s.Equal(permissions, claims.Permissions)
+}
+
 // Test expired token
 func (s *TokenServiceTestSuite) TestExpiredToken() {
 	// Create service with very short duration
@@ -245,6 +302,135 @@ func (s *TokenServiceTestSuite) TestDifferentKeys() {
 	s.Nil(claims)
 }
 
+// rotationService builds a token service with the suite's durations
+func (s *TokenServiceTestSuite) rotationService(cfg config.JWTConfig) TokenServiceInterface {
+	cfg.Issuer = s.issuer
+	cfg.AccessTokenDuration = s.accessDuration
+	cfg.RefreshTokenDuration = s.refreshDuration
+	return NewTokenService(&cfg)
+}
+
+func (s *TokenServiceTestSuite) testUser() *models.User {
+	return &models.User{
+		ID:    uuid.New(),
+		Email: "test@example.com",
+		Role:  models.RoleCustomer,
+	}
+}
+
+func (s *TokenServiceTestSuite) tokenKeyID(tokenString string) string {
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, &models.CustomClaims{})
+	s.Require().NoError(err)
+	kid, _ := token.Header["kid"].(string)
+	return kid
+}
+
+func (s *TokenServiceTestSuite) TestGeneratedTokens_CarryKeyID() {
+	token, _, err := s.service.GenerateAccessToken(s.testUser())
+	s.Require().NoError(err)
+
+	s.Equal(keyID(s.publicKey), s.tokenKeyID(token))
+}
+
+func (s *TokenServiceTestSuite) TestValidate_TokenWithoutKeyID() {
+	claims := s.service.(*TokenService).buildAccessTokenClaims(s.testUser(), time.Now(), time.Now().Add(time.Hour))
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(s.privateKey)
+	s.Require().NoError(err)
+
+	_, err = s.service.ValidateAccessToken(token)
+	s.NoError(err, "tokens issued before key IDs remain valid")
+}
+
+func (s *TokenServiceTestSuite) TestKeyRotation_PreviousKeyStillVerifies() {
+	newPrivateKey, newPublicKey, err := config.GenerateRSAKeyPair()
+	s.Require().NoError(err)
+
+	token, _, err := s.service.GenerateAccessToken(s.testUser())
+	s.Require().NoError(err)
+
+	rotated := s.rotationService(config.JWTConfig{
+		PrivateKey:        newPrivateKey,
+		PublicKey:         newPublicKey,
+		PreviousPublicKey: s.publicKey,
+		KeyRotatedAt:      time.Now().Add(-time.Hour),
+	})
+
+	_, err = rotated.ValidateAccessToken(token)
+	s.NoError(err)
+
+	newToken, _, err := rotated.GenerateAccessToken(s.testUser())
+	s.Require().NoError(err)
+	s.Equal(keyID(newPublicKey), s.tokenKeyID(newToken))
+}
+
+func (s *TokenServiceTestSuite) TestKeyRotation_RetiredKeyRejected() {
+	newPrivateKey, newPublicKey, err := config.GenerateRSAKeyPair()
+	s.Require().NoError(err)
+
+	token, _, err := s.service.GenerateAccessToken(s.testUser())
+	s.Require().NoError(err)
+
+	// Rotated longer ago than the refresh token lifetime
+	rotated := s.rotationService(config.JWTConfig{
+		PrivateKey:        newPrivateKey,
+		PublicKey:         newPublicKey,
+		PreviousPublicKey: s.publicKey,
+		KeyRotatedAt:      time.Now().Add(-s.refreshDuration - time.Hour),
+	})
+
+	_, err = rotated.ValidateAccessToken(token)
+	s.ErrorIs(err, ErrInvalidToken)
+	s.Len(rotated.JWKS().Keys, 1, "the retired key is no longer published")
+}
+
+func (s *TokenServiceTestSuite) TestKeyRotation_NextKeyPublishedBeforeActivation() {
+	nextPrivateKey, _, err := config.GenerateRSAKeyPair()
+	s.Require().NoError(err)
+
+	scheduled := s.rotationService(config.JWTConfig{
+		PrivateKey:         s.privateKey,
+		PublicKey:          s.publicKey,
+		NextPrivateKey:     nextPrivateKey,
+		NextKeyActivatesAt: time.Now().Add(time.Hour),
+	})
+
+	token, _, err := scheduled.GenerateAccessToken(s.testUser())
+	s.Require().NoError(err)
+	s.Equal(keyID(s.publicKey), s.tokenKeyID(token), "the current key signs until activation")
+
+	// A replica whose clock has passed the activation time signs with the next key
+	activated := s.rotationService(config.JWTConfig{
+		PrivateKey:         s.privateKey,
+		PublicKey:          s.publicKey,
+		NextPrivateKey:     nextPrivateKey,
+		NextKeyActivatesAt: time.Now().Add(-time.Minute),
+	})
+	nextToken, _, err := activated.GenerateAccessToken(s.testUser())
+	s.Require().NoError(err)
+	s.Equal(keyID(&nextPrivateKey.PublicKey), s.tokenKeyID(nextToken))
+
+	_, err = scheduled.ValidateAccessToken(nextToken)
+	s.NoError(err, "the next key verifies before it activates")
+	_, err = activated.ValidateAccessToken(token)
+	s.NoError(err, "the replaced key verifies until its tokens expire")
+
+	jwks := scheduled.JWKS()
+	s.Require().Len(jwks.Keys, 2)
+	s.Equal(keyID(s.publicKey), jwks.Keys[0].Kid)
+	s.Equal(keyID(&nextPrivateKey.PublicKey), jwks.Keys[1].Kid)
+	s.Equal("RS256", jwks.Keys[1].Alg)
+	s.Equal("AQAB", jwks.Keys[1].E)
+}
+
+func (s *TokenServiceTestSuite) TestKeyID_RFC7638Thumbprint() {
+	// Example key and thumbprint from RFC 7638 section 3.1
+	n, err := base64.RawURLEncoding.DecodeString("0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw")
+	s.Require().NoError(err)
+	publicKey := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: 65537}
+
+	s.Equal("NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", keyID(publicKey))
+}
+
 // Test ExtractTokenFromHeader with valid bearer token
 func (s *TokenServiceTestSuite) TestExtractTokenFromHeader_ValidBearer() {
 	token, err := s.service.ExtractTokenFromHeader("Bearer eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9.token")
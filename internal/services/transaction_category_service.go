@@ -0,0 +1,180 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransactionNotFound  = errors.New("transaction not found")
+	ErrMerchantNameRequired = errors.New("transaction has no merchant name to build a rule from")
+	ErrRuleCreationDenied   = errors.New("creating a merchant rule requires the categories:manage permission")
+)
+
+// TransactionCategoryService persists manual category corrections on transactions
+type TransactionCategoryService struct {
+	transactionRepo   repositories.TransactionRepositoryInterface
+	accountRepo       repositories.AccountRepositoryInterface
+	auditRepo         repositories.AuditLogRepositoryInterface
+	categoryService   CategoryServiceInterface
+	managementService CategoryManagementServiceInterface
+	logger            *slog.Logger
+}
+
+// NewTransactionCategoryService creates a new transaction category service
+func NewTransactionCategoryService(
+	transactionRepo repositories.TransactionRepositoryInterface,
+	accountRepo repositories.AccountRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	categoryService CategoryServiceInterface,
+	managementService CategoryManagementServiceInterface,
+	logger *slog.Logger,
+) TransactionCategoryServiceInterface {
+	return &TransactionCategoryService{
+		transactionRepo:   transactionRepo,
+		accountRepo:       accountRepo,
+		auditRepo:         auditRepo,
+		categoryService:   categoryService,
+		managementService: managementService,
+		logger:            logger,
+	}
+}
+
+// OverrideTransactionCategory manually sets the category of a transaction, records
+// the change in the audit log and optionally adds a merchant mapping rule so future
+// transactions from the same merchant are categorized the same way. Rules apply to
+// every customer's transactions, so only callers that can manage categories may
+// create one. Customers may only override their own transactions; callers that
+// can manage transactions may override any.
+func (s *TransactionCategoryService) OverrideTransactionCategory(
+	transactionID, userID uuid.UUID,
+	canManageTransactions, canManageRules bool,
+	req *dto.OverrideTransactionCategoryRequest,
+	ipAddress, userAgent string,
+) (*dto.OverrideTransactionCategoryResponse, error) {
+	if req.CreateRule && !canManageRules {
+		return nil, ErrRuleCreationDenied
+	}
+
+	transaction, err := s.transactionRepo.GetByID(transactionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransactionNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if !canManageTransactions {
+		account, err := s.accountRepo.GetByID(transaction.AccountID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrAccountNotFound) {
+				return nil, ErrTransactionNotFound
+			}
+			return nil, fmt.Errorf("failed to get account: %w", err)
+		}
+		if account.UserID != userID {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	if req.Version != nil && transaction.HasVersionConflict(*req.Version) {
+		return nil, models.ErrOptimisticLockConflict
+	}
+
+	if req.CreateRule && strings.TrimSpace(transaction.MerchantName) == "" {
+		return nil, ErrMerchantNameRequired
+	}
+
+	previousCategory := transaction.Category
+	expectedVersion := transaction.Version
+	newCategory := normalizeCategoryCode(req.Category)
+
+	if err := s.categoryService.OverrideCategory(transaction, newCategory, strings.TrimSpace(req.Reason)); err != nil {
+		return nil, err
+	}
+
+	transaction.MarkCategoryOverridden(userID, time.Now())
+
+	if err := s.transactionRepo.UpdateWithOptimisticLock(transaction, expectedVersion); err != nil {
+		if errors.Is(err, models.ErrOptimisticLockConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save category override: %w", err)
+	}
+
+	response := &dto.OverrideTransactionCategoryResponse{
+		Transaction:      transaction,
+		PreviousCategory: previousCategory,
+	}
+
+	if req.CreateRule {
+		response.MerchantMapping = s.createMerchantRule(transaction, req.RuleMatchType)
+	}
+
+	s.logOverride(transaction, userID, previousCategory, req.Reason, response.MerchantMapping, ipAddress, userAgent)
+
+	return response, nil
+}
+
+// createMerchantRule adds a rule mapping the transaction's merchant to its new
+// category. The override is already saved, so a failure is logged rather than returned.
+func (s *TransactionCategoryService) createMerchantRule(transaction *models.Transaction, matchType string) *models.MerchantMapping {
+	if matchType == "" {
+		matchType = models.MatchTypeExact
+	}
+
+	mapping, err := s.managementService.CreateMerchantMapping(&dto.CreateMerchantMappingRequest{
+		MerchantPattern: transaction.MerchantName,
+		CategoryCode:    transaction.Category,
+		MatchType:       matchType,
+	})
+	if err != nil {
+		s.logger.Error("failed to create merchant mapping from category override",
+			slog.String("transaction_id", transaction.ID.String()),
+			slog.String("merchant_name", transaction.MerchantName),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	return mapping
+}
+
+// logOverride writes the audit trail entry for a category override
+func (s *TransactionCategoryService) logOverride(
+	transaction *models.Transaction,
+	userID uuid.UUID,
+	previousCategory, reason string,
+	mapping *models.MerchantMapping,
+	ipAddress, userAgent string,
+) {
+	log := &models.AuditLog{
+		UserID:     &userID,
+		Action:     models.AuditActionCategoryOverridden,
+		Resource:   "transaction",
+		ResourceID: transaction.ID.String(),
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		Metadata: models.JSONBMap{
+			"account_id":   transaction.AccountID.String(),
+			"old_category": previousCategory,
+			"new_category": transaction.Category,
+			"reason":       reason,
+		},
+	}
+
+	if mapping != nil {
+		log.SetMetadata("merchant_mapping_id", mapping.ID.String())
+	}
+
+	recordAuditLog(s.auditRepo, s.logger, log)
+}
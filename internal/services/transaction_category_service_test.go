@@ -0,0 +1,248 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransactionCategoryServiceTestSuite struct {
+	suite.Suite
+	ctrl                  *gomock.Controller
+	mockTransactionRepo   *repository_mocks.MockTransactionRepositoryInterface
+	mockAccountRepo       *repository_mocks.MockAccountRepositoryInterface
+	mockAuditRepo         *repository_mocks.MockAuditLogRepositoryInterface
+	mockCategoryService   *service_mocks.MockCategoryServiceInterface
+	mockManagementService *service_mocks.MockCategoryManagementServiceInterface
+	service               TransactionCategoryServiceInterface
+	userID                uuid.UUID
+	transaction           *models.Transaction
+}
+
+func TestTransactionCategoryServiceSuite(t *testing.T) {
+	suite.Run(t, new(TransactionCategoryServiceTestSuite))
+}
+
+func (s *TransactionCategoryServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockTransactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
+	s.mockAccountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
+	s.mockAuditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.mockCategoryService = service_mocks.NewMockCategoryServiceInterface(s.ctrl)
+	s.mockManagementService = service_mocks.NewMockCategoryManagementServiceInterface(s.ctrl)
+
+	s.service = NewTransactionCategoryService(
+		s.mockTransactionRepo,
+		s.mockAccountRepo,
+		s.mockAuditRepo,
+		s.mockCategoryService,
+		s.mockManagementService,
+		slog.Default(),
+	)
+
+	s.userID = uuid.New()
+	s.transaction = &models.Transaction{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		Category:     models.CategoryOther,
+		MerchantName: "Blue Bottle",
+		Version:      3,
+	}
+}
+
+func (s *TransactionCategoryServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// expectOwnedTransaction sets up the lookup of a transaction on an account owned by the test user
+func (s *TransactionCategoryServiceTestSuite) expectOwnedTransaction() {
+	s.mockTransactionRepo.EXPECT().GetByID(s.transaction.ID).Return(s.transaction, nil)
+	s.mockAccountRepo.EXPECT().GetByID(s.transaction.AccountID).
+		Return(&models.Account{ID: s.transaction.AccountID, UserID: s.userID}, nil)
+}
+
+// expectOverride applies the category change the way the real category service does
+func (s *TransactionCategoryServiceTestSuite) expectOverride(category string) {
+	s.mockCategoryService.EXPECT().OverrideCategory(s.transaction, category, gomock.Any()).
+		DoAndReturn(func(txn *models.Transaction, newCategory, _ string) error {
+			txn.Category = newCategory
+			return nil
+		})
+}
+
+func (s *TransactionCategoryServiceTestSuite) TestOverride_PersistsAndAudits() {
+	s.expectOwnedTransaction()
+	s.expectOverride(models.CategoryDining)
+	s.mockTransactionRepo.EXPECT().UpdateWithOptimisticLock(s.transaction, 3).Return(nil)
+	s.mockAuditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(models.AuditActionCategoryOverridden, log.Action)
+		s.Equal(s.transaction.ID.String(), log.ResourceID)
+		s.Equal(s.userID, *log.UserID)
+		s.Equal(models.CategoryOther, log.Metadata["old_category"])
+		s.Equal(models.CategoryDining, log.Metadata["new_category"])
+		s.Equal("coffee shop", log.Metadata["reason"])
+		s.Equal("10.0.0.1", log.IPAddress)
+		return nil
+	})
+
+	result, err := s.service.OverrideTransactionCategory(s.transaction.ID, s.userID, false, false,
+		&dto.OverrideTransactionCategoryRequest{Category: "dining", Reason: "coffee shop"}, "10.0.0.1", "test-agent")
+
+	s.Require().NoError(err)
+	s.Equal(models.CategoryOther, result.PreviousCategory)
+	s.Equal(models.CategoryDining, result.Transaction.Category)
+	s.Nil(result.MerchantMapping)
+	s.True(result.Transaction.IsCategoryOverridden())
+	s.Equal(s.userID, *result.Transaction.CategoryOverriddenBy)
+}
+
+func (s *TransactionCategoryServiceTestSuite) TestOverride_CreatesMerchantRule() {
+	mapping := &models.MerchantMapping{ID: uuid.New(), MerchantPattern: "Blue Bottle", CategoryCode: models.CategoryDining}
+
+	s.expectOwnedTransaction()
+	s.expectOverride(models.CategoryDining)
+	s.mockTransactionRepo.EXPECT().UpdateWithOptimisticLock(s.transaction, 3).Return(nil)
+	s.mockManagementService.EXPECT().CreateMerchantMapping(&dto.CreateMerchantMappingRequest{
+		MerchantPattern: "Blue Bottle",
+		CategoryCode:    models.CategoryDining,
+		MatchType:       models.MatchTypeExact,
+	}).Return(mapping, nil)
+	s.mockAuditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+		s.Equal(mapping.ID.String(), log.Metadata["merchant_mapping_id"])
+		return nil
+	})
+
+	result, err := s.service.OverrideTransactionCategory(s.transaction.ID, s.userID, false, true,
+		&dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "coffee shop", CreateRule: true}, "", "")
+
+	s.Require().NoError(err)
+	s.Equal(mapping, result.MerchantMapping)
+}
+
+func (s *TransactionCategoryServiceTestSuite) TestOverride_RuleFailureDoesNotFailOverride() {
+	s.expectOwnedTransaction()
+	s.expectOverride(models.CategoryDining)
+	s.mockTransactionRepo.EXPECT().UpdateWithOptimisticLock(s.transaction, 3).Return(nil)
+	s.mockManagementService.EXPECT().CreateMerchantMapping(gomock.Any()).Return(nil, ErrInvalidMerchantMapping)
+	s.mockAuditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	result, err := s.service.OverrideTransactionCategory(s.transaction.ID, s.userID, false, true,
+		&dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "coffee shop", CreateRule: true}, "", "")
+
+	s.Require().NoError(err)
+	s.Nil(result.MerchantMapping)
+}
+
+func (s *TransactionCategoryServiceTestSuite) TestOverride_AuditFailureIsIgnored() {
+	s.expectOwnedTransaction()
+	s.expectOverride(models.CategoryDining)
+	s.mockTransactionRepo.EXPECT().UpdateWithOptimisticLock(s.transaction, 3).Return(nil)
+	s.mockAuditRepo.EXPECT().Create(gomock.Any()).Return(errors.New("audit store unavailable"))
+
+	_, err := s.service.OverrideTransactionCategory(s.transaction.ID, s.userID, false, false,
+		&dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "coffee shop"}, "", "")
+
+	s.NoError(err)
+}
+
+func (s *TransactionCategoryServiceTestSuite) TestOverride_Errors() {
+	otherUser := uuid.New()
+	staleVersion := 2
+
+	tests := []struct {
+		name        string
+		isAdmin     bool
+		req         *dto.OverrideTransactionCategoryRequest
+		setupMocks  func()
+		expectedErr error
+	}{
+		{
+			name: "transaction not found",
+			req:  &dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "r"},
+			setupMocks: func() {
+				s.mockTransactionRepo.EXPECT().GetByID(s.transaction.ID).Return(nil, repositories.ErrTransactionNotFound)
+			},
+			expectedErr: ErrTransactionNotFound,
+		},
+		{
+			name: "account owned by another user",
+			req:  &dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "r"},
+			setupMocks: func() {
+				s.mockTransactionRepo.EXPECT().GetByID(s.transaction.ID).Return(s.transaction, nil)
+				s.mockAccountRepo.EXPECT().GetByID(s.transaction.AccountID).
+					Return(&models.Account{ID: s.transaction.AccountID, UserID: otherUser}, nil)
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name: "client version is stale",
+			req:  &dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "r", Version: &staleVersion},
+			setupMocks: func() {
+				s.expectOwnedTransaction()
+			},
+			expectedErr: models.ErrOptimisticLockConflict,
+		},
+		{
+			name: "category unchanged",
+			req:  &dto.OverrideTransactionCategoryRequest{Category: models.CategoryOther, Reason: "r"},
+			setupMocks: func() {
+				s.expectOwnedTransaction()
+				s.mockCategoryService.EXPECT().OverrideCategory(s.transaction, models.CategoryOther, "r").
+					Return(ErrCategoryNotChanged)
+			},
+			expectedErr: ErrCategoryNotChanged,
+		},
+		{
+			name:    "concurrent update",
+			isAdmin: true,
+			req:     &dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "r"},
+			setupMocks: func() {
+				s.mockTransactionRepo.EXPECT().GetByID(s.transaction.ID).Return(s.transaction, nil)
+				s.expectOverride(models.CategoryDining)
+				s.mockTransactionRepo.EXPECT().UpdateWithOptimisticLock(s.transaction, 3).
+					Return(models.ErrOptimisticLockConflict)
+			},
+			expectedErr: models.ErrOptimisticLockConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			defer s.TearDownTest()
+			tt.setupMocks()
+
+			result, err := s.service.OverrideTransactionCategory(s.transaction.ID, s.userID, tt.isAdmin, false, tt.req, "", "")
+
+			s.Nil(result)
+			s.ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+func (s *TransactionCategoryServiceTestSuite) TestOverride_RuleRequiresMerchantName() {
+	s.transaction.MerchantName = ""
+	s.expectOwnedTransaction()
+
+	_, err := s.service.OverrideTransactionCategory(s.transaction.ID, s.userID, false, true,
+		&dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "r", CreateRule: true}, "", "")
+
+	s.ErrorIs(err, ErrMerchantNameRequired)
+}
+
+func (s *TransactionCategoryServiceTestSuite) TestOverride_RuleRequiresCategoriesPermission() {
+	_, err := s.service.OverrideTransactionCategory(s.transaction.ID, s.userID, false, false,
+		&dto.OverrideTransactionCategoryRequest{Category: models.CategoryDining, Reason: "r", CreateRule: true, RuleMatchType: models.MatchTypePartial}, "", "")
+
+	s.ErrorIs(err, ErrRuleCreationDenied)
+}
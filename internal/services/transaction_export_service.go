@@ -0,0 +1,98 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+)
+
+// Transaction export formats
+const (
+	ExportFormatCSV = "csv"
+	ExportFormatOFX = "ofx"
+	ExportFormatQIF = "qif"
+)
+
+// ExportContentTypes maps each transaction export format to its MIME type
+var ExportContentTypes = map[string]string{
+	ExportFormatCSV: "text/csv",
+	ExportFormatOFX: "application/x-ofx",
+	ExportFormatQIF: "application/qif",
+}
+
+// exportBatchSize is how many transactions are read from the database, and
+// written out, at a time
+const exportBatchSize = 500
+
+var ErrUnsupportedExportFormat = errors.New("unsupported export format")
+
+// TransactionExportService streams an account's transactions to personal finance
+// tools. Transactions are read in batches and written as they arrive, so an export
+// never holds more than one batch in memory.
+type TransactionExportService struct {
+	transactionRepo repositories.TransactionRepositoryInterface
+	batchSize       int
+}
+
+// NewTransactionExportService creates a new transaction export service
+func NewTransactionExportService(transactionRepo repositories.TransactionRepositoryInterface) TransactionExportServiceInterface {
+	return &TransactionExportService{
+		transactionRepo: transactionRepo,
+		batchSize:       exportBatchSize,
+	}
+}
+
+// ExportTransactions writes every transaction on the account matching filters to
+// w, oldest first. Offset and Limit in filters are ignored. If w is an
+// http.Flusher it is flushed after each batch so the client sees rows as they are
+// written.
+func (s *TransactionExportService) ExportTransactions(account *models.Account, filters models.TransactionFilters, format string, w io.Writer) error {
+	newExporter, ok := transactionExporters[format]
+	if !ok {
+		return ErrUnsupportedExportFormat
+	}
+
+	filters.AccountID = account.ID
+	exporter := newExporter(w, account, filters, time.Now())
+
+	if err := exporter.begin(); err != nil {
+		return fmt.Errorf("failed to write export header: %w", err)
+	}
+
+	written := 0
+	err := s.transactionRepo.StreamWithFilters(filters, s.batchSize, func(transaction *models.Transaction) error {
+		if err := exporter.write(transaction); err != nil {
+			return fmt.Errorf("failed to write transaction %s: %w", transaction.ID, err)
+		}
+
+		written++
+		if written%s.batchSize == 0 {
+			return flushExport(exporter, w)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := exporter.end(); err != nil {
+		return fmt.Errorf("failed to write export footer: %w", err)
+	}
+	return flushExport(exporter, w)
+}
+
+// flushExport pushes everything written so far through to the client
+func flushExport(exporter transactionExporter, w io.Writer) error {
+	if err := exporter.flush(); err != nil {
+		return fmt.Errorf("failed to flush export: %w", err)
+	}
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newExportTestAccount() *models.Account {
+	return &models.Account{
+		ID:            uuid.New(),
+		AccountNumber: "1012345678",
+		RoutingNumber: "021000021",
+		AccountType:   models.AccountTypeMoneyMarket,
+		Balance:       decimal.NewFromInt(1075),
+		Currency:      "USD",
+		CreatedAt:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func newExportTestTransactions(accountID uuid.UUID) []*models.Transaction {
+	postedAt := time.Date(2025, 9, 14, 10, 0, 0, 0, time.UTC)
+	return []*models.Transaction{
+		{
+			ID:              uuid.New(),
+			AccountID:       accountID,
+			TransactionType: models.TransactionTypeCredit,
+			Amount:          decimal.NewFromInt(100),
+			BalanceAfter:    decimal.NewFromInt(1100),
+			Description:     "Payroll deposit",
+			Reference:       "TXN-001",
+			Status:          models.TransactionStatusCompleted,
+			Category:        models.CategoryIncome,
+			CreatedAt:       postedAt,
+		},
+		{
+			ID:              uuid.New(),
+			AccountID:       accountID,
+			TransactionType: models.TransactionTypeDebit,
+			Amount:          decimal.RequireFromString("25.50"),
+			BalanceAfter:    decimal.RequireFromString("1074.50"),
+			Description:     "=cmd() <groceries> & more\nsecond line",
+			Reference:       "TXN-002",
+			Status:          models.TransactionStatusCompleted,
+			Category:        models.CategoryGroceries,
+			MerchantName:    "Corner Market & Deli",
+			MCCCode:         "5411",
+			CreatedAt:       postedAt.Add(26 * time.Hour),
+		},
+	}
+}
+
+// expectStream makes the mock repository stream transactions to the export callback
+func expectStream(repo *repository_mocks.MockTransactionRepositoryInterface, transactions []*models.Transaction) *gomock.Call {
+	return repo.EXPECT().StreamWithFilters(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ models.TransactionFilters, _ int, fn func(*models.Transaction) error) error {
+			for _, transaction := range transactions {
+				if err := fn(transaction); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
+
+func TestExportTransactions_CSV(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repository_mocks.NewMockTransactionRepositoryInterface(ctrl)
+	account := newExportTestAccount()
+	transactions := newExportTestTransactions(account.ID)
+
+	filters := models.TransactionFilters{Category: models.CategoryGroceries, Offset: 40, Limit: 21}
+	repo.EXPECT().StreamWithFilters(gomock.Any(), exportBatchSize, gomock.Any()).
+		DoAndReturn(func(got models.TransactionFilters, _ int, fn func(*models.Transaction) error) error {
+			assert.Equal(t, account.ID, got.AccountID)
+			assert.Equal(t, models.CategoryGroceries, got.Category)
+			for _, transaction := range transactions {
+				require.NoError(t, fn(transaction))
+			}
+			return nil
+		})
+
+	var buf bytes.Buffer
+	err := NewTransactionExportService(repo).ExportTransactions(account, filters, ExportFormatCSV, &buf)
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, []string{
+		"date", "transaction_id", "reference", "type", "amount", "running_balance",
+		"category", "merchant_name", "mcc_code", "description", "status",
+	}, rows[0])
+	assert.Equal(t, "100.00", rows[1][4])
+	assert.Equal(t, "1100.00", rows[1][5])
+
+	debit := rows[2]
+	assert.Equal(t, "2025-09-15T12:00:00Z", debit[0])
+	assert.Equal(t, transactions[1].ID.String(), debit[1])
+	assert.Equal(t, "-25.50", debit[4])
+	assert.Equal(t, "1074.50", debit[5])
+	assert.Equal(t, models.CategoryGroceries, debit[6])
+	assert.Equal(t, "Corner Market & Deli", debit[7])
+	assert.Equal(t, "5411", debit[8])
+	assert.True(t, strings.HasPrefix(debit[9], "'="), "formula should be neutralised")
+}
+
+// ofxDocument is the part of an OFX bank statement the tests inspect
+type ofxDocument struct {
+	Statement struct {
+		Currency string `xml:"CURDEF"`
+		Account  struct {
+			BankID string `xml:"BANKID"`
+			AcctID string `xml:"ACCTID"`
+			Type   string `xml:"ACCTTYPE"`
+		} `xml:"BANKACCTFROM"`
+		List struct {
+			Start        string `xml:"DTSTART"`
+			End          string `xml:"DTEND"`
+			Transactions []struct {
+				Type   string `xml:"TRNTYPE"`
+				Posted string `xml:"DTPOSTED"`
+				Amount string `xml:"TRNAMT"`
+				FITID  string `xml:"FITID"`
+				Name   string `xml:"NAME"`
+				Memo   string `xml:"MEMO"`
+			} `xml:"STMTTRN"`
+		} `xml:"BANKTRANLIST"`
+		LedgerBalance string `xml:"LEDGERBAL>BALAMT"`
+	} `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS"`
+}
+
+func TestExportTransactions_OFX(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repository_mocks.NewMockTransactionRepositoryInterface(ctrl)
+	account := newExportTestAccount()
+	transactions := newExportTestTransactions(account.ID)
+	expectStream(repo, transactions)
+
+	startDate := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	filters := models.TransactionFilters{StartDate: &startDate}
+
+	var buf bytes.Buffer
+	err := NewTransactionExportService(repo).ExportTransactions(account, filters, ExportFormatOFX, &buf)
+	require.NoError(t, err)
+
+	assert.True(t, strings.Contains(buf.String(), `<?OFX OFXHEADER="200" VERSION="220"`))
+
+	var doc ofxDocument
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc), buf.String())
+
+	statement := doc.Statement
+	assert.Equal(t, "USD", statement.Currency)
+	assert.Equal(t, "021000021", statement.Account.BankID)
+	assert.Equal(t, "1012345678", statement.Account.AcctID)
+	assert.Equal(t, "MONEYMRKT", statement.Account.Type)
+	assert.Equal(t, "20250901000000[0:GMT]", statement.List.Start)
+	assert.Equal(t, "1075.00", statement.LedgerBalance)
+
+	require.Len(t, statement.List.Transactions, 2)
+
+	credit := statement.List.Transactions[0]
+	assert.Equal(t, "CREDIT", credit.Type)
+	assert.Equal(t, "100.00", credit.Amount)
+	assert.Equal(t, "Payroll deposit", credit.Name)
+
+	debit := statement.List.Transactions[1]
+	assert.Equal(t, "DEBIT", debit.Type)
+	assert.Equal(t, "20250915120000[0:GMT]", debit.Posted)
+	assert.Equal(t, "-25.50", debit.Amount)
+	assert.Equal(t, transactions[1].ID.String(), debit.FITID)
+	assert.Equal(t, "Corner Market & Deli", debit.Name)
+	assert.Contains(t, debit.Memo, "<groceries> & more")
+	assert.Contains(t, debit.Memo, "category: "+models.CategoryGroceries)
+	assert.Contains(t, debit.Memo, "balance: 1074.50")
+}
+
+func TestExportTransactions_QIF(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repository_mocks.NewMockTransactionRepositoryInterface(ctrl)
+	account := newExportTestAccount()
+	transactions := newExportTestTransactions(account.ID)
+	expectStream(repo, transactions)
+
+	var buf bytes.Buffer
+	err := NewTransactionExportService(repo).ExportTransactions(account, models.TransactionFilters{}, ExportFormatQIF, &buf)
+	require.NoError(t, err)
+
+	records := strings.Split(strings.TrimSuffix(buf.String(), "^\n"), "^\n")
+	require.Len(t, records, 2)
+	assert.True(t, strings.HasPrefix(records[0], "!Type:Bank\n"))
+
+	assert.Equal(t, strings.Join([]string{
+		"D09/15/2025",
+		"T-25.50",
+		"PCorner Market & Deli",
+		"M=cmd() <groceries> & more second line | balance: 1074.50",
+		"L" + models.CategoryGroceries,
+		"NTXN-002",
+		"",
+	}, "\n"), records[1])
+}
+
+func TestExportTransactions_FlushesEachBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repository_mocks.NewMockTransactionRepositoryInterface(ctrl)
+	account := newExportTestAccount()
+	transactions := newExportTestTransactions(account.ID)
+
+	service := &TransactionExportService{transactionRepo: repo, batchSize: 1}
+	recorder := httptest.NewRecorder()
+
+	repo.EXPECT().StreamWithFilters(gomock.Any(), 1, gomock.Any()).
+		DoAndReturn(func(_ models.TransactionFilters, _ int, fn func(*models.Transaction) error) error {
+			require.NoError(t, fn(transactions[0]))
+			assert.True(t, recorder.Flushed, "first batch should reach the client before the second is read")
+			assert.Contains(t, recorder.Body.String(), transactions[0].ID.String())
+			return fn(transactions[1])
+		})
+
+	require.NoError(t, service.ExportTransactions(account, models.TransactionFilters{}, ExportFormatCSV, recorder))
+	assert.Contains(t, recorder.Body.String(), transactions[1].ID.String())
+}
+
+func TestExportTransactions_UnsupportedFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repository_mocks.NewMockTransactionRepositoryInterface(ctrl)
+
+	var buf bytes.Buffer
+	err := NewTransactionExportService(repo).ExportTransactions(newExportTestAccount(), models.TransactionFilters{}, "xlsx", &buf)
+
+	assert.ErrorIs(t, err, ErrUnsupportedExportFormat)
+	assert.Zero(t, buf.Len())
+}
+
+func TestExportTransactions_StreamError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repository_mocks.NewMockTransactionRepositoryInterface(ctrl)
+	streamErr := errors.New("connection reset")
+	repo.EXPECT().StreamWithFilters(gomock.Any(), gomock.Any(), gomock.Any()).Return(streamErr)
+
+	var buf bytes.Buffer
+	err := NewTransactionExportService(repo).ExportTransactions(newExportTestAccount(), models.TransactionFilters{}, ExportFormatOFX, &buf)
+
+	assert.ErrorIs(t, err, streamErr)
+}
@@ -0,0 +1,271 @@
+package services
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"array-assessment/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+// transactionExporter writes one export document, a transaction at a time
+type transactionExporter interface {
+	// begin writes everything that comes before the first transaction
+	begin() error
+	// write writes one transaction
+	write(transaction *models.Transaction) error
+	// end writes everything that comes after the last transaction
+	end() error
+	// flush writes out anything buffered
+	flush() error
+}
+
+// transactionExporters create an exporter for each export format
+var transactionExporters = map[string]func(w io.Writer, account *models.Account, filters models.TransactionFilters, now time.Time) transactionExporter{
+	ExportFormatCSV: newCSVExporter,
+	ExportFormatOFX: newOFXExporter,
+	ExportFormatQIF: newQIFExporter,
+}
+
+// signedAmount returns the transaction amount with debits negative
+func signedAmount(transaction *models.Transaction) decimal.Decimal {
+	if transaction.TransactionType == models.TransactionTypeDebit {
+		return transaction.Amount.Neg()
+	}
+	return transaction.Amount
+}
+
+// csvExporter writes one row per transaction with its running balance
+type csvExporter struct {
+	writer *csv.Writer
+}
+
+func newCSVExporter(w io.Writer, _ *models.Account, _ models.TransactionFilters, _ time.Time) transactionExporter {
+	return &csvExporter{writer: csv.NewWriter(w)}
+}
+
+func (e *csvExporter) begin() error {
+	return e.writer.Write([]string{
+		"date", "transaction_id", "reference", "type", "amount", "running_balance",
+		"category", "merchant_name", "mcc_code", "description", "status",
+	})
+}
+
+func (e *csvExporter) write(transaction *models.Transaction) error {
+	return e.writer.Write([]string{
+		transaction.CreatedAt.UTC().Format(time.RFC3339),
+		transaction.ID.String(),
+		csvSafe(transaction.Reference),
+		transaction.TransactionType,
+		signedAmount(transaction).StringFixed(2),
+		transaction.BalanceAfter.StringFixed(2),
+		transaction.Category,
+		csvSafe(transaction.MerchantName),
+		transaction.MCCCode,
+		csvSafe(transaction.Description),
+		transaction.Status,
+	})
+}
+
+func (e *csvExporter) end() error {
+	return nil
+}
+
+func (e *csvExporter) flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// OFX field limits from the OFX 2.2 specification
+const (
+	ofxNameWidth = 32
+	ofxMemoWidth = 255
+)
+
+// ofxAccountTypes maps account types to OFX ACCTTYPE values
+var ofxAccountTypes = map[string]string{
+	models.AccountTypeChecking:    "CHECKING",
+	models.AccountTypeSavings:     "SAVINGS",
+	models.AccountTypeMoneyMarket: "MONEYMRKT",
+}
+
+// ofxExporter writes an OFX 2.2 bank statement response. Category and running
+// balance have no OFX element, so they are carried in each transaction's memo.
+type ofxExporter struct {
+	writer      *bufio.Writer
+	account     *models.Account
+	periodStart time.Time
+	periodEnd   time.Time
+	now         time.Time
+}
+
+func newOFXExporter(w io.Writer, account *models.Account, filters models.TransactionFilters, now time.Time) transactionExporter {
+	exporter := &ofxExporter{
+		writer:      bufio.NewWriter(w),
+		account:     account,
+		periodStart: account.CreatedAt,
+		periodEnd:   now,
+		now:         now,
+	}
+	if filters.StartDate != nil {
+		exporter.periodStart = *filters.StartDate
+	}
+	if filters.EndDate != nil {
+		exporter.periodEnd = *filters.EndDate
+	}
+	return exporter
+}
+
+func (e *ofxExporter) begin() error {
+	_, err := fmt.Fprintf(e.writer, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+<SIGNONMSGSRSV1>
+<SONRS>
+<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>
+<DTSERVER>%s</DTSERVER>
+<LANGUAGE>ENG</LANGUAGE>
+</SONRS>
+</SIGNONMSGSRSV1>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<TRNUID>0</TRNUID>
+<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>
+<STMTRS>
+<CURDEF>%s</CURDEF>
+<BANKACCTFROM>
+<BANKID>%s</BANKID>
+<ACCTID>%s</ACCTID>
+<ACCTTYPE>%s</ACCTTYPE>
+</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>%s</DTSTART>
+<DTEND>%s</DTEND>
+`,
+		ofxDate(e.now),
+		ofxText(e.account.Currency),
+		ofxText(e.account.RoutingNumber),
+		ofxText(e.account.AccountNumber),
+		ofxAccountTypes[e.account.AccountType],
+		ofxDate(e.periodStart),
+		ofxDate(e.periodEnd),
+	)
+	return err
+}
+
+func (e *ofxExporter) write(transaction *models.Transaction) error {
+	trnType := "CREDIT"
+	if transaction.TransactionType == models.TransactionTypeDebit {
+		trnType = "DEBIT"
+	}
+
+	name := transaction.MerchantName
+	if name == "" {
+		name = transaction.Description
+	}
+
+	memo := transaction.Description
+	if transaction.Category != "" {
+		memo += " | category: " + transaction.Category
+	}
+	memo += " | balance: " + transaction.BalanceAfter.StringFixed(2)
+
+	_, err := fmt.Fprintf(e.writer, `<STMTTRN>
+<TRNTYPE>%s</TRNTYPE>
+<DTPOSTED>%s</DTPOSTED>
+<TRNAMT>%s</TRNAMT>
+<FITID>%s</FITID>
+<NAME>%s</NAME>
+<MEMO>%s</MEMO>
+</STMTTRN>
+`,
+		trnType,
+		ofxDate(transaction.CreatedAt),
+		signedAmount(transaction).StringFixed(2),
+		transaction.ID,
+		ofxText(truncate(name, ofxNameWidth)),
+		ofxText(truncate(memo, ofxMemoWidth)),
+	)
+	return err
+}
+
+func (e *ofxExporter) end() error {
+	_, err := fmt.Fprintf(e.writer, `</BANKTRANLIST>
+<LEDGERBAL>
+<BALAMT>%s</BALAMT>
+<DTASOF>%s</DTASOF>
+</LEDGERBAL>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`,
+		e.account.Balance.StringFixed(2),
+		ofxDate(e.now),
+	)
+	return err
+}
+
+func (e *ofxExporter) flush() error {
+	return e.writer.Flush()
+}
+
+// ofxDate formats a time as an OFX datetime in GMT
+func ofxDate(t time.Time) string {
+	return t.UTC().Format("20060102150405") + "[0:GMT]"
+}
+
+// ofxText escapes free text for an OFX element
+func ofxText(text string) string {
+	var buf strings.Builder
+	_ = xml.EscapeText(&buf, []byte(text))
+	return buf.String()
+}
+
+// qifExporter writes a QIF bank register. QIF has no running balance field, so it
+// is carried in each transaction's memo.
+type qifExporter struct {
+	writer *bufio.Writer
+}
+
+func newQIFExporter(w io.Writer, _ *models.Account, _ models.TransactionFilters, _ time.Time) transactionExporter {
+	return &qifExporter{writer: bufio.NewWriter(w)}
+}
+
+func (e *qifExporter) begin() error {
+	_, err := e.writer.WriteString("!Type:Bank\n")
+	return err
+}
+
+func (e *qifExporter) write(transaction *models.Transaction) error {
+	memo := transaction.Description + " | balance: " + transaction.BalanceAfter.StringFixed(2)
+
+	_, err := fmt.Fprintf(e.writer, "D%s\nT%s\nP%s\nM%s\nL%s\nN%s\n^\n",
+		transaction.CreatedAt.UTC().Format("01/02/2006"),
+		signedAmount(transaction).StringFixed(2),
+		qifText(transaction.MerchantName),
+		qifText(memo),
+		qifText(transaction.Category),
+		qifText(transaction.Reference),
+	)
+	return err
+}
+
+func (e *qifExporter) end() error {
+	return nil
+}
+
+func (e *qifExporter) flush() error {
+	return e.writer.Flush()
+}
+
+// qifText keeps free text on one line, since QIF fields are line delimited
+func qifText(text string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(text)
+}
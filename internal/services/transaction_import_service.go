@@ -0,0 +1,567 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Transaction import formats
+const (
+	ImportFormatCSV   = "csv"
+	ImportFormatJSONL = "jsonl"
+)
+
+// MaxImportRows is the most rows a single import file may hold
+const MaxImportRows = 10000
+
+var (
+	ErrUnsupportedImportFormat = errors.New("unsupported import format")
+	ErrInvalidImportMode       = errors.New("invalid import mode")
+	ErrInvalidImportFile       = errors.New("invalid import file")
+)
+
+// importRequiredColumns must be named in a CSV header; merchant_name, mcc_code and
+// category are optional. Columns may come in any order.
+var importRequiredColumns = []string{"account_number", "type", "amount", "description", "reference"}
+
+// importRow is one credit or debit read from an import file
+type importRow struct {
+	line          int
+	AccountNumber string      `json:"account_number"`
+	Type          string      `json:"type"`
+	Amount        json.Number `json:"amount"`
+	Description   string      `json:"description"`
+	Reference     string      `json:"reference"`
+	MerchantName  string      `json:"merchant_name"`
+	MCCCode       string      `json:"mcc_code"`
+	Category      string      `json:"category"`
+	parseErr      error
+}
+
+// importEntry is a row together with the transaction built from it and its outcome
+type importEntry struct {
+	result      *dto.TransactionImportRow
+	account     *models.Account
+	transaction *models.Transaction
+	amount      decimal.Decimal
+}
+
+// TransactionImportService loads back-office files of credits and debits. Every row
+// is validated and categorized, then each account's rows are posted in file order,
+// either all or nothing per account or row by row. Imports bypass limits and fraud
+// screening, which apply to customer-initiated activity; instead, an import with a
+// row at or above the approval threshold is held for a second admin before it is
+// posted.
+type TransactionImportService struct {
+	transactionRepo repositories.TransactionRepositoryInterface
+	accountRepo     repositories.AccountRepositoryInterface
+	categoryRepo    repositories.TransactionCategoryRepositoryInterface
+	categoryService CategoryServiceInterface
+	logger          *slog.Logger
+}
+
+// NewTransactionImportService creates a new transaction import service
+func NewTransactionImportService(
+	transactionRepo repositories.TransactionRepositoryInterface,
+	accountRepo repositories.AccountRepositoryInterface,
+	categoryRepo repositories.TransactionCategoryRepositoryInterface,
+	categoryService CategoryServiceInterface,
+	logger *slog.Logger,
+) TransactionImportServiceInterface {
+	return &TransactionImportService{
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		categoryService: categoryService,
+		logger:          logger,
+	}
+}
+
+// ImportTransactions validates and applies an import file, returning what happened
+// to every row. A dry run validates the rows and checks them against current
+// balances without writing anything.
+func (s *TransactionImportService) ImportTransactions(file io.Reader, options dto.ImportTransactionsOptions) (*dto.TransactionImportReport, error) {
+	if options.Mode != dto.ImportModeAtomic && options.Mode != dto.ImportModePartial {
+		return nil, ErrInvalidImportMode
+	}
+
+	var rows []*importRow
+	var err error
+	switch options.Format {
+	case ImportFormatCSV:
+		rows, err = readImportCSV(file)
+	case ImportFormatJSONL:
+		rows, err = readImportJSONL(file)
+	default:
+		return nil, ErrUnsupportedImportFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: no rows", ErrInvalidImportFile)
+	}
+
+	entries, err := s.validate(rows, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groupByAccount(entries) {
+		s.apply(group, options)
+	}
+
+	return buildImportReport(entries, options), nil
+}
+
+// validate checks every row and builds the transaction to post for each valid one
+func (s *TransactionImportService) validate(rows []*importRow, options dto.ImportTransactionsOptions) ([]*importEntry, error) {
+	references := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Reference != "" {
+			references = append(references, strings.TrimSpace(row.Reference))
+		}
+	}
+	existing, err := s.transactionRepo.GetExistingReferences(references)
+	if err != nil {
+		return nil, err
+	}
+	taken := make(map[string]bool, len(existing))
+	for _, reference := range existing {
+		taken[reference] = true
+	}
+
+	accounts := make(map[string]*models.Account)
+	categories := make(map[string]bool)
+	seen := make(map[string]int)
+
+	entries := make([]*importEntry, 0, len(rows))
+	for _, row := range rows {
+		entry := &importEntry{
+			result: &dto.TransactionImportRow{
+				Row:           row.line,
+				AccountNumber: strings.TrimSpace(row.AccountNumber),
+				Reference:     strings.TrimSpace(row.Reference),
+			},
+		}
+		entries = append(entries, entry)
+
+		if row.parseErr != nil {
+			entry.reject(row.parseErr.Error())
+			continue
+		}
+
+		reference := entry.result.Reference
+		switch {
+		case reference == "":
+			entry.reject("reference is required")
+		case len(reference) > 100:
+			entry.reject("reference must be at most 100 characters")
+		case taken[reference]:
+			entry.reject("reference already exists")
+		case seen[reference] != 0:
+			entry.reject(fmt.Sprintf("reference repeats row %d", seen[reference]))
+		default:
+			seen[reference] = row.line
+		}
+
+		account, err := s.lookupAccount(accounts, entry.result.AccountNumber)
+		if err != nil {
+			return nil, err
+		}
+		if account == nil {
+			entry.reject("account not found")
+		}
+		entry.account = account
+
+		transaction := &models.Transaction{
+			TransactionType: strings.ToLower(strings.TrimSpace(row.Type)),
+			Description:     strings.TrimSpace(row.Description),
+			Reference:       reference,
+			MerchantName:    strings.TrimSpace(row.MerchantName),
+			MCCCode:         strings.TrimSpace(row.MCCCode),
+			Category:        normalizeCategoryCode(row.Category),
+			Status:          models.TransactionStatusCompleted,
+			Metadata: models.JSONBMap{
+				"imported_by": options.ImportedBy.String(),
+				"import_row":  row.line,
+			},
+		}
+
+		if !models.IsValidTransactionType(transaction.TransactionType) {
+			entry.reject("type must be credit or debit")
+		}
+
+		amount, err := decimal.NewFromString(strings.TrimSpace(row.Amount.String()))
+		switch {
+		case err != nil:
+			entry.reject("amount must be a number")
+		case !amount.IsPositive():
+			entry.reject("amount must be positive")
+		case !amount.Equal(amount.Round(2)):
+			entry.reject("amount must have at most 2 decimal places")
+		}
+		transaction.Amount = amount
+		entry.amount = amount
+
+		if transaction.Description == "" {
+			entry.reject("description is required")
+		}
+		if len(transaction.MerchantName) > 255 {
+			entry.reject("merchant_name must be at most 255 characters")
+		}
+		if len(transaction.MCCCode) > 10 {
+			entry.reject("mcc_code must be at most 10 characters")
+		}
+
+		if transaction.Category != "" {
+			active, err := s.isActiveCategory(categories, transaction.Category)
+			if err != nil {
+				return nil, err
+			}
+			if !active {
+				entry.reject("category is not an active category")
+			}
+		}
+
+		if entry.result.Status == dto.ImportRowRejected {
+			continue
+		}
+
+		if transaction.Category == "" {
+			transaction.Category = s.categorize(transaction, options.DryRun)
+		}
+		entry.transaction = transaction
+		entry.result.Category = transaction.Category
+	}
+
+	return entries, nil
+}
+
+// lookupAccount finds an account by number, remembering the answer for later rows.
+// It returns nil when there is no such account.
+func (s *TransactionImportService) lookupAccount(accounts map[string]*models.Account, accountNumber string) (*models.Account, error) {
+	if accountNumber == "" {
+		return nil, nil
+	}
+	if account, ok := accounts[accountNumber]; ok {
+		return account, nil
+	}
+
+	account, err := s.accountRepo.GetByAccountNumber(accountNumber)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrAccountNotFound) {
+			return nil, fmt.Errorf("failed to get account: %w", err)
+		}
+		account = nil
+	}
+	accounts[accountNumber] = account
+	return account, nil
+}
+
+// isActiveCategory reports whether a category code can be assigned, remembering the
+// answer for later rows
+func (s *TransactionImportService) isActiveCategory(categories map[string]bool, code string) (bool, error) {
+	if active, ok := categories[code]; ok {
+		return active, nil
+	}
+
+	category, err := s.categoryRepo.GetByCode(code)
+	if err != nil && !errors.Is(err, repositories.ErrCategoryNotFound) {
+		return false, fmt.Errorf("failed to get category: %w", err)
+	}
+	categories[code] = err == nil && category.IsActive
+	return categories[code], nil
+}
+
+// categorize runs the categorization rules. A dry run previews them so that rule
+// usage statistics are not skewed by rows that are never posted.
+func (s *TransactionImportService) categorize(transaction *models.Transaction, dryRun bool) string {
+	if dryRun {
+		return s.categoryService.PreviewCategorization(transaction).Category
+	}
+	return s.categoryService.CategorizeTransaction(transaction).Category
+}
+
+// groupByAccount groups the rows that reference a known account, keeping file order
+// within and across accounts
+func groupByAccount(entries []*importEntry) [][]*importEntry {
+	var groups [][]*importEntry
+	index := make(map[uuid.UUID]int)
+	for _, entry := range entries {
+		if entry.account == nil {
+			continue
+		}
+		i, ok := index[entry.account.ID]
+		if !ok {
+			i = len(groups)
+			index[entry.account.ID] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], entry)
+	}
+	return groups
+}
+
+// apply posts, or on a dry run simulates posting, one account's valid rows. In
+// atomic mode nothing is posted for the account if any of its rows is rejected or
+// fails.
+func (s *TransactionImportService) apply(group []*importEntry, options dto.ImportTransactionsOptions) {
+	atomic := options.Mode == dto.ImportModeAtomic
+
+	var pending []*importEntry
+	var blocker *importEntry
+	for _, entry := range group {
+		if entry.transaction != nil {
+			pending = append(pending, entry)
+		} else if blocker == nil {
+			blocker = entry
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+	if atomic && blocker != nil {
+		skipAll(pending, blocker)
+		return
+	}
+
+	transactions := make([]*models.Transaction, 0, len(pending))
+	for _, entry := range pending {
+		transactions = append(transactions, entry.transaction)
+	}
+
+	var rowErrs []error
+	if options.DryRun {
+		rowErrs = simulatePosting(group[0].account, transactions, atomic)
+	} else {
+		var err error
+		rowErrs, err = s.transactionRepo.ImportForAccount(group[0].account.ID, transactions, atomic)
+		if err != nil {
+			s.logger.Error("failed to import transactions for account",
+				slog.String("account_id", group[0].account.ID.String()),
+				slog.String("error", err.Error()),
+			)
+			for _, entry := range pending {
+				entry.fail("could not be applied, nothing was imported for this account")
+			}
+			return
+		}
+	}
+
+	for i, entry := range pending {
+		if rowErrs[i] == nil {
+			continue
+		}
+		if atomic {
+			skipAll(pending, entry)
+		}
+		entry.fail(importFailureReason(rowErrs[i]))
+		if atomic {
+			return
+		}
+	}
+
+	for i, entry := range pending {
+		if rowErrs[i] != nil {
+			continue
+		}
+		entry.result.Status = dto.ImportRowValid
+		if !options.DryRun {
+			entry.result.Status = dto.ImportRowImported
+			entry.result.TransactionID = &entry.transaction.ID
+		}
+		entry.result.BalanceAfter = entry.transaction.BalanceAfter.StringFixed(2)
+	}
+}
+
+// simulatePosting posts transactions to a copy of the account's status and
+// balances, the way ImportForAccount would, and returns the error for each one
+// that would fail
+func simulatePosting(account *models.Account, transactions []*models.Transaction, atomic bool) []error {
+	simulated := &models.Account{
+		ID:          account.ID,
+		Status:      account.Status,
+		Balance:     account.Balance,
+		HeldBalance: account.HeldBalance,
+	}
+	rowErrs := make([]error, len(transactions))
+	for i, transaction := range transactions {
+		rowErrs[i] = simulated.Post(transaction)
+		if rowErrs[i] != nil && atomic {
+			break
+		}
+	}
+	return rowErrs
+}
+
+// importFailureReason explains why an account could not take a row
+func importFailureReason(err error) string {
+	switch {
+	case errors.Is(err, models.ErrInsufficientFunds):
+		return "insufficient funds"
+	case errors.Is(err, models.ErrAccountNotActive):
+		return "account is not active"
+	case errors.Is(err, repositories.ErrDuplicateReference):
+		return "reference already exists"
+	default:
+		return err.Error()
+	}
+}
+
+// skipAll marks rows that were not posted because of another row for the account
+func skipAll(entries []*importEntry, cause *importEntry) {
+	for _, entry := range entries {
+		if entry == cause {
+			continue
+		}
+		entry.result.Status = dto.ImportRowSkipped
+		entry.result.Errors = []string{fmt.Sprintf("not imported because row %d for this account was not", cause.result.Row)}
+	}
+}
+
+func (e *importEntry) reject(reason string) {
+	e.result.Status = dto.ImportRowRejected
+	e.result.Errors = append(e.result.Errors, reason)
+}
+
+func (e *importEntry) fail(reason string) {
+	e.result.Status = dto.ImportRowFailed
+	e.result.Errors = append(e.result.Errors, reason)
+}
+
+// buildImportReport tallies the row outcomes
+func buildImportReport(entries []*importEntry, options dto.ImportTransactionsOptions) *dto.TransactionImportReport {
+	report := &dto.TransactionImportReport{
+		DryRun:    options.DryRun,
+		Mode:      options.Mode,
+		Format:    options.Format,
+		TotalRows: len(entries),
+		Rows:      make([]dto.TransactionImportRow, 0, len(entries)),
+	}
+
+	for _, entry := range entries {
+		if entry.amount.GreaterThan(report.LargestAmount) {
+			report.LargestAmount = entry.amount
+		}
+		if entry.amount.IsPositive() {
+			report.TotalAmount = report.TotalAmount.Add(entry.amount)
+		}
+		switch entry.result.Status {
+		case dto.ImportRowImported, dto.ImportRowValid:
+			report.Accepted++
+		case dto.ImportRowRejected:
+			report.Rejected++
+		case dto.ImportRowFailed:
+			report.Failed++
+		case dto.ImportRowSkipped:
+			report.Skipped++
+		}
+		report.Rows = append(report.Rows, *entry.result)
+	}
+	return report
+}
+
+// readImportCSV reads rows from a CSV file with a header row
+func readImportCSV(file io.Reader) ([]*importRow, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidImportFile, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, name := range importRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing column %s", ErrInvalidImportFile, name)
+		}
+	}
+
+	var rows []*importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidImportFile, err)
+		}
+		if len(rows) == MaxImportRows {
+			return nil, fmt.Errorf("%w: more than %d rows", ErrInvalidImportFile, MaxImportRows)
+		}
+
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+		rows = append(rows, &importRow{
+			line:          line,
+			AccountNumber: field("account_number"),
+			Type:          field("type"),
+			Amount:        json.Number(field("amount")),
+			Description:   field("description"),
+			Reference:     field("reference"),
+			MerchantName:  field("merchant_name"),
+			MCCCode:       field("mcc_code"),
+			Category:      field("category"),
+		})
+	}
+}
+
+// readImportJSONL reads rows from a file of JSON objects, one per line. Blank lines
+// are ignored; a line that is not a valid row is reported as a rejected row.
+func readImportJSONL(file io.Reader) ([]*importRow, error) {
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var rows []*importRow
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		if len(rows) == MaxImportRows {
+			return nil, fmt.Errorf("%w: more than %d rows", ErrInvalidImportFile, MaxImportRows)
+		}
+
+		row := &importRow{line: line}
+		decoder := json.NewDecoder(bytes.NewReader(text))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(row); err != nil {
+			row = &importRow{line: line, parseErr: fmt.Errorf("invalid JSON: %s", err)}
+		}
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidImportFile, err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransactionImportServiceTestSuite struct {
+	suite.Suite
+	ctrl                *gomock.Controller
+	mockTransactionRepo *repository_mocks.MockTransactionRepositoryInterface
+	mockAccountRepo     *repository_mocks.MockAccountRepositoryInterface
+	mockCategoryRepo    *repository_mocks.MockTransactionCategoryRepositoryInterface
+	mockCategoryService *service_mocks.MockCategoryServiceInterface
+	service             TransactionImportServiceInterface
+	checking            *models.Account
+	savings             *models.Account
+}
+
+func TestTransactionImportServiceSuite(t *testing.T) {
+	suite.Run(t, new(TransactionImportServiceTestSuite))
+}
+
+func (s *TransactionImportServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockTransactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
+	s.mockAccountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
+	s.mockCategoryRepo = repository_mocks.NewMockTransactionCategoryRepositoryInterface(s.ctrl)
+	s.mockCategoryService = service_mocks.NewMockCategoryServiceInterface(s.ctrl)
+	s.service = NewTransactionImportService(
+		s.mockTransactionRepo,
+		s.mockAccountRepo,
+		s.mockCategoryRepo,
+		s.mockCategoryService,
+		slog.Default(),
+	)
+
+	s.checking = &models.Account{
+		ID:            uuid.New(),
+		AccountNumber: "1000000001",
+		Status:        models.AccountStatusActive,
+		Balance:       decimal.NewFromInt(100),
+	}
+	s.savings = &models.Account{
+		ID:            uuid.New(),
+		AccountNumber: "2000000002",
+		Status:        models.AccountStatusActive,
+		Balance:       decimal.NewFromInt(500),
+	}
+	s.mockAccountRepo.EXPECT().GetByAccountNumber(s.checking.AccountNumber).Return(s.checking, nil).AnyTimes()
+	s.mockAccountRepo.EXPECT().GetByAccountNumber(s.savings.AccountNumber).Return(s.savings, nil).AnyTimes()
+	s.mockAccountRepo.EXPECT().GetByAccountNumber(gomock.Any()).Return(nil, repositories.ErrAccountNotFound).AnyTimes()
+}
+
+func (s *TransactionImportServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *TransactionImportServiceTestSuite) options(format, mode string, dryRun bool) dto.ImportTransactionsOptions {
+	return dto.ImportTransactionsOptions{Format: format, Mode: mode, DryRun: dryRun, ImportedBy: uuid.New()}
+}
+
+// expectImport posts transactions the way the repository would, failing the rows listed
+func (s *TransactionImportServiceTestSuite) expectImport(account *models.Account, allOrNothing bool, failures map[int]error) {
+	s.mockTransactionRepo.EXPECT().ImportForAccount(account.ID, gomock.Any(), allOrNothing).
+		DoAndReturn(func(_ uuid.UUID, transactions []*models.Transaction, _ bool) ([]error, error) {
+			posted := &models.Account{Status: account.Status, Balance: account.Balance, HeldBalance: account.HeldBalance}
+			rowErrs := make([]error, len(transactions))
+			for i, transaction := range transactions {
+				if rowErrs[i] = failures[i]; rowErrs[i] == nil {
+					s.Require().NoError(posted.Post(transaction))
+					transaction.ID = uuid.New()
+				}
+			}
+			return rowErrs, nil
+		})
+}
+
+func (s *TransactionImportServiceTestSuite) TestImportTransactions_PartialCSV() {
+	file := strings.Join([]string{
+		"reference,account_number,type,amount,description,merchant_name,mcc_code",
+		"IMP-1,1000000001,credit,50.00,Payroll,,",
+		"IMP-2,1000000001,debit,12.345,Too precise,,",
+		"IMP-3,9999999999,credit,10,Unknown account,,",
+		"IMP-4,1000000001,DEBIT,500,Rent,,",
+		"IMP-5,1000000001,debit,20,Groceries,Corner Market,5411",
+	}, "\n")
+
+	s.mockTransactionRepo.EXPECT().GetExistingReferences([]string{"IMP-1", "IMP-2", "IMP-3", "IMP-4", "IMP-5"}).Return(nil, nil)
+	s.mockCategoryService.EXPECT().CategorizeTransaction(gomock.Any()).
+		DoAndReturn(func(transaction *models.Transaction) *models.CategorizationResult {
+			if transaction.MCCCode == "5411" {
+				return &models.CategorizationResult{Category: models.CategoryGroceries}
+			}
+			return &models.CategorizationResult{Category: models.CategoryIncome}
+		}).Times(3)
+	s.expectImport(s.checking, false, map[int]error{1: models.ErrInsufficientFunds})
+
+	report, err := s.service.ImportTransactions(strings.NewReader(file), s.options(ImportFormatCSV, dto.ImportModePartial, false))
+	s.Require().NoError(err)
+
+	s.Equal(5, report.TotalRows)
+	s.Equal(2, report.Accepted)
+	s.Equal(2, report.Rejected)
+	s.Equal(1, report.Failed)
+	s.Equal(0, report.Skipped)
+	s.Equal("500", report.LargestAmount.String(), "rejected and failed rows count towards the largest amount")
+	s.Equal("592.345", report.TotalAmount.String(), "rejected and failed rows count towards the total amount")
+
+	rows := report.Rows
+	s.Equal(2, rows[0].Row)
+	s.Equal(dto.ImportRowImported, rows[0].Status)
+	s.NotNil(rows[0].TransactionID)
+	s.Equal(models.CategoryIncome, rows[0].Category)
+	s.Equal("150.00", rows[0].BalanceAfter)
+
+	s.Equal(dto.ImportRowRejected, rows[1].Status)
+	s.Equal([]string{"amount must have at most 2 decimal places"}, rows[1].Errors)
+
+	s.Equal(dto.ImportRowRejected, rows[2].Status)
+	s.Equal([]string{"account not found"}, rows[2].Errors)
+
+	s.Equal(dto.ImportRowFailed, rows[3].Status)
+	s.Equal([]string{"insufficient funds"}, rows[3].Errors)
+
+	s.Equal(dto.ImportRowImported, rows[4].Status)
+	s.Equal(models.CategoryGroceries, rows[4].Category)
+	s.Equal("130.00", rows[4].BalanceAfter)
+}
+
+func (s *TransactionImportServiceTestSuite) TestImportTransactions_AtomicPerAccount() {
+	file := strings.Join([]string{
+		`{"account_number":"1000000001","type":"credit","amount":"25","description":"Refund","reference":"A-1","category":"shopping"}`,
+		`{"account_number":"2000000002","type":"credit","amount":40,"description":"Interest","reference":"B-1","category":"INCOME"}`,
+		`{"account_number":"1000000001","type":"debit","amount":"5","description":"Fee","reference":"A-2","category":"FEES"}`,
+		`{"account_number":"1000000001","type":"refund","amount":"5","description":"Bad type","reference":"A-3"}`,
+	}, "\n")
+
+	s.mockTransactionRepo.EXPECT().GetExistingReferences(gomock.Any()).Return(nil, nil)
+	s.mockCategoryRepo.EXPECT().GetByCode(gomock.Any()).
+		DoAndReturn(func(code string) (*models.TransactionCategory, error) {
+			return &models.TransactionCategory{Code: code, IsActive: true}, nil
+		}).Times(3)
+	s.expectImport(s.savings, true, nil)
+
+	report, err := s.service.ImportTransactions(strings.NewReader(file), s.options(ImportFormatJSONL, dto.ImportModeAtomic, false))
+	s.Require().NoError(err)
+
+	s.Equal(1, report.Accepted)
+	s.Equal(1, report.Rejected)
+	s.Equal(2, report.Skipped)
+
+	s.Equal(dto.ImportRowSkipped, report.Rows[0].Status)
+	s.Equal([]string{"not imported because row 4 for this account was not"}, report.Rows[0].Errors)
+	s.Equal(dto.ImportRowImported, report.Rows[1].Status)
+	s.Equal(models.CategoryIncome, report.Rows[1].Category)
+	s.Equal("540.00", report.Rows[1].BalanceAfter)
+	s.Equal(dto.ImportRowSkipped, report.Rows[2].Status)
+	s.Equal(dto.ImportRowRejected, report.Rows[3].Status)
+	s.Equal([]string{"type must be credit or debit"}, report.Rows[3].Errors)
+}
+
+func (s *TransactionImportServiceTestSuite) TestImportTransactions_AtomicFailureSkipsAccount() {
+	file := strings.Join([]string{
+		"account_number,type,amount,description,reference",
+		"1000000001,credit,10,First,X-1",
+		"1000000001,debit,1000,Second,X-2",
+		"1000000001,credit,10,Third,X-3",
+	}, "\n")
+
+	s.mockTransactionRepo.EXPECT().GetExistingReferences(gomock.Any()).Return(nil, nil)
+	s.mockCategoryService.EXPECT().CategorizeTransaction(gomock.Any()).
+		Return(&models.CategorizationResult{Category: models.CategoryOther}).Times(3)
+	s.mockTransactionRepo.EXPECT().ImportForAccount(s.checking.ID, gomock.Len(3), true).
+		Return([]error{nil, models.ErrInsufficientFunds, nil}, nil)
+
+	report, err := s.service.ImportTransactions(strings.NewReader(file), s.options(ImportFormatCSV, dto.ImportModeAtomic, false))
+	s.Require().NoError(err)
+
+	s.Equal(0, report.Accepted)
+	s.Equal(1, report.Failed)
+	s.Equal(2, report.Skipped)
+	s.Equal(dto.ImportRowSkipped, report.Rows[0].Status)
+	s.Nil(report.Rows[0].TransactionID)
+	s.Equal(dto.ImportRowFailed, report.Rows[1].Status)
+	s.Equal(dto.ImportRowSkipped, report.Rows[2].Status)
+	s.Equal([]string{"not imported because row 3 for this account was not"}, report.Rows[2].Errors)
+}
+
+func (s *TransactionImportServiceTestSuite) TestImportTransactions_DryRun() {
+	file := strings.Join([]string{
+		`{"account_number":"1000000001","type":"debit","amount":"60","description":"Utilities","reference":"D-1"}`,
+		``,
+		`{"account_number":"1000000001","type":"debit","amount":"60","description":"Utilities again","reference":"D-2"}`,
+		`{"account_number":"2000000002","type":"credit","amount":"1","description":"Repeat","reference":"D-1"}`,
+		`{"account_number":"2000000002","type":"credit","amount":"1","description":"Taken","reference":"OLD-1"}`,
+		`{"account_number":"2000000002","type":"credit","amount":"1","description":"Retired category","reference":"D-5","category":"LEGACY"}`,
+		`{"account_number":"2000000002","amount":"1","reference":"D-6","surprise":true}`,
+	}, "\n")
+
+	s.mockTransactionRepo.EXPECT().GetExistingReferences(gomock.Any()).Return([]string{"OLD-1"}, nil)
+	s.mockCategoryRepo.EXPECT().GetByCode("LEGACY").Return(&models.TransactionCategory{Code: "LEGACY", IsActive: false}, nil)
+	s.mockCategoryService.EXPECT().PreviewCategorization(gomock.Any()).
+		Return(&models.CategorizationResult{Category: models.CategoryBillsUtilities}).Times(2)
+
+	report, err := s.service.ImportTransactions(strings.NewReader(file), s.options(ImportFormatJSONL, dto.ImportModePartial, true))
+	s.Require().NoError(err)
+
+	s.True(report.DryRun)
+	s.Equal(6, report.TotalRows)
+	s.Equal(1, report.Accepted)
+	s.Equal(1, report.Failed)
+	s.Equal(4, report.Rejected)
+
+	rows := report.Rows
+	s.Equal(dto.ImportRowValid, rows[0].Status)
+	s.Nil(rows[0].TransactionID)
+	s.Equal("40.00", rows[0].BalanceAfter)
+
+	s.Equal(3, rows[1].Row)
+	s.Equal(dto.ImportRowFailed, rows[1].Status)
+	s.Equal([]string{"insufficient funds"}, rows[1].Errors)
+
+	s.Equal([]string{"reference repeats row 1"}, rows[2].Errors)
+	s.Equal([]string{"reference already exists"}, rows[3].Errors)
+	s.Equal([]string{"category is not an active category"}, rows[4].Errors)
+	s.Equal(dto.ImportRowRejected, rows[5].Status)
+	s.Contains(rows[5].Errors[0], "invalid JSON")
+
+	s.True(decimal.NewFromInt(100).Equal(s.checking.Balance), "a dry run leaves the account untouched")
+}
+
+func (s *TransactionImportServiceTestSuite) TestImportTransactions_InvalidRequests() {
+	testCases := []struct {
+		name    string
+		file    string
+		options dto.ImportTransactionsOptions
+		wantErr error
+	}{
+		{"unsupported format", "", s.options("xlsx", dto.ImportModePartial, false), ErrUnsupportedImportFormat},
+		{"unknown mode", "", s.options(ImportFormatCSV, "best_effort", false), ErrInvalidImportMode},
+		{"missing column", "account_number,type,amount,description\n1,credit,1,x", s.options(ImportFormatCSV, dto.ImportModePartial, false), ErrInvalidImportFile},
+		{"empty file", "account_number,type,amount,description,reference\n", s.options(ImportFormatCSV, dto.ImportModePartial, false), ErrInvalidImportFile},
+		{"malformed CSV", "account_number,type,amount,description,reference\n\"1,credit", s.options(ImportFormatCSV, dto.ImportModePartial, false), ErrInvalidImportFile},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.service.ImportTransactions(strings.NewReader(tc.file), tc.options)
+			s.True(errors.Is(err, tc.wantErr), "got %v", err)
+		})
+	}
+}
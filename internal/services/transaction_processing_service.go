@@ -2,11 +2,13 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"array-assessment/internal/dto"
@@ -24,33 +26,43 @@ var (
 type TransactionProcessingService struct {
 	transactionRepo repositories.TransactionRepositoryInterface
 	queueRepo       repositories.ProcessingQueueRepositoryInterface
-	accountRepo     repositories.AccountRepositoryInterface
+	holdRepo        repositories.HoldRepositoryInterface
 	auditLogger     AuditLoggerInterface
 	metrics         MetricsRecorderInterface
 	circuitBreaker  CircuitBreakerInterface
+	events          EventPublisher
 	maxWorkers      int
 	workerSemaphore chan struct{}
+	workerID        string
+	leaseDuration   time.Duration
+	inFlight        atomic.Int64
 	logger          *slog.Logger
 }
 
 func NewTransactionProcessingService(
 	transactionRepo repositories.TransactionRepositoryInterface,
 	queueRepo repositories.ProcessingQueueRepositoryInterface,
-	accountRepo repositories.AccountRepositoryInterface,
+	holdRepo repositories.HoldRepositoryInterface,
 	auditLogger AuditLoggerInterface,
 	metrics MetricsRecorderInterface,
 	circuitBreaker CircuitBreakerInterface,
+	events EventPublisher,
 	maxWorkers int,
+	workerID string,
+	leaseDuration time.Duration,
 ) TransactionProcessingServiceInterface {
 	return &TransactionProcessingService{
 		transactionRepo: transactionRepo,
 		queueRepo:       queueRepo,
-		accountRepo:     accountRepo,
+		holdRepo:        holdRepo,
 		auditLogger:     auditLogger,
 		metrics:         metrics,
 		circuitBreaker:  circuitBreaker,
+		events:          events,
 		maxWorkers:      maxWorkers,
 		workerSemaphore: make(chan struct{}, maxWorkers),
+		workerID:        workerID,
+		leaseDuration:   leaseDuration,
 		logger:          slog.Default(),
 	}
 }
@@ -75,6 +87,8 @@ func (s *TransactionProcessingService) EnqueueTransaction(transactionID uuid.UUI
 func (s *TransactionProcessingService) StartProcessing(ctx context.Context) {
 	s.logger.Info("starting transaction processing service",
 		slog.Int("max_workers", s.maxWorkers),
+		slog.String("worker_id", s.workerID),
+		slog.Duration("lease_duration", s.leaseDuration),
 	)
 
 	ticker := time.NewTicker(1 * time.Second)
@@ -91,9 +105,16 @@ func (s *TransactionProcessingService) StartProcessing(ctx context.Context) {
 			return
 
 		case <-ticker.C:
-			items, err := s.queueRepo.FetchPending(s.maxWorkers * 2)
+			// Claim only what this worker can start immediately, so a claimed item
+			// never waits on the semaphore while its lease runs down
+			capacity := s.maxWorkers - int(s.inFlight.Load())
+			if capacity <= 0 {
+				continue
+			}
+
+			items, err := s.queueRepo.ClaimPending(s.workerID, capacity, s.leaseDuration)
 			if err != nil {
-				s.logger.Error("failed to fetch pending items",
+				s.logger.Error("failed to claim pending items",
 					slog.String("error", err.Error()),
 				)
 				continue
@@ -101,18 +122,66 @@ func (s *TransactionProcessingService) StartProcessing(ctx context.Context) {
 
 			for _, item := range items {
 				wg.Add(1)
+				s.inFlight.Add(1)
 				go s.processQueueItemAsync(ctx, item, &wg)
 			}
 		}
 	}
 }
 
+// StartLeaseReaper periodically returns items whose processing lease has expired to
+// pending. It recovers items held by a worker that crashed or was stopped mid-item.
+func (s *TransactionProcessingService) StartLeaseReaper(ctx context.Context, pollInterval time.Duration) {
+	s.logger.Info("starting queue lease reaper",
+		slog.Duration("poll_interval", pollInterval),
+	)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("queue lease reaper stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.ReleaseExpiredLeases(); err != nil {
+				s.logger.Error("failed to release expired queue leases",
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
+
+// ReleaseExpiredLeases returns expired processing items to pending and reports how
+// many were released
+func (s *TransactionProcessingService) ReleaseExpiredLeases() (int64, error) {
+	released, err := s.queueRepo.ReleaseExpiredLeases()
+	if err != nil {
+		return 0, err
+	}
+
+	if released > 0 {
+		s.logger.Warn("released queue items with expired leases",
+			slog.Int64("count", released),
+		)
+	}
+
+	return released, nil
+}
+
 func (s *TransactionProcessingService) processQueueItemAsync(ctx context.Context, queueItem *models.ProcessingQueueItem, wg *sync.WaitGroup) {
 	defer wg.Done()
+	defer s.inFlight.Add(-1)
 
 	s.workerSemaphore <- struct{}{}
 	defer func() { <-s.workerSemaphore }()
 
+	stopRenewing := make(chan struct{})
+	defer close(stopRenewing)
+	go s.renewLease(queueItem, stopRenewing)
+
 	if err := s.ProcessQueueItem(ctx, queueItem); err != nil {
 		s.logger.Error("failed to process queue item",
 			slog.String("queue_item_id", queueItem.ID.String()),
@@ -122,6 +191,30 @@ func (s *TransactionProcessingService) processQueueItemAsync(ctx context.Context
 	}
 }
 
+// renewLease extends the item's lease every third of the lease duration until stop
+// is closed, so the lease reaper does not hand a slow item to another worker
+func (s *TransactionProcessingService) renewLease(queueItem *models.ProcessingQueueItem, stop <-chan struct{}) {
+	ticker := time.NewTicker(s.leaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := s.queueRepo.RenewLease(queueItem.ID, s.workerID, s.leaseDuration); err != nil {
+				s.logger.Warn("failed to renew queue item lease",
+					slog.String("queue_item_id", queueItem.ID.String()),
+					slog.String("error", err.Error()),
+				)
+				if errors.Is(err, repositories.ErrQueueLeaseLost) {
+					return
+				}
+			}
+		}
+	}
+}
+
 func (s *TransactionProcessingService) ProcessQueueItem(ctx context.Context, queueItem *models.ProcessingQueueItem) error {
 	startTime := time.Now()
 
@@ -194,14 +287,16 @@ func (s *TransactionProcessingService) performOperation(ctx context.Context, que
 	case models.QueueOperationProcess:
 		return s.processTransaction(ctx, transaction)
 	case models.QueueOperationReverse:
-		return s.reverseTransaction(ctx, transaction)
+		return s.reverseTransaction(ctx, queueItem, transaction)
+	case models.QueueOperationExpire:
+		return s.expireHold(ctx, transaction)
 	default:
 		return fmt.Errorf("unknown operation: %s", queueItem.Operation)
 	}
 }
 
 func (s *TransactionProcessingService) completeProcessing(ctx context.Context, queueItem *models.ProcessingQueueItem, startTime time.Time) error {
-	if err := s.queueRepo.MarkCompleted(queueItem.ID); err != nil {
+	if err := s.queueRepo.MarkCompleted(queueItem.ID, s.workerID); err != nil {
 		return err
 	}
 
@@ -220,86 +315,83 @@ func (s *TransactionProcessingService) completeProcessing(ctx context.Context, q
 }
 
 func (s *TransactionProcessingService) processTransaction(ctx context.Context, transaction *models.Transaction) error {
+	// An item re-queued after its lease expired may find the transaction already
+	// completed by the worker that lost the lease
+	if transaction.Status == models.TransactionStatusCompleted {
+		return nil
+	}
 	if !transaction.IsPending() {
 		return fmt.Errorf("transaction is not in pending status: %s", transaction.Status)
 	}
 
 	oldStatus := transaction.Status
-	expectedVersion := transaction.Version
 
-	transaction.Complete()
-
-	if err := s.updateAccountBalance(ctx, transaction); err != nil {
-		return fmt.Errorf("failed to update account balance: %w", err)
-	}
-
-	if err := s.transactionRepo.UpdateWithOptimisticLock(transaction, expectedVersion); err != nil {
-		if errors.Is(err, models.ErrOptimisticLockConflict) {
-			s.auditLogger.LogOptimisticLockConflict(ctx, "transaction", transaction.ID, expectedVersion, transaction.Version)
+	// The balance change and the status change commit together and only while the
+	// transaction is still pending, so a transaction is never applied twice
+	account, err := s.transactionRepo.CompletePending(transaction)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransactionNotPending) {
+			return nil
 		}
-		return err
+		return fmt.Errorf("failed to complete transaction: %w", err)
 	}
 
-	s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, oldStatus, transaction.Status)
+	s.auditLogger.LogBalanceUpdate(ctx, account.ID, transaction.BalanceBefore.String(), transaction.BalanceAfter.String(), transaction.ID)
+	s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, oldStatus, models.TransactionStatusCompleted)
+	s.events.Publish(models.WebhookEventTransactionCompleted, account.UserID, transaction)
 
 	return nil
 }
 
-func (s *TransactionProcessingService) reverseTransaction(ctx context.Context, transaction *models.Transaction) error {
-	if !transaction.IsCompleted() {
-		return fmt.Errorf("transaction is not completed: %s", transaction.Status)
+// reverseTransaction writes the offsetting transaction for a completed transaction,
+// or for both legs of a transfer, using the reason recorded when the reversal was
+// requested. A transaction that is already reversed needs no further work.
+func (s *TransactionProcessingService) reverseTransaction(ctx context.Context, queueItem *models.ProcessingQueueItem, transaction *models.Transaction) error {
+	var request models.ReversalRequest
+	if queueItem.Metadata != "" {
+		if err := json.Unmarshal([]byte(queueItem.Metadata), &request); err != nil {
+			return fmt.Errorf("invalid reversal metadata: %w", err)
+		}
 	}
 
-	oldStatus := transaction.Status
-	expectedVersion := transaction.Version
-
-	transaction.Reverse()
-
-	if err := s.reverseAccountBalance(ctx, transaction); err != nil {
-		return fmt.Errorf("failed to reverse account balance: %w", err)
+	offsets, err := s.transactionRepo.Reverse(transaction.ID, request.Reason)
+	if err != nil {
+		if errors.Is(err, models.ErrTransactionAlreadyReversed) {
+			return nil
+		}
+		return fmt.Errorf("failed to reverse transaction: %w", err)
 	}
 
-	if err := s.transactionRepo.UpdateWithOptimisticLock(transaction, expectedVersion); err != nil {
-		return err
+	for _, offset := range offsets {
+		s.auditLogger.LogBalanceUpdate(ctx, offset.AccountID, offset.BalanceBefore.String(), offset.BalanceAfter.String(), offset.ID)
+		s.auditLogger.LogTransactionStateChange(ctx, *offset.ReversalOf, models.TransactionStatusCompleted, models.TransactionStatusReversed)
 	}
 
-	s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, oldStatus, transaction.Status)
-
 	return nil
 }
 
-func (s *TransactionProcessingService) updateAccountBalance(ctx context.Context, transaction *models.Transaction) error {
-	account, err := s.accountRepo.GetByID(transaction.AccountID)
-	if err != nil {
-		return err
+// expireHold releases a hold that is past its expiry. A hold that was captured or
+// released after it was queued needs no further work.
+func (s *TransactionProcessingService) expireHold(ctx context.Context, transaction *models.Transaction) error {
+	if !transaction.IsHold() {
+		return fmt.Errorf("transaction is not a hold: %s", transaction.ID)
 	}
 
-	oldBalance := account.Balance.String()
-	newBalance := transaction.BalanceAfter
-
-	if err := s.accountRepo.UpdateBalance(account.ID, newBalance, transaction.TransactionType); err != nil {
-		return err
+	if !transaction.IsPending() {
+		return nil
 	}
 
-	s.auditLogger.LogBalanceUpdate(ctx, account.ID, oldBalance, newBalance.String(), transaction.ID)
-
-	return nil
-}
+	oldStatus := transaction.Status
 
-func (s *TransactionProcessingService) reverseAccountBalance(ctx context.Context, transaction *models.Transaction) error {
-	account, err := s.accountRepo.GetByID(transaction.AccountID)
+	hold, err := s.holdRepo.ReleaseHold(transaction.ID, models.HoldOutcomeExpired)
 	if err != nil {
-		return err
-	}
-
-	oldBalance := account.Balance.String()
-	newBalance := transaction.BalanceBefore
-
-	if err := s.accountRepo.UpdateBalance(account.ID, newBalance, transaction.TransactionType); err != nil {
-		return err
+		if errors.Is(err, repositories.ErrHoldNotActive) {
+			return nil
+		}
+		return fmt.Errorf("failed to expire hold: %w", err)
 	}
 
-	s.auditLogger.LogBalanceUpdate(ctx, account.ID, oldBalance, newBalance.String(), transaction.ID)
+	s.auditLogger.LogTransactionStateChange(ctx, hold.ID, oldStatus, hold.Status)
 
 	return nil
 }
@@ -310,7 +402,7 @@ func (s *TransactionProcessingService) handleProcessingError(ctx context.Context
 
 		s.auditLogger.LogRetryAttempt(ctx, queueItem.ID, queueItem.TransactionID, queueItem.RetryCount+1, queueItem.MaxRetries, backoffMs)
 
-		if retryErr := s.queueRepo.IncrementRetry(queueItem.ID); retryErr != nil {
+		if retryErr := s.queueRepo.IncrementRetry(queueItem.ID, s.workerID, err.Error()); retryErr != nil {
 			return fmt.Errorf("failed to increment retry: %w", retryErr)
 		}
 
@@ -326,7 +418,7 @@ func (s *TransactionProcessingService) handleProcessingError(ctx context.Context
 
 func (s *TransactionProcessingService) handleMaxRetriesExceeded(ctx context.Context, queueItem *models.ProcessingQueueItem) error {
 	transaction, err := s.transactionRepo.GetByID(queueItem.TransactionID)
-	if err == nil {
+	if err == nil && transaction.IsPending() {
 		oldStatus := transaction.Status
 		transaction.Fail()
 		expectedVersion := transaction.Version - 1
@@ -334,7 +426,7 @@ func (s *TransactionProcessingService) handleMaxRetriesExceeded(ctx context.Cont
 		s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, oldStatus, models.TransactionStatusFailed)
 	}
 
-	if err := s.queueRepo.MarkFailed(queueItem.ID, "max retries exceeded"); err != nil {
+	if err := s.queueRepo.MarkFailed(queueItem.ID, s.workerID, "max retries exceeded"); err != nil {
 		return err
 	}
 
@@ -353,7 +445,7 @@ func (s *TransactionProcessingService) handleDuplicateReference(ctx context.Cont
 	expectedVersion := transaction.Version
 	_ = s.transactionRepo.UpdateWithOptimisticLock(transaction, expectedVersion)
 
-	if err := s.queueRepo.MarkFailed(queueItem.ID, "duplicate transaction reference"); err != nil {
+	if err := s.queueRepo.MarkFailed(queueItem.ID, s.workerID, "duplicate transaction reference"); err != nil {
 		return err
 	}
 
@@ -383,6 +475,11 @@ func (s *TransactionProcessingService) GetQueueMetrics() (*dto.QueueMetrics, err
 		return nil, err
 	}
 
+	failedByOperation, err := s.queueRepo.GetFailedCountsByOperation()
+	if err != nil {
+		return nil, err
+	}
+
 	avgProcessingMs, err := s.queueRepo.GetAverageProcessingTime()
 	if err != nil {
 		return nil, err
@@ -394,11 +491,12 @@ func (s *TransactionProcessingService) GetQueueMetrics() (*dto.QueueMetrics, err
 	}
 
 	return &dto.QueueMetrics{
-		PendingCount:    pendingCount,
-		ProcessingCount: processingCount,
-		CompletedCount:  completedCount,
-		FailedCount:     failedCount,
-		AvgProcessingMs: avgProcessingMs,
-		OldestPending:   oldestPending,
+		PendingCount:      pendingCount,
+		ProcessingCount:   processingCount,
+		CompletedCount:    completedCount,
+		FailedCount:       failedCount,
+		FailedByOperation: failedByOperation,
+		AvgProcessingMs:   avgProcessingMs,
+		OldestPending:     oldestPending,
 	}, nil
 }
@@ -0,0 +1,385 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrTransferScheduleNotFound = errors.New("transfer schedule not found")
+	ErrTransferScheduleState    = errors.New("transfer schedule cannot be changed in its current state")
+	ErrInvalidTransferSchedule  = errors.New("invalid transfer schedule")
+)
+
+// DefaultTransferScheduleBatchSize is the number of due schedules run per poll
+const DefaultTransferScheduleBatchSize = 100
+
+// TransferScheduleService manages standing orders. A background worker runs each
+// due occurrence through AccountService.TransferBetweenAccounts with a deterministic
+// idempotency key, so an occurrence retried after a crash never transfers twice.
+type TransferScheduleService struct {
+	scheduleRepo   repositories.TransferScheduleRepositoryInterface
+	transferRepo   repositories.TransferRepositoryInterface
+	accountService AccountServiceInterface
+	batchSize      int
+	logger         *slog.Logger
+}
+
+// NewTransferScheduleService creates a new transfer schedule service
+func NewTransferScheduleService(
+	scheduleRepo repositories.TransferScheduleRepositoryInterface,
+	transferRepo repositories.TransferRepositoryInterface,
+	accountService AccountServiceInterface,
+	batchSize int,
+	logger *slog.Logger,
+) TransferScheduleServiceInterface {
+	if batchSize <= 0 {
+		batchSize = DefaultTransferScheduleBatchSize
+	}
+
+	return &TransferScheduleService{
+		scheduleRepo:   scheduleRepo,
+		transferRepo:   transferRepo,
+		accountService: accountService,
+		batchSize:      batchSize,
+		logger:         logger,
+	}
+}
+
+// CreateSchedule creates a recurring transfer from one of the user's accounts
+func (s *TransferScheduleService) CreateSchedule(userID uuid.UUID, req *dto.CreateTransferScheduleRequest) (*models.TransferSchedule, error) {
+	fromAccountID, err := uuid.Parse(req.FromAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid source account ID", ErrInvalidTransferSchedule)
+	}
+
+	toAccountID, err := uuid.Parse(req.ToAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid destination account ID", ErrInvalidTransferSchedule)
+	}
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil || amount.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidAmount
+	}
+
+	if fromAccountID == toAccountID {
+		return nil, ErrSameAccountTransfer
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.StartDate.Before(today) {
+		return nil, fmt.Errorf("%w: start date must not be in the past", ErrInvalidTransferSchedule)
+	}
+
+	if err := s.checkAccounts(fromAccountID, toAccountID, userID); err != nil {
+		return nil, err
+	}
+
+	schedule := &models.TransferSchedule{
+		UserID:         userID,
+		FromAccountID:  fromAccountID,
+		ToAccountID:    toAccountID,
+		Amount:         amount,
+		Description:    req.Description,
+		Frequency:      req.Frequency,
+		StartDate:      req.StartDate,
+		EndDate:        req.EndDate,
+		MaxOccurrences: req.MaxOccurrences,
+		Status:         models.TransferScheduleStatusActive,
+		NextOccurrence: 1,
+	}
+	if req.Frequency == models.TransferFrequencyDayOfMonth {
+		schedule.DayOfMonth = req.DayOfMonth
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTransferSchedule, err.Error())
+	}
+
+	schedule.ScheduleNext()
+	if schedule.IsFinished() {
+		return nil, fmt.Errorf("%w: no occurrence falls before the end date", ErrInvalidTransferSchedule)
+	}
+
+	if err := s.scheduleRepo.Create(schedule); err != nil {
+		return nil, fmt.Errorf("failed to create transfer schedule: %w", err)
+	}
+
+	s.logger.Info("transfer schedule created",
+		slog.String("schedule_id", schedule.ID.String()),
+		slog.String("user_id", userID.String()),
+		slog.String("frequency", schedule.Frequency),
+	)
+
+	return schedule, nil
+}
+
+// checkAccounts applies the same account rules TransferBetweenAccounts enforces,
+// so a schedule that could never run is rejected up front
+func (s *TransferScheduleService) checkAccounts(fromAccountID, toAccountID, userID uuid.UUID) error {
+	fromAccount, err := s.accountService.GetAccountByID(fromAccountID, nil)
+	if err != nil {
+		return err
+	}
+
+	if fromAccount.UserID != userID {
+		return ErrUnauthorized
+	}
+
+	toAccount, err := s.accountService.GetAccountByID(toAccountID, nil)
+	if err != nil {
+		return err
+	}
+
+	if !fromAccount.IsActive() || !toAccount.IsActive() {
+		return ErrAccountNotActive
+	}
+
+	return nil
+}
+
+// ListSchedules retrieves the user's transfer schedules, newest first
+func (s *TransferScheduleService) ListSchedules(userID uuid.UUID, offset, limit int) ([]models.TransferSchedule, int64, error) {
+	schedules, total, err := s.scheduleRepo.GetByUserID(userID, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list transfer schedules: %w", err)
+	}
+	return schedules, total, nil
+}
+
+// GetSchedule retrieves one of the user's transfer schedules
+func (s *TransferScheduleService) GetSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error) {
+	schedule, err := s.scheduleRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransferScheduleNotFound) {
+			return nil, ErrTransferScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get transfer schedule: %w", err)
+	}
+
+	// Another user's schedule is reported as missing so IDs cannot be probed
+	if schedule.UserID != userID {
+		return nil, ErrTransferScheduleNotFound
+	}
+
+	return schedule, nil
+}
+
+// PauseSchedule stops an active schedule from running until it is resumed
+func (s *TransferScheduleService) PauseSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error) {
+	schedule, err := s.GetSchedule(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.Status != models.TransferScheduleStatusActive {
+		return nil, ErrTransferScheduleState
+	}
+
+	schedule.Status = models.TransferScheduleStatusPaused
+
+	if err := s.scheduleRepo.Update(schedule); err != nil {
+		return nil, fmt.Errorf("failed to pause transfer schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// ResumeSchedule reactivates a paused schedule. Occurrences dated while it was
+// paused are skipped, which may complete the schedule.
+func (s *TransferScheduleService) ResumeSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error) {
+	schedule, err := s.GetSchedule(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.Status != models.TransferScheduleStatusPaused {
+		return nil, ErrTransferScheduleState
+	}
+
+	schedule.Status = models.TransferScheduleStatusActive
+	schedule.SkipMissed(time.Now())
+
+	if err := s.scheduleRepo.Update(schedule); err != nil {
+		return nil, fmt.Errorf("failed to resume transfer schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// CancelSchedule permanently stops a schedule. Transfers already made are kept.
+func (s *TransferScheduleService) CancelSchedule(id, userID uuid.UUID) (*models.TransferSchedule, error) {
+	schedule, err := s.GetSchedule(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.IsFinished() {
+		return nil, ErrTransferScheduleState
+	}
+
+	schedule.Status = models.TransferScheduleStatusCancelled
+	schedule.NextRunAt = nil
+
+	if err := s.scheduleRepo.Update(schedule); err != nil {
+		return nil, fmt.Errorf("failed to cancel transfer schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// StartWorker polls for due schedules and runs their next occurrence until the
+// context is cancelled. Schedules that fell behind catch up one occurrence per poll.
+func (s *TransferScheduleService) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	s.logger.Info("starting transfer schedule worker",
+		slog.Duration("poll_interval", pollInterval),
+	)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("transfer schedule worker stopped")
+			return
+		case <-ticker.C:
+			s.runDueSchedules(ctx)
+		}
+	}
+}
+
+// runDueSchedules runs the next occurrence of each due schedule
+func (s *TransferScheduleService) runDueSchedules(ctx context.Context) {
+	schedules, err := s.scheduleRepo.GetDue(time.Now(), s.batchSize)
+	if err != nil {
+		s.logger.Error("failed to fetch due transfer schedules",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	for i := range schedules {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if err := s.runOccurrence(&schedules[i], time.Now()); err != nil {
+			s.logger.Error("failed to run scheduled transfer",
+				slog.String("schedule_id", schedules[i].ID.String()),
+				slog.Int("occurrence", schedules[i].NextOccurrence),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// runOccurrence executes the schedule's next occurrence and advances it. A failed
+// transfer is an outcome like any other and does not stop the schedule; only an
+// error recording the outcome leaves the occurrence to be retried.
+func (s *TransferScheduleService) runOccurrence(schedule *models.TransferSchedule, now time.Time) error {
+	occurrence := schedule.NextOccurrence
+	key := schedule.OccurrenceKey(occurrence)
+
+	transfer, runErr := s.accountService.TransferBetweenAccounts(
+		schedule.FromAccountID,
+		schedule.ToAccountID,
+		schedule.Amount,
+		schedule.Description,
+		key,
+		schedule.UserID,
+	)
+	if runErr != nil {
+		s.logger.Warn("scheduled transfer failed",
+			slog.String("schedule_id", schedule.ID.String()),
+			slog.Int("occurrence", occurrence),
+			slog.String("error", runErr.Error()),
+		)
+	}
+
+	if err := s.recordOutcome(schedule, occurrence, key, transfer, runErr); err != nil {
+		return err
+	}
+
+	schedule.RecordRun(now)
+
+	if err := s.scheduleRepo.SaveRun(schedule, occurrence); err != nil {
+		if errors.Is(err, repositories.ErrTransferScheduleChanged) {
+			s.logger.Info("transfer schedule changed during run",
+				slog.String("schedule_id", schedule.ID.String()),
+				slog.Int("occurrence", occurrence),
+			)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// recordOutcome links the occurrence's transfer to its schedule so it appears in the
+// transfer history. An occurrence rejected before a transfer was created, for example
+// because an account was closed, is recorded as a failed transfer.
+func (s *TransferScheduleService) recordOutcome(schedule *models.TransferSchedule, occurrence int, key string, transfer *models.Transfer, runErr error) error {
+	if transfer == nil {
+		existing, err := s.transferRepo.FindByIdempotencyKey(key)
+		switch {
+		case err == nil:
+			transfer = existing
+		case errors.Is(err, repositories.ErrTransferNotFound):
+			return s.createFailedTransfer(schedule, occurrence, key, runErr)
+		default:
+			return fmt.Errorf("failed to find scheduled transfer: %w", err)
+		}
+	}
+
+	if transfer.ScheduleID != nil {
+		return nil
+	}
+
+	transfer.ScheduleID = &schedule.ID
+	transfer.ScheduleOccurrence = &occurrence
+
+	if err := s.transferRepo.Update(transfer); err != nil {
+		return fmt.Errorf("failed to link transfer to schedule: %w", err)
+	}
+
+	return nil
+}
+
+// createFailedTransfer records an occurrence that never reached the transfer stage
+func (s *TransferScheduleService) createFailedTransfer(schedule *models.TransferSchedule, occurrence int, key string, runErr error) error {
+	if runErr == nil {
+		runErr = errors.New("transfer was not created")
+	}
+
+	transfer := &models.Transfer{
+		FromAccountID:      schedule.FromAccountID,
+		ToAccountID:        schedule.ToAccountID,
+		Amount:             schedule.Amount,
+		Description:        schedule.Description,
+		IdempotencyKey:     key,
+		ScheduleID:         &schedule.ID,
+		ScheduleOccurrence: &occurrence,
+	}
+	transfer.Fail(runErr.Error())
+
+	if err := s.transferRepo.Create(transfer); err != nil && !errors.Is(err, repositories.ErrTransferIdempotencyKeyExists) {
+		return fmt.Errorf("failed to record scheduled transfer failure: %w", err)
+	}
+
+	return nil
+}
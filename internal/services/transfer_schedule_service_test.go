@@ -0,0 +1,225 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+	"array-assessment/internal/services/service_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransferScheduleServiceTestSuite struct {
+	suite.Suite
+	ctrl               *gomock.Controller
+	mockScheduleRepo   *repository_mocks.MockTransferScheduleRepositoryInterface
+	mockTransferRepo   *repository_mocks.MockTransferRepositoryInterface
+	mockAccountService *service_mocks.MockAccountServiceInterface
+	service            *TransferScheduleService
+	userID             uuid.UUID
+}
+
+func TestTransferScheduleServiceSuite(t *testing.T) {
+	suite.Run(t, new(TransferScheduleServiceTestSuite))
+}
+
+func (s *TransferScheduleServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockScheduleRepo = repository_mocks.NewMockTransferScheduleRepositoryInterface(s.ctrl)
+	s.mockTransferRepo = repository_mocks.NewMockTransferRepositoryInterface(s.ctrl)
+	s.mockAccountService = service_mocks.NewMockAccountServiceInterface(s.ctrl)
+	s.userID = uuid.New()
+
+	s.service = NewTransferScheduleService(
+		s.mockScheduleRepo,
+		s.mockTransferRepo,
+		s.mockAccountService,
+		10,
+		slog.Default(),
+	).(*TransferScheduleService)
+}
+
+func (s *TransferScheduleServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// newDueSchedule builds an active weekly schedule whose first occurrence is due
+func (s *TransferScheduleServiceTestSuite) newDueSchedule() *models.TransferSchedule {
+	schedule := &models.TransferSchedule{
+		ID:             uuid.New(),
+		UserID:         s.userID,
+		FromAccountID:  uuid.New(),
+		ToAccountID:    uuid.New(),
+		Amount:         decimal.NewFromInt(25),
+		Description:    "Allowance",
+		Frequency:      models.TransferFrequencyWeekly,
+		StartDate:      time.Now().Add(-time.Hour),
+		Status:         models.TransferScheduleStatusActive,
+		NextOccurrence: 1,
+	}
+	schedule.ScheduleNext()
+	return schedule
+}
+
+func (s *TransferScheduleServiceTestSuite) validRequest(from, to uuid.UUID) *dto.CreateTransferScheduleRequest {
+	return &dto.CreateTransferScheduleRequest{
+		FromAccountID: from.String(),
+		ToAccountID:   to.String(),
+		Amount:        "100.00",
+		Description:   "Rent",
+		Frequency:     models.TransferFrequencyMonthly,
+		StartDate:     time.Now().Add(24 * time.Hour),
+	}
+}
+
+func (s *TransferScheduleServiceTestSuite) TestCreateSchedule_SchedulesFirstOccurrence() {
+	from := &models.Account{ID: uuid.New(), UserID: s.userID, Status: models.AccountStatusActive}
+	to := &models.Account{ID: uuid.New(), UserID: uuid.New(), Status: models.AccountStatusActive}
+	req := s.validRequest(from.ID, to.ID)
+
+	s.mockAccountService.EXPECT().GetAccountByID(from.ID, nil).Return(from, nil)
+	s.mockAccountService.EXPECT().GetAccountByID(to.ID, nil).Return(to, nil)
+	s.mockScheduleRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	schedule, err := s.service.CreateSchedule(s.userID, req)
+	s.Require().NoError(err)
+	s.Equal(models.TransferScheduleStatusActive, schedule.Status)
+	s.Equal(1, schedule.NextOccurrence)
+	s.Require().NotNil(schedule.NextRunAt)
+	s.True(req.StartDate.Equal(*schedule.NextRunAt))
+}
+
+func (s *TransferScheduleServiceTestSuite) TestCreateSchedule_RejectsAnotherUsersAccount() {
+	from := &models.Account{ID: uuid.New(), UserID: uuid.New(), Status: models.AccountStatusActive}
+
+	s.mockAccountService.EXPECT().GetAccountByID(from.ID, nil).Return(from, nil)
+
+	_, err := s.service.CreateSchedule(s.userID, s.validRequest(from.ID, uuid.New()))
+	s.ErrorIs(err, ErrUnauthorized)
+}
+
+func (s *TransferScheduleServiceTestSuite) TestCreateSchedule_RejectsPastStartDate() {
+	req := s.validRequest(uuid.New(), uuid.New())
+	req.StartDate = time.Now().AddDate(0, 0, -2)
+
+	_, err := s.service.CreateSchedule(s.userID, req)
+	s.ErrorIs(err, ErrInvalidTransferSchedule)
+}
+
+func (s *TransferScheduleServiceTestSuite) TestGetSchedule_HidesOtherUsersSchedules() {
+	schedule := s.newDueSchedule()
+	schedule.UserID = uuid.New()
+	s.mockScheduleRepo.EXPECT().GetByID(schedule.ID).Return(schedule, nil)
+
+	_, err := s.service.GetSchedule(schedule.ID, s.userID)
+	s.ErrorIs(err, ErrTransferScheduleNotFound)
+}
+
+func (s *TransferScheduleServiceTestSuite) TestPauseSchedule_RequiresActive() {
+	schedule := s.newDueSchedule()
+	schedule.Status = models.TransferScheduleStatusPaused
+	s.mockScheduleRepo.EXPECT().GetByID(schedule.ID).Return(schedule, nil)
+
+	_, err := s.service.PauseSchedule(schedule.ID, s.userID)
+	s.ErrorIs(err, ErrTransferScheduleState)
+}
+
+func (s *TransferScheduleServiceTestSuite) TestResumeSchedule_SkipsMissedOccurrences() {
+	schedule := s.newDueSchedule()
+	schedule.StartDate = time.Now().AddDate(0, 0, -20)
+	schedule.Status = models.TransferScheduleStatusPaused
+	s.mockScheduleRepo.EXPECT().GetByID(schedule.ID).Return(schedule, nil)
+	s.mockScheduleRepo.EXPECT().Update(schedule).Return(nil)
+
+	resumed, err := s.service.ResumeSchedule(schedule.ID, s.userID)
+	s.Require().NoError(err)
+	s.Equal(models.TransferScheduleStatusActive, resumed.Status)
+	s.Equal(4, resumed.NextOccurrence)
+	s.Equal(0, resumed.OccurrencesRun)
+	s.True(resumed.NextRunAt.After(time.Now()))
+}
+
+func (s *TransferScheduleServiceTestSuite) TestCancelSchedule_AlreadyCompleted() {
+	schedule := s.newDueSchedule()
+	schedule.Status = models.TransferScheduleStatusCompleted
+	s.mockScheduleRepo.EXPECT().GetByID(schedule.ID).Return(schedule, nil)
+
+	_, err := s.service.CancelSchedule(schedule.ID, s.userID)
+	s.ErrorIs(err, ErrTransferScheduleState)
+}
+
+func (s *TransferScheduleServiceTestSuite) TestRunOccurrence_LinksTransferAndAdvances() {
+	schedule := s.newDueSchedule()
+	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusCompleted}
+
+	s.mockAccountService.EXPECT().TransferBetweenAccounts(
+		schedule.FromAccountID, schedule.ToAccountID, schedule.Amount, schedule.Description,
+		schedule.OccurrenceKey(1), s.userID,
+	).Return(transfer, nil)
+	s.mockTransferRepo.EXPECT().Update(transfer).DoAndReturn(func(t *models.Transfer) error {
+		s.Equal(schedule.ID, *t.ScheduleID)
+		s.Equal(1, *t.ScheduleOccurrence)
+		return nil
+	})
+	s.mockScheduleRepo.EXPECT().SaveRun(schedule, 1).Return(nil)
+
+	s.Require().NoError(s.service.runOccurrence(schedule, time.Now()))
+	s.Equal(2, schedule.NextOccurrence)
+	s.Equal(1, schedule.OccurrencesRun)
+}
+
+func (s *TransferScheduleServiceTestSuite) TestRunOccurrence_FailedTransferIsLinked() {
+	schedule := s.newDueSchedule()
+	failed := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusFailed}
+	key := schedule.OccurrenceKey(1)
+
+	s.mockAccountService.EXPECT().TransferBetweenAccounts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), key, gomock.Any()).
+		Return(nil, ErrInsufficientFunds)
+	s.mockTransferRepo.EXPECT().FindByIdempotencyKey(key).Return(failed, nil)
+	s.mockTransferRepo.EXPECT().Update(failed).Return(nil)
+	s.mockScheduleRepo.EXPECT().SaveRun(schedule, 1).Return(nil)
+
+	s.Require().NoError(s.service.runOccurrence(schedule, time.Now()))
+	s.Equal(schedule.ID, *failed.ScheduleID)
+	s.Equal(2, schedule.NextOccurrence)
+}
+
+func (s *TransferScheduleServiceTestSuite) TestRunOccurrence_RecordsRejectionAsFailedTransfer() {
+	schedule := s.newDueSchedule()
+	key := schedule.OccurrenceKey(1)
+
+	s.mockAccountService.EXPECT().TransferBetweenAccounts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), key, gomock.Any()).
+		Return(nil, ErrAccountNotActive)
+	s.mockTransferRepo.EXPECT().FindByIdempotencyKey(key).Return(nil, repositories.ErrTransferNotFound)
+	s.mockTransferRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(t *models.Transfer) error {
+		s.Equal(models.TransferStatusFailed, t.Status)
+		s.Equal(key, t.IdempotencyKey)
+		s.Equal(ErrAccountNotActive.Error(), *t.ErrorMessage)
+		s.Equal(schedule.ID, *t.ScheduleID)
+		return nil
+	})
+	s.mockScheduleRepo.EXPECT().SaveRun(schedule, 1).Return(nil)
+
+	s.Require().NoError(s.service.runOccurrence(schedule, time.Now()))
+}
+
+func (s *TransferScheduleServiceTestSuite) TestRunOccurrence_RetriesWhenOutcomeCannotBeRecorded() {
+	schedule := s.newDueSchedule()
+	key := schedule.OccurrenceKey(1)
+
+	s.mockAccountService.EXPECT().TransferBetweenAccounts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), key, gomock.Any()).
+		Return(nil, errors.New("connection refused"))
+	s.mockTransferRepo.EXPECT().FindByIdempotencyKey(key).Return(nil, errors.New("connection refused"))
+
+	s.Error(s.service.runOccurrence(schedule, time.Now()))
+	s.Equal(1, schedule.NextOccurrence)
+}
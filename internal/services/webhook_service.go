@@ -0,0 +1,621 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrWebhookDeliveryPending      = errors.New("webhook delivery is still pending")
+	ErrInvalidWebhookSubscription  = errors.New("invalid webhook subscription")
+	ErrWebhookAddressBlocked       = errors.New("webhook endpoint resolves to a private or reserved address")
+)
+
+// Webhook request headers
+const (
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+)
+
+const (
+	// DefaultWebhookBatchSize is the number of due deliveries attempted per poll
+	DefaultWebhookBatchSize = 100
+
+	// DefaultWebhookTimeout is how long an endpoint has to acknowledge a delivery
+	DefaultWebhookTimeout = 10 * time.Second
+
+	// webhookSecretPrefix marks subscription signing secrets
+	webhookSecretPrefix = "whsec_"
+
+	// maxWebhookResponseBody is how much of an endpoint's response is read
+	maxWebhookResponseBody = 64 << 10
+)
+
+// blockedWebhookPrefixes are reserved ranges webhooks may not reach, beyond the
+// loopback, private, link-local, multicast and unspecified addresses netip reports
+var blockedWebhookPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),  // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved and broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, which can reach IPv4 ranges
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("2002::/16"),      // 6to4, which embeds an IPv4 address
+	netip.MustParsePrefix("fec0::/10"),      // deprecated site-local
+}
+
+// blockedWebhookHostSuffixes are host names that only resolve inside a network
+var blockedWebhookHostSuffixes = []string{".localhost", ".local", ".internal", ".localdomain"}
+
+// WebhookEvent is the JSON body sent to webhook endpoints
+type WebhookEvent struct {
+	ID        uuid.UUID   `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// WebhookService manages webhook subscriptions and delivers events to them.
+// Published events are queued in webhook_deliveries, one delivery per subscription,
+// once the change that raised them is saved, and a background worker posts them.
+// Queueing is best effort: an event that cannot be queued is logged and dropped. A delivery that is not
+// acknowledged with a 2xx response is retried with exponential backoff. Endpoints
+// must be public: the client refuses to connect to private or reserved addresses
+// and does not follow redirects.
+type WebhookService struct {
+	webhookRepo       repositories.WebhookRepositoryInterface
+	client            *http.Client
+	maxAttempts       int
+	batchSize         int
+	allowInsecureURLs bool
+	logger            *slog.Logger
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(
+	webhookRepo repositories.WebhookRepositoryInterface,
+	timeout time.Duration,
+	maxAttempts int,
+	batchSize int,
+	allowInsecureURLs bool,
+	logger *slog.Logger,
+) WebhookServiceInterface {
+	if timeout <= 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = models.DefaultWebhookMaxAttempts
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultWebhookBatchSize
+	}
+
+	return &WebhookService{
+		webhookRepo:       webhookRepo,
+		client:            newWebhookClient(timeout),
+		maxAttempts:       maxAttempts,
+		batchSize:         batchSize,
+		allowInsecureURLs: allowInsecureURLs,
+		logger:            logger,
+	}
+}
+
+// SignWebhookPayload returns the signature header value for a payload sent at
+// timestamp: "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<payload>">". Receivers
+// recompute the HMAC with their secret and should reject stale timestamps.
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	t := strconv.FormatInt(timestamp, 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// CreateSubscription registers a URL for the selected events. A customer's
+// subscription receives events about their own accounts; an allCustomers one, for
+// staff who can read every customer, receives events for every customer. The
+// signing secret is only returned here.
+func (s *WebhookService) CreateSubscription(userID uuid.UUID, allCustomers bool, req *dto.CreateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionCreatedResponse, error) {
+	if err := s.validateURL(req.URL); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &models.WebhookSubscription{
+		UserID:       userID,
+		URL:          req.URL,
+		Description:  req.Description,
+		EventTypes:   models.WebhookEventTypes(req.EventTypes),
+		Secret:       secret,
+		AllCustomers: allCustomers,
+		Active:       true,
+	}
+
+	if err := subscription.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidWebhookSubscription, err.Error())
+	}
+
+	if err := s.webhookRepo.CreateSubscription(subscription); err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	s.logger.Info("webhook subscription created",
+		slog.String("subscription_id", subscription.ID.String()),
+		slog.String("user_id", userID.String()),
+		slog.Bool("all_customers", subscription.AllCustomers),
+	)
+
+	return &dto.WebhookSubscriptionCreatedResponse{
+		WebhookSubscription: subscription,
+		Secret:              secret,
+	}, nil
+}
+
+// ListSubscriptions retrieves the user's webhook subscriptions, newest first
+func (s *WebhookService) ListSubscriptions(userID uuid.UUID, offset, limit int) ([]models.WebhookSubscription, int64, error) {
+	subscriptions, total, err := s.webhookRepo.ListSubscriptions(userID, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	return subscriptions, total, nil
+}
+
+// GetSubscription retrieves one of the user's webhook subscriptions
+func (s *WebhookService) GetSubscription(id, userID uuid.UUID) (*models.WebhookSubscription, error) {
+	subscription, err := s.webhookRepo.GetSubscription(id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWebhookSubscriptionNotFound) {
+			return nil, ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+
+	// Another user's subscription is reported as missing so IDs cannot be probed
+	if subscription.UserID != userID {
+		return nil, ErrWebhookSubscriptionNotFound
+	}
+
+	return subscription, nil
+}
+
+// UpdateSubscription changes a subscription's URL, description, events or active
+// flag. Deliveries already queued are sent to the URL they were queued for.
+func (s *WebhookService) UpdateSubscription(id, userID uuid.UUID, req *dto.UpdateWebhookSubscriptionRequest) (*models.WebhookSubscription, error) {
+	subscription, err := s.GetSubscription(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := s.validateURL(*req.URL); err != nil {
+			return nil, err
+		}
+		subscription.URL = *req.URL
+	}
+	if req.Description != nil {
+		subscription.Description = *req.Description
+	}
+	if req.EventTypes != nil {
+		subscription.EventTypes = models.WebhookEventTypes(req.EventTypes)
+	}
+	if req.Active != nil {
+		subscription.Active = *req.Active
+	}
+
+	if err := subscription.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidWebhookSubscription, err.Error())
+	}
+
+	if err := s.webhookRepo.UpdateSubscription(subscription); err != nil {
+		if errors.Is(err, repositories.ErrWebhookSubscriptionNotFound) {
+			return nil, ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// DeleteSubscription removes one of the user's subscriptions and its delivery log
+func (s *WebhookService) DeleteSubscription(id, userID uuid.UUID) error {
+	if _, err := s.GetSubscription(id, userID); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.DeleteSubscription(id); err != nil {
+		if errors.Is(err, repositories.ErrWebhookSubscriptionNotFound) {
+			return ErrWebhookSubscriptionNotFound
+		}
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+
+	s.logger.Info("webhook subscription deleted",
+		slog.String("subscription_id", id.String()),
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
+
+// ListDeliveries retrieves the delivery log of one of the user's subscriptions,
+// newest first. An empty status lists deliveries in every status.
+func (s *WebhookService) ListDeliveries(subscriptionID, userID uuid.UUID, status string, offset, limit int) ([]models.WebhookDelivery, int64, error) {
+	if _, err := s.GetSubscription(subscriptionID, userID); err != nil {
+		return nil, 0, err
+	}
+
+	deliveries, total, err := s.webhookRepo.ListDeliveries(subscriptionID, status, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+// Redeliver queues a finished delivery to be sent again. The redelivery is a new
+// delivery with the same event ID and payload, so receivers can deduplicate it,
+// and its own attempts; the original delivery's log is left as it was.
+func (s *WebhookService) Redeliver(subscriptionID, deliveryID, userID uuid.UUID) (*models.WebhookDelivery, error) {
+	if _, err := s.GetSubscription(subscriptionID, userID); err != nil {
+		return nil, err
+	}
+
+	original, err := s.webhookRepo.GetDelivery(subscriptionID, deliveryID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWebhookDeliveryNotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	if original.IsPending() {
+		return nil, ErrWebhookDeliveryPending
+	}
+
+	redelivery := &models.WebhookDelivery{
+		SubscriptionID: subscriptionID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         models.WebhookDeliveryStatusPending,
+		MaxAttempts:    s.maxAttempts,
+		NextAttemptAt:  time.Now(),
+		RedeliveryOf:   &original.ID,
+	}
+
+	if err := s.webhookRepo.CreateDeliveries([]*models.WebhookDelivery{redelivery}); err != nil {
+		return nil, fmt.Errorf("failed to queue webhook redelivery: %w", err)
+	}
+
+	s.logger.Info("webhook redelivery queued",
+		slog.String("delivery_id", redelivery.ID.String()),
+		slog.String("redelivery_of", original.ID.String()),
+		slog.String("user_id", userID.String()),
+	)
+
+	return redelivery, nil
+}
+
+// Publish queues an event about a customer for every active subscription that
+// selected it. Publishing never fails the operation that raised the event; an
+// event that cannot be queued is logged and dropped.
+func (s *WebhookService) Publish(eventType string, userID uuid.UUID, data interface{}) {
+	subscriptions, err := s.webhookRepo.ListActiveSubscriptions(userID)
+	if err != nil {
+		s.logPublishError(eventType, userID, err)
+		return
+	}
+
+	var subscribed []models.WebhookSubscription
+	for _, subscription := range subscriptions {
+		if subscription.Subscribes(eventType) {
+			subscribed = append(subscribed, subscription)
+		}
+	}
+	if len(subscribed) == 0 {
+		return
+	}
+
+	now := time.Now()
+	event := WebhookEvent{
+		ID:        uuid.New(),
+		Type:      eventType,
+		CreatedAt: now.UTC(),
+		Data:      data,
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		s.logPublishError(eventType, userID, err)
+		return
+	}
+
+	deliveries := make([]*models.WebhookDelivery, 0, len(subscribed))
+	for _, subscription := range subscribed {
+		deliveries = append(deliveries, &models.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			Payload:        string(payload),
+			Status:         models.WebhookDeliveryStatusPending,
+			MaxAttempts:    s.maxAttempts,
+			NextAttemptAt:  now,
+		})
+	}
+
+	if err := s.webhookRepo.CreateDeliveries(deliveries); err != nil {
+		s.logPublishError(eventType, userID, err)
+	}
+}
+
+func (s *WebhookService) logPublishError(eventType string, userID uuid.UUID, err error) {
+	s.logger.Error("failed to publish webhook event",
+		slog.String("event_type", eventType),
+		slog.String("user_id", userID.String()),
+		slog.String("error", err.Error()),
+	)
+}
+
+// StartWorker polls for due deliveries and attempts them until the context is cancelled
+func (s *WebhookService) StartWorker(ctx context.Context, pollInterval time.Duration) {
+	s.logger.Info("starting webhook delivery worker",
+		slog.Duration("poll_interval", pollInterval),
+	)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("webhook delivery worker stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.DeliverDue(ctx); err != nil {
+				s.logger.Error("failed to deliver webhooks",
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
+
+// DeliverDue attempts a batch of due deliveries one after another and returns how
+// many were delivered. The claim's lease covers every delivery in the batch timing
+// out, so another worker does not pick up a delivery while this one is sending it.
+func (s *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	lease := s.client.Timeout*time.Duration(s.batchSize) + time.Minute
+
+	deliveries, err := s.webhookRepo.ClaimDueDeliveries(s.batchSize, lease)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim due webhook deliveries: %w", err)
+	}
+
+	subscriptions := make(map[uuid.UUID]*models.WebhookSubscription)
+	delivered := 0
+	for _, delivery := range deliveries {
+		select {
+		case <-ctx.Done():
+			return delivered, nil
+		default:
+		}
+
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = s.webhookRepo.GetSubscription(delivery.SubscriptionID)
+			if err != nil && !errors.Is(err, repositories.ErrWebhookSubscriptionNotFound) {
+				s.logger.Error("failed to load webhook subscription",
+					slog.String("subscription_id", delivery.SubscriptionID.String()),
+					slog.String("error", err.Error()),
+				)
+				continue
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		if err := s.attempt(ctx, subscription, delivery); err != nil {
+			s.logger.Error("failed to record webhook delivery attempt",
+				slog.String("delivery_id", delivery.ID.String()),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		if delivery.Status == models.WebhookDeliveryStatusDelivered {
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+// attempt sends a delivery once and records the outcome. Deliveries to a
+// subscription that was deactivated or deleted are failed without being sent.
+func (s *WebhookService) attempt(ctx context.Context, subscription *models.WebhookSubscription, delivery *models.WebhookDelivery) error {
+	if subscription == nil || !subscription.Active {
+		delivery.Abandon("subscription is no longer active")
+		return s.webhookRepo.SaveAttempt(delivery)
+	}
+
+	status, sendErr := s.send(ctx, subscription, delivery)
+	delivery.RecordAttempt(status, sendErr, time.Now())
+
+	switch delivery.Status {
+	case models.WebhookDeliveryStatusDelivered:
+		s.logger.Info("webhook delivered",
+			slog.String("delivery_id", delivery.ID.String()),
+			slog.String("event_type", delivery.EventType),
+			slog.Int("attempt", delivery.AttemptCount),
+		)
+	case models.WebhookDeliveryStatusFailed:
+		s.logger.Warn("webhook delivery failed",
+			slog.String("delivery_id", delivery.ID.String()),
+			slog.String("event_type", delivery.EventType),
+			slog.Int("attempts", delivery.AttemptCount),
+			slog.String("error", *delivery.LastError),
+		)
+	}
+
+	return s.webhookRepo.SaveAttempt(delivery)
+}
+
+// send posts the delivery's payload to the subscription URL and returns the
+// response status, or zero if no response was received
+func (s *WebhookService) send(ctx context.Context, subscription *models.WebhookSubscription, delivery *models.WebhookDelivery) (int, error) {
+	payload := []byte(delivery.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(subscription.Secret, time.Now().Unix(), payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxWebhookResponseBody))
+
+	return resp.StatusCode, nil
+}
+
+// validateURL requires an absolute https URL, or http when insecure URLs are allowed,
+// whose host is a public domain name. IP literals and names that only resolve
+// inside a network are rejected; the delivery client also checks every address a
+// name resolves to.
+func (s *WebhookService) validateURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute URL", ErrInvalidWebhookSubscription)
+	}
+
+	if parsed.Scheme != "https" && !(s.allowInsecureURLs && parsed.Scheme == "http") {
+		return fmt.Errorf("%w: url must use https", ErrInvalidWebhookSubscription)
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if _, err := netip.ParseAddr(host); err == nil {
+		return fmt.Errorf("%w: url must use a host name, not an IP address", ErrInvalidWebhookSubscription)
+	}
+
+	if host == "localhost" || !strings.Contains(host, ".") {
+		return fmt.Errorf("%w: url must use a public host name", ErrInvalidWebhookSubscription)
+	}
+	for _, suffix := range blockedWebhookHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return fmt.Errorf("%w: url must use a public host name", ErrInvalidWebhookSubscription)
+		}
+	}
+
+	return nil
+}
+
+// newWebhookClient creates the HTTP client used for deliveries. Its dialer checks
+// each address after DNS resolution, so a host name cannot be pointed at an
+// internal address once the subscription is accepted. Proxies are not used, since
+// the dialer would only see the proxy's address, and redirects are not followed:
+// the 3xx response is recorded as the attempt's status.
+func newWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   webhookDialControl,
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// webhookDialControl refuses connections to private and reserved addresses
+func webhookDialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrWebhookAddressBlocked, address)
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil || isBlockedWebhookAddr(addr) {
+		return fmt.Errorf("%w: %s", ErrWebhookAddressBlocked, host)
+	}
+
+	return nil
+}
+
+// isBlockedWebhookAddr reports whether addr is loopback, private, link-local
+// (including the 169.254.169.254 metadata endpoint), multicast, unspecified or
+// otherwise reserved
+func isBlockedWebhookAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+
+	for _, prefix := range blockedWebhookPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// generateWebhookSecret creates a random signing secret
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return webhookSecretPrefix + hex.EncodeToString(buf), nil
+}
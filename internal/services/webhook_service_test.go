@@ -0,0 +1,362 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"array-assessment/internal/dto"
+	"array-assessment/internal/models"
+	"array-assessment/internal/repositories"
+	"array-assessment/internal/repositories/repository_mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookServiceTestSuite struct {
+	suite.Suite
+	ctrl            *gomock.Controller
+	mockWebhookRepo *repository_mocks.MockWebhookRepositoryInterface
+	service         WebhookServiceInterface
+	userID          uuid.UUID
+}
+
+func TestWebhookServiceSuite(t *testing.T) {
+	suite.Run(t, new(WebhookServiceTestSuite))
+}
+
+func (s *WebhookServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockWebhookRepo = repository_mocks.NewMockWebhookRepositoryInterface(s.ctrl)
+	s.service = NewWebhookService(s.mockWebhookRepo, time.Second, 3, 10, true, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	s.userID = uuid.New()
+}
+
+func (s *WebhookServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// allowLoopback lets the service deliver to httptest servers, which listen on a
+// loopback address the delivery client otherwise refuses
+func (s *WebhookServiceTestSuite) allowLoopback() {
+	s.service.(*WebhookService).client.Transport = &http.Transport{}
+}
+
+func (s *WebhookServiceTestSuite) subscription(url string, eventTypes ...string) *models.WebhookSubscription {
+	return &models.WebhookSubscription{
+		ID:         uuid.New(),
+		UserID:     s.userID,
+		URL:        url,
+		EventTypes: models.WebhookEventTypes(eventTypes),
+		Secret:     "whsec_test",
+		Active:     true,
+	}
+}
+
+func (s *WebhookServiceTestSuite) TestCreateSubscription_GeneratesSecret() {
+	s.mockWebhookRepo.EXPECT().CreateSubscription(gomock.Any()).Return(nil)
+
+	created, err := s.service.CreateSubscription(s.userID, true, &dto.CreateWebhookSubscriptionRequest{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{models.WebhookEventTransferCompleted},
+	})
+
+	s.Require().NoError(err)
+	s.True(strings.HasPrefix(created.Secret, "whsec_"))
+	s.Equal(created.Secret, created.WebhookSubscription.Secret)
+	s.True(created.AllCustomers)
+	s.True(created.Active)
+}
+
+func (s *WebhookServiceTestSuite) TestCreateSubscription_RejectsInvalidInput() {
+	service := NewWebhookService(s.mockWebhookRepo, time.Second, 3, 10, false, slog.Default())
+
+	_, err := service.CreateSubscription(s.userID, false, &dto.CreateWebhookSubscriptionRequest{
+		URL:        "http://example.com/hooks",
+		EventTypes: []string{models.WebhookEventTransferCompleted},
+	})
+	s.ErrorIs(err, ErrInvalidWebhookSubscription)
+
+	_, err = service.CreateSubscription(s.userID, false, &dto.CreateWebhookSubscriptionRequest{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{"account.opened"},
+	})
+	s.ErrorIs(err, ErrInvalidWebhookSubscription)
+
+	for _, url := range []string{
+		"https://169.254.169.254/latest/meta-data",
+		"https://10.0.0.5/hooks",
+		"https://[::1]:8443/hooks",
+		"https://localhost/hooks",
+		"https://intranet/hooks",
+		"https://billing.internal/hooks",
+		"https://printer.local./hooks",
+	} {
+		_, err = service.CreateSubscription(s.userID, false, &dto.CreateWebhookSubscriptionRequest{
+			URL:        url,
+			EventTypes: []string{models.WebhookEventTransferCompleted},
+		})
+		s.ErrorIs(err, ErrInvalidWebhookSubscription, url)
+	}
+}
+
+func (s *WebhookServiceTestSuite) TestGetSubscription_OtherUsersSubscriptionIsNotFound() {
+	subscription := s.subscription("https://example.com/hooks", models.WebhookEventAccountClosed)
+	s.mockWebhookRepo.EXPECT().GetSubscription(subscription.ID).Return(subscription, nil)
+
+	_, err := s.service.GetSubscription(subscription.ID, uuid.New())
+
+	s.ErrorIs(err, ErrWebhookSubscriptionNotFound)
+}
+
+func (s *WebhookServiceTestSuite) TestPublish_QueuesDeliveryPerSubscribedEndpoint() {
+	transfers := s.subscription("https://example.com/transfers", models.WebhookEventTransferCompleted)
+	closures := s.subscription("https://example.com/closures", models.WebhookEventAccountClosed)
+	s.mockWebhookRepo.EXPECT().ListActiveSubscriptions(s.userID).
+		Return([]models.WebhookSubscription{*transfers, *closures}, nil)
+
+	var queued []*models.WebhookDelivery
+	s.mockWebhookRepo.EXPECT().CreateDeliveries(gomock.Any()).
+		DoAndReturn(func(deliveries []*models.WebhookDelivery) error {
+			queued = deliveries
+			return nil
+		})
+
+	s.service.Publish(models.WebhookEventTransferCompleted, s.userID, map[string]string{"transfer_id": "abc"})
+
+	s.Require().Len(queued, 1)
+	s.Equal(transfers.ID, queued[0].SubscriptionID)
+	s.Equal(3, queued[0].MaxAttempts)
+
+	var event WebhookEvent
+	s.Require().NoError(json.Unmarshal([]byte(queued[0].Payload), &event))
+	s.Equal(queued[0].EventID, event.ID)
+	s.Equal(models.WebhookEventTransferCompleted, event.Type)
+	s.Equal(map[string]interface{}{"transfer_id": "abc"}, event.Data)
+}
+
+func (s *WebhookServiceTestSuite) TestPublish_IgnoresRepositoryErrors() {
+	s.mockWebhookRepo.EXPECT().ListActiveSubscriptions(s.userID).Return(nil, errors.New("database unavailable"))
+
+	s.NotPanics(func() {
+		s.service.Publish(models.WebhookEventAccountClosed, s.userID, nil)
+	})
+}
+
+func (s *WebhookServiceTestSuite) TestDeliverDue_SignsAndDelivers() {
+	s.allowLoopback()
+	payload := `{"type":"account.closed"}`
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	subscription := s.subscription(server.URL, models.WebhookEventAccountClosed)
+	delivery := &models.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		EventType:      models.WebhookEventAccountClosed,
+		Payload:        payload,
+		Status:         models.WebhookDeliveryStatusPending,
+		MaxAttempts:    3,
+	}
+	s.mockWebhookRepo.EXPECT().ClaimDueDeliveries(10, gomock.Any()).Return([]*models.WebhookDelivery{delivery}, nil)
+	s.mockWebhookRepo.EXPECT().GetSubscription(subscription.ID).Return(subscription, nil)
+	s.mockWebhookRepo.EXPECT().SaveAttempt(delivery).Return(nil)
+
+	delivered, err := s.service.DeliverDue(context.Background())
+
+	s.Require().NoError(err)
+	s.Equal(1, delivered)
+	s.Equal(models.WebhookDeliveryStatusDelivered, delivery.Status)
+	s.Equal(1, delivery.AttemptCount)
+
+	s.Require().NotNil(received)
+	s.Equal(payload, string(body))
+	s.Equal(models.WebhookEventAccountClosed, received.Header.Get(WebhookEventHeader))
+	s.Equal(delivery.ID.String(), received.Header.Get(WebhookDeliveryHeader))
+
+	signature := received.Header.Get(WebhookSignatureHeader)
+	timestamp, err := strconv.ParseInt(strings.TrimPrefix(strings.Split(signature, ",")[0], "t="), 10, 64)
+	s.Require().NoError(err)
+	s.Equal(SignWebhookPayload("whsec_test", timestamp, []byte(payload)), signature)
+}
+
+func (s *WebhookServiceTestSuite) TestDeliverDue_RetriesFailedAttempt() {
+	s.allowLoopback()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	subscription := s.subscription(server.URL, models.WebhookEventAccountClosed)
+	delivery := &models.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		EventType:      models.WebhookEventAccountClosed,
+		Payload:        `{}`,
+		Status:         models.WebhookDeliveryStatusPending,
+		MaxAttempts:    3,
+	}
+	s.mockWebhookRepo.EXPECT().ClaimDueDeliveries(10, gomock.Any()).Return([]*models.WebhookDelivery{delivery}, nil)
+	s.mockWebhookRepo.EXPECT().GetSubscription(subscription.ID).Return(subscription, nil)
+	s.mockWebhookRepo.EXPECT().SaveAttempt(delivery).Return(nil)
+
+	delivered, err := s.service.DeliverDue(context.Background())
+
+	s.Require().NoError(err)
+	s.Zero(delivered)
+	s.Equal(models.WebhookDeliveryStatusPending, delivery.Status)
+	s.Equal(http.StatusServiceUnavailable, *delivery.ResponseStatus)
+	s.True(delivery.NextAttemptAt.After(time.Now()))
+}
+
+func (s *WebhookServiceTestSuite) TestDeliverDue_RefusesPrivateAddress() {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	subscription := s.subscription(server.URL, models.WebhookEventAccountClosed)
+	delivery := &models.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		EventType:      models.WebhookEventAccountClosed,
+		Payload:        `{}`,
+		Status:         models.WebhookDeliveryStatusPending,
+		MaxAttempts:    3,
+	}
+	s.mockWebhookRepo.EXPECT().ClaimDueDeliveries(10, gomock.Any()).Return([]*models.WebhookDelivery{delivery}, nil)
+	s.mockWebhookRepo.EXPECT().GetSubscription(subscription.ID).Return(subscription, nil)
+	s.mockWebhookRepo.EXPECT().SaveAttempt(delivery).Return(nil)
+
+	delivered, err := s.service.DeliverDue(context.Background())
+
+	s.Require().NoError(err)
+	s.Zero(delivered)
+	s.False(requested)
+	s.Require().NotNil(delivery.LastError)
+	s.Contains(*delivery.LastError, ErrWebhookAddressBlocked.Error())
+}
+
+func (s *WebhookServiceTestSuite) TestDeliverDue_DoesNotFollowRedirects() {
+	s.allowLoopback()
+	followed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/metadata" {
+			followed = true
+			return
+		}
+		http.Redirect(w, r, "/metadata", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	subscription := s.subscription(server.URL+"/hooks", models.WebhookEventAccountClosed)
+	delivery := &models.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		EventType:      models.WebhookEventAccountClosed,
+		Payload:        `{}`,
+		Status:         models.WebhookDeliveryStatusPending,
+		MaxAttempts:    3,
+	}
+	s.mockWebhookRepo.EXPECT().ClaimDueDeliveries(10, gomock.Any()).Return([]*models.WebhookDelivery{delivery}, nil)
+	s.mockWebhookRepo.EXPECT().GetSubscription(subscription.ID).Return(subscription, nil)
+	s.mockWebhookRepo.EXPECT().SaveAttempt(delivery).Return(nil)
+
+	delivered, err := s.service.DeliverDue(context.Background())
+
+	s.Require().NoError(err)
+	s.Zero(delivered)
+	s.False(followed)
+	s.Equal(http.StatusTemporaryRedirect, *delivery.ResponseStatus)
+}
+
+func (s *WebhookServiceTestSuite) TestDeliverDue_AbandonsDeliveryToDeletedSubscription() {
+	delivery := &models.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: uuid.New(),
+		Status:         models.WebhookDeliveryStatusPending,
+		MaxAttempts:    3,
+	}
+	s.mockWebhookRepo.EXPECT().ClaimDueDeliveries(10, gomock.Any()).Return([]*models.WebhookDelivery{delivery}, nil)
+	s.mockWebhookRepo.EXPECT().GetSubscription(delivery.SubscriptionID).Return(nil, repositories.ErrWebhookSubscriptionNotFound)
+	s.mockWebhookRepo.EXPECT().SaveAttempt(delivery).Return(nil)
+
+	_, err := s.service.DeliverDue(context.Background())
+
+	s.Require().NoError(err)
+	s.Equal(models.WebhookDeliveryStatusFailed, delivery.Status)
+	s.Zero(delivery.AttemptCount)
+}
+
+func (s *WebhookServiceTestSuite) TestRedeliver() {
+	subscription := s.subscription("https://example.com/hooks", models.WebhookEventAccountClosed)
+	failed := &models.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		EventID:        uuid.New(),
+		EventType:      models.WebhookEventAccountClosed,
+		Payload:        `{}`,
+		Status:         models.WebhookDeliveryStatusFailed,
+	}
+	s.mockWebhookRepo.EXPECT().GetSubscription(subscription.ID).Return(subscription, nil).AnyTimes()
+	s.mockWebhookRepo.EXPECT().GetDelivery(subscription.ID, failed.ID).Return(failed, nil)
+	s.mockWebhookRepo.EXPECT().CreateDeliveries(gomock.Any()).Return(nil)
+
+	redelivery, err := s.service.Redeliver(subscription.ID, failed.ID, s.userID)
+
+	s.Require().NoError(err)
+	s.NotEqual(failed.ID, redelivery.ID)
+	s.Equal(failed.EventID, redelivery.EventID)
+	s.Equal(&failed.ID, redelivery.RedeliveryOf)
+	s.True(redelivery.IsPending())
+
+	pending := &models.WebhookDelivery{ID: uuid.New(), Status: models.WebhookDeliveryStatusPending}
+	s.mockWebhookRepo.EXPECT().GetDelivery(subscription.ID, pending.ID).Return(pending, nil)
+
+	_, err = s.service.Redeliver(subscription.ID, pending.ID, s.userID)
+	s.ErrorIs(err, ErrWebhookDeliveryPending)
+}
+
+func (s *WebhookServiceTestSuite) TestSignWebhookPayload() {
+	signature := SignWebhookPayload("whsec_test", 1700000000, []byte(`{}`))
+
+	s.True(strings.HasPrefix(signature, "t=1700000000,v1="))
+	s.Len(strings.TrimPrefix(signature, "t=1700000000,v1="), 64)
+	s.NotEqual(signature, SignWebhookPayload("whsec_other", 1700000000, []byte(`{}`)))
+}
+
+func TestIsBlockedWebhookAddr(t *testing.T) {
+	blocked := []string{
+		"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1",
+		"0.0.0.0", "255.255.255.255", "::1", "::", "fd00::1", "fe80::1", "::ffff:10.0.0.1", "64:ff9b::a9fe:a9fe",
+	}
+	for _, ip := range blocked {
+		if !isBlockedWebhookAddr(netip.MustParseAddr(ip)) {
+			t.Errorf("expected %s to be blocked", ip)
+		}
+	}
+
+	for _, ip := range []string{"93.184.216.34", "8.8.8.8", "2606:4700:4700::1111"} {
+		if isBlockedWebhookAddr(netip.MustParseAddr(ip)) {
+			t.Errorf("expected %s to be allowed", ip)
+		}
+	}
+}